@@ -0,0 +1,63 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"openpitrix.io/openpitrix/pkg/devkit"
+)
+
+type keygenCmd struct {
+	name string
+
+	out io.Writer
+}
+
+func newKeygenCmd(out io.Writer) *cobra.Command {
+	k := &keygenCmd{out: out}
+
+	cmd := &cobra.Command{
+		Use:   "keygen NAME",
+		Short: "generate a key pair to sign app archives, saved to NAME.key and NAME.pub",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgsLength(len(args), "the name of the key"); err != nil {
+				return err
+			}
+			k.name = args[0]
+			return k.run()
+		},
+	}
+	return cmd
+}
+
+func (k *keygenCmd) run() error {
+	privateKeyFile := k.name + ".key"
+	publicKeyFile := k.name + ".pub"
+	for _, f := range []string{privateKeyFile, publicKeyFile} {
+		if _, err := os.Stat(f); err == nil {
+			return fmt.Errorf("file [%s] already exists", f)
+		}
+	}
+
+	publicKey, privateKey, err := devkit.GenerateKey()
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(privateKeyFile, []byte(privateKey+"\n"), 0600); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(publicKeyFile, []byte(publicKey+"\n"), 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(k.out, "Private key saved to: %s\n", privateKeyFile)
+	fmt.Fprintf(k.out, "Public key saved to: %s\n", publicKeyFile)
+	return nil
+}
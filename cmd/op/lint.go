@@ -3,3 +3,90 @@
 // that can be found in the LICENSE file.
 
 package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"openpitrix.io/openpitrix/pkg/devkit"
+)
+
+type lintCmd struct {
+	paths  []string
+	output string
+	strict bool
+
+	out io.Writer
+}
+
+func newLintCmd(out io.Writer) *cobra.Command {
+	l := &lintCmd{out: out}
+
+	cmd := &cobra.Command{
+		Use:   "lint [flags] PATH [...]",
+		Short: "examine an app directory or archive for possible issues",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("need at least one argument, the path to the app")
+			}
+			l.paths = args
+			return l.run()
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&l.output, "output", "o", "text", "output format (text/json)")
+	f.BoolVar(&l.strict, "strict", false, "fail on lint warnings")
+
+	return cmd
+}
+
+func (l *lintCmd) run() error {
+	if l.output != "text" && l.output != "json" {
+		return fmt.Errorf("unknown output format [%s]", l.output)
+	}
+
+	var (
+		results  []*devkit.LintResult
+		errors   int
+		warnings int
+	)
+	for _, path := range l.paths {
+		r := devkit.Lint(path)
+		results = append(results, r)
+		errors += r.Count(devkit.LintError)
+		warnings += r.Count(devkit.LintWarning)
+	}
+
+	if l.output == "json" {
+		enc := json.NewEncoder(l.out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	} else {
+		for _, r := range results {
+			fmt.Fprintf(l.out, "==> Linting %s\n", r.Path)
+			for _, m := range r.Messages {
+				location := m.File
+				if m.Field != "" {
+					location += ": " + m.Field
+				}
+				if location != "" {
+					location += ": "
+				}
+				fmt.Fprintf(l.out, "[%s] %s%s\n", m.Severity, location, m.Message)
+			}
+			fmt.Fprintln(l.out)
+		}
+		fmt.Fprintf(l.out, "%d app(s) linted, %d error(s), %d warning(s)\n", len(results), errors, warnings)
+	}
+
+	if errors > 0 || (l.strict && warnings > 0) {
+		return fmt.Errorf("%d error(s), %d warning(s) found", errors, warnings)
+	}
+	return nil
+}
@@ -25,10 +25,14 @@ func newRootCmd(c string, args []string) *cobra.Command {
 	cmd.AddCommand(
 		// app commands
 		newCreateCmd(out),
-		//newLintCmd(out),
+		newLintCmd(out),
+		newPlanCmd(out),
 		newPackageCmd(out),
+		newKeygenCmd(out),
+		newVerifyCmd(out),
 		newIndexCmd(out),
 		newServeCmd(out),
+		newPushCmd(out),
 	)
 	flags.Parse(args)
 	return cmd
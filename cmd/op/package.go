@@ -7,6 +7,7 @@ package main
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -21,6 +22,8 @@ type packageCmd struct {
 	path        string
 	version     string
 	destination string
+	sign        bool
+	key         string
 
 	out io.Writer
 }
@@ -48,11 +51,17 @@ func newPackageCmd(out io.Writer) *cobra.Command {
 	f := cmd.Flags()
 	f.StringVar(&pkg.version, "version", "", "set the version on the app to this semver version")
 	f.StringVarP(&pkg.destination, "destination", "d", ".", "location to write the app.")
+	f.BoolVar(&pkg.sign, "sign", false, "write a provenance file signed by --key next to the app archive")
+	f.StringVar(&pkg.key, "key", "", "private key file generated by 'op keygen', used with --sign")
 
 	return cmd
 }
 
 func (p *packageCmd) run() error {
+	if p.sign && p.key == "" {
+		return fmt.Errorf("--key is required by --sign")
+	}
+
 	path, err := filepath.Abs(p.path)
 	if err != nil {
 		return err
@@ -93,9 +102,29 @@ func (p *packageCmd) run() error {
 		return fmt.Errorf("failed to save: %s", err)
 	}
 
+	if p.sign {
+		return p.signPackage(name)
+	}
 	return err
 }
 
+func (p *packageCmd) signPackage(name string) error {
+	data, err := ioutil.ReadFile(p.key)
+	if err != nil {
+		return err
+	}
+	key, err := devkit.ParsePrivateKey(string(data))
+	if err != nil {
+		return err
+	}
+	provFile, err := devkit.Sign(name, key)
+	if err != nil {
+		return fmt.Errorf("failed to sign: %s", err)
+	}
+	fmt.Fprintf(p.out, "Successfully signed app and saved provenance to: %s\n", provFile)
+	return nil
+}
+
 func setVersion(version *app.App, ver string) error {
 	// Verify that version is a SemVer, and error out if it is not.
 	if _, err := semver.NewVersion(ver); err != nil {
@@ -0,0 +1,314 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit"
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/reflectutil"
+)
+
+const (
+	planCreate   = "create"
+	planScaleOut = "scale-out"
+	planScaleIn  = "scale-in"
+	planDelete   = "delete"
+)
+
+var planActions = []string{planCreate, planScaleOut, planScaleIn, planDelete}
+
+type planCmd struct {
+	path     string
+	config   string
+	actions  []string
+	role     string
+	count    int
+	provider string
+	output   string
+
+	out io.Writer
+}
+
+type planTask struct {
+	Action         string          `json:"action"`
+	Target         string          `json:"target"`
+	NodeId         string          `json:"node_id"`
+	FailureAllowed bool            `json:"failure_allowed"`
+	Directive      json.RawMessage `json:"directive,omitempty"`
+}
+
+type planLayer struct {
+	Tasks []*planTask `json:"tasks"`
+}
+
+type planResult struct {
+	Action string       `json:"action"`
+	Layers []*planLayer `json:"layers"`
+}
+
+type planOutput struct {
+	ClusterWrapper *models.ClusterWrapper `json:"cluster_wrapper"`
+	Plans          []*planResult          `json:"plans"`
+}
+
+func newPlanCmd(out io.Writer) *cobra.Command {
+	p := &planCmd{out: out}
+
+	cmd := &cobra.Command{
+		Use:   "plan [flags] PATH",
+		Short: "show the cluster and the tasks an app would deploy, without touching any service",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgsLength(len(args), "the path to the app"); err != nil {
+				return err
+			}
+			p.path = args[0]
+			return p.run()
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&p.config, "config", "c", "", "json file of the cluster config, merged into the defaults of config.json")
+	f.StringSliceVarP(&p.actions, "action", "a", planActions, "actions to plan ("+strings.Join(planActions, "/")+")")
+	f.StringVar(&p.role, "role", "", "role to scale, default to the first role")
+	f.IntVar(&p.count, "count", 1, "number of nodes to scale")
+	f.StringVar(&p.provider, "provider", constants.ProviderQingCloud, "provider of the fake runtime")
+	f.StringVarP(&p.output, "output", "o", "text", "output format (text/json)")
+
+	return cmd
+}
+
+func (p *planCmd) run() error {
+	if p.output != "text" && p.output != "json" {
+		return fmt.Errorf("unknown output format [%s]", p.output)
+	}
+	for _, action := range p.actions {
+		if !reflectutil.In(action, planActions) {
+			return fmt.Errorf("unknown action [%s], must be one of %v", action, planActions)
+		}
+	}
+	if p.count <= 0 {
+		return fmt.Errorf("invalid count [%d], must be positive", p.count)
+	}
+
+	a, err := devkit.Load(p.path)
+	if err != nil {
+		return err
+	}
+
+	config := a.ConfigTemplate.GetDefaultConfig()
+	if p.config != "" {
+		data, err := ioutil.ReadFile(p.config)
+		if err != nil {
+			return err
+		}
+		var values app.ClusterUserConfig
+		if err := json.Unmarshal(data, &values); err != nil {
+			return fmt.Errorf("failed to decode [%s]: %+v", p.config, err)
+		}
+		config = mergeConfig(config, values)
+	}
+
+	clusterConf, err := a.ClusterConfTemplate.Render(config)
+	if err != nil {
+		return err
+	}
+	if err := clusterConf.Validate(); err != nil {
+		return fmt.Errorf("failed to validate cluster.json: %+v", err)
+	}
+
+	role := p.role
+	if role == "" && len(clusterConf.Nodes) > 0 {
+		role = clusterConf.Nodes[0].Role
+	}
+
+	// the parser and frame only log when something is wrong
+	planLogger := logger.NewLogger()
+	planLogger.SetLevel(logger.ErrorLevel)
+
+	output := new(planOutput)
+	for _, action := range p.actions {
+		frame, err := vmbased.NewPlanFrame(clusterConf, p.provider, planLogger)
+		if err != nil {
+			return err
+		}
+		if output.ClusterWrapper == nil {
+			output.ClusterWrapper = frame.ClusterWrapper
+		}
+
+		var layer *models.TaskLayer
+		switch action {
+		case planCreate:
+			layer = frame.CreateClusterLayer()
+		case planScaleOut:
+			frame.PlanActivate()
+			if err := frame.PlanAddNodes(role, p.count); err != nil {
+				return err
+			}
+			layer = frame.AddClusterNodesLayer()
+		case planScaleIn:
+			frame.PlanActivate()
+			if err := frame.PlanDeleteNodes(role, p.count); err != nil {
+				return err
+			}
+			layer = frame.DeleteClusterNodesLayer()
+		case planDelete:
+			frame.PlanActivate()
+			layer = frame.DeleteClusterLayer()
+		}
+
+		output.Plans = append(output.Plans, &planResult{
+			Action: action,
+			Layers: toPlanLayers(layer),
+		})
+	}
+
+	if p.output == "json" {
+		enc := json.NewEncoder(p.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(output)
+	}
+
+	p.printText(output)
+	return nil
+}
+
+func (p *planCmd) printText(output *planOutput) {
+	w := output.ClusterWrapper
+
+	fmt.Fprintf(p.out, "Cluster: %s\n", w.Cluster.Name)
+	fmt.Fprintf(p.out, "  subnet: %s\n", w.Cluster.SubnetId)
+
+	var roles []string
+	for role := range w.ClusterRoles {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	fmt.Fprintf(p.out, "\nRoles:\n")
+	for _, role := range roles {
+		r := w.ClusterRoles[role]
+		fmt.Fprintf(p.out, "  [%s] cpu: %d, memory: %d, gpu: %d, instance size: %d\n",
+			role, r.Cpu, r.Memory, r.Gpu, r.InstanceSize)
+		if r.StorageSize > 0 {
+			fmt.Fprintf(p.out, "    volume: %d on %s (%s, %s)\n",
+				r.StorageSize, r.MountPoint, r.FileSystem, r.MountOptions)
+		}
+		if r.Env != "" {
+			fmt.Fprintf(p.out, "    env: %s\n", r.Env)
+		}
+		if c, ok := w.ClusterCommons[role]; ok {
+			var services []string
+			for _, s := range []struct{ name, value string }{
+				{constants.ServiceInit, c.InitService},
+				{constants.ServiceStart, c.StartService},
+				{constants.ServiceStop, c.StopService},
+				{constants.ServiceScaleOut, c.ScaleOutService},
+				{constants.ServiceScaleIn, c.ScaleInService},
+				{constants.ServiceRestart, c.RestartService},
+				{constants.ServiceDestroy, c.DestroyService},
+				{constants.ServiceUpgrade, c.UpgradeService},
+			} {
+				if s.value != "" {
+					services = append(services, s.name)
+				}
+			}
+			if len(services) > 0 {
+				fmt.Fprintf(p.out, "    services: %s\n", strings.Join(services, ", "))
+			}
+		}
+	}
+
+	var nodeIds []string
+	for nodeId := range w.ClusterNodesWithKeyPairs {
+		nodeIds = append(nodeIds, nodeId)
+	}
+	sort.Strings(nodeIds)
+
+	fmt.Fprintf(p.out, "\nNodes:\n")
+	for _, nodeId := range nodeIds {
+		n := w.ClusterNodesWithKeyPairs[nodeId]
+		fmt.Fprintf(p.out, "  %s role: %s, server id: %d, group id: %d, ip: %s\n",
+			nodeId, n.Role, n.ServerId, n.GroupId, n.PrivateIp)
+	}
+
+	for _, plan := range output.Plans {
+		fmt.Fprintf(p.out, "\nPlan [%s]:\n", plan.Action)
+		for i, layer := range plan.Layers {
+			var nodes []string
+			for _, task := range layer.Tasks {
+				nodes = append(nodes, task.NodeId)
+			}
+			failureAllowed := ""
+			if layer.Tasks[0].FailureAllowed {
+				failureAllowed = " (failure allowed)"
+			}
+			fmt.Fprintf(p.out, "  %2d. %s -> %s%s: %s\n", i+1,
+				layer.Tasks[0].Action, layer.Tasks[0].Target, failureAllowed, strings.Join(nodes, ", "))
+		}
+	}
+}
+
+func toPlanLayers(layer *models.TaskLayer) []*planLayer {
+	layers := []*planLayer{}
+	for ; layer != nil; layer = layer.Child {
+		if len(layer.Tasks) == 0 {
+			continue
+		}
+
+		l := new(planLayer)
+		for _, task := range layer.Tasks {
+			t := &planTask{
+				Action:         task.TaskAction,
+				Target:         task.Target,
+				NodeId:         task.NodeId,
+				FailureAllowed: task.FailureAllowed,
+			}
+			if json.Valid([]byte(task.Directive)) {
+				t.Directive = json.RawMessage(task.Directive)
+			} else if task.Directive != "" {
+				t.Directive = json.RawMessage(jsonutil.ToString(task.Directive))
+			}
+			l.Tasks = append(l.Tasks, t)
+		}
+		// the tasks in a layer run in parallel, sort them to keep the plan stable
+		sort.SliceStable(l.Tasks, func(i, j int) bool {
+			return l.Tasks[i].NodeId < l.Tasks[j].NodeId
+		})
+		layers = append(layers, l)
+	}
+	return layers
+}
+
+// mergeConfig overrides the defaults with the values of the user.
+func mergeConfig(defaults, values app.ClusterUserConfig) app.ClusterUserConfig {
+	d, ok1 := defaults.(map[string]app.ClusterUserConfig)
+	v, ok2 := values.(map[string]interface{})
+	if !ok1 || !ok2 {
+		return values
+	}
+
+	result := make(map[string]app.ClusterUserConfig)
+	for key, value := range d {
+		result[key] = value
+	}
+	for key, value := range v {
+		result[key] = mergeConfig(result[key], value)
+	}
+	return result
+}
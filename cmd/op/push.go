@@ -0,0 +1,162 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/helm/pkg/provenance"
+
+	"openpitrix.io/openpitrix/pkg/devkit"
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+	"openpitrix.io/openpitrix/pkg/reporeader"
+	"openpitrix.io/openpitrix/pkg/util/yamlutil"
+	"openpitrix.io/openpitrix/test"
+	"openpitrix.io/openpitrix/test/client/repo_indexer"
+	"openpitrix.io/openpitrix/test/models"
+)
+
+type pushCmd struct {
+	path       string
+	repo       string
+	credential string
+	force      bool
+	indexRepo  string
+	host       string
+
+	out io.Writer
+}
+
+func newPushCmd(out io.Writer) *cobra.Command {
+	p := &pushCmd{out: out}
+
+	cmd := &cobra.Command{
+		Use:   "push [flags] PACKAGE",
+		Short: "upload an app archive to a repo and update its index.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgsLength(len(args), "the path to the app archive"); err != nil {
+				return err
+			}
+			p.path = args[0]
+			return p.run()
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&p.repo, "repo", "", "url of the repo, e.g. s3://s3.pek3a.qingstor.com/op-repo or http://127.0.0.1:8879/")
+	f.StringVar(&p.credential, "credential", "", `credential of the s3 repo, {"access_key_id": "...", "secret_access_key": "..."}`)
+	f.BoolVar(&p.force, "force", false, "replace the version if it is already in the repo")
+	f.StringVar(&p.indexRepo, "index-repo", "", "id of the repo in OpenPitrix to index after pushing")
+	f.StringVar(&p.host, "host", "localhost:9100", "api gateway host of OpenPitrix, used with --index-repo")
+
+	return cmd
+}
+
+func (p *pushCmd) run() error {
+	if p.repo == "" {
+		return fmt.Errorf("--repo is required")
+	}
+	if fi, err := os.Stat(p.path); err != nil {
+		return err
+	} else if fi.IsDir() {
+		return fmt.Errorf("[%s] is a directory, run 'op package' first", p.path)
+	}
+
+	u, err := neturl.ParseRequestURI(p.repo)
+	if err != nil {
+		return err
+	}
+	writer, err := reporeader.NewWriter(u.Scheme, p.repo, p.credential)
+	if err != nil {
+		return fmt.Errorf("invalid repo [%s]: %s", p.repo, err)
+	}
+
+	a, err := devkit.Load(p.path)
+	if err != nil {
+		return err
+	}
+	digest, err := provenance.DigestFile(p.path)
+	if err != nil {
+		return err
+	}
+
+	indexFile := app.NewIndexFile()
+	content, err := writer.GetIndexYaml()
+	switch err {
+	case nil:
+		err = yamlutil.Decode(content, indexFile)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s of the repo: %s", devkit.IndexYaml, err)
+		}
+	case reporeader.ErrIndexYamlNotFound:
+		fmt.Fprintf(p.out, "No %s in the repo, a new one will be created\n", devkit.IndexYaml)
+	default:
+		return err
+	}
+
+	name := a.Metadata.Name
+	version := a.Metadata.Version
+	if indexFile.Has(name, version) && !p.force {
+		return fmt.Errorf("app [%s-%s] is already in the repo, use --force to replace it", name, version)
+	}
+
+	// files pushed before the index, the repo never refers to a missing package
+	filename := filepath.Base(p.path)
+	files := []string{p.path}
+	if _, err := os.Stat(p.path + devkit.ProvenanceSuffix); err == nil {
+		files = append(files, p.path+devkit.ProvenanceSuffix)
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if err := writer.PutFile(filepath.Base(file), data); err != nil {
+			return fmt.Errorf("failed to upload [%s]: %s", file, err)
+		}
+		fmt.Fprintf(p.out, "Uploaded [%s]\n", writer.GetFileUrl(filepath.Base(file)))
+	}
+
+	// the existing entries are kept unless they are the pushed version
+	newIndexFile := app.NewIndexFile()
+	newIndexFile.Add(a.Metadata, writer.GetFileUrl(filename), "", digest)
+	newIndexFile.Merge(indexFile)
+	newIndexFile.SortEntries()
+
+	data, err := yamlutil.Encode(newIndexFile)
+	if err != nil {
+		return err
+	}
+	if err := writer.PutFile(devkit.IndexYaml, data); err != nil {
+		return fmt.Errorf("failed to upload %s: %s", devkit.IndexYaml, err)
+	}
+	fmt.Fprintf(p.out, "Successfully pushed app [%s-%s] to [%s]\n", name, version, p.repo)
+
+	if p.indexRepo != "" {
+		return p.triggerIndex()
+	}
+	return nil
+}
+
+func (p *pushCmd) triggerIndex() error {
+	client := test.GetClient(&test.ClientConfig{Host: p.host, BasePath: "/"})
+
+	params := repo_indexer.NewIndexRepoParams()
+	params.SetBody(&models.OpenpitrixIndexRepoRequest{
+		RepoID: p.indexRepo,
+	})
+	resp, err := client.RepoIndexer.IndexRepo(params)
+	if err != nil {
+		return fmt.Errorf("failed to index repo [%s]: %s", p.indexRepo, err)
+	}
+	fmt.Fprintf(p.out, "Indexing repo [%s], repo event [%s]\n", p.indexRepo, resp.Payload.RepoEvent.RepoEventID)
+	return nil
+}
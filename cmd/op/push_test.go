@@ -0,0 +1,142 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/helm/pkg/provenance"
+
+	"openpitrix.io/openpitrix/pkg/devkit"
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+)
+
+// tPackageApp creates the app in dir and packages it like 'op package'.
+func tPackageApp(t *testing.T, dir, name, version string) string {
+	path, err := devkit.Create(&app.Metadata{
+		Name:        name,
+		Description: "An OpenPitrix app",
+		Version:     version,
+		AppVersion:  "1.0",
+		ApiVersion:  devkit.ApiVersionV1,
+	}, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := devkit.LoadDir(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive, err := devkit.Save(a, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return archive
+}
+
+func TestPush(t *testing.T) {
+	dir, err := ioutil.TempDir("", "op-push")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repoPath := filepath.Join(dir, "repo")
+	if err := os.Mkdir(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// the repo has another app indexed already
+	indexFile := app.NewIndexFile()
+	indexFile.Add(&app.Metadata{Name: "redis", Version: "1.0.0", ApiVersion: devkit.ApiVersionV1},
+		"redis-1.0.0.tgz", "http://example.com/", "sha256:redis")
+	if err := indexFile.WriteFile(filepath.Join(repoPath, devkit.IndexYaml), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(&devkit.RepositoryServer{RepoPath: repoPath, AllowUpload: true})
+	defer server.Close()
+
+	archive := tPackageApp(t, dir, "nginx", "0.1.0")
+	digest, err := provenance.DigestFile(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	push := func(force bool) error {
+		p := &pushCmd{path: archive, repo: server.URL + "/", force: force, out: new(bytes.Buffer)}
+		return p.run()
+	}
+
+	if err := push(false); err != nil {
+		t.Fatalf("Push failed: %+v", err)
+	}
+
+	uploaded, err := ioutil.ReadFile(filepath.Join(repoPath, filepath.Base(archive)))
+	if err != nil {
+		t.Fatalf("Package not uploaded: %+v", err)
+	}
+	if expect, _ := ioutil.ReadFile(archive); !bytes.Equal(uploaded, expect) {
+		t.Fatalf("Uploaded package differs from [%s]", archive)
+	}
+
+	checkIndex := func() {
+		i, err := app.LoadIndexFile(filepath.Join(repoPath, devkit.IndexYaml))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !i.Has("redis", "1.0.0") {
+			t.Fatalf("Existing entry [redis-1.0.0] lost after push")
+		}
+		v, err := i.Get("nginx", "0.1.0")
+		if err != nil {
+			t.Fatalf("Pushed entry [nginx-0.1.0] not indexed: %+v", err)
+		}
+		if len(i.Entries["nginx"]) != 1 {
+			t.Fatalf("Expect 1 version of nginx, got %d", len(i.Entries["nginx"]))
+		}
+		if expect := server.URL + "/" + filepath.Base(archive); len(v.URLs) != 1 || v.URLs[0] != expect {
+			t.Fatalf("Expect url [%s], got %v", expect, v.URLs)
+		}
+		if v.Digest != digest {
+			t.Fatalf("Expect digest [%s], got [%s]", digest, v.Digest)
+		}
+	}
+	checkIndex()
+
+	// the same version is replaced only with --force
+	if err := push(false); err == nil {
+		t.Fatalf("Push of an existing version should fail without --force")
+	}
+	if err := push(true); err != nil {
+		t.Fatalf("Push with --force failed: %+v", err)
+	}
+	checkIndex()
+}
+
+func TestPushUploadNotAllowed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "op-push")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	server := httptest.NewServer(&devkit.RepositoryServer{RepoPath: dir})
+	defer server.Close()
+
+	archive := tPackageApp(t, dir, "nginx", "0.1.0")
+	p := &pushCmd{path: archive, repo: server.URL + "/", out: new(bytes.Buffer)}
+	if err := p.run(); err == nil {
+		t.Fatalf("Push should fail when the repo does not allow upload")
+	}
+	if _, err := os.Stat(filepath.Join(dir, devkit.IndexYaml)); err == nil {
+		t.Fatalf("%s should not be written", devkit.IndexYaml)
+	}
+}
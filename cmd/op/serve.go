@@ -16,10 +16,11 @@ import (
 )
 
 type serveCmd struct {
-	out      io.Writer
-	url      string
-	address  string
-	repoPath string
+	out         io.Writer
+	url         string
+	address     string
+	repoPath    string
+	allowUpload bool
 }
 
 func newServeCmd(out io.Writer) *cobra.Command {
@@ -36,6 +37,7 @@ func newServeCmd(out io.Writer) *cobra.Command {
 	f.StringVar(&srv.repoPath, "repo-path", "", "local directory path from which to serve apps")
 	f.StringVar(&srv.address, "address", devkit.DefaultServeAddr, "address to listen on")
 	f.StringVar(&srv.url, "url", "", "external URL of app repository")
+	f.BoolVar(&srv.allowUpload, "allow-upload", false, "accept packages and index.yaml uploaded with PUT, e.g. by 'op push'")
 
 	return cmd
 }
@@ -60,5 +62,5 @@ func (s *serveCmd) run() error {
 	}
 
 	fmt.Fprintf(s.out, "Now serving you on [http://%s/]\n", s.address)
-	return devkit.StartLocalRepo(repoPath, s.address)
+	return devkit.StartLocalRepo(repoPath, s.address, s.allowUpload)
 }
@@ -0,0 +1,70 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"openpitrix.io/openpitrix/pkg/devkit"
+)
+
+type verifyCmd struct {
+	path     string
+	provFile string
+	keyring  string
+
+	out io.Writer
+}
+
+func newVerifyCmd(out io.Writer) *cobra.Command {
+	v := &verifyCmd{out: out}
+
+	cmd := &cobra.Command{
+		Use:   "verify [flags] PATH",
+		Short: "verify that the app archive matches its provenance file and is signed by a trusted key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgsLength(len(args), "the path to the app archive"); err != nil {
+				return err
+			}
+			v.path = args[0]
+			return v.run()
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&v.provFile, "prov", "", "provenance file, default to PATH"+devkit.ProvenanceSuffix)
+	f.StringVar(&v.keyring, "keyring", "", "file of trusted public keys, one per line")
+
+	return cmd
+}
+
+func (v *verifyCmd) run() error {
+	if v.keyring == "" {
+		return fmt.Errorf("--keyring is required")
+	}
+	if v.provFile == "" {
+		v.provFile = v.path + devkit.ProvenanceSuffix
+	}
+
+	data, err := ioutil.ReadFile(v.keyring)
+	if err != nil {
+		return err
+	}
+	keys, err := devkit.ParsePublicKeys(string(data))
+	if err != nil {
+		return err
+	}
+
+	s, err := devkit.VerifyFile(v.path, v.provFile, keys)
+	if err != nil {
+		return fmt.Errorf("failed to verify [%s]: %s", v.path, err)
+	}
+	fmt.Fprintf(v.out, "Verified app [%s-%s], digest: %s\n", s.Name, s.Version, s.Digest)
+	return nil
+}
@@ -2,9 +2,2915 @@
 // Use of this source code is governed by a Apache license
 // that can be found in the LICENSE file.
 
+// Auto generated by 'go run gen_helper.go', DO NOT EDIT.
+
 package main
 
+import (
+	"openpitrix.io/openpitrix/test/client/app_manager"
+	"openpitrix.io/openpitrix/test/client/category_manager"
+	"openpitrix.io/openpitrix/test/client/cluster_manager"
+	"openpitrix.io/openpitrix/test/client/job_manager"
+	"openpitrix.io/openpitrix/test/client/repo_indexer"
+	"openpitrix.io/openpitrix/test/client/repo_manager"
+	"openpitrix.io/openpitrix/test/client/runtime_manager"
+	"openpitrix.io/openpitrix/test/client/task_manager"
+	"openpitrix.io/openpitrix/test/models"
+)
+
 var AllCmd = []Cmd{
 	NewCreateAppCmd(),
-	NewDescribeAppCmd(),
+	NewCreateAppVersionCmd(),
+	NewDeleteAppVersionsCmd(),
+	NewDeleteAppsCmd(),
+	NewDescribeAppVersionAuditsCmd(),
+	NewDescribeAppVersionsCmd(),
+	NewDescribeAppsCmd(),
+	NewGetAppAttachmentCmd(),
+	NewGetAppStatisticsCmd(),
+	NewGetAppVersionPackageCmd(),
+	NewGetAppVersionPackageFilesCmd(),
+	NewGetAppVersionUpgradePathsCmd(),
+	NewModifyAppCmd(),
+	NewModifyAppVersionCmd(),
+	NewPassAppVersionCmd(),
+	NewRejectAppVersionCmd(),
+	NewReleaseAppVersionCmd(),
+	NewReviewAppVersionCmd(),
+	NewSearchAppsCmd(),
+	NewSubmitAppVersionCmd(),
+	NewSuspendAppVersionCmd(),
+	NewUploadAppVersionPackageCmd(),
+	NewCreateCategoryCmd(),
+	NewDeleteCategoriesCmd(),
+	NewDescribeCategoriesCmd(),
+	NewModifyCategoryCmd(),
+	NewAddClusterNodesCmd(),
+	NewAttachKeyPairsCmd(),
+	NewCeaseClustersCmd(),
+	NewCreateClusterCmd(),
+	NewCreateKeyPairCmd(),
+	NewDeleteClusterNodesCmd(),
+	NewDeleteClustersCmd(),
+	NewDeleteKeyPairsCmd(),
+	NewDescribeClusterCostsCmd(),
+	NewDescribeClusterNodeLogsCmd(),
+	NewDescribeClusterNodesCmd(),
+	NewDescribeClustersCmd(),
+	NewDescribeKeyPairsCmd(),
+	NewDescribeRuntimeUsageCmd(),
+	NewDescribeSubnetsCmd(),
+	NewDetachKeyPairsCmd(),
+	NewEstimateClusterCostCmd(),
+	NewGetClusterStatisticsCmd(),
+	NewModifyClusterAttributesCmd(),
+	NewModifyClusterNodeAttributesCmd(),
+	NewRecoverClustersCmd(),
+	NewResizeClusterCmd(),
+	NewRollbackClusterCmd(),
+	NewStartClustersCmd(),
+	NewStopClustersCmd(),
+	NewUpdateClusterEnvCmd(),
+	NewUpgradeClusterCmd(),
+	NewDescribeJobsCmd(),
+	NewDescribeRepoEventsCmd(),
+	NewIndexRepoCmd(),
+	NewCreateRepoCmd(),
+	NewDeleteReposCmd(),
+	NewDescribeReposCmd(),
+	NewModifyRepoCmd(),
+	NewValidateRepoCmd(),
+	NewCreateRuntimeCmd(),
+	NewDeleteRuntimesCmd(),
+	NewDescribeRuntimePricesCmd(),
+	NewDescribeRuntimeProviderZonesCmd(),
+	NewDescribeRuntimesCmd(),
+	NewGetRuntimeStatisticsCmd(),
+	NewModifyRuntimeCmd(),
+	NewModifyRuntimePriceCmd(),
+	NewRotateEncryptionKeyCmd(),
+	NewDescribeTasksCmd(),
+	NewRetryTasksCmd(),
+}
+
+type CreateAppCmd struct {
+	*models.OpenpitrixCreateAppRequest
+}
+
+func NewCreateAppCmd() Cmd {
+	return &CreateAppCmd{
+		OpenpitrixCreateAppRequest: &models.OpenpitrixCreateAppRequest{},
+	}
+}
+
+func (*CreateAppCmd) GetActionName() string {
+	return "CreateApp"
+}
+
+func (c *CreateAppCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.CategoryID, "category_id", "", "")
+	f.StringVar(&c.ChartName, "chart_name", "", "")
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Home, "home", "", "")
+	f.StringVar(&c.Icon, "icon", "", "")
+	f.StringVar(&c.Keywords, "keywords", "", "")
+	f.StringVar(&c.Maintainers, "maintainers", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringVar(&c.Owner, "owner", "", "")
+	f.StringVar(&c.Readme, "readme", "", "")
+	f.StringVar(&c.RepoID, "repo_id", "", "")
+	f.StringVar(&c.Screenshots, "screenshots", "", "")
+	f.StringVar(&c.Sources, "sources", "", "")
+}
+
+func (c *CreateAppCmd) Run(out Out) error {
+	params := app_manager.NewCreateAppParams()
+	params.WithBody(c.OpenpitrixCreateAppRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.CreateApp(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type CreateAppVersionCmd struct {
+	*models.OpenpitrixCreateAppVersionRequest
+}
+
+func NewCreateAppVersionCmd() Cmd {
+	return &CreateAppVersionCmd{
+		OpenpitrixCreateAppVersionRequest: &models.OpenpitrixCreateAppVersionRequest{},
+	}
+}
+
+func (*CreateAppVersionCmd) GetActionName() string {
+	return "CreateAppVersion"
+}
+
+func (c *CreateAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.AppID, "app_id", "", "")
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringVar(&c.Owner, "owner", "", "")
+	f.StringVar(&c.PackageName, "package_name", "", "")
+	f.Uint32Var(&c.Sequence, "sequence", "")
+	f.StringVar(&c.UpgradeFrom, "upgrade_from", "", "semver constraint of the versions that can upgrade to this version, e.g. \">=1.0.0, <2.0.0\"")
+}
+
+func (c *CreateAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewCreateAppVersionParams()
+	params.WithBody(c.OpenpitrixCreateAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.CreateAppVersion(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DeleteAppVersionsCmd struct {
+	*models.OpenpitrixDeleteAppVersionsRequest
+}
+
+func NewDeleteAppVersionsCmd() Cmd {
+	return &DeleteAppVersionsCmd{
+		OpenpitrixDeleteAppVersionsRequest: &models.OpenpitrixDeleteAppVersionsRequest{},
+	}
+}
+
+func (*DeleteAppVersionsCmd) GetActionName() string {
+	return "DeleteAppVersions"
+}
+
+func (c *DeleteAppVersionsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.VersionID, "version_id", []string{}, "")
+}
+
+func (c *DeleteAppVersionsCmd) Run(out Out) error {
+	params := app_manager.NewDeleteAppVersionsParams()
+	params.WithBody(c.OpenpitrixDeleteAppVersionsRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.DeleteAppVersions(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DeleteAppsCmd struct {
+	*models.OpenpitrixDeleteAppsRequest
+}
+
+func NewDeleteAppsCmd() Cmd {
+	return &DeleteAppsCmd{
+		OpenpitrixDeleteAppsRequest: &models.OpenpitrixDeleteAppsRequest{},
+	}
+}
+
+func (*DeleteAppsCmd) GetActionName() string {
+	return "DeleteApps"
+}
+
+func (c *DeleteAppsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AppID, "app_id", []string{}, "")
+}
+
+func (c *DeleteAppsCmd) Run(out Out) error {
+	params := app_manager.NewDeleteAppsParams()
+	params.WithBody(c.OpenpitrixDeleteAppsRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.DeleteApps(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeAppVersionAuditsCmd struct {
+	*app_manager.DescribeAppVersionAuditsParams
+}
+
+func NewDescribeAppVersionAuditsCmd() Cmd {
+	return &DescribeAppVersionAuditsCmd{
+		DescribeAppVersionAuditsParams: app_manager.NewDescribeAppVersionAuditsParams(),
+	}
+}
+
+func (*DescribeAppVersionAuditsCmd) GetActionName() string {
+	return "DescribeAppVersionAudits"
+}
+
+func (c *DescribeAppVersionAuditsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AppID, "app_id", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringSliceVar(&c.Operator, "operator", []string{}, "")
+	f.BoolPtrVar(&c.Reverse, "reverse", "")
+	f.StringPtrVar(&c.SortKey, "sort_key", "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+	f.StringSliceVar(&c.VersionID, "version_id", []string{}, "")
+}
+
+func (c *DescribeAppVersionAuditsCmd) Run(out Out) error {
+	params := c.DescribeAppVersionAuditsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.DescribeAppVersionAudits(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeAppVersionsCmd struct {
+	*app_manager.DescribeAppVersionsParams
+}
+
+func NewDescribeAppVersionsCmd() Cmd {
+	return &DescribeAppVersionsCmd{
+		DescribeAppVersionsParams: app_manager.NewDescribeAppVersionsParams(),
+	}
+}
+
+func (*DescribeAppVersionsCmd) GetActionName() string {
+	return "DescribeAppVersions"
+}
+
+func (c *DescribeAppVersionsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AppID, "app_id", []string{}, "")
+	f.StringSliceVar(&c.Description, "description", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.StringSliceVar(&c.Name, "name", []string{}, "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringSliceVar(&c.Owner, "owner", []string{}, "")
+	f.StringSliceVar(&c.PackageName, "package_name", []string{}, "")
+	f.BoolPtrVar(&c.Reverse, "reverse", "")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringPtrVar(&c.SortKey, "sort_key", "sequence by default, semver to sort by the semantic version of the name.")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+	f.StringSliceVar(&c.VersionID, "version_id", []string{}, "")
+}
+
+func (c *DescribeAppVersionsCmd) Run(out Out) error {
+	params := c.DescribeAppVersionsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.DescribeAppVersions(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeAppsCmd struct {
+	*app_manager.DescribeAppsParams
+}
+
+func NewDescribeAppsCmd() Cmd {
+	return &DescribeAppsCmd{
+		DescribeAppsParams: app_manager.NewDescribeAppsParams(),
+	}
+}
+
+func (*DescribeAppsCmd) GetActionName() string {
+	return "DescribeApps"
+}
+
+func (c *DescribeAppsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AppID, "app_id", []string{}, "")
+	f.StringSliceVar(&c.CategoryID, "category_id", []string{}, "")
+	f.StringSliceVar(&c.ChartName, "chart_name", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.StringSliceVar(&c.Name, "name", []string{}, "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringSliceVar(&c.Owner, "owner", []string{}, "")
+	f.StringSliceVar(&c.RepoID, "repo_id", []string{}, "")
+	f.BoolPtrVar(&c.Reverse, "reverse", "")
+	f.StringPtrVar(&c.RuntimeID, "runtime_id", "apps in the repos whose selectors match the labels of the runtime.")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringPtrVar(&c.SortKey, "sort_key", "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+}
+
+func (c *DescribeAppsCmd) Run(out Out) error {
+	params := c.DescribeAppsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.DescribeApps(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type GetAppAttachmentCmd struct {
+	*app_manager.GetAppAttachmentParams
+}
+
+func NewGetAppAttachmentCmd() Cmd {
+	return &GetAppAttachmentCmd{
+		GetAppAttachmentParams: app_manager.NewGetAppAttachmentParams(),
+	}
+}
+
+func (*GetAppAttachmentCmd) GetActionName() string {
+	return "GetAppAttachment"
+}
+
+func (c *GetAppAttachmentCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.AttachmentID, "attachment_id", "the sha256 of the content with the extension, e.g. 2c26b46b...7ae.png.")
+}
+
+func (c *GetAppAttachmentCmd) Run(out Out) error {
+	params := c.GetAppAttachmentParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.GetAppAttachment(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type GetAppStatisticsCmd struct {
+	*app_manager.GetAppStatisticsParams
+}
+
+func NewGetAppStatisticsCmd() Cmd {
+	return &GetAppStatisticsCmd{
+		GetAppStatisticsParams: app_manager.NewGetAppStatisticsParams(),
+	}
+}
+
+func (*GetAppStatisticsCmd) GetActionName() string {
+	return "GetAppStatistics"
+}
+
+func (c *GetAppStatisticsCmd) ParseFlag(f Flag) {
+}
+
+func (c *GetAppStatisticsCmd) Run(out Out) error {
+	params := c.GetAppStatisticsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.GetAppStatistics(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type GetAppVersionPackageCmd struct {
+	*app_manager.GetAppVersionPackageParams
+}
+
+func NewGetAppVersionPackageCmd() Cmd {
+	return &GetAppVersionPackageCmd{
+		GetAppVersionPackageParams: app_manager.NewGetAppVersionPackageParams(),
+	}
+}
+
+func (*GetAppVersionPackageCmd) GetActionName() string {
+	return "GetAppVersionPackage"
+}
+
+func (c *GetAppVersionPackageCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.VersionID, "version_id", "")
+}
+
+func (c *GetAppVersionPackageCmd) Run(out Out) error {
+	params := c.GetAppVersionPackageParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.GetAppVersionPackage(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type GetAppVersionPackageFilesCmd struct {
+	*app_manager.GetAppVersionPackageFilesParams
+}
+
+func NewGetAppVersionPackageFilesCmd() Cmd {
+	return &GetAppVersionPackageFilesCmd{
+		GetAppVersionPackageFilesParams: app_manager.NewGetAppVersionPackageFilesParams(),
+	}
+}
+
+func (*GetAppVersionPackageFilesCmd) GetActionName() string {
+	return "GetAppVersionPackageFiles"
+}
+
+func (c *GetAppVersionPackageFilesCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.Files, "files", []string{}, "")
+	f.StringPtrVar(&c.VersionID, "version_id", "")
+}
+
+func (c *GetAppVersionPackageFilesCmd) Run(out Out) error {
+	params := c.GetAppVersionPackageFilesParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.GetAppVersionPackageFiles(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type GetAppVersionUpgradePathsCmd struct {
+	*app_manager.GetAppVersionUpgradePathsParams
+}
+
+func NewGetAppVersionUpgradePathsCmd() Cmd {
+	return &GetAppVersionUpgradePathsCmd{
+		GetAppVersionUpgradePathsParams: app_manager.NewGetAppVersionUpgradePathsParams(),
+	}
+}
+
+func (*GetAppVersionUpgradePathsCmd) GetActionName() string {
+	return "GetAppVersionUpgradePaths"
+}
+
+func (c *GetAppVersionUpgradePathsCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.VersionID, "version_id", "")
+}
+
+func (c *GetAppVersionUpgradePathsCmd) Run(out Out) error {
+	params := c.GetAppVersionUpgradePathsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.GetAppVersionUpgradePaths(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ModifyAppCmd struct {
+	*models.OpenpitrixModifyAppRequest
+}
+
+func NewModifyAppCmd() Cmd {
+	return &ModifyAppCmd{
+		OpenpitrixModifyAppRequest: &models.OpenpitrixModifyAppRequest{},
+	}
+}
+
+func (*ModifyAppCmd) GetActionName() string {
+	return "ModifyApp"
+}
+
+func (c *ModifyAppCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.AppID, "app_id", "", "")
+	f.StringVar(&c.CategoryID, "category_id", "", "")
+	f.StringVar(&c.ChartName, "chart_name", "", "")
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Home, "home", "", "")
+	f.StringVar(&c.Icon, "icon", "", "")
+	f.StringVar(&c.Keywords, "keywords", "", "")
+	f.StringVar(&c.Maintainers, "maintainers", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringVar(&c.Owner, "owner", "", "")
+	f.StringVar(&c.Readme, "readme", "", "")
+	f.StringVar(&c.RepoID, "repo_id", "", "")
+	f.StringVar(&c.Screenshots, "screenshots", "", "")
+	f.StringVar(&c.Sources, "sources", "", "")
+}
+
+func (c *ModifyAppCmd) Run(out Out) error {
+	params := app_manager.NewModifyAppParams()
+	params.WithBody(c.OpenpitrixModifyAppRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.ModifyApp(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ModifyAppVersionCmd struct {
+	*models.OpenpitrixModifyAppVersionRequest
+}
+
+func NewModifyAppVersionCmd() Cmd {
+	return &ModifyAppVersionCmd{
+		OpenpitrixModifyAppVersionRequest: &models.OpenpitrixModifyAppVersionRequest{},
+	}
+}
+
+func (*ModifyAppVersionCmd) GetActionName() string {
+	return "ModifyAppVersion"
+}
+
+func (c *ModifyAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringVar(&c.Owner, "owner", "", "")
+	f.StringVar(&c.PackageName, "package_name", "", "")
+	f.Uint32Var(&c.Sequence, "sequence", "")
+	f.StringVar(&c.UpgradeFrom, "upgrade_from", "", "semver constraint of the versions that can upgrade to this version, e.g. \">=1.0.0, <2.0.0\"")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *ModifyAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewModifyAppVersionParams()
+	params.WithBody(c.OpenpitrixModifyAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.ModifyAppVersion(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type PassAppVersionCmd struct {
+	*models.OpenpitrixPassAppVersionRequest
+}
+
+func NewPassAppVersionCmd() Cmd {
+	return &PassAppVersionCmd{
+		OpenpitrixPassAppVersionRequest: &models.OpenpitrixPassAppVersionRequest{},
+	}
+}
+
+func (*PassAppVersionCmd) GetActionName() string {
+	return "PassAppVersion"
+}
+
+func (c *PassAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Message, "message", "", "")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *PassAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewPassAppVersionParams()
+	params.WithBody(c.OpenpitrixPassAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.PassAppVersion(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type RejectAppVersionCmd struct {
+	*models.OpenpitrixRejectAppVersionRequest
+}
+
+func NewRejectAppVersionCmd() Cmd {
+	return &RejectAppVersionCmd{
+		OpenpitrixRejectAppVersionRequest: &models.OpenpitrixRejectAppVersionRequest{},
+	}
+}
+
+func (*RejectAppVersionCmd) GetActionName() string {
+	return "RejectAppVersion"
+}
+
+func (c *RejectAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Message, "message", "", "reason of the rejection, required")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *RejectAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewRejectAppVersionParams()
+	params.WithBody(c.OpenpitrixRejectAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.RejectAppVersion(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ReleaseAppVersionCmd struct {
+	*models.OpenpitrixReleaseAppVersionRequest
+}
+
+func NewReleaseAppVersionCmd() Cmd {
+	return &ReleaseAppVersionCmd{
+		OpenpitrixReleaseAppVersionRequest: &models.OpenpitrixReleaseAppVersionRequest{},
+	}
+}
+
+func (*ReleaseAppVersionCmd) GetActionName() string {
+	return "ReleaseAppVersion"
+}
+
+func (c *ReleaseAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Message, "message", "", "")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *ReleaseAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewReleaseAppVersionParams()
+	params.WithBody(c.OpenpitrixReleaseAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.ReleaseAppVersion(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ReviewAppVersionCmd struct {
+	*models.OpenpitrixReviewAppVersionRequest
+}
+
+func NewReviewAppVersionCmd() Cmd {
+	return &ReviewAppVersionCmd{
+		OpenpitrixReviewAppVersionRequest: &models.OpenpitrixReviewAppVersionRequest{},
+	}
+}
+
+func (*ReviewAppVersionCmd) GetActionName() string {
+	return "ReviewAppVersion"
+}
+
+func (c *ReviewAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Message, "message", "", "")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *ReviewAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewReviewAppVersionParams()
+	params.WithBody(c.OpenpitrixReviewAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.ReviewAppVersion(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type SearchAppsCmd struct {
+	*app_manager.SearchAppsParams
+}
+
+func NewSearchAppsCmd() Cmd {
+	return &SearchAppsCmd{
+		SearchAppsParams: app_manager.NewSearchAppsParams(),
+	}
+}
+
+func (*SearchAppsCmd) GetActionName() string {
+	return "SearchApps"
+}
+
+func (c *SearchAppsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.CategoryID, "category_id", []string{}, "")
+	f.StringSliceVar(&c.Keyword, "keyword", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.StringSliceVar(&c.Maintainer, "maintainer", []string{}, "name of the maintainer.")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringSliceVar(&c.Provider, "provider", []string{}, "")
+	f.StringSliceVar(&c.RepoID, "repo_id", []string{}, "")
+	f.StringPtrVar(&c.SearchWord, "search_word", "full-text search on the name, description, keywords, maintainers, readme and latest version of app.")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+}
+
+func (c *SearchAppsCmd) Run(out Out) error {
+	params := c.SearchAppsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.SearchApps(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type SubmitAppVersionCmd struct {
+	*models.OpenpitrixSubmitAppVersionRequest
+}
+
+func NewSubmitAppVersionCmd() Cmd {
+	return &SubmitAppVersionCmd{
+		OpenpitrixSubmitAppVersionRequest: &models.OpenpitrixSubmitAppVersionRequest{},
+	}
+}
+
+func (*SubmitAppVersionCmd) GetActionName() string {
+	return "SubmitAppVersion"
+}
+
+func (c *SubmitAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Message, "message", "", "")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *SubmitAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewSubmitAppVersionParams()
+	params.WithBody(c.OpenpitrixSubmitAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.SubmitAppVersion(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type SuspendAppVersionCmd struct {
+	*models.OpenpitrixSuspendAppVersionRequest
+}
+
+func NewSuspendAppVersionCmd() Cmd {
+	return &SuspendAppVersionCmd{
+		OpenpitrixSuspendAppVersionRequest: &models.OpenpitrixSuspendAppVersionRequest{},
+	}
+}
+
+func (*SuspendAppVersionCmd) GetActionName() string {
+	return "SuspendAppVersion"
+}
+
+func (c *SuspendAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Message, "message", "", "")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *SuspendAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewSuspendAppVersionParams()
+	params.WithBody(c.OpenpitrixSuspendAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.SuspendAppVersion(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type UploadAppVersionPackageCmd struct {
+	*models.OpenpitrixUploadAppVersionPackageRequest
+}
+
+func NewUploadAppVersionPackageCmd() Cmd {
+	return &UploadAppVersionPackageCmd{
+		OpenpitrixUploadAppVersionPackageRequest: &models.OpenpitrixUploadAppVersionPackageRequest{},
+	}
+}
+
+func (*UploadAppVersionPackageCmd) GetActionName() string {
+	return "UploadAppVersionPackage"
+}
+
+func (c *UploadAppVersionPackageCmd) ParseFlag(f Flag) {
+	f.FileVar(&c.Package, "package", "path of the file")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *UploadAppVersionPackageCmd) Run(out Out) error {
+	params := app_manager.NewUploadAppVersionPackageParams()
+	params.WithBody(c.OpenpitrixUploadAppVersionPackageRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.UploadAppVersionPackage(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type CreateCategoryCmd struct {
+	*models.OpenpitrixCreateCategoryRequest
+}
+
+func NewCreateCategoryCmd() Cmd {
+	return &CreateCategoryCmd{
+		OpenpitrixCreateCategoryRequest: &models.OpenpitrixCreateCategoryRequest{},
+	}
+}
+
+func (*CreateCategoryCmd) GetActionName() string {
+	return "CreateCategory"
+}
+
+func (c *CreateCategoryCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Locale, "locale", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+}
+
+func (c *CreateCategoryCmd) Run(out Out) error {
+	params := category_manager.NewCreateCategoryParams()
+	params.WithBody(c.OpenpitrixCreateCategoryRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.CategoryManager.CreateCategory(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DeleteCategoriesCmd struct {
+	*models.OpenpitrixDeleteCategoriesRequest
+}
+
+func NewDeleteCategoriesCmd() Cmd {
+	return &DeleteCategoriesCmd{
+		OpenpitrixDeleteCategoriesRequest: &models.OpenpitrixDeleteCategoriesRequest{},
+	}
+}
+
+func (*DeleteCategoriesCmd) GetActionName() string {
+	return "DeleteCategories"
+}
+
+func (c *DeleteCategoriesCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.CategoryID, "category_id", []string{}, "")
+}
+
+func (c *DeleteCategoriesCmd) Run(out Out) error {
+	params := category_manager.NewDeleteCategoriesParams()
+	params.WithBody(c.OpenpitrixDeleteCategoriesRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.CategoryManager.DeleteCategories(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeCategoriesCmd struct {
+	*category_manager.DescribeCategoriesParams
+}
+
+func NewDescribeCategoriesCmd() Cmd {
+	return &DescribeCategoriesCmd{
+		DescribeCategoriesParams: category_manager.NewDescribeCategoriesParams(),
+	}
+}
+
+func (*DescribeCategoriesCmd) GetActionName() string {
+	return "DescribeCategories"
+}
+
+func (c *DescribeCategoriesCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.CategoryID, "category_id", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.StringSliceVar(&c.Name, "name", []string{}, "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringSliceVar(&c.Owner, "owner", []string{}, "")
+	f.BoolPtrVar(&c.Reverse, "reverse", "")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringPtrVar(&c.SortKey, "sort_key", "")
+}
+
+func (c *DescribeCategoriesCmd) Run(out Out) error {
+	params := c.DescribeCategoriesParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.CategoryManager.DescribeCategories(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ModifyCategoryCmd struct {
+	*models.OpenpitrixModifyCategoryRequest
+}
+
+func NewModifyCategoryCmd() Cmd {
+	return &ModifyCategoryCmd{
+		OpenpitrixModifyCategoryRequest: &models.OpenpitrixModifyCategoryRequest{},
+	}
+}
+
+func (*ModifyCategoryCmd) GetActionName() string {
+	return "ModifyCategory"
+}
+
+func (c *ModifyCategoryCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.CategoryID, "category_id", "", "")
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Locale, "locale", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+}
+
+func (c *ModifyCategoryCmd) Run(out Out) error {
+	params := category_manager.NewModifyCategoryParams()
+	params.WithBody(c.OpenpitrixModifyCategoryRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.CategoryManager.ModifyCategory(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type AddClusterNodesCmd struct {
+	*models.OpenpitrixAddClusterNodesRequest
+	watch bool
+}
+
+func NewAddClusterNodesCmd() Cmd {
+	return &AddClusterNodesCmd{
+		OpenpitrixAddClusterNodesRequest: &models.OpenpitrixAddClusterNodesRequest{},
+	}
+}
+
+func (*AddClusterNodesCmd) GetActionName() string {
+	return "AddClusterNodes"
+}
+
+func (c *AddClusterNodesCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringVar(&c.ClusterID, "cluster_id", "", "")
+	f.Uint32Var(&c.NodeCount, "node_count", "")
+	f.StringVar(&c.Role, "role", "", "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *AddClusterNodesCmd) Run(out Out) error {
+	params := cluster_manager.NewAddClusterNodesParams()
+	params.WithBody(c.OpenpitrixAddClusterNodesRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.AddClusterNodes(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID)
+	}
+
+	return nil
+}
+
+type AttachKeyPairsCmd struct {
+	*models.OpenpitrixAttachKeyPairsRequest
+	watch bool
+}
+
+func NewAttachKeyPairsCmd() Cmd {
+	return &AttachKeyPairsCmd{
+		OpenpitrixAttachKeyPairsRequest: &models.OpenpitrixAttachKeyPairsRequest{},
+	}
+}
+
+func (*AttachKeyPairsCmd) GetActionName() string {
+	return "AttachKeyPairs"
+}
+
+func (c *AttachKeyPairsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.KeyPairID, "key_pair_id", []string{}, "")
+	f.StringSliceVar(&c.NodeID, "node_id", []string{}, "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *AttachKeyPairsCmd) Run(out Out) error {
+	params := cluster_manager.NewAttachKeyPairsParams()
+	params.WithBody(c.OpenpitrixAttachKeyPairsRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.AttachKeyPairs(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID...)
+	}
+
+	return nil
+}
+
+type CeaseClustersCmd struct {
+	*models.OpenpitrixCeaseClustersRequest
+	watch bool
+}
+
+func NewCeaseClustersCmd() Cmd {
+	return &CeaseClustersCmd{
+		OpenpitrixCeaseClustersRequest: &models.OpenpitrixCeaseClustersRequest{},
+	}
+}
+
+func (*CeaseClustersCmd) GetActionName() string {
+	return "CeaseClusters"
+}
+
+func (c *CeaseClustersCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringSliceVar(&c.ClusterID, "cluster_id", []string{}, "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *CeaseClustersCmd) Run(out Out) error {
+	params := cluster_manager.NewCeaseClustersParams()
+	params.WithBody(c.OpenpitrixCeaseClustersRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.CeaseClusters(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID...)
+	}
+
+	return nil
+}
+
+type CreateClusterCmd struct {
+	*models.OpenpitrixCreateClusterRequest
+	watch bool
+}
+
+func NewCreateClusterCmd() Cmd {
+	return &CreateClusterCmd{
+		OpenpitrixCreateClusterRequest: &models.OpenpitrixCreateClusterRequest{},
+	}
+}
+
+func (*CreateClusterCmd) GetActionName() string {
+	return "CreateCluster"
+}
+
+func (c *CreateClusterCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringVar(&c.AppID, "app_id", "", "")
+	f.StringVar(&c.Conf, "conf", "", "")
+	f.StringVar(&c.RuntimeID, "runtime_id", "", "")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *CreateClusterCmd) Run(out Out) error {
+	params := cluster_manager.NewCreateClusterParams()
+	params.WithBody(c.OpenpitrixCreateClusterRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.CreateCluster(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID)
+	}
+
+	return nil
+}
+
+type CreateKeyPairCmd struct {
+	*models.OpenpitrixCreateKeyPairRequest
+}
+
+func NewCreateKeyPairCmd() Cmd {
+	return &CreateKeyPairCmd{
+		OpenpitrixCreateKeyPairRequest: &models.OpenpitrixCreateKeyPairRequest{},
+	}
+}
+
+func (*CreateKeyPairCmd) GetActionName() string {
+	return "CreateKeyPair"
+}
+
+func (c *CreateKeyPairCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringVar(&c.PubKey, "pub_key", "", "")
+}
+
+func (c *CreateKeyPairCmd) Run(out Out) error {
+	params := cluster_manager.NewCreateKeyPairParams()
+	params.WithBody(c.OpenpitrixCreateKeyPairRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.CreateKeyPair(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DeleteClusterNodesCmd struct {
+	*models.OpenpitrixDeleteClusterNodesRequest
+	watch bool
+}
+
+func NewDeleteClusterNodesCmd() Cmd {
+	return &DeleteClusterNodesCmd{
+		OpenpitrixDeleteClusterNodesRequest: &models.OpenpitrixDeleteClusterNodesRequest{},
+	}
+}
+
+func (*DeleteClusterNodesCmd) GetActionName() string {
+	return "DeleteClusterNodes"
+}
+
+func (c *DeleteClusterNodesCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringVar(&c.ClusterID, "cluster_id", "", "")
+	f.StringSliceVar(&c.NodeID, "node_id", []string{}, "")
+	f.StringVar(&c.Role, "role", "", "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *DeleteClusterNodesCmd) Run(out Out) error {
+	params := cluster_manager.NewDeleteClusterNodesParams()
+	params.WithBody(c.OpenpitrixDeleteClusterNodesRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DeleteClusterNodes(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID)
+	}
+
+	return nil
+}
+
+type DeleteClustersCmd struct {
+	*models.OpenpitrixDeleteClustersRequest
+	watch bool
+}
+
+func NewDeleteClustersCmd() Cmd {
+	return &DeleteClustersCmd{
+		OpenpitrixDeleteClustersRequest: &models.OpenpitrixDeleteClustersRequest{},
+	}
+}
+
+func (*DeleteClustersCmd) GetActionName() string {
+	return "DeleteClusters"
+}
+
+func (c *DeleteClustersCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringSliceVar(&c.ClusterID, "cluster_id", []string{}, "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *DeleteClustersCmd) Run(out Out) error {
+	params := cluster_manager.NewDeleteClustersParams()
+	params.WithBody(c.OpenpitrixDeleteClustersRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DeleteClusters(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID...)
+	}
+
+	return nil
+}
+
+type DeleteKeyPairsCmd struct {
+	*models.OpenpitrixDeleteKeyPairsRequest
+}
+
+func NewDeleteKeyPairsCmd() Cmd {
+	return &DeleteKeyPairsCmd{
+		OpenpitrixDeleteKeyPairsRequest: &models.OpenpitrixDeleteKeyPairsRequest{},
+	}
+}
+
+func (*DeleteKeyPairsCmd) GetActionName() string {
+	return "DeleteKeyPairs"
+}
+
+func (c *DeleteKeyPairsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.KeyPairID, "key_pair_id", []string{}, "")
+}
+
+func (c *DeleteKeyPairsCmd) Run(out Out) error {
+	params := cluster_manager.NewDeleteKeyPairsParams()
+	params.WithBody(c.OpenpitrixDeleteKeyPairsRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DeleteKeyPairs(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeClusterCostsCmd struct {
+	*cluster_manager.DescribeClusterCostsParams
+}
+
+func NewDescribeClusterCostsCmd() Cmd {
+	return &DescribeClusterCostsCmd{
+		DescribeClusterCostsParams: cluster_manager.NewDescribeClusterCostsParams(),
+	}
+}
+
+func (*DescribeClusterCostsCmd) GetActionName() string {
+	return "DescribeClusterCosts"
+}
+
+func (c *DescribeClusterCostsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.ClusterID, "cluster_id", []string{}, "")
+}
+
+func (c *DescribeClusterCostsCmd) Run(out Out) error {
+	params := c.DescribeClusterCostsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeClusterCosts(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeClusterNodeLogsCmd struct {
+	*cluster_manager.DescribeClusterNodeLogsParams
+}
+
+func NewDescribeClusterNodeLogsCmd() Cmd {
+	return &DescribeClusterNodeLogsCmd{
+		DescribeClusterNodeLogsParams: cluster_manager.NewDescribeClusterNodeLogsParams(),
+	}
+}
+
+func (*DescribeClusterNodeLogsCmd) GetActionName() string {
+	return "DescribeClusterNodeLogs"
+}
+
+func (c *DescribeClusterNodeLogsCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.ClusterID, "cluster_id", "")
+	f.StringPtrVar(&c.Grep, "grep", "regexp to filter the log lines.")
+	f.StringSliceVar(&c.LogName, "log_name", []string{}, "all logs declared by the role if empty.")
+	f.StringSliceVar(&c.NodeID, "node_id", []string{}, "all nodes of the cluster if empty.")
+	f.DateTimePtrVar(&c.SinceTime, "since_time", "")
+	f.Int64PtrVar(&c.TailLines, "tail_lines", "default is 100, max value is 10000.")
+}
+
+func (c *DescribeClusterNodeLogsCmd) Run(out Out) error {
+	params := c.DescribeClusterNodeLogsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeClusterNodeLogs(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeClusterNodesCmd struct {
+	*cluster_manager.DescribeClusterNodesParams
+}
+
+func NewDescribeClusterNodesCmd() Cmd {
+	return &DescribeClusterNodesCmd{
+		DescribeClusterNodesParams: cluster_manager.NewDescribeClusterNodesParams(),
+	}
+}
+
+func (*DescribeClusterNodesCmd) GetActionName() string {
+	return "DescribeClusterNodes"
+}
+
+func (c *DescribeClusterNodesCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.ClusterID, "cluster_id", "")
+	f.Int64PtrVar(&c.Limit, "limit", "default is 20, max value is 200.")
+	f.StringSliceVar(&c.NodeID, "node_id", []string{}, "")
+	f.Int64PtrVar(&c.Offset, "offset", "default is 0.")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+}
+
+func (c *DescribeClusterNodesCmd) Run(out Out) error {
+	params := c.DescribeClusterNodesParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeClusterNodes(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeClustersCmd struct {
+	*cluster_manager.DescribeClustersParams
+}
+
+func NewDescribeClustersCmd() Cmd {
+	return &DescribeClustersCmd{
+		DescribeClustersParams: cluster_manager.NewDescribeClustersParams(),
+	}
+}
+
+func (*DescribeClustersCmd) GetActionName() string {
+	return "DescribeClusters"
+}
+
+func (c *DescribeClustersCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AppID, "app_id", []string{}, "")
+	f.StringSliceVar(&c.ClusterID, "cluster_id", []string{}, "")
+	f.StringPtrVar(&c.ExternalClusterID, "external_cluster_id", "")
+	f.StringSliceVar(&c.FrontgateID, "frontgate_id", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "default is 20, max value is 200.")
+	f.Int64PtrVar(&c.Offset, "offset", "default is 0.")
+	f.StringSliceVar(&c.RuntimeID, "runtime_id", []string{}, "")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+	f.StringSliceVar(&c.VersionID, "version_id", []string{}, "")
+}
+
+func (c *DescribeClustersCmd) Run(out Out) error {
+	params := c.DescribeClustersParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeClusters(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeKeyPairsCmd struct {
+	*cluster_manager.DescribeKeyPairsParams
+}
+
+func NewDescribeKeyPairsCmd() Cmd {
+	return &DescribeKeyPairsCmd{
+		DescribeKeyPairsParams: cluster_manager.NewDescribeKeyPairsParams(),
+	}
+}
+
+func (*DescribeKeyPairsCmd) GetActionName() string {
+	return "DescribeKeyPairs"
+}
+
+func (c *DescribeKeyPairsCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.Description, "description", "")
+	f.StringPtrVar(&c.KeyPairID, "key_pair_id", "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.StringPtrVar(&c.Name, "name", "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringPtrVar(&c.Owner, "owner", "")
+	f.StringPtrVar(&c.PubKey, "pub_key", "")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+}
+
+func (c *DescribeKeyPairsCmd) Run(out Out) error {
+	params := c.DescribeKeyPairsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeKeyPairs(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeRuntimeUsageCmd struct {
+	*cluster_manager.DescribeRuntimeUsageParams
+}
+
+func NewDescribeRuntimeUsageCmd() Cmd {
+	return &DescribeRuntimeUsageCmd{
+		DescribeRuntimeUsageParams: cluster_manager.NewDescribeRuntimeUsageParams(),
+	}
+}
+
+func (*DescribeRuntimeUsageCmd) GetActionName() string {
+	return "DescribeRuntimeUsage"
+}
+
+func (c *DescribeRuntimeUsageCmd) ParseFlag(f Flag) {
+	f.DateTimePtrVar(&c.EndTime, "end_time", "")
+	f.StringSliceVar(&c.Owner, "owner", []string{}, "")
+	f.StringSliceVar(&c.RuntimeID, "runtime_id", []string{}, "")
+	f.DateTimePtrVar(&c.StartTime, "start_time", "return the snapshots of the usage taken in the time range.")
+	f.BoolPtrVar(&c.WithQuota, "with_quota", "report the quotas left in the runtimes by the providers.")
+}
+
+func (c *DescribeRuntimeUsageCmd) Run(out Out) error {
+	params := c.DescribeRuntimeUsageParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeRuntimeUsage(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeSubnetsCmd struct {
+	*cluster_manager.DescribeSubnetsParams
+}
+
+func NewDescribeSubnetsCmd() Cmd {
+	return &DescribeSubnetsCmd{
+		DescribeSubnetsParams: cluster_manager.NewDescribeSubnetsParams(),
+	}
+}
+
+func (*DescribeSubnetsCmd) GetActionName() string {
+	return "DescribeSubnets"
+}
+
+func (c *DescribeSubnetsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringPtrVar(&c.RuntimeID, "runtime_id", "")
+	f.StringSliceVar(&c.SubnetID, "subnet_id", []string{}, "")
+	f.Int64PtrVar(&c.SubnetTypeValue, "subnet_type.value", "The uint32 value.")
+	f.StringSliceVar(&c.Zone, "zone", []string{}, "")
+}
+
+func (c *DescribeSubnetsCmd) Run(out Out) error {
+	params := c.DescribeSubnetsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeSubnets(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DetachKeyPairsCmd struct {
+	*models.OpenpitrixDetachKeyPairsRequest
+	watch bool
+}
+
+func NewDetachKeyPairsCmd() Cmd {
+	return &DetachKeyPairsCmd{
+		OpenpitrixDetachKeyPairsRequest: &models.OpenpitrixDetachKeyPairsRequest{},
+	}
+}
+
+func (*DetachKeyPairsCmd) GetActionName() string {
+	return "DetachKeyPairs"
+}
+
+func (c *DetachKeyPairsCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.KeyPairID, "key_pair_id", []string{}, "")
+	f.StringSliceVar(&c.NodeID, "node_id", []string{}, "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *DetachKeyPairsCmd) Run(out Out) error {
+	params := cluster_manager.NewDetachKeyPairsParams()
+	params.WithBody(c.OpenpitrixDetachKeyPairsRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DetachKeyPairs(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID...)
+	}
+
+	return nil
+}
+
+type EstimateClusterCostCmd struct {
+	*models.OpenpitrixEstimateClusterCostRequest
+}
+
+func NewEstimateClusterCostCmd() Cmd {
+	return &EstimateClusterCostCmd{
+		OpenpitrixEstimateClusterCostRequest: &models.OpenpitrixEstimateClusterCostRequest{},
+	}
+}
+
+func (*EstimateClusterCostCmd) GetActionName() string {
+	return "EstimateClusterCost"
+}
+
+func (c *EstimateClusterCostCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.AppID, "app_id", "", "")
+	f.StringVar(&c.Conf, "conf", "", "")
+	f.StringVar(&c.RuntimeID, "runtime_id", "", "")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+}
+
+func (c *EstimateClusterCostCmd) Run(out Out) error {
+	params := cluster_manager.NewEstimateClusterCostParams()
+	params.WithBody(c.OpenpitrixEstimateClusterCostRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.EstimateClusterCost(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type GetClusterStatisticsCmd struct {
+	*cluster_manager.GetClusterStatisticsParams
+}
+
+func NewGetClusterStatisticsCmd() Cmd {
+	return &GetClusterStatisticsCmd{
+		GetClusterStatisticsParams: cluster_manager.NewGetClusterStatisticsParams(),
+	}
+}
+
+func (*GetClusterStatisticsCmd) GetActionName() string {
+	return "GetClusterStatistics"
+}
+
+func (c *GetClusterStatisticsCmd) ParseFlag(f Flag) {
+}
+
+func (c *GetClusterStatisticsCmd) Run(out Out) error {
+	params := c.GetClusterStatisticsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.GetClusterStatistics(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ModifyClusterAttributesCmd struct {
+	*models.OpenpitrixModifyClusterAttributesRequest
+}
+
+func NewModifyClusterAttributesCmd() Cmd {
+	return &ModifyClusterAttributesCmd{
+		OpenpitrixModifyClusterAttributesRequest: &models.OpenpitrixModifyClusterAttributesRequest{},
+	}
+}
+
+func (*ModifyClusterAttributesCmd) GetActionName() string {
+	return "ModifyClusterAttributes"
+}
+
+func (c *ModifyClusterAttributesCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.ClusterID, "cluster_id", "", "")
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+}
+
+func (c *ModifyClusterAttributesCmd) Run(out Out) error {
+	params := cluster_manager.NewModifyClusterAttributesParams()
+	params.WithBody(c.OpenpitrixModifyClusterAttributesRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.ModifyClusterAttributes(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ModifyClusterNodeAttributesCmd struct {
+	*models.OpenpitrixModifyClusterNodeAttributesRequest
+}
+
+func NewModifyClusterNodeAttributesCmd() Cmd {
+	return &ModifyClusterNodeAttributesCmd{
+		OpenpitrixModifyClusterNodeAttributesRequest: &models.OpenpitrixModifyClusterNodeAttributesRequest{},
+	}
+}
+
+func (*ModifyClusterNodeAttributesCmd) GetActionName() string {
+	return "ModifyClusterNodeAttributes"
+}
+
+func (c *ModifyClusterNodeAttributesCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringVar(&c.NodeID, "node_id", "", "")
+}
+
+func (c *ModifyClusterNodeAttributesCmd) Run(out Out) error {
+	params := cluster_manager.NewModifyClusterNodeAttributesParams()
+	params.WithBody(c.OpenpitrixModifyClusterNodeAttributesRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.ModifyClusterNodeAttributes(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type RecoverClustersCmd struct {
+	*models.OpenpitrixRecoverClustersRequest
+	watch bool
+}
+
+func NewRecoverClustersCmd() Cmd {
+	return &RecoverClustersCmd{
+		OpenpitrixRecoverClustersRequest: &models.OpenpitrixRecoverClustersRequest{},
+	}
+}
+
+func (*RecoverClustersCmd) GetActionName() string {
+	return "RecoverClusters"
+}
+
+func (c *RecoverClustersCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringSliceVar(&c.ClusterID, "cluster_id", []string{}, "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *RecoverClustersCmd) Run(out Out) error {
+	params := cluster_manager.NewRecoverClustersParams()
+	params.WithBody(c.OpenpitrixRecoverClustersRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.RecoverClusters(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID...)
+	}
+
+	return nil
+}
+
+type ResizeClusterCmd struct {
+	*models.OpenpitrixResizeClusterRequest
+	watch bool
+}
+
+func NewResizeClusterCmd() Cmd {
+	return &ResizeClusterCmd{
+		OpenpitrixResizeClusterRequest: &models.OpenpitrixResizeClusterRequest{},
+	}
+}
+
+func (*ResizeClusterCmd) GetActionName() string {
+	return "ResizeCluster"
+}
+
+func (c *ResizeClusterCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringVar(&c.ClusterID, "cluster_id", "", "")
+	f.Uint32Var(&c.CPU, "cpu", "")
+	f.Uint32Var(&c.Memory, "memory", "")
+	f.StringVar(&c.Role, "role", "", "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *ResizeClusterCmd) Run(out Out) error {
+	params := cluster_manager.NewResizeClusterParams()
+	params.WithBody(c.OpenpitrixResizeClusterRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.ResizeCluster(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID)
+	}
+
+	return nil
+}
+
+type RollbackClusterCmd struct {
+	*models.OpenpitrixRollbackClusterRequest
+	watch bool
+}
+
+func NewRollbackClusterCmd() Cmd {
+	return &RollbackClusterCmd{
+		OpenpitrixRollbackClusterRequest: &models.OpenpitrixRollbackClusterRequest{},
+	}
+}
+
+func (*RollbackClusterCmd) GetActionName() string {
+	return "RollbackCluster"
+}
+
+func (c *RollbackClusterCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringVar(&c.ClusterID, "cluster_id", "", "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *RollbackClusterCmd) Run(out Out) error {
+	params := cluster_manager.NewRollbackClusterParams()
+	params.WithBody(c.OpenpitrixRollbackClusterRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.RollbackCluster(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID)
+	}
+
+	return nil
+}
+
+type StartClustersCmd struct {
+	*models.OpenpitrixStartClustersRequest
+	watch bool
+}
+
+func NewStartClustersCmd() Cmd {
+	return &StartClustersCmd{
+		OpenpitrixStartClustersRequest: &models.OpenpitrixStartClustersRequest{},
+	}
+}
+
+func (*StartClustersCmd) GetActionName() string {
+	return "StartClusters"
+}
+
+func (c *StartClustersCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringSliceVar(&c.ClusterID, "cluster_id", []string{}, "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *StartClustersCmd) Run(out Out) error {
+	params := cluster_manager.NewStartClustersParams()
+	params.WithBody(c.OpenpitrixStartClustersRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.StartClusters(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID...)
+	}
+
+	return nil
+}
+
+type StopClustersCmd struct {
+	*models.OpenpitrixStopClustersRequest
+	watch bool
+}
+
+func NewStopClustersCmd() Cmd {
+	return &StopClustersCmd{
+		OpenpitrixStopClustersRequest: &models.OpenpitrixStopClustersRequest{},
+	}
+}
+
+func (*StopClustersCmd) GetActionName() string {
+	return "StopClusters"
+}
+
+func (c *StopClustersCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringSliceVar(&c.ClusterID, "cluster_id", []string{}, "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *StopClustersCmd) Run(out Out) error {
+	params := cluster_manager.NewStopClustersParams()
+	params.WithBody(c.OpenpitrixStopClustersRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.StopClusters(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID...)
+	}
+
+	return nil
+}
+
+type UpdateClusterEnvCmd struct {
+	*models.OpenpitrixUpdateClusterEnvRequest
+	watch bool
+}
+
+func NewUpdateClusterEnvCmd() Cmd {
+	return &UpdateClusterEnvCmd{
+		OpenpitrixUpdateClusterEnvRequest: &models.OpenpitrixUpdateClusterEnvRequest{},
+	}
+}
+
+func (*UpdateClusterEnvCmd) GetActionName() string {
+	return "UpdateClusterEnv"
+}
+
+func (c *UpdateClusterEnvCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringVar(&c.ClusterID, "cluster_id", "", "")
+	f.StringVar(&c.Env, "env", "", "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *UpdateClusterEnvCmd) Run(out Out) error {
+	params := cluster_manager.NewUpdateClusterEnvParams()
+	params.WithBody(c.OpenpitrixUpdateClusterEnvRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.UpdateClusterEnv(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID)
+	}
+
+	return nil
+}
+
+type UpgradeClusterCmd struct {
+	*models.OpenpitrixUpgradeClusterRequest
+	watch bool
+}
+
+func NewUpgradeClusterCmd() Cmd {
+	return &UpgradeClusterCmd{
+		OpenpitrixUpgradeClusterRequest: &models.OpenpitrixUpgradeClusterRequest{},
+	}
+}
+
+func (*UpgradeClusterCmd) GetActionName() string {
+	return "UpgradeCluster"
+}
+
+func (c *UpgradeClusterCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.AdvancedParam, "advanced_param", []string{}, "")
+	f.StringVar(&c.ClusterID, "cluster_id", "", "")
+	f.StringVar(&c.VersionID, "version_id", "", "")
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+}
+
+func (c *UpgradeClusterCmd) Run(out Out) error {
+	params := cluster_manager.NewUpgradeClusterParams()
+	params.WithBody(c.OpenpitrixUpgradeClusterRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.UpgradeCluster(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	if c.watch {
+		return watchJobs(out, res.Payload.JobID)
+	}
+
+	return nil
+}
+
+type DescribeJobsCmd struct {
+	*job_manager.DescribeJobsParams
+}
+
+func NewDescribeJobsCmd() Cmd {
+	return &DescribeJobsCmd{
+		DescribeJobsParams: job_manager.NewDescribeJobsParams(),
+	}
+}
+
+func (*DescribeJobsCmd) GetActionName() string {
+	return "DescribeJobs"
+}
+
+func (c *DescribeJobsCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.AppID, "app_id", "")
+	f.StringPtrVar(&c.ClusterID, "cluster_id", "")
+	f.StringPtrVar(&c.Executor, "executor", "")
+	f.StringSliceVar(&c.JobID, "job_id", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "default is 20, max value is 200.")
+	f.Int64PtrVar(&c.Offset, "offset", "default is 0.")
+	f.StringPtrVar(&c.Provider, "provider", "")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+	f.StringPtrVar(&c.VersionID, "version_id", "")
+}
+
+func (c *DescribeJobsCmd) Run(out Out) error {
+	params := c.DescribeJobsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.JobManager.DescribeJobs(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeRepoEventsCmd struct {
+	*repo_indexer.DescribeRepoEventsParams
+}
+
+func NewDescribeRepoEventsCmd() Cmd {
+	return &DescribeRepoEventsCmd{
+		DescribeRepoEventsParams: repo_indexer.NewDescribeRepoEventsParams(),
+	}
+}
+
+func (*DescribeRepoEventsCmd) GetActionName() string {
+	return "DescribeRepoEvents"
+}
+
+func (c *DescribeRepoEventsCmd) ParseFlag(f Flag) {
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringSliceVar(&c.Owner, "owner", []string{}, "")
+	f.StringSliceVar(&c.RepoEventID, "repo_event_id", []string{}, "")
+	f.StringSliceVar(&c.RepoID, "repo_id", []string{}, "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+}
+
+func (c *DescribeRepoEventsCmd) Run(out Out) error {
+	params := c.DescribeRepoEventsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RepoIndexer.DescribeRepoEvents(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type IndexRepoCmd struct {
+	*models.OpenpitrixIndexRepoRequest
+}
+
+func NewIndexRepoCmd() Cmd {
+	return &IndexRepoCmd{
+		OpenpitrixIndexRepoRequest: &models.OpenpitrixIndexRepoRequest{},
+	}
+}
+
+func (*IndexRepoCmd) GetActionName() string {
+	return "IndexRepo"
+}
+
+func (c *IndexRepoCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.RepoID, "repo_id", "", "")
+}
+
+func (c *IndexRepoCmd) Run(out Out) error {
+	params := repo_indexer.NewIndexRepoParams()
+	params.WithBody(c.OpenpitrixIndexRepoRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RepoIndexer.IndexRepo(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type CreateRepoCmd struct {
+	*models.OpenpitrixCreateRepoRequest
+}
+
+func NewCreateRepoCmd() Cmd {
+	return &CreateRepoCmd{
+		OpenpitrixCreateRepoRequest: &models.OpenpitrixCreateRepoRequest{},
+	}
+}
+
+func (*CreateRepoCmd) GetActionName() string {
+	return "CreateRepo"
+}
+
+func (c *CreateRepoCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.CategoryID, "category_id", "", "")
+	f.StringVar(&c.Credential, "credential", "", "")
+	f.StringVar(&c.Description, "description", "", "")
+	f.JsonVar(&c.Labels, "labels", "json array of RepoLabel")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringSliceVar(&c.Providers, "providers", []string{}, "")
+	f.JsonVar(&c.Selectors, "selectors", "json array of RepoSelector")
+	f.StringVar(&c.TrustedKeys, "trusted_keys", "", "public keys to verify the provenance of the packages, one per line")
+	f.StringVar(&c.Type, "type", "", "")
+	f.StringVar(&c.URL, "url", "", "")
+	f.StringVar(&c.Visibility, "visibility", "", "")
+}
+
+func (c *CreateRepoCmd) Run(out Out) error {
+	params := repo_manager.NewCreateRepoParams()
+	params.WithBody(c.OpenpitrixCreateRepoRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RepoManager.CreateRepo(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DeleteReposCmd struct {
+	*models.OpenpitrixDeleteReposRequest
+}
+
+func NewDeleteReposCmd() Cmd {
+	return &DeleteReposCmd{
+		OpenpitrixDeleteReposRequest: &models.OpenpitrixDeleteReposRequest{},
+	}
+}
+
+func (*DeleteReposCmd) GetActionName() string {
+	return "DeleteRepos"
+}
+
+func (c *DeleteReposCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.RepoID, "repo_id", []string{}, "")
+}
+
+func (c *DeleteReposCmd) Run(out Out) error {
+	params := repo_manager.NewDeleteReposParams()
+	params.WithBody(c.OpenpitrixDeleteReposRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RepoManager.DeleteRepos(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeReposCmd struct {
+	*repo_manager.DescribeReposParams
+}
+
+func NewDescribeReposCmd() Cmd {
+	return &DescribeReposCmd{
+		DescribeReposParams: repo_manager.NewDescribeReposParams(),
+	}
+}
+
+func (*DescribeReposCmd) GetActionName() string {
+	return "DescribeRepos"
+}
+
+func (c *DescribeReposCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.CategoryID, "category_id", []string{}, "")
+	f.StringPtrVar(&c.Label, "label", "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.StringSliceVar(&c.Name, "name", []string{}, "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringSliceVar(&c.Provider, "provider", []string{}, "")
+	f.StringSliceVar(&c.RepoID, "repo_id", []string{}, "")
+	f.BoolPtrVar(&c.Reverse, "reverse", "")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringPtrVar(&c.Selector, "selector", "")
+	f.StringPtrVar(&c.SortKey, "sort_key", "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+	f.StringSliceVar(&c.Type, "type", []string{}, "")
+	f.StringSliceVar(&c.Visibility, "visibility", []string{}, "")
+}
+
+func (c *DescribeReposCmd) Run(out Out) error {
+	params := c.DescribeReposParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RepoManager.DescribeRepos(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ModifyRepoCmd struct {
+	*models.OpenpitrixModifyRepoRequest
+}
+
+func NewModifyRepoCmd() Cmd {
+	return &ModifyRepoCmd{
+		OpenpitrixModifyRepoRequest: &models.OpenpitrixModifyRepoRequest{},
+	}
+}
+
+func (*ModifyRepoCmd) GetActionName() string {
+	return "ModifyRepo"
+}
+
+func (c *ModifyRepoCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.CategoryID, "category_id", "", "")
+	f.StringVar(&c.Credential, "credential", "", "")
+	f.StringVar(&c.Description, "description", "", "")
+	f.JsonVar(&c.Labels, "labels", "json array of RepoLabel")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringSliceVar(&c.Providers, "providers", []string{}, "")
+	f.StringVar(&c.RepoID, "repo_id", "", "")
+	f.JsonVar(&c.Selectors, "selectors", "json array of RepoSelector")
+	f.StringVar(&c.TrustedKeys, "trusted_keys", "", "")
+	f.StringVar(&c.Type, "type", "", "")
+	f.StringVar(&c.URL, "url", "", "")
+	f.StringVar(&c.Visibility, "visibility", "", "")
+}
+
+func (c *ModifyRepoCmd) Run(out Out) error {
+	params := repo_manager.NewModifyRepoParams()
+	params.WithBody(c.OpenpitrixModifyRepoRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RepoManager.ModifyRepo(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ValidateRepoCmd struct {
+	*repo_manager.ValidateRepoParams
+}
+
+func NewValidateRepoCmd() Cmd {
+	return &ValidateRepoCmd{
+		ValidateRepoParams: repo_manager.NewValidateRepoParams(),
+	}
+}
+
+func (*ValidateRepoCmd) GetActionName() string {
+	return "ValidateRepo"
+}
+
+func (c *ValidateRepoCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.Credential, "credential", "")
+	f.StringPtrVar(&c.Type, "type", "")
+	f.StringPtrVar(&c.URL, "url", "")
+}
+
+func (c *ValidateRepoCmd) Run(out Out) error {
+	params := c.ValidateRepoParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RepoManager.ValidateRepo(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type CreateRuntimeCmd struct {
+	*models.OpenpitrixCreateRuntimeRequest
+}
+
+func NewCreateRuntimeCmd() Cmd {
+	return &CreateRuntimeCmd{
+		OpenpitrixCreateRuntimeRequest: &models.OpenpitrixCreateRuntimeRequest{},
+	}
+}
+
+func (*CreateRuntimeCmd) GetActionName() string {
+	return "CreateRuntime"
+}
+
+func (c *CreateRuntimeCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Labels, "labels", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringVar(&c.Provider, "provider", "", "")
+	f.StringVar(&c.RuntimeCredential, "runtime_credential", "", "")
+	f.StringVar(&c.RuntimeURL, "runtime_url", "", "")
+	f.StringVar(&c.Zone, "zone", "", "")
+}
+
+func (c *CreateRuntimeCmd) Run(out Out) error {
+	params := runtime_manager.NewCreateRuntimeParams()
+	params.WithBody(c.OpenpitrixCreateRuntimeRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.CreateRuntime(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DeleteRuntimesCmd struct {
+	*models.OpenpitrixDeleteRuntimesRequest
+}
+
+func NewDeleteRuntimesCmd() Cmd {
+	return &DeleteRuntimesCmd{
+		OpenpitrixDeleteRuntimesRequest: &models.OpenpitrixDeleteRuntimesRequest{},
+	}
+}
+
+func (*DeleteRuntimesCmd) GetActionName() string {
+	return "DeleteRuntimes"
+}
+
+func (c *DeleteRuntimesCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.RuntimeID, "runtime_id", []string{}, "")
+}
+
+func (c *DeleteRuntimesCmd) Run(out Out) error {
+	params := runtime_manager.NewDeleteRuntimesParams()
+	params.WithBody(c.OpenpitrixDeleteRuntimesRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.DeleteRuntimes(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeRuntimePricesCmd struct {
+	*runtime_manager.DescribeRuntimePricesParams
+}
+
+func NewDescribeRuntimePricesCmd() Cmd {
+	return &DescribeRuntimePricesCmd{
+		DescribeRuntimePricesParams: runtime_manager.NewDescribeRuntimePricesParams(),
+	}
+}
+
+func (*DescribeRuntimePricesCmd) GetActionName() string {
+	return "DescribeRuntimePrices"
+}
+
+func (c *DescribeRuntimePricesCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.RuntimeID, "runtime_id", []string{}, "")
+}
+
+func (c *DescribeRuntimePricesCmd) Run(out Out) error {
+	params := c.DescribeRuntimePricesParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.DescribeRuntimePrices(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeRuntimeProviderZonesCmd struct {
+	*runtime_manager.DescribeRuntimeProviderZonesParams
+}
+
+func NewDescribeRuntimeProviderZonesCmd() Cmd {
+	return &DescribeRuntimeProviderZonesCmd{
+		DescribeRuntimeProviderZonesParams: runtime_manager.NewDescribeRuntimeProviderZonesParams(),
+	}
+}
+
+func (*DescribeRuntimeProviderZonesCmd) GetActionName() string {
+	return "DescribeRuntimeProviderZones"
+}
+
+func (c *DescribeRuntimeProviderZonesCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.Provider, "provider", "")
+	f.StringPtrVar(&c.RuntimeCredential, "runtime_credential", "")
+	f.StringPtrVar(&c.RuntimeURL, "runtime_url", "")
+}
+
+func (c *DescribeRuntimeProviderZonesCmd) Run(out Out) error {
+	params := c.DescribeRuntimeProviderZonesParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.DescribeRuntimeProviderZones(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeRuntimesCmd struct {
+	*runtime_manager.DescribeRuntimesParams
+}
+
+func NewDescribeRuntimesCmd() Cmd {
+	return &DescribeRuntimesCmd{
+		DescribeRuntimesParams: runtime_manager.NewDescribeRuntimesParams(),
+	}
+}
+
+func (*DescribeRuntimesCmd) GetActionName() string {
+	return "DescribeRuntimes"
+}
+
+func (c *DescribeRuntimesCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.HealthStatus, "health_status", []string{}, "")
+	f.StringPtrVar(&c.Label, "label", "")
+	f.Int64PtrVar(&c.Limit, "limit", "")
+	f.Int64PtrVar(&c.Offset, "offset", "")
+	f.StringSliceVar(&c.Owner, "owner", []string{}, "")
+	f.StringSliceVar(&c.Provider, "provider", []string{}, "")
+	f.StringPtrVar(&c.RepoID, "repo_id", "runtimes whose labels match the selectors of the repo.")
+	f.StringSliceVar(&c.RuntimeID, "runtime_id", []string{}, "")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+}
+
+func (c *DescribeRuntimesCmd) Run(out Out) error {
+	params := c.DescribeRuntimesParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.DescribeRuntimes(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type GetRuntimeStatisticsCmd struct {
+	*runtime_manager.GetRuntimeStatisticsParams
+}
+
+func NewGetRuntimeStatisticsCmd() Cmd {
+	return &GetRuntimeStatisticsCmd{
+		GetRuntimeStatisticsParams: runtime_manager.NewGetRuntimeStatisticsParams(),
+	}
+}
+
+func (*GetRuntimeStatisticsCmd) GetActionName() string {
+	return "GetRuntimeStatistics"
+}
+
+func (c *GetRuntimeStatisticsCmd) ParseFlag(f Flag) {
+}
+
+func (c *GetRuntimeStatisticsCmd) Run(out Out) error {
+	params := c.GetRuntimeStatisticsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.GetRuntimeStatistics(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ModifyRuntimeCmd struct {
+	*models.OpenpitrixModifyRuntimeRequest
+}
+
+func NewModifyRuntimeCmd() Cmd {
+	return &ModifyRuntimeCmd{
+		OpenpitrixModifyRuntimeRequest: &models.OpenpitrixModifyRuntimeRequest{},
+	}
+}
+
+func (*ModifyRuntimeCmd) GetActionName() string {
+	return "ModifyRuntime"
+}
+
+func (c *ModifyRuntimeCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.Description, "description", "", "")
+	f.StringVar(&c.Labels, "labels", "", "")
+	f.StringVar(&c.Name, "name", "", "")
+	f.StringVar(&c.RuntimeID, "runtime_id", "", "")
+}
+
+func (c *ModifyRuntimeCmd) Run(out Out) error {
+	params := runtime_manager.NewModifyRuntimeParams()
+	params.WithBody(c.OpenpitrixModifyRuntimeRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.ModifyRuntime(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type ModifyRuntimePriceCmd struct {
+	*models.OpenpitrixModifyRuntimePriceRequest
+}
+
+func NewModifyRuntimePriceCmd() Cmd {
+	return &ModifyRuntimePriceCmd{
+		OpenpitrixModifyRuntimePriceRequest: &models.OpenpitrixModifyRuntimePriceRequest{},
+	}
+}
+
+func (*ModifyRuntimePriceCmd) GetActionName() string {
+	return "ModifyRuntimePrice"
+}
+
+func (c *ModifyRuntimePriceCmd) ParseFlag(f Flag) {
+	f.Float64Var(&c.CPUHour, "cpu_hour", 0, "")
+	f.StringVar(&c.Currency, "currency", "", "")
+	f.Float64Var(&c.GpuHour, "gpu_hour", 0, "")
+	f.JsonVar(&c.InstanceClassHour, "instance_class_hour", "replaces all the instance classes if not empty")
+	f.Float64Var(&c.MemoryGbHour, "memory_gb_hour", 0, "")
+	f.StringVar(&c.RuntimeID, "runtime_id", "", "")
+	f.Float64Var(&c.VolumeGbMonth, "volume_gb_month", 0, "")
+}
+
+func (c *ModifyRuntimePriceCmd) Run(out Out) error {
+	params := runtime_manager.NewModifyRuntimePriceParams()
+	params.WithBody(c.OpenpitrixModifyRuntimePriceRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.ModifyRuntimePrice(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type RotateEncryptionKeyCmd struct {
+	*models.OpenpitrixRotateEncryptionKeyRequest
+}
+
+func NewRotateEncryptionKeyCmd() Cmd {
+	return &RotateEncryptionKeyCmd{
+		OpenpitrixRotateEncryptionKeyRequest: &models.OpenpitrixRotateEncryptionKeyRequest{},
+	}
+}
+
+func (*RotateEncryptionKeyCmd) GetActionName() string {
+	return "RotateEncryptionKey"
+}
+
+func (c *RotateEncryptionKeyCmd) ParseFlag(f Flag) {
+	f.StringVar(&c.KeyID, "key_id", "", "the expected current master key, the rotation is refused if the master keys of runtime manager or repo manager are not updated yet")
+}
+
+func (c *RotateEncryptionKeyCmd) Run(out Out) error {
+	params := runtime_manager.NewRotateEncryptionKeyParams()
+	params.WithBody(c.OpenpitrixRotateEncryptionKeyRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.RotateEncryptionKey(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeTasksCmd struct {
+	*task_manager.DescribeTasksParams
+}
+
+func NewDescribeTasksCmd() Cmd {
+	return &DescribeTasksCmd{
+		DescribeTasksParams: task_manager.NewDescribeTasksParams(),
+	}
+}
+
+func (*DescribeTasksCmd) GetActionName() string {
+	return "DescribeTasks"
+}
+
+func (c *DescribeTasksCmd) ParseFlag(f Flag) {
+	f.StringPtrVar(&c.Executor, "executor", "")
+	f.StringSliceVar(&c.JobID, "job_id", []string{}, "")
+	f.Int64PtrVar(&c.Limit, "limit", "default is 20, max value is 200.")
+	f.Int64PtrVar(&c.Offset, "offset", "default is 0.")
+	f.StringPtrVar(&c.SearchWord, "search_word", "")
+	f.StringSliceVar(&c.Status, "status", []string{}, "")
+	f.StringPtrVar(&c.Target, "target", "")
+	f.StringSliceVar(&c.TaskID, "task_id", []string{}, "")
+}
+
+func (c *DescribeTasksCmd) Run(out Out) error {
+	params := c.DescribeTasksParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.TaskManager.DescribeTasks(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type RetryTasksCmd struct {
+	*models.OpenpitrixRetryTasksRequest
+}
+
+func NewRetryTasksCmd() Cmd {
+	return &RetryTasksCmd{
+		OpenpitrixRetryTasksRequest: &models.OpenpitrixRetryTasksRequest{},
+	}
+}
+
+func (*RetryTasksCmd) GetActionName() string {
+	return "RetryTasks"
+}
+
+func (c *RetryTasksCmd) ParseFlag(f Flag) {
+	f.StringSliceVar(&c.TaskID, "task_id", []string{}, "")
+}
+
+func (c *RetryTasksCmd) Run(out Out) error {
+	params := task_manager.NewRetryTasksParams()
+	params.WithBody(c.OpenpitrixRetryTasksRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.TaskManager.RetryTasks(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
 }
@@ -0,0 +1,174 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	flag "github.com/spf13/pflag"
+
+	"openpitrix.io/openpitrix/test/models"
+)
+
+type Flag struct {
+	*flag.FlagSet
+}
+
+// The optional params of the swagger client are pointers, they are left
+// nil unless the flag is set so that the server applies its defaults.
+
+type stringPtrValue struct{ p **string }
+
+func (v stringPtrValue) Set(s string) error {
+	*v.p = &s
+	return nil
+}
+func (v stringPtrValue) String() string {
+	if *v.p == nil {
+		return ""
+	}
+	return **v.p
+}
+func (v stringPtrValue) Type() string { return "string" }
+
+type int64PtrValue struct{ p **int64 }
+
+func (v int64PtrValue) Set(s string) error {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*v.p = &n
+	return nil
+}
+func (v int64PtrValue) String() string {
+	if *v.p == nil {
+		return ""
+	}
+	return strconv.FormatInt(**v.p, 10)
+}
+func (v int64PtrValue) Type() string { return "int64" }
+
+type boolPtrValue struct{ p **bool }
+
+func (v boolPtrValue) Set(s string) error {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*v.p = &b
+	return nil
+}
+func (v boolPtrValue) String() string {
+	if *v.p == nil {
+		return ""
+	}
+	return strconv.FormatBool(**v.p)
+}
+func (v boolPtrValue) Type() string { return "bool" }
+
+type dateTimePtrValue struct{ p **strfmt.DateTime }
+
+func (v dateTimePtrValue) Set(s string) error {
+	t, err := strfmt.ParseDateTime(s)
+	if err != nil {
+		return err
+	}
+	*v.p = &t
+	return nil
+}
+func (v dateTimePtrValue) String() string {
+	if *v.p == nil {
+		return ""
+	}
+	return (*v.p).String()
+}
+func (v dateTimePtrValue) Type() string { return "datetime" }
+
+type uint32Value struct{ p **models.ProtobufUint32Value }
+
+func (v uint32Value) Set(s string) error {
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return err
+	}
+	*v.p = &models.ProtobufUint32Value{Value: int64(n)}
+	return nil
+}
+func (v uint32Value) String() string {
+	if *v.p == nil {
+		return ""
+	}
+	return strconv.FormatInt((*v.p).Value, 10)
+}
+func (v uint32Value) Type() string { return "uint32" }
+
+// fileValue reads the content of the file, it is used by the bytes fields,
+// e.g. the package of the app version.
+type fileValue struct {
+	p        *strfmt.Base64
+	filename string
+}
+
+func (v *fileValue) Set(s string) error {
+	data, err := ioutil.ReadFile(s)
+	if err != nil {
+		return err
+	}
+	*v.p = data
+	v.filename = s
+	return nil
+}
+func (v *fileValue) String() string { return v.filename }
+func (v *fileValue) Type() string   { return "file" }
+
+// jsonValue decodes the flag as json, it is used by the fields that have
+// no plain form, e.g. the labels of the repo.
+type jsonValue struct {
+	p   interface{}
+	raw string
+}
+
+func (v *jsonValue) Set(s string) error {
+	if err := json.Unmarshal([]byte(s), v.p); err != nil {
+		return err
+	}
+	v.raw = s
+	return nil
+}
+func (v *jsonValue) String() string { return v.raw }
+func (v *jsonValue) Type() string   { return "json" }
+
+func (f Flag) StringPtrVar(p **string, name, usage string) {
+	f.Var(stringPtrValue{p}, name, usage)
+}
+
+func (f Flag) Int64PtrVar(p **int64, name, usage string) {
+	f.Var(int64PtrValue{p}, name, usage)
+}
+
+func (f Flag) BoolPtrVar(p **bool, name, usage string) {
+	f.Var(boolPtrValue{p}, name, usage)
+	f.Lookup(name).NoOptDefVal = "true"
+}
+
+func (f Flag) DateTimePtrVar(p **strfmt.DateTime, name, usage string) {
+	f.Var(dateTimePtrValue{p}, name, usage)
+}
+
+func (f Flag) Uint32Var(p **models.ProtobufUint32Value, name, usage string) {
+	f.Var(uint32Value{p}, name, usage)
+}
+
+func (f Flag) FileVar(p *strfmt.Base64, name, usage string) {
+	f.Var(&fileValue{p: p}, name, usage)
+}
+
+func (f Flag) JsonVar(p interface{}, name, usage string) {
+	f.Var(&jsonValue{p: p}, name, usage)
+}
@@ -0,0 +1,114 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	flag "github.com/spf13/pflag"
+
+	"openpitrix.io/openpitrix/test/models"
+)
+
+type tFlags struct {
+	str     *string
+	num     *int64
+	enabled *bool
+	since   *strfmt.DateTime
+	seq     *models.ProtobufUint32Value
+	pkg     strfmt.Base64
+	labels  map[string]string
+}
+
+func tParseFlags(args ...string) (*tFlags, error) {
+	v := &tFlags{}
+	f := Flag{flag.NewFlagSet("test", flag.ContinueOnError)}
+	f.SetOutput(ioutil.Discard)
+	f.StringPtrVar(&v.str, "str", "")
+	f.Int64PtrVar(&v.num, "num", "")
+	f.BoolPtrVar(&v.enabled, "enabled", "")
+	f.DateTimePtrVar(&v.since, "since", "")
+	f.Uint32Var(&v.seq, "seq", "")
+	f.FileVar(&v.pkg, "pkg", "")
+	f.JsonVar(&v.labels, "labels", "")
+	return v, f.Parse(args)
+}
+
+func TestFlag(t *testing.T) {
+	f, err := ioutil.TempFile("", "opctl-flag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("package")
+	f.Close()
+
+	// the optional params stay nil unless they are set
+	v, err := tParseFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(v, &tFlags{}) {
+		t.Fatalf("expect no param set, got %+v", v)
+	}
+
+	v, err = tParseFlags(
+		"--str", "",
+		"--num", "-1",
+		"--enabled",
+		"--since", "2018-06-01T10:00:00Z",
+		"--seq", "3",
+		"--pkg", f.Name(),
+		"--labels", `{"env": "test"}`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.str == nil || *v.str != "" {
+		t.Fatalf("expect empty string set, got %v", v.str)
+	}
+	if v.num == nil || *v.num != -1 {
+		t.Fatalf("expect num -1, got %v", v.num)
+	}
+	if v.enabled == nil || !*v.enabled {
+		t.Fatalf("expect enabled without value to be true, got %v", v.enabled)
+	}
+	if v.since == nil || !time.Time(*v.since).Equal(time.Date(2018, 6, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected since %v", v.since)
+	}
+	if v.seq == nil || v.seq.Value != 3 {
+		t.Fatalf("expect seq 3, got %+v", v.seq)
+	}
+	if string(v.pkg) != "package" {
+		t.Fatalf("expect the content of the file, got %q", v.pkg)
+	}
+	if !reflect.DeepEqual(v.labels, map[string]string{"env": "test"}) {
+		t.Fatalf("unexpected labels %v", v.labels)
+	}
+
+	v, err = tParseFlags("--enabled=false")
+	if err != nil || v.enabled == nil || *v.enabled {
+		t.Fatalf("expect enabled false, got %v, %+v", v.enabled, err)
+	}
+
+	for _, args := range [][]string{
+		{"--num", "x"},
+		{"--enabled=x"},
+		{"--since", "yesterday"},
+		{"--seq", "-1"},
+		{"--seq", "4294967296"},
+		{"--pkg", f.Name() + ".missing"},
+		{"--labels", "env=test"},
+	} {
+		if _, err := tParseFlags(args...); err == nil {
+			t.Fatalf("expect error of %v", args)
+		}
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/go-openapi/swag"
+
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+const (
+	specFile   = "../../pkg/apigateway/spec/api.swagger.json"
+	outputFile = "./all_cmd.go"
+)
+
+type schema struct {
+	Ref         string             `json:"$ref"`
+	Type        string             `json:"type"`
+	Format      string             `json:"format"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Items       *schema            `json:"items"`
+	Properties  map[string]*schema `json:"properties"`
+	// AdditionalProperties is the type of the values of map
+	AdditionalProperties *schema `json:"additionalProperties"`
+}
+
+type parameter struct {
+	schema
+	Name   string  `json:"name"`
+	In     string  `json:"in"`
+	Schema *schema `json:"schema"`
+}
+
+type operation struct {
+	OperationId string      `json:"operationId"`
+	Tags        []string    `json:"tags"`
+	Parameters  []parameter `json:"parameters"`
+	Responses   map[string]struct {
+		Schema *schema `json:"schema"`
+	} `json:"responses"`
+}
+
+type spec struct {
+	Paths       map[string]map[string]*operation `json:"paths"`
+	Definitions map[string]*schema               `json:"definitions"`
+}
+
+type cmdFlag struct {
+	Name  string
+	Field string
+	Usage string
+	// Setter is the method of Flag that binds the field
+	Setter string
+	// Default is set for the builtin setters of pflag
+	Default string
+}
+
+type cmd struct {
+	Action  string
+	Package string
+	Tag     string
+	// Body is the model of the request body, empty for GET
+	Body      string
+	Flags     []cmdFlag
+	Watch     bool
+	WatchList bool
+}
+
+// the persistent flags of the root command
+var reservedFlags = map[string]bool{
+	"profile": true, "config": true, "host": true, "base_path": true,
+	"auth_key": true, "output": true, "verbose": true, "watch": true,
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+func usage(s *schema) string {
+	u := s.Description
+	if u == "" {
+		u = s.Title
+	}
+	return strings.Join(strings.Fields(u), " ")
+}
+
+func queryFlag(action string, p parameter) cmdFlag {
+	f := cmdFlag{Name: p.Name, Field: swag.ToGoName(p.Name), Usage: usage(&p.schema)}
+	switch {
+	case p.Type == "array" && p.Items != nil && p.Items.Type == "string":
+		f.Setter, f.Default = "StringSliceVar", "[]string{}"
+	case p.Type == "string" && p.Format == "date-time":
+		f.Setter = "DateTimePtrVar"
+	case p.Type == "string":
+		f.Setter = "StringPtrVar"
+	case p.Type == "integer":
+		f.Setter = "Int64PtrVar"
+	case p.Type == "boolean":
+		f.Setter = "BoolPtrVar"
+	default:
+		log.Fatalf("%s: unsupported query param [%s] of type [%s]", action, p.Name, p.Type)
+	}
+	return f
+}
+
+func bodyFlag(action, name string, s *schema) cmdFlag {
+	f := cmdFlag{Name: name, Field: swag.ToGoName(name), Usage: usage(s)}
+	switch {
+	case s.Ref != "" && refName(s.Ref) == "protobufUInt32Value":
+		f.Setter = "Uint32Var"
+	case s.Type == "array" && s.Items != nil && s.Items.Type == "string":
+		f.Setter, f.Default = "StringSliceVar", "[]string{}"
+	case s.Type == "array" && s.Items != nil && s.Items.Ref != "":
+		f.Setter = "JsonVar"
+		if f.Usage == "" {
+			f.Usage = "json array of " + strings.TrimPrefix(refName(s.Items.Ref), "openpitrix")
+		}
+	case s.Type == "object" && s.AdditionalProperties != nil:
+		f.Setter = "JsonVar"
+		if f.Usage == "" {
+			f.Usage = "json object of " + s.AdditionalProperties.Type
+		}
+	case s.Type == "string" && s.Format == "byte":
+		f.Setter = "FileVar"
+		if f.Usage == "" {
+			f.Usage = "path of the file"
+		}
+	case s.Type == "string":
+		f.Setter, f.Default = "StringVar", `""`
+	case s.Type == "integer":
+		f.Setter, f.Default = "Int64Var", "0"
+	case s.Type == "number" && s.Format == "float":
+		f.Setter, f.Default = "Float32Var", "0"
+	case s.Type == "number":
+		f.Setter, f.Default = "Float64Var", "0"
+	case s.Type == "boolean":
+		f.Setter, f.Default = "BoolVar", "false"
+	default:
+		log.Fatalf("%s: unsupported body field [%s] of type [%s]", action, name, s.Type)
+	}
+	return f
+}
+
+func getCmds(s *spec) []cmd {
+	var cmds []cmd
+	for _, methods := range s.Paths {
+		for method, op := range methods {
+			c := cmd{
+				Action:  op.OperationId,
+				Tag:     op.Tags[0],
+				Package: stringutil.CamelCaseToUnderscore(op.Tags[0]),
+			}
+			for _, p := range op.Parameters {
+				if p.In == "body" {
+					c.Body = swag.ToGoName(refName(p.Schema.Ref))
+					body := s.Definitions[refName(p.Schema.Ref)]
+					for name, prop := range body.Properties {
+						c.Flags = append(c.Flags, bodyFlag(c.Action, name, prop))
+					}
+				} else {
+					c.Flags = append(c.Flags, queryFlag(c.Action, p))
+				}
+			}
+			if method != "get" && c.Body == "" {
+				log.Fatalf("%s: %s without body is not supported", c.Action, method)
+			}
+			for _, f := range c.Flags {
+				if reservedFlags[f.Name] {
+					log.Fatalf("%s: flag [%s] is reserved", c.Action, f.Name)
+				}
+			}
+			sort.Slice(c.Flags, func(i, j int) bool {
+				return c.Flags[i].Name < c.Flags[j].Name
+			})
+
+			// the long running actions return the job id
+			if r, ok := op.Responses["200"]; ok && r.Schema != nil {
+				res := s.Definitions[refName(r.Schema.Ref)]
+				if jobId, ok := res.Properties["job_id"]; ok {
+					c.Watch = true
+					c.WatchList = jobId.Type == "array"
+				}
+			}
+			cmds = append(cmds, c)
+		}
+	}
+	sort.Slice(cmds, func(i, j int) bool {
+		if cmds[i].Tag != cmds[j].Tag {
+			return cmds[i].Tag < cmds[j].Tag
+		}
+		return cmds[i].Action < cmds[j].Action
+	})
+	return cmds
+}
+
+func main() {
+	data, err := ioutil.ReadFile(specFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var s spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		log.Fatal(err)
+	}
+
+	cmds := getCmds(&s)
+	packages := make(map[string]bool)
+	for _, c := range cmds {
+		packages[c.Package] = true
+	}
+	var imports []string
+	for p := range packages {
+		imports = append(imports, p)
+	}
+	sort.Strings(imports)
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"Cmds":    cmds,
+		"Imports": imports,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format.Source: err = %v", err)
+	}
+	if err := ioutil.WriteFile(outputFile, src, 0666); err != nil {
+		log.Fatalf("ioutil.WriteFile: err = %v", err)
+	}
+}
+
+var tmpl = template.Must(template.New("all_cmd").Parse(`// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Auto generated by 'go run gen_helper.go', DO NOT EDIT.
+
+package main
+
+import (
+{{- range .Imports}}
+	"openpitrix.io/openpitrix/test/client/{{.}}"
+{{- end}}
+	"openpitrix.io/openpitrix/test/models"
+)
+
+var AllCmd = []Cmd{
+{{- range .Cmds}}
+	New{{.Action}}Cmd(),
+{{- end}}
+}
+{{range .Cmds}}
+{{- $params := printf "%s.%sParams" .Package .Action}}
+type {{.Action}}Cmd struct {
+{{- if .Body}}
+	*models.{{.Body}}
+{{- else}}
+	*{{$params}}
+{{- end}}
+{{- if .Watch}}
+	watch bool
+{{- end}}
+}
+
+func New{{.Action}}Cmd() Cmd {
+{{- if .Body}}
+	return &{{.Action}}Cmd{
+		{{.Body}}: &models.{{.Body}}{},
+	}
+{{- else}}
+	return &{{.Action}}Cmd{
+		{{.Action}}Params: {{.Package}}.New{{.Action}}Params(),
+	}
+{{- end}}
+}
+
+func (*{{.Action}}Cmd) GetActionName() string {
+	return "{{.Action}}"
+}
+
+func (c *{{.Action}}Cmd) ParseFlag(f Flag) {
+{{- range .Flags}}
+{{- if .Default}}
+	f.{{.Setter}}(&c.{{.Field}}, "{{.Name}}", {{.Default}}, {{printf "%q" .Usage}})
+{{- else}}
+	f.{{.Setter}}(&c.{{.Field}}, "{{.Name}}", {{printf "%q" .Usage}})
+{{- end}}
+{{- end}}
+{{- if .Watch}}
+	f.BoolVar(&c.watch, "watch", false, "wait for the job to finish")
+{{- end}}
+}
+
+func (c *{{.Action}}Cmd) Run(out Out) error {
+{{- if .Body}}
+	params := {{.Package}}.New{{.Action}}Params()
+	params.WithBody(c.{{.Body}})
+{{- else}}
+	params := c.{{.Action}}Params
+{{- end}}
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.{{.Tag}}.{{.Action}}(params)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+{{- if .Watch}}
+
+	if c.watch {
+{{- if .WatchList}}
+		return watchJobs(out, res.Payload.JobID...)
+{{- else}}
+		return watchJobs(out, res.Payload.JobID)
+{{- end}}
+	}
+{{- end}}
+
+	return nil
+}
+{{end}}`))
@@ -2,6 +2,12 @@
 // Use of this source code is governed by a Apache license
 // that can be found in the LICENSE file.
 
+// opctl is the command line client of the OpenPitrix api gateway, the
+// commands in all_cmd.go are generated from the swagger spec.
+
+//go:generate go run gen_helper.go
+//go:generate go fmt
+
 package main
 
 import (
@@ -10,27 +16,32 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	flag "github.com/spf13/pflag"
 
 	"openpitrix.io/openpitrix/pkg/util/stringutil"
 	"openpitrix.io/openpitrix/test"
+	apiclient "openpitrix.io/openpitrix/test/client"
 )
 
-type Flag struct {
-	*flag.FlagSet
-}
-
 type Cmd interface {
 	GetActionName() string
 	ParseFlag(f Flag)
 	Run(out Out) error
 }
 
+var globalOptions struct {
+	profile  string
+	config   string
+	host     string
+	basePath string
+	authKey  string
+	output   string
+	verbose  bool
+}
+
 var clientConfig = &test.ClientConfig{}
 
-func init() {
-	clientConfig = test.GetClientConfig()
-	clientConfig.Debug = false
+func getClient() *apiclient.Openpitrix {
+	return test.GetClient(clientConfig)
 }
 
 func newRootCmd(c string, args []string) *cobra.Command {
@@ -38,11 +49,37 @@ func newRootCmd(c string, args []string) *cobra.Command {
 		Use:          c,
 		Short:        "OpenPitrix cli tool",
 		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			switch globalOptions.output {
+			case OutputTable, OutputJson, OutputYaml:
+			default:
+				return fmt.Errorf("unsupported output [%s], should be one of %s/%s/%s",
+					globalOptions.output, OutputTable, OutputJson, OutputYaml)
+			}
+			p, err := resolveProfile(cmd)
+			if err != nil {
+				return err
+			}
+			clientConfig = &test.ClientConfig{
+				Host:     p.Host,
+				BasePath: p.BasePath,
+				AuthKey:  p.AuthKey,
+			}
+			return nil
+		},
 	}
 	flags := cmd.PersistentFlags()
+	flags.StringVar(&globalOptions.profile, "profile", DefaultProfile, "profile in the config file")
+	flags.StringVar(&globalOptions.config, "config", getDefaultConfigFile(), "config file of the profiles")
+	flags.StringVar(&globalOptions.host, "host", DefaultHost, "api gateway host, overrides the profile")
+	flags.StringVar(&globalOptions.basePath, "base_path", DefaultBasePath, "http base path, overrides the profile")
+	flags.StringVar(&globalOptions.authKey, "auth_key", "", "auth key sent in X-Auth-Key, overrides the profile")
+	flags.StringVarP(&globalOptions.output, "output", "o", OutputJson, "output format, table/json/yaml")
+	flags.BoolVar(&globalOptions.verbose, "verbose", false, "print the request before sending it")
 
 	cmd.AddCommand(getCobraCmds(AllCmd)...)
 	cmd.AddCommand(getValidateCmd())
+	cmd.AddCommand(getSetProfileCmd())
 	flags.Parse(args)
 	return cmd
 }
@@ -57,8 +94,10 @@ func getCobraCmds(cmds []Cmd) (cobraCmds []*cobra.Command) {
 			Short: strings.Replace(underscoreAction, "_", " ", -1),
 			RunE: func(c *cobra.Command, args []string) error {
 				return run(Out{
-					action: action,
-					out:    c.OutOrStdout(),
+					action:  action,
+					out:     c.OutOrStdout(),
+					format:  globalOptions.output,
+					verbose: globalOptions.verbose,
 				})
 			},
 		}
@@ -10,15 +10,32 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"sort"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+
+	"openpitrix.io/openpitrix/pkg/util/yamlutil"
+)
+
+const (
+	OutputTable = "table"
+	OutputJson  = "json"
+	OutputYaml  = "yaml"
 )
 
+// maxCellWidth truncates the cells of the table, nested values and long
+// text such as readme would make it unreadable.
+const maxCellWidth = 48
+
 type Out struct {
-	action string
-	out    io.Writer
+	action  string
+	out     io.Writer
+	format  string
+	verbose bool
 }
 
 type httpBody interface {
@@ -54,6 +71,9 @@ func (o Out) GetParamsWithIndent(request httpRequest) ([]byte, error) {
 }
 
 func (o Out) WriteRequest(request httpRequest) error {
+	if !o.verbose {
+		return nil
+	}
 	b, err := o.GetParamsWithIndent(request)
 	if err != nil {
 		return err
@@ -71,12 +91,133 @@ func (o Out) WriteResponse(response httpBody) error {
 	if err != nil {
 		return err
 	}
-	o.out.Write([]byte("------ response ------\n"))
+	if o.verbose {
+		o.out.Write([]byte("------ response ------\n"))
+	}
+	switch o.format {
+	case OutputYaml:
+		b, err = yamlutil.Encode(response)
+		if err != nil {
+			return err
+		}
+		o.out.Write(b)
+		return nil
+	case OutputTable:
+		return o.writeTable(b)
+	}
 	o.out.Write(b)
 	o.out.Write([]byte("\n"))
 	return nil
 }
 
+// writeTable writes the first list of objects in the response as a table,
+// e.g. app_set of DescribeApps, other responses are written as key/value
+// rows.
+func (o Out) writeTable(b []byte) error {
+	var m map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&m); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(o.out, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	keys := sortedKeys(m)
+	for _, key := range keys {
+		rows, ok := toObjects(m[key])
+		if !ok {
+			continue
+		}
+		columns := getColumns(rows)
+		fmt.Fprintln(w, strings.ToUpper(strings.Join(columns, "\t")))
+		for _, row := range rows {
+			var cells []string
+			for _, c := range columns {
+				cells = append(cells, toCell(row[c]))
+			}
+			fmt.Fprintln(w, strings.Join(cells, "\t"))
+		}
+		// the other fields are usually the total_count
+		for _, k := range keys {
+			if k != key {
+				fmt.Fprintf(w, "\n%s: %s\n", k, toCell(m[k]))
+			}
+		}
+		return nil
+	}
+
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s\t%s\n", key, toCell(m[key]))
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func toObjects(v interface{}) ([]map[string]interface{}, bool) {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, false
+	}
+	var objects []map[string]interface{}
+	for _, i := range list {
+		object, ok := i.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		objects = append(objects, object)
+	}
+	return objects, true
+}
+
+// getColumns returns the keys of the rows, the ids go first.
+func getColumns(rows []map[string]interface{}) []string {
+	set := make(map[string]interface{})
+	for _, row := range rows {
+		for k := range row {
+			set[k] = nil
+		}
+	}
+	var ids, others []string
+	for _, k := range sortedKeys(set) {
+		if strings.HasSuffix(k, "_id") {
+			ids = append(ids, k)
+		} else {
+			others = append(others, k)
+		}
+	}
+	return append(ids, others...)
+}
+
+func toCell(v interface{}) string {
+	var s string
+	switch v := v.(type) {
+	case nil:
+		s = ""
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	default:
+		b, _ := json.Marshal(v)
+		s = string(b)
+	}
+	s = strings.Join(strings.Fields(s), " ")
+	if r := []rune(s); len(r) > maxCellWidth {
+		s = string(r[:maxCellWidth-3]) + "..."
+	}
+	return s
+}
+
 func toJson(i interface{}) ([]byte, error) {
 	return json.MarshalIndent(i, "", "  ")
 }
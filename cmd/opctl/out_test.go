@@ -0,0 +1,144 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"openpitrix.io/openpitrix/test/client/app_manager"
+	"openpitrix.io/openpitrix/test/models"
+)
+
+type tResponse map[string]interface{}
+
+func (r tResponse) MarshalBinary() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func TestWriteResponse(t *testing.T) {
+	list := tResponse{
+		"total_count": 2,
+		"app_set": []interface{}{
+			map[string]interface{}{"name": "nginx", "app_id": "app-1", "repo_id": "repo-1", "description": "web\n  server"},
+			map[string]interface{}{"name": "redis", "app_id": "app-2", "repo_id": "repo-1", "status": "active"},
+		},
+	}
+	object := tResponse{
+		"app_id": "app-1",
+		"labels": map[string]interface{}{"env": "test"},
+		"readme": strings.Repeat("x", 60),
+	}
+
+	for _, tc := range []struct {
+		name     string
+		format   string
+		verbose  bool
+		response tResponse
+		expect   string
+	}{
+		{
+			name:     "table of list",
+			format:   OutputTable,
+			response: list,
+			expect: "" +
+				"APP_ID  REPO_ID  DESCRIPTION  NAME   STATUS\n" +
+				"app-1   repo-1   web server   nginx  \n" +
+				"app-2   repo-1                redis  active\n" +
+				"\n" +
+				"total_count: 2\n",
+		},
+		{
+			name:     "table of object",
+			format:   OutputTable,
+			response: object,
+			expect: "" +
+				"app_id  app-1\n" +
+				"labels  {\"env\":\"test\"}\n" +
+				"readme  " + strings.Repeat("x", maxCellWidth-3) + "...\n",
+		},
+		{
+			name:     "json",
+			format:   OutputJson,
+			response: tResponse{"app_id": "app-1", "total_count": 1},
+			expect:   "{\n  \"app_id\": \"app-1\",\n  \"total_count\": 1\n}\n",
+		},
+		{
+			name:     "yaml",
+			format:   OutputYaml,
+			response: tResponse{"app_id": "app-1", "total_count": 1},
+			expect:   "app_id: app-1\ntotal_count: 1\n",
+		},
+		{
+			name:     "verbose",
+			format:   OutputJson,
+			verbose:  true,
+			response: tResponse{"app_id": "app-1"},
+			expect:   "------ response ------\n{\n  \"app_id\": \"app-1\"\n}\n",
+		},
+	} {
+		var buf bytes.Buffer
+		o := Out{out: &buf, format: tc.format, verbose: tc.verbose}
+		if err := o.WriteResponse(tc.response); err != nil {
+			t.Fatalf("%s: %+v", tc.name, err)
+		}
+		if buf.String() != tc.expect {
+			t.Fatalf("%s: expect\n%q\ngot\n%q", tc.name, tc.expect, buf.String())
+		}
+	}
+}
+
+func TestWriteRequest(t *testing.T) {
+	var buf bytes.Buffer
+	o := Out{action: "DescribeApps", out: &buf, verbose: true}
+
+	params := app_manager.NewDescribeAppsParams()
+	params.WithAppID([]string{"app-1", "app-2"}).WithName([]string{"nginx"})
+	if err := o.WriteRequest(params); err != nil {
+		t.Fatal(err)
+	}
+	expect := "------ sending request ------\nDescribeApps\n------ params ------\napp_id=app-1&app_id=app-2&name=nginx\n"
+	if buf.String() != expect {
+		t.Fatalf("expect\n%q\ngot\n%q", expect, buf.String())
+	}
+
+	buf.Reset()
+	body := app_manager.NewCreateAppParams().WithBody(&models.OpenpitrixCreateAppRequest{Name: "nginx"})
+	if err := o.WriteRequest(body); err != nil {
+		t.Fatal(err)
+	}
+	expect = "------ sending request ------\nDescribeApps\n------ params ------\n{\n  \"name\": \"nginx\"\n}\n"
+	if buf.String() != expect {
+		t.Fatalf("expect\n%q\ngot\n%q", expect, buf.String())
+	}
+
+	// nothing is written unless verbose
+	buf.Reset()
+	o.verbose = false
+	if err := o.WriteRequest(params); err != nil || buf.Len() != 0 {
+		t.Fatalf("expect nothing written, got %q, %+v", buf.String(), err)
+	}
+}
+
+func TestToCell(t *testing.T) {
+	for _, tc := range []struct {
+		v      interface{}
+		expect string
+	}{
+		{v: nil, expect: ""},
+		{v: "nginx", expect: "nginx"},
+		{v: " multi\n\tline  text ", expect: "multi line text"},
+		{v: json.Number("10"), expect: "10"},
+		{v: true, expect: "true"},
+		{v: []interface{}{"a", "b"}, expect: `["a","b"]`},
+		{v: strings.Repeat("中", maxCellWidth+1), expect: strings.Repeat("中", maxCellWidth-3) + "..."},
+	} {
+		if got := toCell(tc.v); got != tc.expect {
+			t.Fatalf("toCell(%#v): expect %q, got %q", tc.v, tc.expect, got)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"openpitrix.io/openpitrix/pkg/util/yamlutil"
+)
+
+const (
+	DefaultProfile  = "default"
+	DefaultHost     = "localhost:9100"
+	DefaultBasePath = "/"
+)
+
+// Profile is the endpoint and the credential used to call the api gateway.
+type Profile struct {
+	Host     string `json:"host,omitempty"`
+	BasePath string `json:"base_path,omitempty"`
+	AuthKey  string `json:"auth_key,omitempty"`
+}
+
+// Config is the content of the config file, e.g.
+//
+//	profiles:
+//	  default:
+//	    host: localhost:9100
+//	    base_path: /
+//	    auth_key: xxx
+type Config struct {
+	Profiles map[string]*Profile `json:"profiles"`
+}
+
+func getDefaultConfigFile() string {
+	return filepath.Join(os.Getenv("HOME"), ".openpitrix", "config.yaml")
+}
+
+func loadConfig(filename string) (*Config, error) {
+	c := &Config{Profiles: make(map[string]*Profile)}
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yamlutil.Decode(data, c); err != nil {
+		return nil, fmt.Errorf("invalid config [%s]: %s", filename, err)
+	}
+	if c.Profiles == nil {
+		c.Profiles = make(map[string]*Profile)
+	}
+	return c, nil
+}
+
+func saveConfig(filename string, c *Config) error {
+	data, err := yamlutil.Encode(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	// the file holds the auth keys
+	return ioutil.WriteFile(filename, data, 0600)
+}
+
+// resolveProfile merges the profile with the flags set in the command line,
+// the flags win.
+func resolveProfile(cmd *cobra.Command) (*Profile, error) {
+	c, err := loadConfig(globalOptions.config)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := c.Profiles[globalOptions.profile]
+	if !ok {
+		if cmd.Flags().Changed("profile") {
+			return nil, fmt.Errorf("profile [%s] not found in [%s]", globalOptions.profile, globalOptions.config)
+		}
+		p = &Profile{}
+	}
+
+	f := cmd.Flags()
+	if f.Changed("host") || p.Host == "" {
+		p.Host = globalOptions.host
+	}
+	if f.Changed("base_path") || p.BasePath == "" {
+		p.BasePath = globalOptions.basePath
+	}
+	if f.Changed("auth_key") {
+		p.AuthKey = globalOptions.authKey
+	}
+	return p, nil
+}
+
+func getSetProfileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set_profile",
+		Short: "set profile",
+		Long:  "save --host, --base_path and --auth_key to the profile selected by --profile",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := resolveProfile(cmd)
+			if err != nil {
+				return err
+			}
+			c, err := loadConfig(globalOptions.config)
+			if err != nil {
+				return err
+			}
+			c.Profiles[globalOptions.profile] = p
+			if err := saveConfig(globalOptions.config, c); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Profile [%s] saved to [%s]\n", globalOptions.profile, globalOptions.config)
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,57 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/test/client/job_manager"
+)
+
+const watchInterval = 2 * time.Second
+
+// watchJobs polls the jobs until none of them is pending or working,
+// status changes are written as they are seen.
+func watchJobs(out Out, jobIds ...string) error {
+	if len(jobIds) == 0 {
+		return nil
+	}
+	client := getClient()
+	statuses := make(map[string]string)
+	for {
+		params := job_manager.NewDescribeJobsParams()
+		params.SetJobID(jobIds)
+		res, err := client.JobManager.DescribeJobs(params)
+		if err != nil {
+			return err
+		}
+
+		// the job may not be visible right after it is submitted
+		done := len(res.Payload.JobSet) >= len(jobIds)
+		var failed []string
+		for _, job := range res.Payload.JobSet {
+			if statuses[job.JobID] != job.Status {
+				statuses[job.JobID] = job.Status
+				fmt.Fprintf(out.out, "%s job [%s] %s\n", time.Now().Format("15:04:05"), job.JobID, job.Status)
+			}
+			switch job.Status {
+			case constants.StatusPending, constants.StatusWorking:
+				done = false
+			case constants.StatusFailed:
+				failed = append(failed, job.JobID)
+			}
+		}
+		if done {
+			if len(failed) > 0 {
+				return fmt.Errorf("job [%s] failed", strings.Join(failed, ","))
+			}
+			return nil
+		}
+		time.Sleep(watchInterval)
+	}
+}
@@ -90,6 +90,12 @@ cd ../..
 
 kubectl create namespace ${NAMESPACE}
 kubectl create secret generic mysql-pass --from-file=./kubernetes/password.txt -n ${NAMESPACE}
+# the master keys of the credentials are generated at the first deployment,
+# the secret is kept by the later ones so that the credentials stay readable
+if ! kubectl get secret encryption-master-keys -n ${NAMESPACE} > /dev/null 2>&1;then
+  kubectl create secret generic encryption-master-keys \
+    --from-literal=master_keys="$(date +%Y%m%d):$(head -c 32 /dev/urandom | base64)" -n ${NAMESPACE}
+fi
 
 
 if [ "${DBCTRL}" == "1" ];then
@@ -41,6 +41,10 @@ EXAMPLE:
    pilot list
    pilot ping
    pilot exec
+   pilot cp ./app.sh drone:/opt/app/app.sh
+   pilot cp drone:/var/log/drone.log ./drone.log
+   pilot agents
+   pilot upgrade-agents --agent-type=drone ./drone
    pilot getv key
    pilot confd-info
    pilot confd-start
@@ -312,6 +316,206 @@ EXAMPLE:
 			},
 		},
 
+		{
+			Name:      "cp",
+			Usage:     "copy file between local and drone service",
+			ArgsUsage: "src dst (drone file likes drone:/path/to/file)",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "frontgate-id",
+					Value: "frontgate-001",
+				},
+				cli.StringFlag{
+					Name:  "drone-host",
+					Value: "localhost",
+				},
+				cli.IntFlag{
+					Name:  "drone-port",
+					Value: constants.DroneServicePort,
+				},
+				cli.StringFlag{
+					Name:  "mode",
+					Value: "0644",
+					Usage: "set permission of the uploaded file",
+				},
+				cli.StringFlag{
+					Name:  "owner",
+					Usage: "set owner of the uploaded file (user[:group])",
+				},
+			},
+
+			Action: func(c *cli.Context) {
+				if c.NArg() != 2 {
+					logger.Critical("invalid args: %v", c.Args())
+					os.Exit(1)
+				}
+
+				cfgpath := pathutil.MakeAbsPath(c.GlobalString("config"))
+				cfg := pilotutil.MustLoadPilotConfig(cfgpath)
+
+				client, conn, err := pilotutil.DialPilotService(
+					context.Background(), cfg.Host, int(cfg.ListenPort),
+				)
+				if err != nil {
+					logger.Critical("%+v", err)
+					os.Exit(1)
+				}
+				defer conn.Close()
+
+				endpoint := &pbtypes.DroneEndpoint{
+					FrontgateId: c.String("frontgate-id"),
+					DroneIp:     c.String("drone-host"),
+					DronePort:   int32(c.Int("drone-port")),
+				}
+
+				const remotePrefix = "drone:"
+				src, dst := c.Args().Get(0), c.Args().Get(1)
+
+				switch {
+				case strings.HasPrefix(dst, remotePrefix) && !strings.HasPrefix(src, remotePrefix):
+					mode, err := strconv.ParseUint(c.String("mode"), 8, 32)
+					if err != nil {
+						logger.Critical("%+v", err)
+						os.Exit(1)
+					}
+					err = pilotutil.UploadFileToDrone(context.Background(), client, endpoint,
+						src, strings.TrimPrefix(dst, remotePrefix),
+						int32(mode), c.String("owner"),
+					)
+					if err != nil {
+						logger.Critical("%+v", err)
+						os.Exit(1)
+					}
+
+				case strings.HasPrefix(src, remotePrefix) && !strings.HasPrefix(dst, remotePrefix):
+					err = pilotutil.DownloadFileFromDrone(context.Background(), client, endpoint,
+						strings.TrimPrefix(src, remotePrefix), dst,
+					)
+					if err != nil {
+						logger.Critical("%+v", err)
+						os.Exit(1)
+					}
+
+				default:
+					logger.Critical("one of src and dst must be drone file: %v", c.Args())
+					os.Exit(1)
+				}
+
+				fmt.Println("OK")
+				return
+			},
+		},
+
+		{
+			Name:  "agents",
+			Usage: "list version of frontgate/drone agents",
+
+			Action: func(c *cli.Context) {
+				cfgpath := pathutil.MakeAbsPath(c.GlobalString("config"))
+				cfg := pilotutil.MustLoadPilotConfig(cfgpath)
+
+				client, conn, err := pilotutil.DialPilotService(
+					context.Background(), cfg.Host, int(cfg.ListenPort),
+				)
+				if err != nil {
+					logger.Critical("%+v", err)
+					os.Exit(1)
+				}
+				defer conn.Close()
+
+				reply, err := client.GetAgentList(context.Background(), &pbtypes.Empty{})
+				if err != nil {
+					logger.Critical("%+v", err)
+					os.Exit(1)
+				}
+
+				fmt.Println(JSONString(reply))
+				return
+			},
+		},
+		{
+			Name:      "upgrade-agents",
+			Usage:     "upgrade frontgate/drone agents with the local binary",
+			ArgsUsage: "binary",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "agent-type",
+					Value: constants.AgentTypeDrone,
+					Usage: "agent type (frontgate/drone)",
+				},
+				cli.StringFlag{
+					Name:  "frontgate-id",
+					Value: "frontgate-001",
+				},
+				cli.StringFlag{
+					Name:  "frontgate-node-id",
+					Value: "frontgate-node-001",
+				},
+				cli.StringFlag{
+					Name:  "drone-host",
+					Value: "localhost",
+				},
+				cli.IntFlag{
+					Name:  "drone-port",
+					Value: constants.DroneServicePort,
+				},
+				cli.IntFlag{
+					Name:  "rollback-timeout",
+					Value: int(constants.DefaultAgentRollbackTimeout.Seconds()),
+					Usage: "roll back if the agent does not ping back in seconds",
+				},
+			},
+
+			Action: func(c *cli.Context) {
+				if c.NArg() != 1 {
+					logger.Critical("invalid args: %v", c.Args())
+					os.Exit(1)
+				}
+
+				cfgpath := pathutil.MakeAbsPath(c.GlobalString("config"))
+				cfg := pilotutil.MustLoadPilotConfig(cfgpath)
+
+				client, conn, err := pilotutil.DialPilotService(
+					context.Background(), cfg.Host, int(cfg.ListenPort),
+				)
+				if err != nil {
+					logger.Critical("%+v", err)
+					os.Exit(1)
+				}
+				defer conn.Close()
+
+				req := &pbtypes.UpgradeAgentsRequest{
+					AgentType:              c.String("agent-type"),
+					RollbackTimeoutSeconds: int32(c.Int("rollback-timeout")),
+				}
+				switch req.AgentType {
+				case constants.AgentTypeFrontgate:
+					req.FrontgateList = []*pbtypes.FrontgateEndpoint{{
+						FrontgateId:     c.String("frontgate-id"),
+						FrontgateNodeId: c.String("frontgate-node-id"),
+					}}
+				case constants.AgentTypeDrone:
+					req.DroneList = []*pbtypes.DroneEndpoint{{
+						FrontgateId: c.String("frontgate-id"),
+						DroneIp:     c.String("drone-host"),
+						DronePort:   int32(c.Int("drone-port")),
+					}}
+				default:
+					logger.Critical("invalid agent type: %s", req.AgentType)
+					os.Exit(1)
+				}
+
+				reply, err := pilotutil.UpgradeAgents(context.Background(), client, req, c.Args().First())
+				if err != nil {
+					logger.Critical("%+v", err)
+					os.Exit(1)
+				}
+
+				fmt.Println(JSONString(reply))
+				return
+			},
+		},
+
 		{
 			Name:  "confd-status",
 			Usage: "get confd service status",
@@ -590,11 +794,31 @@ EXAMPLE:
 		{
 			Name:  "serve",
 			Usage: "run as pilot service",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:   "id",
+					Usage:  "pilot id, overwrite the id in config file",
+					EnvVar: "OPENPITRIX_PILOT_ID",
+				},
+				cli.StringFlag{
+					Name:   "host",
+					Usage:  "pilot host which can be reached by other pilot replicas",
+					EnvVar: "OPENPITRIX_PILOT_HOST",
+				},
+			},
 			Action: func(c *cli.Context) {
 				cfgpath := pathutil.MakeAbsPath(c.GlobalString("config"))
 				cfg := pilotutil.MustLoadPilotConfig(cfgpath)
 
-				pilot.Serve(cfg)
+				var opts []pilot.Options
+				if id := c.String("id"); id != "" {
+					opts = append(opts, pilot.WithPilotId(id))
+				}
+				if host := c.String("host"); host != "" {
+					opts = append(opts, pilot.WithPilotHost(host))
+				}
+
+				pilot.Serve(cfg, opts...)
 				return
 			},
 		},
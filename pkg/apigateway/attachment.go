@@ -0,0 +1,57 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package apigateway
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	appclient "openpitrix.io/openpitrix/pkg/client/app"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/senderutil"
+)
+
+// appAttachmentHandler serves the content of the attachment as the file
+// itself, so that the urls of the icon and screenshots of apps can be used
+// by the browser directly, e.g.
+//
+//	<img src="/v1/app/attachments/2c26b46b...7ae.png">
+func appAttachmentHandler(gwmux *runtime.ServeMux) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := senderutil.NewContext(r.Context(), senderutil.AuthUserInfo(r.Header.Get("X-Auth-Key")))
+		_, outbound := runtime.MarshalerForRequest(gwmux, r)
+
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		client, err := appclient.NewAppManagerClient()
+		if err != nil {
+			runtime.HTTPError(ctx, gwmux, outbound, w, r,
+				gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError))
+			return
+		}
+		res, err := client.GetAppAttachment(ctx, &pb.GetAppAttachmentRequest{
+			AttachmentId: pbutil.ToProtoString(strings.TrimPrefix(r.URL.Path, constants.AppAttachmentPath)),
+		})
+		if err != nil {
+			runtime.HTTPError(ctx, gwmux, outbound, w, r, err)
+			return
+		}
+
+		// the attachment id is the hash of the content, it never changes
+		w.Header().Set("Content-Type", res.GetContentType().GetValue())
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		// the svg of packages must not run scripts on the origin of OpenPitrix
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+		w.Write(res.GetContent())
+	}
+}
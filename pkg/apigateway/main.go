@@ -174,6 +174,8 @@ func (s *Server) mainHandler() http.Handler {
 
 	mux.Handle("/", gwmux)
 	mux.HandleFunc("/v1/io", tm.HandleEvent)
+	mux.HandleFunc(uploadAppVersionPackagePath, uploadAppVersionPackageHandler(gwmux))
+	mux.HandleFunc(constants.AppAttachmentPath, appAttachmentHandler(gwmux))
 
 	return mux
 }
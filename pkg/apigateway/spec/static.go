@@ -27,6 +27,32 @@ var Files = map[string]string{
     "application/json"
   ],
   "paths": {
+    "/v1/app/attachment": {
+      "get": {
+        "summary": "get the readme, icon or screenshot of app",
+        "operationId": "GetAppAttachment",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixGetAppAttachmentResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "attachment_id",
+            "description": "the sha256 of the content with the extension, e.g. 2c26b46b...7ae.png.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
     "/v1/app_version/package": {
       "get": {
         "summary": "get the package content of app version",
@@ -50,6 +76,31 @@ var Files = map[string]string{
         "tags": [
           "AppManager"
         ]
+      },
+      "post": {
+        "summary": "upload the package of app version to the storage of OpenPitrix",
+        "operationId": "UploadAppVersionPackage",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixUploadAppVersionPackageResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixUploadAppVersionPackageRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
       }
     },
     "/v1/app_version/package/files": {
@@ -192,6 +243,7 @@ var Files = map[string]string{
           },
           {
             "name": "sort_key",
+            "description": "sequence by default, semver to sort by the semantic version of the name.",
             "in": "query",
             "required": false,
             "type": "string"
@@ -284,54 +336,21 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/apps": {
+    "/v1/app_versions/audits": {
       "get": {
-        "summary": "describe apps with filter",
-        "operationId": "DescribeApps",
+        "summary": "describe the status transitions of app versions",
+        "operationId": "DescribeAppVersionAudits",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeAppsResponse"
+              "$ref": "#/definitions/openpitrixDescribeAppVersionAuditsResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "search_word",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "sort_key",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "reverse",
-            "in": "query",
-            "required": false,
-            "type": "boolean",
-            "format": "boolean"
-          },
-          {
-            "name": "app_id",
+            "name": "version_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -341,7 +360,7 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "name",
+            "name": "app_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -351,7 +370,7 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "repo_id",
+            "name": "status",
             "in": "query",
             "required": false,
             "type": "array",
@@ -361,7 +380,7 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "status",
+            "name": "operator",
             "in": "query",
             "required": false,
             "type": "array",
@@ -371,48 +390,47 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "owner",
+            "name": "limit",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "integer",
+            "format": "int64"
           },
           {
-            "name": "chart_name",
+            "name": "offset",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "integer",
+            "format": "int64"
           },
           {
-            "name": "category_id",
+            "name": "sort_key",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
           }
         ],
         "tags": [
           "AppManager"
         ]
-      },
-      "delete": {
-        "summary": "delete apps",
-        "operationId": "DeleteApps",
+      }
+    },
+    "/v1/app_versions/pass": {
+      "post": {
+        "summary": "pass the review of app version",
+        "operationId": "PassAppVersion",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteAppsResponse"
+              "$ref": "#/definitions/openpitrixPassAppVersionResponse"
             }
           }
         },
@@ -422,22 +440,24 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteAppsRequest"
+              "$ref": "#/definitions/openpitrixPassAppVersionRequest"
             }
           }
         ],
         "tags": [
           "AppManager"
         ]
-      },
+      }
+    },
+    "/v1/app_versions/reject": {
       "post": {
-        "summary": "create app",
-        "operationId": "CreateApp",
+        "summary": "reject the review of app version",
+        "operationId": "RejectAppVersion",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateAppResponse"
+              "$ref": "#/definitions/openpitrixRejectAppVersionResponse"
             }
           }
         },
@@ -447,22 +467,24 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateAppRequest"
+              "$ref": "#/definitions/openpitrixRejectAppVersionRequest"
             }
           }
         ],
         "tags": [
           "AppManager"
         ]
-      },
-      "patch": {
-        "summary": "modify app",
-        "operationId": "ModifyApp",
+      }
+    },
+    "/v1/app_versions/release": {
+      "post": {
+        "summary": "release the passed or suspended app version",
+        "operationId": "ReleaseAppVersion",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyAppResponse"
+              "$ref": "#/definitions/openpitrixReleaseAppVersionResponse"
             }
           }
         },
@@ -472,7 +494,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyAppRequest"
+              "$ref": "#/definitions/openpitrixReleaseAppVersionRequest"
             }
           }
         ],
@@ -481,32 +503,121 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/apps/statistics": {
+    "/v1/app_versions/review": {
+      "post": {
+        "summary": "start the review of app version",
+        "operationId": "ReviewAppVersion",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixReviewAppVersionResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixReviewAppVersionRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/v1/app_versions/submit": {
+      "post": {
+        "summary": "submit app version for review",
+        "operationId": "SubmitAppVersion",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixSubmitAppVersionResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixSubmitAppVersionRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/v1/app_versions/suspend": {
+      "post": {
+        "summary": "suspend the active app version",
+        "operationId": "SuspendAppVersion",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixSuspendAppVersionResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixSuspendAppVersionRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/v1/app_versions/upgrade_paths": {
       "get": {
-        "summary": "get app statistics",
-        "operationId": "GetAppStatistics",
+        "summary": "get the app versions that the app version can upgrade to",
+        "operationId": "GetAppVersionUpgradePaths",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixGetAppStatisticsResponse"
+              "$ref": "#/definitions/openpitrixGetAppVersionUpgradePathsResponse"
             }
           }
         },
+        "parameters": [
+          {
+            "name": "version_id",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
         "tags": [
           "AppManager"
         ]
       }
     },
-    "/v1/categories": {
+    "/v1/apps": {
       "get": {
-        "summary": "describe categories with filter",
-        "operationId": "DescribeCategories",
+        "summary": "describe apps with filter",
+        "operationId": "DescribeApps",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeCategoriesResponse"
+              "$ref": "#/definitions/openpitrixDescribeAppsResponse"
             }
           }
         },
@@ -532,7 +643,20 @@ var Files = map[string]string{
             "format": "int64"
           },
           {
-            "name": "category_id",
+            "name": "sort_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "app_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -552,7 +676,7 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "owner",
+            "name": "repo_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -562,31 +686,65 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "sort_key",
+            "name": "status",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "reverse",
+            "name": "owner",
             "in": "query",
             "required": false,
-            "type": "boolean",
-            "format": "boolean"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "chart_name",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "category_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "runtime_id",
+            "description": "apps in the repos whose selectors match the labels of the runtime.",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
-          "CategoryManager"
+          "AppManager"
         ]
       },
       "delete": {
-        "summary": "delete categories",
-        "operationId": "DeleteCategories",
+        "summary": "delete apps",
+        "operationId": "DeleteApps",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteCategoriesResponse"
+              "$ref": "#/definitions/openpitrixDeleteAppsResponse"
             }
           }
         },
@@ -596,22 +754,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteCategoriesRequest"
+              "$ref": "#/definitions/openpitrixDeleteAppsRequest"
             }
           }
         ],
         "tags": [
-          "CategoryManager"
+          "AppManager"
         ]
       },
       "post": {
-        "summary": "create category",
-        "operationId": "CreateCategory",
+        "summary": "create app",
+        "operationId": "CreateApp",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateCategoryResponse"
+              "$ref": "#/definitions/openpitrixCreateAppResponse"
             }
           }
         },
@@ -621,22 +779,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateCategoryRequest"
+              "$ref": "#/definitions/openpitrixCreateAppRequest"
             }
           }
         ],
         "tags": [
-          "CategoryManager"
+          "AppManager"
         ]
       },
       "patch": {
-        "summary": "modify category",
-        "operationId": "ModifyCategory",
+        "summary": "modify app",
+        "operationId": "ModifyApp",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyCategoryResponse"
+              "$ref": "#/definitions/openpitrixModifyAppResponse"
             }
           }
         },
@@ -646,30 +804,37 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyCategoryRequest"
+              "$ref": "#/definitions/openpitrixModifyAppRequest"
             }
           }
         ],
         "tags": [
-          "CategoryManager"
+          "AppManager"
         ]
       }
     },
-    "/v1/clusters": {
+    "/v1/apps/search": {
       "get": {
-        "summary": "describe clusters",
-        "operationId": "DescribeClusters",
+        "summary": "search apps with full-text search and facets",
+        "operationId": "SearchApps",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeClustersResponse"
+              "$ref": "#/definitions/openpitrixSearchAppsResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "cluster_id",
+            "name": "search_word",
+            "description": "full-text search on the name, description, keywords, maintainers, readme and latest version of app.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "category_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -679,7 +844,7 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "app_id",
+            "name": "repo_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -689,7 +854,7 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "version_id",
+            "name": "provider",
             "in": "query",
             "required": false,
             "type": "array",
@@ -709,7 +874,7 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "runtime_id",
+            "name": "keyword",
             "in": "query",
             "required": false,
             "type": "array",
@@ -719,7 +884,8 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "frontgate_id",
+            "name": "maintainer",
+            "description": "name of the maintainer.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -728,15 +894,8 @@ var Files = map[string]string{
             },
             "collectionFormat": "multi"
           },
-          {
-            "name": "external_cluster_id",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
           {
             "name": "limit",
-            "description": "default is 20, max value is 200.",
             "in": "query",
             "required": false,
             "type": "integer",
@@ -744,87 +903,123 @@ var Files = map[string]string{
           },
           {
             "name": "offset",
-            "description": "default is 0.",
             "in": "query",
             "required": false,
             "type": "integer",
             "format": "int64"
-          },
-          {
-            "name": "search_word",
-            "in": "query",
-            "required": false,
-            "type": "string"
           }
         ],
         "tags": [
-          "ClusterManager"
+          "AppManager"
         ]
       }
     },
-    "/v1/clusters/add_nodes": {
-      "post": {
-        "summary": "add cluster nodes",
-        "operationId": "AddClusterNodes",
+    "/v1/apps/statistics": {
+      "get": {
+        "summary": "get app statistics",
+        "operationId": "GetAppStatistics",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixAddClusterNodesResponse"
+              "$ref": "#/definitions/openpitrixGetAppStatisticsResponse"
             }
           }
         },
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixAddClusterNodesRequest"
-            }
-          }
-        ],
         "tags": [
-          "ClusterManager"
+          "AppManager"
         ]
       }
     },
-    "/v1/clusters/cease": {
-      "post": {
-        "summary": "cease clusters",
-        "operationId": "CeaseClusters",
+    "/v1/categories": {
+      "get": {
+        "summary": "describe categories with filter",
+        "operationId": "DescribeCategories",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixCeaseClustersResponse"
+              "$ref": "#/definitions/openpitrixDescribeCategoriesResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixCeaseClustersRequest"
-            }
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "category_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "name",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "owner",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "sort_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
           }
         ],
         "tags": [
-          "ClusterManager"
+          "CategoryManager"
         ]
-      }
-    },
-    "/v1/clusters/create": {
-      "post": {
-        "summary": "create cluster",
-        "operationId": "CreateCluster",
+      },
+      "delete": {
+        "summary": "delete categories",
+        "operationId": "DeleteCategories",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateClusterResponse"
+              "$ref": "#/definitions/openpitrixDeleteCategoriesResponse"
             }
           }
         },
@@ -834,24 +1029,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateClusterRequest"
+              "$ref": "#/definitions/openpitrixDeleteCategoriesRequest"
             }
           }
         ],
         "tags": [
-          "ClusterManager"
+          "CategoryManager"
         ]
-      }
-    },
-    "/v1/clusters/delete": {
+      },
       "post": {
-        "summary": "delete clusters",
-        "operationId": "DeleteClusters",
+        "summary": "create category",
+        "operationId": "CreateCategory",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteClustersResponse"
+              "$ref": "#/definitions/openpitrixCreateCategoryResponse"
             }
           }
         },
@@ -861,24 +1054,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteClustersRequest"
+              "$ref": "#/definitions/openpitrixCreateCategoryRequest"
             }
           }
         ],
         "tags": [
-          "ClusterManager"
+          "CategoryManager"
         ]
-      }
-    },
-    "/v1/clusters/delete_nodes": {
-      "post": {
-        "summary": "delete cluster nodes",
-        "operationId": "DeleteClusterNodes",
+      },
+      "patch": {
+        "summary": "modify category",
+        "operationId": "ModifyCategory",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteClusterNodesResponse"
+              "$ref": "#/definitions/openpitrixModifyCategoryResponse"
             }
           }
         },
@@ -888,120 +1079,97 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteClusterNodesRequest"
+              "$ref": "#/definitions/openpitrixModifyCategoryRequest"
             }
           }
         ],
         "tags": [
-          "ClusterManager"
+          "CategoryManager"
         ]
       }
     },
-    "/v1/clusters/key_pair/attach": {
-      "post": {
-        "summary": "attach key pairs",
-        "operationId": "AttachKeyPairs",
+    "/v1/clusters": {
+      "get": {
+        "summary": "describe clusters",
+        "operationId": "DescribeClusters",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixAttachKeyPairsResponse"
+              "$ref": "#/definitions/openpitrixDescribeClustersResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixAttachKeyPairsRequest"
-            }
-          }
-        ],
-        "tags": [
-          "ClusterManager"
-        ]
-      }
-    },
-    "/v1/clusters/key_pair/detach": {
-      "post": {
-        "summary": "detach key pairs",
-        "operationId": "DetachKeyPairs",
-        "responses": {
-          "200": {
-            "description": "",
-            "schema": {
-              "$ref": "#/definitions/openpitrixDetachKeyPairsResponse"
-            }
-          }
-        },
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixDetachKeyPairsRequest"
-            }
-          }
-        ],
-        "tags": [
-          "ClusterManager"
-        ]
-      }
-    },
-    "/v1/clusters/key_pairs": {
-      "get": {
-        "summary": "describe key pairs",
-        "operationId": "DescribeKeyPairs",
-        "responses": {
-          "200": {
-            "description": "",
-            "schema": {
-              "$ref": "#/definitions/openpitrixDescribeKeyPairsResponse"
-            }
-          }
-        },
-        "parameters": [
+            "name": "cluster_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
           {
-            "name": "key_pair_id",
+            "name": "app_id",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "name",
+            "name": "version_id",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "description",
+            "name": "status",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "pub_key",
+            "name": "runtime_id",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "owner",
+            "name": "frontgate_id",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "search_word",
+            "name": "external_cluster_id",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
             "name": "limit",
+            "description": "default is 20, max value is 200.",
             "in": "query",
             "required": false,
             "type": "integer",
@@ -1009,24 +1177,33 @@ var Files = map[string]string{
           },
           {
             "name": "offset",
+            "description": "default is 0.",
             "in": "query",
             "required": false,
             "type": "integer",
             "format": "int64"
+          },
+          {
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      },
-      "delete": {
-        "summary": "delete key pairs",
-        "operationId": "DeleteKeyPairs",
+      }
+    },
+    "/v1/clusters/add_nodes": {
+      "post": {
+        "summary": "add cluster nodes",
+        "operationId": "AddClusterNodes",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteKeyPairsResponse"
+              "$ref": "#/definitions/openpitrixAddClusterNodesResponse"
             }
           }
         },
@@ -1036,22 +1213,24 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteKeyPairsRequest"
+              "$ref": "#/definitions/openpitrixAddClusterNodesRequest"
             }
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      },
+      }
+    },
+    "/v1/clusters/cease": {
       "post": {
-        "summary": "create key pair",
-        "operationId": "CreateKeyPair",
+        "summary": "cease clusters",
+        "operationId": "CeaseClusters",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateKeyPairResponse"
+              "$ref": "#/definitions/openpitrixCeaseClustersResponse"
             }
           }
         },
@@ -1061,7 +1240,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateKeyPairRequest"
+              "$ref": "#/definitions/openpitrixCeaseClustersRequest"
             }
           }
         ],
@@ -1070,26 +1249,28 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/modify": {
-      "post": {
-        "summary": "modify cluster attributes",
-        "operationId": "ModifyClusterAttributes",
+    "/v1/clusters/costs": {
+      "get": {
+        "summary": "describe cost of clusters",
+        "operationId": "DescribeClusterCosts",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyClusterAttributesResponse"
+              "$ref": "#/definitions/openpitrixDescribeClusterCostsResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixModifyClusterAttributesRequest"
-            }
+            "name": "cluster_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           }
         ],
         "tags": [
@@ -1097,15 +1278,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/modify_nodes": {
+    "/v1/clusters/create": {
       "post": {
-        "summary": "modify cluster node attributes",
-        "operationId": "ModifyClusterNodeAttributes",
+        "summary": "create cluster",
+        "operationId": "CreateCluster",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyClusterNodeAttributesResponse"
+              "$ref": "#/definitions/openpitrixCreateClusterResponse"
             }
           }
         },
@@ -1115,7 +1296,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyClusterNodeAttributesRequest"
+              "$ref": "#/definitions/openpitrixCreateClusterRequest"
             }
           }
         ],
@@ -1124,66 +1305,26 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/nodes": {
-      "get": {
-        "summary": "describe cluster nodes",
-        "operationId": "DescribeClusterNodes",
+    "/v1/clusters/delete": {
+      "post": {
+        "summary": "delete clusters",
+        "operationId": "DeleteClusters",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeClusterNodesResponse"
+              "$ref": "#/definitions/openpitrixDeleteClustersResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "cluster_id",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "node_id",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "status",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "limit",
-            "description": "default is 20, max value is 200.",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "offset",
-            "description": "default is 0.",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "search_word",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteClustersRequest"
+            }
           }
         ],
         "tags": [
@@ -1191,15 +1332,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/recover": {
+    "/v1/clusters/delete_nodes": {
       "post": {
-        "summary": "recover clusters",
-        "operationId": "RecoverClusters",
+        "summary": "delete cluster nodes",
+        "operationId": "DeleteClusterNodes",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixRecoverClustersResponse"
+              "$ref": "#/definitions/openpitrixDeleteClusterNodesResponse"
             }
           }
         },
@@ -1209,7 +1350,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixRecoverClustersRequest"
+              "$ref": "#/definitions/openpitrixDeleteClusterNodesRequest"
             }
           }
         ],
@@ -1218,15 +1359,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/resize": {
+    "/v1/clusters/estimate_cost": {
       "post": {
-        "summary": "resize cluster",
-        "operationId": "ResizeCluster",
+        "summary": "estimate cost of cluster before creating it",
+        "operationId": "EstimateClusterCost",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixResizeClusterResponse"
+              "$ref": "#/definitions/openpitrixEstimateClusterCostResponse"
             }
           }
         },
@@ -1236,7 +1377,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixResizeClusterRequest"
+              "$ref": "#/definitions/openpitrixEstimateClusterCostRequest"
             }
           }
         ],
@@ -1245,15 +1386,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/rollback": {
+    "/v1/clusters/key_pair/attach": {
       "post": {
-        "summary": "rollback cluster",
-        "operationId": "RollbackCluster",
+        "summary": "attach key pairs",
+        "operationId": "AttachKeyPairs",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixRollbackClusterResponse"
+              "$ref": "#/definitions/openpitrixAttachKeyPairsResponse"
             }
           }
         },
@@ -1263,7 +1404,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixRollbackClusterRequest"
+              "$ref": "#/definitions/openpitrixAttachKeyPairsRequest"
             }
           }
         ],
@@ -1272,15 +1413,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/start": {
+    "/v1/clusters/key_pair/detach": {
       "post": {
-        "summary": "start clusters",
-        "operationId": "StartClusters",
+        "summary": "detach key pairs",
+        "operationId": "DetachKeyPairs",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixStartClustersResponse"
+              "$ref": "#/definitions/openpitrixDetachKeyPairsResponse"
             }
           }
         },
@@ -1290,7 +1431,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixStartClustersRequest"
+              "$ref": "#/definitions/openpitrixDetachKeyPairsRequest"
             }
           }
         ],
@@ -1299,32 +1440,82 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/statistics": {
+    "/v1/clusters/key_pairs": {
       "get": {
-        "summary": "get cluster statistics",
-        "operationId": "GetClusterStatistics",
+        "summary": "describe key pairs",
+        "operationId": "DescribeKeyPairs",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixGetClusterStatisticsResponse"
+              "$ref": "#/definitions/openpitrixDescribeKeyPairsResponse"
             }
           }
         },
+        "parameters": [
+          {
+            "name": "key_pair_id",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "name",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "description",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "pub_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "owner",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
         "tags": [
           "ClusterManager"
         ]
-      }
-    },
-    "/v1/clusters/stop": {
-      "post": {
-        "summary": "stop clusters",
-        "operationId": "StopClusters",
+      },
+      "delete": {
+        "summary": "delete key pairs",
+        "operationId": "DeleteKeyPairs",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixStopClustersResponse"
+              "$ref": "#/definitions/openpitrixDeleteKeyPairsResponse"
             }
           }
         },
@@ -1334,85 +1525,33 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixStopClustersRequest"
+              "$ref": "#/definitions/openpitrixDeleteKeyPairsRequest"
             }
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      }
-    },
-    "/v1/clusters/subnets": {
-      "get": {
-        "summary": "describe subnets",
-        "operationId": "DescribeSubnets",
+      },
+      "post": {
+        "summary": "create key pair",
+        "operationId": "CreateKeyPair",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeSubnetsResponse"
+              "$ref": "#/definitions/openpitrixCreateKeyPairResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "runtime_id",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "subnet_type.value",
-            "description": "The uint32 value.",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "subnet_id",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "zone",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "advanced_param",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateKeyPairRequest"
+            }
           }
         ],
         "tags": [
@@ -1420,15 +1559,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/update_env": {
-      "patch": {
-        "summary": "update cluster env",
-        "operationId": "UpdateClusterEnv",
+    "/v1/clusters/modify": {
+      "post": {
+        "summary": "modify cluster attributes",
+        "operationId": "ModifyClusterAttributes",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixUpdateClusterEnvResponse"
+              "$ref": "#/definitions/openpitrixModifyClusterAttributesResponse"
             }
           }
         },
@@ -1438,7 +1577,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixUpdateClusterEnvRequest"
+              "$ref": "#/definitions/openpitrixModifyClusterAttributesRequest"
             }
           }
         ],
@@ -1447,15 +1586,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/upgrade": {
+    "/v1/clusters/modify_nodes": {
       "post": {
-        "summary": "upgrade cluster",
-        "operationId": "UpgradeCluster",
+        "summary": "modify cluster node attributes",
+        "operationId": "ModifyClusterNodeAttributes",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixUpgradeClusterResponse"
+              "$ref": "#/definitions/openpitrixModifyClusterNodeAttributesResponse"
             }
           }
         },
@@ -1465,7 +1604,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixUpgradeClusterRequest"
+              "$ref": "#/definitions/openpitrixModifyClusterNodeAttributesRequest"
             }
           }
         ],
@@ -1474,29 +1613,19 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/jobs": {
+    "/v1/clusters/nodes": {
       "get": {
-        "summary": "describe jobs with filter",
-        "operationId": "DescribeJobs",
+        "summary": "describe cluster nodes",
+        "operationId": "DescribeClusterNodes",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeJobsResponse"
+              "$ref": "#/definitions/openpitrixDescribeClusterNodesResponse"
             }
           }
         },
         "parameters": [
-          {
-            "name": "job_id",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
           {
             "name": "cluster_id",
             "in": "query",
@@ -1504,28 +1633,14 @@ var Files = map[string]string{
             "type": "string"
           },
           {
-            "name": "app_id",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "version_id",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "executor",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "provider",
+            "name": "node_id",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
             "name": "status",
@@ -1561,35 +1676,32 @@ var Files = map[string]string{
           }
         ],
         "tags": [
-          "JobManager"
+          "ClusterManager"
         ]
       }
     },
-    "/v1/repos": {
+    "/v1/clusters/nodes/logs": {
       "get": {
-        "summary": "describe repos with filter",
-        "operationId": "DescribeRepos",
+        "summary": "describe cluster node logs",
+        "operationId": "DescribeClusterNodeLogs",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeReposResponse"
+              "$ref": "#/definitions/openpitrixDescribeClusterNodeLogsResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "repo_id",
+            "name": "cluster_id",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "string"
           },
           {
-            "name": "name",
+            "name": "node_id",
+            "description": "all nodes of the cluster if empty.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1599,7 +1711,8 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "type",
+            "name": "log_name",
+            "description": "all logs declared by the role if empty.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1609,103 +1722,42 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "visibility",
+            "name": "tail_lines",
+            "description": "default is 100, max value is 10000.",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "integer",
+            "format": "int64"
           },
           {
-            "name": "status",
+            "name": "since_time",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "string",
+            "format": "date-time"
           },
           {
-            "name": "provider",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "label",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "selector",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "category_id",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "search_word",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "sort_key",
+            "name": "grep",
+            "description": "regexp to filter the log lines.",
             "in": "query",
             "required": false,
             "type": "string"
-          },
-          {
-            "name": "reverse",
-            "in": "query",
-            "required": false,
-            "type": "boolean",
-            "format": "boolean"
           }
         ],
         "tags": [
-          "RepoManager"
+          "ClusterManager"
         ]
-      },
-      "delete": {
-        "summary": "delete repos",
-        "operationId": "DeleteRepos",
+      }
+    },
+    "/v1/clusters/recover": {
+      "post": {
+        "summary": "recover clusters",
+        "operationId": "RecoverClusters",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteReposResponse"
+              "$ref": "#/definitions/openpitrixRecoverClustersResponse"
             }
           }
         },
@@ -1715,22 +1767,24 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteReposRequest"
+              "$ref": "#/definitions/openpitrixRecoverClustersRequest"
             }
           }
         ],
         "tags": [
-          "RepoManager"
+          "ClusterManager"
         ]
-      },
+      }
+    },
+    "/v1/clusters/resize": {
       "post": {
-        "summary": "create repo",
-        "operationId": "CreateRepo",
+        "summary": "resize cluster",
+        "operationId": "ResizeCluster",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateRepoResponse"
+              "$ref": "#/definitions/openpitrixResizeClusterResponse"
             }
           }
         },
@@ -1740,22 +1794,24 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateRepoRequest"
+              "$ref": "#/definitions/openpitrixResizeClusterRequest"
             }
           }
         ],
         "tags": [
-          "RepoManager"
+          "ClusterManager"
         ]
-      },
-      "patch": {
-        "summary": "modify repo",
-        "operationId": "ModifyRepo",
+      }
+    },
+    "/v1/clusters/rollback": {
+      "post": {
+        "summary": "rollback cluster",
+        "operationId": "RollbackCluster",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyRepoResponse"
+              "$ref": "#/definitions/openpitrixRollbackClusterResponse"
             }
           }
         },
@@ -1765,77 +1821,30 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyRepoRequest"
-            }
-          }
-        ],
-        "tags": [
-          "RepoManager"
-        ]
-      }
-    },
-    "/v1/repos/validate": {
-      "get": {
-        "summary": "validate repo",
-        "operationId": "ValidateRepo",
-        "responses": {
-          "200": {
-            "description": "",
-            "schema": {
-              "$ref": "#/definitions/openpitrixValidateRepoResponse"
+              "$ref": "#/definitions/openpitrixRollbackClusterRequest"
             }
           }
-        },
-        "parameters": [
-          {
-            "name": "type",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "url",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "credential",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          }
         ],
         "tags": [
-          "RepoManager"
+          "ClusterManager"
         ]
       }
     },
-    "/v1/repo_events": {
+    "/v1/clusters/runtime_usage": {
       "get": {
-        "summary": "describe repo events",
-        "operationId": "DescribeRepoEvents",
+        "summary": "describe resource usage of clusters by runtime and owner",
+        "operationId": "DescribeRuntimeUsage",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeRepoEventsResponse"
+              "$ref": "#/definitions/openpitrixDescribeRuntimeUsageResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "repo_event_id",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "repo_id",
+            "name": "runtime_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1855,44 +1864,43 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "status",
+            "name": "with_quota",
+            "description": "report the quotas left in the runtimes by the providers.",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "boolean",
+            "format": "boolean"
           },
           {
-            "name": "limit",
+            "name": "start_time",
+            "description": "return the snapshots of the usage taken in the time range.",
             "in": "query",
             "required": false,
-            "type": "integer",
-            "format": "int64"
+            "type": "string",
+            "format": "date-time"
           },
           {
-            "name": "offset",
+            "name": "end_time",
             "in": "query",
             "required": false,
-            "type": "integer",
-            "format": "int64"
+            "type": "string",
+            "format": "date-time"
           }
         ],
         "tags": [
-          "RepoIndexer"
+          "ClusterManager"
         ]
       }
     },
-    "/v1/repos/index": {
+    "/v1/clusters/start": {
       "post": {
-        "summary": "start a index repo event",
-        "operationId": "IndexRepo",
+        "summary": "start clusters",
+        "operationId": "StartClusters",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixIndexRepoResponse"
+              "$ref": "#/definitions/openpitrixStartClustersResponse"
             }
           }
         },
@@ -1902,24 +1910,68 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixIndexRepoRequest"
+              "$ref": "#/definitions/openpitrixStartClustersRequest"
             }
           }
         ],
         "tags": [
-          "RepoIndexer"
+          "ClusterManager"
         ]
       }
     },
-    "/v1/runtimes": {
+    "/v1/clusters/statistics": {
       "get": {
-        "summary": "describe runtime",
-        "operationId": "DescribeRuntimes",
+        "summary": "get cluster statistics",
+        "operationId": "GetClusterStatistics",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeRuntimesResponse"
+              "$ref": "#/definitions/openpitrixGetClusterStatisticsResponse"
+            }
+          }
+        },
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/stop": {
+      "post": {
+        "summary": "stop clusters",
+        "operationId": "StopClusters",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixStopClustersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixStopClustersRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/subnets": {
+      "get": {
+        "summary": "describe subnets",
+        "operationId": "DescribeSubnets",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeSubnetsResponse"
             }
           }
         },
@@ -1928,46 +1980,32 @@ var Files = map[string]string{
             "name": "runtime_id",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "string"
           },
           {
-            "name": "status",
+            "name": "limit",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "provider",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "integer",
+            "format": "int64"
           },
           {
-            "name": "search_word",
+            "name": "offset",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "integer",
+            "format": "int64"
           },
           {
-            "name": "label",
+            "name": "subnet_type.value",
+            "description": "The uint32 value.",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "integer",
+            "format": "int64"
           },
           {
-            "name": "owner",
+            "name": "subnet_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1977,32 +2015,40 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "limit",
+            "name": "zone",
             "in": "query",
             "required": false,
-            "type": "integer",
-            "format": "int64"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "offset",
+            "name": "advanced_param",
             "in": "query",
             "required": false,
-            "type": "integer",
-            "format": "int64"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           }
         ],
         "tags": [
-          "RuntimeManager"
+          "ClusterManager"
         ]
-      },
-      "delete": {
-        "summary": "delete runtimes",
-        "operationId": "DeleteRuntimes",
+      }
+    },
+    "/v1/clusters/update_env": {
+      "patch": {
+        "summary": "update cluster env",
+        "operationId": "UpdateClusterEnv",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteRuntimesResponse"
+              "$ref": "#/definitions/openpitrixUpdateClusterEnvResponse"
             }
           }
         },
@@ -2012,47 +2058,24 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteRuntimesRequest"
+              "$ref": "#/definitions/openpitrixUpdateClusterEnvRequest"
             }
           }
         ],
         "tags": [
-          "RuntimeManager"
+          "ClusterManager"
         ]
-      },
+      }
+    },
+    "/v1/clusters/upgrade": {
       "post": {
-        "summary": "create runtime",
-        "operationId": "CreateRuntime",
-        "responses": {
-          "200": {
-            "description": "",
-            "schema": {
-              "$ref": "#/definitions/openpitrixCreateRuntimeResponse"
-            }
-          }
-        },
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixCreateRuntimeRequest"
-            }
-          }
-        ],
-        "tags": [
-          "RuntimeManager"
-        ]
-      },
-      "patch": {
-        "summary": "modify runtime",
-        "operationId": "ModifyRuntime",
+        "summary": "upgrade cluster",
+        "operationId": "UpgradeCluster",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyRuntimeResponse"
+              "$ref": "#/definitions/openpitrixUpgradeClusterResponse"
             }
           }
         },
@@ -2062,84 +2085,121 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyRuntimeRequest"
+              "$ref": "#/definitions/openpitrixUpgradeClusterRequest"
             }
           }
         ],
         "tags": [
-          "RuntimeManager"
-        ]
-      }
-    },
-    "/v1/runtimes/statistics": {
-      "get": {
-        "summary": "get runtime statistics",
-        "operationId": "GetRuntimeStatistics",
-        "responses": {
-          "200": {
-            "description": "",
-            "schema": {
-              "$ref": "#/definitions/openpitrixGetRuntimeStatisticsResponse"
-            }
-          }
-        },
-        "tags": [
-          "RuntimeManager"
+          "ClusterManager"
         ]
       }
     },
-    "/v1/runtimes/zones": {
+    "/v1/jobs": {
       "get": {
-        "summary": "describe runtime provider zones",
-        "operationId": "DescribeRuntimeProviderZones",
+        "summary": "describe jobs with filter",
+        "operationId": "DescribeJobs",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeRuntimeProviderZonesResponse"
+              "$ref": "#/definitions/openpitrixDescribeJobsResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "provider",
+            "name": "job_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "cluster_id",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "runtime_url",
+            "name": "app_id",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "runtime_credential",
+            "name": "version_id",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "executor",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "provider",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "limit",
+            "description": "default is 20, max value is 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "default is 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "search_word",
             "in": "query",
             "required": false,
             "type": "string"
           }
         ],
         "tags": [
-          "RuntimeManager"
+          "JobManager"
         ]
       }
     },
-    "/v1/tasks": {
+    "/v1/repos": {
       "get": {
-        "summary": "describe tasks with filter",
-        "operationId": "DescribeTasks",
+        "summary": "describe repos with filter",
+        "operationId": "DescribeRepos",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeTasksResponse"
+              "$ref": "#/definitions/openpitrixDescribeReposResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "task_id",
+            "name": "repo_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -2149,7 +2209,7 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "job_id",
+            "name": "name",
             "in": "query",
             "required": false,
             "type": "array",
@@ -2159,16 +2219,24 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "executor",
+            "name": "type",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "target",
+            "name": "visibility",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
             "name": "status",
@@ -2180,9 +2248,30 @@ var Files = map[string]string{
             },
             "collectionFormat": "multi"
           },
+          {
+            "name": "provider",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "label",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "selector",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
           {
             "name": "limit",
-            "description": "default is 20, max value is 200.",
             "in": "query",
             "required": false,
             "type": "integer",
@@ -2190,25 +2279,654 @@ var Files = map[string]string{
           },
           {
             "name": "offset",
-            "description": "default is 0.",
             "in": "query",
             "required": false,
             "type": "integer",
             "format": "int64"
           },
+          {
+            "name": "category_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
           {
             "name": "search_word",
             "in": "query",
             "required": false,
             "type": "string"
-          }
-        ],
-        "tags": [
-          "TaskManager"
-        ]
-      }
-    },
-    "/v1/tasks/retry": {
+          },
+          {
+            "name": "sort_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          }
+        ],
+        "tags": [
+          "RepoManager"
+        ]
+      },
+      "delete": {
+        "summary": "delete repos",
+        "operationId": "DeleteRepos",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteReposResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteReposRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RepoManager"
+        ]
+      },
+      "post": {
+        "summary": "create repo",
+        "operationId": "CreateRepo",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateRepoResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateRepoRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RepoManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify repo",
+        "operationId": "ModifyRepo",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyRepoResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyRepoRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RepoManager"
+        ]
+      }
+    },
+    "/v1/repos/validate": {
+      "get": {
+        "summary": "validate repo",
+        "operationId": "ValidateRepo",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixValidateRepoResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "type",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "url",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "credential",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "RepoManager"
+        ]
+      }
+    },
+    "/v1/repo_events": {
+      "get": {
+        "summary": "describe repo events",
+        "operationId": "DescribeRepoEvents",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeRepoEventsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "repo_event_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "repo_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "owner",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "RepoIndexer"
+        ]
+      }
+    },
+    "/v1/repos/index": {
+      "post": {
+        "summary": "start a index repo event",
+        "operationId": "IndexRepo",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixIndexRepoResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixIndexRepoRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RepoIndexer"
+        ]
+      }
+    },
+    "/v1/encryption_key/rotate": {
+      "post": {
+        "summary": "rotate encryption key of credentials",
+        "operationId": "RotateEncryptionKey",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixRotateEncryptionKeyResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixRotateEncryptionKeyRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      }
+    },
+    "/v1/runtimes": {
+      "get": {
+        "summary": "describe runtime",
+        "operationId": "DescribeRuntimes",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeRuntimesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "runtime_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "provider",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "label",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "owner",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "health_status",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "repo_id",
+            "description": "runtimes whose labels match the selectors of the repo.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      },
+      "delete": {
+        "summary": "delete runtimes",
+        "operationId": "DeleteRuntimes",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteRuntimesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteRuntimesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      },
+      "post": {
+        "summary": "create runtime",
+        "operationId": "CreateRuntime",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateRuntimeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateRuntimeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify runtime",
+        "operationId": "ModifyRuntime",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyRuntimeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyRuntimeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      }
+    },
+    "/v1/runtimes/prices": {
+      "get": {
+        "summary": "describe price sheets of runtimes",
+        "operationId": "DescribeRuntimePrices",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeRuntimePricesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "runtime_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      },
+      "patch": {
+        "summary": "create or modify price sheet of runtime",
+        "operationId": "ModifyRuntimePrice",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyRuntimePriceResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyRuntimePriceRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      }
+    },
+    "/v1/runtimes/statistics": {
+      "get": {
+        "summary": "get runtime statistics",
+        "operationId": "GetRuntimeStatistics",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixGetRuntimeStatisticsResponse"
+            }
+          }
+        },
+        "tags": [
+          "RuntimeManager"
+        ]
+      }
+    },
+    "/v1/runtimes/zones": {
+      "get": {
+        "summary": "describe runtime provider zones",
+        "operationId": "DescribeRuntimeProviderZones",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeRuntimeProviderZonesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "provider",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "runtime_url",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "runtime_credential",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      }
+    },
+    "/v1/tasks": {
+      "get": {
+        "summary": "describe tasks with filter",
+        "operationId": "DescribeTasks",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeTasksResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "task_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "job_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "executor",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "target",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "limit",
+            "description": "default is 20, max value is 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "default is 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TaskManager"
+        ]
+      }
+    },
+    "/v1/tasks/retry": {
       "post": {
         "summary": "retry tasks",
         "operationId": "RetryTasks",
@@ -2290,66 +3008,376 @@ var Files = map[string]string{
           "type": "string",
           "format": "date-time"
         },
-        "update_time": {
-          "type": "string",
-          "format": "date-time"
+        "update_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "category_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixResourceCategory"
+          }
+        },
+        "latest_app_version": {
+          "$ref": "#/definitions/openpitrixAppVersion"
+        }
+      }
+    },
+    "openpitrixAppAttachment": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "the path in the package, the extension decides the content type"
+        },
+        "content": {
+          "type": "string",
+          "format": "byte"
+        }
+      },
+      "title": "AppAttachment is a file of the package shown with the app, e.g. the icon"
+    },
+    "openpitrixAppVersion": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
+        },
+        "app_id": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "package_name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "update_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "sequence": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "signature_status": {
+          "type": "string",
+          "title": "verified/unverified, empty if the repo has no trusted keys"
+        },
+        "upgrade_from": {
+          "type": "string",
+          "title": "semver constraint of the versions that can upgrade to this version"
+        }
+      }
+    },
+    "openpitrixAppVersionAudit": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
+        },
+        "app_id": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "status of the app version after the transition"
+        },
+        "operator": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "openpitrixCreateAppRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "repo_id": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "chart_name": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "home": {
+          "type": "string"
+        },
+        "icon": {
+          "type": "string"
+        },
+        "screenshots": {
+          "type": "string"
+        },
+        "maintainers": {
+          "type": "string"
+        },
+        "sources": {
+          "type": "string"
+        },
+        "readme": {
+          "type": "string"
+        },
+        "category_id": {
+          "type": "string"
+        },
+        "keywords": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixCreateAppResponse": {
+      "type": "object",
+      "properties": {
+        "app_id": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixCreateAppVersionRequest": {
+      "type": "object",
+      "properties": {
+        "app_id": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "package_name": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "sequence": {
+          "$ref": "#/definitions/protobufUInt32Value"
+        },
+        "upgrade_from": {
+          "type": "string",
+          "title": "semver constraint of the versions that can upgrade to this version, e.g. \">=1.0.0, <2.0.0\""
+        }
+      }
+    },
+    "openpitrixCreateAppVersionResponse": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixDeleteAppVersionsRequest": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "openpitrixDeleteAppVersionsResponse": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "openpitrixDeleteAppsRequest": {
+      "type": "object",
+      "properties": {
+        "app_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "openpitrixDeleteAppsResponse": {
+      "type": "object",
+      "properties": {
+        "app_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "openpitrixDescribeAppVersionAuditsResponse": {
+      "type": "object",
+      "properties": {
+        "total_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "app_version_audit_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixAppVersionAudit"
+          }
+        }
+      }
+    },
+    "openpitrixDescribeAppVersionsResponse": {
+      "type": "object",
+      "properties": {
+        "total_count": {
+          "type": "integer",
+          "format": "int64"
         },
-        "category_set": {
+        "app_version_set": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/openpitrixResourceCategory"
+            "$ref": "#/definitions/openpitrixAppVersion"
           }
+        }
+      }
+    },
+    "openpitrixDescribeAppsResponse": {
+      "type": "object",
+      "properties": {
+        "total_count": {
+          "type": "integer",
+          "format": "int64"
         },
-        "latest_app_version": {
-          "$ref": "#/definitions/openpitrixAppVersion"
+        "app_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixApp"
+          }
         }
       }
     },
-    "openpitrixAppVersion": {
+    "openpitrixGetAppAttachmentResponse": {
       "type": "object",
       "properties": {
-        "version_id": {
+        "attachment_id": {
           "type": "string"
         },
-        "app_id": {
+        "content_type": {
           "type": "string"
         },
-        "owner": {
-          "type": "string"
+        "content": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "openpitrixGetAppStatisticsResponse": {
+      "type": "object",
+      "properties": {
+        "last_two_week_created": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
         },
-        "name": {
-          "type": "string"
+        "top_ten_repos": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
         },
-        "description": {
-          "type": "string"
+        "app_count": {
+          "type": "integer",
+          "format": "int64"
         },
-        "package_name": {
-          "type": "string"
+        "repo_count": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "openpitrixGetAppVersionPackageFilesResponse": {
+      "type": "object",
+      "properties": {
+        "files": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string",
+            "format": "byte"
+          }
         },
-        "status": {
+        "version_id": {
           "type": "string"
-        },
-        "create_time": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "status_time": {
+        }
+      }
+    },
+    "openpitrixGetAppVersionPackageResponse": {
+      "type": "object",
+      "properties": {
+        "package": {
           "type": "string",
-          "format": "date-time"
+          "format": "byte"
         },
-        "update_time": {
-          "type": "string",
-          "format": "date-time"
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixGetAppVersionUpgradePathsResponse": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
         },
-        "sequence": {
-          "type": "integer",
-          "format": "int64"
+        "app_version_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixAppVersion"
+          },
+          "title": "the active versions that the version can upgrade to, in semver order"
         }
       }
     },
-    "openpitrixCreateAppRequest": {
+    "openpitrixModifyAppRequest": {
       "type": "object",
       "properties": {
+        "app_id": {
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
@@ -2391,7 +3419,7 @@ var Files = map[string]string{
         }
       }
     },
-    "openpitrixCreateAppResponse": {
+    "openpitrixModifyAppResponse": {
       "type": "object",
       "properties": {
         "app_id": {
@@ -2399,13 +3427,10 @@ var Files = map[string]string{
         }
       }
     },
-    "openpitrixCreateAppVersionRequest": {
+    "openpitrixModifyAppVersionRequest": {
       "type": "object",
       "properties": {
-        "app_id": {
-          "type": "string"
-        },
-        "owner": {
+        "version_id": {
           "type": "string"
         },
         "name": {
@@ -2414,15 +3439,22 @@ var Files = map[string]string{
         "package_name": {
           "type": "string"
         },
+        "owner": {
+          "type": "string"
+        },
         "description": {
           "type": "string"
         },
         "sequence": {
           "$ref": "#/definitions/protobufUInt32Value"
+        },
+        "upgrade_from": {
+          "type": "string",
+          "title": "semver constraint of the versions that can upgrade to this version, e.g. \">=1.0.0, <2.0.0\""
         }
       }
     },
-    "openpitrixCreateAppVersionResponse": {
+    "openpitrixModifyAppVersionResponse": {
       "type": "object",
       "properties": {
         "version_id": {
@@ -2430,242 +3462,229 @@ var Files = map[string]string{
         }
       }
     },
-    "openpitrixDeleteAppVersionsRequest": {
+    "openpitrixModifyAppVersionSignatureResponse": {
       "type": "object",
       "properties": {
         "version_id": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+          "type": "string"
         }
       }
     },
-    "openpitrixDeleteAppVersionsResponse": {
+    "openpitrixPassAppVersionRequest": {
       "type": "object",
       "properties": {
         "version_id": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
         }
       }
     },
-    "openpitrixDeleteAppsRequest": {
+    "openpitrixPassAppVersionResponse": {
       "type": "object",
       "properties": {
-        "app_id": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "version_id": {
+          "type": "string"
         }
       }
     },
-    "openpitrixDeleteAppsResponse": {
+    "openpitrixRejectAppVersionRequest": {
       "type": "object",
       "properties": {
-        "app_id": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "version_id": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string",
+          "title": "reason of the rejection, required"
+        }
+      }
+    },
+    "openpitrixRejectAppVersionResponse": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixReleaseAppVersionRequest": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixReleaseAppVersionResponse": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixResourceCategory": {
+      "type": "object",
+      "properties": {
+        "category_id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "locale": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "openpitrixReviewAppVersionRequest": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixReviewAppVersionResponse": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string"
         }
       }
     },
-    "openpitrixDescribeAppVersionsResponse": {
+    "openpitrixSearchAppsResponse": {
       "type": "object",
       "properties": {
         "total_count": {
           "type": "integer",
           "format": "int64"
         },
-        "app_version_set": {
+        "app_set": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/openpitrixAppVersion"
-          }
+            "$ref": "#/definitions/openpitrixApp"
+          },
+          "title": "ranked by the relevance to search_word"
+        },
+        "facet_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixSearchFacet"
+          },
+          "title": "the number of the matched apps of each value, the filter of the facet itself is ignored"
         }
       }
     },
-    "openpitrixDescribeAppsResponse": {
+    "openpitrixSearchFacet": {
       "type": "object",
       "properties": {
-        "total_count": {
-          "type": "integer",
-          "format": "int64"
+        "name": {
+          "type": "string",
+          "title": "category_id, repo_id, provider or status"
         },
-        "app_set": {
+        "value_set": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/openpitrixApp"
+            "$ref": "#/definitions/openpitrixSearchFacetValue"
           }
         }
       }
     },
-    "openpitrixGetAppStatisticsResponse": {
+    "openpitrixSearchFacetValue": {
       "type": "object",
       "properties": {
-        "last_two_week_created": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "integer",
-            "format": "int64"
-          }
-        },
-        "top_ten_repos": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "integer",
-            "format": "int64"
-          }
-        },
-        "app_count": {
-          "type": "integer",
-          "format": "int64"
+        "value": {
+          "type": "string"
         },
-        "repo_count": {
+        "count": {
           "type": "integer",
           "format": "int64"
         }
       }
     },
-    "openpitrixGetAppVersionPackageFilesResponse": {
+    "openpitrixSubmitAppVersionRequest": {
       "type": "object",
       "properties": {
-        "files": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string",
-            "format": "byte"
-          }
-        },
         "version_id": {
           "type": "string"
+        },
+        "message": {
+          "type": "string"
         }
       }
     },
-    "openpitrixGetAppVersionPackageResponse": {
+    "openpitrixSubmitAppVersionResponse": {
       "type": "object",
       "properties": {
-        "package": {
-          "type": "string",
-          "format": "byte"
-        },
         "version_id": {
           "type": "string"
         }
       }
     },
-    "openpitrixModifyAppRequest": {
+    "openpitrixSuspendAppVersionRequest": {
       "type": "object",
       "properties": {
-        "app_id": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "repo_id": {
-          "type": "string"
-        },
-        "owner": {
-          "type": "string"
-        },
-        "chart_name": {
-          "type": "string"
-        },
-        "description": {
-          "type": "string"
-        },
-        "home": {
-          "type": "string"
-        },
-        "icon": {
-          "type": "string"
-        },
-        "screenshots": {
-          "type": "string"
-        },
-        "maintainers": {
-          "type": "string"
-        },
-        "sources": {
-          "type": "string"
-        },
-        "readme": {
-          "type": "string"
-        },
-        "category_id": {
+        "version_id": {
           "type": "string"
         },
-        "keywords": {
+        "message": {
           "type": "string"
         }
       }
     },
-    "openpitrixModifyAppResponse": {
+    "openpitrixSuspendAppVersionResponse": {
       "type": "object",
       "properties": {
-        "app_id": {
+        "version_id": {
           "type": "string"
         }
       }
     },
-    "openpitrixModifyAppVersionRequest": {
+    "openpitrixUploadAppAttachmentsResponse": {
       "type": "object",
       "properties": {
-        "version_id": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "package_name": {
-          "type": "string"
-        },
-        "owner": {
-          "type": "string"
-        },
-        "description": {
+        "app_id": {
           "type": "string"
-        },
-        "sequence": {
-          "$ref": "#/definitions/protobufUInt32Value"
         }
       }
     },
-    "openpitrixModifyAppVersionResponse": {
+    "openpitrixUploadAppVersionPackageRequest": {
       "type": "object",
       "properties": {
         "version_id": {
           "type": "string"
+        },
+        "package": {
+          "type": "string",
+          "format": "byte"
         }
       }
     },
-    "openpitrixResourceCategory": {
+    "openpitrixUploadAppVersionPackageResponse": {
       "type": "object",
       "properties": {
-        "category_id": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "locale": {
-          "type": "string"
-        },
-        "status": {
+        "version_id": {
           "type": "string"
-        },
-        "create_time": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "status_time": {
-          "type": "string",
-          "format": "date-time"
         }
       }
     },
@@ -2984,6 +4003,16 @@ var Files = map[string]string{
           "items": {
             "$ref": "#/definitions/openpitrixClusterLoadbalancer"
           }
+        },
+        "managed_vpc_id": {
+          "type": "string",
+          "title": "network resources created by openpitrix for the cluster"
+        },
+        "managed_subnet_id": {
+          "type": "string"
+        },
+        "managed_eip_id": {
+          "type": "string"
         }
       }
     },
@@ -3069,6 +4098,39 @@ var Files = map[string]string{
         },
         "hypervisor": {
           "type": "string"
+        },
+        "logs": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixClusterCost": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string"
+        },
+        "runtime_id": {
+          "type": "string"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "role_cost_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixRoleCost"
+          },
+          "title": "cost per hour of the nodes not deleted"
+        },
+        "hourly": {
+          "type": "number",
+          "format": "double"
+        },
+        "accumulated": {
+          "type": "number",
+          "format": "double",
+          "title": "cost of all the nodes from their creation to now or their deletion"
         }
       }
     },
@@ -3196,6 +4258,33 @@ var Files = map[string]string{
           "items": {
             "type": "string"
           }
+        },
+        "eip_id": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixClusterNodeLog": {
+      "type": "object",
+      "properties": {
+        "node_id": {
+          "type": "string"
+        },
+        "role": {
+          "type": "string"
+        },
+        "log_name": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "content": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string",
+          "title": "set if the log of the node can not be fetched"
         }
       }
     },
@@ -3234,6 +4323,10 @@ var Files = map[string]string{
         },
         "env": {
           "type": "string"
+        },
+        "public_endpoint": {
+          "type": "boolean",
+          "format": "boolean"
         }
       }
     },
@@ -3386,6 +4479,28 @@ var Files = map[string]string{
     "openpitrixDeleteNodeKeyPairsResponse": {
       "type": "object"
     },
+    "openpitrixDescribeClusterCostsResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_cost_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixClusterCost"
+          }
+        }
+      }
+    },
+    "openpitrixDescribeClusterNodeLogsResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_node_log_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixClusterNodeLog"
+          }
+        }
+      }
+    },
     "openpitrixDescribeClusterNodesResponse": {
       "type": "object",
       "properties": {
@@ -3431,6 +4546,29 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixDescribeRuntimeUsageResponse": {
+      "type": "object",
+      "properties": {
+        "usage_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixRuntimeUsage"
+          }
+        },
+        "quota_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixRuntimeQuota"
+          }
+        },
+        "snapshot_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixRuntimeUsage"
+          }
+        }
+      }
+    },
     "openpitrixDescribeSubnetsResponse": {
       "type": "object",
       "properties": {
@@ -3474,6 +4612,48 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixEstimateClusterCostRequest": {
+      "type": "object",
+      "properties": {
+        "app_id": {
+          "type": "string"
+        },
+        "version_id": {
+          "type": "string"
+        },
+        "conf": {
+          "type": "string"
+        },
+        "runtime_id": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixEstimateClusterCostResponse": {
+      "type": "object",
+      "properties": {
+        "runtime_id": {
+          "type": "string"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "role_cost_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixRoleCost"
+          }
+        },
+        "hourly": {
+          "type": "number",
+          "format": "double"
+        },
+        "monthly": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
     "openpitrixGetClusterStatisticsResponse": {
       "type": "object",
       "properties": {
@@ -3671,6 +4851,44 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixRoleCost": {
+      "type": "object",
+      "properties": {
+        "role": {
+          "type": "string"
+        },
+        "node_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "cpu": {
+          "type": "number",
+          "format": "double"
+        },
+        "memory": {
+          "type": "number",
+          "format": "double"
+        },
+        "gpu": {
+          "type": "number",
+          "format": "double"
+        },
+        "volume": {
+          "type": "number",
+          "format": "double"
+        },
+        "instance_class": {
+          "type": "number",
+          "format": "double",
+          "title": "replaces the cost of cpu and memory if the nodes are in a priced instance class"
+        },
+        "total": {
+          "type": "number",
+          "format": "double"
+        }
+      },
+      "description": "RoleCost is the cost per hour of all the nodes of the role."
+    },
     "openpitrixRollbackClusterRequest": {
       "type": "object",
       "properties": {
@@ -3696,6 +4914,82 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixRuntimeQuota": {
+      "type": "object",
+      "properties": {
+        "runtime_id": {
+          "type": "string"
+        },
+        "instance": {
+          "type": "integer",
+          "format": "int32",
+          "title": "quotas left in the runtime, -1 means unlimited"
+        },
+        "cpu": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "gpu": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "memory": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "volume": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "volume_size": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "error": {
+          "type": "string",
+          "title": "error of describing the quotas by the provider"
+        }
+      }
+    },
+    "openpitrixRuntimeUsage": {
+      "type": "object",
+      "properties": {
+        "runtime_id": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "cluster_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "node_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "cpu": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "gpu": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "memory": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "volume_size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "openpitrixStartClustersRequest": {
       "type": "object",
       "properties": {
@@ -3939,6 +5233,98 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixCheckResourceResponse": {
+      "type": "object",
+      "properties": {
+        "ok": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "openpitrixDescribeProviderResponse": {
+      "type": "object",
+      "properties": {
+        "provider": {
+          "type": "string"
+        },
+        "vm_based": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "openpitrixDescribeVpcResponse": {
+      "type": "object",
+      "properties": {
+        "vpc": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixDescribeZonesResponse": {
+      "type": "object",
+      "properties": {
+        "zone": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "openpitrixHandleSubtaskResponse": {
+      "type": "object",
+      "properties": {
+        "task": {
+          "type": "string",
+          "title": "the task with the directive written back"
+        }
+      }
+    },
+    "openpitrixParseClusterConfResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_wrapper": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixSplitJobIntoTasksResponse": {
+      "type": "object",
+      "properties": {
+        "task_layer": {
+          "type": "string"
+        }
+      }
+    },
+    "openpitrixUpdateClusterStatusResponse": {
+      "type": "object",
+      "properties": {
+        "ok": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "openpitrixValidateCredentialResponse": {
+      "type": "object",
+      "properties": {
+        "ok": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "openpitrixWaitSubtaskResponse": {
+      "type": "object",
+      "properties": {
+        "task": {
+          "type": "string",
+          "title": "the task with the directive written back"
+        }
+      }
+    },
     "openpitrixCreateRepoRequest": {
       "type": "object",
       "properties": {
@@ -3980,6 +5366,10 @@ var Files = map[string]string{
         },
         "category_id": {
           "type": "string"
+        },
+        "trusted_keys": {
+          "type": "string",
+          "title": "public keys to verify the provenance of the packages, one per line"
         }
       }
     },
@@ -4072,6 +5462,9 @@ var Files = map[string]string{
         },
         "category_id": {
           "type": "string"
+        },
+        "trusted_keys": {
+          "type": "string"
         }
       }
     },
@@ -4144,6 +5537,9 @@ var Files = map[string]string{
           "items": {
             "$ref": "#/definitions/openpitrixResourceCategory"
           }
+        },
+        "trusted_keys": {
+          "type": "string"
         }
       }
     },
@@ -4169,6 +5565,18 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixRotateRepoEncryptionKeyResponse": {
+      "type": "object",
+      "properties": {
+        "key_id": {
+          "type": "string"
+        },
+        "repo_count": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "openpitrixValidateRepoResponse": {
       "type": "object",
       "properties": {
@@ -4315,6 +5723,17 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixDescribeRuntimePricesResponse": {
+      "type": "object",
+      "properties": {
+        "runtime_price_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixRuntimePrice"
+          }
+        }
+      }
+    },
     "openpitrixDescribeRuntimeProviderZonesResponse": {
       "type": "object",
       "properties": {
@@ -4371,6 +5790,49 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixModifyRuntimePriceRequest": {
+      "type": "object",
+      "properties": {
+        "runtime_id": {
+          "type": "string"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "cpu_hour": {
+          "type": "number",
+          "format": "double"
+        },
+        "memory_gb_hour": {
+          "type": "number",
+          "format": "double"
+        },
+        "volume_gb_month": {
+          "type": "number",
+          "format": "double"
+        },
+        "gpu_hour": {
+          "type": "number",
+          "format": "double"
+        },
+        "instance_class_hour": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          },
+          "title": "replaces all the instance classes if not empty"
+        }
+      }
+    },
+    "openpitrixModifyRuntimePriceResponse": {
+      "type": "object",
+      "properties": {
+        "runtime_id": {
+          "type": "string"
+        }
+      }
+    },
     "openpitrixModifyRuntimeRequest": {
       "type": "object",
       "properties": {
@@ -4396,6 +5858,32 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixRotateEncryptionKeyRequest": {
+      "type": "object",
+      "properties": {
+        "key_id": {
+          "type": "string",
+          "title": "the expected current master key, the rotation is refused if the master\nkeys of runtime manager or repo manager are not updated yet"
+        }
+      }
+    },
+    "openpitrixRotateEncryptionKeyResponse": {
+      "type": "object",
+      "properties": {
+        "key_id": {
+          "type": "string",
+          "title": "the master key that wraps the data keys of the credentials now"
+        },
+        "runtime_credential_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "repo_count": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "openpitrixRuntime": {
       "type": "object",
       "properties": {
@@ -4436,6 +5924,21 @@ var Files = map[string]string{
         "status_time": {
           "type": "string",
           "format": "date-time"
+        },
+        "health_status": {
+          "type": "string",
+          "title": "healthy, unhealthy or unknown before the first check"
+        },
+        "last_error": {
+          "type": "string"
+        },
+        "api_latency": {
+          "$ref": "#/definitions/protobufUInt32Value",
+          "title": "latency of the provider api in milliseconds"
+        },
+        "health_check_time": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
@@ -4471,6 +5974,54 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixRuntimePrice": {
+      "type": "object",
+      "properties": {
+        "runtime_id": {
+          "type": "string"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "cpu_hour": {
+          "type": "number",
+          "format": "double",
+          "title": "price of a cpu core per hour"
+        },
+        "memory_gb_hour": {
+          "type": "number",
+          "format": "double",
+          "title": "price of 1 GB memory per hour"
+        },
+        "volume_gb_month": {
+          "type": "number",
+          "format": "double",
+          "title": "price of 1 GB volume per month of 30 days"
+        },
+        "gpu_hour": {
+          "type": "number",
+          "format": "double",
+          "title": "price of a gpu per hour"
+        },
+        "instance_class_hour": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          },
+          "title": "price per hour of the instance classes named by cpu and memory in MB,\ne.g. \"2c4096m\", which replaces the price of the cpu and memory"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "description": "RuntimePrice is the price sheet of the resources in the runtime."
+    },
     "openpitrixCreateTaskResponse": {
       "type": "object",
       "properties": {
@@ -0,0 +1,76 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package apigateway
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	appclient "openpitrix.io/openpitrix/pkg/client/app"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/senderutil"
+)
+
+const uploadAppVersionPackagePath = "/v1/app_version/package"
+
+// uploadAppVersionPackageHandler accepts the package as multipart form with
+// the fields version_id and package, e.g.
+//
+//	curl -F version_id=appv-xxx -F package=@nginx-0.1.0.tgz .../v1/app_version/package
+//
+// the other requests of the path, including the json form, go to the gateway.
+func uploadAppVersionPackageHandler(gwmux *runtime.ServeMux) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			gwmux.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := senderutil.NewContext(r.Context(), senderutil.AuthUserInfo(r.Header.Get("X-Auth-Key")))
+		_, outbound := runtime.MarshalerForRequest(gwmux, r)
+
+		r.Body = http.MaxBytesReader(w, r.Body, constants.MaxAppPackageSize)
+		if err := r.ParseMultipartForm(constants.MaxAppPackageSize); err != nil {
+			runtime.HTTPError(ctx, gwmux, outbound, w, r,
+				gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorParameterParseFailed, "package"))
+			return
+		}
+		f, _, err := r.FormFile("package")
+		if err != nil {
+			runtime.HTTPError(ctx, gwmux, outbound, w, r,
+				gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorMissingParameter, "package"))
+			return
+		}
+		defer f.Close()
+		content, err := ioutil.ReadAll(f)
+		if err != nil {
+			runtime.HTTPError(ctx, gwmux, outbound, w, r,
+				gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorParameterParseFailed, "package"))
+			return
+		}
+
+		client, err := appclient.NewAppManagerClient()
+		if err != nil {
+			runtime.HTTPError(ctx, gwmux, outbound, w, r,
+				gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError))
+			return
+		}
+		res, err := client.UploadAppVersionPackage(ctx, &pb.UploadAppVersionPackageRequest{
+			VersionId: pbutil.ToProtoString(r.FormValue("version_id")),
+			Package:   content,
+		})
+		if err != nil {
+			runtime.HTTPError(ctx, gwmux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, gwmux, outbound, w, r, res)
+	}
+}
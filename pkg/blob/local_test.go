@@ -0,0 +1,51 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package blob
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blob-local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewLocalStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := "apps/app-1/appv-1.tgz"
+	if _, err := s.Read(key); err != ErrNotFound {
+		t.Fatalf("expect ErrNotFound, got [%v]", err)
+	}
+	if err := s.Write(key, []byte("package")); err != nil {
+		t.Fatal(err)
+	}
+	data, err := s.Read(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "package" {
+		t.Fatalf("unexpected content [%s]", data)
+	}
+	if err := s.Delete(key); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Read(key); err != ErrNotFound {
+		t.Fatalf("expect ErrNotFound after delete, got [%v]", err)
+	}
+
+	for _, key := range []string{"", "/etc/passwd", "../a.tgz", "apps/../../a.tgz"} {
+		if err := s.Write(key, nil); err == nil {
+			t.Fatalf("expect error for key [%s]", key)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package blob
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// S3Store keeps the blobs in a bucket of any S3 compatible service.
+type S3Store struct {
+	bucket string
+	svc    *s3.S3
+}
+
+func NewS3Store(endpoint, region, bucket, accessKeyId, secretAccessKey string) (*S3Store, error) {
+	config := &aws.Config{
+		Region:      aws.String(region),
+		Endpoint:    aws.String(endpoint),
+		Credentials: credentials.NewStaticCredentials(accessKeyId, secretAccessKey, ""),
+		// most of the S3 compatible services do not resolve the bucket domain
+		S3ForcePathStyle: aws.Bool(true),
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+	return &S3Store{bucket: bucket, svc: s3.New(sess)}, nil
+}
+
+func (s *S3Store) Write(key string, data []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	_, err := s.svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s *S3Store) Read(key string) ([]byte, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	output, err := s.svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	defer output.Body.Close()
+	return ioutil.ReadAll(output.Body)
+}
+
+func (s *S3Store) Delete(key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	_, err := s.svc.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
@@ -0,0 +1,62 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package blob stores the files uploaded to OpenPitrix, e.g. the packages of
+// the app versions that are not in any repo.
+package blob
+
+import (
+	"fmt"
+	"strings"
+
+	"openpitrix.io/openpitrix/pkg/config"
+)
+
+// UrlPrefix marks the package names that are kept in the store instead of
+// an external repo.
+const UrlPrefix = "blob://"
+
+var ErrNotFound = fmt.Errorf("blob not found")
+
+type Store interface {
+	Write(key string, data []byte) error
+	Read(key string) ([]byte, error)
+	Delete(key string) error
+}
+
+func NewStore(cfg config.StorageConfig) (Store, error) {
+	switch cfg.Type {
+	case "local":
+		return NewLocalStore(cfg.Path)
+	case "s3":
+		return NewS3Store(cfg.Endpoint, cfg.Region, cfg.Bucket, cfg.AccessKeyId, cfg.SecretAccessKey)
+	default:
+		return nil, fmt.Errorf("unsupported storage type [%s]", cfg.Type)
+	}
+}
+
+func Url(key string) string {
+	return UrlPrefix + key
+}
+
+func IsBlobUrl(url string) bool {
+	return strings.HasPrefix(url, UrlPrefix)
+}
+
+// GetKey returns the key of the blob url.
+func GetKey(url string) string {
+	return strings.TrimPrefix(url, UrlPrefix)
+}
+
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return fmt.Errorf("invalid blob key [%s]", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("invalid blob key [%s]", key)
+		}
+	}
+	return nil
+}
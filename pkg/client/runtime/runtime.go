@@ -19,6 +19,7 @@ import (
 type Runtime struct {
 	models.Runtime
 	Credential string
+	Labels     map[string]string
 }
 
 func NewRuntime(runtimeId string) (*Runtime, error) {
@@ -30,11 +31,14 @@ func NewRuntime(runtimeId string) (*Runtime, error) {
 	zone := runtime.GetRuntime().GetZone().GetValue()
 	result := &Runtime{
 		Credential: runtime.GetRuntimeCredential().GetValue(),
+		Labels:     models.PbToRuntimeLabelMap(runtime.GetRuntime().GetLabels()),
 	}
 	result.RuntimeId = runtimeId
 	result.Provider = provider
 	result.Zone = zone
 	result.RuntimeUrl = runtime.GetRuntime().GetRuntimeUrl().GetValue()
+	result.HealthStatus = runtime.GetRuntime().GetHealthStatus().GetValue()
+	result.LastError = runtime.GetRuntime().GetLastError().GetValue()
 	return result, nil
 }
 
@@ -63,3 +67,26 @@ func getRuntime(runtimeId string) (*pb.RuntimeDetail, error) {
 
 	return response.RuntimeDetailSet[0], nil
 }
+
+// GetRuntimePrices returns the price sheets of the runtimes by runtime id,
+// the runtimes without price sheet are not in the map.
+func GetRuntimePrices(runtimeIds []string) (map[string]*models.RuntimePrice, error) {
+	ctx := clientutil.GetSystemUserContext()
+	client, err := NewRuntimeManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.DescribeRuntimePrices(ctx, &pb.DescribeRuntimePricesRequest{
+		RuntimeId: runtimeIds,
+	})
+	if err != nil {
+		logger.Error("Describe price of runtime [%s] failed: %+v",
+			strings.Join(runtimeIds, ","), err)
+		return nil, err
+	}
+	runtimePrices := make(map[string]*models.RuntimePrice)
+	for _, runtimePrice := range response.GetRuntimePriceSet() {
+		runtimePrices[runtimePrice.GetRuntimeId().GetValue()] = models.PbToRuntimePrice(runtimePrice)
+	}
+	return runtimePrices, nil
+}
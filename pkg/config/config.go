@@ -17,11 +17,14 @@ import (
 )
 
 type Config struct {
-	Log       LogConfig
-	Grpc      GrpcConfig
-	Mysql     MysqlConfig
-	Etcd      EtcdConfig
-	Profiling ProfilingConfig
+	Log        LogConfig
+	Grpc       GrpcConfig
+	Mysql      MysqlConfig
+	Etcd       EtcdConfig
+	Profiling  ProfilingConfig
+	Storage    StorageConfig
+	Encryption EncryptionConfig
+	Simulator  SimulatorConfig
 }
 
 type LogConfig struct {
@@ -45,6 +48,28 @@ type MysqlConfig struct {
 	Disable  bool   `default:"false"`
 }
 
+// StorageConfig is the blob store of the uploaded app packages.
+type StorageConfig struct {
+	Type            string `default:"local"`                   // local, s3
+	Path            string `default:"/opt/openpitrix/storage"` // root directory of the local storage
+	Endpoint        string // endpoint of the s3 compatible service, e.g. https://s3.pek3a.qingstor.com
+	Region          string
+	Bucket          string
+	AccessKeyId     string
+	SecretAccessKey string
+}
+
+// EncryptionConfig is the master keys of the credentials in database, e.g.
+// "key2:base64_key,key1:base64_key". The first key wraps the new data keys,
+// the others are kept to unwrap the data keys until they are rotated.
+type EncryptionConfig struct {
+	MasterKeys string
+}
+
+type SimulatorConfig struct {
+	StateDir string `default:"/opt/openpitrix/simulator"` // root directory of the state files of the simulator runtimes
+}
+
 type ProfilingConfig struct {
 	Enable bool `default:"false"`
 }
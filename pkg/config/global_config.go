@@ -33,6 +33,27 @@ type ClusterServiceConfig struct {
 	FrontgateAutoDelete bool     `json:"frontgate_auto_delete"`
 }
 
+// GetPluginNames returns the providers of the plugins, the provider running
+// out of process is given as "name=host:port".
+func (c ClusterServiceConfig) GetPluginNames() []string {
+	var names []string
+	for _, plugin := range c.Plugins {
+		names = append(names, strings.SplitN(plugin, "=", 2)[0])
+	}
+	return names
+}
+
+// GetPluginEndpoint returns the endpoint of the provider running out of process.
+func (c ClusterServiceConfig) GetPluginEndpoint(name string) (string, bool) {
+	for _, plugin := range c.Plugins {
+		parts := strings.SplitN(plugin, "=", 2)
+		if len(parts) == 2 && parts[0] == name {
+			return parts[1], true
+		}
+	}
+	return "", false
+}
+
 type PilotServiceConfig struct {
 	Ip string `json:"ip"`
 }
@@ -26,6 +26,7 @@ cluster:
     - qingcloud
     - kubernetes
     - aws
+    - openstack
   frontgate_conf: '{"app_id":"app-ABCDEFGHIJKLMNOPQRST","version_id":"appv-ABCDEFGHIJKLMNOPQRST","name":"frontgate","description":"OpenPitrixbuilt-infrontgateservice","subnet":"","nodes":[{"container":{"type":"docker","image":"openpitrix/openpitrix:metadata"},"count":1,"cpu":1,"memory":1024,"volume":{"size":10,"mount_point":"/data","filesystem":"ext4"}}]}'
   frontgate_auto_delete: true
 pilot:
@@ -41,4 +42,10 @@ runtime:
     zone: .*
     image_name: amzn2-ami-hvm-2.0.20180622.1-x86_64-gp2
     image_url: https://openpitrix.pek3a.qingstor.com/image/amazon-linux.tar.gz
+  # api_server of openstack is the keystone endpoint of the runtime url
+  openstack_provider:
+    api_server: keystone.openstack.local:5000/v3
+    zone: .*
+    image_name: ubuntu-16.04
+    image_url: https://openpitrix.pek3a.qingstor.com/image/ubuntu.tar.gz
 `
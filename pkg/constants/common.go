@@ -73,6 +73,33 @@ const (
 	VisibilityPrivate = "private"
 )
 
+// review status of app version, see the transitions in the app manager
+const (
+	StatusDraft     = "draft"
+	StatusSubmitted = "submitted"
+	StatusInReview  = "in-review"
+	StatusPassed    = "passed"
+	StatusRejected  = "rejected"
+	StatusSuspended = "suspended"
+)
+
+// AppAttachmentPath is where the api gateway serves the readme, icon and
+// screenshots of apps, followed by the attachment id
+const AppAttachmentPath = "/v1/app/attachments/"
+
+// health status of runtime, checked by the runtime manager periodically
+const (
+	HealthStatusUnknown   = "unknown"
+	HealthStatusHealthy   = "healthy"
+	HealthStatusUnhealthy = "unhealthy"
+)
+
+// signature status of app version, empty if the repo has no trusted keys
+const (
+	SignatureVerified   = "verified"
+	SignatureUnverified = "unverified"
+)
+
 const (
 	JobLength       = 20
 	TaskLength      = 20
@@ -98,6 +125,41 @@ const (
 	WaitDroneServiceInterval     = 10 * time.Second
 
 	GrpcToPilotTimeout = 10 * time.Second
+	SubTaskStatusTTL   = 2 * MaxTaskTimeout
+
+	FileTransferChunkSize      = 512 * 1024
+	MaxFileTransferSize        = 256 * 1024 * 1024
+	FileTransferSessionTimeout = 600 * time.Second
+
+	MaxAppPackageSize = 32 * 1024 * 1024
+	// the whole app package is sent in one grpc message, leave room for the
+	// other fields of the message
+	GrpcMaxMsgSize = MaxAppPackageSize + 1024*1024
+
+	DefaultTailLogLines = 100
+	MaxTailLogLines     = 10000
+	MaxTailLogScanSize  = 16 * 1024 * 1024
+	// count of the nodes tailed at the same time by DescribeClusterNodeLogs
+	TailLogConcurrency = 16
+
+	AgentReportInterval         = 60 * time.Second
+	AgentInfoTTL                = 10 * AgentReportInterval
+	DefaultAgentRollbackTimeout = 120 * time.Second
+	AgentRollbackWaitTimeout    = 60 * time.Second
+	AgentUpgradeStagingDir      = "/tmp/openpitrix-agent-upgrade"
+	AgentTypeFrontgate          = "frontgate"
+	AgentTypeDrone              = "drone"
+
+	RuntimeHealthCheckInterval    = 5 * time.Minute
+	RuntimeHealthCheckTimeout     = 60 * time.Second
+	RuntimeHealthCheckConcurrency = 10
+	// consecutive failed probes to mark the runtime unhealthy
+	RuntimeHealthFailureThreshold = 3
+
+	RuntimeUsageSnapshotInterval = 1 * time.Hour
+
+	DefaultCurrency = "CNY"
+	HoursPerMonth   = 720
 
 	TimeoutName           = "timeout"
 	DefaultServiceTimeout = 600
@@ -124,14 +186,11 @@ const (
 	ProviderQingCloud  = "qingcloud"
 	ProviderKubernetes = "kubernetes"
 	ProviderAWS        = "aws"
+	ProviderSimulator  = "simulator"
+	ProviderOpenStack  = "openstack"
 	TargetPilot        = "pilot"
 )
 
-var VmBaseProviders = []string{
-	ProviderQingCloud,
-	ProviderAWS,
-}
-
 const (
 	PlaceHolder       = "*"
 	ReplicaRoleSuffix = "-replica"
@@ -7,4 +7,6 @@ package constants
 const (
 	RepoIndexPrefix = "repo_index_"
 	ClusterPrefix   = "cluster_"
+
+	RuntimeHealthCheckKey = "runtime_health_check"
 )
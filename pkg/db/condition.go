@@ -98,3 +98,41 @@ func Lt(column string, value interface{}) dbr.Builder {
 func Lte(column string, value interface{}) dbr.Builder {
 	return dbr.Lte(column, value)
 }
+
+// Match is the full-text search of MySQL, it is true in WHERE if the row
+// matches and is the relevance in ORDER BY.
+// The columns must have a FULLTEXT index together.
+func Match(columns []string, value string) dbr.Builder {
+	return dbr.BuildFunc(func(d dbr.Dialect, buf dbr.Buffer) error {
+		buf.WriteString("MATCH(")
+		for i, column := range columns {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(d.QuoteIdent(column))
+		}
+		buf.WriteString(") AGAINST(")
+		buf.WriteString(placeholder)
+		buf.WriteString(" IN NATURAL LANGUAGE MODE)")
+		buf.WriteValue(value)
+		return nil
+	})
+}
+
+// GetEqValues returns the values of the Eq conditions on the column.
+func GetEqValues(column string, conds []dbr.Builder) []string {
+	var values []string
+	for _, cond := range conds {
+		c, ok := cond.(*EqCondition)
+		if !ok || c.Column != column {
+			continue
+		}
+		switch v := c.Value.(type) {
+		case string:
+			values = append(values, v)
+		case []string:
+			values = append(values, v...)
+		}
+	}
+	return values
+}
@@ -69,6 +69,11 @@ func TestCondition(t *testing.T) {
 			query: "(`a` < ?) AND ((`b` > ?) OR (`c` != ?))",
 			value: []interface{}{1, 2, 3},
 		},
+		{
+			cond:  Match([]string{"a", "b"}, "nginx"),
+			query: "MATCH(`a`, `b`) AGAINST(? IN NATURAL LANGUAGE MODE)",
+			value: []interface{}{"nginx"},
+		},
 	} {
 		buf := dbr.NewBuffer()
 		err := test.cond.Build(dialect.MySQL, buf)
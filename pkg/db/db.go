@@ -135,6 +135,23 @@ func (b *SelectQuery) OrderDir(col string, isAsc bool) *SelectQuery {
 	return b
 }
 
+// OrderBy orders by the builder, e.g. the relevance of Match.
+func (b *SelectQuery) OrderBy(order dbr.Builder, isAsc bool) *SelectQuery {
+	b.SelectStmt.Order = append(b.SelectStmt.Order, dbr.BuildFunc(func(d dbr.Dialect, buf dbr.Buffer) error {
+		err := order.Build(d, buf)
+		if err != nil {
+			return err
+		}
+		if isAsc {
+			buf.WriteString(" ASC")
+		} else {
+			buf.WriteString(" DESC")
+		}
+		return nil
+	}))
+	return b
+}
+
 func (b *SelectQuery) Load(value interface{}) (int, error) {
 	return b.SelectBuilder.Load(value)
 }
@@ -0,0 +1,26 @@
+CREATE TABLE app_search (
+	app_id      VARCHAR(50)  NOT NULL,
+	name        VARCHAR(255) NOT NULL,
+	keywords    TEXT         NOT NULL,
+	maintainers TEXT         NOT NULL,
+	content     MEDIUMTEXT   NOT NULL,
+	update_time TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (app_id)
+);
+
+CREATE FULLTEXT INDEX app_search_name_idx
+	ON app_search (name);
+CREATE FULLTEXT INDEX app_search_keywords_idx
+	ON app_search (keywords);
+CREATE FULLTEXT INDEX app_search_all_idx
+	ON app_search (name, keywords, maintainers, content);
+
+CREATE TABLE app_search_facet (
+	app_id VARCHAR(50)  NOT NULL,
+	facet  VARCHAR(50)  NOT NULL,
+	value  VARCHAR(255) NOT NULL,
+	PRIMARY KEY (app_id, facet, value)
+);
+
+CREATE INDEX app_search_facet_facet_value_idx
+	ON app_search_facet (facet, value);
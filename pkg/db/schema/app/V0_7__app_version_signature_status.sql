@@ -0,0 +1,2 @@
+ALTER TABLE app_version
+	ADD COLUMN signature_status VARCHAR(50) NOT NULL DEFAULT "";
@@ -0,0 +1,6 @@
+ALTER TABLE app_version
+	ADD COLUMN semver VARCHAR(255) NOT NULL DEFAULT "",
+	ADD COLUMN upgrade_from VARCHAR(255) NOT NULL DEFAULT "";
+
+CREATE INDEX app_version_semver_idx
+	ON app_version (semver);
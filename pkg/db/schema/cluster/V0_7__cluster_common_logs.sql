@@ -0,0 +1,2 @@
+ALTER TABLE cluster_common
+	ADD COLUMN logs TEXT;
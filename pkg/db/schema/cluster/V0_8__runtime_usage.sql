@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS runtime_usage (
+	runtime_id    VARCHAR(50)  NOT NULL,
+	owner         VARCHAR(255) NOT NULL,
+	cluster_count INT UNSIGNED NOT NULL DEFAULT 0,
+	node_count    INT UNSIGNED NOT NULL DEFAULT 0,
+	cpu           INT UNSIGNED NOT NULL DEFAULT 0,
+	gpu           INT UNSIGNED NOT NULL DEFAULT 0,
+	memory        INT UNSIGNED NOT NULL DEFAULT 0,
+	volume_size   INT UNSIGNED NOT NULL DEFAULT 0,
+	create_time   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX runtime_usage_runtime_id_index (runtime_id ASC),
+	INDEX runtime_usage_owner_index (owner ASC),
+	INDEX runtime_usage_create_time_index (create_time ASC)
+);
@@ -0,0 +1,10 @@
+ALTER TABLE cluster
+	ADD COLUMN managed_vpc_id VARCHAR(50) NOT NULL DEFAULT '',
+	ADD COLUMN managed_subnet_id VARCHAR(50) NOT NULL DEFAULT '',
+	ADD COLUMN managed_eip_id VARCHAR(50) NOT NULL DEFAULT '';
+
+ALTER TABLE cluster_node
+	ADD COLUMN eip_id VARCHAR(50) NOT NULL DEFAULT '';
+
+ALTER TABLE cluster_role
+	ADD COLUMN public_endpoint BOOL NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+ALTER TABLE repo
+	ADD COLUMN trusted_keys TEXT NOT NULL;
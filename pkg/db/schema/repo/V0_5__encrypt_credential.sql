@@ -0,0 +1,2 @@
+ALTER TABLE repo
+	MODIFY COLUMN credential TEXT NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE runtime_credential
+	MODIFY COLUMN content TEXT NOT NULL;
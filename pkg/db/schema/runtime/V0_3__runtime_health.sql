@@ -0,0 +1,8 @@
+ALTER TABLE runtime
+	ADD COLUMN health_status VARCHAR(50) NOT NULL DEFAULT 'unknown',
+	ADD COLUMN last_error TEXT NOT NULL,
+	ADD COLUMN api_latency INT UNSIGNED NOT NULL DEFAULT 0,
+	ADD COLUMN health_check_time TIMESTAMP NULL DEFAULT NULL;
+
+CREATE INDEX runtime_health_status_idx
+	ON runtime (health_status);
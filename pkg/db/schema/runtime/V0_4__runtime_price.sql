@@ -0,0 +1,11 @@
+CREATE TABLE runtime_price (
+	runtime_id          VARCHAR(50) PRIMARY KEY             NOT NULL,
+	currency            VARCHAR(10)                         NOT NULL,
+	cpu_hour            DOUBLE                              NOT NULL DEFAULT 0,
+	memory_gb_hour      DOUBLE                              NOT NULL DEFAULT 0,
+	volume_gb_month     DOUBLE                              NOT NULL DEFAULT 0,
+	gpu_hour            DOUBLE                              NOT NULL DEFAULT 0,
+	instance_class_hour TEXT                                NOT NULL,
+	create_time         TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+	status_time         TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
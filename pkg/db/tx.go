@@ -0,0 +1,64 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package db
+
+import (
+	"github.com/gocraft/dbr"
+)
+
+// Tx is a transaction whose queries run the hooks of the database, the
+// hooks are delayed until the transaction is committed, so that they see
+// the committed data and never run for a rollback.
+type Tx struct {
+	*dbr.Tx
+	db    *Database
+	hooks []func()
+}
+
+// Tx
+// Example: NewTx(); Update().Set().Where().Exec(); InsertInto().Record().Exec(); Commit()
+
+func (db *Database) NewTx() (*Tx, error) {
+	tx, err := db.Session.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, db: db}, nil
+}
+
+func (tx *Tx) InsertInto(table string) *InsertQuery {
+	query := &InsertQuery{InsertBuilder: tx.Tx.InsertInto(table)}
+	if hook := tx.db.InsertHook; hook != nil {
+		query.Hook = func(q *InsertQuery) { tx.hooks = append(tx.hooks, func() { hook(q) }) }
+	}
+	return query
+}
+
+func (tx *Tx) DeleteFrom(table string) *DeleteQuery {
+	query := &DeleteQuery{DeleteBuilder: tx.Tx.DeleteFrom(table)}
+	if hook := tx.db.DeleteHook; hook != nil {
+		query.Hook = func(q *DeleteQuery) { tx.hooks = append(tx.hooks, func() { hook(q) }) }
+	}
+	return query
+}
+
+func (tx *Tx) Update(table string) *UpdateQuery {
+	query := &UpdateQuery{UpdateBuilder: tx.Tx.Update(table)}
+	if hook := tx.db.UpdateHook; hook != nil {
+		query.Hook = func(q *UpdateQuery) { tx.hooks = append(tx.hooks, func() { hook(q) }) }
+	}
+	return query
+}
+
+func (tx *Tx) Commit() error {
+	err := tx.Tx.Commit()
+	if err != nil {
+		return err
+	}
+	for _, hook := range tx.hooks {
+		hook()
+	}
+	return nil
+}
@@ -84,13 +84,11 @@ type ClusterConf struct {
 	MetadataRootAccess *bool        `json:"metadata_root_access"`
 	HealthCheck        *HealthCheck `json:"health_check"`
 	Monitor            *Monitor     `json:"monitor"`
-	DisplayTabs        struct {
-		DisplayTabsItems map[string]struct {
-			Cmd              string   `json:"cmd"`
-			RolesToExecuteOn []string `json:"roles_to_execute_on"`
-			Description      string   `json:"description"`
-			Timeout          uint32   `json:"timeout"`
-		}
+	DisplayTabs        map[string]struct {
+		Cmd              string   `json:"cmd"`
+		RolesToExecuteOn []string `json:"roles_to_execute_on"`
+		Description      string   `json:"description"`
+		Timeout          uint32   `json:"timeout"`
 	} `json:"display_tabs"`
 }
 
@@ -124,6 +122,11 @@ type Monitor struct {
 	Alarm   []string            `json:"alarm"`
 }
 
+type Log struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
 type Node struct {
 	Role            string   `json:"role"`
 	AdvancedActions []string `json:"advanced_actions"`
@@ -132,7 +135,8 @@ type Node struct {
 		Port     uint32 `json:"port"`
 		Policy   string `json:"policy"`
 	} `json:"loadbalancer"`
-	Container struct {
+	PublicEndpoint bool `json:"public_endpoint"`
+	Container      struct {
 		Type  string `json:"type"`
 		Image string `json:"image"`
 	} `json:"container"`
@@ -158,6 +162,7 @@ type Node struct {
 	CustomMetadata        map[string]interface{} `json:"custom_metadata"`
 	HealthCheck           *HealthCheck           `json:"health_check"`
 	Monitor               *Monitor               `json:"monitor"`
+	Logs                  []Log                  `json:"logs"`
 }
 
 type Service struct {
@@ -4,6 +4,15 @@
 
 package app
 
+// ClusterSchema is used by Validate when the package is uploaded or indexed,
+// the objects of health check, monitor, logs and display tabs accept unknown
+// fields, op lint warns about them instead.
+//
+// Before op lint, health_check, monitor, logs and display_tabs were not in
+// the schema at all, so a package using them was rejected. They are accepted
+// now, and their known fields must be valid, e.g. health_check requires
+// check_cmd. The packages accepted before are still accepted, the relations
+// between fields and roles are only checked by op lint.
 const ClusterSchema = `
 {
   "additionalProperties": false,
@@ -14,6 +23,137 @@ const ClusterSchema = `
     "subnet",
     "nodes"
   ],
+  "definitions": {
+    "health_check": {
+      "required": [
+        "check_cmd"
+      ],
+      "type": "object",
+      "properties": {
+        "enable": {
+          "type": "boolean"
+        },
+        "interval_sec": {
+          "minimum": 1,
+          "type": "integer",
+          "maximum": 86400
+        },
+        "timeout_sec": {
+          "minimum": 1,
+          "type": "integer",
+          "maximum": 86400
+        },
+        "action_timeout_sec": {
+          "minimum": 1,
+          "type": "integer",
+          "maximum": 86400
+        },
+        "healthy_threshold": {
+          "minimum": 1,
+          "type": "integer",
+          "maximum": 100
+        },
+        "unhealthy_threshold": {
+          "minimum": 1,
+          "type": "integer",
+          "maximum": 100
+        },
+        "check_cmd": {
+          "pattern": "^.*[^\\s]+.*$",
+          "type": "string",
+          "maxLength": 1000
+        },
+        "action_cmd": {
+          "type": "string",
+          "maxLength": 1000
+        }
+      }
+    },
+    "monitor": {
+      "required": [
+        "cmd",
+        "items"
+      ],
+      "type": "object",
+      "properties": {
+        "enable": {
+          "type": "boolean"
+        },
+        "cmd": {
+          "pattern": "^.*[^\\s]+.*$",
+          "type": "string",
+          "maxLength": 1000
+        },
+        "items": {
+          "minProperties": 1,
+          "patternProperties": {
+            "^.*$": {
+              "type": "object",
+              "properties": {
+                "unit": {
+                  "type": "string"
+                },
+                "value_type": {
+                  "enum": [
+                    "int",
+                    "str"
+                  ],
+                  "type": "string"
+                },
+                "statistics_type": {
+                  "enum": [
+                    "latest",
+                    "min",
+                    "max",
+                    "avg",
+                    "sum",
+                    "median",
+                    "delta",
+                    "rate"
+                  ],
+                  "type": "string"
+                },
+                "scale_factor_when_display": {
+                  "minimum": 1,
+                  "type": "integer"
+                },
+                "enums": {
+                  "items": {
+                    "type": "string"
+                  },
+                  "type": "array"
+                }
+              }
+            }
+          },
+          "type": "object"
+        },
+        "groups": {
+          "patternProperties": {
+            "^.*$": {
+              "items": {
+                "type": "string"
+              },
+              "type": "array"
+            }
+          },
+          "type": "object"
+        },
+        "display": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "alarm": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      }
+    }
+  },
   "type": "object",
   "properties": {
     "description": {
@@ -477,6 +617,34 @@ const ClusterSchema = `
           },
           "loadbalancer": {
             "type": "array"
+          },
+          "public_endpoint": {
+            "type": "boolean"
+          },
+          "health_check": {
+            "$ref": "#/definitions/health_check"
+          },
+          "monitor": {
+            "$ref": "#/definitions/monitor"
+          },
+          "logs": {
+            "items": {
+              "required": [
+                "name",
+                "path"
+              ],
+              "type": "object",
+              "properties": {
+                "name": {
+                  "type": "string"
+                },
+                "path": {
+                  "pattern": "^/.*?$",
+                  "type": "string"
+                }
+              }
+            },
+            "type": "array"
           }
         }
       },
@@ -485,6 +653,43 @@ const ClusterSchema = `
     },
     "incremental_backup_supported": {
       "type": "boolean"
+    },
+    "health_check": {
+      "$ref": "#/definitions/health_check"
+    },
+    "monitor": {
+      "$ref": "#/definitions/monitor"
+    },
+    "display_tabs": {
+      "patternProperties": {
+        "^.*$": {
+          "required": [
+            "cmd"
+          ],
+          "type": "object",
+          "properties": {
+            "cmd": {
+              "pattern": "^.*[^\\s]+.*$",
+              "type": "string",
+              "maxLength": 1000
+            },
+            "roles_to_execute_on": {
+              "items": {
+                "type": "string"
+              },
+              "type": "array"
+            },
+            "description": {
+              "type": "string"
+            },
+            "timeout": {
+              "type": "integer",
+              "maximum": 86400
+            }
+          }
+        }
+      },
+      "type": "object"
     }
   }
 }
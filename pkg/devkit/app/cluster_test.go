@@ -46,3 +46,39 @@ func TestCluster_Render(t *testing.T) {
 	}
 	t.Log(cluster.Name, cluster.Description)
 }
+
+func TestCluster_RenderDisplayTabs(t *testing.T) {
+	clusterTmpl := ClusterConfTemplate{
+		Raw: `
+{
+	"name": "{{.cluster.name}}",
+	"display_tabs": {
+		"node_info": {
+			"cmd": "cat /etc/info",
+			"roles_to_execute_on": ["master"],
+			"description": "node info",
+			"timeout": 10
+		}
+	}
+}
+`,
+	}
+	cluster, err := clusterTmpl.Render(map[string]interface{}{
+		"cluster": map[string]interface{}{"name": "foobar"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Name != "foobar" {
+		t.Fatalf("unexpected cluster name [%s]", cluster.Name)
+	}
+
+	tab, ok := cluster.DisplayTabs["node_info"]
+	if !ok {
+		t.Fatalf("display tab [node_info] not decoded: %+v", cluster.DisplayTabs)
+	}
+	if tab.Cmd != "cat /etc/info" || tab.Description != "node info" || tab.Timeout != 10 ||
+		len(tab.RolesToExecuteOn) != 1 || tab.RolesToExecuteOn[0] != "master" {
+		t.Fatalf("unexpected display tab %+v", tab)
+	}
+}
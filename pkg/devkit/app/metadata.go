@@ -16,6 +16,9 @@ type Metadata struct {
 	Screenshots []string      `json:"screenshots,omitempty"`
 	Keywords    []string      `json:"keywords,omitempty"`
 	Sources     []string      `json:"sources,omitempty"`
+	// UpgradeFrom is the semver constraint of the versions that can
+	// upgrade to this version, e.g. ">=1.0.0, <2.0.0"
+	UpgradeFrom string `json:"upgrade_from,omitempty"`
 }
 
 type Maintainer struct {
@@ -92,6 +95,13 @@ func (m *Metadata) GetDescription() string {
 	return ""
 }
 
+func (m *Metadata) GetUpgradeFrom() string {
+	if m != nil {
+		return m.UpgradeFrom
+	}
+	return ""
+}
+
 func (m *Metadata) GetScreenshots() []string {
 	if m != nil {
 		return m.Screenshots
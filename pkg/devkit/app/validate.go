@@ -22,8 +22,7 @@ func getError(result *gojsonschema.Result) error {
 }
 
 func (c ClusterConf) Validate() error {
-	documentLoader := gojsonschema.NewStringLoader(c.RenderJson)
-	result, err := gojsonschema.Validate(schemaLoader, documentLoader)
+	result, err := c.validateSchema()
 	if err != nil {
 		return err
 	}
@@ -33,6 +32,20 @@ func (c ClusterConf) Validate() error {
 	return nil
 }
 
+// SchemaErrors returns every violation of ClusterSchema, one per field.
+func (c ClusterConf) SchemaErrors() ([]gojsonschema.ResultError, error) {
+	result, err := c.validateSchema()
+	if err != nil {
+		return nil, err
+	}
+	return result.Errors(), nil
+}
+
+func (c ClusterConf) validateSchema() (*gojsonschema.Result, error) {
+	documentLoader := gojsonschema.NewStringLoader(c.RenderJson)
+	return gojsonschema.Validate(schemaLoader, documentLoader)
+}
+
 func ValidateClusterConfTmpl(clusterTmpl *ClusterConfTemplate, input *ClusterUserConfig) error {
 	cluster, err := clusterTmpl.Render(input)
 	if err != nil {
@@ -158,3 +158,57 @@ func TestValidateClusterTmpl(t *testing.T) {
 	}
 	t.Log(err)
 }
+
+func TestValidateClusterSchemaChange(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		extra string
+		valid bool
+	}{
+		{
+			// accepted before health_check, monitor, logs and display_tabs were added
+			name:  "previously accepted",
+			valid: true,
+		},
+		{
+			name:  "health check",
+			extra: `, "health_check": {"check_cmd": "true", "interval_sec": 10}`,
+			valid: true,
+		},
+		{
+			name:  "health check without check_cmd",
+			extra: `, "health_check": {"interval_sec": 10}`,
+		},
+		{
+			name:  "monitor without items",
+			extra: `, "monitor": {"cmd": "stat"}`,
+		},
+	} {
+		s := `{
+	"name": "test",
+	"description": "test cluster",
+	"subnet": "subnet-1",
+	"env": {"a": 1},
+	"advanced_actions": ["scale_horizontal"],
+	"nodes": [{
+		"role": "master",
+		"container": {"type": "kvm", "image": "img-1"},
+		"count": 1,
+		"cpu": 1,
+		"memory": 1024,
+		"volume": {"size": 10, "mount_point": "/data", "filesystem": "ext4"},
+		"env": {"b": 2},
+		"services": {"init": {"cmd": "init"}, "start": {"cmd": "start", "order": 1}}
+	}]` + tc.extra + `
+}`
+		var cluster ClusterConf
+		if err := json.Unmarshal([]byte(s), &cluster); err != nil {
+			t.Fatalf("%s: %+v", tc.name, err)
+		}
+		cluster.RenderJson = s
+
+		if err := cluster.Validate(); (err == nil) != tc.valid {
+			t.Fatalf("%s: unexpected result of Validate: %+v", tc.name, err)
+		}
+	}
+}
@@ -18,6 +18,7 @@ const (
 	PackageJson     = "package.json"
 	ClusterJsonTmpl = "cluster.json.tmpl"
 	ConfigJson      = "config.json"
+	IndexYaml       = "index.yaml"
 )
 
 // IsAppDir validate a app directory.
@@ -0,0 +1,476 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package devkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/xeipuuv/gojsonschema"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+	"openpitrix.io/openpitrix/pkg/util/reflectutil"
+)
+
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+// LintMessage is a finding of Lint, Field is the json path in File.
+type LintMessage struct {
+	Severity string `json:"severity"`
+	File     string `json:"file,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Message  string `json:"message"`
+}
+
+type LintResult struct {
+	Path     string         `json:"path"`
+	Messages []*LintMessage `json:"messages"`
+}
+
+func (r *LintResult) Count(severity string) int {
+	var n int
+	for _, m := range r.Messages {
+		if m.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *LintResult) add(severity, file, field, format string, a ...interface{}) {
+	r.Messages = append(r.Messages, &LintMessage{
+		Severity: severity,
+		File:     file,
+		Field:    field,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+// Lint checks the app directory or archive, the findings are returned
+// instead of stopping at the first one like Load.
+func Lint(name string) *LintResult {
+	r := &LintResult{Path: name, Messages: []*LintMessage{}}
+
+	a, err := Load(name)
+	if a == nil || a.Metadata == nil || a.ConfigTemplate == nil || a.ClusterConfTemplate == nil {
+		if err == nil {
+			err = fmt.Errorf("invalid app")
+		}
+		r.add(LintError, "", "", "%s", err)
+		return r
+	}
+	// the error of ValidateClusterConfTmpl is reported in detail below
+
+	lintMetadata(r, a.Metadata)
+	if fi, err := os.Stat(name); err == nil && fi.IsDir() {
+		if abs, err := filepath.Abs(name); err == nil && filepath.Base(abs) != a.Metadata.Name {
+			r.add(LintWarning, PackageJson, "name",
+				"directory name [%s] and package.json name [%s] should match", filepath.Base(abs), a.Metadata.Name)
+		}
+	}
+
+	lintConfig(r, a.ConfigTemplate, "")
+
+	config := a.ConfigTemplate.GetDefaultConfig()
+	cluster, err := a.ClusterConfTemplate.Render(&config)
+	if err != nil {
+		r.add(LintError, ClusterJsonTmpl, "", "%s", err)
+		return r
+	}
+	lintCluster(r, cluster)
+
+	return r
+}
+
+func lintMetadata(r *LintResult, m *app.Metadata) {
+	if m.Name == "" {
+		r.add(LintError, PackageJson, "name", "name is required")
+	}
+	if m.Version == "" {
+		r.add(LintError, PackageJson, "version", "version is required")
+	} else if _, err := semver.NewVersion(m.Version); err != nil {
+		r.add(LintError, PackageJson, "version", "version [%s] is not a valid semver: %s", m.Version, err)
+	}
+	if m.UpgradeFrom != "" {
+		if _, err := semver.NewConstraint(m.UpgradeFrom); err != nil {
+			r.add(LintError, PackageJson, "upgrade_from", "upgrade_from [%s] is not a valid semver constraint: %s", m.UpgradeFrom, err)
+		}
+	}
+	if m.ApiVersion == "" {
+		r.add(LintWarning, PackageJson, "api_version", "api_version is empty, [%s] is assumed", ApiVersionV1)
+	} else if m.ApiVersion != ApiVersionV1 {
+		r.add(LintError, PackageJson, "api_version", "api_version [%s] is not supported", m.ApiVersion)
+	}
+	if m.AppVersion == "" {
+		r.add(LintWarning, PackageJson, "app_version", "app_version is empty")
+	}
+	if m.Description == "" {
+		r.add(LintWarning, PackageJson, "description", "description is empty")
+	}
+	for i, maintainer := range m.Maintainers {
+		if maintainer.Name == "" {
+			r.add(LintWarning, PackageJson, fmt.Sprintf("maintainers.%d.name", i), "maintainer name is empty")
+		}
+	}
+}
+
+// lintConfig checks the default value of every config item against its
+// own type, pattern, range, min and max.
+func lintConfig(r *LintResult, c *app.ConfigTemplate, prefix string) {
+	field := c.Key
+	if prefix != "" {
+		field = prefix + "." + c.Key
+	}
+
+	if c.Type == app.TypeArray {
+		for _, p := range c.Properties {
+			if p.Key == "" {
+				r.add(LintError, ConfigJson, field, "key of property is empty")
+				continue
+			}
+			lintConfig(r, p, field)
+		}
+		return
+	}
+
+	if c.Default == nil {
+		if c.Required {
+			r.add(LintWarning, ConfigJson, field, "required item has no default value")
+		}
+		return
+	}
+
+	var values []interface{}
+	if s, ok := c.Default.(string); ok && c.Multichoice && c.Separator != "" {
+		for _, v := range strings.Split(s, c.Separator) {
+			values = append(values, v)
+		}
+	} else {
+		values = append(values, c.Default)
+	}
+
+	for _, v := range values {
+		lintConfigValue(r, c, field, v)
+	}
+}
+
+func lintConfigValue(r *LintResult, c *app.ConfigTemplate, field string, v interface{}) {
+	switch c.Type {
+	case app.TypeInteger, app.TypeNumber:
+		f, ok := v.(float64)
+		if !ok {
+			r.add(LintError, ConfigJson, field, "default [%v] is not a %s", v, c.Type)
+			return
+		}
+		if c.Type == app.TypeInteger && f != math.Trunc(f) {
+			r.add(LintError, ConfigJson, field, "default [%v] is not an integer", v)
+			return
+		}
+		if (c.Min != 0 || c.Max != 0) && f < float64(c.Min) {
+			r.add(LintError, ConfigJson, field, "default [%v] is less than min [%d]", v, c.Min)
+		}
+		if c.Max != 0 && f > float64(c.Max) {
+			r.add(LintError, ConfigJson, field, "default [%v] is greater than max [%d]", v, c.Max)
+		}
+
+	case app.TypeString, app.TypePassword:
+		s, ok := v.(string)
+		if !ok {
+			r.add(LintError, ConfigJson, field, "default [%v] is not a %s", v, c.Type)
+			return
+		}
+		// empty default means the user must fill it
+		if c.Pattern != "" && s != "" {
+			re, err := regexp.Compile(c.Pattern)
+			if err != nil {
+				r.add(LintError, ConfigJson, field, "invalid pattern [%s]: %s", c.Pattern, err)
+			} else if !re.MatchString(s) {
+				r.add(LintError, ConfigJson, field, "default [%s] does not match pattern [%s]", s, c.Pattern)
+			}
+		}
+
+	case app.TypeBoolean:
+		if _, ok := v.(bool); !ok {
+			r.add(LintError, ConfigJson, field, "default [%v] is not a %s", v, c.Type)
+			return
+		}
+
+	default:
+		r.add(LintError, ConfigJson, field, "unknown type [%s]", c.Type)
+		return
+	}
+
+	if len(c.Range) > 0 {
+		for _, x := range c.Range {
+			if fmt.Sprint(x) == fmt.Sprint(v) {
+				return
+			}
+		}
+		r.add(LintError, ConfigJson, field, "default [%v] is not in range %v", v, c.Range)
+	}
+}
+
+func lintCluster(r *LintResult, cluster app.ClusterConf) {
+	errs, err := cluster.SchemaErrors()
+	if err != nil {
+		r.add(LintError, ClusterJsonTmpl, "", "%s", err)
+		return
+	}
+	for _, e := range errs {
+		field := strings.TrimPrefix(e.Context().String(), gojsonschema.STRING_ROOT_SCHEMA_PROPERTY)
+		field = strings.TrimPrefix(field, ".")
+
+		switch {
+		case e.Type() == "invalid_property_pattern":
+			// the error of the property itself is reported too
+			continue
+		case e.Type() == "additional_property_not_allowed" && strings.HasSuffix(field, "services"):
+			// unknown services are reported with the valid names below
+			continue
+		case e.Type() == "additional_property_not_allowed":
+			field = strings.TrimPrefix(field+"."+e.Field(), ".")
+		}
+		r.add(LintError, ClusterJsonTmpl, field, "%s", e.Description())
+	}
+
+	roles := make(map[string]bool)
+	for i, node := range cluster.Nodes {
+		prefix := fmt.Sprintf("nodes.%d", i)
+
+		if roles[node.Role] {
+			r.add(LintError, ClusterJsonTmpl, prefix+".role", "role [%s] is defined more than once", node.Role)
+		}
+		roles[node.Role] = true
+
+		var names []string
+		for name := range node.Services {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !reflectutil.In(name, constants.ServiceNames) {
+				r.add(LintError, ClusterJsonTmpl, prefix+".services."+name,
+					"unknown service [%s] of role [%s], must be one of %v", name, node.Role, constants.ServiceNames)
+			}
+		}
+
+		lintHealthCheck(r, prefix+".health_check", node.HealthCheck)
+		lintMonitor(r, prefix+".monitor", node.Monitor)
+	}
+
+	lintHealthCheck(r, "health_check", cluster.HealthCheck)
+	lintMonitor(r, "monitor", cluster.Monitor)
+	lintUnknownFields(r, cluster)
+	lintRoles(r, cluster, roles)
+}
+
+// lintRoles checks the roles referenced outside of nodes are defined in
+// nodes, they are the roles of display tabs, and the keys of the cluster
+// level services, health_check, monitor and env which are keyed by role,
+// e.g. "env": {"master": {...}}.
+func lintRoles(r *LintResult, cluster app.ClusterConf, roles map[string]bool) {
+	var tabs []string
+	for name := range cluster.DisplayTabs {
+		tabs = append(tabs, name)
+	}
+	sort.Strings(tabs)
+	for _, name := range tabs {
+		for _, role := range cluster.DisplayTabs[name].RolesToExecuteOn {
+			if !roles[role] {
+				r.add(LintError, ClusterJsonTmpl, "display_tabs."+name+".roles_to_execute_on",
+					"role [%s] is not defined in nodes", role)
+			}
+		}
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(cluster.RenderJson), &raw); err != nil {
+		// the invalid types are reported by the schema
+		return
+	}
+
+	serviceNames := make(map[string]bool)
+	for _, name := range constants.ServiceNames {
+		serviceNames[name] = true
+	}
+	for _, section := range []struct {
+		name  string
+		known map[string]bool
+	}{
+		{"services", serviceNames},
+		{"health_check", jsonFields(reflect.TypeOf(app.HealthCheck{}))},
+		{"monitor", jsonFields(reflect.TypeOf(app.Monitor{}))},
+		{"env", nil},
+	} {
+		m, _ := raw[section.name].(map[string]interface{})
+
+		var keys []string
+		for key, v := range m {
+			// a key with an object value which is not a field of the section
+			// is a role, the values of env are never objects otherwise
+			if _, ok := v.(map[string]interface{}); ok && !section.known[key] {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, role := range keys {
+			if !roles[role] {
+				r.add(LintError, ClusterJsonTmpl, section.name+"."+role, "role [%s] is not defined in nodes", role)
+			}
+		}
+	}
+}
+
+// lintHealthCheck checks the relations between fields, the fields
+// themselves are checked by the schema.
+func lintHealthCheck(r *LintResult, field string, h *app.HealthCheck) {
+	if h == nil {
+		return
+	}
+	if h.IntervalSec > 0 && h.TimeoutSec >= h.IntervalSec {
+		r.add(LintError, ClusterJsonTmpl, field+".timeout_sec",
+			"timeout_sec [%d] must be less than interval_sec [%d]", h.TimeoutSec, h.IntervalSec)
+	}
+	if h.ActionCmd == "" && h.ActionTimeoutSec > 0 {
+		r.add(LintWarning, ClusterJsonTmpl, field+".action_timeout_sec", "action_timeout_sec is set without action_cmd")
+	}
+}
+
+func lintMonitor(r *LintResult, field string, m *app.Monitor) {
+	if m == nil {
+		return
+	}
+
+	var groups []string
+	for group := range m.Groups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		for _, item := range m.Groups[group] {
+			if _, ok := m.Items[item]; !ok {
+				r.add(LintError, ClusterJsonTmpl, field+".groups."+group, "item [%s] is not defined in items", item)
+			}
+		}
+	}
+	for _, item := range m.Display {
+		_, isItem := m.Items[item]
+		_, isGroup := m.Groups[item]
+		if !isItem && !isGroup {
+			r.add(LintError, ClusterJsonTmpl, field+".display", "[%s] is not defined in items or groups", item)
+		}
+	}
+	for _, item := range m.Alarm {
+		if _, ok := m.Items[item]; !ok {
+			r.add(LintError, ClusterJsonTmpl, field+".alarm", "item [%s] is not defined in items", item)
+		}
+	}
+}
+
+// lintUnknownFields warns about the unknown fields of health check, monitor,
+// logs and display tabs. ClusterSchema accepts them, so the packages written
+// for a newer openpitrix can still be uploaded, but they are ignored.
+func lintUnknownFields(r *LintResult, cluster app.ClusterConf) {
+	var raw struct {
+		HealthCheck interface{}            `json:"health_check"`
+		Monitor     interface{}            `json:"monitor"`
+		DisplayTabs map[string]interface{} `json:"display_tabs"`
+		Nodes       []struct {
+			HealthCheck interface{}   `json:"health_check"`
+			Monitor     interface{}   `json:"monitor"`
+			Logs        []interface{} `json:"logs"`
+		} `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(cluster.RenderJson), &raw); err != nil {
+		// the invalid types are reported by the schema
+		return
+	}
+
+	var (
+		healthCheckFields = jsonFields(reflect.TypeOf(app.HealthCheck{}))
+		logFields         = jsonFields(reflect.TypeOf(app.Log{}))
+		displayTabFields  = jsonFields(reflect.TypeOf(cluster.DisplayTabs).Elem())
+	)
+
+	for i, node := range raw.Nodes {
+		prefix := fmt.Sprintf("nodes.%d", i)
+		lintFields(r, prefix+".health_check", node.HealthCheck, healthCheckFields)
+		lintMonitorFields(r, prefix+".monitor", node.Monitor)
+		for j, log := range node.Logs {
+			lintFields(r, fmt.Sprintf("%s.logs.%d", prefix, j), log, logFields)
+		}
+	}
+
+	lintFields(r, "health_check", raw.HealthCheck, healthCheckFields)
+	lintMonitorFields(r, "monitor", raw.Monitor)
+
+	var tabs []string
+	for name := range raw.DisplayTabs {
+		tabs = append(tabs, name)
+	}
+	sort.Strings(tabs)
+	for _, name := range tabs {
+		lintFields(r, "display_tabs."+name, raw.DisplayTabs[name], displayTabFields)
+	}
+}
+
+func lintMonitorFields(r *LintResult, field string, v interface{}) {
+	lintFields(r, field, v, jsonFields(reflect.TypeOf(app.Monitor{})))
+
+	m, _ := v.(map[string]interface{})
+	items, _ := m["items"].(map[string]interface{})
+
+	var names []string
+	for name := range items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	itemFields := jsonFields(reflect.TypeOf(app.Monitor{}.Items).Elem())
+	for _, name := range names {
+		lintFields(r, field+".items."+name, items[name], itemFields)
+	}
+}
+
+func lintFields(r *LintResult, field string, v interface{}, known map[string]bool) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	var keys []string
+	for key := range m {
+		if !known[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		r.add(LintWarning, ClusterJsonTmpl, field+"."+key, "unknown field [%s] is ignored", key)
+	}
+}
+
+// jsonFields returns the json names of the fields of the struct type.
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}
@@ -0,0 +1,132 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package devkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+)
+
+func tClusterConf(t *testing.T, node, extra string) app.ClusterConf {
+	s := fmt.Sprintf(`{
+	"name": "test",
+	"description": "test cluster",
+	"subnet": "subnet-1",
+	"nodes": [{
+		"role": "master",
+		"container": {"type": "kvm", "image": "img-1"},
+		"count": 1%s
+	}]%s
+}`, node, extra)
+
+	var cluster app.ClusterConf
+	if err := json.Unmarshal([]byte(s), &cluster); err != nil {
+		t.Fatalf("%+v: %s", err, s)
+	}
+	cluster.RenderJson = s
+	return cluster
+}
+
+func TestLintCluster(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		node   string
+		extra  string
+		strict bool // rejected by Validate too
+		expect []string
+	}{
+		{
+			name:   "valid",
+			expect: nil,
+		},
+		{
+			name: "unknown fields",
+			node: `,
+		"health_check": {"check_cmd": "true", "retry": 3},
+		"logs": [{"name": "app", "path": "/var/log/app.log", "rotate": true}]`,
+			extra: `,
+	"monitor": {"cmd": "stat", "items": {"cpu": {"unit": "%", "color": "red"}}, "chart": "line"},
+	"display_tabs": {"tab": {"cmd": "ls", "icon": "list"}}`,
+			expect: []string{
+				"warning nodes.0.health_check.retry",
+				"warning nodes.0.logs.0.rotate",
+				"warning monitor.chart",
+				"warning monitor.items.cpu.color",
+				"warning display_tabs.tab.icon",
+			},
+		},
+		{
+			name: "health check timeout",
+			node: `,
+		"health_check": {"check_cmd": "true", "interval_sec": 10, "timeout_sec": 10, "action_timeout_sec": 5}`,
+			expect: []string{
+				"error nodes.0.health_check.timeout_sec",
+				"warning nodes.0.health_check.action_timeout_sec",
+			},
+		},
+		{
+			name: "monitor references",
+			extra: `,
+	"monitor": {"cmd": "stat", "items": {"cpu": {}}, "groups": {"load": ["cpu", "mem"]}, "display": ["load", "disk"], "alarm": ["net"]}`,
+			expect: []string{
+				"error monitor.groups.load",
+				"error monitor.display",
+				"error monitor.alarm",
+			},
+		},
+		{
+			name: "display tab roles",
+			extra: `,
+	"display_tabs": {"tab": {"cmd": "ls", "roles_to_execute_on": ["master", "slave"]}}`,
+			expect: []string{
+				"error display_tabs.tab.roles_to_execute_on",
+			},
+		},
+		{
+			name: "role keyed sections",
+			extra: `,
+	"env": {"master": {"a": 1}, "slave": {"b": 2}, "c": 3},
+	"health_check": {"check_cmd": "true", "slave": {"check_cmd": "true"}},
+	"monitor": {"cmd": "stat", "items": {"cpu": {}}, "master": {"cmd": "stat"}}`,
+			expect: []string{
+				"warning health_check.slave",
+				"warning monitor.master",
+				"error health_check.slave",
+				"error env.slave",
+			},
+		},
+		{
+			name: "unknown service",
+			node: `,
+		"services": {"start": {"cmd": "start"}, "boot": {"cmd": "boot"}}`,
+			strict: true,
+			expect: []string{
+				"error nodes.0.services.boot",
+			},
+		},
+	} {
+		cluster := tClusterConf(t, tc.node, tc.extra)
+
+		// op lint warns about unknown fields, but upload and index accept them
+		if err := cluster.Validate(); (err != nil) != tc.strict {
+			t.Fatalf("%s: unexpected result of Validate: %+v", tc.name, err)
+		}
+
+		r := &LintResult{}
+		lintCluster(r, cluster)
+
+		var got []string
+		for _, m := range r.Messages {
+			got = append(got, m.Severity+" "+m.Field)
+		}
+		if !reflect.DeepEqual(got, tc.expect) {
+			t.Fatalf("%s: expect %q, got %q", tc.name, tc.expect, got)
+		}
+	}
+}
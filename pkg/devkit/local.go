@@ -7,7 +7,9 @@ package devkit
 import (
 	"fmt"
 	"html/template"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -38,13 +40,19 @@ const indexHTMLTemplate = `
 `
 
 // RepositoryServer is an HTTP handler for serving a app repository.
+// The packages and index.yaml can be uploaded with PUT if AllowUpload is set.
 type RepositoryServer struct {
-	RepoPath string
+	RepoPath    string
+	AllowUpload bool
 }
 
 // ServeHTTP implements the http.Handler interface.
 func (s *RepositoryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	uri := r.URL.Path
+	if r.Method == http.MethodPut {
+		s.upload(w, r)
+		return
+	}
 	switch uri {
 	case "/", "/apps/", "/apps/index.html", "/apps/index":
 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -56,18 +64,70 @@ func (s *RepositoryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 // StartLocalRepo starts a web server and serves files from the given path
-func StartLocalRepo(path, address string) error {
+func StartLocalRepo(path, address string, allowUpload bool) error {
 	if address == "" {
 		address = DefaultServeAddr
 	}
-	s := &RepositoryServer{RepoPath: path}
+	s := &RepositoryServer{RepoPath: path, AllowUpload: allowUpload}
 	return http.ListenAndServe(address, s)
 }
 
+func (s *RepositoryServer) upload(w http.ResponseWriter, r *http.Request) {
+	if !s.AllowUpload {
+		http.Error(w, "upload is not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	file := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/apps/"), "/")
+	// only the files of the repo root are accepted
+	if file == "" || file != filepath.Base(file) {
+		http.Error(w, fmt.Sprintf("invalid file name [%s]", file), http.StatusBadRequest)
+		return
+	}
+	if file != IndexYaml && !strings.HasSuffix(file, ".tgz") && !strings.HasSuffix(file, ".tgz"+ProvenanceSuffix) {
+		http.Error(w, fmt.Sprintf("file [%s] is not an app package or %s", file, IndexYaml), http.StatusBadRequest)
+		return
+	}
+
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// write to a temp file first, so that index.yaml is never served half written
+	// the temp file is unique, so the concurrent uploads of a file never mix
+	dest := filepath.Join(s.RepoPath, file)
+	if err := writeFileAtomic(dest, content, 0644); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func writeFileAtomic(filename string, data []byte, perm os.FileMode) error {
+	f, err := ioutil.TempFile(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filename)
+}
+
 func (s *RepositoryServer) htmlIndex(w http.ResponseWriter, r *http.Request) {
 	t := template.Must(template.New("index.html").Parse(indexHTMLTemplate))
 	// load index
-	lrp := filepath.Join(s.RepoPath, "index.yaml")
+	lrp := filepath.Join(s.RepoPath, IndexYaml)
 	i, err := app.LoadIndexFile(lrp)
 	if err != nil {
 		http.Error(w, err.Error(), 500)
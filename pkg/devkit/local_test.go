@@ -0,0 +1,103 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package devkit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRepositoryServerUpload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "devkit-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, tc := range []struct {
+		allowUpload bool
+		path        string
+		code        int
+	}{
+		{allowUpload: false, path: "/nginx-0.1.0.tgz", code: http.StatusMethodNotAllowed},
+		{allowUpload: true, path: "/nginx-0.1.0.tgz", code: http.StatusCreated},
+		{allowUpload: true, path: "/apps/nginx-0.1.0.tgz" + ProvenanceSuffix, code: http.StatusCreated},
+		{allowUpload: true, path: "/" + IndexYaml, code: http.StatusCreated},
+		{allowUpload: true, path: "/", code: http.StatusBadRequest},
+		{allowUpload: true, path: "/sub/nginx-0.1.0.tgz", code: http.StatusBadRequest},
+		{allowUpload: true, path: "/..%2Fnginx-0.1.0.tgz", code: http.StatusBadRequest},
+		{allowUpload: true, path: "/nginx.txt", code: http.StatusBadRequest},
+	} {
+		s := &RepositoryServer{RepoPath: dir, AllowUpload: tc.allowUpload}
+		r := httptest.NewRequest(http.MethodPut, tc.path, strings.NewReader(tc.path))
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, r)
+
+		if w.Code != tc.code {
+			t.Fatalf("PUT [%s]: expect status [%d], got [%d]: %s", tc.path, tc.code, w.Code, w.Body.String())
+		}
+
+		file := filepath.Join(dir, filepath.Base(r.URL.Path))
+		content, err := ioutil.ReadFile(file)
+		if tc.code == http.StatusCreated {
+			if err != nil || string(content) != tc.path {
+				t.Fatalf("PUT [%s]: file not written, got %q, %+v", tc.path, content, err)
+			}
+			if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+				t.Fatalf("PUT [%s]: temp file left, got %d files", tc.path, len(files))
+			}
+			os.Remove(file)
+		} else if err == nil && tc.path != "/" {
+			t.Fatalf("PUT [%s]: file should not be written", tc.path)
+		}
+	}
+}
+
+func TestRepositoryServerConcurrentUpload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "devkit-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := &RepositoryServer{RepoPath: dir, AllowUpload: true}
+	contents := make(map[string]bool)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		content := strings.Repeat(fmt.Sprintf("%d", i), 64*1024)
+		contents[content] = true
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := httptest.NewRequest(http.MethodPut, "/nginx-0.1.0.tgz", strings.NewReader(content))
+			w := httptest.NewRecorder()
+			s.ServeHTTP(w, r)
+			if w.Code != http.StatusCreated {
+				t.Errorf("PUT: expect status [%d], got [%d]: %s", http.StatusCreated, w.Code, w.Body.String())
+			}
+		}()
+	}
+	wg.Wait()
+
+	// one of the uploads wins as a whole
+	content, err := ioutil.ReadFile(filepath.Join(dir, "nginx-0.1.0.tgz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !contents[string(content)] {
+		t.Fatalf("uploads are mixed, got %d bytes", len(content))
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Fatalf("temp files left, got %d files", len(files))
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package devkit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ed25519"
+	"k8s.io/helm/pkg/provenance"
+
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+)
+
+// ProvenanceSuffix is appended to the package filename to get the filename
+// of its provenance file, e.g. nginx-0.1.0.tgz.prov
+const ProvenanceSuffix = ".prov"
+
+// Statement is the signed content of a provenance file.
+type Statement struct {
+	Name     string        `json:"name"`
+	Version  string        `json:"version"`
+	Digest   string        `json:"digest"`
+	Created  time.Time     `json:"created"`
+	Metadata *app.Metadata `json:"metadata"`
+}
+
+// Provenance is the detached signature of a package, the signature is
+// computed over the raw bytes of Statement so that it can be checked
+// without re-encoding.
+type Provenance struct {
+	Statement json.RawMessage `json:"statement"`
+	KeyId     string          `json:"key_id"`
+	Signature string          `json:"signature"`
+}
+
+// GenerateKey returns a new ed25519 key pair encoded by EncodePublicKey and
+// EncodePrivateKey.
+func GenerateKey() (publicKey, privateKey string, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	return EncodePublicKey(pub), EncodePrivateKey(priv), nil
+}
+
+func EncodePublicKey(key ed25519.PublicKey) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func EncodePrivateKey(key ed25519.PrivateKey) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// KeyId is the short fingerprint of the public key, recorded in the
+// provenance file to tell which key signed it.
+func KeyId(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+func ParsePrivateKey(s string) (ed25519.PrivateKey, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %s", err)
+	}
+	if len(data) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key: length [%d] is not [%d]", len(data), ed25519.PrivateKeySize)
+	}
+	return ed25519.PrivateKey(data), nil
+}
+
+// ParsePublicKeys parses a keyring, one public key per line.
+// Empty lines and lines starting with # are ignored.
+func ParsePublicKeys(s string) ([]ed25519.PublicKey, error) {
+	var keys []ed25519.PublicKey
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key [%s]: %s", line, err)
+		}
+		if len(data) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid public key [%s]: length [%d] is not [%d]", line, len(data), ed25519.PublicKeySize)
+		}
+		keys = append(keys, ed25519.PublicKey(data))
+	}
+	return keys, scanner.Err()
+}
+
+// Sign writes the provenance file of the package next to it and returns
+// the filename of the provenance file.
+func Sign(filename string, key ed25519.PrivateKey) (string, error) {
+	c, err := Load(filename)
+	if err != nil {
+		return "", err
+	}
+	digest, err := provenance.DigestFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	statement, err := json.Marshal(Statement{
+		Name:     c.Metadata.Name,
+		Version:  c.Metadata.Version,
+		Digest:   digest,
+		Created:  time.Now(),
+		Metadata: c.Metadata,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	prov := Provenance{
+		Statement: statement,
+		KeyId:     KeyId(key.Public().(ed25519.PublicKey)),
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(key, statement)),
+	}
+	// indenting would change the raw statement and break the signature
+	data, err := json.Marshal(prov)
+	if err != nil {
+		return "", err
+	}
+
+	provFile := filename + ProvenanceSuffix
+	if err := ioutil.WriteFile(provFile, data, 0644); err != nil {
+		return "", err
+	}
+	return provFile, nil
+}
+
+// Verify checks that the provenance is signed by one of the keys and
+// matches the content of the package.
+func Verify(pkg, prov []byte, keys []ed25519.PublicKey) (*Statement, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no trusted keys")
+	}
+
+	var p Provenance
+	if err := json.Unmarshal(prov, &p); err != nil {
+		return nil, fmt.Errorf("invalid provenance: %s", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(p.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %s", err)
+	}
+
+	var signer ed25519.PublicKey
+	for _, key := range keys {
+		if ed25519.Verify(key, p.Statement, signature) {
+			signer = key
+			break
+		}
+	}
+	if signer == nil {
+		return nil, fmt.Errorf("signature of key [%s] is not valid for any trusted key", p.KeyId)
+	}
+
+	var s Statement
+	if err := json.Unmarshal(p.Statement, &s); err != nil {
+		return nil, fmt.Errorf("invalid statement: %s", err)
+	}
+	digest, err := provenance.Digest(bytes.NewReader(pkg))
+	if err != nil {
+		return nil, err
+	}
+	if digest != s.Digest {
+		return nil, fmt.Errorf("package digest [%s] does not match the signed digest [%s]", digest, s.Digest)
+	}
+	return &s, nil
+}
+
+// VerifyFile checks the package against its provenance file.
+func VerifyFile(filename, provFile string, keys []ed25519.PublicKey) (*Statement, error) {
+	pkg, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	prov, err := ioutil.ReadFile(provFile)
+	if err != nil {
+		return nil, err
+	}
+	return Verify(pkg, prov, keys)
+}
@@ -0,0 +1,85 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package devkit
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "devkit-provenance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	appDir, err := Create(&app.Metadata{Name: "nginx", Version: "0.1.0"}, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := LoadDir(appDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename, err := Save(c, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, privateKey, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ParsePrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provFile, err := Sign(filename, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey, _, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ParsePublicKeys("# trusted keys\n" + otherKey + "\n\n" + publicKey + "\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("expect 2 keys, got [%d]", len(keys))
+	}
+
+	s, err := VerifyFile(filename, provFile, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != "nginx" || s.Version != "0.1.0" || s.Metadata.Name != "nginx" {
+		t.Fatalf("unexpected statement: %+v", s)
+	}
+
+	_, err = VerifyFile(filename, provFile, keys[:1])
+	if err == nil {
+		t.Fatal("expect error when the signer is not trusted")
+	}
+
+	pkg, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prov, err := ioutil.ReadFile(provFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Verify(append(pkg, 0), prov, keys)
+	if err == nil {
+		t.Fatal("expect error when the package is modified")
+	}
+}
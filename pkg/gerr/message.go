@@ -105,10 +105,34 @@ var (
 		Name: "subnet_not_found",
 		En:   "subnet [%s] not found or vpc not bind eip",
 	}
+	ErrorNetworkProvisionNotSupported = ErrorMessage{
+		Name: "network_provision_not_supported",
+		En:   "provider [%s] does not support to provision vpc, subnet and eip",
+	}
+	ErrorLoadbalancerNotSupported = ErrorMessage{
+		Name: "loadbalancer_not_supported",
+		En:   "provider [%s] does not support to add nodes to loadbalancer",
+	}
 	ErrorProviderNotFound = ErrorMessage{
 		Name: "provider_not_found",
 		En:   "provider [%s] not found",
 	}
+	ErrorRuntimeUnhealthy = ErrorMessage{
+		Name: "runtime_unhealthy",
+		En:   "runtime [%s] is unhealthy: %s",
+	}
+	ErrorRuntimeNotMatchRepoSelector = ErrorMessage{
+		Name: "runtime_not_match_repo_selector",
+		En:   "runtime [%s] does not match the selector [%s] of repo [%s]",
+	}
+	ErrorRuntimePriceNotFound = ErrorMessage{
+		Name: "runtime_price_not_found",
+		En:   "price of runtime [%s] not found",
+	}
+	ErrorIllegalPrice = ErrorMessage{
+		Name: "illegal_price",
+		En:   "illegal price [%s], it must not be negative",
+	}
 	ErrorInternalError = ErrorMessage{
 		Name: "internal_error",
 		En:   "internal error",
@@ -125,6 +149,10 @@ var (
 		Name: "parameter_parse_failed",
 		En:   "parameter [%s] parse failed",
 	}
+	ErrorPermissionDenied = ErrorMessage{
+		Name: "permission_denied",
+		En:   "permission denied on resource [%s]",
+	}
 	ErrorResourceAlreadyDeleted = ErrorMessage{
 		Name: "resource_already_deleted",
 		En:   "resource [%s] has already been deleted",
@@ -185,4 +213,36 @@ var (
 		Name: "detach_key_pairs_failed",
 		En:   "detach key pairs failed",
 	}
+	ErrorAppVersionUnverified = ErrorMessage{
+		Name: "app_version_unverified",
+		En:   "the package of app version [%s] failed the signature verification",
+	}
+	ErrorAppVersionNotActive = ErrorMessage{
+		Name: "app_version_not_active",
+		En:   "app version [%s] is not released",
+	}
+	ErrorAppVersionUpgradeNotAllowed = ErrorMessage{
+		Name: "app_version_upgrade_not_allowed",
+		En:   "app version [%s] can not upgrade to [%s]",
+	}
+	ErrorInvalidAppAttachment = ErrorMessage{
+		Name: "invalid_app_attachment",
+		En:   "invalid app attachment [%s]",
+	}
+	ErrorEncryptionNotEnabled = ErrorMessage{
+		Name: "encryption_not_enabled",
+		En:   "no master key is configured for the encryption of credentials",
+	}
+	ErrorEncryptedCredential = ErrorMessage{
+		Name: "encrypted_credential",
+		En:   "credential is encrypted, the plaintext is expected",
+	}
+	ErrorMasterKeyMismatch = ErrorMessage{
+		Name: "master_key_mismatch",
+		En:   "current master key is [%s], not [%s]",
+	}
+	ErrorInvalidAppPackage = ErrorMessage{
+		Name: "invalid_app_package",
+		En:   "invalid app package: %s",
+	}
 )
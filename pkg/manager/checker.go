@@ -58,6 +58,10 @@ func (c *checker) checkRequired(param string, value interface{}) error {
 			if len(values) == 0 {
 				return gerr.New(gerr.InvalidArgument, gerr.ErrorMissingParameter, param)
 			}
+		case []byte:
+			if len(v) == 0 {
+				return gerr.New(gerr.InvalidArgument, gerr.ErrorMissingParameter, param)
+			}
 		}
 	}
 	return nil
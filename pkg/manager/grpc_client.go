@@ -12,6 +12,8 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
+
+	"openpitrix.io/openpitrix/pkg/constants"
 )
 
 var ClientOptions = []grpc.DialOption{
@@ -21,6 +23,10 @@ var ClientOptions = []grpc.DialOption{
 		Timeout:             10 * time.Second,
 		PermitWithoutStream: true,
 	}),
+	grpc.WithDefaultCallOptions(
+		grpc.MaxCallRecvMsgSize(constants.GrpcMaxMsgSize),
+		grpc.MaxCallSendMsgSize(constants.GrpcMaxMsgSize),
+	),
 }
 
 var clientCache sync.Map
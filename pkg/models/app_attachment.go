@@ -0,0 +1,57 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const MaxAppAttachmentSize = 2 << 20
+
+// MaxAppScreenshots keeps the attachments of an app in one grpc message, see
+// constants.GrpcMaxMsgSize.
+const MaxAppScreenshots = 10
+
+// AppAttachmentContentTypes are the types of the readme, icon and
+// screenshots of app by the extension.
+var AppAttachmentContentTypes = map[string]string{
+	".md":   "text/markdown; charset=utf-8",
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".gif":  "image/gif",
+	".svg":  "image/svg+xml",
+}
+
+var appAttachmentIdRegexp = regexp.MustCompile(`^[0-9a-f]{64}\.[a-z]+$`)
+
+// GetAppAttachmentId names the attachment by the sha256 of the content, the
+// same file of different versions or apps is stored once.
+func GetAppAttachmentId(name string, content []byte) (string, error) {
+	ext := strings.ToLower(path.Ext(name))
+	if _, ok := AppAttachmentContentTypes[ext]; !ok {
+		return "", fmt.Errorf("unsupported file type [%s]", ext)
+	}
+	if len(content) == 0 || len(content) > MaxAppAttachmentSize {
+		return "", fmt.Errorf("size [%d] out of range (0, %d]", len(content), MaxAppAttachmentSize)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]) + ext, nil
+}
+
+// GetAppAttachmentContentType returns the content type of the attachment
+// id, false if the id is invalid.
+func GetAppAttachmentContentType(attachmentId string) (string, bool) {
+	if !appAttachmentIdRegexp.MatchString(attachmentId) {
+		return "", false
+	}
+	contentType, ok := AppAttachmentContentTypes[path.Ext(attachmentId)]
+	return contentType, ok
+}
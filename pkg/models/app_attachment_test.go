@@ -0,0 +1,35 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"testing"
+)
+
+func TestGetAppAttachmentId(t *testing.T) {
+	id, err := GetAppAttachmentId("images/Icon.PNG", []byte("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae.png" {
+		t.Fatalf("unexpected attachment id [%s]", id)
+	}
+	contentType, ok := GetAppAttachmentContentType(id)
+	if !ok || contentType != "image/png" {
+		t.Fatalf("unexpected content type [%s] of [%s]", contentType, id)
+	}
+
+	if _, err := GetAppAttachmentId("icon.exe", []byte("foo")); err == nil {
+		t.Fatal("expect error for unsupported file type")
+	}
+	if _, err := GetAppAttachmentId("icon.png", nil); err == nil {
+		t.Fatal("expect error for empty content")
+	}
+	for _, id := range []string{"../a.png", "2c26b46b.png", id + "/x"} {
+		if _, ok := GetAppAttachmentContentType(id); ok {
+			t.Fatalf("expect invalid attachment id [%s]", id)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"time"
+)
+
+const (
+	AppSearchTableName      = "app_search"
+	AppSearchFacetTableName = "app_search_facet"
+)
+
+// AppSearch is the full-text document of the app, Content holds the
+// description, readme and latest version of the app.
+type AppSearch struct {
+	AppId       string
+	Name        string
+	Keywords    string
+	Maintainers string
+	Content     string
+	UpdateTime  time.Time
+}
+
+var AppSearchColumns = GetColumnsFromStruct(&AppSearch{})
+
+// AppSearchFacet is a value of the app to filter and count by, e.g. the
+// category or the provider.
+type AppSearchFacet struct {
+	AppId string
+	Facet string
+	Value string
+}
+
+var AppSearchFacetColumns = GetColumnsFromStruct(&AppSearchFacet{})
@@ -11,6 +11,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/util/idutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/semverutil"
 )
 
 const AppVersionTableName = "app_version"
@@ -20,17 +21,20 @@ func NewAppVersionId() string {
 }
 
 type AppVersion struct {
-	VersionId   string
-	AppId       string
-	Owner       string
-	Name        string
-	Description string
-	PackageName string
-	Status      string
-	Sequence    uint32
-	CreateTime  time.Time
-	StatusTime  time.Time
-	UpdateTime  *time.Time
+	VersionId       string
+	AppId           string
+	Owner           string
+	Name            string
+	Description     string
+	PackageName     string
+	Status          string
+	Sequence        uint32
+	SignatureStatus string
+	Semver          string
+	UpgradeFrom     string
+	CreateTime      time.Time
+	StatusTime      time.Time
+	UpdateTime      *time.Time
 }
 
 var AppVersionColumns = GetColumnsFromStruct(&AppVersion{})
@@ -43,7 +47,8 @@ func NewAppVersion(appId, name, description, owner, packageName string) *AppVers
 		Owner:       owner,
 		PackageName: packageName,
 		Description: description,
-		Status:      constants.StatusActive,
+		Semver:      semverutil.SortKey(name),
+		Status:      constants.StatusDraft,
 		CreateTime:  time.Now(),
 		StatusTime:  time.Now(),
 	}
@@ -64,6 +69,8 @@ func AppVersionToPb(appVersion *AppVersion) *pb.AppVersion {
 	pbAppVersion.CreateTime = pbutil.ToProtoTimestamp(appVersion.CreateTime)
 	pbAppVersion.StatusTime = pbutil.ToProtoTimestamp(appVersion.StatusTime)
 	pbAppVersion.Sequence = uint32(appVersion.Sequence)
+	pbAppVersion.SignatureStatus = pbutil.ToProtoString(appVersion.SignatureStatus)
+	pbAppVersion.UpgradeFrom = pbutil.ToProtoString(appVersion.UpgradeFrom)
 	if appVersion.UpdateTime != nil {
 		pbAppVersion.UpdateTime = pbutil.ToProtoTimestamp(*appVersion.UpdateTime)
 	}
@@ -0,0 +1,55 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+const AppVersionAuditTableName = "app_version_audit"
+
+// AppVersionAudit records a status transition of the app version.
+type AppVersionAudit struct {
+	VersionId  string
+	AppId      string
+	Status     string
+	Operator   string
+	Message    string
+	StatusTime time.Time
+}
+
+var AppVersionAuditColumns = GetColumnsFromStruct(&AppVersionAudit{})
+
+func NewAppVersionAudit(versionId, appId, status, operator, message string) *AppVersionAudit {
+	return &AppVersionAudit{
+		VersionId:  versionId,
+		AppId:      appId,
+		Status:     status,
+		Operator:   operator,
+		Message:    message,
+		StatusTime: time.Now(),
+	}
+}
+
+func AppVersionAuditToPb(appVersionAudit *AppVersionAudit) *pb.AppVersionAudit {
+	pbAppVersionAudit := pb.AppVersionAudit{}
+	pbAppVersionAudit.VersionId = pbutil.ToProtoString(appVersionAudit.VersionId)
+	pbAppVersionAudit.AppId = pbutil.ToProtoString(appVersionAudit.AppId)
+	pbAppVersionAudit.Status = pbutil.ToProtoString(appVersionAudit.Status)
+	pbAppVersionAudit.Operator = pbutil.ToProtoString(appVersionAudit.Operator)
+	pbAppVersionAudit.Message = pbutil.ToProtoString(appVersionAudit.Message)
+	pbAppVersionAudit.StatusTime = pbutil.ToProtoTimestamp(appVersionAudit.StatusTime)
+	return &pbAppVersionAudit
+}
+
+func AppVersionAuditsToPbs(appVersionAudits []*AppVersionAudit) (pbAppVersionAudits []*pb.AppVersionAudit) {
+	for _, appVersionAudit := range appVersionAudits {
+		pbAppVersionAudits = append(pbAppVersionAudits, AppVersionAuditToPb(appVersionAudit))
+	}
+	return
+}
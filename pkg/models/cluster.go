@@ -27,6 +27,9 @@ type Cluster struct {
 	VersionId          string
 	SubnetId           string
 	VpcId              string
+	ManagedVpcId       string
+	ManagedSubnetId    string
+	ManagedEipId       string
 	FrontgateId        string
 	ClusterType        uint32
 	Endpoints          string
@@ -60,6 +63,9 @@ func ClusterToPb(cluster *Cluster) *pb.Cluster {
 		VersionId:          pbutil.ToProtoString(cluster.VersionId),
 		SubnetId:           pbutil.ToProtoString(cluster.SubnetId),
 		VpcId:              pbutil.ToProtoString(cluster.VpcId),
+		ManagedVpcId:       pbutil.ToProtoString(cluster.ManagedVpcId),
+		ManagedSubnetId:    pbutil.ToProtoString(cluster.ManagedSubnetId),
+		ManagedEipId:       pbutil.ToProtoString(cluster.ManagedEipId),
 		FrontgateId:        pbutil.ToProtoString(cluster.FrontgateId),
 		ClusterType:        pbutil.ToProtoUInt32(cluster.ClusterType),
 		Endpoints:          pbutil.ToProtoString(cluster.Endpoints),
@@ -88,6 +94,9 @@ func PbToCluster(pbCluster *pb.Cluster) *Cluster {
 		VersionId:          pbCluster.GetVersionId().GetValue(),
 		SubnetId:           pbCluster.GetSubnetId().GetValue(),
 		VpcId:              pbCluster.GetVpcId().GetValue(),
+		ManagedVpcId:       pbCluster.GetManagedVpcId().GetValue(),
+		ManagedSubnetId:    pbCluster.GetManagedSubnetId().GetValue(),
+		ManagedEipId:       pbCluster.GetManagedEipId().GetValue(),
 		FrontgateId:        pbCluster.GetFrontgateId().GetValue(),
 		ClusterType:        pbCluster.GetClusterType().GetValue(),
 		Endpoints:          pbCluster.GetEndpoints().GetValue(),
@@ -40,6 +40,7 @@ type ClusterCommon struct {
 	BackupPolicy               string
 	IncrementalBackupSupported bool
 	Hypervisor                 string
+	Logs                       string
 }
 
 var ClusterCommonColumns = GetColumnsFromStruct(&ClusterCommon{})
@@ -78,6 +79,7 @@ func ClusterCommonToPb(clusterCommon *ClusterCommon) *pb.ClusterCommon {
 		BackupPolicy:               pbutil.ToProtoString(clusterCommon.BackupPolicy),
 		IncrementalBackupSupported: pbutil.ToProtoBool(clusterCommon.IncrementalBackupSupported),
 		Hypervisor:                 pbutil.ToProtoString(clusterCommon.Hypervisor),
+		Logs:                       pbutil.ToProtoString(clusterCommon.Logs),
 	}
 }
 
@@ -109,6 +111,7 @@ func PbToClusterCommon(pbClusterCommon *pb.ClusterCommon) *ClusterCommon {
 		BackupPolicy:               pbClusterCommon.GetBackupPolicy().GetValue(),
 		IncrementalBackupSupported: pbClusterCommon.GetIncrementalBackupSupported().GetValue(),
 		Hypervisor:                 pbClusterCommon.GetHypervisor().GetValue(),
+		Logs:                       pbClusterCommon.GetLogs().GetValue(),
 	}
 }
 
@@ -28,6 +28,7 @@ type ClusterNode struct {
 	SubnetId         string
 	PrivateIp        string
 	Eip              string
+	EipId            string
 	ServerId         uint32
 	Role             string
 	Status           string
@@ -68,6 +69,8 @@ func ClusterNodeToPb(clusterNode *ClusterNode) *pb.ClusterNode {
 		Device:           pbutil.ToProtoString(clusterNode.Device),
 		SubnetId:         pbutil.ToProtoString(clusterNode.SubnetId),
 		PrivateIp:        pbutil.ToProtoString(clusterNode.PrivateIp),
+		Eip:              pbutil.ToProtoString(clusterNode.Eip),
+		EipId:            pbutil.ToProtoString(clusterNode.EipId),
 		ServerId:         pbutil.ToProtoUInt32(clusterNode.ServerId),
 		Role:             pbutil.ToProtoString(clusterNode.Role),
 		Status:           pbutil.ToProtoString(clusterNode.Status),
@@ -95,6 +98,8 @@ func ClusterNodeWithKeyPairsToPb(clusterNodeKeyPairs *ClusterNodeWithKeyPairs) *
 		Device:           pbutil.ToProtoString(clusterNodeKeyPairs.Device),
 		SubnetId:         pbutil.ToProtoString(clusterNodeKeyPairs.SubnetId),
 		PrivateIp:        pbutil.ToProtoString(clusterNodeKeyPairs.PrivateIp),
+		Eip:              pbutil.ToProtoString(clusterNodeKeyPairs.Eip),
+		EipId:            pbutil.ToProtoString(clusterNodeKeyPairs.EipId),
 		ServerId:         pbutil.ToProtoUInt32(clusterNodeKeyPairs.ServerId),
 		Role:             pbutil.ToProtoString(clusterNodeKeyPairs.Role),
 		Status:           pbutil.ToProtoString(clusterNodeKeyPairs.Status),
@@ -124,6 +129,8 @@ func PbToClusterNode(pbClusterNode *pb.ClusterNode) *ClusterNodeWithKeyPairs {
 			Device:           pbClusterNode.GetDevice().GetValue(),
 			SubnetId:         pbClusterNode.GetSubnetId().GetValue(),
 			PrivateIp:        pbClusterNode.GetPrivateIp().GetValue(),
+			Eip:              pbClusterNode.GetEip().GetValue(),
+			EipId:            pbClusterNode.GetEipId().GetValue(),
 			ServerId:         pbClusterNode.GetServerId().GetValue(),
 			Role:             pbClusterNode.GetRole().GetValue(),
 			Status:           pbClusterNode.GetStatus().GetValue(),
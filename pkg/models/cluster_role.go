@@ -23,39 +23,43 @@ type ClusterRole struct {
 	MountOptions string
 	FileSystem   string
 	Env          string
+	// PublicEndpoint is true if the nodes of the role are bound with eips
+	PublicEndpoint bool
 }
 
 var ClusterRoleColumns = GetColumnsFromStruct(&ClusterRole{})
 
 func ClusterRoleToPb(clusterRole *ClusterRole) *pb.ClusterRole {
 	return &pb.ClusterRole{
-		ClusterId:    pbutil.ToProtoString(clusterRole.ClusterId),
-		Role:         pbutil.ToProtoString(clusterRole.Role),
-		Cpu:          pbutil.ToProtoUInt32(clusterRole.Cpu),
-		Gpu:          pbutil.ToProtoUInt32(clusterRole.Gpu),
-		Memory:       pbutil.ToProtoUInt32(clusterRole.Memory),
-		InstanceSize: pbutil.ToProtoUInt32(clusterRole.InstanceSize),
-		StorageSize:  pbutil.ToProtoUInt32(clusterRole.StorageSize),
-		MountPoint:   pbutil.ToProtoString(clusterRole.MountPoint),
-		MountOptions: pbutil.ToProtoString(clusterRole.MountOptions),
-		FileSystem:   pbutil.ToProtoString(clusterRole.FileSystem),
-		Env:          pbutil.ToProtoString(clusterRole.Env),
+		ClusterId:      pbutil.ToProtoString(clusterRole.ClusterId),
+		Role:           pbutil.ToProtoString(clusterRole.Role),
+		Cpu:            pbutil.ToProtoUInt32(clusterRole.Cpu),
+		Gpu:            pbutil.ToProtoUInt32(clusterRole.Gpu),
+		Memory:         pbutil.ToProtoUInt32(clusterRole.Memory),
+		InstanceSize:   pbutil.ToProtoUInt32(clusterRole.InstanceSize),
+		StorageSize:    pbutil.ToProtoUInt32(clusterRole.StorageSize),
+		MountPoint:     pbutil.ToProtoString(clusterRole.MountPoint),
+		MountOptions:   pbutil.ToProtoString(clusterRole.MountOptions),
+		FileSystem:     pbutil.ToProtoString(clusterRole.FileSystem),
+		Env:            pbutil.ToProtoString(clusterRole.Env),
+		PublicEndpoint: pbutil.ToProtoBool(clusterRole.PublicEndpoint),
 	}
 }
 
 func PbToClusterRole(pbClusterRole *pb.ClusterRole) *ClusterRole {
 	return &ClusterRole{
-		ClusterId:    pbClusterRole.GetClusterId().GetValue(),
-		Role:         pbClusterRole.GetRole().GetValue(),
-		Cpu:          pbClusterRole.GetCpu().GetValue(),
-		Gpu:          pbClusterRole.GetGpu().GetValue(),
-		Memory:       pbClusterRole.GetMemory().GetValue(),
-		InstanceSize: pbClusterRole.GetInstanceSize().GetValue(),
-		StorageSize:  pbClusterRole.GetStorageSize().GetValue(),
-		MountPoint:   pbClusterRole.GetMountPoint().GetValue(),
-		MountOptions: pbClusterRole.GetMountOptions().GetValue(),
-		FileSystem:   pbClusterRole.GetFileSystem().GetValue(),
-		Env:          pbClusterRole.GetEnv().GetValue(),
+		ClusterId:      pbClusterRole.GetClusterId().GetValue(),
+		Role:           pbClusterRole.GetRole().GetValue(),
+		Cpu:            pbClusterRole.GetCpu().GetValue(),
+		Gpu:            pbClusterRole.GetGpu().GetValue(),
+		Memory:         pbClusterRole.GetMemory().GetValue(),
+		InstanceSize:   pbClusterRole.GetInstanceSize().GetValue(),
+		StorageSize:    pbClusterRole.GetStorageSize().GetValue(),
+		MountPoint:     pbClusterRole.GetMountPoint().GetValue(),
+		MountOptions:   pbClusterRole.GetMountOptions().GetValue(),
+		FileSystem:     pbClusterRole.GetFileSystem().GetValue(),
+		Env:            pbClusterRole.GetEnv().GetValue(),
+		PublicEndpoint: pbClusterRole.GetPublicEndpoint().GetValue(),
 	}
 }
 
@@ -33,7 +33,8 @@ const (
 
 	ColumnRepoEventId = "repo_event_id"
 
-	ColumnCredential = "credential"
+	ColumnCredential  = "credential"
+	ColumnTrustedKeys = "trusted_keys"
 
 	ColumnVisibility = "visibility"
 
@@ -52,6 +53,16 @@ const (
 	ColumnKeywords    = "keywords"
 	ColumnPackageName = "package_name"
 
+	ColumnSignatureStatus = "signature_status"
+	ColumnSemver          = "semver"
+	ColumnUpgradeFrom     = "upgrade_from"
+
+	ColumnOperator = "operator"
+
+	ColumnFacet   = "facet"
+	ColumnValue   = "value"
+	ColumnContent = "content"
+
 	ColumnJobId       = "job_id"
 	ColumnClusterId   = "cluster_id"
 	ColumnExecutor    = "executor"
@@ -63,8 +74,9 @@ const (
 	ColumnRole        = "role"
 	ColumnFrontgateId = "frontgate_id"
 
-	ColumnZone   = "zone"
-	ColumnNodeId = "node_id"
+	ColumnZone         = "zone"
+	ColumnHealthStatus = "health_status"
+	ColumnNodeId       = "node_id"
 
 	ColumnTaskAction = "task_action"
 	ColumnJobAction  = "job_action"
@@ -39,6 +39,9 @@ var IndexedColumns = map[string][]string{
 		ColumnVersionId, ColumnAppId, ColumnName, ColumnOwner, ColumnDescription,
 		ColumnPackageName, ColumnStatus,
 	},
+	AppVersionAuditTableName: {
+		ColumnVersionId, ColumnAppId, ColumnStatus, ColumnOperator,
+	},
 	JobTableName: {
 		ColumnJobId, ColumnClusterId, ColumnAppId, ColumnVersionId,
 		ColumnExecutor, ColumnProvider, ColumnStatus, ColumnOwner,
@@ -50,7 +53,7 @@ var IndexedColumns = map[string][]string{
 		ColumnRepoId, ColumnName, ColumnType, ColumnVisibility, ColumnStatus,
 	},
 	RuntimeTableName: {
-		ColumnRuntimeId, ColumnProvider, ColumnZone, ColumnStatus, ColumnOwner,
+		ColumnRuntimeId, ColumnProvider, ColumnZone, ColumnStatus, ColumnOwner, ColumnHealthStatus,
 	},
 	RepoLabelTableName: {
 		ColumnRepoId, ColumnRepoLabelId, ColumnStatus,
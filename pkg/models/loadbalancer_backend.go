@@ -0,0 +1,36 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+// LoadbalancerBackend is the instances of the nodes of a role which are added to
+// or removed from the loadbalancer listener declared by the role. ListenerId is
+// the listener id for qingcloud and the target group arn for aws.
+type LoadbalancerBackend struct {
+	ClusterId   string
+	Role        string
+	ListenerId  string
+	PolicyId    string
+	Port        uint32
+	NodeIds     []string
+	InstanceIds []string
+	Zone        string
+	RuntimeId   string
+	TargetJobId string // target cloud job id
+	Timeout     int    `json:"timeout"`
+}
+
+func NewLoadbalancerBackend(data string) (*LoadbalancerBackend, error) {
+	loadbalancerBackend := &LoadbalancerBackend{}
+	err := jsonutil.Decode([]byte(data), loadbalancerBackend)
+	if err != nil {
+		logger.Error("Decode [%s] into loadbalancer backend failed: %+v", data, err)
+	}
+	return loadbalancerBackend, err
+}
@@ -0,0 +1,57 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+// Network is the vpc with one subnet and the eip bound to the vpc which
+// openpitrix provisions for the cluster that has no subnet given.
+type Network struct {
+	ClusterId   string
+	Name        string
+	VpcId       string
+	SubnetId    string
+	EipId       string
+	Eip         string
+	Zone        string
+	RuntimeId   string
+	TargetJobId string // target cloud job id
+	Timeout     int    `json:"timeout"`
+}
+
+func NewNetwork(data string) (*Network, error) {
+	network := &Network{}
+	err := jsonutil.Decode([]byte(data), network)
+	if err != nil {
+		logger.Error("Decode [%s] into network failed: %+v", data, err)
+	}
+	return network, err
+}
+
+// NodeEip is the eip allocated for the instance of a cluster node whose role
+// declares a public endpoint.
+type NodeEip struct {
+	NodeId      string
+	InstanceId  string
+	Name        string
+	EipId       string
+	Eip         string
+	Zone        string
+	RuntimeId   string
+	TargetJobId string // target cloud job id
+	Timeout     int    `json:"timeout"`
+}
+
+func NewNodeEip(data string) (*NodeEip, error) {
+	nodeEip := &NodeEip{}
+	err := jsonutil.Decode([]byte(data), nodeEip)
+	if err != nil {
+		logger.Error("Decode [%s] into node eip failed: %+v", data, err)
+	}
+	return nodeEip, err
+}
@@ -6,8 +6,14 @@ package models
 
 import (
 	"fmt"
+
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
 )
 
+// UnlimitedQuota is the count of the quota that has no limit.
+const UnlimitedQuota = -1
+
 type Quota struct {
 	Name  string
 	Count int
@@ -34,24 +40,40 @@ func NewQuotas() *Quotas {
 	return quotas
 }
 
+func (p *Quota) exceed(quota *Quota) error {
+	if quota.Count == UnlimitedQuota || p.Count <= quota.Count {
+		return nil
+	}
+	return fmt.Errorf("need %d more %s quota", p.Count-quota.Count, p.Name)
+}
+
 func (p *Quotas) LessThan(quotas *Quotas) error {
-	if p.Instance.Count > quotas.Instance.Count {
-		return fmt.Errorf("need %d more %s quota", p.Instance.Count-quotas.Instance.Count, p.Instance.Name)
+	if err := p.Instance.exceed(quotas.Instance); err != nil {
+		return err
 	}
-	if p.Cpu.Count > quotas.Cpu.Count {
-		return fmt.Errorf("need %d more %s quota", p.Cpu.Count-quotas.Cpu.Count, p.Cpu.Name)
+	if err := p.Cpu.exceed(quotas.Cpu); err != nil {
+		return err
 	}
-	if p.Gpu.Count > quotas.Gpu.Count {
-		return fmt.Errorf("need %d more %s quota", p.Gpu.Count-quotas.Gpu.Count, p.Gpu.Name)
+	if err := p.Gpu.exceed(quotas.Gpu); err != nil {
+		return err
 	}
-	if p.Memory.Count > quotas.Memory.Count {
-		return fmt.Errorf("need %d more %s quota", p.Memory.Count-quotas.Memory.Count, p.Memory.Name)
+	if err := p.Memory.exceed(quotas.Memory); err != nil {
+		return err
 	}
-	if p.Volume.Count > quotas.Volume.Count {
-		return fmt.Errorf("need %d more %s quota", p.Volume.Count-quotas.Volume.Count, p.Volume.Name)
+	if err := p.Volume.exceed(quotas.Volume); err != nil {
+		return err
 	}
-	if p.VolumeSize.Count > quotas.VolumeSize.Count {
-		return fmt.Errorf("need %d more %s quota", p.VolumeSize.Count-quotas.VolumeSize.Count, p.VolumeSize.Name)
+	return p.VolumeSize.exceed(quotas.VolumeSize)
+}
+
+func QuotasToRuntimeQuotaPb(runtimeId string, quotas *Quotas) *pb.RuntimeQuota {
+	return &pb.RuntimeQuota{
+		RuntimeId:  pbutil.ToProtoString(runtimeId),
+		Instance:   int32(quotas.Instance.Count),
+		Cpu:        int32(quotas.Cpu.Count),
+		Gpu:        int32(quotas.Gpu.Count),
+		Memory:     int32(quotas.Memory.Count),
+		Volume:     int32(quotas.Volume.Count),
+		VolumeSize: int32(quotas.VolumeSize.Count),
 	}
-	return nil
 }
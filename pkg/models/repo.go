@@ -28,6 +28,7 @@ type Repo struct {
 	Credential  string
 	Visibility  string
 	Owner       string
+	TrustedKeys string
 
 	Status     string
 	CreateTime time.Time
@@ -37,7 +38,7 @@ type Repo struct {
 var RepoColumns = GetColumnsFromStruct(&Repo{})
 var RepoColumnsWithTablePrefix = GetColumnsFromStructWithPrefix(RepoTableName, &Repo{})
 
-func NewRepo(name, description, typ, url, credential, visibility, owner string) *Repo {
+func NewRepo(name, description, typ, url, credential, visibility, owner, trustedKeys string) *Repo {
 	return &Repo{
 		RepoId:      NewRepoId(),
 		Name:        name,
@@ -47,6 +48,7 @@ func NewRepo(name, description, typ, url, credential, visibility, owner string)
 		Credential:  credential,
 		Visibility:  visibility,
 		Owner:       owner,
+		TrustedKeys: trustedKeys,
 		Status:      constants.StatusActive,
 		CreateTime:  time.Now(),
 		StatusTime:  time.Now(),
@@ -63,6 +65,7 @@ func RepoToPb(repo *Repo) *pb.Repo {
 	pbRepo.Credential = pbutil.ToProtoString(repo.Credential)
 	pbRepo.Visibility = pbutil.ToProtoString(repo.Visibility)
 	pbRepo.Owner = pbutil.ToProtoString(repo.Owner)
+	pbRepo.TrustedKeys = pbutil.ToProtoString(repo.TrustedKeys)
 	pbRepo.Status = pbutil.ToProtoString(repo.Status)
 	pbRepo.CreateTime = pbutil.ToProtoTimestamp(repo.CreateTime)
 	pbRepo.StatusTime = pbutil.ToProtoTimestamp(repo.StatusTime)
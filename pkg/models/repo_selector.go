@@ -10,6 +10,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/util/idutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/selectorutil"
 )
 
 const RepoSelectorTableName = "repo_selector"
@@ -62,3 +63,22 @@ func RepoSelectorsMap(repoSelectors []*RepoSelector) map[string][]*RepoSelector
 	}
 	return selectorsMap
 }
+
+// PbToSelector converts the selectors of a repo to the label selector of the
+// runtimes that the apps in the repo can be deployed to, the selector with
+// empty key is skipped.
+func PbToSelector(pbRepoSelectors []*pb.RepoSelector) (selectorutil.Selector, error) {
+	var selector selectorutil.Selector
+	for _, s := range pbRepoSelectors {
+		key := s.GetSelectorKey().GetValue()
+		if key == "" {
+			continue
+		}
+		requirement, err := selectorutil.ParseKeyValue(key, s.GetSelectorValue().GetValue())
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(requirement)
+	}
+	return selector, nil
+}
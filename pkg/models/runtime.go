@@ -32,6 +32,10 @@ type Runtime struct {
 	Status              string
 	CreateTime          time.Time
 	StatusTime          time.Time
+	HealthStatus        string
+	LastError           string
+	ApiLatency          uint32 // milliseconds
+	HealthCheckTime     *time.Time
 }
 
 var RuntimeColumnsWithTablePrefix = GetColumnsFromStructWithPrefix(RuntimeTableName, &Runtime{})
@@ -50,6 +54,7 @@ func NewRuntime(name, description, provider, runtimeUrl, runtimeCredentialId, zo
 		Status:              constants.StatusActive,
 		CreateTime:          time.Now(),
 		StatusTime:          time.Now(),
+		HealthStatus:        constants.HealthStatusUnknown,
 	}
 }
 
@@ -65,6 +70,12 @@ func RuntimeToPb(runtime *Runtime) *pb.Runtime {
 	pbRuntime.Status = pbutil.ToProtoString(runtime.Status)
 	pbRuntime.CreateTime = pbutil.ToProtoTimestamp(runtime.CreateTime)
 	pbRuntime.StatusTime = pbutil.ToProtoTimestamp(runtime.StatusTime)
+	pbRuntime.HealthStatus = pbutil.ToProtoString(runtime.HealthStatus)
+	pbRuntime.LastError = pbutil.ToProtoString(runtime.LastError)
+	pbRuntime.ApiLatency = pbutil.ToProtoUInt32(runtime.ApiLatency)
+	if runtime.HealthCheckTime != nil {
+		pbRuntime.HealthCheckTime = pbutil.ToProtoTimestamp(*runtime.HealthCheckTime)
+	}
 	return &pbRuntime
 }
 
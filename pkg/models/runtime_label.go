@@ -63,3 +63,20 @@ func RuntimeLabelsMap(runtimeLabels []*RuntimeLabel) map[string][]*RuntimeLabel
 	}
 	return labelsMap
 }
+
+// RuntimeLabelsToMap returns the labels of a runtime keyed by the label key.
+func RuntimeLabelsToMap(runtimeLabels []*RuntimeLabel) map[string]string {
+	labelMap := make(map[string]string)
+	for _, l := range runtimeLabels {
+		labelMap[l.LabelKey] = l.LabelValue
+	}
+	return labelMap
+}
+
+func PbToRuntimeLabelMap(pbRuntimeLabels []*pb.RuntimeLabel) map[string]string {
+	labelMap := make(map[string]string)
+	for _, l := range pbRuntimeLabels {
+		labelMap[l.GetLabelKey().GetValue()] = l.GetLabelValue().GetValue()
+	}
+	return labelMap
+}
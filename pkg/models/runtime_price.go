@@ -0,0 +1,126 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+const RuntimePriceTableName = "runtime_price"
+
+// RuntimePrice is the price sheet of a runtime, InstanceClassHour is the json
+// of the prices per hour of the instance classes.
+type RuntimePrice struct {
+	RuntimeId         string
+	Currency          string
+	CpuHour           float64
+	MemoryGbHour      float64
+	VolumeGbMonth     float64
+	GpuHour           float64
+	InstanceClassHour string
+	CreateTime        time.Time
+	StatusTime        time.Time
+}
+
+var RuntimePriceColumns = GetColumnsFromStruct(&RuntimePrice{})
+
+func NewRuntimePrice(runtimeId string) *RuntimePrice {
+	return &RuntimePrice{
+		RuntimeId:         runtimeId,
+		Currency:          constants.DefaultCurrency,
+		InstanceClassHour: "{}",
+		CreateTime:        time.Now(),
+		StatusTime:        time.Now(),
+	}
+}
+
+// InstanceClassKey is the key of the instance class with the cpu cores and
+// the memory in MB, such as "2c4096m".
+func InstanceClassKey(cpu, memory uint32) string {
+	return fmt.Sprintf("%dc%dm", cpu, memory)
+}
+
+func (p *RuntimePrice) GetInstanceClassHour() map[string]float64 {
+	instanceClassHour := make(map[string]float64)
+	if p.InstanceClassHour == "" {
+		return instanceClassHour
+	}
+	err := jsonutil.Decode([]byte(p.InstanceClassHour), &instanceClassHour)
+	if err != nil {
+		logger.Error("Decode instance class price of runtime [%s] failed: %+v", p.RuntimeId, err)
+	}
+	return instanceClassHour
+}
+
+func (p *RuntimePrice) SetInstanceClassHour(instanceClassHour map[string]float64) {
+	if instanceClassHour == nil {
+		instanceClassHour = make(map[string]float64)
+	}
+	p.InstanceClassHour = jsonutil.ToString(instanceClassHour)
+}
+
+// GetRoleCost returns the cost per hour of count nodes with the resources,
+// storageSize is in GB and memory is in MB. The price of the instance class
+// replaces the price of cpu and memory if it is defined.
+func (p *RuntimePrice) GetRoleCost(role string, count, cpu, gpu, memory, storageSize uint32) *pb.RoleCost {
+	n := float64(count)
+	roleCost := &pb.RoleCost{
+		Role:      pbutil.ToProtoString(role),
+		NodeCount: count,
+		Gpu:       n * float64(gpu) * p.GpuHour,
+		Volume:    n * float64(storageSize) * p.VolumeGbMonth / constants.HoursPerMonth,
+	}
+	if price, ok := p.GetInstanceClassHour()[InstanceClassKey(cpu, memory)]; ok {
+		roleCost.InstanceClass = n * price
+	} else {
+		roleCost.Cpu = n * float64(cpu) * p.CpuHour
+		roleCost.Memory = n * float64(memory) / 1024 * p.MemoryGbHour
+	}
+	roleCost.Total = roleCost.Cpu + roleCost.Memory + roleCost.Gpu + roleCost.Volume + roleCost.InstanceClass
+	return roleCost
+}
+
+func RuntimePriceToPb(runtimePrice *RuntimePrice) *pb.RuntimePrice {
+	return &pb.RuntimePrice{
+		RuntimeId:         pbutil.ToProtoString(runtimePrice.RuntimeId),
+		Currency:          pbutil.ToProtoString(runtimePrice.Currency),
+		CpuHour:           pbutil.ToProtoDouble(runtimePrice.CpuHour),
+		MemoryGbHour:      pbutil.ToProtoDouble(runtimePrice.MemoryGbHour),
+		VolumeGbMonth:     pbutil.ToProtoDouble(runtimePrice.VolumeGbMonth),
+		GpuHour:           pbutil.ToProtoDouble(runtimePrice.GpuHour),
+		InstanceClassHour: runtimePrice.GetInstanceClassHour(),
+		CreateTime:        pbutil.ToProtoTimestamp(runtimePrice.CreateTime),
+		StatusTime:        pbutil.ToProtoTimestamp(runtimePrice.StatusTime),
+	}
+}
+
+func RuntimePricesToPbs(runtimePrices []*RuntimePrice) (pbRuntimePrices []*pb.RuntimePrice) {
+	for _, runtimePrice := range runtimePrices {
+		pbRuntimePrices = append(pbRuntimePrices, RuntimePriceToPb(runtimePrice))
+	}
+	return
+}
+
+func PbToRuntimePrice(pbRuntimePrice *pb.RuntimePrice) *RuntimePrice {
+	runtimePrice := &RuntimePrice{
+		RuntimeId:     pbRuntimePrice.GetRuntimeId().GetValue(),
+		Currency:      pbRuntimePrice.GetCurrency().GetValue(),
+		CpuHour:       pbRuntimePrice.GetCpuHour().GetValue(),
+		MemoryGbHour:  pbRuntimePrice.GetMemoryGbHour().GetValue(),
+		VolumeGbMonth: pbRuntimePrice.GetVolumeGbMonth().GetValue(),
+		GpuHour:       pbRuntimePrice.GetGpuHour().GetValue(),
+		CreateTime:    pbutil.FromProtoTimestamp(pbRuntimePrice.GetCreateTime()),
+		StatusTime:    pbutil.FromProtoTimestamp(pbRuntimePrice.GetStatusTime()),
+	}
+	runtimePrice.SetInstanceClassHour(pbRuntimePrice.GetInstanceClassHour())
+	return runtimePrice
+}
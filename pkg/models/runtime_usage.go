@@ -0,0 +1,53 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+const RuntimeUsageTableName = "runtime_usage"
+
+// RuntimeUsage is the resources of the clusters of an owner in a runtime.
+type RuntimeUsage struct {
+	RuntimeId    string
+	Owner        string
+	ClusterCount uint32
+	NodeCount    uint32
+	Cpu          uint32
+	Gpu          uint32
+	Memory       uint32
+	VolumeSize   uint32
+	CreateTime   time.Time
+}
+
+var RuntimeUsageColumns = GetColumnsFromStruct(&RuntimeUsage{})
+
+func RuntimeUsageToPb(runtimeUsage *RuntimeUsage) *pb.RuntimeUsage {
+	pbRuntimeUsage := &pb.RuntimeUsage{
+		RuntimeId:    pbutil.ToProtoString(runtimeUsage.RuntimeId),
+		Owner:        pbutil.ToProtoString(runtimeUsage.Owner),
+		ClusterCount: runtimeUsage.ClusterCount,
+		NodeCount:    runtimeUsage.NodeCount,
+		Cpu:          runtimeUsage.Cpu,
+		Gpu:          runtimeUsage.Gpu,
+		Memory:       runtimeUsage.Memory,
+		VolumeSize:   runtimeUsage.VolumeSize,
+	}
+	if !runtimeUsage.CreateTime.IsZero() {
+		pbRuntimeUsage.CreateTime = pbutil.ToProtoTimestamp(runtimeUsage.CreateTime)
+	}
+	return pbRuntimeUsage
+}
+
+func RuntimeUsagesToPbs(runtimeUsages []*RuntimeUsage) (pbRuntimeUsages []*pb.RuntimeUsage) {
+	for _, runtimeUsage := range runtimeUsages {
+		pbRuntimeUsages = append(pbRuntimeUsages, RuntimeUsageToPb(runtimeUsage))
+	}
+	return
+}
@@ -50,7 +50,7 @@ func (m *CreateAppRequest) Reset()         { *m = CreateAppRequest{} }
 func (m *CreateAppRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAppRequest) ProtoMessage()    {}
 func (*CreateAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{0}
+	return fileDescriptor_app_1ed27684b39fe474, []int{0}
 }
 func (m *CreateAppRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateAppRequest.Unmarshal(m, b)
@@ -172,7 +172,7 @@ func (m *CreateAppResponse) Reset()         { *m = CreateAppResponse{} }
 func (m *CreateAppResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateAppResponse) ProtoMessage()    {}
 func (*CreateAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{1}
+	return fileDescriptor_app_1ed27684b39fe474, []int{1}
 }
 func (m *CreateAppResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateAppResponse.Unmarshal(m, b)
@@ -223,7 +223,7 @@ func (m *ModifyAppRequest) Reset()         { *m = ModifyAppRequest{} }
 func (m *ModifyAppRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyAppRequest) ProtoMessage()    {}
 func (*ModifyAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{2}
+	return fileDescriptor_app_1ed27684b39fe474, []int{2}
 }
 func (m *ModifyAppRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyAppRequest.Unmarshal(m, b)
@@ -352,7 +352,7 @@ func (m *ModifyAppResponse) Reset()         { *m = ModifyAppResponse{} }
 func (m *ModifyAppResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyAppResponse) ProtoMessage()    {}
 func (*ModifyAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{3}
+	return fileDescriptor_app_1ed27684b39fe474, []int{3}
 }
 func (m *ModifyAppResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyAppResponse.Unmarshal(m, b)
@@ -390,7 +390,7 @@ func (m *DeleteAppsRequest) Reset()         { *m = DeleteAppsRequest{} }
 func (m *DeleteAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAppsRequest) ProtoMessage()    {}
 func (*DeleteAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{4}
+	return fileDescriptor_app_1ed27684b39fe474, []int{4}
 }
 func (m *DeleteAppsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAppsRequest.Unmarshal(m, b)
@@ -428,7 +428,7 @@ func (m *DeleteAppsResponse) Reset()         { *m = DeleteAppsResponse{} }
 func (m *DeleteAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAppsResponse) ProtoMessage()    {}
 func (*DeleteAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{5}
+	return fileDescriptor_app_1ed27684b39fe474, []int{5}
 }
 func (m *DeleteAppsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAppsResponse.Unmarshal(m, b)
@@ -484,7 +484,7 @@ func (m *App) Reset()         { *m = App{} }
 func (m *App) String() string { return proto.CompactTextString(m) }
 func (*App) ProtoMessage()    {}
 func (*App) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{6}
+	return fileDescriptor_app_1ed27684b39fe474, []int{6}
 }
 func (m *App) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_App.Unmarshal(m, b)
@@ -638,18 +638,20 @@ func (m *App) GetLatestAppVersion() *AppVersion {
 }
 
 type DescribeAppsRequest struct {
-	SearchWord           *wrappers.StringValue `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
-	Limit                uint32                `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               uint32                `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	SortKey              *wrappers.StringValue `protobuf:"bytes,5,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
-	Reverse              *wrappers.BoolValue   `protobuf:"bytes,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	AppId                []string              `protobuf:"bytes,7,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Name                 []string              `protobuf:"bytes,8,rep,name=name,proto3" json:"name,omitempty"`
-	RepoId               []string              `protobuf:"bytes,9,rep,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
-	Status               []string              `protobuf:"bytes,10,rep,name=status,proto3" json:"status,omitempty"`
-	Owner                []string              `protobuf:"bytes,11,rep,name=owner,proto3" json:"owner,omitempty"`
-	ChartName            []string              `protobuf:"bytes,12,rep,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`
-	CategoryId           []string              `protobuf:"bytes,13,rep,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SearchWord *wrappers.StringValue `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
+	Limit      uint32                `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset     uint32                `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	SortKey    *wrappers.StringValue `protobuf:"bytes,5,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	Reverse    *wrappers.BoolValue   `protobuf:"bytes,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	AppId      []string              `protobuf:"bytes,7,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Name       []string              `protobuf:"bytes,8,rep,name=name,proto3" json:"name,omitempty"`
+	RepoId     []string              `protobuf:"bytes,9,rep,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	Status     []string              `protobuf:"bytes,10,rep,name=status,proto3" json:"status,omitempty"`
+	Owner      []string              `protobuf:"bytes,11,rep,name=owner,proto3" json:"owner,omitempty"`
+	ChartName  []string              `protobuf:"bytes,12,rep,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`
+	CategoryId []string              `protobuf:"bytes,13,rep,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// apps in the repos whose selectors match the labels of the runtime
+	RuntimeId            *wrappers.StringValue `protobuf:"bytes,14,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -659,7 +661,7 @@ func (m *DescribeAppsRequest) Reset()         { *m = DescribeAppsRequest{} }
 func (m *DescribeAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeAppsRequest) ProtoMessage()    {}
 func (*DescribeAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{7}
+	return fileDescriptor_app_1ed27684b39fe474, []int{7}
 }
 func (m *DescribeAppsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeAppsRequest.Unmarshal(m, b)
@@ -763,6 +765,13 @@ func (m *DescribeAppsRequest) GetCategoryId() []string {
 	return nil
 }
 
+func (m *DescribeAppsRequest) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
 type DescribeAppsResponse struct {
 	TotalCount           uint32   `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	AppSet               []*App   `protobuf:"bytes,2,rep,name=app_set,json=appSet,proto3" json:"app_set,omitempty"`
@@ -775,7 +784,7 @@ func (m *DescribeAppsResponse) Reset()         { *m = DescribeAppsResponse{} }
 func (m *DescribeAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeAppsResponse) ProtoMessage()    {}
 func (*DescribeAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{8}
+	return fileDescriptor_app_1ed27684b39fe474, []int{8}
 }
 func (m *DescribeAppsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeAppsResponse.Unmarshal(m, b)
@@ -809,600 +818,2025 @@ func (m *DescribeAppsResponse) GetAppSet() []*App {
 	return nil
 }
 
-type CreateAppVersionRequest struct {
-	AppId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Owner                *wrappers.StringValue `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Name                 *wrappers.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	PackageName          *wrappers.StringValue `protobuf:"bytes,4,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	Description          *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Sequence             *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type SearchAppsRequest struct {
+	// full-text search on the name, description, keywords, maintainers, readme and latest version of app
+	SearchWord *wrappers.StringValue `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
+	CategoryId []string              `protobuf:"bytes,2,rep,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	RepoId     []string              `protobuf:"bytes,3,rep,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	Provider   []string              `protobuf:"bytes,4,rep,name=provider,proto3" json:"provider,omitempty"`
+	Status     []string              `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
+	Keyword    []string              `protobuf:"bytes,6,rep,name=keyword,proto3" json:"keyword,omitempty"`
+	// name of the maintainer
+	Maintainer           []string `protobuf:"bytes,7,rep,name=maintainer,proto3" json:"maintainer,omitempty"`
+	Limit                uint32   `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               uint32   `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateAppVersionRequest) Reset()         { *m = CreateAppVersionRequest{} }
-func (m *CreateAppVersionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateAppVersionRequest) ProtoMessage()    {}
-func (*CreateAppVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{9}
+func (m *SearchAppsRequest) Reset()         { *m = SearchAppsRequest{} }
+func (m *SearchAppsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchAppsRequest) ProtoMessage()    {}
+func (*SearchAppsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{9}
 }
-func (m *CreateAppVersionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateAppVersionRequest.Unmarshal(m, b)
+func (m *SearchAppsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchAppsRequest.Unmarshal(m, b)
 }
-func (m *CreateAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateAppVersionRequest.Marshal(b, m, deterministic)
+func (m *SearchAppsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchAppsRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateAppVersionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateAppVersionRequest.Merge(dst, src)
+func (dst *SearchAppsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchAppsRequest.Merge(dst, src)
 }
-func (m *CreateAppVersionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateAppVersionRequest.Size(m)
+func (m *SearchAppsRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchAppsRequest.Size(m)
 }
-func (m *CreateAppVersionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateAppVersionRequest.DiscardUnknown(m)
+func (m *SearchAppsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchAppsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateAppVersionRequest proto.InternalMessageInfo
+var xxx_messageInfo_SearchAppsRequest proto.InternalMessageInfo
 
-func (m *CreateAppVersionRequest) GetAppId() *wrappers.StringValue {
+func (m *SearchAppsRequest) GetSearchWord() *wrappers.StringValue {
 	if m != nil {
-		return m.AppId
+		return m.SearchWord
 	}
 	return nil
 }
 
-func (m *CreateAppVersionRequest) GetOwner() *wrappers.StringValue {
+func (m *SearchAppsRequest) GetCategoryId() []string {
 	if m != nil {
-		return m.Owner
+		return m.CategoryId
 	}
 	return nil
 }
 
-func (m *CreateAppVersionRequest) GetName() *wrappers.StringValue {
+func (m *SearchAppsRequest) GetRepoId() []string {
 	if m != nil {
-		return m.Name
+		return m.RepoId
 	}
 	return nil
 }
 
-func (m *CreateAppVersionRequest) GetPackageName() *wrappers.StringValue {
+func (m *SearchAppsRequest) GetProvider() []string {
 	if m != nil {
-		return m.PackageName
+		return m.Provider
 	}
 	return nil
 }
 
-func (m *CreateAppVersionRequest) GetDescription() *wrappers.StringValue {
+func (m *SearchAppsRequest) GetStatus() []string {
 	if m != nil {
-		return m.Description
+		return m.Status
 	}
 	return nil
 }
 
-func (m *CreateAppVersionRequest) GetSequence() *wrappers.UInt32Value {
+func (m *SearchAppsRequest) GetKeyword() []string {
 	if m != nil {
-		return m.Sequence
+		return m.Keyword
 	}
 	return nil
 }
 
-type CreateAppVersionResponse struct {
-	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+func (m *SearchAppsRequest) GetMaintainer() []string {
+	if m != nil {
+		return m.Maintainer
+	}
+	return nil
+}
+
+func (m *SearchAppsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchAppsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type SearchFacetValue struct {
+	Value                *wrappers.StringValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count                uint32                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *CreateAppVersionResponse) Reset()         { *m = CreateAppVersionResponse{} }
-func (m *CreateAppVersionResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateAppVersionResponse) ProtoMessage()    {}
-func (*CreateAppVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{10}
+func (m *SearchFacetValue) Reset()         { *m = SearchFacetValue{} }
+func (m *SearchFacetValue) String() string { return proto.CompactTextString(m) }
+func (*SearchFacetValue) ProtoMessage()    {}
+func (*SearchFacetValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{10}
 }
-func (m *CreateAppVersionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateAppVersionResponse.Unmarshal(m, b)
+func (m *SearchFacetValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchFacetValue.Unmarshal(m, b)
 }
-func (m *CreateAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateAppVersionResponse.Marshal(b, m, deterministic)
+func (m *SearchFacetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchFacetValue.Marshal(b, m, deterministic)
 }
-func (dst *CreateAppVersionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateAppVersionResponse.Merge(dst, src)
+func (dst *SearchFacetValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchFacetValue.Merge(dst, src)
 }
-func (m *CreateAppVersionResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateAppVersionResponse.Size(m)
+func (m *SearchFacetValue) XXX_Size() int {
+	return xxx_messageInfo_SearchFacetValue.Size(m)
 }
-func (m *CreateAppVersionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateAppVersionResponse.DiscardUnknown(m)
+func (m *SearchFacetValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchFacetValue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateAppVersionResponse proto.InternalMessageInfo
+var xxx_messageInfo_SearchFacetValue proto.InternalMessageInfo
 
-func (m *CreateAppVersionResponse) GetVersionId() *wrappers.StringValue {
+func (m *SearchFacetValue) GetValue() *wrappers.StringValue {
 	if m != nil {
-		return m.VersionId
+		return m.Value
 	}
 	return nil
 }
 
-type ModifyAppVersionRequest struct {
-	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	Name                 *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PackageName          *wrappers.StringValue `protobuf:"bytes,3,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	Owner                *wrappers.StringValue `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Description          *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Sequence             *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+func (m *SearchFacetValue) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type SearchFacet struct {
+	// category_id, repo_id, provider or status
+	Name                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValueSet             []*SearchFacetValue   `protobuf:"bytes,2,rep,name=value_set,json=valueSet,proto3" json:"value_set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ModifyAppVersionRequest) Reset()         { *m = ModifyAppVersionRequest{} }
-func (m *ModifyAppVersionRequest) String() string { return proto.CompactTextString(m) }
-func (*ModifyAppVersionRequest) ProtoMessage()    {}
-func (*ModifyAppVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{11}
+func (m *SearchFacet) Reset()         { *m = SearchFacet{} }
+func (m *SearchFacet) String() string { return proto.CompactTextString(m) }
+func (*SearchFacet) ProtoMessage()    {}
+func (*SearchFacet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{11}
 }
-func (m *ModifyAppVersionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ModifyAppVersionRequest.Unmarshal(m, b)
+func (m *SearchFacet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchFacet.Unmarshal(m, b)
 }
-func (m *ModifyAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ModifyAppVersionRequest.Marshal(b, m, deterministic)
+func (m *SearchFacet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchFacet.Marshal(b, m, deterministic)
 }
-func (dst *ModifyAppVersionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyAppVersionRequest.Merge(dst, src)
+func (dst *SearchFacet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchFacet.Merge(dst, src)
 }
-func (m *ModifyAppVersionRequest) XXX_Size() int {
-	return xxx_messageInfo_ModifyAppVersionRequest.Size(m)
+func (m *SearchFacet) XXX_Size() int {
+	return xxx_messageInfo_SearchFacet.Size(m)
 }
-func (m *ModifyAppVersionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyAppVersionRequest.DiscardUnknown(m)
+func (m *SearchFacet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchFacet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyAppVersionRequest proto.InternalMessageInfo
+var xxx_messageInfo_SearchFacet proto.InternalMessageInfo
 
-func (m *ModifyAppVersionRequest) GetVersionId() *wrappers.StringValue {
+func (m *SearchFacet) GetName() *wrappers.StringValue {
 	if m != nil {
-		return m.VersionId
+		return m.Name
 	}
 	return nil
 }
 
-func (m *ModifyAppVersionRequest) GetName() *wrappers.StringValue {
+func (m *SearchFacet) GetValueSet() []*SearchFacetValue {
 	if m != nil {
-		return m.Name
+		return m.ValueSet
 	}
 	return nil
 }
 
-func (m *ModifyAppVersionRequest) GetPackageName() *wrappers.StringValue {
-	if m != nil {
-		return m.PackageName
-	}
-	return nil
+type SearchAppsResponse struct {
+	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// ranked by the relevance to search_word
+	AppSet []*App `protobuf:"bytes,2,rep,name=app_set,json=appSet,proto3" json:"app_set,omitempty"`
+	// the number of the matched apps of each value, the filter of the facet itself is ignored
+	FacetSet             []*SearchFacet `protobuf:"bytes,3,rep,name=facet_set,json=facetSet,proto3" json:"facet_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ModifyAppVersionRequest) GetOwner() *wrappers.StringValue {
+func (m *SearchAppsResponse) Reset()         { *m = SearchAppsResponse{} }
+func (m *SearchAppsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchAppsResponse) ProtoMessage()    {}
+func (*SearchAppsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{12}
+}
+func (m *SearchAppsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchAppsResponse.Unmarshal(m, b)
+}
+func (m *SearchAppsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchAppsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SearchAppsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchAppsResponse.Merge(dst, src)
+}
+func (m *SearchAppsResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchAppsResponse.Size(m)
+}
+func (m *SearchAppsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchAppsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchAppsResponse proto.InternalMessageInfo
+
+func (m *SearchAppsResponse) GetTotalCount() uint32 {
 	if m != nil {
-		return m.Owner
+		return m.TotalCount
 	}
-	return nil
+	return 0
 }
 
-func (m *ModifyAppVersionRequest) GetDescription() *wrappers.StringValue {
+func (m *SearchAppsResponse) GetAppSet() []*App {
 	if m != nil {
-		return m.Description
+		return m.AppSet
 	}
 	return nil
 }
 
-func (m *ModifyAppVersionRequest) GetSequence() *wrappers.UInt32Value {
+func (m *SearchAppsResponse) GetFacetSet() []*SearchFacet {
 	if m != nil {
-		return m.Sequence
+		return m.FacetSet
 	}
 	return nil
 }
 
-type ModifyAppVersionResponse struct {
-	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+// AppAttachment is a file of the package shown with the app, e.g. the icon
+type AppAttachment struct {
+	// the path in the package, the extension decides the content type
+	Name                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content              []byte                `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ModifyAppVersionResponse) Reset()         { *m = ModifyAppVersionResponse{} }
-func (m *ModifyAppVersionResponse) String() string { return proto.CompactTextString(m) }
-func (*ModifyAppVersionResponse) ProtoMessage()    {}
-func (*ModifyAppVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{12}
+func (m *AppAttachment) Reset()         { *m = AppAttachment{} }
+func (m *AppAttachment) String() string { return proto.CompactTextString(m) }
+func (*AppAttachment) ProtoMessage()    {}
+func (*AppAttachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{13}
 }
-func (m *ModifyAppVersionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ModifyAppVersionResponse.Unmarshal(m, b)
+func (m *AppAttachment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppAttachment.Unmarshal(m, b)
 }
-func (m *ModifyAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ModifyAppVersionResponse.Marshal(b, m, deterministic)
+func (m *AppAttachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppAttachment.Marshal(b, m, deterministic)
 }
-func (dst *ModifyAppVersionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyAppVersionResponse.Merge(dst, src)
+func (dst *AppAttachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppAttachment.Merge(dst, src)
 }
-func (m *ModifyAppVersionResponse) XXX_Size() int {
-	return xxx_messageInfo_ModifyAppVersionResponse.Size(m)
+func (m *AppAttachment) XXX_Size() int {
+	return xxx_messageInfo_AppAttachment.Size(m)
 }
-func (m *ModifyAppVersionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyAppVersionResponse.DiscardUnknown(m)
+func (m *AppAttachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppAttachment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyAppVersionResponse proto.InternalMessageInfo
+var xxx_messageInfo_AppAttachment proto.InternalMessageInfo
 
-func (m *ModifyAppVersionResponse) GetVersionId() *wrappers.StringValue {
+func (m *AppAttachment) GetName() *wrappers.StringValue {
 	if m != nil {
-		return m.VersionId
+		return m.Name
 	}
 	return nil
 }
 
-type DeleteAppVersionsRequest struct {
-	VersionId            []string `protobuf:"bytes,1,rep,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AppAttachment) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
 }
 
-func (m *DeleteAppVersionsRequest) Reset()         { *m = DeleteAppVersionsRequest{} }
-func (m *DeleteAppVersionsRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAppVersionsRequest) ProtoMessage()    {}
-func (*DeleteAppVersionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{13}
+type UploadAppAttachmentsRequest struct {
+	AppId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Readme               *AppAttachment        `protobuf:"bytes,2,opt,name=readme,proto3" json:"readme,omitempty"`
+	Icon                 *AppAttachment        `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Screenshots          []*AppAttachment      `protobuf:"bytes,4,rep,name=screenshots,proto3" json:"screenshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *DeleteAppVersionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAppVersionsRequest.Unmarshal(m, b)
+
+func (m *UploadAppAttachmentsRequest) Reset()         { *m = UploadAppAttachmentsRequest{} }
+func (m *UploadAppAttachmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadAppAttachmentsRequest) ProtoMessage()    {}
+func (*UploadAppAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{14}
 }
-func (m *DeleteAppVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAppVersionsRequest.Marshal(b, m, deterministic)
+func (m *UploadAppAttachmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadAppAttachmentsRequest.Unmarshal(m, b)
 }
-func (dst *DeleteAppVersionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAppVersionsRequest.Merge(dst, src)
+func (m *UploadAppAttachmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadAppAttachmentsRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteAppVersionsRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAppVersionsRequest.Size(m)
+func (dst *UploadAppAttachmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadAppAttachmentsRequest.Merge(dst, src)
 }
-func (m *DeleteAppVersionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAppVersionsRequest.DiscardUnknown(m)
+func (m *UploadAppAttachmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadAppAttachmentsRequest.Size(m)
+}
+func (m *UploadAppAttachmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadAppAttachmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAppVersionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_UploadAppAttachmentsRequest proto.InternalMessageInfo
 
-func (m *DeleteAppVersionsRequest) GetVersionId() []string {
+func (m *UploadAppAttachmentsRequest) GetAppId() *wrappers.StringValue {
 	if m != nil {
-		return m.VersionId
+		return m.AppId
 	}
 	return nil
 }
 
-type DeleteAppVersionsResponse struct {
-	VersionId            []string `protobuf:"bytes,1,rep,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UploadAppAttachmentsRequest) GetReadme() *AppAttachment {
+	if m != nil {
+		return m.Readme
+	}
+	return nil
 }
 
-func (m *DeleteAppVersionsResponse) Reset()         { *m = DeleteAppVersionsResponse{} }
-func (m *DeleteAppVersionsResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteAppVersionsResponse) ProtoMessage()    {}
-func (*DeleteAppVersionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{14}
+func (m *UploadAppAttachmentsRequest) GetIcon() *AppAttachment {
+	if m != nil {
+		return m.Icon
+	}
+	return nil
 }
-func (m *DeleteAppVersionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAppVersionsResponse.Unmarshal(m, b)
+
+func (m *UploadAppAttachmentsRequest) GetScreenshots() []*AppAttachment {
+	if m != nil {
+		return m.Screenshots
+	}
+	return nil
 }
-func (m *DeleteAppVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAppVersionsResponse.Marshal(b, m, deterministic)
+
+type UploadAppAttachmentsResponse struct {
+	AppId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (dst *DeleteAppVersionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAppVersionsResponse.Merge(dst, src)
+
+func (m *UploadAppAttachmentsResponse) Reset()         { *m = UploadAppAttachmentsResponse{} }
+func (m *UploadAppAttachmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadAppAttachmentsResponse) ProtoMessage()    {}
+func (*UploadAppAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{15}
 }
-func (m *DeleteAppVersionsResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteAppVersionsResponse.Size(m)
+func (m *UploadAppAttachmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadAppAttachmentsResponse.Unmarshal(m, b)
 }
-func (m *DeleteAppVersionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAppVersionsResponse.DiscardUnknown(m)
+func (m *UploadAppAttachmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadAppAttachmentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *UploadAppAttachmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadAppAttachmentsResponse.Merge(dst, src)
+}
+func (m *UploadAppAttachmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadAppAttachmentsResponse.Size(m)
+}
+func (m *UploadAppAttachmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadAppAttachmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAppVersionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_UploadAppAttachmentsResponse proto.InternalMessageInfo
 
-func (m *DeleteAppVersionsResponse) GetVersionId() []string {
+func (m *UploadAppAttachmentsResponse) GetAppId() *wrappers.StringValue {
 	if m != nil {
-		return m.VersionId
+		return m.AppId
 	}
 	return nil
 }
 
-type AppVersion struct {
-	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	AppId                *wrappers.StringValue `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Owner                *wrappers.StringValue `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	Name                 *wrappers.StringValue `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description          *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	PackageName          *wrappers.StringValue `protobuf:"bytes,6,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	Status               *wrappers.StringValue `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	CreateTime           *timestamp.Timestamp  `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	StatusTime           *timestamp.Timestamp  `protobuf:"bytes,9,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
-	UpdateTime           *timestamp.Timestamp  `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	Sequence             uint32                `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+type GetAppAttachmentRequest struct {
+	// the sha256 of the content with the extension, e.g. 2c26b46b...7ae.png
+	AttachmentId         *wrappers.StringValue `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *AppVersion) Reset()         { *m = AppVersion{} }
-func (m *AppVersion) String() string { return proto.CompactTextString(m) }
-func (*AppVersion) ProtoMessage()    {}
-func (*AppVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{15}
+func (m *GetAppAttachmentRequest) Reset()         { *m = GetAppAttachmentRequest{} }
+func (m *GetAppAttachmentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAppAttachmentRequest) ProtoMessage()    {}
+func (*GetAppAttachmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{16}
 }
-func (m *AppVersion) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AppVersion.Unmarshal(m, b)
+func (m *GetAppAttachmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAppAttachmentRequest.Unmarshal(m, b)
 }
-func (m *AppVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AppVersion.Marshal(b, m, deterministic)
+func (m *GetAppAttachmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAppAttachmentRequest.Marshal(b, m, deterministic)
 }
-func (dst *AppVersion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppVersion.Merge(dst, src)
+func (dst *GetAppAttachmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAppAttachmentRequest.Merge(dst, src)
 }
-func (m *AppVersion) XXX_Size() int {
-	return xxx_messageInfo_AppVersion.Size(m)
+func (m *GetAppAttachmentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAppAttachmentRequest.Size(m)
 }
-func (m *AppVersion) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppVersion.DiscardUnknown(m)
+func (m *GetAppAttachmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAppAttachmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AppVersion proto.InternalMessageInfo
+var xxx_messageInfo_GetAppAttachmentRequest proto.InternalMessageInfo
 
-func (m *AppVersion) GetVersionId() *wrappers.StringValue {
+func (m *GetAppAttachmentRequest) GetAttachmentId() *wrappers.StringValue {
 	if m != nil {
-		return m.VersionId
+		return m.AttachmentId
 	}
 	return nil
 }
 
-func (m *AppVersion) GetAppId() *wrappers.StringValue {
-	if m != nil {
+type GetAppAttachmentResponse struct {
+	AttachmentId         *wrappers.StringValue `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	ContentType          *wrappers.StringValue `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content              []byte                `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetAppAttachmentResponse) Reset()         { *m = GetAppAttachmentResponse{} }
+func (m *GetAppAttachmentResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAppAttachmentResponse) ProtoMessage()    {}
+func (*GetAppAttachmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{17}
+}
+func (m *GetAppAttachmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAppAttachmentResponse.Unmarshal(m, b)
+}
+func (m *GetAppAttachmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAppAttachmentResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAppAttachmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAppAttachmentResponse.Merge(dst, src)
+}
+func (m *GetAppAttachmentResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAppAttachmentResponse.Size(m)
+}
+func (m *GetAppAttachmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAppAttachmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAppAttachmentResponse proto.InternalMessageInfo
+
+func (m *GetAppAttachmentResponse) GetAttachmentId() *wrappers.StringValue {
+	if m != nil {
+		return m.AttachmentId
+	}
+	return nil
+}
+
+func (m *GetAppAttachmentResponse) GetContentType() *wrappers.StringValue {
+	if m != nil {
+		return m.ContentType
+	}
+	return nil
+}
+
+func (m *GetAppAttachmentResponse) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type CreateAppVersionRequest struct {
+	AppId       *wrappers.StringValue `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Owner       *wrappers.StringValue `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name        *wrappers.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PackageName *wrappers.StringValue `protobuf:"bytes,4,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	Description *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Sequence    *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// semver constraint of the versions that can upgrade to this version, e.g. ">=1.0.0, <2.0.0"
+	UpgradeFrom          *wrappers.StringValue `protobuf:"bytes,8,opt,name=upgrade_from,json=upgradeFrom,proto3" json:"upgrade_from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateAppVersionRequest) Reset()         { *m = CreateAppVersionRequest{} }
+func (m *CreateAppVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAppVersionRequest) ProtoMessage()    {}
+func (*CreateAppVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{18}
+}
+func (m *CreateAppVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAppVersionRequest.Unmarshal(m, b)
+}
+func (m *CreateAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAppVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateAppVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAppVersionRequest.Merge(dst, src)
+}
+func (m *CreateAppVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAppVersionRequest.Size(m)
+}
+func (m *CreateAppVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAppVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAppVersionRequest proto.InternalMessageInfo
+
+func (m *CreateAppVersionRequest) GetAppId() *wrappers.StringValue {
+	if m != nil {
 		return m.AppId
 	}
 	return nil
 }
 
-func (m *AppVersion) GetOwner() *wrappers.StringValue {
+func (m *CreateAppVersionRequest) GetOwner() *wrappers.StringValue {
 	if m != nil {
 		return m.Owner
 	}
 	return nil
 }
 
-func (m *AppVersion) GetName() *wrappers.StringValue {
+func (m *CreateAppVersionRequest) GetName() *wrappers.StringValue {
 	if m != nil {
 		return m.Name
 	}
 	return nil
 }
 
-func (m *AppVersion) GetDescription() *wrappers.StringValue {
+func (m *CreateAppVersionRequest) GetPackageName() *wrappers.StringValue {
+	if m != nil {
+		return m.PackageName
+	}
+	return nil
+}
+
+func (m *CreateAppVersionRequest) GetDescription() *wrappers.StringValue {
 	if m != nil {
 		return m.Description
 	}
 	return nil
 }
 
-func (m *AppVersion) GetPackageName() *wrappers.StringValue {
+func (m *CreateAppVersionRequest) GetSequence() *wrappers.UInt32Value {
 	if m != nil {
-		return m.PackageName
+		return m.Sequence
 	}
 	return nil
 }
 
-func (m *AppVersion) GetStatus() *wrappers.StringValue {
+func (m *CreateAppVersionRequest) GetUpgradeFrom() *wrappers.StringValue {
 	if m != nil {
-		return m.Status
+		return m.UpgradeFrom
 	}
 	return nil
 }
 
-func (m *AppVersion) GetCreateTime() *timestamp.Timestamp {
+type CreateAppVersionResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateAppVersionResponse) Reset()         { *m = CreateAppVersionResponse{} }
+func (m *CreateAppVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAppVersionResponse) ProtoMessage()    {}
+func (*CreateAppVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{19}
+}
+func (m *CreateAppVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAppVersionResponse.Unmarshal(m, b)
+}
+func (m *CreateAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAppVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateAppVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAppVersionResponse.Merge(dst, src)
+}
+func (m *CreateAppVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAppVersionResponse.Size(m)
+}
+func (m *CreateAppVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAppVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAppVersionResponse proto.InternalMessageInfo
+
+func (m *CreateAppVersionResponse) GetVersionId() *wrappers.StringValue {
 	if m != nil {
-		return m.CreateTime
+		return m.VersionId
 	}
 	return nil
 }
 
-func (m *AppVersion) GetStatusTime() *timestamp.Timestamp {
+type ModifyAppVersionRequest struct {
+	VersionId   *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Name        *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PackageName *wrappers.StringValue `protobuf:"bytes,3,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	Owner       *wrappers.StringValue `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Description *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Sequence    *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// semver constraint of the versions that can upgrade to this version, e.g. ">=1.0.0, <2.0.0"
+	UpgradeFrom          *wrappers.StringValue `protobuf:"bytes,8,opt,name=upgrade_from,json=upgradeFrom,proto3" json:"upgrade_from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyAppVersionRequest) Reset()         { *m = ModifyAppVersionRequest{} }
+func (m *ModifyAppVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyAppVersionRequest) ProtoMessage()    {}
+func (*ModifyAppVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{20}
+}
+func (m *ModifyAppVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyAppVersionRequest.Unmarshal(m, b)
+}
+func (m *ModifyAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyAppVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *ModifyAppVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyAppVersionRequest.Merge(dst, src)
+}
+func (m *ModifyAppVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyAppVersionRequest.Size(m)
+}
+func (m *ModifyAppVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyAppVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyAppVersionRequest proto.InternalMessageInfo
+
+func (m *ModifyAppVersionRequest) GetVersionId() *wrappers.StringValue {
 	if m != nil {
-		return m.StatusTime
+		return m.VersionId
 	}
 	return nil
 }
 
-func (m *AppVersion) GetUpdateTime() *timestamp.Timestamp {
+func (m *ModifyAppVersionRequest) GetName() *wrappers.StringValue {
 	if m != nil {
-		return m.UpdateTime
+		return m.Name
 	}
 	return nil
 }
 
-func (m *AppVersion) GetSequence() uint32 {
+func (m *ModifyAppVersionRequest) GetPackageName() *wrappers.StringValue {
+	if m != nil {
+		return m.PackageName
+	}
+	return nil
+}
+
+func (m *ModifyAppVersionRequest) GetOwner() *wrappers.StringValue {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *ModifyAppVersionRequest) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *ModifyAppVersionRequest) GetSequence() *wrappers.UInt32Value {
 	if m != nil {
 		return m.Sequence
 	}
-	return 0
+	return nil
+}
+
+func (m *ModifyAppVersionRequest) GetUpgradeFrom() *wrappers.StringValue {
+	if m != nil {
+		return m.UpgradeFrom
+	}
+	return nil
+}
+
+type ModifyAppVersionResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyAppVersionResponse) Reset()         { *m = ModifyAppVersionResponse{} }
+func (m *ModifyAppVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyAppVersionResponse) ProtoMessage()    {}
+func (*ModifyAppVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{21}
+}
+func (m *ModifyAppVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyAppVersionResponse.Unmarshal(m, b)
+}
+func (m *ModifyAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyAppVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *ModifyAppVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyAppVersionResponse.Merge(dst, src)
+}
+func (m *ModifyAppVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyAppVersionResponse.Size(m)
+}
+func (m *ModifyAppVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyAppVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyAppVersionResponse proto.InternalMessageInfo
+
+func (m *ModifyAppVersionResponse) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+type ModifyAppVersionSignatureRequest struct {
+	VersionId *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// verified/unverified, empty if the repo has no trusted keys
+	SignatureStatus      *wrappers.StringValue `protobuf:"bytes,2,opt,name=signature_status,json=signatureStatus,proto3" json:"signature_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyAppVersionSignatureRequest) Reset()         { *m = ModifyAppVersionSignatureRequest{} }
+func (m *ModifyAppVersionSignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyAppVersionSignatureRequest) ProtoMessage()    {}
+func (*ModifyAppVersionSignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{22}
+}
+func (m *ModifyAppVersionSignatureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyAppVersionSignatureRequest.Unmarshal(m, b)
+}
+func (m *ModifyAppVersionSignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyAppVersionSignatureRequest.Marshal(b, m, deterministic)
+}
+func (dst *ModifyAppVersionSignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyAppVersionSignatureRequest.Merge(dst, src)
+}
+func (m *ModifyAppVersionSignatureRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyAppVersionSignatureRequest.Size(m)
+}
+func (m *ModifyAppVersionSignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyAppVersionSignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyAppVersionSignatureRequest proto.InternalMessageInfo
+
+func (m *ModifyAppVersionSignatureRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *ModifyAppVersionSignatureRequest) GetSignatureStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.SignatureStatus
+	}
+	return nil
+}
+
+type ModifyAppVersionSignatureResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyAppVersionSignatureResponse) Reset()         { *m = ModifyAppVersionSignatureResponse{} }
+func (m *ModifyAppVersionSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyAppVersionSignatureResponse) ProtoMessage()    {}
+func (*ModifyAppVersionSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{23}
+}
+func (m *ModifyAppVersionSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyAppVersionSignatureResponse.Unmarshal(m, b)
+}
+func (m *ModifyAppVersionSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyAppVersionSignatureResponse.Marshal(b, m, deterministic)
+}
+func (dst *ModifyAppVersionSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyAppVersionSignatureResponse.Merge(dst, src)
+}
+func (m *ModifyAppVersionSignatureResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyAppVersionSignatureResponse.Size(m)
+}
+func (m *ModifyAppVersionSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyAppVersionSignatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyAppVersionSignatureResponse proto.InternalMessageInfo
+
+func (m *ModifyAppVersionSignatureResponse) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+type DeleteAppVersionsRequest struct {
+	VersionId            []string `protobuf:"bytes,1,rep,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAppVersionsRequest) Reset()         { *m = DeleteAppVersionsRequest{} }
+func (m *DeleteAppVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAppVersionsRequest) ProtoMessage()    {}
+func (*DeleteAppVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{24}
+}
+func (m *DeleteAppVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAppVersionsRequest.Unmarshal(m, b)
+}
+func (m *DeleteAppVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAppVersionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAppVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAppVersionsRequest.Merge(dst, src)
+}
+func (m *DeleteAppVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAppVersionsRequest.Size(m)
+}
+func (m *DeleteAppVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAppVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAppVersionsRequest proto.InternalMessageInfo
+
+func (m *DeleteAppVersionsRequest) GetVersionId() []string {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+type DeleteAppVersionsResponse struct {
+	VersionId            []string `protobuf:"bytes,1,rep,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAppVersionsResponse) Reset()         { *m = DeleteAppVersionsResponse{} }
+func (m *DeleteAppVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAppVersionsResponse) ProtoMessage()    {}
+func (*DeleteAppVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{25}
+}
+func (m *DeleteAppVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAppVersionsResponse.Unmarshal(m, b)
+}
+func (m *DeleteAppVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAppVersionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAppVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAppVersionsResponse.Merge(dst, src)
+}
+func (m *DeleteAppVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAppVersionsResponse.Size(m)
+}
+func (m *DeleteAppVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAppVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAppVersionsResponse proto.InternalMessageInfo
+
+func (m *DeleteAppVersionsResponse) GetVersionId() []string {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+type AppVersion struct {
+	VersionId   *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	AppId       *wrappers.StringValue `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Owner       *wrappers.StringValue `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name        *wrappers.StringValue `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	PackageName *wrappers.StringValue `protobuf:"bytes,6,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	Status      *wrappers.StringValue `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreateTime  *timestamp.Timestamp  `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	StatusTime  *timestamp.Timestamp  `protobuf:"bytes,9,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	UpdateTime  *timestamp.Timestamp  `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Sequence    uint32                `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// verified/unverified, empty if the repo has no trusted keys
+	SignatureStatus *wrappers.StringValue `protobuf:"bytes,12,opt,name=signature_status,json=signatureStatus,proto3" json:"signature_status,omitempty"`
+	// semver constraint of the versions that can upgrade to this version
+	UpgradeFrom          *wrappers.StringValue `protobuf:"bytes,13,opt,name=upgrade_from,json=upgradeFrom,proto3" json:"upgrade_from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AppVersion) Reset()         { *m = AppVersion{} }
+func (m *AppVersion) String() string { return proto.CompactTextString(m) }
+func (*AppVersion) ProtoMessage()    {}
+func (*AppVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{26}
+}
+func (m *AppVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppVersion.Unmarshal(m, b)
+}
+func (m *AppVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppVersion.Marshal(b, m, deterministic)
+}
+func (dst *AppVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppVersion.Merge(dst, src)
+}
+func (m *AppVersion) XXX_Size() int {
+	return xxx_messageInfo_AppVersion.Size(m)
+}
+func (m *AppVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppVersion proto.InternalMessageInfo
+
+func (m *AppVersion) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *AppVersion) GetAppId() *wrappers.StringValue {
+	if m != nil {
+		return m.AppId
+	}
+	return nil
+}
+
+func (m *AppVersion) GetOwner() *wrappers.StringValue {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *AppVersion) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *AppVersion) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *AppVersion) GetPackageName() *wrappers.StringValue {
+	if m != nil {
+		return m.PackageName
+	}
+	return nil
+}
+
+func (m *AppVersion) GetStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *AppVersion) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *AppVersion) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+func (m *AppVersion) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+func (m *AppVersion) GetSequence() uint32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AppVersion) GetSignatureStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.SignatureStatus
+	}
+	return nil
+}
+
+func (m *AppVersion) GetUpgradeFrom() *wrappers.StringValue {
+	if m != nil {
+		return m.UpgradeFrom
+	}
+	return nil
+}
+
+type DescribeAppVersionsRequest struct {
+	VersionId   []string              `protobuf:"bytes,1,rep,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	AppId       []string              `protobuf:"bytes,2,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Name        []string              `protobuf:"bytes,3,rep,name=name,proto3" json:"name,omitempty"`
+	Owner       []string              `protobuf:"bytes,4,rep,name=owner,proto3" json:"owner,omitempty"`
+	Description []string              `protobuf:"bytes,5,rep,name=description,proto3" json:"description,omitempty"`
+	PackageName []string              `protobuf:"bytes,6,rep,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	Status      []string              `protobuf:"bytes,7,rep,name=status,proto3" json:"status,omitempty"`
+	SearchWord  *wrappers.StringValue `protobuf:"bytes,8,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
+	Limit       uint32                `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset      uint32                `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`
+	// sequence by default, semver to sort by the semantic version of the name
+	SortKey              *wrappers.StringValue `protobuf:"bytes,11,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	Reverse              *wrappers.BoolValue   `protobuf:"bytes,12,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeAppVersionsRequest) Reset()         { *m = DescribeAppVersionsRequest{} }
+func (m *DescribeAppVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeAppVersionsRequest) ProtoMessage()    {}
+func (*DescribeAppVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{27}
+}
+func (m *DescribeAppVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeAppVersionsRequest.Unmarshal(m, b)
+}
+func (m *DescribeAppVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeAppVersionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DescribeAppVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeAppVersionsRequest.Merge(dst, src)
+}
+func (m *DescribeAppVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeAppVersionsRequest.Size(m)
+}
+func (m *DescribeAppVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeAppVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeAppVersionsRequest proto.InternalMessageInfo
+
+func (m *DescribeAppVersionsRequest) GetVersionId() []string {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetAppId() []string {
+	if m != nil {
+		return m.AppId
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetName() []string {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetOwner() []string {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetDescription() []string {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetPackageName() []string {
+	if m != nil {
+		return m.PackageName
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetStatus() []string {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetSearchWord() *wrappers.StringValue {
+	if m != nil {
+		return m.SearchWord
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeAppVersionsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeAppVersionsRequest) GetSortKey() *wrappers.StringValue {
+	if m != nil {
+		return m.SortKey
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionsRequest) GetReverse() *wrappers.BoolValue {
+	if m != nil {
+		return m.Reverse
+	}
+	return nil
+}
+
+type DescribeAppVersionsResponse struct {
+	TotalCount           uint32        `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	AppVersionSet        []*AppVersion `protobuf:"bytes,2,rep,name=app_version_set,json=appVersionSet,proto3" json:"app_version_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DescribeAppVersionsResponse) Reset()         { *m = DescribeAppVersionsResponse{} }
+func (m *DescribeAppVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeAppVersionsResponse) ProtoMessage()    {}
+func (*DescribeAppVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{28}
+}
+func (m *DescribeAppVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeAppVersionsResponse.Unmarshal(m, b)
+}
+func (m *DescribeAppVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeAppVersionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeAppVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeAppVersionsResponse.Merge(dst, src)
+}
+func (m *DescribeAppVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeAppVersionsResponse.Size(m)
+}
+func (m *DescribeAppVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeAppVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeAppVersionsResponse proto.InternalMessageInfo
+
+func (m *DescribeAppVersionsResponse) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DescribeAppVersionsResponse) GetAppVersionSet() []*AppVersion {
+	if m != nil {
+		return m.AppVersionSet
+	}
+	return nil
+}
+
+type GetAppVersionUpgradePathsRequest struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetAppVersionUpgradePathsRequest) Reset()         { *m = GetAppVersionUpgradePathsRequest{} }
+func (m *GetAppVersionUpgradePathsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAppVersionUpgradePathsRequest) ProtoMessage()    {}
+func (*GetAppVersionUpgradePathsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{29}
+}
+func (m *GetAppVersionUpgradePathsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAppVersionUpgradePathsRequest.Unmarshal(m, b)
+}
+func (m *GetAppVersionUpgradePathsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAppVersionUpgradePathsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetAppVersionUpgradePathsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAppVersionUpgradePathsRequest.Merge(dst, src)
+}
+func (m *GetAppVersionUpgradePathsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAppVersionUpgradePathsRequest.Size(m)
+}
+func (m *GetAppVersionUpgradePathsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAppVersionUpgradePathsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAppVersionUpgradePathsRequest proto.InternalMessageInfo
+
+func (m *GetAppVersionUpgradePathsRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+type GetAppVersionUpgradePathsResponse struct {
+	VersionId *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// the active versions that the version can upgrade to, in semver order
+	AppVersionSet        []*AppVersion `protobuf:"bytes,2,rep,name=app_version_set,json=appVersionSet,proto3" json:"app_version_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetAppVersionUpgradePathsResponse) Reset()         { *m = GetAppVersionUpgradePathsResponse{} }
+func (m *GetAppVersionUpgradePathsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAppVersionUpgradePathsResponse) ProtoMessage()    {}
+func (*GetAppVersionUpgradePathsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{30}
+}
+func (m *GetAppVersionUpgradePathsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAppVersionUpgradePathsResponse.Unmarshal(m, b)
+}
+func (m *GetAppVersionUpgradePathsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAppVersionUpgradePathsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAppVersionUpgradePathsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAppVersionUpgradePathsResponse.Merge(dst, src)
+}
+func (m *GetAppVersionUpgradePathsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAppVersionUpgradePathsResponse.Size(m)
+}
+func (m *GetAppVersionUpgradePathsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAppVersionUpgradePathsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAppVersionUpgradePathsResponse proto.InternalMessageInfo
+
+func (m *GetAppVersionUpgradePathsResponse) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *GetAppVersionUpgradePathsResponse) GetAppVersionSet() []*AppVersion {
+	if m != nil {
+		return m.AppVersionSet
+	}
+	return nil
+}
+
+type AppVersionAudit struct {
+	VersionId *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	AppId     *wrappers.StringValue `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// status of the app version after the transition
+	Status               *wrappers.StringValue `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Operator             *wrappers.StringValue `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	Message              *wrappers.StringValue `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	StatusTime           *timestamp.Timestamp  `protobuf:"bytes,6,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AppVersionAudit) Reset()         { *m = AppVersionAudit{} }
+func (m *AppVersionAudit) String() string { return proto.CompactTextString(m) }
+func (*AppVersionAudit) ProtoMessage()    {}
+func (*AppVersionAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{31}
+}
+func (m *AppVersionAudit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppVersionAudit.Unmarshal(m, b)
+}
+func (m *AppVersionAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppVersionAudit.Marshal(b, m, deterministic)
+}
+func (dst *AppVersionAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppVersionAudit.Merge(dst, src)
+}
+func (m *AppVersionAudit) XXX_Size() int {
+	return xxx_messageInfo_AppVersionAudit.Size(m)
+}
+func (m *AppVersionAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppVersionAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppVersionAudit proto.InternalMessageInfo
+
+func (m *AppVersionAudit) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *AppVersionAudit) GetAppId() *wrappers.StringValue {
+	if m != nil {
+		return m.AppId
+	}
+	return nil
+}
+
+func (m *AppVersionAudit) GetStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *AppVersionAudit) GetOperator() *wrappers.StringValue {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *AppVersionAudit) GetMessage() *wrappers.StringValue {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *AppVersionAudit) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+type DescribeAppVersionAuditsRequest struct {
+	VersionId            []string              `protobuf:"bytes,1,rep,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	AppId                []string              `protobuf:"bytes,2,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Status               []string              `protobuf:"bytes,3,rep,name=status,proto3" json:"status,omitempty"`
+	Operator             []string              `protobuf:"bytes,4,rep,name=operator,proto3" json:"operator,omitempty"`
+	Limit                uint32                `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               uint32                `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	SortKey              *wrappers.StringValue `protobuf:"bytes,7,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	Reverse              *wrappers.BoolValue   `protobuf:"bytes,8,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeAppVersionAuditsRequest) Reset()         { *m = DescribeAppVersionAuditsRequest{} }
+func (m *DescribeAppVersionAuditsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeAppVersionAuditsRequest) ProtoMessage()    {}
+func (*DescribeAppVersionAuditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{32}
+}
+func (m *DescribeAppVersionAuditsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeAppVersionAuditsRequest.Unmarshal(m, b)
+}
+func (m *DescribeAppVersionAuditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeAppVersionAuditsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DescribeAppVersionAuditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeAppVersionAuditsRequest.Merge(dst, src)
+}
+func (m *DescribeAppVersionAuditsRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeAppVersionAuditsRequest.Size(m)
+}
+func (m *DescribeAppVersionAuditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeAppVersionAuditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeAppVersionAuditsRequest proto.InternalMessageInfo
+
+func (m *DescribeAppVersionAuditsRequest) GetVersionId() []string {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionAuditsRequest) GetAppId() []string {
+	if m != nil {
+		return m.AppId
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionAuditsRequest) GetStatus() []string {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionAuditsRequest) GetOperator() []string {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionAuditsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeAppVersionAuditsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeAppVersionAuditsRequest) GetSortKey() *wrappers.StringValue {
+	if m != nil {
+		return m.SortKey
+	}
+	return nil
+}
+
+func (m *DescribeAppVersionAuditsRequest) GetReverse() *wrappers.BoolValue {
+	if m != nil {
+		return m.Reverse
+	}
+	return nil
+}
+
+type DescribeAppVersionAuditsResponse struct {
+	TotalCount           uint32             `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	AppVersionAuditSet   []*AppVersionAudit `protobuf:"bytes,2,rep,name=app_version_audit_set,json=appVersionAuditSet,proto3" json:"app_version_audit_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DescribeAppVersionAuditsResponse) Reset()         { *m = DescribeAppVersionAuditsResponse{} }
+func (m *DescribeAppVersionAuditsResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeAppVersionAuditsResponse) ProtoMessage()    {}
+func (*DescribeAppVersionAuditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{33}
+}
+func (m *DescribeAppVersionAuditsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeAppVersionAuditsResponse.Unmarshal(m, b)
+}
+func (m *DescribeAppVersionAuditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeAppVersionAuditsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeAppVersionAuditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeAppVersionAuditsResponse.Merge(dst, src)
+}
+func (m *DescribeAppVersionAuditsResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeAppVersionAuditsResponse.Size(m)
+}
+func (m *DescribeAppVersionAuditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeAppVersionAuditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeAppVersionAuditsResponse proto.InternalMessageInfo
+
+func (m *DescribeAppVersionAuditsResponse) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DescribeAppVersionAuditsResponse) GetAppVersionAuditSet() []*AppVersionAudit {
+	if m != nil {
+		return m.AppVersionAuditSet
+	}
+	return nil
+}
+
+type SubmitAppVersionRequest struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Message              *wrappers.StringValue `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SubmitAppVersionRequest) Reset()         { *m = SubmitAppVersionRequest{} }
+func (m *SubmitAppVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitAppVersionRequest) ProtoMessage()    {}
+func (*SubmitAppVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{34}
+}
+func (m *SubmitAppVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitAppVersionRequest.Unmarshal(m, b)
+}
+func (m *SubmitAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitAppVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubmitAppVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitAppVersionRequest.Merge(dst, src)
+}
+func (m *SubmitAppVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitAppVersionRequest.Size(m)
+}
+func (m *SubmitAppVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitAppVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitAppVersionRequest proto.InternalMessageInfo
+
+func (m *SubmitAppVersionRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *SubmitAppVersionRequest) GetMessage() *wrappers.StringValue {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type SubmitAppVersionResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SubmitAppVersionResponse) Reset()         { *m = SubmitAppVersionResponse{} }
+func (m *SubmitAppVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitAppVersionResponse) ProtoMessage()    {}
+func (*SubmitAppVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{35}
+}
+func (m *SubmitAppVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitAppVersionResponse.Unmarshal(m, b)
+}
+func (m *SubmitAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitAppVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubmitAppVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitAppVersionResponse.Merge(dst, src)
+}
+func (m *SubmitAppVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitAppVersionResponse.Size(m)
+}
+func (m *SubmitAppVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitAppVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitAppVersionResponse proto.InternalMessageInfo
+
+func (m *SubmitAppVersionResponse) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+type ReviewAppVersionRequest struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Message              *wrappers.StringValue `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReviewAppVersionRequest) Reset()         { *m = ReviewAppVersionRequest{} }
+func (m *ReviewAppVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReviewAppVersionRequest) ProtoMessage()    {}
+func (*ReviewAppVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{36}
+}
+func (m *ReviewAppVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewAppVersionRequest.Unmarshal(m, b)
+}
+func (m *ReviewAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewAppVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReviewAppVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewAppVersionRequest.Merge(dst, src)
+}
+func (m *ReviewAppVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReviewAppVersionRequest.Size(m)
+}
+func (m *ReviewAppVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewAppVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewAppVersionRequest proto.InternalMessageInfo
+
+func (m *ReviewAppVersionRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *ReviewAppVersionRequest) GetMessage() *wrappers.StringValue {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type ReviewAppVersionResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReviewAppVersionResponse) Reset()         { *m = ReviewAppVersionResponse{} }
+func (m *ReviewAppVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*ReviewAppVersionResponse) ProtoMessage()    {}
+func (*ReviewAppVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{37}
+}
+func (m *ReviewAppVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewAppVersionResponse.Unmarshal(m, b)
+}
+func (m *ReviewAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewAppVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReviewAppVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewAppVersionResponse.Merge(dst, src)
+}
+func (m *ReviewAppVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_ReviewAppVersionResponse.Size(m)
+}
+func (m *ReviewAppVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewAppVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewAppVersionResponse proto.InternalMessageInfo
+
+func (m *ReviewAppVersionResponse) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+type PassAppVersionRequest struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Message              *wrappers.StringValue `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PassAppVersionRequest) Reset()         { *m = PassAppVersionRequest{} }
+func (m *PassAppVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*PassAppVersionRequest) ProtoMessage()    {}
+func (*PassAppVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{38}
+}
+func (m *PassAppVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PassAppVersionRequest.Unmarshal(m, b)
+}
+func (m *PassAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PassAppVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *PassAppVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PassAppVersionRequest.Merge(dst, src)
+}
+func (m *PassAppVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_PassAppVersionRequest.Size(m)
+}
+func (m *PassAppVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PassAppVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PassAppVersionRequest proto.InternalMessageInfo
+
+func (m *PassAppVersionRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *PassAppVersionRequest) GetMessage() *wrappers.StringValue {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type PassAppVersionResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PassAppVersionResponse) Reset()         { *m = PassAppVersionResponse{} }
+func (m *PassAppVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*PassAppVersionResponse) ProtoMessage()    {}
+func (*PassAppVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{39}
+}
+func (m *PassAppVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PassAppVersionResponse.Unmarshal(m, b)
+}
+func (m *PassAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PassAppVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *PassAppVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PassAppVersionResponse.Merge(dst, src)
+}
+func (m *PassAppVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_PassAppVersionResponse.Size(m)
+}
+func (m *PassAppVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PassAppVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PassAppVersionResponse proto.InternalMessageInfo
+
+func (m *PassAppVersionResponse) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+type RejectAppVersionRequest struct {
+	VersionId *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// reason of the rejection, required
+	Message              *wrappers.StringValue `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RejectAppVersionRequest) Reset()         { *m = RejectAppVersionRequest{} }
+func (m *RejectAppVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*RejectAppVersionRequest) ProtoMessage()    {}
+func (*RejectAppVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{40}
+}
+func (m *RejectAppVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectAppVersionRequest.Unmarshal(m, b)
+}
+func (m *RejectAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectAppVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *RejectAppVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectAppVersionRequest.Merge(dst, src)
+}
+func (m *RejectAppVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_RejectAppVersionRequest.Size(m)
+}
+func (m *RejectAppVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectAppVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectAppVersionRequest proto.InternalMessageInfo
+
+func (m *RejectAppVersionRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *RejectAppVersionRequest) GetMessage() *wrappers.StringValue {
+	if m != nil {
+		return m.Message
+	}
+	return nil
 }
 
-type DescribeAppVersionsRequest struct {
-	VersionId            []string              `protobuf:"bytes,1,rep,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	AppId                []string              `protobuf:"bytes,2,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Name                 []string              `protobuf:"bytes,3,rep,name=name,proto3" json:"name,omitempty"`
-	Owner                []string              `protobuf:"bytes,4,rep,name=owner,proto3" json:"owner,omitempty"`
-	Description          []string              `protobuf:"bytes,5,rep,name=description,proto3" json:"description,omitempty"`
-	PackageName          []string              `protobuf:"bytes,6,rep,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	Status               []string              `protobuf:"bytes,7,rep,name=status,proto3" json:"status,omitempty"`
-	SearchWord           *wrappers.StringValue `protobuf:"bytes,8,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
-	Limit                uint32                `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               uint32                `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`
-	SortKey              *wrappers.StringValue `protobuf:"bytes,11,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
-	Reverse              *wrappers.BoolValue   `protobuf:"bytes,12,opt,name=reverse,proto3" json:"reverse,omitempty"`
+type RejectAppVersionResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DescribeAppVersionsRequest) Reset()         { *m = DescribeAppVersionsRequest{} }
-func (m *DescribeAppVersionsRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeAppVersionsRequest) ProtoMessage()    {}
-func (*DescribeAppVersionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{16}
+func (m *RejectAppVersionResponse) Reset()         { *m = RejectAppVersionResponse{} }
+func (m *RejectAppVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*RejectAppVersionResponse) ProtoMessage()    {}
+func (*RejectAppVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{41}
 }
-func (m *DescribeAppVersionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeAppVersionsRequest.Unmarshal(m, b)
+func (m *RejectAppVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectAppVersionResponse.Unmarshal(m, b)
 }
-func (m *DescribeAppVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeAppVersionsRequest.Marshal(b, m, deterministic)
+func (m *RejectAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectAppVersionResponse.Marshal(b, m, deterministic)
 }
-func (dst *DescribeAppVersionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeAppVersionsRequest.Merge(dst, src)
+func (dst *RejectAppVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectAppVersionResponse.Merge(dst, src)
 }
-func (m *DescribeAppVersionsRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeAppVersionsRequest.Size(m)
+func (m *RejectAppVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_RejectAppVersionResponse.Size(m)
 }
-func (m *DescribeAppVersionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeAppVersionsRequest.DiscardUnknown(m)
+func (m *RejectAppVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectAppVersionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeAppVersionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_RejectAppVersionResponse proto.InternalMessageInfo
 
-func (m *DescribeAppVersionsRequest) GetVersionId() []string {
+func (m *RejectAppVersionResponse) GetVersionId() *wrappers.StringValue {
 	if m != nil {
 		return m.VersionId
 	}
 	return nil
 }
 
-func (m *DescribeAppVersionsRequest) GetAppId() []string {
-	if m != nil {
-		return m.AppId
-	}
-	return nil
+type SuspendAppVersionRequest struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Message              *wrappers.StringValue `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DescribeAppVersionsRequest) GetName() []string {
-	if m != nil {
-		return m.Name
-	}
-	return nil
+func (m *SuspendAppVersionRequest) Reset()         { *m = SuspendAppVersionRequest{} }
+func (m *SuspendAppVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendAppVersionRequest) ProtoMessage()    {}
+func (*SuspendAppVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{42}
+}
+func (m *SuspendAppVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendAppVersionRequest.Unmarshal(m, b)
+}
+func (m *SuspendAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendAppVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *SuspendAppVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendAppVersionRequest.Merge(dst, src)
+}
+func (m *SuspendAppVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_SuspendAppVersionRequest.Size(m)
+}
+func (m *SuspendAppVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendAppVersionRequest.DiscardUnknown(m)
 }
 
-func (m *DescribeAppVersionsRequest) GetOwner() []string {
+var xxx_messageInfo_SuspendAppVersionRequest proto.InternalMessageInfo
+
+func (m *SuspendAppVersionRequest) GetVersionId() *wrappers.StringValue {
 	if m != nil {
-		return m.Owner
+		return m.VersionId
 	}
 	return nil
 }
 
-func (m *DescribeAppVersionsRequest) GetDescription() []string {
+func (m *SuspendAppVersionRequest) GetMessage() *wrappers.StringValue {
 	if m != nil {
-		return m.Description
+		return m.Message
 	}
 	return nil
 }
 
-func (m *DescribeAppVersionsRequest) GetPackageName() []string {
-	if m != nil {
-		return m.PackageName
-	}
-	return nil
+type SuspendAppVersionResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DescribeAppVersionsRequest) GetStatus() []string {
-	if m != nil {
-		return m.Status
-	}
-	return nil
+func (m *SuspendAppVersionResponse) Reset()         { *m = SuspendAppVersionResponse{} }
+func (m *SuspendAppVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*SuspendAppVersionResponse) ProtoMessage()    {}
+func (*SuspendAppVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{43}
+}
+func (m *SuspendAppVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendAppVersionResponse.Unmarshal(m, b)
+}
+func (m *SuspendAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendAppVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *SuspendAppVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendAppVersionResponse.Merge(dst, src)
+}
+func (m *SuspendAppVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_SuspendAppVersionResponse.Size(m)
+}
+func (m *SuspendAppVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendAppVersionResponse.DiscardUnknown(m)
 }
 
-func (m *DescribeAppVersionsRequest) GetSearchWord() *wrappers.StringValue {
+var xxx_messageInfo_SuspendAppVersionResponse proto.InternalMessageInfo
+
+func (m *SuspendAppVersionResponse) GetVersionId() *wrappers.StringValue {
 	if m != nil {
-		return m.SearchWord
+		return m.VersionId
 	}
 	return nil
 }
 
-func (m *DescribeAppVersionsRequest) GetLimit() uint32 {
-	if m != nil {
-		return m.Limit
-	}
-	return 0
+type ReleaseAppVersionRequest struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Message              *wrappers.StringValue `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DescribeAppVersionsRequest) GetOffset() uint32 {
-	if m != nil {
-		return m.Offset
-	}
-	return 0
+func (m *ReleaseAppVersionRequest) Reset()         { *m = ReleaseAppVersionRequest{} }
+func (m *ReleaseAppVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseAppVersionRequest) ProtoMessage()    {}
+func (*ReleaseAppVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{44}
+}
+func (m *ReleaseAppVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseAppVersionRequest.Unmarshal(m, b)
+}
+func (m *ReleaseAppVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseAppVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReleaseAppVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseAppVersionRequest.Merge(dst, src)
+}
+func (m *ReleaseAppVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseAppVersionRequest.Size(m)
+}
+func (m *ReleaseAppVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseAppVersionRequest.DiscardUnknown(m)
 }
 
-func (m *DescribeAppVersionsRequest) GetSortKey() *wrappers.StringValue {
+var xxx_messageInfo_ReleaseAppVersionRequest proto.InternalMessageInfo
+
+func (m *ReleaseAppVersionRequest) GetVersionId() *wrappers.StringValue {
 	if m != nil {
-		return m.SortKey
+		return m.VersionId
 	}
 	return nil
 }
 
-func (m *DescribeAppVersionsRequest) GetReverse() *wrappers.BoolValue {
+func (m *ReleaseAppVersionRequest) GetMessage() *wrappers.StringValue {
 	if m != nil {
-		return m.Reverse
+		return m.Message
 	}
 	return nil
 }
 
-type DescribeAppVersionsResponse struct {
-	TotalCount           uint32        `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	AppVersionSet        []*AppVersion `protobuf:"bytes,2,rep,name=app_version_set,json=appVersionSet,proto3" json:"app_version_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type ReleaseAppVersionResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DescribeAppVersionsResponse) Reset()         { *m = DescribeAppVersionsResponse{} }
-func (m *DescribeAppVersionsResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeAppVersionsResponse) ProtoMessage()    {}
-func (*DescribeAppVersionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{17}
+func (m *ReleaseAppVersionResponse) Reset()         { *m = ReleaseAppVersionResponse{} }
+func (m *ReleaseAppVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseAppVersionResponse) ProtoMessage()    {}
+func (*ReleaseAppVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{45}
 }
-func (m *DescribeAppVersionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeAppVersionsResponse.Unmarshal(m, b)
+func (m *ReleaseAppVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseAppVersionResponse.Unmarshal(m, b)
 }
-func (m *DescribeAppVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeAppVersionsResponse.Marshal(b, m, deterministic)
+func (m *ReleaseAppVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseAppVersionResponse.Marshal(b, m, deterministic)
 }
-func (dst *DescribeAppVersionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeAppVersionsResponse.Merge(dst, src)
+func (dst *ReleaseAppVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseAppVersionResponse.Merge(dst, src)
 }
-func (m *DescribeAppVersionsResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeAppVersionsResponse.Size(m)
+func (m *ReleaseAppVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseAppVersionResponse.Size(m)
 }
-func (m *DescribeAppVersionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeAppVersionsResponse.DiscardUnknown(m)
+func (m *ReleaseAppVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseAppVersionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeAppVersionsResponse proto.InternalMessageInfo
-
-func (m *DescribeAppVersionsResponse) GetTotalCount() uint32 {
-	if m != nil {
-		return m.TotalCount
-	}
-	return 0
-}
+var xxx_messageInfo_ReleaseAppVersionResponse proto.InternalMessageInfo
 
-func (m *DescribeAppVersionsResponse) GetAppVersionSet() []*AppVersion {
+func (m *ReleaseAppVersionResponse) GetVersionId() *wrappers.StringValue {
 	if m != nil {
-		return m.AppVersionSet
+		return m.VersionId
 	}
 	return nil
 }
@@ -1418,7 +2852,7 @@ func (m *GetAppVersionPackageRequest) Reset()         { *m = GetAppVersionPackag
 func (m *GetAppVersionPackageRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAppVersionPackageRequest) ProtoMessage()    {}
 func (*GetAppVersionPackageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{18}
+	return fileDescriptor_app_1ed27684b39fe474, []int{46}
 }
 func (m *GetAppVersionPackageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAppVersionPackageRequest.Unmarshal(m, b)
@@ -1457,7 +2891,7 @@ func (m *GetAppVersionPackageResponse) Reset()         { *m = GetAppVersionPacka
 func (m *GetAppVersionPackageResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAppVersionPackageResponse) ProtoMessage()    {}
 func (*GetAppVersionPackageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{19}
+	return fileDescriptor_app_1ed27684b39fe474, []int{47}
 }
 func (m *GetAppVersionPackageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAppVersionPackageResponse.Unmarshal(m, b)
@@ -1491,6 +2925,90 @@ func (m *GetAppVersionPackageResponse) GetVersionId() *wrappers.StringValue {
 	return nil
 }
 
+type UploadAppVersionPackageRequest struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Package              []byte                `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UploadAppVersionPackageRequest) Reset()         { *m = UploadAppVersionPackageRequest{} }
+func (m *UploadAppVersionPackageRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadAppVersionPackageRequest) ProtoMessage()    {}
+func (*UploadAppVersionPackageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{48}
+}
+func (m *UploadAppVersionPackageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadAppVersionPackageRequest.Unmarshal(m, b)
+}
+func (m *UploadAppVersionPackageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadAppVersionPackageRequest.Marshal(b, m, deterministic)
+}
+func (dst *UploadAppVersionPackageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadAppVersionPackageRequest.Merge(dst, src)
+}
+func (m *UploadAppVersionPackageRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadAppVersionPackageRequest.Size(m)
+}
+func (m *UploadAppVersionPackageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadAppVersionPackageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadAppVersionPackageRequest proto.InternalMessageInfo
+
+func (m *UploadAppVersionPackageRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *UploadAppVersionPackageRequest) GetPackage() []byte {
+	if m != nil {
+		return m.Package
+	}
+	return nil
+}
+
+type UploadAppVersionPackageResponse struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UploadAppVersionPackageResponse) Reset()         { *m = UploadAppVersionPackageResponse{} }
+func (m *UploadAppVersionPackageResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadAppVersionPackageResponse) ProtoMessage()    {}
+func (*UploadAppVersionPackageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_app_1ed27684b39fe474, []int{49}
+}
+func (m *UploadAppVersionPackageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadAppVersionPackageResponse.Unmarshal(m, b)
+}
+func (m *UploadAppVersionPackageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadAppVersionPackageResponse.Marshal(b, m, deterministic)
+}
+func (dst *UploadAppVersionPackageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadAppVersionPackageResponse.Merge(dst, src)
+}
+func (m *UploadAppVersionPackageResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadAppVersionPackageResponse.Size(m)
+}
+func (m *UploadAppVersionPackageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadAppVersionPackageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadAppVersionPackageResponse proto.InternalMessageInfo
+
+func (m *UploadAppVersionPackageResponse) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
 type GetAppVersionPackageFilesRequest struct {
 	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	Files                []string              `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
@@ -1503,7 +3021,7 @@ func (m *GetAppVersionPackageFilesRequest) Reset()         { *m = GetAppVersionP
 func (m *GetAppVersionPackageFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAppVersionPackageFilesRequest) ProtoMessage()    {}
 func (*GetAppVersionPackageFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{20}
+	return fileDescriptor_app_1ed27684b39fe474, []int{50}
 }
 func (m *GetAppVersionPackageFilesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAppVersionPackageFilesRequest.Unmarshal(m, b)
@@ -1549,7 +3067,7 @@ func (m *GetAppVersionPackageFilesResponse) Reset()         { *m = GetAppVersion
 func (m *GetAppVersionPackageFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAppVersionPackageFilesResponse) ProtoMessage()    {}
 func (*GetAppVersionPackageFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{21}
+	return fileDescriptor_app_1ed27684b39fe474, []int{51}
 }
 func (m *GetAppVersionPackageFilesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAppVersionPackageFilesResponse.Unmarshal(m, b)
@@ -1593,7 +3111,7 @@ func (m *GetAppStatisticsRequest) Reset()         { *m = GetAppStatisticsRequest
 func (m *GetAppStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAppStatisticsRequest) ProtoMessage()    {}
 func (*GetAppStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{22}
+	return fileDescriptor_app_1ed27684b39fe474, []int{52}
 }
 func (m *GetAppStatisticsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAppStatisticsRequest.Unmarshal(m, b)
@@ -1627,7 +3145,7 @@ func (m *GetAppStatisticsResponse) Reset()         { *m = GetAppStatisticsRespon
 func (m *GetAppStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAppStatisticsResponse) ProtoMessage()    {}
 func (*GetAppStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_app_c2db7e52b44fbba8, []int{23}
+	return fileDescriptor_app_1ed27684b39fe474, []int{53}
 }
 func (m *GetAppStatisticsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAppStatisticsResponse.Unmarshal(m, b)
@@ -1685,17 +3203,47 @@ func init() {
 	proto.RegisterType((*App)(nil), "openpitrix.App")
 	proto.RegisterType((*DescribeAppsRequest)(nil), "openpitrix.DescribeAppsRequest")
 	proto.RegisterType((*DescribeAppsResponse)(nil), "openpitrix.DescribeAppsResponse")
+	proto.RegisterType((*SearchAppsRequest)(nil), "openpitrix.SearchAppsRequest")
+	proto.RegisterType((*SearchFacetValue)(nil), "openpitrix.SearchFacetValue")
+	proto.RegisterType((*SearchFacet)(nil), "openpitrix.SearchFacet")
+	proto.RegisterType((*SearchAppsResponse)(nil), "openpitrix.SearchAppsResponse")
+	proto.RegisterType((*AppAttachment)(nil), "openpitrix.AppAttachment")
+	proto.RegisterType((*UploadAppAttachmentsRequest)(nil), "openpitrix.UploadAppAttachmentsRequest")
+	proto.RegisterType((*UploadAppAttachmentsResponse)(nil), "openpitrix.UploadAppAttachmentsResponse")
+	proto.RegisterType((*GetAppAttachmentRequest)(nil), "openpitrix.GetAppAttachmentRequest")
+	proto.RegisterType((*GetAppAttachmentResponse)(nil), "openpitrix.GetAppAttachmentResponse")
 	proto.RegisterType((*CreateAppVersionRequest)(nil), "openpitrix.CreateAppVersionRequest")
 	proto.RegisterType((*CreateAppVersionResponse)(nil), "openpitrix.CreateAppVersionResponse")
 	proto.RegisterType((*ModifyAppVersionRequest)(nil), "openpitrix.ModifyAppVersionRequest")
 	proto.RegisterType((*ModifyAppVersionResponse)(nil), "openpitrix.ModifyAppVersionResponse")
+	proto.RegisterType((*ModifyAppVersionSignatureRequest)(nil), "openpitrix.ModifyAppVersionSignatureRequest")
+	proto.RegisterType((*ModifyAppVersionSignatureResponse)(nil), "openpitrix.ModifyAppVersionSignatureResponse")
 	proto.RegisterType((*DeleteAppVersionsRequest)(nil), "openpitrix.DeleteAppVersionsRequest")
 	proto.RegisterType((*DeleteAppVersionsResponse)(nil), "openpitrix.DeleteAppVersionsResponse")
 	proto.RegisterType((*AppVersion)(nil), "openpitrix.AppVersion")
 	proto.RegisterType((*DescribeAppVersionsRequest)(nil), "openpitrix.DescribeAppVersionsRequest")
 	proto.RegisterType((*DescribeAppVersionsResponse)(nil), "openpitrix.DescribeAppVersionsResponse")
+	proto.RegisterType((*GetAppVersionUpgradePathsRequest)(nil), "openpitrix.GetAppVersionUpgradePathsRequest")
+	proto.RegisterType((*GetAppVersionUpgradePathsResponse)(nil), "openpitrix.GetAppVersionUpgradePathsResponse")
+	proto.RegisterType((*AppVersionAudit)(nil), "openpitrix.AppVersionAudit")
+	proto.RegisterType((*DescribeAppVersionAuditsRequest)(nil), "openpitrix.DescribeAppVersionAuditsRequest")
+	proto.RegisterType((*DescribeAppVersionAuditsResponse)(nil), "openpitrix.DescribeAppVersionAuditsResponse")
+	proto.RegisterType((*SubmitAppVersionRequest)(nil), "openpitrix.SubmitAppVersionRequest")
+	proto.RegisterType((*SubmitAppVersionResponse)(nil), "openpitrix.SubmitAppVersionResponse")
+	proto.RegisterType((*ReviewAppVersionRequest)(nil), "openpitrix.ReviewAppVersionRequest")
+	proto.RegisterType((*ReviewAppVersionResponse)(nil), "openpitrix.ReviewAppVersionResponse")
+	proto.RegisterType((*PassAppVersionRequest)(nil), "openpitrix.PassAppVersionRequest")
+	proto.RegisterType((*PassAppVersionResponse)(nil), "openpitrix.PassAppVersionResponse")
+	proto.RegisterType((*RejectAppVersionRequest)(nil), "openpitrix.RejectAppVersionRequest")
+	proto.RegisterType((*RejectAppVersionResponse)(nil), "openpitrix.RejectAppVersionResponse")
+	proto.RegisterType((*SuspendAppVersionRequest)(nil), "openpitrix.SuspendAppVersionRequest")
+	proto.RegisterType((*SuspendAppVersionResponse)(nil), "openpitrix.SuspendAppVersionResponse")
+	proto.RegisterType((*ReleaseAppVersionRequest)(nil), "openpitrix.ReleaseAppVersionRequest")
+	proto.RegisterType((*ReleaseAppVersionResponse)(nil), "openpitrix.ReleaseAppVersionResponse")
 	proto.RegisterType((*GetAppVersionPackageRequest)(nil), "openpitrix.GetAppVersionPackageRequest")
 	proto.RegisterType((*GetAppVersionPackageResponse)(nil), "openpitrix.GetAppVersionPackageResponse")
+	proto.RegisterType((*UploadAppVersionPackageRequest)(nil), "openpitrix.UploadAppVersionPackageRequest")
+	proto.RegisterType((*UploadAppVersionPackageResponse)(nil), "openpitrix.UploadAppVersionPackageResponse")
 	proto.RegisterType((*GetAppVersionPackageFilesRequest)(nil), "openpitrix.GetAppVersionPackageFilesRequest")
 	proto.RegisterType((*GetAppVersionPackageFilesResponse)(nil), "openpitrix.GetAppVersionPackageFilesResponse")
 	proto.RegisterMapType((map[string][]byte)(nil), "openpitrix.GetAppVersionPackageFilesResponse.FilesEntry")
@@ -1720,99 +3268,224 @@ type AppManagerClient interface {
 	CreateApp(ctx context.Context, in *CreateAppRequest, opts ...grpc.CallOption) (*CreateAppResponse, error)
 	GetAppStatistics(ctx context.Context, in *GetAppStatisticsRequest, opts ...grpc.CallOption) (*GetAppStatisticsResponse, error)
 	DescribeApps(ctx context.Context, in *DescribeAppsRequest, opts ...grpc.CallOption) (*DescribeAppsResponse, error)
+	SearchApps(ctx context.Context, in *SearchAppsRequest, opts ...grpc.CallOption) (*SearchAppsResponse, error)
 	ModifyApp(ctx context.Context, in *ModifyAppRequest, opts ...grpc.CallOption) (*ModifyAppResponse, error)
 	DeleteApps(ctx context.Context, in *DeleteAppsRequest, opts ...grpc.CallOption) (*DeleteAppsResponse, error)
+	// UploadAppAttachments is called by the repo indexer with the files
+	// extracted from the package
+	UploadAppAttachments(ctx context.Context, in *UploadAppAttachmentsRequest, opts ...grpc.CallOption) (*UploadAppAttachmentsResponse, error)
+	GetAppAttachment(ctx context.Context, in *GetAppAttachmentRequest, opts ...grpc.CallOption) (*GetAppAttachmentResponse, error)
 	CreateAppVersion(ctx context.Context, in *CreateAppVersionRequest, opts ...grpc.CallOption) (*CreateAppVersionResponse, error)
 	DescribeAppVersions(ctx context.Context, in *DescribeAppVersionsRequest, opts ...grpc.CallOption) (*DescribeAppVersionsResponse, error)
 	ModifyAppVersion(ctx context.Context, in *ModifyAppVersionRequest, opts ...grpc.CallOption) (*ModifyAppVersionResponse, error)
+	// ModifyAppVersionSignature is called by the repo indexer after it
+	// verifies the provenance of the package
+	ModifyAppVersionSignature(ctx context.Context, in *ModifyAppVersionSignatureRequest, opts ...grpc.CallOption) (*ModifyAppVersionSignatureResponse, error)
 	DeleteAppVersions(ctx context.Context, in *DeleteAppVersionsRequest, opts ...grpc.CallOption) (*DeleteAppVersionsResponse, error)
+	SubmitAppVersion(ctx context.Context, in *SubmitAppVersionRequest, opts ...grpc.CallOption) (*SubmitAppVersionResponse, error)
+	ReviewAppVersion(ctx context.Context, in *ReviewAppVersionRequest, opts ...grpc.CallOption) (*ReviewAppVersionResponse, error)
+	PassAppVersion(ctx context.Context, in *PassAppVersionRequest, opts ...grpc.CallOption) (*PassAppVersionResponse, error)
+	RejectAppVersion(ctx context.Context, in *RejectAppVersionRequest, opts ...grpc.CallOption) (*RejectAppVersionResponse, error)
+	SuspendAppVersion(ctx context.Context, in *SuspendAppVersionRequest, opts ...grpc.CallOption) (*SuspendAppVersionResponse, error)
+	ReleaseAppVersion(ctx context.Context, in *ReleaseAppVersionRequest, opts ...grpc.CallOption) (*ReleaseAppVersionResponse, error)
+	DescribeAppVersionAudits(ctx context.Context, in *DescribeAppVersionAuditsRequest, opts ...grpc.CallOption) (*DescribeAppVersionAuditsResponse, error)
+	GetAppVersionUpgradePaths(ctx context.Context, in *GetAppVersionUpgradePathsRequest, opts ...grpc.CallOption) (*GetAppVersionUpgradePathsResponse, error)
 	GetAppVersionPackage(ctx context.Context, in *GetAppVersionPackageRequest, opts ...grpc.CallOption) (*GetAppVersionPackageResponse, error)
+	UploadAppVersionPackage(ctx context.Context, in *UploadAppVersionPackageRequest, opts ...grpc.CallOption) (*UploadAppVersionPackageResponse, error)
 	GetAppVersionPackageFiles(ctx context.Context, in *GetAppVersionPackageFilesRequest, opts ...grpc.CallOption) (*GetAppVersionPackageFilesResponse, error)
 }
 
-type appManagerClient struct {
-	cc *grpc.ClientConn
+type appManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAppManagerClient(cc *grpc.ClientConn) AppManagerClient {
+	return &appManagerClient{cc}
+}
+
+func (c *appManagerClient) CreateApp(ctx context.Context, in *CreateAppRequest, opts ...grpc.CallOption) (*CreateAppResponse, error) {
+	out := new(CreateAppResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/CreateApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) GetAppStatistics(ctx context.Context, in *GetAppStatisticsRequest, opts ...grpc.CallOption) (*GetAppStatisticsResponse, error) {
+	out := new(GetAppStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/GetAppStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) DescribeApps(ctx context.Context, in *DescribeAppsRequest, opts ...grpc.CallOption) (*DescribeAppsResponse, error) {
+	out := new(DescribeAppsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DescribeApps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) SearchApps(ctx context.Context, in *SearchAppsRequest, opts ...grpc.CallOption) (*SearchAppsResponse, error) {
+	out := new(SearchAppsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/SearchApps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) ModifyApp(ctx context.Context, in *ModifyAppRequest, opts ...grpc.CallOption) (*ModifyAppResponse, error) {
+	out := new(ModifyAppResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ModifyApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) DeleteApps(ctx context.Context, in *DeleteAppsRequest, opts ...grpc.CallOption) (*DeleteAppsResponse, error) {
+	out := new(DeleteAppsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DeleteApps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) UploadAppAttachments(ctx context.Context, in *UploadAppAttachmentsRequest, opts ...grpc.CallOption) (*UploadAppAttachmentsResponse, error) {
+	out := new(UploadAppAttachmentsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/UploadAppAttachments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) GetAppAttachment(ctx context.Context, in *GetAppAttachmentRequest, opts ...grpc.CallOption) (*GetAppAttachmentResponse, error) {
+	out := new(GetAppAttachmentResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/GetAppAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) CreateAppVersion(ctx context.Context, in *CreateAppVersionRequest, opts ...grpc.CallOption) (*CreateAppVersionResponse, error) {
+	out := new(CreateAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/CreateAppVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) DescribeAppVersions(ctx context.Context, in *DescribeAppVersionsRequest, opts ...grpc.CallOption) (*DescribeAppVersionsResponse, error) {
+	out := new(DescribeAppVersionsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DescribeAppVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewAppManagerClient(cc *grpc.ClientConn) AppManagerClient {
-	return &appManagerClient{cc}
+func (c *appManagerClient) ModifyAppVersion(ctx context.Context, in *ModifyAppVersionRequest, opts ...grpc.CallOption) (*ModifyAppVersionResponse, error) {
+	out := new(ModifyAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ModifyAppVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *appManagerClient) CreateApp(ctx context.Context, in *CreateAppRequest, opts ...grpc.CallOption) (*CreateAppResponse, error) {
-	out := new(CreateAppResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/CreateApp", in, out, opts...)
+func (c *appManagerClient) ModifyAppVersionSignature(ctx context.Context, in *ModifyAppVersionSignatureRequest, opts ...grpc.CallOption) (*ModifyAppVersionSignatureResponse, error) {
+	out := new(ModifyAppVersionSignatureResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ModifyAppVersionSignature", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appManagerClient) GetAppStatistics(ctx context.Context, in *GetAppStatisticsRequest, opts ...grpc.CallOption) (*GetAppStatisticsResponse, error) {
-	out := new(GetAppStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/GetAppStatistics", in, out, opts...)
+func (c *appManagerClient) DeleteAppVersions(ctx context.Context, in *DeleteAppVersionsRequest, opts ...grpc.CallOption) (*DeleteAppVersionsResponse, error) {
+	out := new(DeleteAppVersionsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DeleteAppVersions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appManagerClient) DescribeApps(ctx context.Context, in *DescribeAppsRequest, opts ...grpc.CallOption) (*DescribeAppsResponse, error) {
-	out := new(DescribeAppsResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DescribeApps", in, out, opts...)
+func (c *appManagerClient) SubmitAppVersion(ctx context.Context, in *SubmitAppVersionRequest, opts ...grpc.CallOption) (*SubmitAppVersionResponse, error) {
+	out := new(SubmitAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/SubmitAppVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appManagerClient) ModifyApp(ctx context.Context, in *ModifyAppRequest, opts ...grpc.CallOption) (*ModifyAppResponse, error) {
-	out := new(ModifyAppResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ModifyApp", in, out, opts...)
+func (c *appManagerClient) ReviewAppVersion(ctx context.Context, in *ReviewAppVersionRequest, opts ...grpc.CallOption) (*ReviewAppVersionResponse, error) {
+	out := new(ReviewAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ReviewAppVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appManagerClient) DeleteApps(ctx context.Context, in *DeleteAppsRequest, opts ...grpc.CallOption) (*DeleteAppsResponse, error) {
-	out := new(DeleteAppsResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DeleteApps", in, out, opts...)
+func (c *appManagerClient) PassAppVersion(ctx context.Context, in *PassAppVersionRequest, opts ...grpc.CallOption) (*PassAppVersionResponse, error) {
+	out := new(PassAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/PassAppVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appManagerClient) CreateAppVersion(ctx context.Context, in *CreateAppVersionRequest, opts ...grpc.CallOption) (*CreateAppVersionResponse, error) {
-	out := new(CreateAppVersionResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/CreateAppVersion", in, out, opts...)
+func (c *appManagerClient) RejectAppVersion(ctx context.Context, in *RejectAppVersionRequest, opts ...grpc.CallOption) (*RejectAppVersionResponse, error) {
+	out := new(RejectAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/RejectAppVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appManagerClient) DescribeAppVersions(ctx context.Context, in *DescribeAppVersionsRequest, opts ...grpc.CallOption) (*DescribeAppVersionsResponse, error) {
-	out := new(DescribeAppVersionsResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DescribeAppVersions", in, out, opts...)
+func (c *appManagerClient) SuspendAppVersion(ctx context.Context, in *SuspendAppVersionRequest, opts ...grpc.CallOption) (*SuspendAppVersionResponse, error) {
+	out := new(SuspendAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/SuspendAppVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appManagerClient) ModifyAppVersion(ctx context.Context, in *ModifyAppVersionRequest, opts ...grpc.CallOption) (*ModifyAppVersionResponse, error) {
-	out := new(ModifyAppVersionResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ModifyAppVersion", in, out, opts...)
+func (c *appManagerClient) ReleaseAppVersion(ctx context.Context, in *ReleaseAppVersionRequest, opts ...grpc.CallOption) (*ReleaseAppVersionResponse, error) {
+	out := new(ReleaseAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ReleaseAppVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appManagerClient) DeleteAppVersions(ctx context.Context, in *DeleteAppVersionsRequest, opts ...grpc.CallOption) (*DeleteAppVersionsResponse, error) {
-	out := new(DeleteAppVersionsResponse)
-	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DeleteAppVersions", in, out, opts...)
+func (c *appManagerClient) DescribeAppVersionAudits(ctx context.Context, in *DescribeAppVersionAuditsRequest, opts ...grpc.CallOption) (*DescribeAppVersionAuditsResponse, error) {
+	out := new(DescribeAppVersionAuditsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DescribeAppVersionAudits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) GetAppVersionUpgradePaths(ctx context.Context, in *GetAppVersionUpgradePathsRequest, opts ...grpc.CallOption) (*GetAppVersionUpgradePathsResponse, error) {
+	out := new(GetAppVersionUpgradePathsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/GetAppVersionUpgradePaths", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1828,6 +3501,15 @@ func (c *appManagerClient) GetAppVersionPackage(ctx context.Context, in *GetAppV
 	return out, nil
 }
 
+func (c *appManagerClient) UploadAppVersionPackage(ctx context.Context, in *UploadAppVersionPackageRequest, opts ...grpc.CallOption) (*UploadAppVersionPackageResponse, error) {
+	out := new(UploadAppVersionPackageResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/UploadAppVersionPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appManagerClient) GetAppVersionPackageFiles(ctx context.Context, in *GetAppVersionPackageFilesRequest, opts ...grpc.CallOption) (*GetAppVersionPackageFilesResponse, error) {
 	out := new(GetAppVersionPackageFilesResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/GetAppVersionPackageFiles", in, out, opts...)
@@ -1842,13 +3524,30 @@ type AppManagerServer interface {
 	CreateApp(context.Context, *CreateAppRequest) (*CreateAppResponse, error)
 	GetAppStatistics(context.Context, *GetAppStatisticsRequest) (*GetAppStatisticsResponse, error)
 	DescribeApps(context.Context, *DescribeAppsRequest) (*DescribeAppsResponse, error)
+	SearchApps(context.Context, *SearchAppsRequest) (*SearchAppsResponse, error)
 	ModifyApp(context.Context, *ModifyAppRequest) (*ModifyAppResponse, error)
 	DeleteApps(context.Context, *DeleteAppsRequest) (*DeleteAppsResponse, error)
+	// UploadAppAttachments is called by the repo indexer with the files
+	// extracted from the package
+	UploadAppAttachments(context.Context, *UploadAppAttachmentsRequest) (*UploadAppAttachmentsResponse, error)
+	GetAppAttachment(context.Context, *GetAppAttachmentRequest) (*GetAppAttachmentResponse, error)
 	CreateAppVersion(context.Context, *CreateAppVersionRequest) (*CreateAppVersionResponse, error)
 	DescribeAppVersions(context.Context, *DescribeAppVersionsRequest) (*DescribeAppVersionsResponse, error)
 	ModifyAppVersion(context.Context, *ModifyAppVersionRequest) (*ModifyAppVersionResponse, error)
+	// ModifyAppVersionSignature is called by the repo indexer after it
+	// verifies the provenance of the package
+	ModifyAppVersionSignature(context.Context, *ModifyAppVersionSignatureRequest) (*ModifyAppVersionSignatureResponse, error)
 	DeleteAppVersions(context.Context, *DeleteAppVersionsRequest) (*DeleteAppVersionsResponse, error)
+	SubmitAppVersion(context.Context, *SubmitAppVersionRequest) (*SubmitAppVersionResponse, error)
+	ReviewAppVersion(context.Context, *ReviewAppVersionRequest) (*ReviewAppVersionResponse, error)
+	PassAppVersion(context.Context, *PassAppVersionRequest) (*PassAppVersionResponse, error)
+	RejectAppVersion(context.Context, *RejectAppVersionRequest) (*RejectAppVersionResponse, error)
+	SuspendAppVersion(context.Context, *SuspendAppVersionRequest) (*SuspendAppVersionResponse, error)
+	ReleaseAppVersion(context.Context, *ReleaseAppVersionRequest) (*ReleaseAppVersionResponse, error)
+	DescribeAppVersionAudits(context.Context, *DescribeAppVersionAuditsRequest) (*DescribeAppVersionAuditsResponse, error)
+	GetAppVersionUpgradePaths(context.Context, *GetAppVersionUpgradePathsRequest) (*GetAppVersionUpgradePathsResponse, error)
 	GetAppVersionPackage(context.Context, *GetAppVersionPackageRequest) (*GetAppVersionPackageResponse, error)
+	UploadAppVersionPackage(context.Context, *UploadAppVersionPackageRequest) (*UploadAppVersionPackageResponse, error)
 	GetAppVersionPackageFiles(context.Context, *GetAppVersionPackageFilesRequest) (*GetAppVersionPackageFilesResponse, error)
 }
 
@@ -1910,6 +3609,24 @@ func _AppManager_DescribeApps_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_SearchApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAppsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).SearchApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/SearchApps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).SearchApps(ctx, req.(*SearchAppsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppManager_ModifyApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ModifyAppRequest)
 	if err := dec(in); err != nil {
@@ -1946,6 +3663,42 @@ func _AppManager_DeleteApps_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_UploadAppAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAppAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).UploadAppAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/UploadAppAttachments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).UploadAppAttachments(ctx, req.(*UploadAppAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_GetAppAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).GetAppAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/GetAppAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).GetAppAttachment(ctx, req.(*GetAppAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppManager_CreateAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAppVersionRequest)
 	if err := dec(in); err != nil {
@@ -2000,6 +3753,24 @@ func _AppManager_ModifyAppVersion_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_ModifyAppVersionSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyAppVersionSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).ModifyAppVersionSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/ModifyAppVersionSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).ModifyAppVersionSignature(ctx, req.(*ModifyAppVersionSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppManager_DeleteAppVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAppVersionsRequest)
 	if err := dec(in); err != nil {
@@ -2018,6 +3789,150 @@ func _AppManager_DeleteAppVersions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_SubmitAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).SubmitAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/SubmitAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).SubmitAppVersion(ctx, req.(*SubmitAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_ReviewAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).ReviewAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/ReviewAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).ReviewAppVersion(ctx, req.(*ReviewAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_PassAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PassAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).PassAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/PassAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).PassAppVersion(ctx, req.(*PassAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_RejectAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).RejectAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/RejectAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).RejectAppVersion(ctx, req.(*RejectAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_SuspendAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).SuspendAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/SuspendAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).SuspendAppVersion(ctx, req.(*SuspendAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_ReleaseAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).ReleaseAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/ReleaseAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).ReleaseAppVersion(ctx, req.(*ReleaseAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_DescribeAppVersionAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeAppVersionAuditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).DescribeAppVersionAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/DescribeAppVersionAudits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).DescribeAppVersionAudits(ctx, req.(*DescribeAppVersionAuditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_GetAppVersionUpgradePaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppVersionUpgradePathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).GetAppVersionUpgradePaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/GetAppVersionUpgradePaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).GetAppVersionUpgradePaths(ctx, req.(*GetAppVersionUpgradePathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppManager_GetAppVersionPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAppVersionPackageRequest)
 	if err := dec(in); err != nil {
@@ -2036,6 +3951,24 @@ func _AppManager_GetAppVersionPackage_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_UploadAppVersionPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAppVersionPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).UploadAppVersionPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/UploadAppVersionPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).UploadAppVersionPackage(ctx, req.(*UploadAppVersionPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppManager_GetAppVersionPackageFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAppVersionPackageFilesRequest)
 	if err := dec(in); err != nil {
@@ -2070,6 +4003,10 @@ var _AppManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeApps",
 			Handler:    _AppManager_DescribeApps_Handler,
 		},
+		{
+			MethodName: "SearchApps",
+			Handler:    _AppManager_SearchApps_Handler,
+		},
 		{
 			MethodName: "ModifyApp",
 			Handler:    _AppManager_ModifyApp_Handler,
@@ -2078,6 +4015,14 @@ var _AppManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApps",
 			Handler:    _AppManager_DeleteApps_Handler,
 		},
+		{
+			MethodName: "UploadAppAttachments",
+			Handler:    _AppManager_UploadAppAttachments_Handler,
+		},
+		{
+			MethodName: "GetAppAttachment",
+			Handler:    _AppManager_GetAppAttachment_Handler,
+		},
 		{
 			MethodName: "CreateAppVersion",
 			Handler:    _AppManager_CreateAppVersion_Handler,
@@ -2090,14 +4035,54 @@ var _AppManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyAppVersion",
 			Handler:    _AppManager_ModifyAppVersion_Handler,
 		},
+		{
+			MethodName: "ModifyAppVersionSignature",
+			Handler:    _AppManager_ModifyAppVersionSignature_Handler,
+		},
 		{
 			MethodName: "DeleteAppVersions",
 			Handler:    _AppManager_DeleteAppVersions_Handler,
 		},
+		{
+			MethodName: "SubmitAppVersion",
+			Handler:    _AppManager_SubmitAppVersion_Handler,
+		},
+		{
+			MethodName: "ReviewAppVersion",
+			Handler:    _AppManager_ReviewAppVersion_Handler,
+		},
+		{
+			MethodName: "PassAppVersion",
+			Handler:    _AppManager_PassAppVersion_Handler,
+		},
+		{
+			MethodName: "RejectAppVersion",
+			Handler:    _AppManager_RejectAppVersion_Handler,
+		},
+		{
+			MethodName: "SuspendAppVersion",
+			Handler:    _AppManager_SuspendAppVersion_Handler,
+		},
+		{
+			MethodName: "ReleaseAppVersion",
+			Handler:    _AppManager_ReleaseAppVersion_Handler,
+		},
+		{
+			MethodName: "DescribeAppVersionAudits",
+			Handler:    _AppManager_DescribeAppVersionAudits_Handler,
+		},
+		{
+			MethodName: "GetAppVersionUpgradePaths",
+			Handler:    _AppManager_GetAppVersionUpgradePaths_Handler,
+		},
 		{
 			MethodName: "GetAppVersionPackage",
 			Handler:    _AppManager_GetAppVersionPackage_Handler,
 		},
+		{
+			MethodName: "UploadAppVersionPackage",
+			Handler:    _AppManager_UploadAppVersionPackage_Handler,
+		},
 		{
 			MethodName: "GetAppVersionPackageFiles",
 			Handler:    _AppManager_GetAppVersionPackageFiles_Handler,
@@ -2107,125 +4092,200 @@ var _AppManager_serviceDesc = grpc.ServiceDesc{
 	Metadata: "app.proto",
 }
 
-func init() { proto.RegisterFile("app.proto", fileDescriptor_app_c2db7e52b44fbba8) }
-
-var fileDescriptor_app_c2db7e52b44fbba8 = []byte{
-	// 1870 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdd, 0x8e, 0x1b, 0x49,
-	0x15, 0x96, 0x7f, 0xc6, 0x3f, 0xc7, 0x76, 0xe2, 0x54, 0x26, 0x99, 0x1e, 0x27, 0xb3, 0xe3, 0x74,
-	0x96, 0xdd, 0x51, 0x48, 0x6c, 0x98, 0x64, 0x61, 0xc8, 0xb2, 0x59, 0x79, 0x93, 0x05, 0x06, 0xd8,
-	0x15, 0x72, 0x86, 0x44, 0xec, 0x8d, 0x55, 0x63, 0xd7, 0x78, 0x5a, 0x63, 0x77, 0x15, 0xdd, 0xe5,
-	0x98, 0xb9, 0x41, 0x82, 0x37, 0xc0, 0x88, 0x27, 0x40, 0x42, 0x42, 0xe2, 0x76, 0xdf, 0x03, 0x09,
-	0x1e, 0x01, 0x71, 0xc1, 0x05, 0x5c, 0xee, 0x2d, 0xaa, 0x9f, 0xfe, 0xb5, 0x1d, 0x77, 0xdb, 0x23,
-	0xd8, 0xab, 0x99, 0xaa, 0x3a, 0xa7, 0xea, 0xd4, 0x39, 0xe7, 0x3b, 0xe7, 0xab, 0x36, 0x94, 0x31,
-	0x63, 0x2d, 0xe6, 0x50, 0x4e, 0x11, 0x50, 0x46, 0x6c, 0x66, 0x71, 0xc7, 0xfa, 0x55, 0xe3, 0x9d,
-	0x21, 0xa5, 0xc3, 0x11, 0x69, 0xcb, 0x95, 0xd3, 0xc9, 0x59, 0x7b, 0xea, 0x60, 0xc6, 0x88, 0xe3,
-	0x2a, 0xd9, 0xc6, 0x7e, 0x7c, 0x9d, 0x5b, 0x63, 0xe2, 0x72, 0x3c, 0xd6, 0x9b, 0x35, 0xee, 0x6a,
-	0x01, 0xcc, 0xac, 0x36, 0xb6, 0x6d, 0xca, 0x31, 0xb7, 0xa8, 0xed, 0xa9, 0x3f, 0x94, 0x7f, 0xfa,
-	0x8f, 0x86, 0xc4, 0x7e, 0xe4, 0x4e, 0xf1, 0x70, 0x48, 0x9c, 0x36, 0x65, 0x52, 0x62, 0x81, 0x74,
-	0x85, 0x5f, 0x32, 0xa2, 0x07, 0xe6, 0x57, 0x5b, 0x50, 0x7f, 0xee, 0x10, 0xcc, 0x49, 0x87, 0xb1,
-	0x2e, 0xf9, 0xe5, 0x84, 0xb8, 0x1c, 0x7d, 0x0b, 0xf2, 0x36, 0x1e, 0x13, 0x23, 0xd3, 0xcc, 0x1c,
-	0x54, 0x0e, 0xef, 0xb6, 0xd4, 0xe1, 0x2d, 0xcf, 0xba, 0xd6, 0x4b, 0xee, 0x58, 0xf6, 0xf0, 0x15,
-	0x1e, 0x4d, 0x48, 0x57, 0x4a, 0xa2, 0x0f, 0xa0, 0xe8, 0x10, 0x46, 0x7b, 0xd6, 0xc0, 0xc8, 0x26,
-	0x50, 0x2a, 0x08, 0xe1, 0xe3, 0x01, 0x3a, 0x84, 0x2d, 0x3a, 0xb5, 0x89, 0x63, 0xe4, 0x12, 0x28,
-	0x29, 0x51, 0xf4, 0x21, 0x40, 0xff, 0x1c, 0x3b, 0xbc, 0x27, 0x4d, 0xcc, 0x27, 0x50, 0x2c, 0x4b,
-	0xf9, 0xcf, 0x85, 0x9d, 0xcf, 0xa0, 0x32, 0x20, 0x6e, 0xdf, 0xb1, 0xa4, 0x77, 0x8c, 0xad, 0x04,
-	0xda, 0x61, 0x05, 0xe1, 0x99, 0x73, 0x3a, 0x26, 0x46, 0x31, 0x89, 0x67, 0x84, 0xa4, 0xd0, 0xb0,
-	0xfa, 0xd4, 0x36, 0x4a, 0x49, 0x34, 0x84, 0xa4, 0xb0, 0xd1, 0xed, 0x3b, 0x84, 0xd8, 0xee, 0x39,
-	0xe5, 0xae, 0x51, 0x4e, 0x62, 0x63, 0x48, 0x41, 0xe8, 0x8f, 0xb1, 0x65, 0x73, 0x6c, 0xd9, 0xc4,
-	0x71, 0x0d, 0x48, 0xa2, 0x1f, 0x52, 0x40, 0xdf, 0x81, 0xa2, 0x4b, 0x27, 0x4e, 0x9f, 0xb8, 0x46,
-	0x25, 0x81, 0xae, 0x27, 0x8c, 0x9e, 0x40, 0xc1, 0x21, 0x78, 0x30, 0x26, 0x46, 0x35, 0x59, 0x0a,
-	0x08, 0x59, 0xf4, 0x11, 0x54, 0xfa, 0x98, 0x93, 0x21, 0x75, 0x2e, 0x45, 0xf6, 0xd4, 0x12, 0xa8,
-	0x82, 0xa7, 0x70, 0x3c, 0x40, 0x47, 0x50, 0xba, 0x20, 0x97, 0x53, 0xea, 0x0c, 0x5c, 0xe3, 0x5a,
-	0x02, 0x5d, 0x5f, 0xda, 0xfc, 0x11, 0xdc, 0x08, 0x25, 0xbe, 0xcb, 0xa8, 0xed, 0x12, 0xf4, 0x18,
-	0x0a, 0x98, 0x31, 0x61, 0x48, 0x92, 0xdc, 0xdf, 0xc2, 0x8c, 0x1d, 0x0f, 0xcc, 0xbf, 0x14, 0xa0,
-	0xfe, 0x19, 0x1d, 0x58, 0x67, 0x97, 0x21, 0x0c, 0xad, 0xb3, 0x93, 0x0f, 0xbc, 0xec, 0x3a, 0xc0,
-	0xcb, 0xad, 0x03, 0xbc, 0xfc, 0xba, 0xc0, 0xdb, 0xda, 0x08, 0x78, 0x85, 0x75, 0x81, 0x57, 0x4a,
-	0x0d, 0xbc, 0xf2, 0xba, 0xc0, 0x83, 0x0d, 0x81, 0x57, 0xd9, 0x00, 0x78, 0xd5, 0xf5, 0x80, 0x57,
-	0x5b, 0x1f, 0x78, 0xd7, 0x36, 0x00, 0xde, 0xf5, 0xb4, 0xc0, 0x0b, 0xa1, 0x65, 0x13, 0xe0, 0x3d,
-	0x80, 0x1b, 0x2f, 0xc8, 0x88, 0x48, 0x08, 0xbb, 0x1e, 0xf0, 0x6e, 0x85, 0x76, 0xca, 0x1d, 0x94,
-	0x3d, 0xd9, 0x6f, 0x02, 0x0a, 0xcb, 0xea, 0x63, 0x97, 0x08, 0xff, 0xbd, 0x04, 0xb9, 0x0e, 0x63,
-	0x5f, 0x73, 0x10, 0xc7, 0x30, 0x95, 0x4f, 0x8b, 0xa9, 0x27, 0x50, 0x70, 0x39, 0xe6, 0x13, 0x37,
-	0x11, 0x98, 0xb5, 0xac, 0x8f, 0xc4, 0x42, 0x6a, 0x24, 0x16, 0xd7, 0x45, 0x62, 0x69, 0x43, 0x24,
-	0x96, 0xd3, 0x22, 0x31, 0x9c, 0xdc, 0x90, 0x26, 0xb9, 0xff, 0xc7, 0xcd, 0x33, 0x5a, 0x92, 0x6b,
-	0xe9, 0x4a, 0xb2, 0xdf, 0x03, 0xae, 0xa5, 0xe9, 0x01, 0x95, 0xbe, 0x6c, 0x9a, 0x3d, 0xc1, 0x50,
-	0x35, 0xf0, 0x1b, 0x73, 0x9a, 0x27, 0x1e, 0x7d, 0xed, 0x82, 0x12, 0x17, 0x13, 0x42, 0x59, 0xe5,
-	0x90, 0x52, 0xae, 0xaf, 0x56, 0x56, 0xe2, 0x9e, 0xf2, 0x84, 0x0d, 0xfc, 0x93, 0x6f, 0xac, 0x56,
-	0x56, 0xe2, 0x52, 0xf9, 0x63, 0xa8, 0xfa, 0xb5, 0xce, 0x25, 0xdc, 0x40, 0xcd, 0x9c, 0xbc, 0x71,
-	0x40, 0xd1, 0x5b, 0x5d, 0xa2, 0x42, 0xf1, 0x5c, 0xcb, 0x75, 0xfd, 0xea, 0xf8, 0x92, 0x70, 0xf4,
-	0x02, 0xd0, 0x08, 0x73, 0xe2, 0xf2, 0x9e, 0xa8, 0x07, 0x6f, 0x88, 0xe3, 0x0a, 0xc4, 0xdd, 0x94,
-	0x46, 0xdc, 0x0e, 0x6f, 0xd3, 0x61, 0xec, 0x95, 0x5a, 0xed, 0xd6, 0x95, 0x46, 0x30, 0x63, 0xfe,
-	0x31, 0x07, 0x37, 0x5f, 0x48, 0x00, 0x9e, 0x46, 0x4a, 0xd6, 0x47, 0x50, 0x71, 0x09, 0x76, 0xfa,
-	0xe7, 0x3d, 0x91, 0x44, 0x89, 0x6a, 0x0d, 0x28, 0x85, 0xd7, 0xd4, 0x19, 0xa0, 0x6d, 0xd8, 0x1a,
-	0x59, 0x63, 0x8b, 0xcb, 0x8a, 0x53, 0xeb, 0xaa, 0x01, 0xba, 0x0d, 0x05, 0x7a, 0x76, 0x26, 0x6e,
-	0x9b, 0x93, 0xd3, 0x7a, 0x84, 0xbe, 0x0b, 0x25, 0x97, 0x3a, 0xbc, 0x77, 0x41, 0x2e, 0x13, 0xe1,
-	0xbe, 0x28, 0xa4, 0x7f, 0x42, 0x2e, 0xd1, 0x13, 0x51, 0xa5, 0xc4, 0xd5, 0x3d, 0xec, 0xcf, 0x7b,
-	0xff, 0x13, 0x4a, 0x47, 0x5a, 0x4b, 0x8b, 0x86, 0x2a, 0x6c, 0x31, 0x54, 0x61, 0x11, 0xd2, 0x45,
-	0xb2, 0x24, 0x27, 0x55, 0x19, 0xdc, 0x09, 0xca, 0x60, 0x59, 0x4e, 0x7b, 0x85, 0xee, 0xb6, 0x5f,
-	0xa8, 0x40, 0xcd, 0xeb, 0x52, 0xb4, 0xed, 0x65, 0x70, 0x45, 0x6d, 0xad, 0x72, 0x74, 0x2f, 0x02,
-	0x8a, 0xaa, 0x5c, 0x0a, 0xa5, 0xfd, 0x7e, 0x9c, 0x70, 0x8a, 0xf5, 0x50, 0x67, 0x33, 0x31, 0x6c,
-	0x47, 0x83, 0xa4, 0x7b, 0xc5, 0x3e, 0x54, 0x38, 0xe5, 0x78, 0xd4, 0xeb, 0xd3, 0x89, 0xcd, 0x65,
-	0x94, 0x6a, 0x5d, 0x90, 0x53, 0xcf, 0xc5, 0x0c, 0x3a, 0x80, 0xa2, 0xb8, 0xaa, 0x70, 0x79, 0x56,
-	0x26, 0xd8, 0xf5, 0x58, 0x66, 0x74, 0x85, 0x2b, 0x5e, 0x12, 0x6e, 0xfe, 0x27, 0x0b, 0x3b, 0x3e,
-	0xf9, 0xf4, 0xf2, 0x65, 0x13, 0xe2, 0xe8, 0x63, 0x39, 0x9b, 0x1c, 0xcb, 0x5e, 0x9f, 0xca, 0x25,
-	0xee, 0x53, 0x1f, 0x43, 0x95, 0xe1, 0xfe, 0x05, 0x1e, 0x92, 0xe4, 0x8f, 0xaf, 0x8a, 0xd6, 0xb8,
-	0x92, 0xe7, 0xd7, 0x11, 0x94, 0x5c, 0xe1, 0x26, 0xbb, 0xbf, 0xbc, 0xff, 0xfc, 0xfc, 0xd8, 0xe6,
-	0x8f, 0x0f, 0x75, 0x5d, 0xf6, 0xa4, 0xcd, 0xd7, 0x60, 0xcc, 0x3b, 0x5c, 0x07, 0xf6, 0x43, 0x00,
-	0x8d, 0xe8, 0xa4, 0x5e, 0x2f, 0x6b, 0xf9, 0xe3, 0x81, 0xf9, 0x55, 0x16, 0x76, 0x7c, 0x3a, 0x13,
-	0x0b, 0xe5, 0x26, 0x1b, 0xaf, 0x41, 0x23, 0xe2, 0xe1, 0xc9, 0xa5, 0x0d, 0xcf, 0x3a, 0xaf, 0x82,
-	0xff, 0x6b, 0x48, 0xe7, 0x1d, 0x7f, 0x15, 0x21, 0xfd, 0x1e, 0x18, 0x3e, 0x55, 0xd4, 0x1b, 0xfb,
-	0xa5, 0x7a, 0x2f, 0xb6, 0xb1, 0x2c, 0x2e, 0x81, 0xea, 0x53, 0xd8, 0x5d, 0xa0, 0xaa, 0x8d, 0x5a,
-	0xa1, 0xfb, 0xef, 0x3c, 0x40, 0xa0, 0xb6, 0x59, 0xf2, 0x04, 0x45, 0x24, 0xbb, 0x46, 0x11, 0xc9,
-	0xa5, 0x2f, 0x22, 0xf9, 0xc4, 0x59, 0xba, 0x69, 0xc2, 0xc4, 0xb3, 0xbc, 0x90, 0x36, 0xcb, 0x03,
-	0xda, 0x5b, 0x4c, 0x41, 0x7b, 0x63, 0xcc, 0xa7, 0xb4, 0x09, 0xf3, 0x29, 0x6f, 0xc2, 0x7c, 0x20,
-	0x15, 0xf3, 0x69, 0x84, 0xe0, 0x55, 0x91, 0x1d, 0x2b, 0x00, 0xd0, 0x97, 0x39, 0x68, 0x84, 0x3a,
-	0x5d, 0xba, 0x54, 0x0f, 0x35, 0xf6, 0xec, 0xa2, 0xc6, 0x9e, 0x0b, 0x35, 0xf6, 0xed, 0xa0, 0xae,
-	0x84, 0xfa, 0x74, 0x33, 0x9e, 0x08, 0x62, 0x2d, 0x12, 0xea, 0x7b, 0x73, 0xa1, 0x96, 0x22, 0xe1,
-	0x60, 0xde, 0x0e, 0x05, 0x33, 0x4c, 0x0d, 0x62, 0x94, 0xaa, 0xb4, 0x2e, 0xa5, 0x2a, 0x2f, 0xa6,
-	0x54, 0xb0, 0x94, 0x52, 0x55, 0xd6, 0xa4, 0x54, 0xd5, 0xc4, 0x94, 0xca, 0xfc, 0x35, 0xdc, 0x59,
-	0x18, 0xb6, 0xa4, 0x3c, 0xe5, 0x19, 0x5c, 0x0f, 0xb1, 0xd8, 0x10, 0x5f, 0x59, 0xc6, 0x64, 0x6b,
-	0xd8, 0xff, 0x5f, 0xb0, 0x97, 0x2f, 0xe0, 0xce, 0x0f, 0x49, 0x88, 0xd7, 0xfe, 0x4c, 0xc5, 0xe3,
-	0x2a, 0xba, 0x9e, 0x39, 0x81, 0xbb, 0x8b, 0xf7, 0xd6, 0x97, 0x33, 0xa0, 0xa8, 0xc3, 0x2f, 0x77,
-	0xae, 0x76, 0xbd, 0x61, 0xec, 0xd8, 0x6c, 0xda, 0x63, 0x9b, 0x8b, 0x8e, 0xfd, 0x81, 0x35, 0x22,
-	0xee, 0x95, 0x74, 0xf3, 0x6d, 0xd8, 0x3a, 0x13, 0x9b, 0x79, 0x60, 0x91, 0x03, 0xf3, 0x5f, 0x19,
-	0xb8, 0xf7, 0x96, 0x73, 0xf5, 0x9d, 0x3f, 0xf7, 0x74, 0x33, 0x32, 0x4a, 0x47, 0xe1, 0x28, 0xad,
-	0xd4, 0x6e, 0xc9, 0xd1, 0xa7, 0x36, 0x77, 0x2e, 0xf5, 0xa9, 0x1b, 0x79, 0xaa, 0x71, 0x04, 0x10,
-	0xec, 0x88, 0xea, 0x90, 0x13, 0x49, 0x2f, 0x9c, 0x51, 0xee, 0x8a, 0x7f, 0xc5, 0x45, 0xdf, 0x08,
-	0x1d, 0xb9, 0x6f, 0xb5, 0xab, 0x06, 0x4f, 0xb3, 0x47, 0x19, 0x73, 0x17, 0x76, 0x94, 0xb5, 0x2f,
-	0x39, 0xe6, 0x96, 0xcb, 0xad, 0xbe, 0xe7, 0x5a, 0xf3, 0xcf, 0x39, 0x30, 0xe6, 0xd7, 0xf4, 0xf5,
-	0x29, 0xdc, 0x1a, 0x61, 0x97, 0xf7, 0xf8, 0x94, 0xf6, 0xa6, 0x84, 0x5c, 0xf4, 0x54, 0x61, 0x1d,
-	0x68, 0x77, 0x7c, 0x7f, 0xde, 0x1d, 0xf3, 0x9b, 0xb4, 0x7e, 0x8a, 0x5d, 0x7e, 0x32, 0xa5, 0xaf,
-	0x09, 0xb9, 0x50, 0x3c, 0x70, 0xa0, 0x5c, 0x82, 0x46, 0x73, 0x0b, 0xe8, 0x17, 0x50, 0xe3, 0x94,
-	0xf5, 0x38, 0xb1, 0x7b, 0xe2, 0x01, 0xe2, 0x6a, 0x74, 0x7c, 0x90, 0xe8, 0xa0, 0x13, 0xca, 0x4e,
-	0x88, 0xdd, 0x15, 0x7a, 0xea, 0x84, 0x0a, 0x0f, 0x66, 0xd0, 0x1d, 0xf9, 0x0b, 0x91, 0x46, 0xa6,
-	0x7a, 0x97, 0x95, 0x30, 0x63, 0x0a, 0x97, 0x7b, 0x00, 0xf2, 0xfd, 0xa3, 0x56, 0xf3, 0x72, 0xb5,
-	0x2c, 0x66, 0xe4, 0x72, 0xe3, 0x53, 0xd8, 0x59, 0x72, 0x8b, 0x55, 0x61, 0xa8, 0x85, 0xc2, 0xd0,
-	0x78, 0x06, 0xf5, 0xb8, 0x8d, 0x69, 0xf4, 0x0f, 0xff, 0x59, 0x95, 0x34, 0xe5, 0x33, 0x6c, 0xe3,
-	0x21, 0x71, 0xd0, 0x08, 0xca, 0x3e, 0xb1, 0x46, 0x91, 0x17, 0x75, 0xfc, 0x67, 0xa5, 0xc6, 0xde,
-	0x92, 0x55, 0xe5, 0x39, 0xd3, 0x9c, 0x75, 0xaa, 0x48, 0x77, 0xcd, 0x26, 0x66, 0xec, 0xb7, 0x7f,
-	0xfb, 0xc7, 0xef, 0xb3, 0x35, 0xb3, 0xd4, 0x7e, 0xf3, 0xed, 0x36, 0x66, 0xcc, 0x7d, 0x9a, 0x79,
-	0x80, 0x7e, 0x97, 0x81, 0x7a, 0xdc, 0xf5, 0xe8, 0xfe, 0xdb, 0x03, 0xa3, 0x0e, 0x7f, 0x37, 0x49,
-	0xf4, 0xcc, 0xc3, 0x59, 0x67, 0x1b, 0xa1, 0x21, 0xe1, 0xc2, 0x80, 0xa6, 0xeb, 0x0b, 0x48, 0x5b,
-	0x6e, 0xa1, 0x9b, 0x9e, 0x2d, 0xed, 0x60, 0x09, 0xfd, 0x26, 0x03, 0xd5, 0xf0, 0x83, 0x11, 0xed,
-	0x87, 0x8f, 0x5a, 0xf0, 0xde, 0x6f, 0x34, 0x97, 0x0b, 0x68, 0x3b, 0x5a, 0xb3, 0xce, 0x1d, 0xb4,
-	0x3b, 0xd0, 0x4b, 0xc2, 0x18, 0xb7, 0x39, 0xb5, 0xf8, 0x79, 0xf3, 0xcc, 0x1a, 0x71, 0xe2, 0x48,
-	0x73, 0x00, 0xf9, 0xae, 0x11, 0x51, 0xf0, 0xb9, 0x70, 0x34, 0x0a, 0xf1, 0x1f, 0x26, 0xa2, 0x51,
-	0x98, 0xfb, 0x10, 0xab, 0xa3, 0x30, 0x96, 0xf3, 0x41, 0x14, 0x0e, 0x23, 0x51, 0x60, 0x00, 0xc1,
-	0xb7, 0x54, 0xb4, 0x17, 0xbd, 0x4d, 0xec, 0x7b, 0x6c, 0xe3, 0x9d, 0x65, 0xcb, 0xfa, 0xc0, 0xfb,
-	0xb3, 0x4e, 0x0d, 0x55, 0x06, 0x72, 0x41, 0x5e, 0x54, 0x9d, 0xf8, 0x60, 0x2e, 0xee, 0xf1, 0xf7,
-	0x5b, 0x34, 0xee, 0x4b, 0x9e, 0xd3, 0xd1, 0xb8, 0x2f, 0x7b, 0x02, 0xea, 0xb8, 0x07, 0xb9, 0xd7,
-	0xd4, 0x05, 0x4f, 0xc5, 0xdd, 0xac, 0x6b, 0x5b, 0xbc, 0x9e, 0x29, 0x6d, 0xfa, 0x53, 0x26, 0xf2,
-	0x35, 0xc7, 0xeb, 0xc3, 0xe8, 0xbd, 0x25, 0xd1, 0x8d, 0xf1, 0xab, 0xc6, 0xfb, 0x2b, 0xe5, 0xb4,
-	0x71, 0xcf, 0x66, 0x9d, 0xfb, 0xe8, 0x5e, 0x38, 0x19, 0x3c, 0xf3, 0xe6, 0x93, 0x02, 0xa1, 0x39,
-	0x5b, 0xa5, 0xf3, 0xe2, 0x2f, 0xa5, 0xa8, 0xf3, 0x96, 0x3c, 0x60, 0xa3, 0xce, 0x5b, 0xf6, 0xd8,
-	0xd2, 0xce, 0x0b, 0x52, 0x26, 0xea, 0xbc, 0xc3, 0x85, 0xce, 0xfb, 0x43, 0x26, 0xf4, 0xed, 0xde,
-	0x77, 0xdd, 0xbb, 0x0b, 0x73, 0x25, 0xee, 0xb8, 0x6f, 0xac, 0x90, 0xd2, 0x66, 0x3d, 0x9e, 0x75,
-	0x6e, 0xa1, 0x9b, 0x41, 0x62, 0xf9, 0x4e, 0x53, 0x76, 0x3d, 0x58, 0x68, 0xd7, 0x97, 0x19, 0xd8,
-	0x5e, 0xd4, 0x53, 0xd1, 0xfb, 0xab, 0xba, 0xae, 0x67, 0xdd, 0xc1, 0x6a, 0x41, 0x6d, 0xe0, 0x8f,
-	0x67, 0x9d, 0x03, 0xf4, 0x9e, 0x28, 0x36, 0xfc, 0x9c, 0x34, 0x35, 0x8f, 0x69, 0xf6, 0xa9, 0xcd,
-	0x89, 0xcd, 0x9b, 0xf4, 0x6c, 0xce, 0x97, 0xbb, 0x68, 0x27, 0x66, 0x73, 0xdb, 0x63, 0x3f, 0x7f,
-	0xcd, 0xc0, 0xee, 0x52, 0x2e, 0x80, 0x1e, 0x26, 0xa4, 0x0c, 0xea, 0x06, 0x8f, 0x52, 0x11, 0x0c,
-	0xf3, 0xd5, 0xac, 0xd3, 0x42, 0x0f, 0xe3, 0xd7, 0x90, 0x54, 0xe3, 0x6d, 0x97, 0xd9, 0x47, 0x7b,
-	0x4b, 0x2e, 0xd3, 0x96, 0xba, 0x9f, 0xe4, 0xbf, 0xc8, 0xb2, 0xd3, 0xd3, 0x82, 0x24, 0x24, 0x8f,
-	0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x5d, 0x0b, 0x5b, 0x5a, 0x21, 0x00, 0x00,
+func init() { proto.RegisterFile("app.proto", fileDescriptor_app_1ed27684b39fe474) }
+
+var fileDescriptor_app_1ed27684b39fe474 = []byte{
+	// 3063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xcf, 0x93, 0x1b, 0x47,
+	0xf5, 0xaf, 0x91, 0xf6, 0x87, 0xf4, 0xb4, 0xb2, 0xb5, 0xed, 0x5d, 0xaf, 0x76, 0xec, 0xdd, 0x1d,
+	0x4f, 0x36, 0x89, 0xbf, 0xb6, 0x77, 0xf7, 0x9b, 0xb5, 0x03, 0x1b, 0x87, 0x38, 0x28, 0x76, 0x9c,
+	0x18, 0x48, 0x70, 0x69, 0xed, 0x04, 0x42, 0x0a, 0xd1, 0x96, 0x7a, 0xb5, 0x83, 0xa5, 0x99, 0x61,
+	0xa6, 0xe5, 0xcd, 0x72, 0xa0, 0x0a, 0xce, 0x14, 0x29, 0x94, 0xca, 0x8d, 0x03, 0x55, 0x14, 0x10,
+	0xaa, 0x38, 0x70, 0x71, 0x15, 0x7f, 0x03, 0x27, 0xaa, 0xe0, 0xc2, 0x81, 0x2a, 0x0e, 0x1c, 0x28,
+	0x6e, 0x9c, 0xe0, 0x48, 0x75, 0x4f, 0xcf, 0x4c, 0xcf, 0x0f, 0x49, 0xa3, 0x1f, 0x05, 0xe6, 0xe4,
+	0x9d, 0xee, 0xf7, 0xba, 0x3f, 0xef, 0x67, 0xbf, 0x7e, 0x2d, 0x43, 0x11, 0xdb, 0xf6, 0xae, 0xed,
+	0x58, 0xd4, 0x42, 0x60, 0xd9, 0xc4, 0xb4, 0x0d, 0xea, 0x18, 0x1f, 0xa9, 0x9b, 0x6d, 0xcb, 0x6a,
+	0x77, 0xc8, 0x1e, 0x9f, 0x79, 0xd4, 0x3b, 0xda, 0x3b, 0x71, 0xb0, 0x6d, 0x13, 0xc7, 0xf5, 0x68,
+	0xd5, 0xad, 0xf8, 0x3c, 0x35, 0xba, 0xc4, 0xa5, 0xb8, 0x2b, 0x16, 0x53, 0x2f, 0x0a, 0x02, 0x6c,
+	0x1b, 0x7b, 0xd8, 0x34, 0x2d, 0x8a, 0xa9, 0x61, 0x99, 0x3e, 0xfb, 0x35, 0xfe, 0x4f, 0x73, 0xa7,
+	0x4d, 0xcc, 0x1d, 0xf7, 0x04, 0xb7, 0xdb, 0xc4, 0xd9, 0xb3, 0x6c, 0x4e, 0x91, 0x42, 0x5d, 0xa2,
+	0xa7, 0x36, 0x11, 0x1f, 0xfa, 0x3f, 0xe7, 0xa1, 0x72, 0xdb, 0x21, 0x98, 0x92, 0x9a, 0x6d, 0xd7,
+	0xc9, 0x77, 0x7a, 0xc4, 0xa5, 0xe8, 0xff, 0x61, 0xce, 0xc4, 0x5d, 0x52, 0x55, 0x34, 0xe5, 0x72,
+	0x69, 0xff, 0xe2, 0xae, 0xb7, 0xf9, 0xae, 0x8f, 0x6e, 0xf7, 0x90, 0x3a, 0x86, 0xd9, 0x7e, 0x0f,
+	0x77, 0x7a, 0xa4, 0xce, 0x29, 0xd1, 0xcb, 0xb0, 0xe8, 0x10, 0xdb, 0x6a, 0x18, 0xad, 0x6a, 0x2e,
+	0x03, 0xd3, 0x02, 0x23, 0xbe, 0xd7, 0x42, 0xfb, 0x30, 0x6f, 0x9d, 0x98, 0xc4, 0xa9, 0xe6, 0x33,
+	0x30, 0x79, 0xa4, 0xe8, 0x55, 0x80, 0xe6, 0x31, 0x76, 0x68, 0x83, 0x43, 0x9c, 0xcb, 0xc0, 0x58,
+	0xe4, 0xf4, 0xef, 0x32, 0x9c, 0xb7, 0xa0, 0xd4, 0x22, 0x6e, 0xd3, 0x31, 0xb8, 0x76, 0xaa, 0xf3,
+	0x19, 0xb8, 0x65, 0x06, 0xa6, 0x99, 0x63, 0xab, 0x4b, 0xaa, 0x8b, 0x59, 0x34, 0xc3, 0x28, 0x19,
+	0x87, 0xd1, 0xb4, 0xcc, 0x6a, 0x21, 0x0b, 0x07, 0xa3, 0x64, 0x18, 0xdd, 0xa6, 0x43, 0x88, 0xe9,
+	0x1e, 0x5b, 0xd4, 0xad, 0x16, 0xb3, 0x60, 0x94, 0x18, 0x18, 0x7f, 0x17, 0x1b, 0x26, 0xc5, 0x86,
+	0x49, 0x1c, 0xb7, 0x0a, 0x59, 0xf8, 0x25, 0x06, 0xf4, 0x39, 0x58, 0x74, 0xad, 0x9e, 0xd3, 0x24,
+	0x6e, 0xb5, 0x94, 0x81, 0xd7, 0x27, 0x46, 0x37, 0x60, 0xc1, 0x21, 0xb8, 0xd5, 0x25, 0xd5, 0xa5,
+	0x6c, 0x2e, 0xc0, 0x68, 0xd1, 0x6b, 0x50, 0x6a, 0x62, 0x4a, 0xda, 0x96, 0x73, 0xca, 0xbc, 0xa7,
+	0x9c, 0x81, 0x15, 0x7c, 0x86, 0x7b, 0x2d, 0x74, 0x00, 0x85, 0xc7, 0xe4, 0xf4, 0xc4, 0x72, 0x5a,
+	0x6e, 0xf5, 0x4c, 0x06, 0xde, 0x80, 0x5a, 0x7f, 0x1b, 0x96, 0x25, 0xc7, 0x77, 0x6d, 0xcb, 0x74,
+	0x09, 0xba, 0x0e, 0x0b, 0xd8, 0xb6, 0x19, 0x90, 0x2c, 0xbe, 0x3f, 0x8f, 0x6d, 0xfb, 0x5e, 0x4b,
+	0xff, 0xf5, 0x02, 0x54, 0xde, 0xb1, 0x5a, 0xc6, 0xd1, 0xa9, 0x14, 0x43, 0x93, 0xac, 0x14, 0x04,
+	0x5e, 0x6e, 0x92, 0xc0, 0xcb, 0x4f, 0x12, 0x78, 0x73, 0x93, 0x06, 0xde, 0xfc, 0x54, 0x81, 0xb7,
+	0x30, 0x69, 0xe0, 0x15, 0xc6, 0x0e, 0xbc, 0xe2, 0xa4, 0x81, 0x07, 0x53, 0x06, 0x5e, 0x69, 0x8a,
+	0xc0, 0x5b, 0x9a, 0x2c, 0xf0, 0xca, 0x93, 0x07, 0xde, 0x99, 0x29, 0x02, 0xef, 0xec, 0xb8, 0x81,
+	0x27, 0x45, 0xcb, 0x34, 0x81, 0x77, 0x05, 0x96, 0xef, 0x90, 0x0e, 0xe1, 0x21, 0xec, 0xfa, 0x81,
+	0xb7, 0x2a, 0xad, 0x94, 0xbf, 0x5c, 0xf4, 0x69, 0xaf, 0x02, 0x92, 0x69, 0xc5, 0xb6, 0x03, 0x88,
+	0xff, 0x58, 0x80, 0x7c, 0xcd, 0xb6, 0x9f, 0xf1, 0x20, 0x8e, 0xc5, 0xd4, 0xdc, 0xb8, 0x31, 0x75,
+	0x03, 0x16, 0x5c, 0x8a, 0x69, 0xcf, 0xcd, 0x14, 0xcc, 0x82, 0x36, 0x88, 0xc4, 0x85, 0xb1, 0x23,
+	0x71, 0x71, 0xd2, 0x48, 0x2c, 0x4c, 0x19, 0x89, 0xc5, 0x71, 0x23, 0x51, 0x76, 0x6e, 0x18, 0xc7,
+	0xb9, 0xff, 0xc3, 0x87, 0x67, 0x34, 0x25, 0x97, 0xc7, 0x4b, 0xc9, 0xc1, 0x19, 0x70, 0x66, 0x9c,
+	0x33, 0xa0, 0xd4, 0xe4, 0x87, 0x66, 0x83, 0x55, 0xa8, 0x22, 0xf0, 0xd5, 0x04, 0xe7, 0x03, 0xbf,
+	0x7c, 0xad, 0x83, 0x47, 0xce, 0x06, 0x18, 0xb3, 0xe7, 0x43, 0x1e, 0x73, 0x65, 0x34, 0xb3, 0x47,
+	0xee, 0x33, 0xf7, 0xec, 0x56, 0xb0, 0xf3, 0xf2, 0x68, 0x66, 0x8f, 0x9c, 0x33, 0xbf, 0x0e, 0x4b,
+	0x41, 0xae, 0x73, 0x09, 0xad, 0x22, 0x2d, 0xcf, 0x25, 0x0e, 0x4b, 0xf4, 0xdd, 0x3a, 0xf1, 0x4c,
+	0x71, 0x5b, 0xd0, 0xd5, 0x83, 0xec, 0x78, 0x48, 0x28, 0xba, 0x03, 0xa8, 0x83, 0x29, 0x71, 0x69,
+	0x83, 0xe5, 0x83, 0x27, 0xc4, 0x71, 0x59, 0xc4, 0x9d, 0xe3, 0x20, 0xce, 0xcb, 0xcb, 0xd4, 0x6c,
+	0xfb, 0x3d, 0x6f, 0xb6, 0x5e, 0xf1, 0x38, 0xc2, 0x11, 0xfd, 0x2f, 0x79, 0x38, 0x77, 0x87, 0x07,
+	0xe0, 0xa3, 0x48, 0xca, 0x7a, 0x0d, 0x4a, 0x2e, 0xc1, 0x4e, 0xf3, 0xb8, 0xc1, 0x9c, 0x28, 0x53,
+	0xae, 0x01, 0x8f, 0xe1, 0x7d, 0xcb, 0x69, 0xa1, 0x15, 0x98, 0xef, 0x18, 0x5d, 0x83, 0xf2, 0x8c,
+	0x53, 0xae, 0x7b, 0x1f, 0xe8, 0x3c, 0x2c, 0x58, 0x47, 0x47, 0x4c, 0xda, 0x3c, 0x1f, 0x16, 0x5f,
+	0xe8, 0xf3, 0x50, 0x70, 0x2d, 0x87, 0x36, 0x1e, 0x93, 0xd3, 0x4c, 0x71, 0xbf, 0xc8, 0xa8, 0xbf,
+	0x4c, 0x4e, 0xd1, 0x0d, 0x96, 0xa5, 0x98, 0xe8, 0x7e, 0xec, 0x27, 0xb5, 0xff, 0x86, 0x65, 0x75,
+	0x04, 0x97, 0x20, 0x95, 0x32, 0xec, 0xa2, 0x94, 0x61, 0x11, 0x12, 0x49, 0xb2, 0xc0, 0x07, 0xf9,
+	0xdf, 0x68, 0x2d, 0x4c, 0x83, 0x45, 0x3e, 0xec, 0x27, 0xba, 0xf3, 0x41, 0xa2, 0x02, 0x6f, 0xdc,
+	0xfb, 0x62, 0x82, 0x7b, 0x1e, 0x5c, 0xf2, 0x96, 0xe6, 0x1f, 0x68, 0x23, 0x12, 0x14, 0x4b, 0x7c,
+	0x4a, 0x72, 0xfb, 0xad, 0x78, 0xc1, 0xc9, 0xe6, 0xe5, 0x93, 0xed, 0x55, 0x00, 0xa7, 0x67, 0x32,
+	0x2f, 0xcb, 0x7a, 0x2e, 0x16, 0x05, 0xfd, 0xbd, 0x96, 0x8e, 0x61, 0x25, 0x6a, 0x61, 0x71, 0xd0,
+	0x6c, 0x41, 0x89, 0x5a, 0x14, 0x77, 0x1a, 0x4d, 0xab, 0x67, 0x52, 0x6e, 0xe2, 0x72, 0x1d, 0xf8,
+	0xd0, 0x6d, 0x36, 0x82, 0x2e, 0xc3, 0x22, 0xd3, 0x13, 0xb3, 0x57, 0x8e, 0x7b, 0xe7, 0xd9, 0x98,
+	0x5b, 0xd5, 0x99, 0x1e, 0x0f, 0x09, 0xd5, 0x3f, 0xcb, 0xc1, 0xf2, 0x21, 0xb7, 0xfe, 0x0c, 0x7d,
+	0x28, 0xa6, 0x95, 0x5c, 0x42, 0x2b, 0x6b, 0xf2, 0x19, 0x25, 0x1b, 0x47, 0x85, 0x82, 0xed, 0x58,
+	0x4f, 0x8c, 0x16, 0xaf, 0x26, 0xd9, 0x4c, 0xf0, 0x2d, 0x19, 0x6e, 0x3e, 0x62, 0xb8, 0x2a, 0x2c,
+	0x8a, 0x8c, 0x59, 0x5d, 0xe0, 0x13, 0xfe, 0x27, 0xda, 0x04, 0x08, 0x13, 0xb1, 0x70, 0x19, 0x69,
+	0x24, 0xf4, 0xf5, 0x42, 0xba, 0xaf, 0x17, 0x65, 0x5f, 0xd7, 0x3f, 0x84, 0x8a, 0xa7, 0xa9, 0xbb,
+	0xb8, 0x49, 0x28, 0x97, 0x9a, 0xa5, 0xbd, 0x27, 0xec, 0x8f, 0x6c, 0x47, 0x3a, 0x27, 0x65, 0xbb,
+	0x7a, 0x76, 0x13, 0x11, 0xc6, 0x3f, 0xf4, 0xef, 0x42, 0x49, 0x5a, 0x7d, 0x82, 0x5b, 0xf3, 0x2b,
+	0x50, 0xe4, 0xeb, 0x4b, 0x56, 0x8f, 0xe4, 0xa4, 0x38, 0xf6, 0x7a, 0x81, 0x93, 0x33, 0x27, 0xf8,
+	0x54, 0x01, 0x24, 0x3b, 0xc1, 0xcc, 0xdd, 0x0c, 0xdd, 0x80, 0xe2, 0x11, 0xdb, 0xb9, 0xe1, 0xa5,
+	0x10, 0x46, 0xbb, 0x36, 0x00, 0x5c, 0xbd, 0xc0, 0x29, 0x19, 0xae, 0x6f, 0x40, 0xb9, 0x66, 0xdb,
+	0x35, 0x4a, 0x71, 0xf3, 0xb8, 0x4b, 0xcc, 0x49, 0xb4, 0x52, 0x85, 0xc5, 0xa6, 0x65, 0x52, 0x22,
+	0xd4, 0xbd, 0x54, 0xf7, 0x3f, 0xf5, 0x7f, 0x28, 0x70, 0xe1, 0xa1, 0xdd, 0xb1, 0x70, 0x2b, 0xb2,
+	0x87, 0x3b, 0xd5, 0x9d, 0xeb, 0xa5, 0xe0, 0xe4, 0xf5, 0x0a, 0xb6, 0xf5, 0x98, 0x42, 0xc2, 0x7d,
+	0x82, 0x63, 0x77, 0x47, 0x14, 0x34, 0xf9, 0x51, 0x0c, 0x9c, 0x8c, 0x9f, 0x7b, 0x52, 0x35, 0x33,
+	0xa7, 0xe5, 0x87, 0x73, 0xc9, 0xd4, 0xfa, 0x21, 0x5c, 0x4c, 0x17, 0x79, 0x9a, 0xc2, 0xf9, 0x43,
+	0x58, 0x7b, 0x8b, 0xd0, 0xe8, 0xae, 0x42, 0x87, 0x35, 0x28, 0xe3, 0x60, 0x30, 0xeb, 0xb2, 0x4b,
+	0x21, 0xcb, 0xbd, 0x96, 0xfe, 0x5b, 0x05, 0xaa, 0xc9, 0xe5, 0x05, 0xde, 0xe9, 0xd7, 0xe7, 0xa7,
+	0xb9, 0xe7, 0x11, 0x0d, 0xd6, 0xca, 0xca, 0x54, 0x68, 0x97, 0x04, 0xc7, 0x83, 0x53, 0x3b, 0xe2,
+	0x61, 0xf9, 0xa8, 0x87, 0xfd, 0x26, 0x0f, 0x6b, 0x41, 0x57, 0xc0, 0x3f, 0xc8, 0xa7, 0xf1, 0xae,
+	0xa0, 0xc8, 0xca, 0x65, 0x2f, 0xb2, 0xfc, 0x90, 0xc9, 0x67, 0x0e, 0x99, 0xd7, 0x61, 0xc9, 0xc6,
+	0xcd, 0xc7, 0xb8, 0x4d, 0xb2, 0x77, 0xc5, 0x4a, 0x82, 0x63, 0x26, 0x7d, 0xb1, 0x03, 0x28, 0xb8,
+	0x4c, 0x4d, 0x66, 0x73, 0xf0, 0xc5, 0xe0, 0xe1, 0x3d, 0x93, 0x5e, 0xdf, 0x17, 0x89, 0xcc, 0xa7,
+	0x66, 0xd0, 0x7b, 0x76, 0xdb, 0xc1, 0x2d, 0xd2, 0x38, 0x72, 0xac, 0x6e, 0xb6, 0x5a, 0x5f, 0x70,
+	0xdc, 0x75, 0xac, 0xae, 0xfe, 0x3e, 0x54, 0x93, 0x16, 0x13, 0xce, 0xf6, 0x2a, 0x80, 0xa8, 0xd5,
+	0xb2, 0x9a, 0xad, 0x28, 0xe8, 0x99, 0x1b, 0xe7, 0x61, 0x2d, 0xb8, 0xa8, 0xc6, 0x7c, 0x61, 0x9a,
+	0x85, 0x27, 0xb8, 0x20, 0xc6, 0xed, 0x9b, 0x1f, 0xd7, 0xbe, 0x93, 0xf4, 0x7b, 0xfe, 0xb7, 0x7d,
+	0x22, 0x69, 0xb9, 0x59, 0xf8, 0xc4, 0x67, 0x0a, 0x68, 0xf1, 0x95, 0x0f, 0x8d, 0xb6, 0x89, 0x69,
+	0xcf, 0x21, 0x33, 0x71, 0x8e, 0xb7, 0xa0, 0xe2, 0xfa, 0x0b, 0x36, 0x44, 0xf1, 0x94, 0xc5, 0x51,
+	0xce, 0x06, 0x5c, 0x87, 0x9c, 0x49, 0xff, 0x16, 0x5c, 0x1a, 0x82, 0x74, 0x16, 0xca, 0x78, 0x05,
+	0xaa, 0x41, 0x4b, 0x45, 0xec, 0x10, 0x1c, 0xc5, 0x1b, 0xb1, 0x85, 0x79, 0x11, 0x1e, 0xb2, 0xde,
+	0x84, 0xf5, 0x14, 0x56, 0x01, 0x6a, 0x04, 0xef, 0xbf, 0xe6, 0x01, 0x42, 0xb6, 0xe9, 0xb4, 0x1d,
+	0xe6, 0xf4, 0xdc, 0x04, 0x39, 0x3d, 0x3f, 0x7e, 0x4e, 0x9f, 0xcb, 0x1c, 0xf3, 0xd3, 0x86, 0x5f,
+	0x3c, 0x67, 0x2c, 0x8c, 0x9b, 0x33, 0xc2, 0xf6, 0xd0, 0xe2, 0x18, 0xed, 0xa1, 0x58, 0x87, 0xa0,
+	0x30, 0x4d, 0x87, 0xa0, 0x38, 0x4d, 0x87, 0x00, 0xc6, 0xea, 0x10, 0xa8, 0x52, 0xb2, 0x2a, 0xf1,
+	0xaa, 0x39, 0xf8, 0x4e, 0x0d, 0xc9, 0xa5, 0x09, 0x42, 0x32, 0x91, 0xd7, 0xca, 0xe3, 0xe6, 0xb5,
+	0xa7, 0x79, 0x50, 0xa5, 0xeb, 0xe5, 0x78, 0x41, 0x27, 0x5d, 0xc5, 0x73, 0x69, 0x57, 0xf1, 0xbc,
+	0x74, 0x15, 0x5f, 0x09, 0xcf, 0x0b, 0xe9, 0x66, 0xad, 0xc5, 0x5d, 0x92, 0xcd, 0x45, 0x9c, 0xee,
+	0x52, 0xc2, 0xe9, 0x38, 0x89, 0xec, 0x56, 0xe7, 0x25, 0xb7, 0x92, 0xef, 0x84, 0xb1, 0x0b, 0x6c,
+	0x61, 0xd2, 0x26, 0x48, 0x31, 0xfd, 0x62, 0x08, 0x03, 0x9b, 0x20, 0xa5, 0x09, 0x9b, 0x20, 0x4b,
+	0x99, 0x9b, 0x20, 0xfa, 0xf7, 0xe0, 0x42, 0xaa, 0xd9, 0xb2, 0xde, 0xda, 0x6e, 0xc1, 0x59, 0xa9,
+	0xef, 0x24, 0xdd, 0xde, 0x06, 0xf5, 0x9e, 0xca, 0x38, 0xf8, 0x9b, 0xdd, 0xca, 0x1a, 0xa0, 0x79,
+	0x05, 0xb9, 0x18, 0x7b, 0xe8, 0xf9, 0xd4, 0x7d, 0x4c, 0x8f, 0xdd, 0x59, 0x9c, 0x5a, 0xfa, 0x4f,
+	0x15, 0xb8, 0x34, 0x64, 0x87, 0x19, 0x9c, 0x36, 0x53, 0xeb, 0xe0, 0x6f, 0x39, 0x38, 0x1b, 0xce,
+	0xd6, 0x7a, 0x2d, 0x83, 0xfe, 0x17, 0xce, 0x8e, 0x30, 0xa9, 0xe6, 0xc7, 0x48, 0xaa, 0x07, 0x50,
+	0xb0, 0x6c, 0xe2, 0x60, 0x6a, 0x65, 0xab, 0xe0, 0x02, 0x6a, 0xd6, 0x8f, 0xee, 0x12, 0xd7, 0xc5,
+	0xed, 0x6c, 0x2f, 0x76, 0x3e, 0x71, 0x3c, 0x13, 0x2f, 0x8c, 0x93, 0x89, 0x59, 0x87, 0x6a, 0x2b,
+	0xe9, 0xef, 0x5c, 0xe5, 0x53, 0xe6, 0xaa, 0xf3, 0x92, 0xfa, 0xe4, 0xe4, 0xa1, 0x46, 0x14, 0xc4,
+	0x9b, 0x50, 0x81, 0x0a, 0x82, 0xcc, 0x30, 0x9f, 0x9e, 0x19, 0x16, 0x06, 0x66, 0x86, 0xc5, 0x09,
+	0x33, 0x43, 0x21, 0x7b, 0x66, 0xf8, 0x44, 0x01, 0x6d, 0xb0, 0xaa, 0xb2, 0xe6, 0x87, 0x77, 0x61,
+	0x55, 0x8e, 0x0d, 0xcc, 0xd8, 0xa5, 0x08, 0xb9, 0x90, 0x1e, 0x21, 0x7c, 0x97, 0x3a, 0xc2, 0xd1,
+	0x01, 0x16, 0x2b, 0x3f, 0x52, 0x60, 0xed, 0xb0, 0xf7, 0xa8, 0x6b, 0xd0, 0x19, 0x5f, 0x7d, 0x24,
+	0x77, 0xcc, 0x8d, 0xe1, 0x8e, 0xac, 0xa0, 0x4f, 0xe2, 0x99, 0x45, 0x0d, 0xcb, 0x24, 0xad, 0x93,
+	0x27, 0x06, 0x39, 0x79, 0x76, 0x24, 0x4d, 0xe2, 0x99, 0x85, 0xa4, 0x3f, 0x54, 0x60, 0xf5, 0x3e,
+	0x76, 0xdd, 0x67, 0x44, 0xce, 0x87, 0x70, 0x3e, 0x8e, 0x66, 0x76, 0xf6, 0xfc, 0x36, 0x69, 0xd2,
+	0x67, 0xc9, 0x9e, 0x71, 0x3c, 0xb3, 0x90, 0xf4, 0x63, 0x85, 0xc5, 0x84, 0x6b, 0x13, 0xb3, 0xf5,
+	0x8c, 0x88, 0xfa, 0x35, 0x58, 0x4f, 0x01, 0x34, 0x2b, 0x59, 0xeb, 0xa4, 0x43, 0xb0, 0x4b, 0x9e,
+	0x1d, 0x59, 0x53, 0x00, 0xcd, 0x42, 0xd6, 0x0f, 0xe0, 0x42, 0xa4, 0x92, 0xba, 0xef, 0xd5, 0xce,
+	0x33, 0x29, 0xd3, 0x7a, 0x70, 0x31, 0x7d, 0x6d, 0x01, 0xbc, 0x0a, 0x8b, 0xa2, 0x54, 0xe7, 0x2b,
+	0x2f, 0xd5, 0xfd, 0xcf, 0xd8, 0xb6, 0xb9, 0xf1, 0xb6, 0x3d, 0x81, 0xcd, 0xa0, 0x87, 0x3d, 0x7b,
+	0xa9, 0x64, 0xd4, 0xb9, 0x08, 0x6a, 0xfd, 0x9b, 0xb0, 0x35, 0x70, 0xe3, 0x59, 0xd8, 0xaa, 0x17,
+	0xab, 0xab, 0xc5, 0xe2, 0x77, 0x8d, 0x0e, 0x99, 0x49, 0x5d, 0xcd, 0x6a, 0x97, 0x23, 0xb6, 0x98,
+	0x5f, 0x05, 0xf1, 0x0f, 0xfd, 0xef, 0xf1, 0x6a, 0x3b, 0xba, 0xaf, 0x90, 0xec, 0x5d, 0x9f, 0x57,
+	0xe1, 0x45, 0xc0, 0x81, 0x5c, 0x04, 0x8c, 0xe4, 0xde, 0xe5, 0x5f, 0x6f, 0x9a, 0xd4, 0x39, 0x15,
+	0xbb, 0x4e, 0xe5, 0x02, 0xea, 0x01, 0x40, 0xb8, 0x22, 0xaa, 0x40, 0x9e, 0xd5, 0x57, 0x4c, 0x19,
+	0xc5, 0x3a, 0xfb, 0x93, 0x09, 0xea, 0xbd, 0xca, 0x79, 0x16, 0xf4, 0x3e, 0x6e, 0xe6, 0x0e, 0x14,
+	0x7d, 0xdd, 0x7f, 0xab, 0x60, 0x97, 0x68, 0xc3, 0xa5, 0x46, 0xd3, 0x57, 0xad, 0xfe, 0xab, 0x3c,
+	0x54, 0x93, 0x73, 0x42, 0x7c, 0x0b, 0x56, 0x3b, 0xd8, 0xa5, 0x0d, 0x7a, 0x62, 0x35, 0x4e, 0x08,
+	0x79, 0xdc, 0xf0, 0xfa, 0x0c, 0x2d, 0xa1, 0x8e, 0x2f, 0x24, 0xd5, 0x91, 0x5c, 0x64, 0xf7, 0x2b,
+	0xd8, 0xa5, 0x0f, 0x4e, 0xac, 0xf7, 0x09, 0x79, 0xec, 0x35, 0x99, 0x5b, 0x9e, 0x4a, 0x50, 0x27,
+	0x31, 0x81, 0xbe, 0x0e, 0x65, 0x6a, 0xd9, 0x0d, 0x4a, 0xcc, 0x86, 0x43, 0x6c, 0xcb, 0x15, 0xc5,
+	0xd7, 0xcb, 0x99, 0x36, 0x7a, 0x60, 0xd9, 0x0f, 0x88, 0x59, 0x67, 0x7c, 0xde, 0x0e, 0x25, 0x1a,
+	0x8e, 0xa0, 0x0b, 0xfc, 0x87, 0xc5, 0xa2, 0xfc, 0xf3, 0x9e, 0xf3, 0x0b, 0xd8, 0xb6, 0xbd, 0xe2,
+	0x6f, 0x03, 0x80, 0xbf, 0xcc, 0x7a, 0xb3, 0x73, 0x7c, 0xb6, 0xc8, 0x46, 0xf8, 0xb4, 0xfa, 0x26,
+	0xac, 0x0d, 0x90, 0x62, 0x94, 0x19, 0xca, 0x92, 0x19, 0xd4, 0x5b, 0x50, 0x89, 0x63, 0x1c, 0x87,
+	0x7f, 0xff, 0xe3, 0x4d, 0xde, 0xb5, 0x7b, 0x07, 0x9b, 0xb8, 0x4d, 0x1c, 0xd4, 0x81, 0x62, 0xd0,
+	0xb5, 0x47, 0x91, 0x47, 0xcf, 0xf8, 0xaf, 0x91, 0xd5, 0x8d, 0x01, 0xb3, 0x9e, 0xe6, 0x74, 0xbd,
+	0x5f, 0x5b, 0x42, 0xa2, 0x89, 0xa4, 0x61, 0xdb, 0xfe, 0xc1, 0x1f, 0xfe, 0xfa, 0x49, 0xae, 0xac,
+	0x17, 0xf6, 0x9e, 0xbc, 0xb4, 0x87, 0x6d, 0xdb, 0xbd, 0xa9, 0x5c, 0x41, 0x3f, 0x56, 0xa0, 0x12,
+	0x57, 0x3d, 0x7a, 0x6e, 0xb8, 0x61, 0xbc, 0xcd, 0xb7, 0xb3, 0x58, 0x4f, 0xdf, 0xef, 0xd7, 0x56,
+	0x10, 0x6a, 0x13, 0xca, 0x00, 0x68, 0x6e, 0x40, 0xc0, 0xb1, 0xac, 0xa2, 0x73, 0x3e, 0x96, 0xbd,
+	0x70, 0x0a, 0x7d, 0x5f, 0x81, 0x25, 0xf9, 0xa7, 0x02, 0x68, 0x4b, 0xde, 0x2a, 0xe5, 0x67, 0x22,
+	0xaa, 0x36, 0x98, 0x40, 0xe0, 0xd8, 0xed, 0xd7, 0x2e, 0xa0, 0xf5, 0x96, 0x98, 0x62, 0x60, 0x5c,
+	0xed, 0xc4, 0xa0, 0xc7, 0xda, 0x91, 0xd1, 0xa1, 0xc4, 0xe1, 0x70, 0x00, 0x05, 0xaa, 0x41, 0x9f,
+	0x2a, 0x00, 0xe1, 0x2b, 0x32, 0xda, 0x48, 0xbe, 0xef, 0xca, 0xfb, 0x6f, 0x0e, 0x9a, 0x16, 0xbb,
+	0xbf, 0xdd, 0xaf, 0xed, 0xa2, 0x6b, 0x5e, 0x4f, 0x46, 0xde, 0xbb, 0xd7, 0xe9, 0xec, 0x50, 0xf2,
+	0x11, 0xd5, 0xfc, 0x29, 0xb3, 0xa5, 0xf1, 0x07, 0x63, 0x4f, 0x3f, 0xcb, 0xe8, 0x6c, 0xa8, 0x1f,
+	0x4e, 0xc1, 0xbc, 0x23, 0xe8, 0x5d, 0x47, 0xbd, 0x23, 0xfe, 0x3b, 0x5b, 0x75, 0x63, 0xc0, 0x6c,
+	0xc4, 0x3b, 0xba, 0x7c, 0x3c, 0xf4, 0x8e, 0xfd, 0x88, 0x77, 0xd8, 0x00, 0xe1, 0x4f, 0x03, 0xa3,
+	0x4a, 0x48, 0xfc, 0xbc, 0x50, 0xdd, 0x1c, 0x34, 0x2d, 0x36, 0x7c, 0xae, 0x5f, 0x2b, 0xa3, 0x52,
+	0x8b, 0x4f, 0x70, 0x25, 0x78, 0x3b, 0x5e, 0x89, 0xec, 0x68, 0xc0, 0x4a, 0xda, 0xa3, 0x2e, 0x7a,
+	0x51, 0x5e, 0x7c, 0xc8, 0x4b, 0xb7, 0x7a, 0x79, 0x34, 0xa1, 0x48, 0x83, 0xbf, 0x0c, 0x5c, 0x3f,
+	0x9c, 0x4d, 0x73, 0xfd, 0xc4, 0x4b, 0xb0, 0xba, 0x3d, 0x9c, 0x28, 0x34, 0xfa, 0x55, 0xf4, 0x7f,
+	0xcc, 0xf5, 0xe9, 0x31, 0xd1, 0xbc, 0x07, 0xf2, 0x6b, 0x1a, 0x7b, 0xf7, 0xd6, 0x2c, 0x47, 0x0b,
+	0x5f, 0xb2, 0x35, 0xeb, 0x28, 0xd0, 0xff, 0x0a, 0x42, 0x42, 0x1b, 0x7b, 0xe1, 0xc3, 0x2e, 0x0f,
+	0xd2, 0xf8, 0x4b, 0x5e, 0x14, 0xe9, 0x80, 0x97, 0x59, 0x75, 0x7b, 0x38, 0x51, 0x24, 0x48, 0xc3,
+	0x44, 0xa1, 0x89, 0xd3, 0xc9, 0x0b, 0x52, 0xbd, 0x22, 0x20, 0xf9, 0xb7, 0x68, 0x6e, 0xa8, 0x5f,
+	0x28, 0x91, 0x5f, 0x6c, 0x89, 0x25, 0x5d, 0xf4, 0xc2, 0x80, 0x50, 0x8c, 0x75, 0x64, 0xd5, 0x17,
+	0x47, 0xd2, 0x09, 0x70, 0xb7, 0xfa, 0xb5, 0xe7, 0xd0, 0x25, 0x39, 0x72, 0x7d, 0x78, 0xc9, 0x08,
+	0x46, 0x28, 0x81, 0x95, 0x2b, 0x2f, 0xfe, 0xdc, 0x13, 0x55, 0xde, 0x80, 0xa7, 0x4c, 0x75, 0x7b,
+	0x38, 0x51, 0x44, 0x79, 0x61, 0x1c, 0x45, 0x95, 0xb7, 0x9f, 0xaa, 0xbc, 0x8f, 0x60, 0x7d, 0xe0,
+	0x0b, 0x14, 0xba, 0x36, 0x6c, 0xdb, 0xf8, 0x93, 0x9a, 0xba, 0x93, 0x91, 0x5a, 0x38, 0xfd, 0xa7,
+	0x8a, 0xf4, 0xcb, 0xe0, 0xc0, 0x68, 0xdb, 0xa9, 0xa1, 0x1b, 0x37, 0xd9, 0xf3, 0x23, 0xa8, 0x84,
+	0x42, 0xae, 0xf7, 0x6b, 0xab, 0xe8, 0x5c, 0x18, 0xe7, 0x81, 0xb9, 0x3c, 0x8d, 0x5c, 0x49, 0xd5,
+	0xc8, 0xcf, 0x14, 0xa8, 0xc4, 0xfb, 0x18, 0x51, 0x2b, 0x0d, 0xe8, 0xba, 0xa8, 0xdb, 0xc3, 0x89,
+	0x04, 0xa8, 0x3b, 0xfd, 0xda, 0x16, 0xda, 0x70, 0xf9, 0xb4, 0x0c, 0x4a, 0x3b, 0xb2, 0x1c, 0xcd,
+	0xe1, 0x6d, 0x05, 0x0e, 0xef, 0xa2, 0xbe, 0x16, 0x87, 0xb7, 0xe7, 0x71, 0x31, 0x94, 0x3f, 0x57,
+	0xa0, 0x12, 0xef, 0x41, 0x44, 0x51, 0x0e, 0xe8, 0x98, 0xa8, 0xdb, 0xc3, 0x89, 0x04, 0xca, 0xbb,
+	0xfd, 0xda, 0x25, 0xb4, 0xe5, 0x52, 0xec, 0xf8, 0x49, 0x83, 0x11, 0x8a, 0x04, 0x11, 0x71, 0xac,
+	0x34, 0x9c, 0x1e, 0x35, 0xc3, 0xf9, 0x13, 0x05, 0xce, 0x44, 0x7b, 0x08, 0xe8, 0x92, 0x0c, 0x20,
+	0xb5, 0xdb, 0xa1, 0xea, 0xc3, 0x48, 0x04, 0xc2, 0xdb, 0xfd, 0x9a, 0x86, 0x36, 0x6d, 0xec, 0xba,
+	0x23, 0x00, 0xaa, 0xfa, 0x6a, 0x02, 0x20, 0x63, 0x13, 0xb9, 0xa3, 0x12, 0xbf, 0xfa, 0xc7, 0xd5,
+	0x98, 0xda, 0xa8, 0x50, 0xb7, 0x87, 0x13, 0x09, 0x90, 0x6f, 0xf5, 0x6b, 0x3a, 0xd2, 0x1c, 0x3e,
+	0x3d, 0x91, 0x1e, 0x19, 0x23, 0x03, 0xfa, 0x99, 0x02, 0xcb, 0x89, 0x8b, 0x3b, 0x8a, 0x79, 0x5c,
+	0x7a, 0xa3, 0x41, 0x7d, 0x7e, 0x04, 0x55, 0x68, 0x72, 0x0d, 0x6d, 0xba, 0xde, 0x3c, 0x07, 0x8b,
+	0x9b, 0xd4, 0x78, 0x92, 0xcc, 0xc3, 0x1b, 0x7a, 0x35, 0xc5, 0x33, 0x39, 0x1b, 0x83, 0xfa, 0x54,
+	0x81, 0xe5, 0xc4, 0xbd, 0x1b, 0xc5, 0xf4, 0x95, 0xde, 0x27, 0x50, 0x9f, 0x1f, 0x41, 0x25, 0xa0,
+	0x1e, 0xf6, 0x6b, 0x3b, 0xe8, 0xaa, 0xe3, 0xcd, 0x73, 0xa8, 0xcc, 0xa0, 0xa4, 0xc5, 0x8f, 0x33,
+	0x0f, 0x09, 0x69, 0x65, 0xc2, 0x2d, 0xd6, 0x60, 0xb8, 0x7f, 0xa7, 0xb0, 0xb7, 0xf2, 0xf4, 0x36,
+	0x2f, 0xba, 0x3a, 0xfc, 0x90, 0x88, 0xf4, 0xcd, 0xd5, 0x6b, 0xd9, 0x88, 0x43, 0x61, 0x5e, 0x42,
+	0x7b, 0xc1, 0xb1, 0xc2, 0xa4, 0xf1, 0x3a, 0xe6, 0x1a, 0x75, 0xb0, 0xe9, 0x1a, 0xfc, 0xff, 0x05,
+	0xc6, 0x3c, 0xc6, 0xcb, 0x60, 0xeb, 0x28, 0xe9, 0x32, 0xd8, 0xc3, 0xfb, 0x67, 0x05, 0xd6, 0x07,
+	0x3e, 0xf6, 0x44, 0x13, 0xfb, 0xa8, 0x57, 0x27, 0x75, 0x27, 0x23, 0xb5, 0x90, 0xa7, 0xd9, 0xaf,
+	0xdd, 0x44, 0x07, 0x7e, 0xb5, 0x11, 0x39, 0x25, 0xe9, 0x31, 0x4e, 0x8c, 0x6a, 0x4d, 0x6c, 0x6a,
+	0xe2, 0x01, 0x55, 0xa3, 0x16, 0x17, 0x4c, 0x43, 0x9b, 0x09, 0xc1, 0x04, 0x49, 0xc3, 0xe6, 0x12,
+	0x3c, 0x55, 0x60, 0x25, 0xed, 0x82, 0x1c, 0x2d, 0xcf, 0x86, 0x34, 0x69, 0xd4, 0xcb, 0xa3, 0x09,
+	0x85, 0x40, 0x5f, 0xea, 0xd7, 0x2e, 0xa3, 0x17, 0x7c, 0x81, 0x44, 0xdf, 0x42, 0x13, 0x3f, 0x47,
+	0x4b, 0x0b, 0xe5, 0xa4, 0x5d, 0xf6, 0x04, 0x17, 0xfa, 0x93, 0x02, 0x6b, 0x03, 0xda, 0x1d, 0xe8,
+	0x4a, 0x6a, 0xc1, 0x98, 0x8e, 0xfe, 0x6a, 0x26, 0x5a, 0x21, 0x00, 0xe9, 0xd7, 0xbe, 0x88, 0x6e,
+	0xf5, 0x38, 0x55, 0x44, 0x86, 0x28, 0x76, 0x8d, 0x5a, 0xc2, 0xfb, 0x2c, 0x47, 0xcc, 0x7e, 0xd5,
+	0x26, 0xe6, 0x7d, 0xbe, 0xc9, 0x80, 0x1c, 0xe5, 0x0b, 0xc6, 0x02, 0xe8, 0xf7, 0x71, 0x9f, 0x93,
+	0x9b, 0x16, 0x43, 0x7c, 0x2e, 0xa5, 0x23, 0xa3, 0xee, 0x64, 0xa4, 0x16, 0x12, 0xbe, 0xc7, 0xaf,
+	0x35, 0x71, 0x13, 0xf1, 0x9e, 0xc8, 0x30, 0x43, 0x6d, 0xa1, 0x8d, 0x01, 0xf2, 0xec, 0x71, 0xde,
+	0x37, 0xe6, 0x3e, 0xc8, 0xd9, 0x8f, 0x1e, 0x2d, 0xf0, 0xce, 0xc9, 0xf5, 0x7f, 0x0f, 0x00, 0x92,
+	0x70, 0x1d, 0x96, 0x3a, 0x3c, 0x00, 0x00,
 }
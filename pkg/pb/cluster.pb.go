@@ -45,7 +45,7 @@ func (m *DescribeSubnetsRequest) Reset()         { *m = DescribeSubnetsRequest{}
 func (m *DescribeSubnetsRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSubnetsRequest) ProtoMessage()    {}
 func (*DescribeSubnetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{0}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{0}
 }
 func (m *DescribeSubnetsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeSubnetsRequest.Unmarshal(m, b)
@@ -132,7 +132,7 @@ func (m *Subnet) Reset()         { *m = Subnet{} }
 func (m *Subnet) String() string { return proto.CompactTextString(m) }
 func (*Subnet) ProtoMessage()    {}
 func (*Subnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{1}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{1}
 }
 func (m *Subnet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Subnet.Unmarshal(m, b)
@@ -220,7 +220,7 @@ func (m *DescribeSubnetsResponse) Reset()         { *m = DescribeSubnetsResponse
 func (m *DescribeSubnetsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSubnetsResponse) ProtoMessage()    {}
 func (*DescribeSubnetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{2}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{2}
 }
 func (m *DescribeSubnetsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeSubnetsResponse.Unmarshal(m, b)
@@ -269,7 +269,7 @@ func (m *CreateClusterRequest) Reset()         { *m = CreateClusterRequest{} }
 func (m *CreateClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateClusterRequest) ProtoMessage()    {}
 func (*CreateClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{3}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{3}
 }
 func (m *CreateClusterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateClusterRequest.Unmarshal(m, b)
@@ -336,7 +336,7 @@ func (m *CreateClusterResponse) Reset()         { *m = CreateClusterResponse{} }
 func (m *CreateClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateClusterResponse) ProtoMessage()    {}
 func (*CreateClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{4}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{4}
 }
 func (m *CreateClusterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateClusterResponse.Unmarshal(m, b)
@@ -386,7 +386,7 @@ func (m *ModifyClusterRequest) Reset()         { *m = ModifyClusterRequest{} }
 func (m *ModifyClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyClusterRequest) ProtoMessage()    {}
 func (*ModifyClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{5}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{5}
 }
 func (m *ModifyClusterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyClusterRequest.Unmarshal(m, b)
@@ -459,7 +459,7 @@ func (m *ModifyClusterResponse) Reset()         { *m = ModifyClusterResponse{} }
 func (m *ModifyClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyClusterResponse) ProtoMessage()    {}
 func (*ModifyClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{6}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{6}
 }
 func (m *ModifyClusterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyClusterResponse.Unmarshal(m, b)
@@ -497,7 +497,7 @@ func (m *ModifyClusterNodeRequest) Reset()         { *m = ModifyClusterNodeReque
 func (m *ModifyClusterNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyClusterNodeRequest) ProtoMessage()    {}
 func (*ModifyClusterNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{7}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{7}
 }
 func (m *ModifyClusterNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyClusterNodeRequest.Unmarshal(m, b)
@@ -535,7 +535,7 @@ func (m *ModifyClusterNodeResponse) Reset()         { *m = ModifyClusterNodeResp
 func (m *ModifyClusterNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyClusterNodeResponse) ProtoMessage()    {}
 func (*ModifyClusterNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{8}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{8}
 }
 func (m *ModifyClusterNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyClusterNodeResponse.Unmarshal(m, b)
@@ -575,7 +575,7 @@ func (m *ModifyClusterAttributesRequest) Reset()         { *m = ModifyClusterAtt
 func (m *ModifyClusterAttributesRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyClusterAttributesRequest) ProtoMessage()    {}
 func (*ModifyClusterAttributesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{9}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{9}
 }
 func (m *ModifyClusterAttributesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyClusterAttributesRequest.Unmarshal(m, b)
@@ -627,7 +627,7 @@ func (m *ModifyClusterAttributesResponse) Reset()         { *m = ModifyClusterAt
 func (m *ModifyClusterAttributesResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyClusterAttributesResponse) ProtoMessage()    {}
 func (*ModifyClusterAttributesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{10}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{10}
 }
 func (m *ModifyClusterAttributesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyClusterAttributesResponse.Unmarshal(m, b)
@@ -666,7 +666,7 @@ func (m *ModifyClusterNodeAttributesRequest) Reset()         { *m = ModifyCluste
 func (m *ModifyClusterNodeAttributesRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyClusterNodeAttributesRequest) ProtoMessage()    {}
 func (*ModifyClusterNodeAttributesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{11}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{11}
 }
 func (m *ModifyClusterNodeAttributesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyClusterNodeAttributesRequest.Unmarshal(m, b)
@@ -711,7 +711,7 @@ func (m *ModifyClusterNodeAttributesResponse) Reset()         { *m = ModifyClust
 func (m *ModifyClusterNodeAttributesResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyClusterNodeAttributesResponse) ProtoMessage()    {}
 func (*ModifyClusterNodeAttributesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{12}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{12}
 }
 func (m *ModifyClusterNodeAttributesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyClusterNodeAttributesResponse.Unmarshal(m, b)
@@ -749,7 +749,7 @@ func (m *AddTableClusterNodesRequest) Reset()         { *m = AddTableClusterNode
 func (m *AddTableClusterNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddTableClusterNodesRequest) ProtoMessage()    {}
 func (*AddTableClusterNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{13}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{13}
 }
 func (m *AddTableClusterNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddTableClusterNodesRequest.Unmarshal(m, b)
@@ -787,7 +787,7 @@ func (m *DeleteTableClusterNodesRequest) Reset()         { *m = DeleteTableClust
 func (m *DeleteTableClusterNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteTableClusterNodesRequest) ProtoMessage()    {}
 func (*DeleteTableClusterNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{14}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{14}
 }
 func (m *DeleteTableClusterNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteTableClusterNodesRequest.Unmarshal(m, b)
@@ -826,7 +826,7 @@ func (m *DeleteClustersRequest) Reset()         { *m = DeleteClustersRequest{} }
 func (m *DeleteClustersRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteClustersRequest) ProtoMessage()    {}
 func (*DeleteClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{15}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{15}
 }
 func (m *DeleteClustersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteClustersRequest.Unmarshal(m, b)
@@ -872,7 +872,7 @@ func (m *DeleteClustersResponse) Reset()         { *m = DeleteClustersResponse{}
 func (m *DeleteClustersResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteClustersResponse) ProtoMessage()    {}
 func (*DeleteClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{16}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{16}
 }
 func (m *DeleteClustersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteClustersResponse.Unmarshal(m, b)
@@ -919,7 +919,7 @@ func (m *UpgradeClusterRequest) Reset()         { *m = UpgradeClusterRequest{} }
 func (m *UpgradeClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*UpgradeClusterRequest) ProtoMessage()    {}
 func (*UpgradeClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{17}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{17}
 }
 func (m *UpgradeClusterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeClusterRequest.Unmarshal(m, b)
@@ -972,7 +972,7 @@ func (m *UpgradeClusterResponse) Reset()         { *m = UpgradeClusterResponse{}
 func (m *UpgradeClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*UpgradeClusterResponse) ProtoMessage()    {}
 func (*UpgradeClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{18}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{18}
 }
 func (m *UpgradeClusterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeClusterResponse.Unmarshal(m, b)
@@ -1018,7 +1018,7 @@ func (m *RollbackClusterRequest) Reset()         { *m = RollbackClusterRequest{}
 func (m *RollbackClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*RollbackClusterRequest) ProtoMessage()    {}
 func (*RollbackClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{19}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{19}
 }
 func (m *RollbackClusterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RollbackClusterRequest.Unmarshal(m, b)
@@ -1064,7 +1064,7 @@ func (m *RollbackClusterResponse) Reset()         { *m = RollbackClusterResponse
 func (m *RollbackClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*RollbackClusterResponse) ProtoMessage()    {}
 func (*RollbackClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{20}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{20}
 }
 func (m *RollbackClusterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RollbackClusterResponse.Unmarshal(m, b)
@@ -1113,7 +1113,7 @@ func (m *ResizeClusterRequest) Reset()         { *m = ResizeClusterRequest{} }
 func (m *ResizeClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*ResizeClusterRequest) ProtoMessage()    {}
 func (*ResizeClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{21}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{21}
 }
 func (m *ResizeClusterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResizeClusterRequest.Unmarshal(m, b)
@@ -1180,7 +1180,7 @@ func (m *ResizeClusterResponse) Reset()         { *m = ResizeClusterResponse{} }
 func (m *ResizeClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*ResizeClusterResponse) ProtoMessage()    {}
 func (*ResizeClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{22}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{22}
 }
 func (m *ResizeClusterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResizeClusterResponse.Unmarshal(m, b)
@@ -1228,7 +1228,7 @@ func (m *AddClusterNodesRequest) Reset()         { *m = AddClusterNodesRequest{}
 func (m *AddClusterNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddClusterNodesRequest) ProtoMessage()    {}
 func (*AddClusterNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{23}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{23}
 }
 func (m *AddClusterNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddClusterNodesRequest.Unmarshal(m, b)
@@ -1288,7 +1288,7 @@ func (m *AddClusterNodesResponse) Reset()         { *m = AddClusterNodesResponse
 func (m *AddClusterNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*AddClusterNodesResponse) ProtoMessage()    {}
 func (*AddClusterNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{24}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{24}
 }
 func (m *AddClusterNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddClusterNodesResponse.Unmarshal(m, b)
@@ -1336,7 +1336,7 @@ func (m *DeleteClusterNodesRequest) Reset()         { *m = DeleteClusterNodesReq
 func (m *DeleteClusterNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteClusterNodesRequest) ProtoMessage()    {}
 func (*DeleteClusterNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{25}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{25}
 }
 func (m *DeleteClusterNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteClusterNodesRequest.Unmarshal(m, b)
@@ -1396,7 +1396,7 @@ func (m *DeleteClusterNodesResponse) Reset()         { *m = DeleteClusterNodesRe
 func (m *DeleteClusterNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteClusterNodesResponse) ProtoMessage()    {}
 func (*DeleteClusterNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{26}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{26}
 }
 func (m *DeleteClusterNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteClusterNodesResponse.Unmarshal(m, b)
@@ -1443,7 +1443,7 @@ func (m *UpdateClusterEnvRequest) Reset()         { *m = UpdateClusterEnvRequest
 func (m *UpdateClusterEnvRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateClusterEnvRequest) ProtoMessage()    {}
 func (*UpdateClusterEnvRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{27}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{27}
 }
 func (m *UpdateClusterEnvRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateClusterEnvRequest.Unmarshal(m, b)
@@ -1496,7 +1496,7 @@ func (m *UpdateClusterEnvResponse) Reset()         { *m = UpdateClusterEnvRespon
 func (m *UpdateClusterEnvResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateClusterEnvResponse) ProtoMessage()    {}
 func (*UpdateClusterEnvResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{28}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{28}
 }
 func (m *UpdateClusterEnvResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateClusterEnvResponse.Unmarshal(m, b)
@@ -1557,6 +1557,7 @@ type ClusterCommon struct {
 	BackupPolicy               *wrappers.StringValue `protobuf:"bytes,24,opt,name=backup_policy,json=backupPolicy,proto3" json:"backup_policy,omitempty"`
 	IncrementalBackupSupported *wrappers.BoolValue   `protobuf:"bytes,25,opt,name=incremental_backup_supported,json=incrementalBackupSupported,proto3" json:"incremental_backup_supported,omitempty"`
 	Hypervisor                 *wrappers.StringValue `protobuf:"bytes,26,opt,name=hypervisor,proto3" json:"hypervisor,omitempty"`
+	Logs                       *wrappers.StringValue `protobuf:"bytes,27,opt,name=logs,proto3" json:"logs,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}              `json:"-"`
 	XXX_unrecognized           []byte                `json:"-"`
 	XXX_sizecache              int32                 `json:"-"`
@@ -1566,7 +1567,7 @@ func (m *ClusterCommon) Reset()         { *m = ClusterCommon{} }
 func (m *ClusterCommon) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommon) ProtoMessage()    {}
 func (*ClusterCommon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{29}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{29}
 }
 func (m *ClusterCommon) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterCommon.Unmarshal(m, b)
@@ -1768,6 +1769,13 @@ func (m *ClusterCommon) GetHypervisor() *wrappers.StringValue {
 	return nil
 }
 
+func (m *ClusterCommon) GetLogs() *wrappers.StringValue {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 type ClusterNode struct {
 	NodeId               *wrappers.StringValue `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	ClusterId            *wrappers.StringValue `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
@@ -1795,6 +1803,7 @@ type ClusterNode struct {
 	ClusterRole          *ClusterRole          `protobuf:"bytes,24,opt,name=cluster_role,json=clusterRole,proto3" json:"cluster_role,omitempty"`
 	ClusterCommon        *ClusterCommon        `protobuf:"bytes,25,opt,name=cluster_common,json=clusterCommon,proto3" json:"cluster_common,omitempty"`
 	KeyPairId            []string              `protobuf:"bytes,26,rep,name=key_pair_id,json=keyPairId,proto3" json:"key_pair_id,omitempty"`
+	EipId                *wrappers.StringValue `protobuf:"bytes,27,opt,name=eip_id,json=eipId,proto3" json:"eip_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1804,7 +1813,7 @@ func (m *ClusterNode) Reset()         { *m = ClusterNode{} }
 func (m *ClusterNode) String() string { return proto.CompactTextString(m) }
 func (*ClusterNode) ProtoMessage()    {}
 func (*ClusterNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{30}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{30}
 }
 func (m *ClusterNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterNode.Unmarshal(m, b)
@@ -2006,6 +2015,13 @@ func (m *ClusterNode) GetKeyPairId() []string {
 	return nil
 }
 
+func (m *ClusterNode) GetEipId() *wrappers.StringValue {
+	if m != nil {
+		return m.EipId
+	}
+	return nil
+}
+
 type ClusterRole struct {
 	ClusterId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	Role                 *wrappers.StringValue `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
@@ -2018,6 +2034,7 @@ type ClusterRole struct {
 	MountOptions         *wrappers.StringValue `protobuf:"bytes,9,opt,name=mount_options,json=mountOptions,proto3" json:"mount_options,omitempty"`
 	FileSystem           *wrappers.StringValue `protobuf:"bytes,10,opt,name=file_system,json=fileSystem,proto3" json:"file_system,omitempty"`
 	Env                  *wrappers.StringValue `protobuf:"bytes,11,opt,name=env,proto3" json:"env,omitempty"`
+	PublicEndpoint       *wrappers.BoolValue   `protobuf:"bytes,12,opt,name=public_endpoint,json=publicEndpoint,proto3" json:"public_endpoint,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -2027,7 +2044,7 @@ func (m *ClusterRole) Reset()         { *m = ClusterRole{} }
 func (m *ClusterRole) String() string { return proto.CompactTextString(m) }
 func (*ClusterRole) ProtoMessage()    {}
 func (*ClusterRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{31}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{31}
 }
 func (m *ClusterRole) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterRole.Unmarshal(m, b)
@@ -2124,6 +2141,13 @@ func (m *ClusterRole) GetEnv() *wrappers.StringValue {
 	return nil
 }
 
+func (m *ClusterRole) GetPublicEndpoint() *wrappers.BoolValue {
+	if m != nil {
+		return m.PublicEndpoint
+	}
+	return nil
+}
+
 type ClusterLoadbalancer struct {
 	ClusterId              *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	Role                   *wrappers.StringValue `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
@@ -2139,7 +2163,7 @@ func (m *ClusterLoadbalancer) Reset()         { *m = ClusterLoadbalancer{} }
 func (m *ClusterLoadbalancer) String() string { return proto.CompactTextString(m) }
 func (*ClusterLoadbalancer) ProtoMessage()    {}
 func (*ClusterLoadbalancer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{32}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{32}
 }
 func (m *ClusterLoadbalancer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterLoadbalancer.Unmarshal(m, b)
@@ -2208,7 +2232,7 @@ func (m *ClusterLink) Reset()         { *m = ClusterLink{} }
 func (m *ClusterLink) String() string { return proto.CompactTextString(m) }
 func (*ClusterLink) ProtoMessage()    {}
 func (*ClusterLink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{33}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{33}
 }
 func (m *ClusterLink) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterLink.Unmarshal(m, b)
@@ -2282,16 +2306,20 @@ type Cluster struct {
 	ClusterLinkSet         []*ClusterLink         `protobuf:"bytes,23,rep,name=cluster_link_set,json=clusterLinkSet,proto3" json:"cluster_link_set,omitempty"`
 	ClusterCommonSet       []*ClusterCommon       `protobuf:"bytes,24,rep,name=cluster_common_set,json=clusterCommonSet,proto3" json:"cluster_common_set,omitempty"`
 	ClusterLoadbalancerSet []*ClusterLoadbalancer `protobuf:"bytes,25,rep,name=cluster_loadbalancer_set,json=clusterLoadbalancerSet,proto3" json:"cluster_loadbalancer_set,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
-	XXX_unrecognized       []byte                 `json:"-"`
-	XXX_sizecache          int32                  `json:"-"`
+	// network resources created by openpitrix for the cluster
+	ManagedVpcId         *wrappers.StringValue `protobuf:"bytes,26,opt,name=managed_vpc_id,json=managedVpcId,proto3" json:"managed_vpc_id,omitempty"`
+	ManagedSubnetId      *wrappers.StringValue `protobuf:"bytes,27,opt,name=managed_subnet_id,json=managedSubnetId,proto3" json:"managed_subnet_id,omitempty"`
+	ManagedEipId         *wrappers.StringValue `protobuf:"bytes,28,opt,name=managed_eip_id,json=managedEipId,proto3" json:"managed_eip_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *Cluster) Reset()         { *m = Cluster{} }
 func (m *Cluster) String() string { return proto.CompactTextString(m) }
 func (*Cluster) ProtoMessage()    {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{34}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{34}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Cluster.Unmarshal(m, b)
@@ -2486,6 +2514,27 @@ func (m *Cluster) GetClusterLoadbalancerSet() []*ClusterLoadbalancer {
 	return nil
 }
 
+func (m *Cluster) GetManagedVpcId() *wrappers.StringValue {
+	if m != nil {
+		return m.ManagedVpcId
+	}
+	return nil
+}
+
+func (m *Cluster) GetManagedSubnetId() *wrappers.StringValue {
+	if m != nil {
+		return m.ManagedSubnetId
+	}
+	return nil
+}
+
+func (m *Cluster) GetManagedEipId() *wrappers.StringValue {
+	if m != nil {
+		return m.ManagedEipId
+	}
+	return nil
+}
+
 type DescribeClustersRequest struct {
 	ClusterId         []string              `protobuf:"bytes,1,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	AppId             []string              `protobuf:"bytes,2,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -2508,7 +2557,7 @@ func (m *DescribeClustersRequest) Reset()         { *m = DescribeClustersRequest
 func (m *DescribeClustersRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeClustersRequest) ProtoMessage()    {}
 func (*DescribeClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{35}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{35}
 }
 func (m *DescribeClustersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeClustersRequest.Unmarshal(m, b)
@@ -2610,7 +2659,7 @@ func (m *DescribeClustersResponse) Reset()         { *m = DescribeClustersRespon
 func (m *DescribeClustersResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeClustersResponse) ProtoMessage()    {}
 func (*DescribeClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{36}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{36}
 }
 func (m *DescribeClustersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeClustersResponse.Unmarshal(m, b)
@@ -2662,7 +2711,7 @@ func (m *DescribeClusterNodesRequest) Reset()         { *m = DescribeClusterNode
 func (m *DescribeClusterNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeClusterNodesRequest) ProtoMessage()    {}
 func (*DescribeClusterNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{37}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{37}
 }
 func (m *DescribeClusterNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeClusterNodesRequest.Unmarshal(m, b)
@@ -2736,7 +2785,7 @@ func (m *DescribeClusterNodesResponse) Reset()         { *m = DescribeClusterNod
 func (m *DescribeClusterNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeClusterNodesResponse) ProtoMessage()    {}
 func (*DescribeClusterNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{38}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{38}
 }
 func (m *DescribeClusterNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeClusterNodesResponse.Unmarshal(m, b)
@@ -2770,6 +2819,205 @@ func (m *DescribeClusterNodesResponse) GetClusterNodeSet() []*ClusterNode {
 	return nil
 }
 
+type DescribeClusterNodeLogsRequest struct {
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// all nodes of the cluster if empty
+	NodeId []string `protobuf:"bytes,2,rep,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// all logs declared by the role if empty
+	LogName []string `protobuf:"bytes,3,rep,name=log_name,json=logName,proto3" json:"log_name,omitempty"`
+	// default is 100, max value is 10000
+	TailLines uint32               `protobuf:"varint,4,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	SinceTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	// regexp to filter the log lines
+	Grep                 *wrappers.StringValue `protobuf:"bytes,6,opt,name=grep,proto3" json:"grep,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeClusterNodeLogsRequest) Reset()         { *m = DescribeClusterNodeLogsRequest{} }
+func (m *DescribeClusterNodeLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterNodeLogsRequest) ProtoMessage()    {}
+func (*DescribeClusterNodeLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{39}
+}
+func (m *DescribeClusterNodeLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterNodeLogsRequest.Unmarshal(m, b)
+}
+func (m *DescribeClusterNodeLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterNodeLogsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DescribeClusterNodeLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterNodeLogsRequest.Merge(dst, src)
+}
+func (m *DescribeClusterNodeLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterNodeLogsRequest.Size(m)
+}
+func (m *DescribeClusterNodeLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterNodeLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterNodeLogsRequest proto.InternalMessageInfo
+
+func (m *DescribeClusterNodeLogsRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DescribeClusterNodeLogsRequest) GetNodeId() []string {
+	if m != nil {
+		return m.NodeId
+	}
+	return nil
+}
+
+func (m *DescribeClusterNodeLogsRequest) GetLogName() []string {
+	if m != nil {
+		return m.LogName
+	}
+	return nil
+}
+
+func (m *DescribeClusterNodeLogsRequest) GetTailLines() uint32 {
+	if m != nil {
+		return m.TailLines
+	}
+	return 0
+}
+
+func (m *DescribeClusterNodeLogsRequest) GetSinceTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.SinceTime
+	}
+	return nil
+}
+
+func (m *DescribeClusterNodeLogsRequest) GetGrep() *wrappers.StringValue {
+	if m != nil {
+		return m.Grep
+	}
+	return nil
+}
+
+type ClusterNodeLog struct {
+	NodeId  *wrappers.StringValue `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Role    *wrappers.StringValue `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	LogName *wrappers.StringValue `protobuf:"bytes,3,opt,name=log_name,json=logName,proto3" json:"log_name,omitempty"`
+	Path    *wrappers.StringValue `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Content *wrappers.StringValue `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// set if the log of the node can not be fetched
+	Error                *wrappers.StringValue `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ClusterNodeLog) Reset()         { *m = ClusterNodeLog{} }
+func (m *ClusterNodeLog) String() string { return proto.CompactTextString(m) }
+func (*ClusterNodeLog) ProtoMessage()    {}
+func (*ClusterNodeLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{40}
+}
+func (m *ClusterNodeLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterNodeLog.Unmarshal(m, b)
+}
+func (m *ClusterNodeLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterNodeLog.Marshal(b, m, deterministic)
+}
+func (dst *ClusterNodeLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterNodeLog.Merge(dst, src)
+}
+func (m *ClusterNodeLog) XXX_Size() int {
+	return xxx_messageInfo_ClusterNodeLog.Size(m)
+}
+func (m *ClusterNodeLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterNodeLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterNodeLog proto.InternalMessageInfo
+
+func (m *ClusterNodeLog) GetNodeId() *wrappers.StringValue {
+	if m != nil {
+		return m.NodeId
+	}
+	return nil
+}
+
+func (m *ClusterNodeLog) GetRole() *wrappers.StringValue {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *ClusterNodeLog) GetLogName() *wrappers.StringValue {
+	if m != nil {
+		return m.LogName
+	}
+	return nil
+}
+
+func (m *ClusterNodeLog) GetPath() *wrappers.StringValue {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *ClusterNodeLog) GetContent() *wrappers.StringValue {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *ClusterNodeLog) GetError() *wrappers.StringValue {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type DescribeClusterNodeLogsResponse struct {
+	ClusterNodeLogSet    []*ClusterNodeLog `protobuf:"bytes,1,rep,name=cluster_node_log_set,json=clusterNodeLogSet,proto3" json:"cluster_node_log_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescribeClusterNodeLogsResponse) Reset()         { *m = DescribeClusterNodeLogsResponse{} }
+func (m *DescribeClusterNodeLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterNodeLogsResponse) ProtoMessage()    {}
+func (*DescribeClusterNodeLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{41}
+}
+func (m *DescribeClusterNodeLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterNodeLogsResponse.Unmarshal(m, b)
+}
+func (m *DescribeClusterNodeLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterNodeLogsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeClusterNodeLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterNodeLogsResponse.Merge(dst, src)
+}
+func (m *DescribeClusterNodeLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterNodeLogsResponse.Size(m)
+}
+func (m *DescribeClusterNodeLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterNodeLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterNodeLogsResponse proto.InternalMessageInfo
+
+func (m *DescribeClusterNodeLogsResponse) GetClusterNodeLogSet() []*ClusterNodeLog {
+	if m != nil {
+		return m.ClusterNodeLogSet
+	}
+	return nil
+}
+
 type StopClustersRequest struct {
 	ClusterId            []string `protobuf:"bytes,1,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	AdvancedParam        []string `protobuf:"bytes,2,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
@@ -2782,7 +3030,7 @@ func (m *StopClustersRequest) Reset()         { *m = StopClustersRequest{} }
 func (m *StopClustersRequest) String() string { return proto.CompactTextString(m) }
 func (*StopClustersRequest) ProtoMessage()    {}
 func (*StopClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{39}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{42}
 }
 func (m *StopClustersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopClustersRequest.Unmarshal(m, b)
@@ -2828,7 +3076,7 @@ func (m *StopClustersResponse) Reset()         { *m = StopClustersResponse{} }
 func (m *StopClustersResponse) String() string { return proto.CompactTextString(m) }
 func (*StopClustersResponse) ProtoMessage()    {}
 func (*StopClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{40}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{43}
 }
 func (m *StopClustersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopClustersResponse.Unmarshal(m, b)
@@ -2874,7 +3122,7 @@ func (m *StartClustersRequest) Reset()         { *m = StartClustersRequest{} }
 func (m *StartClustersRequest) String() string { return proto.CompactTextString(m) }
 func (*StartClustersRequest) ProtoMessage()    {}
 func (*StartClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{41}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{44}
 }
 func (m *StartClustersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartClustersRequest.Unmarshal(m, b)
@@ -2920,7 +3168,7 @@ func (m *StartClustersResponse) Reset()         { *m = StartClustersResponse{} }
 func (m *StartClustersResponse) String() string { return proto.CompactTextString(m) }
 func (*StartClustersResponse) ProtoMessage()    {}
 func (*StartClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{42}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{45}
 }
 func (m *StartClustersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartClustersResponse.Unmarshal(m, b)
@@ -2966,7 +3214,7 @@ func (m *RecoverClustersRequest) Reset()         { *m = RecoverClustersRequest{}
 func (m *RecoverClustersRequest) String() string { return proto.CompactTextString(m) }
 func (*RecoverClustersRequest) ProtoMessage()    {}
 func (*RecoverClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{43}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{46}
 }
 func (m *RecoverClustersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RecoverClustersRequest.Unmarshal(m, b)
@@ -3012,7 +3260,7 @@ func (m *RecoverClustersResponse) Reset()         { *m = RecoverClustersResponse
 func (m *RecoverClustersResponse) String() string { return proto.CompactTextString(m) }
 func (*RecoverClustersResponse) ProtoMessage()    {}
 func (*RecoverClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{44}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{47}
 }
 func (m *RecoverClustersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RecoverClustersResponse.Unmarshal(m, b)
@@ -3026,210 +3274,918 @@ func (dst *RecoverClustersResponse) XXX_Merge(src proto.Message) {
 func (m *RecoverClustersResponse) XXX_Size() int {
 	return xxx_messageInfo_RecoverClustersResponse.Size(m)
 }
-func (m *RecoverClustersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecoverClustersResponse.DiscardUnknown(m)
+func (m *RecoverClustersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoverClustersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoverClustersResponse proto.InternalMessageInfo
+
+func (m *RecoverClustersResponse) GetClusterId() []string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *RecoverClustersResponse) GetJobId() []string {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+type CeaseClustersRequest struct {
+	ClusterId            []string `protobuf:"bytes,1,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	AdvancedParam        []string `protobuf:"bytes,2,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CeaseClustersRequest) Reset()         { *m = CeaseClustersRequest{} }
+func (m *CeaseClustersRequest) String() string { return proto.CompactTextString(m) }
+func (*CeaseClustersRequest) ProtoMessage()    {}
+func (*CeaseClustersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{48}
+}
+func (m *CeaseClustersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CeaseClustersRequest.Unmarshal(m, b)
+}
+func (m *CeaseClustersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CeaseClustersRequest.Marshal(b, m, deterministic)
+}
+func (dst *CeaseClustersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CeaseClustersRequest.Merge(dst, src)
+}
+func (m *CeaseClustersRequest) XXX_Size() int {
+	return xxx_messageInfo_CeaseClustersRequest.Size(m)
+}
+func (m *CeaseClustersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CeaseClustersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CeaseClustersRequest proto.InternalMessageInfo
+
+func (m *CeaseClustersRequest) GetClusterId() []string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *CeaseClustersRequest) GetAdvancedParam() []string {
+	if m != nil {
+		return m.AdvancedParam
+	}
+	return nil
+}
+
+type CeaseClustersResponse struct {
+	ClusterId            []string `protobuf:"bytes,1,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	JobId                []string `protobuf:"bytes,2,rep,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CeaseClustersResponse) Reset()         { *m = CeaseClustersResponse{} }
+func (m *CeaseClustersResponse) String() string { return proto.CompactTextString(m) }
+func (*CeaseClustersResponse) ProtoMessage()    {}
+func (*CeaseClustersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{49}
+}
+func (m *CeaseClustersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CeaseClustersResponse.Unmarshal(m, b)
+}
+func (m *CeaseClustersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CeaseClustersResponse.Marshal(b, m, deterministic)
+}
+func (dst *CeaseClustersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CeaseClustersResponse.Merge(dst, src)
+}
+func (m *CeaseClustersResponse) XXX_Size() int {
+	return xxx_messageInfo_CeaseClustersResponse.Size(m)
+}
+func (m *CeaseClustersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CeaseClustersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CeaseClustersResponse proto.InternalMessageInfo
+
+func (m *CeaseClustersResponse) GetClusterId() []string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *CeaseClustersResponse) GetJobId() []string {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+type GetClusterStatisticsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClusterStatisticsRequest) Reset()         { *m = GetClusterStatisticsRequest{} }
+func (m *GetClusterStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetClusterStatisticsRequest) ProtoMessage()    {}
+func (*GetClusterStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{50}
+}
+func (m *GetClusterStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClusterStatisticsRequest.Unmarshal(m, b)
+}
+func (m *GetClusterStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClusterStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetClusterStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterStatisticsRequest.Merge(dst, src)
+}
+func (m *GetClusterStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetClusterStatisticsRequest.Size(m)
+}
+func (m *GetClusterStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterStatisticsRequest proto.InternalMessageInfo
+
+type GetClusterStatisticsResponse struct {
+	LastTwoWeekCreated   map[string]uint32 `protobuf:"bytes,1,rep,name=last_two_week_created,json=lastTwoWeekCreated,proto3" json:"last_two_week_created,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TopTenRuntimes       map[string]uint32 `protobuf:"bytes,2,rep,name=top_ten_runtimes,json=topTenRuntimes,proto3" json:"top_ten_runtimes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ClusterCount         uint32            `protobuf:"varint,3,opt,name=cluster_count,json=clusterCount,proto3" json:"cluster_count,omitempty"`
+	RuntimeCount         uint32            `protobuf:"varint,4,opt,name=runtime_count,json=runtimeCount,proto3" json:"runtime_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetClusterStatisticsResponse) Reset()         { *m = GetClusterStatisticsResponse{} }
+func (m *GetClusterStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetClusterStatisticsResponse) ProtoMessage()    {}
+func (*GetClusterStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{51}
+}
+func (m *GetClusterStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClusterStatisticsResponse.Unmarshal(m, b)
+}
+func (m *GetClusterStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClusterStatisticsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetClusterStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterStatisticsResponse.Merge(dst, src)
+}
+func (m *GetClusterStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetClusterStatisticsResponse.Size(m)
+}
+func (m *GetClusterStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterStatisticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterStatisticsResponse proto.InternalMessageInfo
+
+func (m *GetClusterStatisticsResponse) GetLastTwoWeekCreated() map[string]uint32 {
+	if m != nil {
+		return m.LastTwoWeekCreated
+	}
+	return nil
+}
+
+func (m *GetClusterStatisticsResponse) GetTopTenRuntimes() map[string]uint32 {
+	if m != nil {
+		return m.TopTenRuntimes
+	}
+	return nil
+}
+
+func (m *GetClusterStatisticsResponse) GetClusterCount() uint32 {
+	if m != nil {
+		return m.ClusterCount
+	}
+	return 0
+}
+
+func (m *GetClusterStatisticsResponse) GetRuntimeCount() uint32 {
+	if m != nil {
+		return m.RuntimeCount
+	}
+	return 0
+}
+
+type DescribeRuntimeUsageRequest struct {
+	RuntimeId []string `protobuf:"bytes,1,rep,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Owner     []string `protobuf:"bytes,2,rep,name=owner,proto3" json:"owner,omitempty"`
+	// report the quotas left in the runtimes by the providers
+	WithQuota *wrappers.BoolValue `protobuf:"bytes,3,opt,name=with_quota,json=withQuota,proto3" json:"with_quota,omitempty"`
+	// return the snapshots of the usage taken in the time range
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DescribeRuntimeUsageRequest) Reset()         { *m = DescribeRuntimeUsageRequest{} }
+func (m *DescribeRuntimeUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeRuntimeUsageRequest) ProtoMessage()    {}
+func (*DescribeRuntimeUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{52}
+}
+func (m *DescribeRuntimeUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeRuntimeUsageRequest.Unmarshal(m, b)
+}
+func (m *DescribeRuntimeUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeRuntimeUsageRequest.Marshal(b, m, deterministic)
+}
+func (dst *DescribeRuntimeUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeRuntimeUsageRequest.Merge(dst, src)
+}
+func (m *DescribeRuntimeUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeRuntimeUsageRequest.Size(m)
+}
+func (m *DescribeRuntimeUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeRuntimeUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeRuntimeUsageRequest proto.InternalMessageInfo
+
+func (m *DescribeRuntimeUsageRequest) GetRuntimeId() []string {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *DescribeRuntimeUsageRequest) GetOwner() []string {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *DescribeRuntimeUsageRequest) GetWithQuota() *wrappers.BoolValue {
+	if m != nil {
+		return m.WithQuota
+	}
+	return nil
+}
+
+func (m *DescribeRuntimeUsageRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *DescribeRuntimeUsageRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type RuntimeUsage struct {
+	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Owner                *wrappers.StringValue `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	ClusterCount         uint32                `protobuf:"varint,3,opt,name=cluster_count,json=clusterCount,proto3" json:"cluster_count,omitempty"`
+	NodeCount            uint32                `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Cpu                  uint32                `protobuf:"varint,5,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Gpu                  uint32                `protobuf:"varint,6,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	Memory               uint32                `protobuf:"varint,7,opt,name=memory,proto3" json:"memory,omitempty"`
+	VolumeSize           uint32                `protobuf:"varint,8,opt,name=volume_size,json=volumeSize,proto3" json:"volume_size,omitempty"`
+	CreateTime           *timestamp.Timestamp  `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RuntimeUsage) Reset()         { *m = RuntimeUsage{} }
+func (m *RuntimeUsage) String() string { return proto.CompactTextString(m) }
+func (*RuntimeUsage) ProtoMessage()    {}
+func (*RuntimeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{53}
+}
+func (m *RuntimeUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimeUsage.Unmarshal(m, b)
+}
+func (m *RuntimeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimeUsage.Marshal(b, m, deterministic)
+}
+func (dst *RuntimeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeUsage.Merge(dst, src)
+}
+func (m *RuntimeUsage) XXX_Size() int {
+	return xxx_messageInfo_RuntimeUsage.Size(m)
+}
+func (m *RuntimeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeUsage proto.InternalMessageInfo
+
+func (m *RuntimeUsage) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *RuntimeUsage) GetOwner() *wrappers.StringValue {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *RuntimeUsage) GetClusterCount() uint32 {
+	if m != nil {
+		return m.ClusterCount
+	}
+	return 0
+}
+
+func (m *RuntimeUsage) GetNodeCount() uint32 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
+func (m *RuntimeUsage) GetCpu() uint32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *RuntimeUsage) GetGpu() uint32 {
+	if m != nil {
+		return m.Gpu
+	}
+	return 0
+}
+
+func (m *RuntimeUsage) GetMemory() uint32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *RuntimeUsage) GetVolumeSize() uint32 {
+	if m != nil {
+		return m.VolumeSize
+	}
+	return 0
+}
+
+func (m *RuntimeUsage) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+type RuntimeQuota struct {
+	RuntimeId *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	// quotas left in the runtime, -1 means unlimited
+	Instance   int32 `protobuf:"varint,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	Cpu        int32 `protobuf:"varint,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Gpu        int32 `protobuf:"varint,4,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	Memory     int32 `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	Volume     int32 `protobuf:"varint,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	VolumeSize int32 `protobuf:"varint,7,opt,name=volume_size,json=volumeSize,proto3" json:"volume_size,omitempty"`
+	// error of describing the quotas by the provider
+	Error                *wrappers.StringValue `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RuntimeQuota) Reset()         { *m = RuntimeQuota{} }
+func (m *RuntimeQuota) String() string { return proto.CompactTextString(m) }
+func (*RuntimeQuota) ProtoMessage()    {}
+func (*RuntimeQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{54}
+}
+func (m *RuntimeQuota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimeQuota.Unmarshal(m, b)
+}
+func (m *RuntimeQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimeQuota.Marshal(b, m, deterministic)
+}
+func (dst *RuntimeQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeQuota.Merge(dst, src)
+}
+func (m *RuntimeQuota) XXX_Size() int {
+	return xxx_messageInfo_RuntimeQuota.Size(m)
+}
+func (m *RuntimeQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeQuota proto.InternalMessageInfo
+
+func (m *RuntimeQuota) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *RuntimeQuota) GetInstance() int32 {
+	if m != nil {
+		return m.Instance
+	}
+	return 0
+}
+
+func (m *RuntimeQuota) GetCpu() int32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *RuntimeQuota) GetGpu() int32 {
+	if m != nil {
+		return m.Gpu
+	}
+	return 0
+}
+
+func (m *RuntimeQuota) GetMemory() int32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *RuntimeQuota) GetVolume() int32 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *RuntimeQuota) GetVolumeSize() int32 {
+	if m != nil {
+		return m.VolumeSize
+	}
+	return 0
+}
+
+func (m *RuntimeQuota) GetError() *wrappers.StringValue {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type DescribeRuntimeUsageResponse struct {
+	UsageSet             []*RuntimeUsage `protobuf:"bytes,1,rep,name=usage_set,json=usageSet,proto3" json:"usage_set,omitempty"`
+	QuotaSet             []*RuntimeQuota `protobuf:"bytes,2,rep,name=quota_set,json=quotaSet,proto3" json:"quota_set,omitempty"`
+	SnapshotSet          []*RuntimeUsage `protobuf:"bytes,3,rep,name=snapshot_set,json=snapshotSet,proto3" json:"snapshot_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DescribeRuntimeUsageResponse) Reset()         { *m = DescribeRuntimeUsageResponse{} }
+func (m *DescribeRuntimeUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeRuntimeUsageResponse) ProtoMessage()    {}
+func (*DescribeRuntimeUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{55}
+}
+func (m *DescribeRuntimeUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeRuntimeUsageResponse.Unmarshal(m, b)
+}
+func (m *DescribeRuntimeUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeRuntimeUsageResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeRuntimeUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeRuntimeUsageResponse.Merge(dst, src)
+}
+func (m *DescribeRuntimeUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeRuntimeUsageResponse.Size(m)
+}
+func (m *DescribeRuntimeUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeRuntimeUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeRuntimeUsageResponse proto.InternalMessageInfo
+
+func (m *DescribeRuntimeUsageResponse) GetUsageSet() []*RuntimeUsage {
+	if m != nil {
+		return m.UsageSet
+	}
+	return nil
+}
+
+func (m *DescribeRuntimeUsageResponse) GetQuotaSet() []*RuntimeQuota {
+	if m != nil {
+		return m.QuotaSet
+	}
+	return nil
+}
+
+func (m *DescribeRuntimeUsageResponse) GetSnapshotSet() []*RuntimeUsage {
+	if m != nil {
+		return m.SnapshotSet
+	}
+	return nil
+}
+
+type EstimateClusterCostRequest struct {
+	AppId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	VersionId            *wrappers.StringValue `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Conf                 *wrappers.StringValue `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	RuntimeId            *wrappers.StringValue `protobuf:"bytes,4,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *EstimateClusterCostRequest) Reset()         { *m = EstimateClusterCostRequest{} }
+func (m *EstimateClusterCostRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateClusterCostRequest) ProtoMessage()    {}
+func (*EstimateClusterCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{56}
+}
+func (m *EstimateClusterCostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateClusterCostRequest.Unmarshal(m, b)
+}
+func (m *EstimateClusterCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateClusterCostRequest.Marshal(b, m, deterministic)
+}
+func (dst *EstimateClusterCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateClusterCostRequest.Merge(dst, src)
+}
+func (m *EstimateClusterCostRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateClusterCostRequest.Size(m)
+}
+func (m *EstimateClusterCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateClusterCostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateClusterCostRequest proto.InternalMessageInfo
+
+func (m *EstimateClusterCostRequest) GetAppId() *wrappers.StringValue {
+	if m != nil {
+		return m.AppId
+	}
+	return nil
+}
+
+func (m *EstimateClusterCostRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *EstimateClusterCostRequest) GetConf() *wrappers.StringValue {
+	if m != nil {
+		return m.Conf
+	}
+	return nil
+}
+
+func (m *EstimateClusterCostRequest) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+// RoleCost is the cost per hour of all the nodes of the role.
+type RoleCost struct {
+	Role      *wrappers.StringValue `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	NodeCount uint32                `protobuf:"varint,2,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Cpu       float64               `protobuf:"fixed64,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory    float64               `protobuf:"fixed64,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Gpu       float64               `protobuf:"fixed64,5,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	Volume    float64               `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	// replaces the cost of cpu and memory if the nodes are in a priced instance class
+	InstanceClass        float64  `protobuf:"fixed64,7,opt,name=instance_class,json=instanceClass,proto3" json:"instance_class,omitempty"`
+	Total                float64  `protobuf:"fixed64,8,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleCost) Reset()         { *m = RoleCost{} }
+func (m *RoleCost) String() string { return proto.CompactTextString(m) }
+func (*RoleCost) ProtoMessage()    {}
+func (*RoleCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{57}
+}
+func (m *RoleCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleCost.Unmarshal(m, b)
+}
+func (m *RoleCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleCost.Marshal(b, m, deterministic)
+}
+func (dst *RoleCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleCost.Merge(dst, src)
+}
+func (m *RoleCost) XXX_Size() int {
+	return xxx_messageInfo_RoleCost.Size(m)
+}
+func (m *RoleCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleCost proto.InternalMessageInfo
+
+func (m *RoleCost) GetRole() *wrappers.StringValue {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *RoleCost) GetNodeCount() uint32 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
+func (m *RoleCost) GetCpu() float64 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *RoleCost) GetMemory() float64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *RoleCost) GetGpu() float64 {
+	if m != nil {
+		return m.Gpu
+	}
+	return 0
 }
 
-var xxx_messageInfo_RecoverClustersResponse proto.InternalMessageInfo
+func (m *RoleCost) GetVolume() float64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
 
-func (m *RecoverClustersResponse) GetClusterId() []string {
+func (m *RoleCost) GetInstanceClass() float64 {
 	if m != nil {
-		return m.ClusterId
+		return m.InstanceClass
 	}
-	return nil
+	return 0
 }
 
-func (m *RecoverClustersResponse) GetJobId() []string {
+func (m *RoleCost) GetTotal() float64 {
 	if m != nil {
-		return m.JobId
+		return m.Total
 	}
-	return nil
+	return 0
 }
 
-type CeaseClustersRequest struct {
-	ClusterId            []string `protobuf:"bytes,1,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	AdvancedParam        []string `protobuf:"bytes,2,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type EstimateClusterCostResponse struct {
+	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Currency             *wrappers.StringValue `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	RoleCostSet          []*RoleCost           `protobuf:"bytes,3,rep,name=role_cost_set,json=roleCostSet,proto3" json:"role_cost_set,omitempty"`
+	Hourly               float64               `protobuf:"fixed64,4,opt,name=hourly,proto3" json:"hourly,omitempty"`
+	Monthly              float64               `protobuf:"fixed64,5,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *CeaseClustersRequest) Reset()         { *m = CeaseClustersRequest{} }
-func (m *CeaseClustersRequest) String() string { return proto.CompactTextString(m) }
-func (*CeaseClustersRequest) ProtoMessage()    {}
-func (*CeaseClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{45}
+func (m *EstimateClusterCostResponse) Reset()         { *m = EstimateClusterCostResponse{} }
+func (m *EstimateClusterCostResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateClusterCostResponse) ProtoMessage()    {}
+func (*EstimateClusterCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{58}
 }
-func (m *CeaseClustersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CeaseClustersRequest.Unmarshal(m, b)
+func (m *EstimateClusterCostResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateClusterCostResponse.Unmarshal(m, b)
 }
-func (m *CeaseClustersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CeaseClustersRequest.Marshal(b, m, deterministic)
+func (m *EstimateClusterCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateClusterCostResponse.Marshal(b, m, deterministic)
 }
-func (dst *CeaseClustersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CeaseClustersRequest.Merge(dst, src)
+func (dst *EstimateClusterCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateClusterCostResponse.Merge(dst, src)
 }
-func (m *CeaseClustersRequest) XXX_Size() int {
-	return xxx_messageInfo_CeaseClustersRequest.Size(m)
+func (m *EstimateClusterCostResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateClusterCostResponse.Size(m)
 }
-func (m *CeaseClustersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CeaseClustersRequest.DiscardUnknown(m)
+func (m *EstimateClusterCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateClusterCostResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CeaseClustersRequest proto.InternalMessageInfo
+var xxx_messageInfo_EstimateClusterCostResponse proto.InternalMessageInfo
 
-func (m *CeaseClustersRequest) GetClusterId() []string {
+func (m *EstimateClusterCostResponse) GetRuntimeId() *wrappers.StringValue {
 	if m != nil {
-		return m.ClusterId
+		return m.RuntimeId
 	}
 	return nil
 }
 
-func (m *CeaseClustersRequest) GetAdvancedParam() []string {
+func (m *EstimateClusterCostResponse) GetCurrency() *wrappers.StringValue {
 	if m != nil {
-		return m.AdvancedParam
+		return m.Currency
 	}
 	return nil
 }
 
-type CeaseClustersResponse struct {
+func (m *EstimateClusterCostResponse) GetRoleCostSet() []*RoleCost {
+	if m != nil {
+		return m.RoleCostSet
+	}
+	return nil
+}
+
+func (m *EstimateClusterCostResponse) GetHourly() float64 {
+	if m != nil {
+		return m.Hourly
+	}
+	return 0
+}
+
+func (m *EstimateClusterCostResponse) GetMonthly() float64 {
+	if m != nil {
+		return m.Monthly
+	}
+	return 0
+}
+
+type DescribeClusterCostsRequest struct {
 	ClusterId            []string `protobuf:"bytes,1,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	JobId                []string `protobuf:"bytes,2,rep,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CeaseClustersResponse) Reset()         { *m = CeaseClustersResponse{} }
-func (m *CeaseClustersResponse) String() string { return proto.CompactTextString(m) }
-func (*CeaseClustersResponse) ProtoMessage()    {}
-func (*CeaseClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{46}
+func (m *DescribeClusterCostsRequest) Reset()         { *m = DescribeClusterCostsRequest{} }
+func (m *DescribeClusterCostsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterCostsRequest) ProtoMessage()    {}
+func (*DescribeClusterCostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{59}
 }
-func (m *CeaseClustersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CeaseClustersResponse.Unmarshal(m, b)
+func (m *DescribeClusterCostsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterCostsRequest.Unmarshal(m, b)
 }
-func (m *CeaseClustersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CeaseClustersResponse.Marshal(b, m, deterministic)
+func (m *DescribeClusterCostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterCostsRequest.Marshal(b, m, deterministic)
 }
-func (dst *CeaseClustersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CeaseClustersResponse.Merge(dst, src)
+func (dst *DescribeClusterCostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterCostsRequest.Merge(dst, src)
 }
-func (m *CeaseClustersResponse) XXX_Size() int {
-	return xxx_messageInfo_CeaseClustersResponse.Size(m)
+func (m *DescribeClusterCostsRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterCostsRequest.Size(m)
 }
-func (m *CeaseClustersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CeaseClustersResponse.DiscardUnknown(m)
+func (m *DescribeClusterCostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterCostsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CeaseClustersResponse proto.InternalMessageInfo
+var xxx_messageInfo_DescribeClusterCostsRequest proto.InternalMessageInfo
 
-func (m *CeaseClustersResponse) GetClusterId() []string {
+func (m *DescribeClusterCostsRequest) GetClusterId() []string {
 	if m != nil {
 		return m.ClusterId
 	}
 	return nil
 }
 
-func (m *CeaseClustersResponse) GetJobId() []string {
-	if m != nil {
-		return m.JobId
-	}
-	return nil
-}
-
-type GetClusterStatisticsRequest struct {
+type ClusterCost struct {
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	RuntimeId *wrappers.StringValue `protobuf:"bytes,2,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Currency  *wrappers.StringValue `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// cost per hour of the nodes not deleted
+	RoleCostSet []*RoleCost `protobuf:"bytes,4,rep,name=role_cost_set,json=roleCostSet,proto3" json:"role_cost_set,omitempty"`
+	Hourly      float64     `protobuf:"fixed64,5,opt,name=hourly,proto3" json:"hourly,omitempty"`
+	// cost of all the nodes from their creation to now or their deletion
+	Accumulated          float64  `protobuf:"fixed64,6,opt,name=accumulated,proto3" json:"accumulated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetClusterStatisticsRequest) Reset()         { *m = GetClusterStatisticsRequest{} }
-func (m *GetClusterStatisticsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetClusterStatisticsRequest) ProtoMessage()    {}
-func (*GetClusterStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{47}
+func (m *ClusterCost) Reset()         { *m = ClusterCost{} }
+func (m *ClusterCost) String() string { return proto.CompactTextString(m) }
+func (*ClusterCost) ProtoMessage()    {}
+func (*ClusterCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{60}
 }
-func (m *GetClusterStatisticsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClusterStatisticsRequest.Unmarshal(m, b)
+func (m *ClusterCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterCost.Unmarshal(m, b)
 }
-func (m *GetClusterStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClusterStatisticsRequest.Marshal(b, m, deterministic)
+func (m *ClusterCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterCost.Marshal(b, m, deterministic)
 }
-func (dst *GetClusterStatisticsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterStatisticsRequest.Merge(dst, src)
+func (dst *ClusterCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCost.Merge(dst, src)
 }
-func (m *GetClusterStatisticsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetClusterStatisticsRequest.Size(m)
+func (m *ClusterCost) XXX_Size() int {
+	return xxx_messageInfo_ClusterCost.Size(m)
 }
-func (m *GetClusterStatisticsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterStatisticsRequest.DiscardUnknown(m)
+func (m *ClusterCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCost.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClusterStatisticsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ClusterCost proto.InternalMessageInfo
 
-type GetClusterStatisticsResponse struct {
-	LastTwoWeekCreated   map[string]uint32 `protobuf:"bytes,1,rep,name=last_two_week_created,json=lastTwoWeekCreated,proto3" json:"last_two_week_created,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	TopTenRuntimes       map[string]uint32 `protobuf:"bytes,2,rep,name=top_ten_runtimes,json=topTenRuntimes,proto3" json:"top_ten_runtimes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	ClusterCount         uint32            `protobuf:"varint,3,opt,name=cluster_count,json=clusterCount,proto3" json:"cluster_count,omitempty"`
-	RuntimeCount         uint32            `protobuf:"varint,4,opt,name=runtime_count,json=runtimeCount,proto3" json:"runtime_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ClusterCost) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
 }
 
-func (m *GetClusterStatisticsResponse) Reset()         { *m = GetClusterStatisticsResponse{} }
-func (m *GetClusterStatisticsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetClusterStatisticsResponse) ProtoMessage()    {}
-func (*GetClusterStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{48}
-}
-func (m *GetClusterStatisticsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClusterStatisticsResponse.Unmarshal(m, b)
-}
-func (m *GetClusterStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClusterStatisticsResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetClusterStatisticsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterStatisticsResponse.Merge(dst, src)
-}
-func (m *GetClusterStatisticsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetClusterStatisticsResponse.Size(m)
-}
-func (m *GetClusterStatisticsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterStatisticsResponse.DiscardUnknown(m)
+func (m *ClusterCost) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetClusterStatisticsResponse proto.InternalMessageInfo
-
-func (m *GetClusterStatisticsResponse) GetLastTwoWeekCreated() map[string]uint32 {
+func (m *ClusterCost) GetCurrency() *wrappers.StringValue {
 	if m != nil {
-		return m.LastTwoWeekCreated
+		return m.Currency
 	}
 	return nil
 }
 
-func (m *GetClusterStatisticsResponse) GetTopTenRuntimes() map[string]uint32 {
+func (m *ClusterCost) GetRoleCostSet() []*RoleCost {
 	if m != nil {
-		return m.TopTenRuntimes
+		return m.RoleCostSet
 	}
 	return nil
 }
 
-func (m *GetClusterStatisticsResponse) GetClusterCount() uint32 {
+func (m *ClusterCost) GetHourly() float64 {
 	if m != nil {
-		return m.ClusterCount
+		return m.Hourly
 	}
 	return 0
 }
 
-func (m *GetClusterStatisticsResponse) GetRuntimeCount() uint32 {
+func (m *ClusterCost) GetAccumulated() float64 {
 	if m != nil {
-		return m.RuntimeCount
+		return m.Accumulated
 	}
 	return 0
 }
 
+type DescribeClusterCostsResponse struct {
+	ClusterCostSet       []*ClusterCost `protobuf:"bytes,1,rep,name=cluster_cost_set,json=clusterCostSet,proto3" json:"cluster_cost_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DescribeClusterCostsResponse) Reset()         { *m = DescribeClusterCostsResponse{} }
+func (m *DescribeClusterCostsResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterCostsResponse) ProtoMessage()    {}
+func (*DescribeClusterCostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{61}
+}
+func (m *DescribeClusterCostsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterCostsResponse.Unmarshal(m, b)
+}
+func (m *DescribeClusterCostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterCostsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeClusterCostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterCostsResponse.Merge(dst, src)
+}
+func (m *DescribeClusterCostsResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterCostsResponse.Size(m)
+}
+func (m *DescribeClusterCostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterCostsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterCostsResponse proto.InternalMessageInfo
+
+func (m *DescribeClusterCostsResponse) GetClusterCostSet() []*ClusterCost {
+	if m != nil {
+		return m.ClusterCostSet
+	}
+	return nil
+}
+
 type KeyPair struct {
 	KeyPairId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=key_pair_id,json=keyPairId,proto3" json:"key_pair_id,omitempty"`
 	Name                 *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -3248,7 +4204,7 @@ func (m *KeyPair) Reset()         { *m = KeyPair{} }
 func (m *KeyPair) String() string { return proto.CompactTextString(m) }
 func (*KeyPair) ProtoMessage()    {}
 func (*KeyPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{49}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{62}
 }
 func (m *KeyPair) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyPair.Unmarshal(m, b)
@@ -3337,7 +4293,7 @@ func (m *CreateKeyPairRequest) Reset()         { *m = CreateKeyPairRequest{} }
 func (m *CreateKeyPairRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateKeyPairRequest) ProtoMessage()    {}
 func (*CreateKeyPairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{50}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{63}
 }
 func (m *CreateKeyPairRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateKeyPairRequest.Unmarshal(m, b)
@@ -3389,7 +4345,7 @@ func (m *CreateKeyPairResponse) Reset()         { *m = CreateKeyPairResponse{} }
 func (m *CreateKeyPairResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateKeyPairResponse) ProtoMessage()    {}
 func (*CreateKeyPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{51}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{64}
 }
 func (m *CreateKeyPairResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateKeyPairResponse.Unmarshal(m, b)
@@ -3434,7 +4390,7 @@ func (m *DescribeKeyPairsRequest) Reset()         { *m = DescribeKeyPairsRequest
 func (m *DescribeKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeKeyPairsRequest) ProtoMessage()    {}
 func (*DescribeKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{52}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{65}
 }
 func (m *DescribeKeyPairsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeKeyPairsRequest.Unmarshal(m, b)
@@ -3522,7 +4478,7 @@ func (m *DescribeKeyPairsResponse) Reset()         { *m = DescribeKeyPairsRespon
 func (m *DescribeKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeKeyPairsResponse) ProtoMessage()    {}
 func (*DescribeKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{53}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{66}
 }
 func (m *DescribeKeyPairsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeKeyPairsResponse.Unmarshal(m, b)
@@ -3567,7 +4523,7 @@ func (m *DeleteKeyPairsRequest) Reset()         { *m = DeleteKeyPairsRequest{} }
 func (m *DeleteKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteKeyPairsRequest) ProtoMessage()    {}
 func (*DeleteKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{54}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{67}
 }
 func (m *DeleteKeyPairsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteKeyPairsRequest.Unmarshal(m, b)
@@ -3605,7 +4561,7 @@ func (m *DeleteKeyPairsResponse) Reset()         { *m = DeleteKeyPairsResponse{}
 func (m *DeleteKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteKeyPairsResponse) ProtoMessage()    {}
 func (*DeleteKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{55}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{68}
 }
 func (m *DeleteKeyPairsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteKeyPairsResponse.Unmarshal(m, b)
@@ -3644,7 +4600,7 @@ func (m *AttachKeyPairsRequest) Reset()         { *m = AttachKeyPairsRequest{} }
 func (m *AttachKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*AttachKeyPairsRequest) ProtoMessage()    {}
 func (*AttachKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{56}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{69}
 }
 func (m *AttachKeyPairsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AttachKeyPairsRequest.Unmarshal(m, b)
@@ -3689,7 +4645,7 @@ func (m *AttachKeyPairsResponse) Reset()         { *m = AttachKeyPairsResponse{}
 func (m *AttachKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*AttachKeyPairsResponse) ProtoMessage()    {}
 func (*AttachKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{57}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{70}
 }
 func (m *AttachKeyPairsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AttachKeyPairsResponse.Unmarshal(m, b)
@@ -3728,7 +4684,7 @@ func (m *DetachKeyPairsRequest) Reset()         { *m = DetachKeyPairsRequest{} }
 func (m *DetachKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*DetachKeyPairsRequest) ProtoMessage()    {}
 func (*DetachKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{58}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{71}
 }
 func (m *DetachKeyPairsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DetachKeyPairsRequest.Unmarshal(m, b)
@@ -3773,7 +4729,7 @@ func (m *DetachKeyPairsResponse) Reset()         { *m = DetachKeyPairsResponse{}
 func (m *DetachKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetachKeyPairsResponse) ProtoMessage()    {}
 func (*DetachKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{59}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{72}
 }
 func (m *DetachKeyPairsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DetachKeyPairsResponse.Unmarshal(m, b)
@@ -3812,7 +4768,7 @@ func (m *NodeKeyPair) Reset()         { *m = NodeKeyPair{} }
 func (m *NodeKeyPair) String() string { return proto.CompactTextString(m) }
 func (*NodeKeyPair) ProtoMessage()    {}
 func (*NodeKeyPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{60}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{73}
 }
 func (m *NodeKeyPair) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeKeyPair.Unmarshal(m, b)
@@ -3857,7 +4813,7 @@ func (m *AddNodeKeyPairsRequest) Reset()         { *m = AddNodeKeyPairsRequest{}
 func (m *AddNodeKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddNodeKeyPairsRequest) ProtoMessage()    {}
 func (*AddNodeKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{61}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{74}
 }
 func (m *AddNodeKeyPairsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddNodeKeyPairsRequest.Unmarshal(m, b)
@@ -3894,7 +4850,7 @@ func (m *AddNodeKeyPairsResponse) Reset()         { *m = AddNodeKeyPairsResponse
 func (m *AddNodeKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*AddNodeKeyPairsResponse) ProtoMessage()    {}
 func (*AddNodeKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{62}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{75}
 }
 func (m *AddNodeKeyPairsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddNodeKeyPairsResponse.Unmarshal(m, b)
@@ -3925,7 +4881,7 @@ func (m *DeleteNodeKeyPairsRequest) Reset()         { *m = DeleteNodeKeyPairsReq
 func (m *DeleteNodeKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteNodeKeyPairsRequest) ProtoMessage()    {}
 func (*DeleteNodeKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{63}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{76}
 }
 func (m *DeleteNodeKeyPairsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteNodeKeyPairsRequest.Unmarshal(m, b)
@@ -3962,7 +4918,7 @@ func (m *DeleteNodeKeyPairsResponse) Reset()         { *m = DeleteNodeKeyPairsRe
 func (m *DeleteNodeKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteNodeKeyPairsResponse) ProtoMessage()    {}
 func (*DeleteNodeKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_9e7334410fdc5e66, []int{64}
+	return fileDescriptor_cluster_3ea543781b7353ca, []int{77}
 }
 func (m *DeleteNodeKeyPairsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteNodeKeyPairsResponse.Unmarshal(m, b)
@@ -4022,6 +4978,9 @@ func init() {
 	proto.RegisterType((*DescribeClustersResponse)(nil), "openpitrix.DescribeClustersResponse")
 	proto.RegisterType((*DescribeClusterNodesRequest)(nil), "openpitrix.DescribeClusterNodesRequest")
 	proto.RegisterType((*DescribeClusterNodesResponse)(nil), "openpitrix.DescribeClusterNodesResponse")
+	proto.RegisterType((*DescribeClusterNodeLogsRequest)(nil), "openpitrix.DescribeClusterNodeLogsRequest")
+	proto.RegisterType((*ClusterNodeLog)(nil), "openpitrix.ClusterNodeLog")
+	proto.RegisterType((*DescribeClusterNodeLogsResponse)(nil), "openpitrix.DescribeClusterNodeLogsResponse")
 	proto.RegisterType((*StopClustersRequest)(nil), "openpitrix.StopClustersRequest")
 	proto.RegisterType((*StopClustersResponse)(nil), "openpitrix.StopClustersResponse")
 	proto.RegisterType((*StartClustersRequest)(nil), "openpitrix.StartClustersRequest")
@@ -4034,6 +4993,16 @@ func init() {
 	proto.RegisterType((*GetClusterStatisticsResponse)(nil), "openpitrix.GetClusterStatisticsResponse")
 	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetClusterStatisticsResponse.LastTwoWeekCreatedEntry")
 	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetClusterStatisticsResponse.TopTenRuntimesEntry")
+	proto.RegisterType((*DescribeRuntimeUsageRequest)(nil), "openpitrix.DescribeRuntimeUsageRequest")
+	proto.RegisterType((*RuntimeUsage)(nil), "openpitrix.RuntimeUsage")
+	proto.RegisterType((*RuntimeQuota)(nil), "openpitrix.RuntimeQuota")
+	proto.RegisterType((*DescribeRuntimeUsageResponse)(nil), "openpitrix.DescribeRuntimeUsageResponse")
+	proto.RegisterType((*EstimateClusterCostRequest)(nil), "openpitrix.EstimateClusterCostRequest")
+	proto.RegisterType((*RoleCost)(nil), "openpitrix.RoleCost")
+	proto.RegisterType((*EstimateClusterCostResponse)(nil), "openpitrix.EstimateClusterCostResponse")
+	proto.RegisterType((*DescribeClusterCostsRequest)(nil), "openpitrix.DescribeClusterCostsRequest")
+	proto.RegisterType((*ClusterCost)(nil), "openpitrix.ClusterCost")
+	proto.RegisterType((*DescribeClusterCostsResponse)(nil), "openpitrix.DescribeClusterCostsResponse")
 	proto.RegisterType((*KeyPair)(nil), "openpitrix.KeyPair")
 	proto.RegisterType((*CreateKeyPairRequest)(nil), "openpitrix.CreateKeyPairRequest")
 	proto.RegisterType((*CreateKeyPairResponse)(nil), "openpitrix.CreateKeyPairResponse")
@@ -4088,11 +5057,15 @@ type ClusterManagerClient interface {
 	UpdateClusterEnv(ctx context.Context, in *UpdateClusterEnvRequest, opts ...grpc.CallOption) (*UpdateClusterEnvResponse, error)
 	DescribeClusters(ctx context.Context, in *DescribeClustersRequest, opts ...grpc.CallOption) (*DescribeClustersResponse, error)
 	DescribeClusterNodes(ctx context.Context, in *DescribeClusterNodesRequest, opts ...grpc.CallOption) (*DescribeClusterNodesResponse, error)
+	DescribeClusterNodeLogs(ctx context.Context, in *DescribeClusterNodeLogsRequest, opts ...grpc.CallOption) (*DescribeClusterNodeLogsResponse, error)
 	StopClusters(ctx context.Context, in *StopClustersRequest, opts ...grpc.CallOption) (*StopClustersResponse, error)
 	StartClusters(ctx context.Context, in *StartClustersRequest, opts ...grpc.CallOption) (*StartClustersResponse, error)
 	RecoverClusters(ctx context.Context, in *RecoverClustersRequest, opts ...grpc.CallOption) (*RecoverClustersResponse, error)
 	CeaseClusters(ctx context.Context, in *CeaseClustersRequest, opts ...grpc.CallOption) (*CeaseClustersResponse, error)
 	GetClusterStatistics(ctx context.Context, in *GetClusterStatisticsRequest, opts ...grpc.CallOption) (*GetClusterStatisticsResponse, error)
+	DescribeRuntimeUsage(ctx context.Context, in *DescribeRuntimeUsageRequest, opts ...grpc.CallOption) (*DescribeRuntimeUsageResponse, error)
+	EstimateClusterCost(ctx context.Context, in *EstimateClusterCostRequest, opts ...grpc.CallOption) (*EstimateClusterCostResponse, error)
+	DescribeClusterCosts(ctx context.Context, in *DescribeClusterCostsRequest, opts ...grpc.CallOption) (*DescribeClusterCostsResponse, error)
 }
 
 type clusterManagerClient struct {
@@ -4319,6 +5292,15 @@ func (c *clusterManagerClient) DescribeClusterNodes(ctx context.Context, in *Des
 	return out, nil
 }
 
+func (c *clusterManagerClient) DescribeClusterNodeLogs(ctx context.Context, in *DescribeClusterNodeLogsRequest, opts ...grpc.CallOption) (*DescribeClusterNodeLogsResponse, error) {
+	out := new(DescribeClusterNodeLogsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeClusterNodeLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerClient) StopClusters(ctx context.Context, in *StopClustersRequest, opts ...grpc.CallOption) (*StopClustersResponse, error) {
 	out := new(StopClustersResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/StopClusters", in, out, opts...)
@@ -4364,6 +5346,33 @@ func (c *clusterManagerClient) GetClusterStatistics(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *clusterManagerClient) DescribeRuntimeUsage(ctx context.Context, in *DescribeRuntimeUsageRequest, opts ...grpc.CallOption) (*DescribeRuntimeUsageResponse, error) {
+	out := new(DescribeRuntimeUsageResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeRuntimeUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) EstimateClusterCost(ctx context.Context, in *EstimateClusterCostRequest, opts ...grpc.CallOption) (*EstimateClusterCostResponse, error) {
+	out := new(EstimateClusterCostResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/EstimateClusterCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) DescribeClusterCosts(ctx context.Context, in *DescribeClusterCostsRequest, opts ...grpc.CallOption) (*DescribeClusterCostsResponse, error) {
+	out := new(DescribeClusterCostsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeClusterCosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterManagerServer is the server API for ClusterManager service.
 type ClusterManagerServer interface {
 	AddNodeKeyPairs(context.Context, *AddNodeKeyPairsRequest) (*AddNodeKeyPairsResponse, error)
@@ -4390,11 +5399,15 @@ type ClusterManagerServer interface {
 	UpdateClusterEnv(context.Context, *UpdateClusterEnvRequest) (*UpdateClusterEnvResponse, error)
 	DescribeClusters(context.Context, *DescribeClustersRequest) (*DescribeClustersResponse, error)
 	DescribeClusterNodes(context.Context, *DescribeClusterNodesRequest) (*DescribeClusterNodesResponse, error)
+	DescribeClusterNodeLogs(context.Context, *DescribeClusterNodeLogsRequest) (*DescribeClusterNodeLogsResponse, error)
 	StopClusters(context.Context, *StopClustersRequest) (*StopClustersResponse, error)
 	StartClusters(context.Context, *StartClustersRequest) (*StartClustersResponse, error)
 	RecoverClusters(context.Context, *RecoverClustersRequest) (*RecoverClustersResponse, error)
 	CeaseClusters(context.Context, *CeaseClustersRequest) (*CeaseClustersResponse, error)
 	GetClusterStatistics(context.Context, *GetClusterStatisticsRequest) (*GetClusterStatisticsResponse, error)
+	DescribeRuntimeUsage(context.Context, *DescribeRuntimeUsageRequest) (*DescribeRuntimeUsageResponse, error)
+	EstimateClusterCost(context.Context, *EstimateClusterCostRequest) (*EstimateClusterCostResponse, error)
+	DescribeClusterCosts(context.Context, *DescribeClusterCostsRequest) (*DescribeClusterCostsResponse, error)
 }
 
 func RegisterClusterManagerServer(s *grpc.Server, srv ClusterManagerServer) {
@@ -4833,6 +5846,24 @@ func _ClusterManager_DescribeClusterNodes_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterManager_DescribeClusterNodeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterNodeLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeClusterNodeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DescribeClusterNodeLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeClusterNodeLogs(ctx, req.(*DescribeClusterNodeLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterManager_StopClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopClustersRequest)
 	if err := dec(in); err != nil {
@@ -4923,6 +5954,60 @@ func _ClusterManager_GetClusterStatistics_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterManager_DescribeRuntimeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRuntimeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeRuntimeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DescribeRuntimeUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeRuntimeUsage(ctx, req.(*DescribeRuntimeUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_EstimateClusterCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateClusterCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).EstimateClusterCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/EstimateClusterCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).EstimateClusterCost(ctx, req.(*EstimateClusterCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_DescribeClusterCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeClusterCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DescribeClusterCosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeClusterCosts(ctx, req.(*DescribeClusterCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClusterManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.ClusterManager",
 	HandlerType: (*ClusterManagerServer)(nil),
@@ -5023,6 +6108,10 @@ var _ClusterManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeClusterNodes",
 			Handler:    _ClusterManager_DescribeClusterNodes_Handler,
 		},
+		{
+			MethodName: "DescribeClusterNodeLogs",
+			Handler:    _ClusterManager_DescribeClusterNodeLogs_Handler,
+		},
 		{
 			MethodName: "StopClusters",
 			Handler:    _ClusterManager_StopClusters_Handler,
@@ -5043,261 +6132,333 @@ var _ClusterManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetClusterStatistics",
 			Handler:    _ClusterManager_GetClusterStatistics_Handler,
 		},
+		{
+			MethodName: "DescribeRuntimeUsage",
+			Handler:    _ClusterManager_DescribeRuntimeUsage_Handler,
+		},
+		{
+			MethodName: "EstimateClusterCost",
+			Handler:    _ClusterManager_EstimateClusterCost_Handler,
+		},
+		{
+			MethodName: "DescribeClusterCosts",
+			Handler:    _ClusterManager_DescribeClusterCosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cluster.proto",
 }
 
-func init() { proto.RegisterFile("cluster.proto", fileDescriptor_cluster_9e7334410fdc5e66) }
-
-var fileDescriptor_cluster_9e7334410fdc5e66 = []byte{
-	// 3965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xdd, 0x6f, 0x24, 0x49,
-	0x52, 0x57, 0xb9, 0xfd, 0x19, 0xfd, 0x61, 0x3b, 0xdd, 0x6e, 0xb7, 0xdb, 0xde, 0x19, 0x6f, 0xcd,
-	0xee, 0x31, 0x5a, 0x58, 0x7b, 0x77, 0x66, 0xf7, 0xf6, 0x76, 0x66, 0x87, 0xbd, 0x5e, 0xef, 0xb0,
-	0xb4, 0x6e, 0xe6, 0x66, 0xd4, 0xf6, 0xec, 0xc2, 0xb1, 0x77, 0x75, 0xe5, 0xae, 0x74, 0xbb, 0xce,
-	0xdd, 0x95, 0x75, 0x55, 0xd9, 0x9e, 0xeb, 0x11, 0x2f, 0x2c, 0x12, 0xa7, 0x63, 0xef, 0xf8, 0x98,
-	0x03, 0x09, 0xf1, 0x80, 0x00, 0x89, 0x47, 0x24, 0xe0, 0x05, 0x78, 0x42, 0xe2, 0x05, 0x89, 0x97,
-	0x43, 0xe2, 0x5f, 0xe0, 0x8d, 0x7f, 0x00, 0x09, 0x81, 0x50, 0x7e, 0x54, 0x75, 0x65, 0x75, 0x75,
-	0x3b, 0xdb, 0xed, 0x19, 0x83, 0xc4, 0x93, 0xdd, 0x95, 0x11, 0x91, 0xbf, 0x8a, 0x88, 0x8c, 0x88,
-	0xca, 0xc8, 0x84, 0x62, 0xab, 0xd3, 0x0b, 0x29, 0x0e, 0x76, 0xfd, 0x80, 0x50, 0x82, 0x80, 0xf8,
-	0xd8, 0xf3, 0x5d, 0x1a, 0xb8, 0x3f, 0xa8, 0x6d, 0xb5, 0x09, 0x69, 0x77, 0xf0, 0x1e, 0x1f, 0x39,
-	0xea, 0x1d, 0xef, 0xe1, 0xae, 0x4f, 0xfb, 0x82, 0xb0, 0x76, 0x2d, 0x3d, 0xf8, 0x34, 0xb0, 0x7d,
-	0x1f, 0x07, 0xa1, 0x1c, 0xbf, 0x9e, 0x1e, 0xa7, 0x6e, 0x17, 0x87, 0xd4, 0xee, 0xfa, 0x92, 0x60,
-	0x5b, 0x12, 0xd8, 0xbe, 0xbb, 0x67, 0x7b, 0x1e, 0xa1, 0x36, 0x75, 0x89, 0x17, 0xb1, 0xff, 0x02,
-	0xff, 0xd3, 0x7a, 0xb3, 0x8d, 0xbd, 0x37, 0xc3, 0xa7, 0x76, 0xbb, 0x8d, 0x83, 0x3d, 0xe2, 0x73,
-	0x8a, 0x61, 0x6a, 0xf3, 0x8f, 0x67, 0xa0, 0xf2, 0x31, 0x0e, 0x5b, 0x81, 0x7b, 0x84, 0x0f, 0x7a,
-	0x47, 0x1e, 0xa6, 0x61, 0x13, 0x7f, 0xbf, 0x87, 0x43, 0x8a, 0xee, 0x02, 0x04, 0x3d, 0x8f, 0x4d,
-	0x6e, 0xb9, 0x4e, 0xd5, 0xd8, 0x31, 0x6e, 0xe6, 0x6f, 0x6d, 0xef, 0x8a, 0xb9, 0x77, 0x23, 0x70,
-	0xbb, 0x07, 0x34, 0x70, 0xbd, 0xf6, 0xa7, 0x76, 0xa7, 0x87, 0x9b, 0x4b, 0x92, 0xbe, 0xe1, 0xa0,
-	0x32, 0xcc, 0x75, 0xdc, 0xae, 0x4b, 0xab, 0x33, 0x3b, 0xc6, 0xcd, 0x62, 0x53, 0xfc, 0x40, 0x15,
-	0x98, 0x27, 0xc7, 0xc7, 0x21, 0xa6, 0xd5, 0x1c, 0x7f, 0x2c, 0x7f, 0xa1, 0x7b, 0x90, 0x0f, 0xf9,
-	0xe4, 0x16, 0xed, 0xfb, 0xb8, 0x3a, 0x3b, 0x62, 0xae, 0x27, 0x0d, 0x8f, 0xde, 0xbe, 0x25, 0xe6,
-	0x02, 0xc1, 0x70, 0xd8, 0xf7, 0x31, 0xda, 0x82, 0x25, 0xc9, 0xee, 0x3a, 0xd5, 0xb9, 0x9d, 0xdc,
-	0xcd, 0xa5, 0xe6, 0xa2, 0x78, 0xd0, 0x70, 0x10, 0x82, 0xd9, 0x67, 0xc4, 0xc3, 0xd5, 0x79, 0xfe,
-	0x9c, 0xff, 0x8f, 0x5e, 0x87, 0x92, 0xed, 0x9c, 0xd9, 0x5e, 0x0b, 0x3b, 0x96, 0x6f, 0x07, 0x76,
-	0xb7, 0xba, 0xc0, 0x47, 0x8b, 0xd1, 0xd3, 0xc7, 0xec, 0xa1, 0xf9, 0xf7, 0x39, 0x98, 0x17, 0x4a,
-	0x41, 0xef, 0x27, 0xa7, 0xd0, 0xd1, 0xc5, 0x00, 0xc0, 0x5b, 0x30, 0xeb, 0xd9, 0x5d, 0xcc, 0x35,
-	0x71, 0x1e, 0x17, 0xa7, 0x64, 0x1c, 0x1c, 0x72, 0x4e, 0x87, 0x83, 0xbf, 0xd0, 0x5d, 0xc8, 0xb7,
-	0x02, 0x6c, 0x53, 0x6c, 0x31, 0xfd, 0x4b, 0x05, 0xd6, 0x86, 0x18, 0x0f, 0x23, 0x4f, 0x6a, 0x82,
-	0x20, 0x67, 0x0f, 0xd0, 0x2f, 0x42, 0xde, 0xe1, 0x2e, 0xc0, 0xbd, 0xa4, 0x3a, 0xa7, 0x31, 0x6b,
-	0x92, 0x01, 0x5d, 0x87, 0xbc, 0xeb, 0x85, 0x94, 0x29, 0x8e, 0x69, 0x47, 0x28, 0x1a, 0xa2, 0x47,
-	0x0d, 0x07, 0xdd, 0x86, 0xf9, 0x33, 0xbf, 0xc5, 0xc6, 0x16, 0x34, 0x64, 0xcf, 0x9d, 0xf9, 0xad,
-	0x86, 0x93, 0xf6, 0x89, 0xc5, 0xc9, 0x7c, 0xc2, 0xec, 0xc2, 0xc6, 0x90, 0x5f, 0x87, 0x3e, 0xf1,
-	0x42, 0xcc, 0xf0, 0x52, 0x42, 0xed, 0x8e, 0xd5, 0x22, 0x3d, 0x8f, 0x72, 0x6b, 0x16, 0x9b, 0xc0,
-	0x1f, 0xed, 0xb3, 0x27, 0xe8, 0x6d, 0x90, 0x92, 0x2c, 0xe6, 0xaa, 0x33, 0x3b, 0xb9, 0x9b, 0xf9,
-	0x5b, 0x68, 0x77, 0xb0, 0xbe, 0x77, 0x85, 0xc4, 0xa6, 0x74, 0x89, 0x03, 0x4c, 0xcd, 0x3f, 0x99,
-	0x81, 0xf2, 0x3e, 0x57, 0xe9, 0xbe, 0x88, 0x0a, 0xd1, 0x2a, 0xba, 0x0d, 0xf3, 0xb6, 0xef, 0xeb,
-	0x7a, 0xcd, 0x9c, 0xed, 0xfb, 0x0d, 0x87, 0x2d, 0xbd, 0x33, 0x1c, 0x84, 0x2e, 0xf1, 0x18, 0xa3,
-	0x8e, 0xe3, 0x2c, 0x49, 0x7a, 0xc1, 0x9c, 0x58, 0xb7, 0xb9, 0xc9, 0xd6, 0xed, 0x5b, 0x30, 0xdb,
-	0x22, 0xde, 0xf1, 0xc8, 0x25, 0xa8, 0xb8, 0x1e, 0xa3, 0xcc, 0x58, 0x4b, 0x73, 0x59, 0x6b, 0xe9,
-	0x47, 0x06, 0xac, 0xa7, 0x14, 0x24, 0xcd, 0x71, 0x17, 0x40, 0x46, 0x52, 0xed, 0x38, 0x23, 0xe9,
-	0x85, 0x6b, 0x7d, 0x8f, 0x1c, 0xe9, 0x6a, 0x69, 0xee, 0x7b, 0xe4, 0xa8, 0xe1, 0x98, 0x7f, 0x93,
-	0x83, 0xf2, 0x43, 0xe2, 0xb8, 0xc7, 0xfd, 0x94, 0xb1, 0xde, 0x84, 0x05, 0x29, 0x5a, 0xe2, 0x58,
-	0x4b, 0x5a, 0x3d, 0x22, 0x8e, 0x68, 0x50, 0x1d, 0x56, 0x22, 0xe4, 0x1e, 0x71, 0x70, 0xc2, 0x5b,
-	0x36, 0x32, 0xf8, 0xbe, 0x49, 0x1c, 0xdc, 0x2c, 0xb5, 0x06, 0x3f, 0x0e, 0x30, 0x4d, 0x8a, 0x08,
-	0x48, 0x47, 0x88, 0xc8, 0x8d, 0x14, 0xd1, 0x24, 0x9d, 0x81, 0x08, 0xf6, 0x23, 0x25, 0xa2, 0xe3,
-	0x7a, 0xa7, 0x5c, 0xc4, 0xec, 0x48, 0x11, 0x0f, 0x5c, 0xef, 0x34, 0x16, 0xc1, 0x7e, 0x30, 0x11,
-	0x9f, 0x00, 0x8a, 0x44, 0xb4, 0x48, 0xb7, 0x4b, 0x3c, 0x2e, 0x64, 0x8e, 0x0b, 0xd9, 0xcc, 0x10,
-	0xb2, 0xcf, 0x89, 0x9a, 0xd1, 0xbc, 0xe2, 0x27, 0x13, 0xf4, 0xab, 0x50, 0x8d, 0xb1, 0x10, 0xdb,
-	0x39, 0xb2, 0x3b, 0xcc, 0x05, 0x02, 0x2e, 0x6e, 0x9e, 0x8b, 0xbb, 0x9e, 0x85, 0x29, 0x41, 0xda,
-	0xac, 0xb4, 0x86, 0x1f, 0xb2, 0x15, 0x76, 0x08, 0xeb, 0x29, 0x9b, 0x5d, 0x82, 0xff, 0x98, 0x9f,
-	0x42, 0x55, 0x91, 0xca, 0x8d, 0x24, 0xbd, 0xe1, 0x0e, 0x14, 0x92, 0xe6, 0x95, 0xa2, 0x47, 0x9a,
-	0x36, 0x9f, 0x30, 0xad, 0xd9, 0x84, 0xcd, 0x0c, 0xb9, 0x12, 0xf1, 0xbb, 0xb0, 0xc0, 0xfd, 0x45,
-	0x13, 0xee, 0x3c, 0x23, 0x6e, 0x38, 0xe6, 0xcf, 0x0c, 0xb8, 0xa6, 0x08, 0xad, 0x53, 0x1a, 0xb8,
-	0x47, 0x3d, 0x8a, 0x93, 0x39, 0xfb, 0xe2, 0x6b, 0x69, 0xf2, 0x44, 0x95, 0xca, 0x1c, 0xb9, 0x09,
-	0x33, 0x87, 0xf9, 0x1d, 0xb8, 0x3e, 0xf2, 0x85, 0x2e, 0xc3, 0xba, 0x3f, 0x31, 0xc0, 0x1c, 0x32,
-	0xc3, 0xb0, 0xd6, 0x2e, 0x66, 0x8f, 0xc9, 0xf5, 0x65, 0x7e, 0x0e, 0x37, 0xc6, 0xc2, 0x99, 0xce,
-	0x3f, 0xbe, 0x0b, 0x5b, 0x75, 0xc7, 0x39, 0xb4, 0x8f, 0x3a, 0x38, 0x21, 0x3f, 0x7e, 0xcb, 0xac,
-	0x68, 0x65, 0x4c, 0x14, 0xad, 0xcc, 0xf7, 0xe1, 0xda, 0xc7, 0xb8, 0x83, 0x29, 0x1e, 0x39, 0xc9,
-	0x46, 0x12, 0x3a, 0x4b, 0x03, 0x11, 0xb8, 0x6f, 0xc3, 0xba, 0x60, 0x95, 0x5c, 0x31, 0xc7, 0x2b,
-	0x29, 0x03, 0x33, 0xa6, 0x84, 0x53, 0x0e, 0xa7, 0x97, 0x99, 0xac, 0xf4, 0xf2, 0x4d, 0x56, 0xc6,
-	0xaa, 0xe2, 0xa5, 0x32, 0xcf, 0x91, 0xbf, 0x9e, 0x48, 0x20, 0x6c, 0x48, 0xa6, 0x88, 0xbf, 0x33,
-	0x60, 0xfd, 0x89, 0xdf, 0x0e, 0x6c, 0x27, 0x9d, 0xd0, 0xa7, 0x5a, 0x62, 0x53, 0x25, 0xf6, 0x61,
-	0x55, 0xe4, 0xb2, 0x54, 0xf1, 0xdb, 0x06, 0x54, 0xd2, 0xd0, 0xaf, 0x2c, 0xd5, 0xfe, 0x3a, 0x54,
-	0x9a, 0xa4, 0xd3, 0x39, 0xb2, 0x5b, 0xa7, 0x97, 0xa9, 0x47, 0x4d, 0xaf, 0xf8, 0xd2, 0x80, 0x8d,
-	0xa1, 0xe9, 0xaf, 0x4c, 0x17, 0xcf, 0x67, 0xa0, 0xdc, 0xc4, 0xa1, 0xfb, 0xec, 0x52, 0x5d, 0xea,
-	0x2d, 0x98, 0x65, 0x95, 0x83, 0x5e, 0x14, 0x62, 0x94, 0x68, 0x17, 0x72, 0x2d, 0xbf, 0x37, 0x32,
-	0x5a, 0x27, 0x2b, 0x6a, 0x46, 0x88, 0xde, 0x81, 0xf9, 0x2e, 0xee, 0x92, 0xa0, 0xaf, 0xf5, 0x61,
-	0x26, 0x69, 0x27, 0xa9, 0x0b, 0x53, 0x4a, 0xb9, 0x32, 0x03, 0xfd, 0xbb, 0x01, 0x95, 0xba, 0xe3,
-	0x64, 0xc5, 0xb5, 0x97, 0x6c, 0xa2, 0xbb, 0x00, 0x3c, 0x8c, 0x8a, 0x2f, 0x14, 0x1d, 0x4b, 0x2d,
-	0x31, 0x7a, 0xf1, 0xf9, 0x32, 0xac, 0xf9, 0xd9, 0x51, 0x8b, 0x63, 0xe8, 0x6d, 0xaf, 0x4c, 0xf7,
-	0xff, 0x6c, 0xc0, 0xa6, 0x12, 0xc1, 0xaf, 0x52, 0xfd, 0x89, 0x2c, 0x96, 0x4b, 0x66, 0x31, 0x5d,
-	0xd5, 0xfe, 0x8e, 0x01, 0xb5, 0xac, 0x97, 0xb9, 0x32, 0xed, 0xfe, 0xa5, 0x01, 0x1b, 0x4f, 0x7c,
-	0x67, 0xf0, 0xf5, 0x75, 0xdf, 0x3b, 0xbb, 0x14, 0xdd, 0xee, 0x42, 0x0e, 0x7b, 0x67, 0x5a, 0x50,
-	0x18, 0xa1, 0x6e, 0x0e, 0xfb, 0xb1, 0x01, 0xd5, 0x61, 0xbc, 0x57, 0xa6, 0xbe, 0xbf, 0x2e, 0x41,
-	0x51, 0xf9, 0xf4, 0x79, 0xd9, 0x0e, 0xf9, 0x08, 0xd6, 0x43, 0x1c, 0x9c, 0xf1, 0xd9, 0xac, 0x9e,
-	0xef, 0xe3, 0xc0, 0x3a, 0x22, 0x3d, 0xcf, 0xd1, 0x0a, 0x0d, 0x48, 0xb0, 0x36, 0x9c, 0x27, 0x8c,
-	0xf1, 0x23, 0xc6, 0x87, 0x3e, 0x81, 0x95, 0xd8, 0x0e, 0x76, 0x8b, 0xef, 0x08, 0x6a, 0x7d, 0xf3,
-	0x2f, 0x47, 0x5c, 0x75, 0xc1, 0x84, 0x3e, 0x84, 0x82, 0xeb, 0xb9, 0xd4, 0x62, 0x73, 0xb8, 0x2d,
-	0xac, 0xb7, 0x7b, 0xc4, 0x38, 0x0e, 0x04, 0x03, 0xaa, 0x43, 0x31, 0xa4, 0x76, 0x30, 0x90, 0x30,
-	0xaf, 0x21, 0xa1, 0xc0, 0x59, 0x22, 0x11, 0x1f, 0x42, 0x21, 0xa4, 0xc4, 0x8f, 0x25, 0xe8, 0xec,
-	0x32, 0xe5, 0x19, 0x47, 0x24, 0xe0, 0x97, 0x61, 0x35, 0x6c, 0xd9, 0x1d, 0x6c, 0x91, 0xde, 0x00,
-	0xc7, 0xa2, 0x8e, 0x3a, 0x38, 0xdb, 0xa3, 0x5e, 0x0c, 0xe5, 0x97, 0x60, 0x45, 0x48, 0x72, 0xbd,
-	0x58, 0xd0, 0x92, 0x86, 0xa0, 0x12, 0xe7, 0x6a, 0x78, 0x91, 0x9c, 0xfb, 0xb0, 0x1c, 0x60, 0x55,
-	0x2f, 0xa0, 0x23, 0x46, 0x32, 0x25, 0xc4, 0x38, 0x38, 0xa4, 0x01, 0xe9, 0xc7, 0x62, 0xf2, 0x3a,
-	0x62, 0x24, 0x53, 0x42, 0x4c, 0x4f, 0x54, 0x94, 0xb1, 0x98, 0x82, 0x8e, 0x18, 0xc9, 0x14, 0x89,
-	0xd9, 0x87, 0x52, 0xab, 0x17, 0x52, 0xd2, 0x8d, 0xa5, 0x14, 0x35, 0xa4, 0x14, 0x05, 0x4f, 0x42,
-	0x08, 0x2b, 0xe7, 0x7a, 0x03, 0x73, 0x97, 0x74, 0x84, 0x08, 0x9e, 0x94, 0x7a, 0x49, 0x30, 0x78,
-	0xa1, 0x65, 0x5d, 0xf5, 0x92, 0x20, 0x7e, 0xa1, 0x43, 0xd8, 0x70, 0x78, 0x98, 0xb7, 0x42, 0xcf,
-	0xf6, 0xc3, 0x13, 0x32, 0xb0, 0xd6, 0x8a, 0x86, 0xb8, 0x75, 0xc1, 0x7c, 0x20, 0x79, 0x13, 0xee,
-	0x7c, 0x82, 0xed, 0x0e, 0x3d, 0xb1, 0x5a, 0x27, 0xb8, 0x75, 0x5a, 0x5d, 0xd5, 0x71, 0x67, 0xc1,
-	0xb1, 0xcf, 0x18, 0xd0, 0x57, 0x61, 0xa1, 0x4b, 0x3c, 0x97, 0x92, 0xa0, 0x8a, 0x34, 0x78, 0x23,
-	0x62, 0xf4, 0x31, 0x94, 0x7c, 0x3b, 0x0c, 0xfd, 0x93, 0xc0, 0x0e, 0x71, 0x07, 0x87, 0x61, 0x75,
-	0x4d, 0x47, 0x29, 0x2a, 0x0f, 0x53, 0xca, 0x19, 0x0e, 0xa8, 0xdb, 0xb2, 0x3b, 0x16, 0xf3, 0x6a,
-	0xd7, 0x6b, 0x5b, 0x3e, 0xe9, 0xb8, 0xad, 0x7e, 0xb5, 0xac, 0xa3, 0x94, 0x88, 0xf9, 0x40, 0xf0,
-	0x3e, 0xe6, 0xac, 0x68, 0x1f, 0x96, 0xed, 0x36, 0xf6, 0xa8, 0xc5, 0xf7, 0x95, 0x3b, 0x1d, 0xec,
-	0x54, 0xd7, 0x47, 0xec, 0x72, 0x7f, 0x44, 0x48, 0x47, 0x42, 0xe3, 0x2c, 0x8d, 0x88, 0x03, 0x35,
-	0xa1, 0x22, 0x1d, 0xb0, 0x8b, 0xa9, 0xed, 0xd8, 0xd4, 0xb6, 0xc4, 0x66, 0x44, 0xb5, 0xa2, 0x81,
-	0xac, 0x2c, 0x78, 0x1f, 0x4a, 0xd6, 0x03, 0xce, 0x89, 0xde, 0x83, 0x45, 0xb7, 0x6b, 0xb7, 0x79,
-	0xb1, 0xb0, 0xa1, 0xa3, 0x6d, 0x4e, 0xdd, 0x70, 0x58, 0xe0, 0x93, 0x8e, 0x2c, 0xb5, 0x53, 0xd5,
-	0x09, 0x7c, 0x82, 0x45, 0x2a, 0xe5, 0x73, 0xd8, 0x76, 0xbd, 0x56, 0x80, 0xbb, 0xd8, 0xa3, 0x76,
-	0xc7, 0x8a, 0xd6, 0x45, 0xcf, 0xf7, 0x49, 0x40, 0xb1, 0x53, 0xdd, 0x3c, 0x57, 0x43, 0xb5, 0x04,
-	0xff, 0x47, 0x62, 0x89, 0x44, 0xdc, 0xe8, 0x03, 0x80, 0x93, 0xbe, 0xcf, 0x9c, 0x32, 0x24, 0x41,
-	0xb5, 0xa6, 0x81, 0x2e, 0x41, 0x6f, 0xfe, 0x57, 0x1e, 0xf2, 0x89, 0xea, 0xe7, 0xa2, 0x9b, 0x2c,
-	0x6a, 0xa2, 0x9d, 0xb9, 0xd8, 0x8e, 0x56, 0x4e, 0x7b, 0x47, 0xeb, 0x9e, 0xda, 0xcb, 0xd0, 0x49,
-	0x89, 0xc9, 0x4e, 0xc7, 0xfb, 0xb0, 0x74, 0x46, 0x3a, 0x3d, 0xb1, 0xf5, 0xae, 0x93, 0x0a, 0x17,
-	0x05, 0x79, 0xc3, 0x61, 0x5f, 0x59, 0x0e, 0xd6, 0x4e, 0x80, 0x92, 0x56, 0xed, 0x4b, 0x2d, 0x4c,
-	0xd4, 0x97, 0xba, 0x0b, 0xe0, 0x07, 0xee, 0x99, 0x4d, 0xb1, 0xe5, 0xfa, 0x5a, 0xd9, 0x6e, 0x49,
-	0xd2, 0x37, 0x7c, 0x5e, 0xf7, 0xb9, 0xbe, 0x56, 0x6a, 0x63, 0x84, 0x1c, 0x67, 0x54, 0xc0, 0x8c,
-	0xcc, 0x64, 0xc9, 0xa2, 0x65, 0x31, 0x2a, 0x5a, 0xe2, 0x6a, 0x29, 0xaf, 0x5d, 0x2d, 0xbd, 0x03,
-	0xf3, 0x21, 0xb5, 0x69, 0x2f, 0xd4, 0xca, 0x52, 0x92, 0x16, 0x35, 0x60, 0x95, 0x06, 0xb6, 0x17,
-	0xba, 0xac, 0xb0, 0xb1, 0xa4, 0x00, 0x9d, 0x04, 0xb5, 0x32, 0x60, 0x3b, 0x10, 0xa2, 0xde, 0x83,
-	0xc5, 0x76, 0x40, 0x7a, 0xbc, 0xed, 0x53, 0xd2, 0x78, 0xd9, 0x05, 0x4e, 0xdd, 0x70, 0xd0, 0x2d,
-	0x98, 0x23, 0x4f, 0x3d, 0x1c, 0x68, 0x65, 0x23, 0x41, 0xca, 0x4a, 0x8e, 0x76, 0x87, 0x1c, 0xb1,
-	0x68, 0x1b, 0x6b, 0x78, 0x45, 0x63, 0xd2, 0x92, 0xe0, 0x3a, 0x88, 0xf4, 0x7c, 0x1f, 0x96, 0x53,
-	0xc1, 0x51, 0x2b, 0xf3, 0x94, 0xd4, 0xa8, 0xc8, 0xd6, 0xb9, 0xdf, 0x3b, 0xb2, 0x4e, 0x71, 0x5f,
-	0x2b, 0xf9, 0xcc, 0xfb, 0xbd, 0xa3, 0x6f, 0xe0, 0x3e, 0x8b, 0x86, 0x32, 0xe9, 0x49, 0xcd, 0xeb,
-	0xa4, 0x1e, 0x99, 0x27, 0x63, 0xad, 0x2f, 0xb9, 0xa1, 0x0c, 0x82, 0x32, 0xd5, 0x8c, 0x0b, 0x7d,
-	0x8b, 0x6e, 0x28, 0x22, 0x1e, 0xba, 0x0b, 0x79, 0xbb, 0x47, 0x49, 0xc4, 0x7a, 0x7e, 0x5e, 0x01,
-	0x46, 0x3e, 0x60, 0x4e, 0xb6, 0x5e, 0x2b, 0x13, 0xb5, 0x5e, 0xef, 0x42, 0x5e, 0xbc, 0xae, 0x60,
-	0xde, 0x38, 0x9f, 0x59, 0x90, 0x73, 0xe6, 0x44, 0x7f, 0x82, 0x2f, 0x90, 0xea, 0xc8, 0xfe, 0x04,
-	0xef, 0x1b, 0xe5, 0x13, 0x7d, 0x23, 0xf4, 0x75, 0x28, 0xa9, 0x1d, 0x1f, 0x99, 0x2b, 0xc6, 0x74,
-	0x7b, 0x8a, 0x4a, 0xb7, 0x07, 0x5d, 0x83, 0xfc, 0x29, 0xee, 0x5b, 0xbe, 0xed, 0x72, 0x8f, 0xab,
-	0x89, 0x8d, 0xd5, 0x53, 0xdc, 0x7f, 0x6c, 0xbb, 0x41, 0xc3, 0x31, 0x7f, 0x38, 0x17, 0xc7, 0xff,
-	0xa6, 0xdc, 0xd2, 0xf8, 0xdf, 0xbc, 0xc9, 0xb5, 0x0b, 0xb9, 0xb6, 0xdf, 0xd3, 0xda, 0xe1, 0x62,
-	0x84, 0x89, 0x4d, 0xb1, 0xb9, 0x09, 0x36, 0xc5, 0xea, 0x50, 0x8c, 0xd3, 0x4b, 0xe8, 0x3e, 0x1b,
-	0x1d, 0xeb, 0x93, 0xcc, 0x85, 0x88, 0xe5, 0xc0, 0x7d, 0x16, 0x7d, 0xec, 0x04, 0xac, 0xe2, 0xe0,
-	0x12, 0x16, 0x34, 0x24, 0xe4, 0x25, 0x07, 0x17, 0x70, 0x0f, 0xf2, 0x5d, 0xd2, 0xf3, 0xa8, 0xe5,
-	0x13, 0xd7, 0xa3, 0x5a, 0x81, 0x1f, 0x38, 0xc3, 0x63, 0x46, 0xcf, 0x5e, 0x41, 0xb0, 0xcb, 0x43,
-	0x25, 0x5a, 0x39, 0xa0, 0xc0, 0x59, 0x1e, 0x09, 0x0e, 0x86, 0xe0, 0xd8, 0xed, 0x60, 0x2b, 0xec,
-	0x87, 0x14, 0x77, 0xb5, 0x3e, 0x6c, 0x80, 0x31, 0x1c, 0x70, 0xfa, 0x68, 0xcf, 0x21, 0xaf, 0xb9,
-	0xe7, 0x60, 0xfe, 0xe7, 0x0c, 0xac, 0x65, 0x74, 0x1a, 0x5f, 0xb6, 0x47, 0x7e, 0x0a, 0x55, 0xa5,
-	0x27, 0xda, 0x71, 0x43, 0x8a, 0x3d, 0x31, 0xb9, 0x4e, 0x81, 0x52, 0x49, 0x72, 0x3f, 0x90, 0xcc,
-	0x0d, 0x87, 0xe5, 0x2d, 0x45, 0x2e, 0xab, 0xde, 0xb4, 0xfc, 0x78, 0x25, 0xc9, 0xf6, 0x98, 0x04,
-	0x94, 0xd5, 0xc7, 0x29, 0x51, 0xac, 0xcc, 0xd4, 0xad, 0x65, 0xca, 0xaa, 0x3c, 0xc6, 0xda, 0x70,
-	0xcc, 0xff, 0x36, 0xe2, 0x38, 0xf0, 0xc0, 0xf5, 0x4e, 0x5f, 0x76, 0x8b, 0xf2, 0x01, 0xac, 0xe1,
-	0x1f, 0x50, 0x1c, 0x78, 0x76, 0xc7, 0x4a, 0xcc, 0xab, 0xa3, 0xf0, 0xd5, 0x88, 0x71, 0x3f, 0x9e,
-	0x3f, 0xce, 0xcf, 0xb3, 0xda, 0xf9, 0xd9, 0xfc, 0xc7, 0x02, 0x2c, 0x48, 0x09, 0xff, 0xc7, 0xfa,
-	0xb3, 0x89, 0xc3, 0x2b, 0xb3, 0x17, 0x3d, 0xbc, 0x32, 0x37, 0x59, 0x8f, 0x4b, 0xa9, 0x67, 0xe7,
-	0x27, 0xaa, 0x67, 0x2f, 0x74, 0xca, 0xe8, 0x43, 0x28, 0x1c, 0x07, 0xc4, 0xa3, 0x6d, 0x5e, 0x06,
-	0x3b, 0x5a, 0xd1, 0x30, 0x1f, 0x73, 0x08, 0x01, 0x91, 0x45, 0xf9, 0x39, 0xa5, 0x25, 0x9d, 0x70,
-	0x2c, 0x39, 0xf8, 0xe1, 0xb5, 0x3b, 0xb0, 0x84, 0x3d, 0x87, 0xc7, 0xe2, 0x50, 0x2b, 0x14, 0x0e,
-	0xc8, 0x13, 0x85, 0x6e, 0x7e, 0xda, 0x42, 0xb7, 0x70, 0xa1, 0x42, 0xf7, 0x01, 0x94, 0xe3, 0x2f,
-	0xe9, 0x80, 0x10, 0x6a, 0xd9, 0xad, 0x16, 0x0e, 0xa3, 0xb2, 0x79, 0x5c, 0x09, 0x85, 0x22, 0xbe,
-	0x26, 0x21, 0xb4, 0xce, 0xb9, 0x06, 0xab, 0xab, 0xa4, 0x5f, 0xfd, 0xde, 0x83, 0xbc, 0xac, 0x7e,
-	0x7b, 0x3d, 0xd7, 0xd1, 0xaa, 0x9b, 0x41, 0x30, 0x3c, 0xe9, 0xb9, 0x0e, 0xda, 0x87, 0x52, 0xbc,
-	0xb3, 0x25, 0x14, 0xa1, 0xb3, 0x71, 0x53, 0x8c, 0x36, 0xb6, 0x84, 0x16, 0xee, 0x41, 0x21, 0x12,
-	0xc2, 0xcb, 0xb8, 0xd5, 0x73, 0xcb, 0xb8, 0xbc, 0xa4, 0x97, 0x45, 0x60, 0xf2, 0xc0, 0x16, 0x9a,
-	0xec, 0xc0, 0x56, 0xaa, 0xfc, 0x5c, 0x9b, 0xa6, 0xfc, 0x2c, 0x4f, 0x54, 0x7e, 0x66, 0x9d, 0x27,
-	0x58, 0x9f, 0xfe, 0xf4, 0x53, 0x65, 0xfa, 0xd3, 0x4f, 0x1b, 0x97, 0x71, 0xfa, 0xa9, 0x7a, 0xb9,
-	0xa7, 0x9f, 0x36, 0xa7, 0x3b, 0xfd, 0xf4, 0x9b, 0xb9, 0xc1, 0x79, 0xc6, 0x09, 0x4f, 0x50, 0xac,
-	0xc7, 0x41, 0x5c, 0x9e, 0x70, 0x10, 0x61, 0xfa, 0x15, 0x25, 0x4c, 0x8b, 0x2e, 0x4c, 0x22, 0x10,
-	0x57, 0xe2, 0xd0, 0x22, 0x3a, 0x5c, 0x51, 0xf0, 0x78, 0x45, 0x71, 0x56, 0xd1, 0xd2, 0x4d, 0xb8,
-	0xe3, 0xab, 0xa9, 0x78, 0x2a, 0x0e, 0x83, 0x2a, 0x11, 0x73, 0x44, 0x46, 0x5e, 0xb8, 0x58, 0x46,
-	0x8e, 0x0f, 0x1a, 0x2f, 0x66, 0x1f, 0x34, 0x5e, 0x1a, 0x3a, 0x68, 0x8c, 0xed, 0xa0, 0x75, 0x62,
-	0x3d, 0x25, 0x81, 0xa3, 0x57, 0x79, 0x0a, 0x86, 0xcf, 0x48, 0xe0, 0x98, 0xdf, 0x87, 0xea, 0xb0,
-	0x11, 0x74, 0x4f, 0x95, 0xbe, 0x03, 0x51, 0xdc, 0x4f, 0x1c, 0x14, 0xcc, 0x3c, 0x60, 0x18, 0x99,
-	0x93, 0x19, 0xfe, 0x3f, 0x0c, 0xd8, 0x4a, 0xcd, 0x79, 0x79, 0x9d, 0xd1, 0x44, 0x9f, 0x73, 0x46,
-	0xe9, 0x73, 0x0e, 0xac, 0x9f, 0x53, 0xac, 0x1f, 0x6b, 0x7b, 0x36, 0x5b, 0xdb, 0x73, 0xe3, 0xb4,
-	0x3d, 0x3f, 0xa1, 0xb6, 0xbf, 0x30, 0x60, 0x3b, 0xfb, 0xd5, 0x75, 0x55, 0x3e, 0xfd, 0x01, 0x4d,
-	0xf3, 0xd7, 0x60, 0xed, 0x80, 0x12, 0xff, 0xc5, 0x9c, 0x5a, 0x7a, 0x00, 0x65, 0x55, 0xf8, 0x54,
-	0x67, 0x96, 0x3e, 0x67, 0xd2, 0xec, 0x80, 0xbe, 0x18, 0xac, 0x0f, 0x61, 0x3d, 0x25, 0x7d, 0x2a,
-	0xb0, 0xdf, 0x81, 0x4a, 0x13, 0xb7, 0xc8, 0x19, 0x0e, 0x5e, 0x0c, 0xdc, 0x47, 0xb0, 0x31, 0x24,
-	0x7f, 0x5a, 0xed, 0xee, 0x63, 0x3b, 0xc4, 0x2f, 0x4c, 0xbb, 0x29, 0xe9, 0x53, 0x81, 0x7d, 0x05,
-	0xb6, 0x3e, 0xc1, 0x91, 0xa9, 0x58, 0x95, 0xe2, 0x86, 0xd4, 0x6d, 0x45, 0x98, 0xcd, 0x9f, 0xe5,
-	0x60, 0x3b, 0x7b, 0x5c, 0xce, 0x1a, 0xc2, 0x7a, 0xc7, 0x0e, 0xa9, 0x45, 0x9f, 0x12, 0xeb, 0x29,
-	0xc6, 0xa7, 0x96, 0x28, 0x1a, 0x1c, 0x79, 0x60, 0xf0, 0xeb, 0xc9, 0xd5, 0x33, 0x4e, 0xd0, 0xee,
-	0x03, 0x3b, 0xa4, 0x87, 0x4f, 0xc9, 0x67, 0x18, 0x9f, 0x8a, 0xd3, 0xdf, 0xce, 0x7d, 0x8f, 0x06,
-	0xfd, 0x26, 0xea, 0x0c, 0x0d, 0xa0, 0x63, 0x58, 0xa1, 0xc4, 0xb7, 0x28, 0xf6, 0x2c, 0x99, 0x50,
-	0x42, 0xb9, 0x5a, 0x3f, 0xd0, 0x9e, 0xef, 0x90, 0xf8, 0x87, 0xd8, 0x6b, 0x4a, 0x76, 0x31, 0x57,
-	0x89, 0x2a, 0x0f, 0xd1, 0x8d, 0xf8, 0xea, 0x4e, 0xe2, 0x7c, 0x4d, 0xb1, 0x59, 0x88, 0xf3, 0x39,
-	0x0b, 0x1d, 0x37, 0xa0, 0x18, 0xe5, 0x39, 0x41, 0x24, 0x22, 0x5e, 0x41, 0x3e, 0xe4, 0x44, 0xb5,
-	0xfb, 0xb0, 0x31, 0xe2, 0x05, 0xd1, 0x0a, 0xe4, 0x4e, 0x71, 0x9f, 0xc7, 0xa4, 0xa5, 0x26, 0xfb,
-	0x97, 0xc5, 0xce, 0x33, 0x16, 0xe3, 0xa2, 0x2b, 0x31, 0xfc, 0xc7, 0x9d, 0x99, 0xaf, 0x19, 0xb5,
-	0x3a, 0xac, 0x65, 0xe0, 0x9e, 0x44, 0x84, 0xf9, 0xb7, 0x39, 0x58, 0xf8, 0x86, 0xd8, 0x7b, 0x43,
-	0x1f, 0xa8, 0x3b, 0x73, 0x5a, 0x39, 0x21, 0xde, 0xb7, 0xbb, 0x82, 0xaf, 0xcc, 0xc4, 0x8e, 0xf1,
-	0xec, 0x04, 0x3b, 0xc6, 0xf1, 0xd7, 0xc2, 0x9c, 0xfe, 0xd7, 0x42, 0xaa, 0x5a, 0x9e, 0x9f, 0xa6,
-	0x5a, 0x5e, 0x98, 0xa8, 0x5a, 0x4e, 0xa4, 0xda, 0x45, 0xe5, 0x60, 0xec, 0x3f, 0x18, 0xd1, 0xcd,
-	0x11, 0x69, 0xbf, 0x28, 0xb0, 0x44, 0x86, 0x30, 0x2e, 0x6a, 0x88, 0x99, 0x29, 0x0c, 0x91, 0xd3,
-	0x37, 0x84, 0xf9, 0x24, 0xba, 0xd9, 0x11, 0xbf, 0x80, 0x8c, 0x22, 0x53, 0x39, 0xa2, 0xf9, 0x67,
-	0x89, 0x92, 0x57, 0x4a, 0x8e, 0x83, 0xee, 0xff, 0xbb, 0xf8, 0xb8, 0x0f, 0xe2, 0x29, 0x8a, 0xae,
-	0x41, 0x85, 0xb7, 0x90, 0x5d, 0xe1, 0x2d, 0x26, 0x2b, 0x3c, 0x33, 0x18, 0x14, 0xc4, 0x03, 0x13,
-	0xe9, 0x56, 0x67, 0xef, 0x42, 0x21, 0x36, 0xe2, 0x88, 0x8a, 0x38, 0xf2, 0x28, 0x90, 0xc6, 0x63,
-	0x15, 0xd9, 0x7b, 0xd1, 0x49, 0xf2, 0xb4, 0x53, 0x5c, 0x4b, 0x3b, 0x45, 0xaa, 0x23, 0xf1, 0xb5,
-	0xe8, 0x8c, 0xf8, 0x10, 0xd4, 0xf3, 0x38, 0x1f, 0xc3, 0x7a, 0x9d, 0x52, 0xbb, 0x75, 0x32, 0xe1,
-	0x94, 0x23, 0x0b, 0x6c, 0x73, 0x0f, 0x2a, 0x69, 0x89, 0x12, 0xcb, 0x20, 0xa3, 0x1b, 0xc9, 0x8c,
-	0xfe, 0x98, 0xbd, 0xf5, 0x65, 0x43, 0x48, 0x4b, 0x1c, 0x0f, 0xe1, 0x0b, 0x03, 0xf2, 0xac, 0x2c,
-	0x8e, 0xf2, 0xcc, 0x05, 0x3b, 0xfa, 0xa9, 0xb5, 0x3b, 0x33, 0x59, 0x54, 0x78, 0xc2, 0x8f, 0xe8,
-	0x26, 0x60, 0x24, 0xbe, 0x84, 0x8a, 0x1c, 0x4e, 0x24, 0x3c, 0xeb, 0x72, 0x43, 0x82, 0xaf, 0x99,
-	0xf7, 0x06, 0x3f, 0xcc, 0x4d, 0x7e, 0x16, 0x56, 0x15, 0x2b, 0xb4, 0x61, 0xfe, 0x4a, 0x74, 0x30,
-	0xf5, 0xd2, 0x27, 0xdd, 0x8e, 0x4e, 0x89, 0x66, 0xcd, 0x7b, 0xeb, 0x5f, 0xae, 0x43, 0x49, 0x16,
-	0x3a, 0x0f, 0x6d, 0xcf, 0x6e, 0xe3, 0x00, 0x7d, 0x0b, 0x96, 0x53, 0x28, 0x91, 0x99, 0x9c, 0x29,
-	0x5b, 0x33, 0xb5, 0x1b, 0x63, 0x69, 0xa4, 0xd1, 0x5b, 0x80, 0x86, 0xc1, 0xa0, 0xd7, 0x93, 0xac,
-	0x23, 0xd5, 0x50, 0xfb, 0xca, 0x79, 0x64, 0x72, 0x92, 0x2f, 0x0d, 0x28, 0x2a, 0xb9, 0x02, 0xed,
-	0x28, 0x1f, 0x62, 0x19, 0x79, 0xb0, 0xf6, 0xea, 0x18, 0x0a, 0x69, 0xa2, 0x77, 0x9f, 0xd7, 0x57,
-	0xd1, 0xb2, 0x48, 0xd5, 0x3b, 0xa7, 0xb8, 0xbf, 0xc3, 0x4c, 0xf1, 0xc5, 0xbf, 0xfe, 0xdb, 0x4f,
-	0x67, 0xb6, 0xcc, 0xca, 0xde, 0xd9, 0xdb, 0x7b, 0xb2, 0xb4, 0x0b, 0xf7, 0x22, 0x3b, 0x85, 0x77,
-	0x8c, 0x37, 0xd0, 0x1f, 0x18, 0xb0, 0x92, 0x0e, 0x5f, 0xe8, 0x86, 0xfa, 0x2a, 0x99, 0xf9, 0xa7,
-	0xf6, 0xda, 0x78, 0xa2, 0x01, 0xac, 0x32, 0x42, 0x8e, 0x1c, 0x8e, 0x81, 0x85, 0x1c, 0x59, 0x15,
-	0x8d, 0x40, 0x86, 0x7e, 0xd7, 0x80, 0x92, 0x1a, 0xa8, 0xd0, 0xab, 0xc3, 0xfa, 0x4d, 0x43, 0x32,
-	0xc7, 0x91, 0x48, 0x40, 0x5f, 0x7d, 0x5e, 0x47, 0x68, 0x45, 0x9c, 0x3a, 0x4b, 0xc1, 0xd9, 0x7a,
-	0x63, 0x8c, 0xa2, 0xfe, 0xd0, 0x80, 0x92, 0x1a, 0xae, 0x54, 0x44, 0x99, 0xc1, 0x51, 0x45, 0x94,
-	0x1d, 0xed, 0xcc, 0x0f, 0x38, 0x22, 0x9b, 0x0f, 0xa6, 0x10, 0xbd, 0x6a, 0x6e, 0x67, 0x22, 0xda,
-	0x13, 0xd4, 0x11, 0x2e, 0x35, 0x86, 0xa5, 0x35, 0x75, 0x2e, 0xae, 0xec, 0x10, 0x28, 0x71, 0x39,
-	0x78, 0x12, 0x5c, 0x82, 0x9a, 0xe1, 0xfa, 0x89, 0x01, 0xcb, 0xa9, 0xdb, 0xc7, 0xc8, 0xcc, 0x72,
-	0x19, 0xf5, 0xca, 0x7d, 0xed, 0xc6, 0x58, 0x1a, 0x09, 0xed, 0x6d, 0x09, 0x4d, 0x7a, 0x95, 0xe8,
-	0x7f, 0x08, 0x68, 0x15, 0x54, 0x56, 0xa0, 0xc9, 0x31, 0xf4, 0xc3, 0x78, 0xd9, 0x45, 0x8d, 0xa8,
-	0x8c, 0x65, 0xa7, 0x5e, 0x4a, 0xc9, 0x5a, 0x76, 0xa9, 0x1b, 0x1a, 0x0c, 0xc9, 0x0a, 0x2a, 0xc9,
-	0x65, 0x27, 0x27, 0x15, 0xbe, 0x6d, 0xae, 0x29, 0x38, 0x04, 0x09, 0xd3, 0xcc, 0x21, 0x14, 0x95,
-	0x1b, 0x70, 0x2a, 0x90, 0xac, 0x4b, 0xb9, 0x2a, 0x90, 0xec, 0x2b, 0xa0, 0xdf, 0x85, 0xd5, 0xa1,
-	0x7b, 0x75, 0xe8, 0xb5, 0x91, 0x7c, 0x89, 0x4b, 0x9e, 0xb5, 0xd7, 0xcf, 0xa1, 0x92, 0x33, 0xfc,
-	0x95, 0x01, 0x1b, 0x23, 0xae, 0x2a, 0xa2, 0x37, 0x46, 0x8a, 0x18, 0xba, 0x6a, 0x58, 0xfb, 0x79,
-	0x2d, 0xda, 0x81, 0x13, 0x6e, 0xa1, 0xcd, 0x2e, 0xa7, 0x8a, 0xf4, 0xbb, 0x63, 0xc7, 0x74, 0x99,
-	0xaa, 0x16, 0xd4, 0x4c, 0xd5, 0xff, 0x64, 0xc0, 0xd6, 0x98, 0xdb, 0x86, 0x68, 0x77, 0xec, 0x9b,
-	0x0f, 0x43, 0xdf, 0xd3, 0xa6, 0x97, 0xf0, 0x3f, 0x79, 0x5e, 0xdf, 0x41, 0xd7, 0x52, 0xf0, 0x59,
-	0xfe, 0x4b, 0xbf, 0xc3, 0x35, 0x73, 0x33, 0xe3, 0x1d, 0xf8, 0x96, 0x1d, 0x0f, 0x3f, 0x9f, 0x41,
-	0x39, 0xeb, 0x62, 0x23, 0xfa, 0xb9, 0x54, 0x5e, 0x1b, 0x75, 0x2b, 0xb1, 0x56, 0x19, 0xaa, 0x2e,
-	0xee, 0x77, 0x7d, 0xda, 0x47, 0xdf, 0x66, 0x5f, 0x18, 0x99, 0xf7, 0x19, 0x55, 0xa3, 0x8e, 0xbf,
-	0xf4, 0x38, 0x52, 0xfc, 0x97, 0x71, 0x20, 0x8f, 0xb6, 0x75, 0xb2, 0x02, 0x79, 0x6a, 0x43, 0x29,
-	0x2b, 0x90, 0xa7, 0x77, 0x85, 0xcc, 0x5b, 0x3c, 0xe1, 0xc9, 0x40, 0x1e, 0xe9, 0x2d, 0xd3, 0x1f,
-	0x04, 0x0d, 0xd3, 0xe2, 0x8f, 0x0d, 0x28, 0xa9, 0xf7, 0x02, 0x55, 0x34, 0x99, 0xd7, 0x1d, 0x55,
-	0x34, 0xd9, 0xd7, 0x0a, 0xcd, 0xdb, 0x1c, 0x8d, 0x6c, 0x69, 0x29, 0x81, 0x60, 0xd3, 0x54, 0x03,
-	0x92, 0xa4, 0x61, 0x70, 0x7e, 0xdf, 0x80, 0xe5, 0xd4, 0xdd, 0x3c, 0x35, 0x46, 0x66, 0xdf, 0x1b,
-	0x54, 0x63, 0xe4, 0x88, 0xcb, 0x7d, 0x2c, 0xf3, 0x22, 0xb4, 0x12, 0xc8, 0x51, 0x05, 0x52, 0xcd,
-	0x5c, 0x57, 0x20, 0x45, 0x44, 0x0c, 0x13, 0x8b, 0x93, 0xca, 0x65, 0x34, 0x35, 0x3c, 0x65, 0x5d,
-	0xde, 0x53, 0xc3, 0x53, 0xe6, 0x4d, 0x36, 0x19, 0x27, 0x03, 0x3e, 0x36, 0x36, 0x4e, 0x0a, 0x12,
-	0x86, 0xe4, 0xa7, 0x06, 0x2f, 0xf5, 0x14, 0x97, 0x4c, 0x97, 0x7a, 0x59, 0xae, 0x78, 0x63, 0x2c,
-	0x8d, 0xc4, 0xf3, 0xde, 0xf3, 0xfa, 0x1a, 0x5a, 0xb5, 0x1d, 0x47, 0x59, 0x95, 0x61, 0x66, 0xc1,
-	0x64, 0x3b, 0xce, 0x60, 0x21, 0xfe, 0xb9, 0x11, 0x15, 0x89, 0x0a, 0xb0, 0xd7, 0x47, 0x7a, 0xac,
-	0x82, 0xed, 0x2b, 0xe7, 0x91, 0x49, 0x78, 0xf7, 0x9e, 0xd7, 0x2b, 0xa8, 0xac, 0x3a, 0x77, 0x02,
-	0x61, 0x3a, 0x5a, 0xc8, 0x13, 0xf8, 0x31, 0xc8, 0x3f, 0x32, 0x60, 0x25, 0x7d, 0x77, 0x48, 0xad,
-	0xea, 0x46, 0xdc, 0x84, 0x52, 0xab, 0xba, 0x51, 0xd7, 0x8f, 0xcc, 0xf7, 0x79, 0x55, 0xd7, 0xe3,
-	0xc3, 0x31, 0x3c, 0xec, 0x9d, 0x71, 0x70, 0xdb, 0xb7, 0x36, 0x52, 0x0e, 0xcf, 0xc8, 0x2c, 0xec,
-	0x9d, 0x31, 0x68, 0x3f, 0x4a, 0x14, 0x9c, 0x71, 0x48, 0xc8, 0x4c, 0xfa, 0xe9, 0xa0, 0xf0, 0xda,
-	0x78, 0x22, 0x09, 0xed, 0x0d, 0x6e, 0xd8, 0xb8, 0x34, 0x50, 0x02, 0x43, 0x09, 0x15, 0x92, 0xc8,
-	0xd0, 0x9f, 0x1a, 0x50, 0xce, 0xea, 0xae, 0xa8, 0x51, 0x75, 0x4c, 0xeb, 0xa9, 0x76, 0xf3, 0x7c,
-	0xc2, 0xc1, 0x72, 0xac, 0xa2, 0x4a, 0x1a, 0x57, 0xc2, 0xa6, 0x65, 0x84, 0x14, 0xb5, 0xf1, 0x11,
-	0xf4, 0x1b, 0x06, 0x14, 0x92, 0xfd, 0x11, 0xa4, 0xb4, 0x4f, 0x33, 0xda, 0x32, 0xb5, 0x9d, 0xd1,
-	0x04, 0x12, 0xca, 0xee, 0xf3, 0xfa, 0x32, 0x2a, 0x86, 0x94, 0xf8, 0xaa, 0x7a, 0x2a, 0xe6, 0xaa,
-	0x5a, 0x3a, 0x51, 0xe2, 0x33, 0x93, 0xfd, 0x96, 0x01, 0x45, 0xa5, 0xef, 0x81, 0x52, 0x73, 0x0c,
-	0x37, 0x5c, 0xd4, 0x90, 0x90, 0xd9, 0x34, 0x31, 0xdf, 0xe2, 0x21, 0x81, 0xdf, 0xd5, 0x51, 0x71,
-	0x6c, 0x98, 0x28, 0x85, 0xc3, 0x0e, 0x28, 0x03, 0xf2, 0x7b, 0x2c, 0x5e, 0xaa, 0x1d, 0x8d, 0x54,
-	0xbc, 0xcc, 0x6c, 0xa7, 0xa4, 0xe2, 0x65, 0x76, 0x4b, 0xc4, 0x7c, 0x47, 0xc4, 0x4b, 0x31, 0xaa,
-	0x02, 0x4a, 0x87, 0x70, 0x49, 0x14, 0xe9, 0x46, 0xe9, 0x5a, 0xa4, 0xca, 0xca, 0x8c, 0x76, 0x49,
-	0xaa, 0xac, 0xcc, 0x6a, 0x79, 0x48, 0xdd, 0xb4, 0xd8, 0xd8, 0x78, 0xdd, 0x70, 0x12, 0x06, 0xe4,
-	0x2f, 0x0c, 0x28, 0x67, 0xb5, 0x05, 0x54, 0x5f, 0x1e, 0xd3, 0x11, 0x51, 0x7d, 0x79, 0x5c, 0x87,
-	0xc1, 0xbc, 0xc3, 0x7d, 0xb9, 0x8d, 0x63, 0xbb, 0xed, 0x84, 0x31, 0x91, 0x50, 0x18, 0xda, 0x48,
-	0x5b, 0x50, 0x0e, 0x7f, 0x34, 0xfb, 0xad, 0x19, 0xff, 0xe8, 0x68, 0x9e, 0x97, 0x09, 0xb7, 0xff,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x00, 0xbd, 0xbf, 0xf3, 0x41, 0x4c, 0x00, 0x00,
+func init() { proto.RegisterFile("cluster.proto", fileDescriptor_cluster_3ea543781b7353ca) }
+
+var fileDescriptor_cluster_3ea543781b7353ca = []byte{
+	// 4920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xdd, 0x6f, 0x23, 0x59,
+	0x56, 0x57, 0xd9, 0x71, 0x12, 0x1f, 0x27, 0x4e, 0x72, 0xe3, 0x38, 0x4e, 0x25, 0xd3, 0xed, 0xae,
+	0x9e, 0xde, 0x6d, 0xcd, 0x32, 0x49, 0x4f, 0xf7, 0xf4, 0xf6, 0xf7, 0xce, 0xa6, 0xd3, 0xcd, 0x6c,
+	0x34, 0x3d, 0x33, 0x8d, 0xd3, 0x3d, 0x03, 0xcb, 0xec, 0x7a, 0x2b, 0x55, 0x37, 0x4e, 0x6d, 0xec,
+	0xba, 0x35, 0x55, 0xe5, 0xf4, 0x66, 0xc4, 0x03, 0x0c, 0x12, 0x68, 0x99, 0x5d, 0x58, 0xb2, 0x8b,
+	0x84, 0x90, 0x40, 0x7c, 0x88, 0x47, 0x24, 0x34, 0x12, 0x62, 0x79, 0x40, 0x3c, 0x22, 0x56, 0x48,
+	0x8b, 0xc4, 0x33, 0x4f, 0xf0, 0x06, 0x7f, 0x00, 0x12, 0xe2, 0x43, 0xf7, 0xa3, 0xca, 0x75, 0xcb,
+	0x65, 0xe7, 0x3a, 0x4e, 0x77, 0x83, 0xc4, 0x53, 0xe2, 0xaa, 0x73, 0xce, 0xfd, 0xd5, 0xb9, 0xe7,
+	0x9e, 0x73, 0xee, 0x3d, 0xa7, 0x0a, 0x66, 0xad, 0x76, 0x37, 0x08, 0xb1, 0xbf, 0xee, 0xf9, 0x24,
+	0x24, 0x08, 0x88, 0x87, 0x5d, 0xcf, 0x09, 0x7d, 0xe7, 0x3b, 0xfa, 0x6a, 0x8b, 0x90, 0x56, 0x1b,
+	0x6f, 0xb0, 0x3b, 0xbb, 0xdd, 0xbd, 0x0d, 0xdc, 0xf1, 0xc2, 0x23, 0x4e, 0xa8, 0x9f, 0x4b, 0xdf,
+	0x7c, 0xe6, 0x9b, 0x9e, 0x87, 0xfd, 0x40, 0xdc, 0x3f, 0x9f, 0xbe, 0x1f, 0x3a, 0x1d, 0x1c, 0x84,
+	0x66, 0xc7, 0x13, 0x04, 0x6b, 0x82, 0xc0, 0xf4, 0x9c, 0x0d, 0xd3, 0x75, 0x49, 0x68, 0x86, 0x0e,
+	0x71, 0x23, 0xf6, 0x9f, 0x61, 0x7f, 0xac, 0xd7, 0x5b, 0xd8, 0x7d, 0x3d, 0x78, 0x66, 0xb6, 0x5a,
+	0xd8, 0xdf, 0x20, 0x1e, 0xa3, 0xe8, 0xa7, 0x36, 0x7e, 0x2f, 0x07, 0xd5, 0x07, 0x38, 0xb0, 0x7c,
+	0x67, 0x17, 0xef, 0x74, 0x77, 0x5d, 0x1c, 0x06, 0x0d, 0xfc, 0x71, 0x17, 0x07, 0x21, 0xba, 0x03,
+	0xe0, 0x77, 0x5d, 0x3a, 0x78, 0xd3, 0xb1, 0x6b, 0x5a, 0x5d, 0xbb, 0x5c, 0xba, 0xba, 0xb6, 0xce,
+	0xc7, 0x5e, 0x8f, 0xc0, 0xad, 0xef, 0x84, 0xbe, 0xe3, 0xb6, 0x3e, 0x30, 0xdb, 0x5d, 0xdc, 0x28,
+	0x0a, 0xfa, 0x6d, 0x1b, 0x55, 0xa0, 0xd0, 0x76, 0x3a, 0x4e, 0x58, 0xcb, 0xd5, 0xb5, 0xcb, 0xb3,
+	0x0d, 0xfe, 0x03, 0x55, 0x61, 0x92, 0xec, 0xed, 0x05, 0x38, 0xac, 0xe5, 0xd9, 0x65, 0xf1, 0x0b,
+	0xdd, 0x83, 0x52, 0xc0, 0x06, 0x6f, 0x86, 0x47, 0x1e, 0xae, 0x4d, 0x0c, 0x18, 0xeb, 0xe9, 0xb6,
+	0x1b, 0x5e, 0xbb, 0xca, 0xc7, 0x02, 0xce, 0xf0, 0xe4, 0xc8, 0xc3, 0x68, 0x15, 0x8a, 0x82, 0xdd,
+	0xb1, 0x6b, 0x85, 0x7a, 0xfe, 0x72, 0xb1, 0x31, 0xcd, 0x2f, 0x6c, 0xdb, 0x08, 0xc1, 0xc4, 0x27,
+	0xc4, 0xc5, 0xb5, 0x49, 0x76, 0x9d, 0xfd, 0x8f, 0x2e, 0x41, 0xd9, 0xb4, 0x0f, 0x4d, 0xd7, 0xc2,
+	0x76, 0xd3, 0x33, 0x7d, 0xb3, 0x53, 0x9b, 0x62, 0x77, 0x67, 0xa3, 0xab, 0x8f, 0xe9, 0x45, 0xe3,
+	0xaf, 0xf2, 0x30, 0xc9, 0x95, 0x82, 0x6e, 0x25, 0x87, 0x50, 0xd1, 0x45, 0x0f, 0xc0, 0x15, 0x98,
+	0x70, 0xcd, 0x0e, 0xae, 0xe5, 0x14, 0xb8, 0x18, 0x25, 0xe5, 0x60, 0x90, 0xf3, 0x2a, 0x1c, 0xec,
+	0x81, 0xee, 0x40, 0xc9, 0xf2, 0xb1, 0x19, 0xe2, 0x26, 0xd5, 0xbf, 0x50, 0xa0, 0xde, 0xc7, 0xf8,
+	0x24, 0xb2, 0xa4, 0x06, 0x70, 0x72, 0x7a, 0x01, 0x7d, 0x05, 0x4a, 0x36, 0x33, 0x01, 0x66, 0x25,
+	0xb5, 0x82, 0xc2, 0xa8, 0x49, 0x06, 0x74, 0x1e, 0x4a, 0x8e, 0x1b, 0x84, 0x54, 0x71, 0x54, 0x3b,
+	0x5c, 0xd1, 0x10, 0x5d, 0xda, 0xb6, 0xd1, 0x35, 0x98, 0x3c, 0xf4, 0x2c, 0x7a, 0x6f, 0x4a, 0x41,
+	0x76, 0xe1, 0xd0, 0xb3, 0xb6, 0xed, 0xb4, 0x4d, 0x4c, 0x8f, 0x66, 0x13, 0x46, 0x07, 0x96, 0xfb,
+	0xec, 0x3a, 0xf0, 0x88, 0x1b, 0x60, 0x8a, 0x37, 0x24, 0xa1, 0xd9, 0x6e, 0x5a, 0xa4, 0xeb, 0x86,
+	0x6c, 0x36, 0x67, 0x1b, 0xc0, 0x2e, 0x6d, 0xd1, 0x2b, 0xe8, 0x0d, 0x10, 0x92, 0x9a, 0xd4, 0x54,
+	0x73, 0xf5, 0xfc, 0xe5, 0xd2, 0x55, 0xb4, 0xde, 0x5b, 0xdf, 0xeb, 0x5c, 0x62, 0x43, 0x98, 0xc4,
+	0x0e, 0x0e, 0x8d, 0x3f, 0xc8, 0x41, 0x65, 0x8b, 0xa9, 0x74, 0x8b, 0x7b, 0x85, 0x68, 0x15, 0x5d,
+	0x83, 0x49, 0xd3, 0xf3, 0x54, 0xad, 0xa6, 0x60, 0x7a, 0xde, 0xb6, 0x4d, 0x97, 0xde, 0x21, 0xf6,
+	0x03, 0x87, 0xb8, 0x94, 0x51, 0xc5, 0x70, 0x8a, 0x82, 0x9e, 0x33, 0x27, 0xd6, 0x6d, 0x7e, 0xb4,
+	0x75, 0x7b, 0x05, 0x26, 0x2c, 0xe2, 0xee, 0xd5, 0x26, 0x14, 0xd8, 0x18, 0x65, 0xc6, 0x5a, 0x2a,
+	0x64, 0xad, 0xa5, 0xef, 0x6a, 0xb0, 0x94, 0x52, 0x90, 0x98, 0x8e, 0x3b, 0x00, 0xc2, 0x93, 0x2a,
+	0xfb, 0x19, 0x41, 0xcf, 0x4d, 0xeb, 0xdb, 0x64, 0x57, 0x55, 0x4b, 0x85, 0x6f, 0x93, 0xdd, 0x6d,
+	0xdb, 0xf8, 0x3c, 0x0f, 0x95, 0x77, 0x89, 0xed, 0xec, 0x1d, 0xa5, 0x26, 0xeb, 0x75, 0x98, 0x12,
+	0xa2, 0x05, 0x8e, 0xc5, 0xe4, 0xac, 0x47, 0xc4, 0x11, 0x0d, 0xda, 0x84, 0xf9, 0x08, 0xb9, 0x4b,
+	0x6c, 0x9c, 0xb0, 0x96, 0xe5, 0x0c, 0xbe, 0xf7, 0x88, 0x8d, 0x1b, 0x65, 0xab, 0xf7, 0x63, 0x07,
+	0x87, 0x49, 0x11, 0x3e, 0x69, 0x73, 0x11, 0xf9, 0x81, 0x22, 0x1a, 0xa4, 0xdd, 0x13, 0x41, 0x7f,
+	0xa4, 0x44, 0xb4, 0x1d, 0xf7, 0x80, 0x89, 0x98, 0x18, 0x28, 0xe2, 0x91, 0xe3, 0x1e, 0xc4, 0x22,
+	0xe8, 0x0f, 0x2a, 0xe2, 0x6d, 0x40, 0x91, 0x08, 0x8b, 0x74, 0x3a, 0xc4, 0x65, 0x42, 0x0a, 0x4c,
+	0xc8, 0x4a, 0x86, 0x90, 0x2d, 0x46, 0xd4, 0x98, 0xb7, 0x92, 0x3f, 0xa9, 0xa0, 0x5f, 0x80, 0x5a,
+	0x8c, 0x85, 0x98, 0xf6, 0xae, 0xd9, 0xa6, 0x26, 0xe0, 0x33, 0x71, 0x93, 0x4c, 0xdc, 0xf9, 0x2c,
+	0x4c, 0x09, 0xd2, 0x46, 0xd5, 0xea, 0xbf, 0x48, 0x57, 0xd8, 0x13, 0x58, 0x4a, 0xcd, 0xd9, 0x19,
+	0xd8, 0x8f, 0xf1, 0x01, 0xd4, 0x24, 0xa9, 0x6c, 0x92, 0x84, 0x35, 0xdc, 0x86, 0x99, 0xe4, 0xf4,
+	0x0a, 0xd1, 0x03, 0xa7, 0xb6, 0x94, 0x98, 0x5a, 0xa3, 0x01, 0x2b, 0x19, 0x72, 0x05, 0xe2, 0xeb,
+	0x30, 0xc5, 0xec, 0x45, 0x11, 0xee, 0x24, 0x25, 0xde, 0xb6, 0x8d, 0x9f, 0x6a, 0x70, 0x4e, 0x12,
+	0xba, 0x19, 0x86, 0xbe, 0xb3, 0xdb, 0x0d, 0x71, 0x32, 0x66, 0x9f, 0x7e, 0x2d, 0x8d, 0x1e, 0xa8,
+	0x52, 0x91, 0x23, 0x3f, 0x62, 0xe4, 0x30, 0xbe, 0x09, 0xe7, 0x07, 0x3e, 0xd0, 0x59, 0xcc, 0xee,
+	0xf7, 0x35, 0x30, 0xfa, 0xa6, 0xa1, 0x5f, 0x6b, 0xa7, 0x9b, 0x8f, 0xd1, 0xf5, 0x65, 0x7c, 0x04,
+	0x17, 0x87, 0xc2, 0x19, 0xcf, 0x3e, 0xbe, 0x05, 0xab, 0x9b, 0xb6, 0xfd, 0xc4, 0xdc, 0x6d, 0xe3,
+	0x84, 0xfc, 0xf8, 0x29, 0xb3, 0xbc, 0x95, 0x36, 0x92, 0xb7, 0x32, 0x6e, 0xc1, 0xb9, 0x07, 0xb8,
+	0x8d, 0x43, 0x3c, 0x70, 0x90, 0xe5, 0x24, 0x74, 0x1a, 0x06, 0x22, 0x70, 0xdf, 0x80, 0x25, 0xce,
+	0x2a, 0xb8, 0x62, 0x8e, 0x57, 0x52, 0x13, 0x4c, 0x99, 0x12, 0x46, 0xd9, 0x1f, 0x5e, 0x72, 0x59,
+	0xe1, 0xe5, 0x3d, 0xa8, 0xa6, 0xc5, 0x0b, 0x65, 0x9e, 0x20, 0x7f, 0x29, 0x11, 0x40, 0xe8, 0x2d,
+	0x11, 0x22, 0x7e, 0xac, 0xc1, 0xd2, 0x53, 0xaf, 0xe5, 0x9b, 0x76, 0x3a, 0xa0, 0x8f, 0xb5, 0xc4,
+	0xc6, 0x0a, 0xec, 0xfd, 0xaa, 0xc8, 0x67, 0xa9, 0xe2, 0x37, 0x34, 0xa8, 0xa6, 0xa1, 0xbf, 0xb4,
+	0x50, 0xfb, 0x4b, 0x50, 0x6d, 0x90, 0x76, 0x7b, 0xd7, 0xb4, 0x0e, 0xce, 0x52, 0x8f, 0x8a, 0x56,
+	0xf1, 0x99, 0x06, 0xcb, 0x7d, 0xc3, 0xbf, 0x34, 0x5d, 0x1c, 0xe7, 0xa0, 0xd2, 0xc0, 0x81, 0xf3,
+	0xc9, 0x99, 0x9a, 0xd4, 0x15, 0x98, 0xf0, 0x49, 0x5b, 0xd1, 0x0b, 0x51, 0x4a, 0xb4, 0x0e, 0x79,
+	0xcb, 0xeb, 0xd6, 0xf2, 0x0a, 0x19, 0x35, 0x25, 0x44, 0x6f, 0xc2, 0x64, 0x07, 0x77, 0x88, 0x7f,
+	0xa4, 0xb4, 0x31, 0x13, 0xb4, 0xa3, 0xe4, 0x85, 0x29, 0xa5, 0xbc, 0xb4, 0x09, 0xfa, 0x57, 0x0d,
+	0xaa, 0x9b, 0xb6, 0x9d, 0xe5, 0xd7, 0x5e, 0xf0, 0x14, 0xdd, 0x01, 0x60, 0x6e, 0x94, 0xef, 0x50,
+	0x54, 0x66, 0xaa, 0x48, 0xe9, 0xf9, 0xf6, 0xa5, 0x5f, 0xf3, 0x13, 0x83, 0x16, 0x47, 0xdf, 0xd3,
+	0xbe, 0x34, 0xdd, 0xff, 0x44, 0x83, 0x15, 0xc9, 0x83, 0xbf, 0x4c, 0xf5, 0x27, 0xa2, 0x58, 0x3e,
+	0x19, 0xc5, 0x54, 0x55, 0xfb, 0x9b, 0x1a, 0xe8, 0x59, 0x0f, 0xf3, 0xd2, 0xb4, 0xfb, 0x67, 0x1a,
+	0x2c, 0x3f, 0xf5, 0xec, 0xde, 0xee, 0xeb, 0xa1, 0x7b, 0x78, 0x26, 0xba, 0x5d, 0x87, 0x3c, 0x76,
+	0x0f, 0x95, 0xa0, 0x50, 0x42, 0xd5, 0x18, 0xf6, 0x3d, 0x0d, 0x6a, 0xfd, 0x78, 0x5f, 0x9a, 0xfa,
+	0xfe, 0xb9, 0x0c, 0xb3, 0xd2, 0xd6, 0xe7, 0x45, 0x1b, 0xe4, 0xfb, 0xb0, 0x14, 0x60, 0xff, 0x90,
+	0x8d, 0xd6, 0xec, 0x7a, 0x1e, 0xf6, 0x9b, 0xbb, 0xa4, 0xeb, 0xda, 0x4a, 0xae, 0x01, 0x71, 0xd6,
+	0x6d, 0xfb, 0x29, 0x65, 0xbc, 0x4f, 0xf9, 0xd0, 0xdb, 0x30, 0x1f, 0xcf, 0x83, 0x69, 0xb1, 0x13,
+	0x41, 0xa5, 0x3d, 0xff, 0x5c, 0xc4, 0xb5, 0xc9, 0x99, 0xd0, 0x5b, 0x30, 0xe3, 0xb8, 0x4e, 0xd8,
+	0xa4, 0x63, 0x38, 0x16, 0x56, 0x3b, 0x3d, 0xa2, 0x1c, 0x3b, 0x9c, 0x01, 0x6d, 0xc2, 0x6c, 0x10,
+	0x9a, 0x7e, 0x4f, 0xc2, 0xa4, 0x82, 0x84, 0x19, 0xc6, 0x12, 0x89, 0x78, 0x0b, 0x66, 0x82, 0x90,
+	0x78, 0xb1, 0x04, 0x95, 0x53, 0xa6, 0x12, 0xe5, 0x88, 0x04, 0x7c, 0x0d, 0x16, 0x02, 0xcb, 0x6c,
+	0xe3, 0x26, 0xe9, 0xf6, 0x70, 0x4c, 0xab, 0xa8, 0x83, 0xb1, 0xbd, 0xdf, 0x8d, 0xa1, 0xfc, 0x2c,
+	0xcc, 0x73, 0x49, 0x8e, 0x1b, 0x0b, 0x2a, 0x2a, 0x08, 0x2a, 0x33, 0xae, 0x6d, 0x37, 0x92, 0xf3,
+	0x10, 0xe6, 0x7c, 0x2c, 0xeb, 0x05, 0x54, 0xc4, 0x08, 0xa6, 0x84, 0x18, 0x1b, 0x07, 0xa1, 0x4f,
+	0x8e, 0x62, 0x31, 0x25, 0x15, 0x31, 0x82, 0x29, 0x21, 0xa6, 0xcb, 0x33, 0xca, 0x58, 0xcc, 0x8c,
+	0x8a, 0x18, 0xc1, 0x14, 0x89, 0xd9, 0x82, 0xb2, 0xd5, 0x0d, 0x42, 0xd2, 0x89, 0xa5, 0xcc, 0x2a,
+	0x48, 0x99, 0xe5, 0x3c, 0x09, 0x21, 0x34, 0x9d, 0xeb, 0xf6, 0xa6, 0xbb, 0xac, 0x22, 0x84, 0xf3,
+	0xa4, 0xd4, 0x4b, 0xfc, 0xde, 0x03, 0xcd, 0xa9, 0xaa, 0x97, 0xf8, 0xf1, 0x03, 0x3d, 0x81, 0x65,
+	0x9b, 0xb9, 0xf9, 0x66, 0xe0, 0x9a, 0x5e, 0xb0, 0x4f, 0x7a, 0xb3, 0x35, 0xaf, 0x20, 0x6e, 0x89,
+	0x33, 0xef, 0x08, 0xde, 0x84, 0x39, 0xef, 0x63, 0xb3, 0x1d, 0xee, 0x37, 0xad, 0x7d, 0x6c, 0x1d,
+	0xd4, 0x16, 0x54, 0xcc, 0x99, 0x73, 0x6c, 0x51, 0x06, 0xf4, 0x65, 0x98, 0xea, 0x10, 0xd7, 0x09,
+	0x89, 0x5f, 0x43, 0x0a, 0xbc, 0x11, 0x31, 0x7a, 0x00, 0x65, 0xcf, 0x0c, 0x02, 0x6f, 0xdf, 0x37,
+	0x03, 0xdc, 0xc6, 0x41, 0x50, 0x5b, 0x54, 0x51, 0x8a, 0xcc, 0x43, 0x95, 0x72, 0x88, 0xfd, 0xd0,
+	0xb1, 0xcc, 0x76, 0x93, 0x5a, 0xb5, 0xe3, 0xb6, 0x9a, 0x1e, 0x69, 0x3b, 0xd6, 0x51, 0xad, 0xa2,
+	0xa2, 0x94, 0x88, 0x79, 0x87, 0xf3, 0x3e, 0x66, 0xac, 0x68, 0x0b, 0xe6, 0xcc, 0x16, 0x76, 0xc3,
+	0x26, 0x3b, 0x57, 0x6e, 0xb7, 0xb1, 0x5d, 0x5b, 0x1a, 0x70, 0xca, 0x7d, 0x9f, 0x90, 0xb6, 0x80,
+	0xc6, 0x58, 0xb6, 0x23, 0x0e, 0xd4, 0x80, 0xaa, 0x30, 0xc0, 0x0e, 0x0e, 0x4d, 0xdb, 0x0c, 0xcd,
+	0x26, 0x3f, 0x8c, 0xa8, 0x55, 0x15, 0x90, 0x55, 0x38, 0xef, 0xbb, 0x82, 0x75, 0x87, 0x71, 0xa2,
+	0x1b, 0x30, 0xed, 0x74, 0xcc, 0x16, 0x4b, 0x16, 0x96, 0x55, 0xb4, 0xcd, 0xa8, 0xb7, 0x6d, 0xea,
+	0xf8, 0x84, 0x21, 0x0b, 0xed, 0xd4, 0x54, 0x1c, 0x1f, 0x67, 0x11, 0x4a, 0xf9, 0x08, 0xd6, 0x1c,
+	0xd7, 0xf2, 0x71, 0x07, 0xbb, 0xf4, 0x3c, 0x3b, 0x5a, 0x17, 0x5d, 0xcf, 0x23, 0x7e, 0x88, 0xed,
+	0xda, 0xca, 0x89, 0x1a, 0xd2, 0x13, 0xfc, 0xf7, 0xf9, 0x12, 0x89, 0xb8, 0xd1, 0x5d, 0x80, 0xfd,
+	0x23, 0x8f, 0x1a, 0x65, 0x40, 0xfc, 0x9a, 0xae, 0x80, 0x2e, 0x41, 0x4f, 0x83, 0x5c, 0x9b, 0xb4,
+	0x82, 0xda, 0xaa, 0x4a, 0x90, 0xa3, 0x94, 0xc6, 0xe7, 0x33, 0x50, 0x4a, 0xe4, 0x4b, 0xa7, 0x3d,
+	0x96, 0x91, 0x43, 0x73, 0xee, 0x74, 0x67, 0x60, 0x79, 0xe5, 0x33, 0xb0, 0x7b, 0x72, 0xf5, 0x43,
+	0x25, 0x88, 0x26, 0x6b, 0x23, 0xb7, 0xa0, 0x78, 0x48, 0xda, 0x5d, 0x7e, 0x58, 0xaf, 0x12, 0x3c,
+	0xa7, 0x39, 0xf9, 0xb6, 0x4d, 0xf7, 0x65, 0x36, 0x56, 0x0e, 0x99, 0x82, 0x56, 0xae, 0x64, 0x4d,
+	0x8d, 0x54, 0xc9, 0xba, 0x03, 0xe0, 0xf9, 0xce, 0xa1, 0x19, 0xe2, 0xa6, 0xe3, 0x29, 0xc5, 0xc7,
+	0xa2, 0xa0, 0xdf, 0xf6, 0x58, 0xa6, 0xe8, 0x78, 0x4a, 0xc1, 0x90, 0x12, 0x32, 0x9c, 0x51, 0xca,
+	0x53, 0x03, 0x85, 0x34, 0x67, 0x3a, 0x4a, 0x73, 0xe2, 0xfc, 0xaa, 0xa4, 0x9c, 0x5f, 0xbd, 0x09,
+	0x93, 0x41, 0x68, 0x86, 0xdd, 0x40, 0x29, 0xae, 0x09, 0x5a, 0xb4, 0x0d, 0x0b, 0xa1, 0x6f, 0xba,
+	0x81, 0x43, 0x53, 0xa1, 0xa6, 0x10, 0xa0, 0x12, 0xd2, 0xe6, 0x7b, 0x6c, 0x3b, 0x5c, 0xd4, 0x0d,
+	0x98, 0x6e, 0xf9, 0xa4, 0xcb, 0x0a, 0x45, 0x65, 0x85, 0x87, 0x9d, 0x62, 0xd4, 0xdb, 0x36, 0xba,
+	0x0a, 0x05, 0xf2, 0xcc, 0xc5, 0xbe, 0x52, 0xfc, 0xe2, 0xa4, 0x34, 0x49, 0x69, 0xb5, 0xc9, 0x2e,
+	0xf5, 0xcf, 0xb1, 0x86, 0xe7, 0x15, 0x06, 0x2d, 0x73, 0xae, 0x9d, 0x48, 0xcf, 0x0f, 0x61, 0x2e,
+	0xe5, 0x4e, 0x95, 0x62, 0x55, 0x59, 0xf6, 0xa3, 0x74, 0x9d, 0x7b, 0xdd, 0xdd, 0xe6, 0x01, 0x3e,
+	0x52, 0x0a, 0x57, 0x93, 0x5e, 0x77, 0xf7, 0x1d, 0x7c, 0x44, 0xfd, 0xa7, 0x08, 0x93, 0x42, 0xf3,
+	0x2a, 0xc1, 0x4a, 0x44, 0xd6, 0x58, 0xeb, 0x45, 0x27, 0x10, 0x6e, 0xb3, 0x56, 0x39, 0xd1, 0x59,
+	0x4e, 0x3b, 0x01, 0xf7, 0x91, 0xb4, 0xde, 0x6a, 0x76, 0x43, 0x12, 0xb1, 0x9e, 0x1c, 0x89, 0x80,
+	0x92, 0xf7, 0x98, 0x93, 0xc5, 0xda, 0xea, 0x48, 0xc5, 0xda, 0x3b, 0x50, 0xe2, 0x8f, 0xcb, 0x99,
+	0x97, 0x4f, 0x66, 0xe6, 0xe4, 0x8c, 0x39, 0x51, 0xd1, 0x60, 0x0b, 0xa4, 0x36, 0xb0, 0xa2, 0xc1,
+	0x2a, 0x4d, 0xa5, 0x44, 0xa5, 0x09, 0x7d, 0x15, 0xca, 0x72, 0x8d, 0x48, 0x44, 0x97, 0x21, 0xf5,
+	0xa1, 0x59, 0xa9, 0x3e, 0x84, 0xce, 0x41, 0xe9, 0x00, 0x1f, 0x35, 0x3d, 0xd3, 0x61, 0x16, 0xa7,
+	0xf3, 0xa3, 0xd8, 0x03, 0x7c, 0xf4, 0xd8, 0x74, 0xc4, 0xd6, 0x0c, 0x3b, 0x6c, 0x05, 0xa8, 0xc4,
+	0x8c, 0x02, 0x76, 0xbc, 0x6d, 0xdb, 0xf8, 0xfb, 0x42, 0x1c, 0x34, 0x1a, 0xe2, 0xe4, 0xe4, 0x7f,
+	0xf3, 0x59, 0xda, 0x3a, 0xe4, 0x5b, 0x5e, 0x57, 0xe9, 0x20, 0x8d, 0x12, 0x26, 0xce, 0xde, 0x0a,
+	0x23, 0x9c, 0xbd, 0x6d, 0xc2, 0x6c, 0x1c, 0x93, 0xe8, 0xd1, 0x5a, 0x6d, 0x52, 0x81, 0x79, 0x26,
+	0x62, 0xd9, 0x71, 0x3e, 0x89, 0xf6, 0x54, 0xbe, 0xd9, 0x12, 0x12, 0xa6, 0x14, 0x24, 0x94, 0x04,
+	0x07, 0x13, 0x70, 0x0f, 0x4a, 0x1d, 0xd2, 0x75, 0xc3, 0xa6, 0x47, 0x1c, 0x37, 0x54, 0x8a, 0x16,
+	0xc0, 0x18, 0x1e, 0x53, 0x7a, 0xfa, 0x08, 0x9c, 0x5d, 0xf4, 0xae, 0x28, 0x05, 0x8e, 0x19, 0xc6,
+	0xf2, 0x3e, 0xe7, 0xa0, 0x08, 0xf6, 0x1c, 0x5a, 0x53, 0x3d, 0x0a, 0x42, 0xdc, 0x51, 0xda, 0x3f,
+	0x01, 0x65, 0xd8, 0x61, 0xf4, 0xd1, 0xd1, 0x46, 0x49, 0xf5, 0x68, 0x63, 0x0b, 0xe6, 0xbc, 0xee,
+	0x6e, 0xdb, 0xb1, 0x9a, 0xd8, 0xb5, 0xf9, 0x43, 0xcf, 0x9c, 0x9c, 0xa1, 0x72, 0x96, 0x87, 0x82,
+	0xc3, 0xf8, 0x8f, 0x1c, 0x2c, 0x66, 0x54, 0x45, 0x5f, 0xb4, 0x59, 0x7f, 0x00, 0x35, 0xa9, 0x7e,
+	0xdb, 0x76, 0x82, 0x10, 0xbb, 0x7c, 0x70, 0x95, 0xd4, 0xa8, 0x9a, 0xe4, 0x7e, 0x24, 0x98, 0xb7,
+	0x6d, 0x1a, 0x31, 0x25, 0xb9, 0x1e, 0xf1, 0x43, 0xa5, 0xc5, 0x30, 0x9f, 0x64, 0x7b, 0x4c, 0xfc,
+	0x90, 0xe6, 0xf2, 0x29, 0x51, 0x34, 0x25, 0x56, 0xcd, 0xa2, 0x2a, 0xb2, 0x3c, 0xca, 0xba, 0x6d,
+	0x1b, 0xff, 0xa5, 0xc5, 0xce, 0x84, 0x96, 0xc6, 0x5f, 0x74, 0x39, 0xf5, 0x11, 0x2c, 0xe2, 0xef,
+	0x84, 0xd8, 0x77, 0x69, 0x6f, 0x4a, 0x6f, 0x5c, 0x15, 0x85, 0x2f, 0x44, 0x8c, 0x5b, 0xf1, 0xf8,
+	0x71, 0x66, 0x30, 0xa1, 0x9c, 0x19, 0x18, 0x3f, 0x2a, 0xc3, 0x94, 0x90, 0xf0, 0x7f, 0xac, 0x96,
+	0x9c, 0x68, 0xb4, 0x99, 0x38, 0x6d, 0xa3, 0x4d, 0x61, 0xb4, 0x7a, 0x9c, 0x94, 0x49, 0x4f, 0x8e,
+	0x94, 0x49, 0x9f, 0xaa, 0x23, 0xea, 0x2d, 0x98, 0xd9, 0xf3, 0x89, 0x1b, 0xb6, 0x58, 0x02, 0x6e,
+	0x2b, 0xb9, 0xd4, 0x52, 0xcc, 0xc1, 0x05, 0x44, 0x33, 0xca, 0x7a, 0xaa, 0x8a, 0x2a, 0x3e, 0x5d,
+	0x70, 0xb0, 0x46, 0xbb, 0xdb, 0x50, 0x8c, 0x7c, 0x5b, 0xa0, 0xe4, 0x4f, 0x7b, 0xe4, 0x89, 0x14,
+	0xbb, 0x34, 0x6e, 0x8a, 0x3d, 0x73, 0xaa, 0x14, 0xfb, 0x11, 0x54, 0xe2, 0x5d, 0xbf, 0x4f, 0x48,
+	0xd8, 0x34, 0x2d, 0x0b, 0x07, 0x51, 0xc2, 0x3e, 0xcc, 0x49, 0xa3, 0x88, 0xaf, 0x41, 0x48, 0xb8,
+	0xc9, 0xb8, 0x7a, 0xab, 0xab, 0xac, 0x9e, 0x77, 0xdf, 0x83, 0x92, 0xc8, 0xbb, 0xbb, 0x5d, 0xc7,
+	0x56, 0xca, 0xd8, 0x81, 0x33, 0x3c, 0xed, 0x3a, 0x36, 0x3d, 0xf9, 0x8a, 0x4f, 0xe1, 0xb8, 0x22,
+	0x54, 0x0e, 0x99, 0x66, 0x05, 0x8f, 0xd0, 0xc2, 0x3d, 0x98, 0x89, 0x84, 0xb0, 0x04, 0x72, 0xe1,
+	0xc4, 0x04, 0xb2, 0x24, 0xe8, 0x45, 0xfa, 0x99, 0x6c, 0x2e, 0x43, 0xa3, 0x35, 0x97, 0xa5, 0x12,
+	0xdf, 0xc5, 0x71, 0x12, 0xdf, 0xca, 0x48, 0x89, 0x6f, 0x56, 0xef, 0xc3, 0xd2, 0xf8, 0x9d, 0x5a,
+	0xd5, 0xf1, 0x3b, 0xb5, 0x96, 0xcf, 0xa2, 0x53, 0xab, 0x76, 0xb6, 0x9d, 0x5a, 0x2b, 0x63, 0x75,
+	0x6a, 0xa1, 0xfb, 0x50, 0xee, 0x98, 0xae, 0xd9, 0xc2, 0x76, 0x53, 0x38, 0x39, 0x5d, 0x29, 0x77,
+	0xe3, 0x3c, 0x1f, 0x30, 0x5f, 0xf7, 0x35, 0x58, 0x88, 0x64, 0xf4, 0x7c, 0xac, 0xca, 0xb6, 0x60,
+	0x4e, 0xb0, 0xed, 0x44, 0xae, 0x36, 0x81, 0x46, 0xec, 0x2e, 0xd6, 0x46, 0x40, 0xf3, 0x90, 0x6d,
+	0x32, 0x7e, 0x35, 0xdf, 0xeb, 0x26, 0x1d, 0xb1, 0x7f, 0x65, 0x29, 0x0e, 0x4b, 0xa2, 0xbf, 0x84,
+	0x07, 0x9e, 0x57, 0xa4, 0xc0, 0xc3, 0x6b, 0x60, 0x89, 0xd0, 0x52, 0x8d, 0x9d, 0x25, 0xaf, 0x2f,
+	0x8a, 0x5f, 0x94, 0x2d, 0xb1, 0xfc, 0x78, 0x41, 0x3d, 0xb1, 0xc0, 0x2e, 0xa4, 0x22, 0x04, 0x6f,
+	0xc5, 0x95, 0x62, 0xc0, 0x80, 0x1c, 0x63, 0xea, 0x74, 0x39, 0x46, 0xdc, 0xe6, 0x3d, 0x9d, 0xdd,
+	0xe6, 0x5d, 0xec, 0x6b, 0xf3, 0xc6, 0xa6, 0x6f, 0xed, 0x37, 0x9f, 0x11, 0xdf, 0x56, 0x4b, 0xc8,
+	0x39, 0xc3, 0x87, 0xc4, 0xb7, 0x8d, 0x8f, 0xa1, 0xd6, 0x3f, 0x09, 0xaa, 0x3d, 0xbd, 0x6f, 0x42,
+	0x14, 0xc9, 0x12, 0x6d, 0x9a, 0x99, 0xed, 0x9d, 0xd1, 0x74, 0xd2, 0x86, 0xa7, 0x7f, 0xd7, 0x60,
+	0x35, 0x35, 0xe6, 0xd9, 0xd5, 0xa5, 0x13, 0x55, 0xe6, 0x9c, 0x54, 0x65, 0xee, 0xcd, 0x7e, 0x5e,
+	0x9a, 0xfd, 0x58, 0xdb, 0x13, 0xd9, 0xda, 0x2e, 0x0c, 0xd3, 0xf6, 0xe4, 0x88, 0xda, 0xfe, 0x54,
+	0x83, 0xb5, 0xec, 0x47, 0x57, 0x55, 0xf9, 0xf8, 0xed, 0xb1, 0xc6, 0xef, 0xe7, 0xe0, 0x5c, 0x06,
+	0x88, 0x47, 0xa4, 0xf5, 0x9c, 0xa7, 0x60, 0x05, 0xa6, 0xdb, 0xa4, 0xd5, 0x14, 0x67, 0xc1, 0xf4,
+	0xce, 0x54, 0x9b, 0xb4, 0xde, 0xa3, 0x89, 0xea, 0x2b, 0x00, 0xa1, 0xe9, 0xb4, 0xa9, 0x0b, 0xc7,
+	0x81, 0x98, 0x8a, 0x22, 0xbd, 0xf2, 0x88, 0x5e, 0x40, 0xb7, 0x00, 0x02, 0x87, 0x6e, 0xbc, 0x59,
+	0x98, 0x2a, 0x9c, 0x18, 0xa6, 0x8a, 0x8c, 0x9a, 0xfe, 0xa6, 0x49, 0x73, 0xcb, 0xc7, 0x9e, 0xd2,
+	0x54, 0x31, 0x4a, 0xe3, 0x1f, 0x72, 0x50, 0x96, 0xf5, 0x32, 0x46, 0x33, 0xe3, 0x88, 0x7b, 0xc4,
+	0x1b, 0x92, 0x8a, 0x14, 0x0a, 0x1f, 0x91, 0x02, 0xaf, 0xc0, 0x84, 0x67, 0x86, 0xfb, 0x6a, 0x3d,
+	0xe6, 0x94, 0x92, 0x16, 0xb4, 0x2c, 0xe2, 0x86, 0xd8, 0x0d, 0x95, 0x72, 0xf4, 0x88, 0x98, 0x26,
+	0x69, 0xd8, 0xf7, 0x89, 0xaf, 0xa4, 0x51, 0x4e, 0x6a, 0xb8, 0x70, 0x7e, 0xa0, 0xc5, 0x09, 0xcb,
+	0x7f, 0x07, 0x2a, 0x92, 0x61, 0x53, 0x35, 0xf4, 0xba, 0x29, 0xf5, 0x01, 0xc6, 0xfd, 0x88, 0xb4,
+	0x1a, 0x0b, 0x96, 0xf4, 0x9b, 0x9a, 0xf8, 0x2f, 0xc2, 0xe2, 0x4e, 0x48, 0xbc, 0xe7, 0xd3, 0x16,
+	0xf9, 0x08, 0x2a, 0xb2, 0xf0, 0xb1, 0x9a, 0x22, 0x3f, 0xa2, 0xd2, 0x4c, 0x3f, 0x7c, 0x3e, 0x58,
+	0xdf, 0x85, 0xa5, 0x94, 0xf4, 0xb1, 0xc0, 0x7e, 0x13, 0xaa, 0x0d, 0x6c, 0x91, 0x43, 0xec, 0x3f,
+	0x1f, 0xb8, 0xef, 0xc3, 0x72, 0x9f, 0xfc, 0x71, 0xb5, 0xbb, 0x85, 0xcd, 0x00, 0x3f, 0x37, 0xed,
+	0xa6, 0xa4, 0x8f, 0x05, 0xf6, 0x15, 0x58, 0x7d, 0x1b, 0x47, 0x53, 0x45, 0xb7, 0x16, 0x4e, 0x10,
+	0x3a, 0x56, 0x84, 0xd9, 0xf8, 0x69, 0x1e, 0xd6, 0xb2, 0xef, 0x8b, 0x51, 0x03, 0x58, 0x6a, 0x9b,
+	0x41, 0xd8, 0x0c, 0x9f, 0x91, 0xe6, 0x33, 0x8c, 0x0f, 0x9a, 0x3c, 0xd3, 0xb7, 0xc5, 0x1a, 0xfa,
+	0x6a, 0x72, 0x0d, 0x0d, 0x13, 0xb4, 0xfe, 0xc8, 0x0c, 0xc2, 0x27, 0xcf, 0xc8, 0x87, 0x18, 0x1f,
+	0xf0, 0xd7, 0x4b, 0xec, 0x87, 0x6e, 0xe8, 0x1f, 0x35, 0x50, 0xbb, 0xef, 0x06, 0xda, 0x83, 0xf9,
+	0x90, 0x78, 0xcd, 0x10, 0xbb, 0x4d, 0x91, 0x33, 0x05, 0x22, 0x20, 0xdd, 0x55, 0x1e, 0xef, 0x09,
+	0xf1, 0x9e, 0x60, 0xb7, 0x21, 0xd8, 0xf9, 0x58, 0xe5, 0x50, 0xba, 0x88, 0x2e, 0xc6, 0xef, 0x06,
+	0x26, 0x1a, 0xf8, 0x66, 0x1b, 0x33, 0x71, 0x12, 0x4e, 0xa3, 0xe3, 0x45, 0x98, 0x8d, 0x52, 0x39,
+	0x4e, 0xc4, 0x23, 0xc9, 0x8c, 0xb8, 0xc8, 0x88, 0xf4, 0x87, 0xb0, 0x3c, 0xe0, 0x01, 0xd1, 0x3c,
+	0xe4, 0x69, 0xc5, 0x84, 0xfa, 0xf8, 0x62, 0x83, 0xfe, 0x4b, 0xd3, 0x83, 0x43, 0xea, 0xc9, 0xa2,
+	0x77, 0xee, 0xd8, 0x8f, 0xdb, 0xb9, 0x9b, 0x9a, 0xbe, 0x09, 0x8b, 0x19, 0xb8, 0x47, 0x11, 0x61,
+	0xfc, 0x77, 0x22, 0x13, 0x12, 0x52, 0x9e, 0x06, 0x66, 0x0b, 0x27, 0xac, 0x54, 0x7a, 0x5b, 0x30,
+	0x95, 0x99, 0x56, 0xa2, 0xed, 0xb2, 0xb0, 0x22, 0xf6, 0x83, 0xc6, 0xca, 0x67, 0x4e, 0xb8, 0xdf,
+	0xfc, 0xb8, 0x4b, 0x42, 0xb3, 0x96, 0x1f, 0x10, 0x2b, 0x7b, 0x1b, 0xf1, 0x22, 0xa5, 0xfe, 0x39,
+	0x4a, 0x4c, 0x59, 0x79, 0x7b, 0x8c, 0xe2, 0x0b, 0x6f, 0x45, 0x46, 0x4d, 0x7f, 0xa3, 0xeb, 0x30,
+	0x8d, 0x5d, 0x5b, 0x35, 0x3e, 0x4f, 0x61, 0xd7, 0xa6, 0xbf, 0x8c, 0x7f, 0xca, 0xc1, 0x4c, 0xf2,
+	0xc9, 0xc7, 0x7b, 0x41, 0xf2, 0x6a, 0x4f, 0x21, 0xca, 0xe7, 0x07, 0x4a, 0x76, 0xf5, 0x8a, 0xd4,
+	0x3a, 0x2a, 0xd2, 0x93, 0x5e, 0x73, 0xe8, 0x3c, 0x2f, 0x58, 0xf0, 0x54, 0x91, 0xfe, 0x8b, 0xe6,
+	0x79, 0x49, 0x62, 0x92, 0x5f, 0xa1, 0x45, 0x87, 0x6a, 0x5c, 0x74, 0x98, 0xe2, 0x19, 0x25, 0xff,
+	0x45, 0x33, 0x3e, 0x51, 0xab, 0x66, 0x25, 0x01, 0x9e, 0xf3, 0x03, 0xbf, 0xc4, 0xce, 0xfc, 0x53,
+	0x1b, 0xfc, 0xe2, 0x28, 0x1b, 0x7c, 0xe3, 0x07, 0x3d, 0xfd, 0xf2, 0x29, 0x1e, 0x4b, 0xbf, 0x3a,
+	0x4c, 0x47, 0xf5, 0x0c, 0xa6, 0xe2, 0x42, 0x23, 0xfe, 0x1d, 0xe9, 0x20, 0xcf, 0x2e, 0x27, 0x75,
+	0x30, 0xc1, 0xaf, 0xc8, 0x3a, 0x28, 0xb0, 0x8b, 0x91, 0x0e, 0xaa, 0x30, 0xc9, 0x1f, 0x98, 0x29,
+	0xac, 0xd0, 0x10, 0xbf, 0xd2, 0xba, 0x99, 0x62, 0x37, 0x93, 0xba, 0x89, 0x73, 0x91, 0x69, 0xf5,
+	0x5c, 0xe4, 0xef, 0x12, 0x39, 0xb8, 0xbc, 0xe8, 0xe2, 0x37, 0x45, 0x8a, 0xdd, 0x80, 0xd5, 0x68,
+	0xe2, 0xf4, 0xa3, 0x96, 0x74, 0x65, 0x12, 0xd3, 0x34, 0x23, 0xa5, 0x3b, 0xf4, 0xeb, 0x50, 0x64,
+	0x4b, 0x2e, 0x91, 0x92, 0x67, 0xb1, 0xb1, 0x69, 0x68, 0x4c, 0x33, 0x52, 0xca, 0x76, 0x07, 0x66,
+	0x12, 0x7d, 0x4e, 0xd1, 0x8b, 0x6a, 0x83, 0x07, 0x2c, 0x05, 0x71, 0x67, 0x53, 0x68, 0xfc, 0xa7,
+	0x06, 0xfa, 0xc3, 0x20, 0x74, 0x3a, 0xbd, 0xa6, 0xce, 0x2d, 0x12, 0x84, 0x2f, 0xef, 0x3d, 0xc9,
+	0xe8, 0x55, 0xc7, 0xbc, 0xf2, 0xab, 0x8e, 0xb2, 0x41, 0x4e, 0x8c, 0x64, 0x90, 0xc6, 0xbf, 0x69,
+	0x30, 0xdd, 0x20, 0x6d, 0x4c, 0x1f, 0x3a, 0xce, 0xb6, 0x35, 0xe5, 0x6c, 0x5b, 0x5e, 0xd6, 0xb9,
+	0x01, 0xcb, 0x9a, 0x3e, 0x8b, 0xc6, 0x4d, 0xba, 0x2a, 0x75, 0xed, 0x6b, 0xb1, 0x01, 0x0b, 0x53,
+	0x2f, 0x70, 0x4a, 0x61, 0xea, 0x09, 0x93, 0xd6, 0x62, 0x93, 0xbe, 0x04, 0xe5, 0xb8, 0x8a, 0x68,
+	0xb5, 0xcd, 0x20, 0x60, 0x56, 0xad, 0x35, 0xe2, 0xda, 0xe2, 0x16, 0xbd, 0x48, 0x5d, 0x3b, 0xdb,
+	0xf4, 0x31, 0xc3, 0xd6, 0x1a, 0xfc, 0x87, 0xf1, 0xcb, 0x39, 0x58, 0xcd, 0x9c, 0xee, 0x5e, 0x13,
+	0xef, 0xe9, 0x17, 0xf7, 0x4d, 0x98, 0xb6, 0xba, 0xbe, 0x8f, 0x5d, 0xeb, 0x48, 0x69, 0xd6, 0x63,
+	0x6a, 0x74, 0x13, 0x66, 0xd9, 0xe9, 0x9d, 0x45, 0x82, 0xa4, 0x0d, 0x57, 0x24, 0x1b, 0x16, 0xb3,
+	0xd4, 0x28, 0xf9, 0xe2, 0x3f, 0x6a, 0xfc, 0x55, 0x98, 0xdc, 0x27, 0x5d, 0xbf, 0x1d, 0xeb, 0x93,
+	0xff, 0x42, 0x35, 0xd6, 0x6c, 0x17, 0xee, 0xb7, 0x8f, 0x84, 0x4e, 0xa3, 0x9f, 0xc6, 0xdd, 0xbe,
+	0xb3, 0x03, 0x2a, 0x4b, 0x31, 0xaf, 0x33, 0xfe, 0x22, 0x07, 0xa5, 0x04, 0xdb, 0xd8, 0xef, 0x1d,
+	0x25, 0xb4, 0x9d, 0x3b, 0xbd, 0xb6, 0xf3, 0xe3, 0x69, 0x7b, 0x62, 0x74, 0x6d, 0x17, 0x24, 0x6d,
+	0xd7, 0xa1, 0x64, 0x5a, 0x56, 0xb7, 0xd3, 0x6d, 0xb3, 0x6c, 0x91, 0x1b, 0x6c, 0xf2, 0x92, 0x61,
+	0xf6, 0x1d, 0x5b, 0x08, 0xad, 0x0b, 0xc3, 0x4b, 0x9c, 0x4a, 0xc4, 0xb0, 0x06, 0xbf, 0x06, 0xc7,
+	0x90, 0x95, 0xad, 0xde, 0x0f, 0xea, 0xca, 0xfe, 0x32, 0x0f, 0x53, 0xef, 0xf0, 0xb6, 0x05, 0x74,
+	0x57, 0x6e, 0x6a, 0x50, 0x9a, 0x97, 0x5e, 0xcb, 0xc3, 0x8b, 0x2f, 0x93, 0x25, 0x9a, 0x6d, 0x26,
+	0x46, 0x68, 0xb6, 0x89, 0xd3, 0x95, 0x82, 0x7a, 0xba, 0x92, 0xca, 0x06, 0x26, 0xc7, 0x39, 0xee,
+	0x9f, 0x1a, 0xe9, 0xb8, 0x3f, 0x71, 0xac, 0x33, 0x2d, 0xbd, 0x85, 0xf8, 0x37, 0x5a, 0xf4, 0x9a,
+	0xbe, 0x98, 0xbf, 0x68, 0x31, 0x46, 0x13, 0xa1, 0x9d, 0x76, 0x22, 0x72, 0x63, 0x4c, 0x44, 0x5e,
+	0x7d, 0x22, 0x8c, 0xa7, 0xb0, 0x94, 0x7a, 0x00, 0x61, 0xd7, 0x63, 0x19, 0xa2, 0xf1, 0x47, 0x89,
+	0x13, 0x6e, 0x21, 0x39, 0x76, 0x54, 0xff, 0x6f, 0xe2, 0xc3, 0x2a, 0x7a, 0x63, 0x9c, 0xb1, 0xf6,
+	0x0e, 0x74, 0xa7, 0xb2, 0x0f, 0x74, 0xa7, 0x93, 0x07, 0xba, 0x86, 0x0f, 0xb5, 0xfe, 0x29, 0x52,
+	0x3d, 0x8c, 0xbd, 0x0e, 0x33, 0xf1, 0x24, 0x0e, 0x38, 0x00, 0x8f, 0x2c, 0x0a, 0xc4, 0xe4, 0x51,
+	0x57, 0x77, 0x23, 0x7a, 0x6d, 0x37, 0x6d, 0x14, 0xe7, 0xd2, 0x46, 0x21, 0x37, 0x73, 0x19, 0x37,
+	0xa1, 0x9a, 0x66, 0x14, 0x50, 0x4f, 0xe2, 0x7c, 0x0c, 0x4b, 0x9b, 0x61, 0x68, 0x5a, 0xfb, 0x23,
+	0x0e, 0x39, 0xf0, 0x30, 0xd7, 0xd8, 0x80, 0x6a, 0x5a, 0xa2, 0xc0, 0xd2, 0x3b, 0xdd, 0xd0, 0x92,
+	0xa7, 0x1b, 0x8f, 0xe9, 0x53, 0x9f, 0x35, 0x84, 0x07, 0x78, 0x14, 0x08, 0x9f, 0x6a, 0x50, 0xa2,
+	0xa7, 0x84, 0x51, 0x9c, 0x39, 0xe5, 0xb1, 0x6e, 0x6a, 0xed, 0xe6, 0x46, 0xf3, 0x0a, 0x4f, 0xd9,
+	0xfb, 0x90, 0x09, 0x18, 0x89, 0x53, 0xf7, 0x59, 0x06, 0x27, 0x12, 0x9e, 0x15, 0x42, 0x13, 0x7c,
+	0x8d, 0x92, 0xdb, 0xfb, 0x61, 0xac, 0xb0, 0x17, 0x0f, 0x65, 0xb1, 0x5c, 0x1b, 0xc6, 0xcf, 0x47,
+	0x6f, 0x01, 0x9e, 0xf9, 0xa0, 0x6b, 0xd1, 0x2b, 0x79, 0x59, 0xe3, 0x5e, 0xfd, 0xf1, 0xa5, 0xf8,
+	0x20, 0xfd, 0x5d, 0x56, 0xf9, 0xf3, 0xd1, 0xd7, 0x61, 0x2e, 0x85, 0x12, 0x19, 0xc9, 0x91, 0xb2,
+	0x35, 0xa3, 0x5f, 0x1c, 0x4a, 0x23, 0x26, 0xdd, 0x02, 0xd4, 0x0f, 0x06, 0x5d, 0x4a, 0xb2, 0x0e,
+	0x54, 0x83, 0xfe, 0x85, 0x93, 0xc8, 0xc4, 0x20, 0x9f, 0x69, 0x30, 0x2b, 0xc5, 0x0a, 0x54, 0x97,
+	0x32, 0x9c, 0x8c, 0x38, 0xa8, 0x5f, 0x18, 0x42, 0x21, 0xa6, 0xe8, 0xfa, 0xf1, 0xe6, 0x02, 0x9a,
+	0xe3, 0xa1, 0xba, 0x7e, 0x80, 0x8f, 0xea, 0x74, 0x2a, 0x3e, 0xfd, 0xc7, 0x7f, 0xf9, 0x61, 0x6e,
+	0xd5, 0xa8, 0x6e, 0x1c, 0xbe, 0xb1, 0x21, 0xb2, 0xa5, 0x60, 0x23, 0x9a, 0xa7, 0xe0, 0xb6, 0xf6,
+	0x1a, 0xfa, 0x91, 0x06, 0xf3, 0x69, 0xf7, 0x85, 0x2e, 0xca, 0x8f, 0x92, 0x19, 0x7f, 0xf4, 0x57,
+	0x87, 0x13, 0xf5, 0x60, 0x55, 0x10, 0xb2, 0xc5, 0xed, 0x18, 0x58, 0xc0, 0x90, 0xd5, 0xd0, 0x00,
+	0x64, 0xe8, 0xb7, 0x34, 0x28, 0xcb, 0x8e, 0x0a, 0x5d, 0xe8, 0xd7, 0x6f, 0x1a, 0x92, 0x31, 0x8c,
+	0x44, 0x00, 0xfa, 0xf2, 0xf1, 0x26, 0x42, 0xf3, 0xfc, 0x15, 0x9f, 0x14, 0x9c, 0xd5, 0xd7, 0x86,
+	0x28, 0xea, 0x77, 0x34, 0x28, 0xcb, 0xee, 0x4a, 0x46, 0x94, 0xe9, 0x1c, 0x75, 0x63, 0x18, 0x89,
+	0x40, 0x74, 0x97, 0x21, 0x32, 0xd9, 0xcd, 0x14, 0xa2, 0x0b, 0xc6, 0x5a, 0x26, 0xa2, 0x0d, 0x4e,
+	0x1d, 0xe1, 0x7a, 0x80, 0x07, 0xe3, 0x7a, 0x80, 0x4f, 0xc4, 0xf5, 0x00, 0x0f, 0xc1, 0x65, 0xe3,
+	0x51, 0x70, 0xd9, 0x38, 0xc2, 0xf5, 0x7d, 0x0d, 0xe6, 0x52, 0x9f, 0x7a, 0x42, 0x46, 0x96, 0xc9,
+	0xc8, 0xdf, 0x37, 0xd3, 0x2f, 0x0e, 0xa5, 0x11, 0xd0, 0xde, 0x10, 0xd0, 0x84, 0x55, 0xf1, 0x5e,
+	0x04, 0x0e, 0xad, 0x8a, 0x2a, 0x12, 0x34, 0x71, 0x0f, 0xfd, 0x7a, 0xbc, 0xec, 0xa2, 0x4e, 0xba,
+	0x8c, 0x65, 0x27, 0x7f, 0x01, 0x40, 0xbf, 0x30, 0x84, 0xa2, 0x87, 0x64, 0x1e, 0x95, 0xc5, 0xb2,
+	0x13, 0x83, 0x72, 0xdb, 0x36, 0x16, 0x25, 0x1c, 0x9c, 0x84, 0x6a, 0xe6, 0x09, 0xcc, 0x4a, 0x9f,
+	0x1b, 0x91, 0x81, 0x64, 0x7d, 0x01, 0x49, 0xbf, 0x30, 0x84, 0x42, 0xb8, 0x95, 0x6f, 0xc1, 0x42,
+	0xdf, 0x47, 0x4c, 0xd0, 0xab, 0x03, 0xf9, 0x12, 0x5f, 0xd4, 0xd1, 0x2f, 0x9d, 0x40, 0x25, 0x46,
+	0xf8, 0x73, 0x0d, 0x96, 0x07, 0x7c, 0x17, 0x06, 0xbd, 0x36, 0x50, 0x44, 0xdf, 0x77, 0x5d, 0xf4,
+	0x2f, 0x29, 0xd1, 0xf6, 0x8c, 0x70, 0x15, 0xad, 0x74, 0x18, 0x55, 0xa4, 0xdf, 0xba, 0x19, 0xd3,
+	0x65, 0xaa, 0x9a, 0x53, 0x53, 0x55, 0xff, 0xad, 0x06, 0xab, 0x43, 0x3e, 0xed, 0x82, 0xd6, 0x87,
+	0x3e, 0x79, 0x3f, 0xf4, 0x0d, 0x65, 0x7a, 0x01, 0xff, 0xed, 0xe3, 0xcd, 0x3a, 0x3a, 0x97, 0x82,
+	0x4f, 0xe3, 0x5f, 0xfa, 0x19, 0xce, 0x19, 0x2b, 0x19, 0xcf, 0xc0, 0x0a, 0x99, 0xcc, 0xfd, 0x7c,
+	0x08, 0x95, 0xac, 0xaf, 0xc8, 0xa0, 0x2f, 0xa6, 0xe2, 0xda, 0xa0, 0x4f, 0xc0, 0xe8, 0xd5, 0xbe,
+	0xec, 0xe2, 0x21, 0xfd, 0xfa, 0x21, 0xfa, 0x06, 0xdd, 0x61, 0x64, 0x7e, 0x3c, 0x46, 0x9e, 0xd4,
+	0xe1, 0x5f, 0x98, 0x19, 0x28, 0xfe, 0xb3, 0xd8, 0x91, 0x0b, 0xae, 0x4c, 0x47, 0x9e, 0x2a, 0xae,
+	0xe9, 0xc6, 0x30, 0x12, 0xa1, 0xda, 0xab, 0x2c, 0xe0, 0x09, 0x47, 0x1e, 0xe9, 0x2d, 0xd3, 0x1e,
+	0x38, 0x0d, 0xd5, 0xe2, 0xf7, 0x34, 0x28, 0xcb, 0x1f, 0x61, 0x91, 0xd1, 0x64, 0x7e, 0x5b, 0x46,
+	0x37, 0x86, 0x91, 0x08, 0x34, 0xd7, 0x18, 0x1a, 0xd1, 0x93, 0x27, 0x39, 0x82, 0x15, 0x43, 0x76,
+	0x48, 0x82, 0x86, 0xc2, 0xf9, 0x6d, 0x0d, 0xe6, 0x52, 0x1f, 0x42, 0x91, 0x7d, 0x64, 0xf6, 0x47,
+	0x5a, 0xf4, 0x8b, 0x43, 0x69, 0x7a, 0x91, 0x17, 0xa1, 0x79, 0x5f, 0xdc, 0x95, 0x20, 0xe9, 0xc6,
+	0x92, 0x04, 0x29, 0x22, 0xa2, 0x98, 0xa8, 0x9f, 0x94, 0xbe, 0xfc, 0x21, 0xbb, 0xa7, 0xac, 0x2f,
+	0xa5, 0xe8, 0x17, 0x86, 0x50, 0x48, 0x7e, 0xd2, 0x67, 0xf7, 0x86, 0xfa, 0x49, 0x4e, 0x42, 0x91,
+	0xfc, 0x50, 0x63, 0xa9, 0x9e, 0x64, 0x92, 0xe9, 0x54, 0x2f, 0xcb, 0x14, 0x2f, 0x0e, 0xa5, 0x11,
+	0x78, 0x6e, 0x1c, 0x6f, 0x2e, 0xa2, 0x05, 0xd3, 0xb6, 0xa5, 0x55, 0x19, 0x64, 0x26, 0x4c, 0xa6,
+	0x6d, 0xf7, 0x16, 0xe2, 0x1f, 0x6b, 0x51, 0x92, 0x28, 0x01, 0xbb, 0x34, 0xd0, 0x62, 0x25, 0x6c,
+	0x5f, 0x38, 0x89, 0x4c, 0xc0, 0xbb, 0x77, 0xbc, 0x59, 0x45, 0x15, 0xd9, 0xb8, 0x13, 0x08, 0xd3,
+	0xde, 0x82, 0x13, 0xf6, 0x40, 0xfe, 0xae, 0x06, 0xf3, 0xe9, 0x0f, 0x35, 0xc8, 0x59, 0xdd, 0x80,
+	0xcf, 0x4e, 0xe8, 0xaf, 0x0e, 0x27, 0x12, 0xf0, 0x6e, 0xb1, 0xac, 0xae, 0xcb, 0x6e, 0xc7, 0xf0,
+	0xb0, 0x7b, 0xc8, 0xc0, 0xad, 0x5d, 0x5d, 0x4e, 0x19, 0x3c, 0x25, 0x6b, 0x62, 0xf7, 0x90, 0x42,
+	0xfb, 0x6e, 0x22, 0xe1, 0x8c, 0x5d, 0x42, 0x66, 0xd0, 0x4f, 0x3b, 0x85, 0x57, 0x87, 0x13, 0x09,
+	0x68, 0xaf, 0xb1, 0x89, 0x8d, 0x53, 0x03, 0xc9, 0x31, 0x94, 0xd1, 0x4c, 0x12, 0x19, 0xfa, 0x43,
+	0x0d, 0x2a, 0x59, 0xcd, 0x54, 0xb2, 0x57, 0x1d, 0xd2, 0x69, 0xa6, 0x5f, 0x3e, 0x99, 0xb0, 0xb7,
+	0x1c, 0x6b, 0xa8, 0x9a, 0xc6, 0x95, 0x98, 0xd3, 0x0a, 0x42, 0x92, 0xda, 0xd8, 0x1d, 0xf4, 0xb9,
+	0x06, 0xcb, 0x19, 0x72, 0x69, 0xe3, 0x4b, 0xda, 0x3f, 0x0f, 0xeb, 0xc7, 0xd2, 0xbf, 0xa4, 0x44,
+	0x2b, 0xb0, 0x7e, 0xe5, 0x78, 0x73, 0x0d, 0xe9, 0x99, 0x58, 0xeb, 0x6d, 0xd2, 0xe2, 0x78, 0x57,
+	0xd0, 0x72, 0x3f, 0xde, 0x0d, 0x7a, 0x1b, 0xfd, 0x8a, 0x06, 0x33, 0xc9, 0x06, 0x17, 0x24, 0x35,
+	0xad, 0x66, 0xf4, 0xd5, 0xe8, 0xf5, 0xc1, 0x04, 0x02, 0xd3, 0xfa, 0xf1, 0xe6, 0x1c, 0x9a, 0x0d,
+	0x42, 0xe2, 0xc9, 0x73, 0x5a, 0x35, 0x16, 0xe4, 0x7c, 0x2f, 0x24, 0x1e, 0xb5, 0xb3, 0x5f, 0xd3,
+	0x60, 0x56, 0x6a, 0x5c, 0x41, 0xa9, 0x31, 0xfa, 0x3b, 0x66, 0xf4, 0x0b, 0x43, 0x28, 0x04, 0x8c,
+	0x2b, 0xcc, 0x8f, 0xb1, 0xaa, 0xb5, 0x8c, 0x63, 0xd9, 0x40, 0x29, 0x1c, 0xa6, 0x1f, 0x52, 0x20,
+	0x3f, 0xa0, 0x4e, 0x5e, 0x6e, 0x49, 0x49, 0x39, 0xf9, 0xcc, 0x7e, 0x18, 0xfd, 0xe2, 0x50, 0x1a,
+	0x01, 0xe7, 0x4d, 0xee, 0xe4, 0xf9, 0x5d, 0x19, 0x50, 0x3a, 0xee, 0x08, 0xa2, 0x48, 0x37, 0x52,
+	0xdb, 0x49, 0x2a, 0x17, 0xce, 0xe8, 0x77, 0xd1, 0x2f, 0x0c, 0xa1, 0x90, 0x74, 0x63, 0xd1, 0x7b,
+	0xc3, 0x75, 0xc3, 0x48, 0x28, 0x90, 0x3f, 0xd5, 0xa0, 0x92, 0xd5, 0xd7, 0x21, 0x2f, 0xc0, 0x21,
+	0x2d, 0x2d, 0xfa, 0xe5, 0x93, 0x09, 0x05, 0xba, 0xdb, 0x6c, 0x01, 0xb6, 0x70, 0x3c, 0x6f, 0xf5,
+	0x20, 0x26, 0xca, 0x34, 0xe8, 0xde, 0x6d, 0xf4, 0x93, 0x84, 0xa3, 0x90, 0x9a, 0x0d, 0x32, 0x1d,
+	0x45, 0x46, 0x23, 0x86, 0x7e, 0xf9, 0x64, 0x42, 0x81, 0xb3, 0x79, 0xbc, 0x79, 0x1b, 0xdd, 0x8c,
+	0x17, 0x9f, 0x8f, 0x03, 0xd2, 0xf5, 0x2d, 0x5c, 0x67, 0x65, 0xe2, 0x3a, 0xd9, 0x8b, 0x55, 0x5b,
+	0xdf, 0x3d, 0xaa, 0x8b, 0x72, 0x50, 0xdd, 0x74, 0xed, 0x3a, 0x3b, 0xfb, 0xe4, 0x1e, 0x18, 0xe9,
+	0xf2, 0xd4, 0x73, 0xaa, 0x26, 0x13, 0x81, 0xfe, 0x5a, 0x83, 0xc5, 0x8c, 0x1a, 0x20, 0x92, 0x62,
+	0xd3, 0xe0, 0x9a, 0xb0, 0xfe, 0xc5, 0x13, 0xe9, 0xc4, 0x93, 0x3c, 0x3d, 0xde, 0x7c, 0x1d, 0x7d,
+	0x09, 0x0b, 0x8a, 0x3a, 0xad, 0xeb, 0x24, 0xf0, 0xd7, 0x77, 0xf1, 0x1e, 0xf1, 0x71, 0x9d, 0x6d,
+	0x8c, 0x1c, 0xb7, 0x55, 0x77, 0x42, 0x06, 0xfe, 0xbc, 0x21, 0x83, 0x8f, 0xf8, 0x59, 0x5d, 0x88,
+	0x1a, 0xcd, 0x9f, 0xf4, 0x7b, 0x6d, 0x3a, 0xec, 0x70, 0xaf, 0x9d, 0xac, 0xf1, 0xe9, 0x97, 0x4f,
+	0x26, 0x14, 0x8f, 0x70, 0x93, 0x6d, 0x3f, 0x7a, 0x9e, 0x50, 0x7e, 0x84, 0x6c, 0xc7, 0x4d, 0x89,
+	0x82, 0xfb, 0x13, 0x5f, 0xcf, 0x79, 0xbb, 0xbb, 0x93, 0x2c, 0x1d, 0xbe, 0xf6, 0x3f, 0x03, 0x00,
+	0x9f, 0x01, 0x09, 0x8f, 0x96, 0x5c, 0x00, 0x00,
 }
@@ -51,6 +51,11 @@ type DroneServiceClient interface {
 	PingFrontgate(ctx context.Context, in *types.FrontgateEndpoint, opts ...grpc.CallOption) (*types.Empty, error)
 	PingDrone(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
 	RunCommand(ctx context.Context, in *types.RunCommandOnDroneRequest, opts ...grpc.CallOption) (*types.String, error)
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (DroneService_UploadFileClient, error)
+	DownloadFile(ctx context.Context, in *types.DownloadFileRequest, opts ...grpc.CallOption) (DroneService_DownloadFileClient, error)
+	TailLog(ctx context.Context, in *types.TailLogRequest, opts ...grpc.CallOption) (*types.String, error)
+	GetDroneVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.AgentInfo, error)
+	UpgradeDrone(ctx context.Context, in *types.UpgradeAgentRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type droneServiceClient struct {
@@ -196,6 +201,99 @@ func (c *droneServiceClient) RunCommand(ctx context.Context, in *types.RunComman
 	return out, nil
 }
 
+func (c *droneServiceClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (DroneService_UploadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DroneService_serviceDesc.Streams[0], "/metadata.drone.DroneService/UploadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &droneServiceUploadFileClient{stream}
+	return x, nil
+}
+
+type DroneService_UploadFileClient interface {
+	Send(*types.UploadFileRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
+}
+
+type droneServiceUploadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *droneServiceUploadFileClient) Send(m *types.UploadFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *droneServiceUploadFileClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *droneServiceClient) DownloadFile(ctx context.Context, in *types.DownloadFileRequest, opts ...grpc.CallOption) (DroneService_DownloadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DroneService_serviceDesc.Streams[1], "/metadata.drone.DroneService/DownloadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &droneServiceDownloadFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DroneService_DownloadFileClient interface {
+	Recv() (*types.FileChunk, error)
+	grpc.ClientStream
+}
+
+type droneServiceDownloadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *droneServiceDownloadFileClient) Recv() (*types.FileChunk, error) {
+	m := new(types.FileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *droneServiceClient) TailLog(ctx context.Context, in *types.TailLogRequest, opts ...grpc.CallOption) (*types.String, error) {
+	out := new(types.String)
+	err := c.cc.Invoke(ctx, "/metadata.drone.DroneService/TailLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *droneServiceClient) GetDroneVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.AgentInfo, error) {
+	out := new(types.AgentInfo)
+	err := c.cc.Invoke(ctx, "/metadata.drone.DroneService/GetDroneVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *droneServiceClient) UpgradeDrone(ctx context.Context, in *types.UpgradeAgentRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/metadata.drone.DroneService/UpgradeDrone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DroneServiceServer is the server API for DroneService service.
 type DroneServiceServer interface {
 	GetDroneConfig(context.Context, *types.Empty) (*types.DroneConfig, error)
@@ -213,6 +311,11 @@ type DroneServiceServer interface {
 	PingFrontgate(context.Context, *types.FrontgateEndpoint) (*types.Empty, error)
 	PingDrone(context.Context, *types.Empty) (*types.Empty, error)
 	RunCommand(context.Context, *types.RunCommandOnDroneRequest) (*types.String, error)
+	UploadFile(DroneService_UploadFileServer) error
+	DownloadFile(*types.DownloadFileRequest, DroneService_DownloadFileServer) error
+	TailLog(context.Context, *types.TailLogRequest) (*types.String, error)
+	GetDroneVersion(context.Context, *types.Empty) (*types.AgentInfo, error)
+	UpgradeDrone(context.Context, *types.UpgradeAgentRequest) (*types.Empty, error)
 }
 
 func RegisterDroneServiceServer(s *grpc.Server, srv DroneServiceServer) {
@@ -489,6 +592,107 @@ func _DroneService_RunCommand_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DroneService_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DroneServiceServer).UploadFile(&droneServiceUploadFileServer{stream})
+}
+
+type DroneService_UploadFileServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*types.UploadFileRequest, error)
+	grpc.ServerStream
+}
+
+type droneServiceUploadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *droneServiceUploadFileServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *droneServiceUploadFileServer) Recv() (*types.UploadFileRequest, error) {
+	m := new(types.UploadFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DroneService_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.DownloadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DroneServiceServer).DownloadFile(m, &droneServiceDownloadFileServer{stream})
+}
+
+type DroneService_DownloadFileServer interface {
+	Send(*types.FileChunk) error
+	grpc.ServerStream
+}
+
+type droneServiceDownloadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *droneServiceDownloadFileServer) Send(m *types.FileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DroneService_TailLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.TailLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DroneServiceServer).TailLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.drone.DroneService/TailLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DroneServiceServer).TailLog(ctx, req.(*types.TailLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DroneService_GetDroneVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DroneServiceServer).GetDroneVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.drone.DroneService/GetDroneVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DroneServiceServer).GetDroneVersion(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DroneService_UpgradeDrone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.UpgradeAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DroneServiceServer).UpgradeDrone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.drone.DroneService/UpgradeDrone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DroneServiceServer).UpgradeDrone(ctx, req.(*types.UpgradeAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DroneService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metadata.drone.DroneService",
 	HandlerType: (*DroneServiceServer)(nil),
@@ -553,38 +757,68 @@ var _DroneService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunCommand",
 			Handler:    _DroneService_RunCommand_Handler,
 		},
+		{
+			MethodName: "TailLog",
+			Handler:    _DroneService_TailLog_Handler,
+		},
+		{
+			MethodName: "GetDroneVersion",
+			Handler:    _DroneService_GetDroneVersion_Handler,
+		},
+		{
+			MethodName: "UpgradeDrone",
+			Handler:    _DroneService_UpgradeDrone_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFile",
+			Handler:       _DroneService_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _DroneService_DownloadFile_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "metadata/drone/drone.proto",
 }
 
-func init() { proto.RegisterFile("metadata/drone/drone.proto", fileDescriptor_drone_28bf75ca0199208f) }
-
-var fileDescriptor_drone_28bf75ca0199208f = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6b, 0xdb, 0x30,
-	0x14, 0xbf, 0x8d, 0xe5, 0xb1, 0x99, 0x21, 0xb6, 0xc1, 0x34, 0xd8, 0xd8, 0x6d, 0x27, 0x1b, 0x36,
-	0x18, 0xcb, 0x46, 0x2f, 0x49, 0x1c, 0x13, 0x48, 0xdb, 0x10, 0x97, 0x1e, 0x7a, 0x53, 0xe2, 0x17,
-	0x23, 0x6a, 0x4b, 0xaa, 0xfd, 0x5c, 0x9a, 0x6f, 0xd3, 0x8f, 0x5a, 0x62, 0xe7, 0x8f, 0xe3, 0x44,
-	0x2e, 0x69, 0x2f, 0x32, 0xfe, 0xfd, 0xd3, 0x4f, 0xcf, 0x58, 0xc0, 0x53, 0x24, 0x11, 0x09, 0x12,
-	0x5e, 0x94, 0x69, 0x85, 0xd5, 0xea, 0x9a, 0x4c, 0x93, 0x66, 0xce, 0x86, 0x73, 0x4b, 0x94, 0xef,
-	0xb4, 0xb4, 0x34, 0x98, 0x57, 0x6b, 0xa5, 0x3d, 0xe0, 0xe6, 0x5a, 0x2d, 0x22, 0x0b, 0x57, 0xdb,
-	0x83, 0x7f, 0x6b, 0x70, 0x8b, 0x4c, 0x2b, 0x8a, 0x05, 0xad, 0xf9, 0x5f, 0x8f, 0x6f, 0xe1, 0xdd,
-	0x60, 0xa5, 0x0f, 0x31, 0xbb, 0x97, 0x73, 0x64, 0x03, 0x70, 0x02, 0xa4, 0x12, 0xea, 0x6b, 0xb5,
-	0x90, 0x31, 0xfb, 0xe4, 0x6e, 0x7b, 0x56, 0x8d, 0xfc, 0xd4, 0xd0, 0x92, 0x7f, 0x6d, 0xc2, 0x75,
-	0xcf, 0x00, 0x9c, 0x70, 0x3f, 0xa5, 0x4d, 0xce, 0x8f, 0x6f, 0xb1, 0xee, 0xb2, 0xd2, 0x44, 0x27,
-	0x76, 0xa9, 0x7b, 0xaa, 0x2e, 0x75, 0xa4, 0x4d, 0x6e, 0xeb, 0x32, 0x06, 0x16, 0x20, 0x0d, 0x37,
-	0xe3, 0x6b, 0xef, 0xf3, 0xbd, 0x09, 0x37, 0x7d, 0x63, 0x60, 0xe1, 0x61, 0xda, 0x73, 0x36, 0x5b,
-	0xb7, 0x33, 0x70, 0x46, 0x79, 0x79, 0x86, 0x69, 0xa1, 0x94, 0x54, 0xd6, 0x5e, 0x1f, 0x9b, 0x70,
-	0x4f, 0xeb, 0x84, 0xfd, 0x03, 0x08, 0x49, 0x64, 0xd5, 0x88, 0x6c, 0x56, 0xcb, 0xd6, 0x5d, 0xe8,
-	0x84, 0xa4, 0xcd, 0x4b, 0xac, 0x3e, 0x7c, 0x08, 0x90, 0xae, 0x30, 0x35, 0x89, 0x20, 0x1c, 0xca,
-	0x04, 0x73, 0x5b, 0x02, 0x6f, 0xc2, 0x21, 0x65, 0x52, 0xc5, 0x63, 0x99, 0x13, 0xeb, 0x41, 0x27,
-	0x40, 0xba, 0x16, 0x49, 0x81, 0x39, 0x6b, 0x11, 0xf2, 0x2f, 0xc7, 0xb9, 0x73, 0x61, 0x98, 0x0f,
-	0x9d, 0x89, 0x54, 0xf1, 0x44, 0x26, 0x9a, 0xd8, 0x0f, 0xeb, 0x57, 0xf0, 0x55, 0x64, 0xb4, 0x54,
-	0x64, 0x3b, 0xd1, 0x08, 0xde, 0xaf, 0x62, 0xb6, 0xfa, 0x57, 0x44, 0x75, 0xab, 0x46, 0xe5, 0x4f,
-	0x72, 0xe2, 0x5c, 0x2f, 0x00, 0xa6, 0x85, 0xea, 0xeb, 0x34, 0x15, 0x2a, 0x62, 0x3f, 0x9b, 0xa2,
-	0x1d, 0x77, 0xa9, 0xca, 0xf8, 0x29, 0xde, 0x15, 0x98, 0x13, 0xff, 0x7c, 0x7c, 0x3e, 0xbd, 0xbf,
-	0x37, 0x7f, 0xb4, 0x41, 0x65, 0x24, 0x65, 0xf2, 0xc1, 0x95, 0xda, 0xdb, 0xbd, 0x79, 0xe6, 0x36,
-	0xf6, 0xcc, 0xcc, 0xdb, 0xbf, 0xe4, 0xfe, 0x9b, 0x59, 0xf9, 0x9c, 0xbd, 0x29, 0xef, 0x98, 0xdf,
-	0x4f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x5a, 0xf3, 0x09, 0x05, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("metadata/drone/drone.proto", fileDescriptor_drone_53ed6ca26d5f1766) }
+
+var fileDescriptor_drone_53ed6ca26d5f1766 = []byte{
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x55, 0x2f, 0xa0, 0x0c, 0x21, 0xa0, 0x15, 0x20, 0x61, 0xa4, 0x22, 0xd4, 0x4b, 0x4f, 0x31,
+	0x02, 0x09, 0x51, 0x10, 0x87, 0xe6, 0xa3, 0x21, 0x28, 0x40, 0x14, 0xb7, 0x3d, 0x70, 0xdb, 0xd4,
+	0x1b, 0xb3, 0xaa, 0x3d, 0xb3, 0xd8, 0x63, 0xa0, 0xff, 0x82, 0x9f, 0x8c, 0x6c, 0xc7, 0x89, 0x6b,
+	0x67, 0x5d, 0x15, 0x2e, 0x6b, 0x79, 0xde, 0x9b, 0xb7, 0x6f, 0x66, 0xe4, 0x31, 0x38, 0x91, 0x62,
+	0xe9, 0x4b, 0x96, 0xae, 0x1f, 0x13, 0xaa, 0xe2, 0xec, 0x9b, 0x98, 0x98, 0x44, 0xaf, 0xc4, 0xfa,
+	0x79, 0xd4, 0xd9, 0x72, 0xf9, 0xca, 0xa8, 0xa4, 0x38, 0x0b, 0x6e, 0x03, 0x93, 0x81, 0x42, 0xb6,
+	0x60, 0x17, 0x84, 0x2b, 0xdf, 0x82, 0x55, 0xee, 0x77, 0xf6, 0x6b, 0xd8, 0x2a, 0x26, 0xe4, 0x40,
+	0xf2, 0x1a, 0x7f, 0xf5, 0xe7, 0x1e, 0x74, 0x47, 0x19, 0xdf, 0x53, 0xf1, 0x4f, 0x7d, 0xa1, 0xc4,
+	0x08, 0x7a, 0x13, 0xc5, 0x79, 0x68, 0x48, 0xb8, 0xd2, 0x81, 0x78, 0xdc, 0xdf, 0xd4, 0x50, 0xb8,
+	0x1d, 0x47, 0x86, 0xaf, 0x9c, 0x67, 0xf5, 0x70, 0x35, 0x67, 0x04, 0x3d, 0xef, 0xba, 0x4a, 0x1b,
+	0xdd, 0xd9, 0x7d, 0xc5, 0xda, 0x4b, 0xc6, 0xf1, 0x6f, 0xe9, 0xa5, 0x9a, 0x53, 0x78, 0xa9, 0x46,
+	0xda, 0xe8, 0x36, 0x2f, 0x33, 0x10, 0x13, 0xc5, 0x27, 0x65, 0xfb, 0xda, 0xfd, 0x3c, 0xaf, 0x87,
+	0xeb, 0x79, 0x33, 0x10, 0x5e, 0x53, 0xed, 0xa6, 0x34, 0x9b, 0xb7, 0x0f, 0xd0, 0x9b, 0x26, 0x79,
+	0x0d, 0x8b, 0x14, 0x51, 0xa3, 0xd5, 0xd7, 0xa3, 0x7a, 0x78, 0x40, 0x14, 0x8a, 0x77, 0x00, 0x1e,
+	0xcb, 0xb8, 0x68, 0x91, 0x2d, 0xd5, 0x72, 0xf5, 0x11, 0x74, 0x3c, 0x26, 0xf3, 0x2f, 0xa9, 0x63,
+	0x78, 0x38, 0x51, 0x7c, 0xaa, 0x22, 0x13, 0x4a, 0x56, 0x27, 0x3a, 0x54, 0x89, 0x4d, 0xc1, 0xa9,
+	0x87, 0x3d, 0x8e, 0x35, 0x06, 0x33, 0x9d, 0xb0, 0x18, 0x40, 0x67, 0xa2, 0xf8, 0x5c, 0x86, 0xa9,
+	0x4a, 0x44, 0x0b, 0xd1, 0x79, 0xba, 0x1b, 0xfb, 0x2c, 0x8d, 0x18, 0x43, 0x67, 0xae, 0x31, 0x98,
+	0xeb, 0x90, 0x58, 0xbc, 0xb0, 0x4e, 0x61, 0x8c, 0xbe, 0x21, 0x8d, 0x6c, 0xab, 0x68, 0x0a, 0xf7,
+	0x33, 0x99, 0x0d, 0xff, 0x3f, 0xa4, 0x8e, 0x0a, 0x47, 0xf9, 0x47, 0x72, 0xcb, 0xbe, 0x7e, 0x01,
+	0x58, 0xa4, 0x38, 0xa4, 0x28, 0x92, 0xe8, 0x8b, 0xc3, 0x3a, 0x69, 0x8b, 0x7d, 0xc5, 0x5c, 0x7e,
+	0xa1, 0x7e, 0xa4, 0x2a, 0x61, 0xe7, 0xc9, 0xee, 0xfe, 0x88, 0x8f, 0x00, 0x67, 0x26, 0x24, 0xe9,
+	0x67, 0x23, 0x6a, 0x96, 0xb4, 0xc5, 0x4a, 0xa1, 0xdd, 0xbe, 0x0e, 0xf7, 0xc4, 0x1c, 0xba, 0x23,
+	0xfa, 0x85, 0x1b, 0xad, 0x83, 0xc6, 0x4e, 0xa8, 0xa0, 0xa5, 0x5a, 0x63, 0x6c, 0x19, 0x38, 0xfc,
+	0x9e, 0xe2, 0xe5, 0xcb, 0x3d, 0x71, 0x0c, 0x77, 0x4f, 0xa5, 0x0e, 0x67, 0x14, 0x88, 0xfd, 0x3a,
+	0x6f, 0x0d, 0xdc, 0x54, 0xde, 0x10, 0x1e, 0x94, 0x0b, 0xef, 0x5c, 0xc5, 0x89, 0x26, 0xb4, 0xf5,
+	0xbb, 0xe1, 0xe4, 0x38, 0x5b, 0xd0, 0x53, 0x5c, 0x91, 0xf8, 0x04, 0xdd, 0x33, 0x13, 0xc4, 0xd2,
+	0x57, 0xc5, 0xc4, 0x0e, 0x9a, 0x5d, 0xca, 0xd1, 0x3c, 0xa3, 0xbd, 0x4f, 0x83, 0xb7, 0xdf, 0xde,
+	0x90, 0x51, 0x68, 0x34, 0xc7, 0xfa, 0x77, 0x5f, 0x93, 0xbb, 0x7d, 0x73, 0xcd, 0x65, 0xe0, 0x9a,
+	0xa5, 0x7b, 0xfd, 0x87, 0xf3, 0xde, 0x2c, 0xf3, 0xe7, 0xf2, 0x4e, 0xbe, 0xd3, 0x5f, 0xff, 0x1d,
+	0x00, 0x6e, 0x57, 0x31, 0x5b, 0x91, 0x06, 0x00, 0x00,
 }
@@ -46,7 +46,7 @@ func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_frontgate_1c059706beae9b27, []int{0}
+	return fileDescriptor_frontgate_e75f3229f9e87416, []int{0}
 }
 func (m *Config) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Config.Unmarshal(m, b)
@@ -145,7 +145,16 @@ type FrontgateService interface {
 	PingDrone(in *types.DroneEndpoint, out *types.Empty) error
 	RunCommand(in *types.RunCommandOnFrontgateRequest, out *types.String) error
 	RunCommandOnDrone(in *types.RunCommandOnDroneRequest, out *types.String) error
-	HeartBeat(in *types.Empty, out *types.Empty) error
+	UploadFileToDrone(in *types.UploadFileRequest, out *types.Empty) error
+	DownloadFileFromDrone(in *types.DownloadFileRequest, out *types.FileChunk) error
+	AbortFileTransfer(in *types.String, out *types.Empty) error
+	TailLogOnDrone(in *types.TailLogRequest, out *types.String) error
+	UploadFile(in *types.UploadFileRequest, out *types.Empty) error
+	UpgradeFrontgate(in *types.UpgradeAgentRequest, out *types.Empty) error
+	ReportDroneInfo(in *types.AgentInfo, out *types.Empty) error
+	GetDroneVersion(in *types.DroneEndpoint, out *types.AgentInfo) error
+	UpgradeDrone(in *types.UpgradeDroneRequest, out *types.Empty) error
+	HeartBeat(in *types.Empty, out *types.AgentInfoList) error
 }
 
 // AcceptFrontgateServiceClient accepts connections on the listener and serves requests
@@ -1101,9 +1110,289 @@ func (c *FrontgateServiceClient) AsyncRunCommandOnDrone(in *types.RunCommandOnDr
 	)
 }
 
-func (c *FrontgateServiceClient) HeartBeat(in *types.Empty) (out *types.Empty, err error) {
+func (c *FrontgateServiceClient) UploadFileToDrone(in *types.UploadFileRequest) (out *types.Empty, err error) {
 	if in == nil {
-		in = new(types.Empty)
+		in = new(types.UploadFileRequest)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.UploadFileToDrone", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncUploadFileToDrone(in *types.UploadFileRequest, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.UploadFileRequest)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.UploadFileToDrone",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) DownloadFileFromDrone(in *types.DownloadFileRequest) (out *types.FileChunk, err error) {
+	if in == nil {
+		in = new(types.DownloadFileRequest)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.FileChunk)
+	if err = c.Call("metadata.frontgate.FrontgateService.DownloadFileFromDrone", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncDownloadFileFromDrone(in *types.DownloadFileRequest, out *types.FileChunk, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.DownloadFileRequest)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.DownloadFileFromDrone",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) AbortFileTransfer(in *types.String) (out *types.Empty, err error) {
+	if in == nil {
+		in = new(types.String)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.AbortFileTransfer", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncAbortFileTransfer(in *types.String, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.String)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.AbortFileTransfer",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) TailLogOnDrone(in *types.TailLogRequest) (out *types.String, err error) {
+	if in == nil {
+		in = new(types.TailLogRequest)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.String)
+	if err = c.Call("metadata.frontgate.FrontgateService.TailLogOnDrone", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncTailLogOnDrone(in *types.TailLogRequest, out *types.String, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.TailLogRequest)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.TailLogOnDrone",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) UploadFile(in *types.UploadFileRequest) (out *types.Empty, err error) {
+	if in == nil {
+		in = new(types.UploadFileRequest)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.UploadFile", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncUploadFile(in *types.UploadFileRequest, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.UploadFileRequest)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.UploadFile",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) UpgradeFrontgate(in *types.UpgradeAgentRequest) (out *types.Empty, err error) {
+	if in == nil {
+		in = new(types.UpgradeAgentRequest)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.UpgradeFrontgate", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncUpgradeFrontgate(in *types.UpgradeAgentRequest, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.UpgradeAgentRequest)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.UpgradeFrontgate",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) ReportDroneInfo(in *types.AgentInfo) (out *types.Empty, err error) {
+	if in == nil {
+		in = new(types.AgentInfo)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.ReportDroneInfo", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncReportDroneInfo(in *types.AgentInfo, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.AgentInfo)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.ReportDroneInfo",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) GetDroneVersion(in *types.DroneEndpoint) (out *types.AgentInfo, err error) {
+	if in == nil {
+		in = new(types.DroneEndpoint)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.AgentInfo)
+	if err = c.Call("metadata.frontgate.FrontgateService.GetDroneVersion", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncGetDroneVersion(in *types.DroneEndpoint, out *types.AgentInfo, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.DroneEndpoint)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.GetDroneVersion",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) UpgradeDrone(in *types.UpgradeDroneRequest) (out *types.Empty, err error) {
+	if in == nil {
+		in = new(types.UpgradeDroneRequest)
 	}
 	type Validator interface {
 		Validate() error
@@ -1114,6 +1403,41 @@ func (c *FrontgateServiceClient) HeartBeat(in *types.Empty) (out *types.Empty, e
 		}
 	}
 	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.UpgradeDrone", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncUpgradeDrone(in *types.UpgradeDroneRequest, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.UpgradeDroneRequest)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.UpgradeDrone",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) HeartBeat(in *types.Empty) (out *types.AgentInfoList, err error) {
+	if in == nil {
+		in = new(types.Empty)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.AgentInfoList)
 	if err = c.Call("metadata.frontgate.FrontgateService.HeartBeat", in, out); err != nil {
 		return nil, err
 	}
@@ -1125,7 +1449,7 @@ func (c *FrontgateServiceClient) HeartBeat(in *types.Empty) (out *types.Empty, e
 	return out, nil
 }
 
-func (c *FrontgateServiceClient) AsyncHeartBeat(in *types.Empty, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+func (c *FrontgateServiceClient) AsyncHeartBeat(in *types.Empty, out *types.AgentInfoList, done chan *rpc.Call) *rpc.Call {
 	if in == nil {
 		in = new(types.Empty)
 	}
@@ -1210,55 +1534,65 @@ func dialFrontgateServicePath(network, address, path string, conn net.Conn) (*Fr
 }
 
 func init() {
-	proto.RegisterFile("metadata/frontgate/frontgate.proto", fileDescriptor_frontgate_1c059706beae9b27)
-}
-
-var fileDescriptor_frontgate_1c059706beae9b27 = []byte{
-	// 731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xed, 0x4e, 0x1a, 0x4f,
-	0x14, 0xc6, 0x03, 0xff, 0xbf, 0xb6, 0x1c, 0x84, 0xea, 0xb4, 0x1a, 0xdc, 0xc6, 0x6a, 0x6d, 0x4c,
-	0x48, 0xd3, 0x40, 0x62, 0x3f, 0x19, 0x53, 0x53, 0x41, 0x45, 0x5b, 0xb5, 0x64, 0xb7, 0x2f, 0x49,
-	0xbf, 0x90, 0x85, 0x1d, 0xe8, 0x44, 0x98, 0xd9, 0xee, 0x1e, 0x1a, 0xbd, 0x91, 0x5e, 0x49, 0x2f,
-	0xb0, 0x99, 0xd9, 0x17, 0x60, 0x97, 0x61, 0x6b, 0xfc, 0x62, 0x96, 0x79, 0xce, 0xf3, 0x9b, 0x67,
-	0xce, 0xac, 0x27, 0x0b, 0xbb, 0x23, 0x8a, 0xb6, 0x63, 0xa3, 0x5d, 0xef, 0x7b, 0x82, 0xe3, 0xc0,
-	0x46, 0x3a, 0x79, 0xaa, 0xb9, 0x9e, 0x40, 0x41, 0x48, 0x54, 0x53, 0x8b, 0x15, 0xc3, 0x88, 0x7d,
-	0x78, 0xe7, 0x52, 0x3f, 0xf8, 0x1b, 0xd4, 0x1b, 0x9b, 0x09, 0x8d, 0x62, 0xcf, 0x09, 0xa5, 0xa4,
-	0xad, 0x27, 0x78, 0x5f, 0xa7, 0x39, 0x9e, 0xe0, 0x61, 0x04, 0xe3, 0x45, 0x42, 0x4b, 0x44, 0x4c,
-	0x79, 0x5d, 0x36, 0x14, 0xa8, 0x89, 0x83, 0xb6, 0x7f, 0x13, 0x48, 0xbb, 0x7f, 0xf2, 0xb0, 0xdc,
-	0x14, 0xbc, 0xcf, 0x06, 0xa4, 0x0c, 0x79, 0xe6, 0x54, 0x72, 0x3b, 0xb9, 0x6a, 0xc1, 0xcc, 0x33,
-	0x87, 0x6c, 0x43, 0x71, 0xc8, 0x7c, 0xa4, 0xbc, 0xe3, 0x0a, 0x0f, 0x2b, 0xf9, 0x9d, 0x5c, 0x75,
-	0xc9, 0x84, 0x60, 0xa9, 0x2d, 0x3c, 0x24, 0x5b, 0x00, 0x6a, 0x97, 0xce, 0x0f, 0xe1, 0x63, 0xe5,
-	0x3f, 0x65, 0x2c, 0xa8, 0x95, 0x73, 0xe1, 0x4f, 0xc9, 0xca, 0xfe, 0xbf, 0xb2, 0x07, 0xb2, 0x72,
-	0x1f, 0x41, 0x81, 0x0b, 0x87, 0x76, 0x24, 0xb0, 0xb2, 0xb4, 0x93, 0xab, 0x16, 0xf7, 0x5f, 0xd6,
-	0xe2, 0x3e, 0x07, 0xdd, 0x3c, 0x8b, 0x0e, 0x79, 0xca, 0x1d, 0x57, 0x30, 0x8e, 0xe6, 0x63, 0xe9,
-	0xb9, 0x64, 0x3e, 0x92, 0x43, 0x28, 0xca, 0xb6, 0x76, 0x7a, 0x2a, 0x7d, 0x65, 0x59, 0x11, 0x8c,
-	0x24, 0xe1, 0x14, 0x7b, 0x4e, 0x70, 0x3e, 0x13, 0x68, 0xfc, 0x4c, 0x8e, 0x60, 0x45, 0x35, 0x3e,
-	0x72, 0x3f, 0x52, 0xee, 0xe7, 0x49, 0xb7, 0xac, 0x8e, 0xec, 0xc5, 0xde, 0xe4, 0xc7, 0xfe, 0xef,
-	0x27, 0xb0, 0x1a, 0x87, 0xb3, 0xa8, 0xf7, 0x8b, 0xf5, 0x28, 0x39, 0x81, 0x72, 0x8b, 0x62, 0x5b,
-	0x9e, 0x30, 0xdc, 0x66, 0x3d, 0x15, 0x67, 0xe4, 0xe2, 0x9d, 0x91, 0xda, 0x67, 0xda, 0x73, 0x09,
-	0xa4, 0x45, 0x31, 0x86, 0x2f, 0x26, 0x6d, 0x6b, 0x3b, 0x36, 0xa1, 0x59, 0x69, 0x5a, 0x96, 0xcd,
-	0x98, 0xbf, 0x1d, 0x69, 0xc3, 0xc6, 0x34, 0xed, 0x5a, 0x38, 0x0f, 0x25, 0x36, 0x60, 0xa5, 0x45,
-	0xf1, 0x44, 0xbe, 0xe8, 0xea, 0x56, 0xff, 0xb5, 0x63, 0xca, 0x71, 0xe1, 0x28, 0xcf, 0xa5, 0xea,
-	0xbb, 0x5a, 0x09, 0xd3, 0x6c, 0xcd, 0x2d, 0x8f, 0x5e, 0x22, 0x0d, 0x2d, 0xf4, 0x5e, 0x43, 0xd9,
-	0x9a, 0xa5, 0xed, 0x25, 0xcb, 0x67, 0x75, 0x93, 0xfe, 0x1c, 0x53, 0x1f, 0x75, 0x27, 0x0c, 0xd2,
-	0x4d, 0xbd, 0x49, 0xe9, 0x74, 0x4a, 0xd4, 0xa7, 0x9b, 0xf6, 0x9e, 0x42, 0xf9, 0xc2, 0x57, 0x0b,
-	0xe6, 0x98, 0x73, 0xc6, 0x33, 0x69, 0xcf, 0x92, 0x72, 0x43, 0x88, 0x21, 0x69, 0x00, 0x58, 0x68,
-	0x7b, 0x41, 0xac, 0x2c, 0x84, 0xe6, 0x60, 0xc7, 0x50, 0xb0, 0x50, 0xb8, 0x0f, 0x41, 0x58, 0xb0,
-	0x6a, 0xd2, 0x81, 0x9c, 0x28, 0xde, 0x55, 0xa8, 0x93, 0x6a, 0xaa, 0xdb, 0xe3, 0xee, 0x67, 0xdb,
-	0xbf, 0xe9, 0x24, 0x2b, 0x75, 0xd0, 0x6f, 0x40, 0x4e, 0xa8, 0x97, 0xc4, 0xbe, 0xd6, 0x61, 0xd3,
-	0xb5, 0x3a, 0xf0, 0x05, 0x14, 0xa3, 0x0c, 0xcd, 0x91, 0x43, 0x5e, 0x65, 0x05, 0x6d, 0x8e, 0x1c,
-	0x1d, 0xea, 0x0a, 0x4a, 0x93, 0x7d, 0x25, 0x6c, 0x2f, 0x3b, 0xde, 0x02, 0xdc, 0x47, 0x78, 0x6a,
-	0x52, 0x39, 0x66, 0x43, 0x97, 0x85, 0x36, 0x8e, 0xfd, 0xf4, 0xa5, 0xcc, 0xc8, 0x3a, 0xd8, 0x07,
-	0x58, 0x6f, 0x51, 0x94, 0x83, 0xf3, 0xab, 0x3d, 0x1c, 0x53, 0xbf, 0x71, 0xd7, 0xf6, 0x68, 0x9f,
-	0xdd, 0x92, 0x8d, 0x14, 0x0e, 0x3d, 0xc6, 0x07, 0xc6, 0xe6, 0xfc, 0xf5, 0x2b, 0xdb, 0x25, 0x67,
-	0x50, 0x9a, 0x61, 0x11, 0x63, 0x7e, 0xad, 0xfc, 0x3f, 0x5e, 0xc4, 0x39, 0x86, 0x92, 0x35, 0xc3,
-	0xd1, 0xd7, 0xea, 0x8e, 0x75, 0x00, 0x85, 0x36, 0xe3, 0x03, 0x35, 0x6a, 0x75, 0x63, 0x46, 0x63,
-	0x7d, 0x07, 0x25, 0x69, 0x8d, 0x47, 0xda, 0x3d, 0xed, 0xc7, 0xb0, 0x36, 0x63, 0x97, 0x63, 0xf3,
-	0xde, 0x08, 0x15, 0x5e, 0x4d, 0x9d, 0xac, 0xe9, 0xa6, 0x41, 0x98, 0x00, 0xe6, 0x98, 0x37, 0xc5,
-	0x68, 0x64, 0x73, 0x87, 0xbc, 0x49, 0x16, 0x4d, 0xb4, 0x4f, 0x3c, 0x4e, 0x1a, 0x8d, 0x36, 0xcd,
-	0xcd, 0x93, 0x2f, 0xb0, 0x36, 0xed, 0x0b, 0xe2, 0x55, 0x17, 0xa1, 0x55, 0x49, 0x16, 0xf6, 0x00,
-	0x0a, 0xe7, 0xd4, 0xf6, 0xb0, 0x41, 0xed, 0x7b, 0x5e, 0x55, 0xe3, 0xfd, 0xf7, 0x23, 0xe1, 0x52,
-	0xee, 0x32, 0xf4, 0xd8, 0x6d, 0x8d, 0x89, 0xfa, 0xe4, 0x57, 0xdd, 0xbd, 0x19, 0xd4, 0xdd, 0x6e,
-	0x3d, 0xfd, 0xbd, 0x77, 0xe8, 0x76, 0xe3, 0xe7, 0xee, 0xb2, 0xfa, 0x30, 0x7a, 0xfb, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0x82, 0x27, 0xfe, 0x01, 0x18, 0x0a, 0x00, 0x00,
+	proto.RegisterFile("metadata/frontgate/frontgate.proto", fileDescriptor_frontgate_e75f3229f9e87416)
+}
+
+var fileDescriptor_frontgate_e75f3229f9e87416 = []byte{
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x7f, 0x4f, 0x1b, 0x37,
+	0x18, 0xc7, 0x05, 0x5b, 0xd9, 0xf2, 0x04, 0x52, 0xf0, 0x4a, 0x95, 0xde, 0x44, 0xcb, 0x5a, 0x55,
+	0x8a, 0xa6, 0x89, 0x48, 0xdd, 0x5f, 0x53, 0x35, 0xb4, 0x24, 0x10, 0xa0, 0x03, 0x16, 0xdd, 0x41,
+	0x2b, 0xed, 0x9f, 0xc8, 0xc9, 0x39, 0x57, 0x8b, 0xc4, 0xf6, 0x7c, 0xce, 0x56, 0xde, 0xd7, 0x5e,
+	0xc5, 0x5e, 0xd5, 0x64, 0xdf, 0xcf, 0x9c, 0x31, 0xd7, 0x8a, 0x7f, 0x50, 0x72, 0xdf, 0xe7, 0xfb,
+	0xf1, 0xd7, 0xcf, 0xf9, 0x9e, 0x1c, 0xf0, 0x72, 0x41, 0x14, 0x0e, 0xb1, 0xc2, 0xdd, 0x99, 0xe4,
+	0x4c, 0x45, 0x58, 0x91, 0xe2, 0xd3, 0x81, 0x90, 0x5c, 0x71, 0x84, 0xb2, 0x9a, 0x83, 0x5c, 0xf1,
+	0xbc, 0xdc, 0xa7, 0x6e, 0x05, 0x89, 0x93, 0xbf, 0x49, 0xbd, 0xa5, 0xe1, 0x88, 0x30, 0x95, 0x6a,
+	0xcf, 0x2a, 0x1a, 0x51, 0xd3, 0xd0, 0x61, 0x9b, 0x72, 0x36, 0x73, 0x69, 0xa1, 0xe4, 0x2c, 0x8d,
+	0xe7, 0x3d, 0xaf, 0x68, 0x95, 0xf8, 0x96, 0x57, 0xd0, 0x39, 0x77, 0xc5, 0x51, 0x38, 0xbe, 0x49,
+	0xa4, 0x97, 0xff, 0xae, 0xc3, 0xc6, 0x80, 0xb3, 0x19, 0x8d, 0x50, 0x0b, 0xd6, 0x69, 0xd8, 0x5e,
+	0xdb, 0x5f, 0xeb, 0x34, 0xfc, 0x75, 0x1a, 0xa2, 0x17, 0xd0, 0x9c, 0xd3, 0x58, 0x11, 0x36, 0x16,
+	0x5c, 0xaa, 0xf6, 0xfa, 0xfe, 0x5a, 0xe7, 0x91, 0x0f, 0xc9, 0xa5, 0x11, 0x97, 0x0a, 0xed, 0x01,
+	0x98, 0x55, 0xc6, 0x1f, 0x79, 0xac, 0xda, 0x5f, 0x19, 0x63, 0xc3, 0x5c, 0x39, 0xe5, 0x71, 0x49,
+	0x36, 0xf6, 0xaf, 0x8d, 0x3d, 0x91, 0x8d, 0xfb, 0x10, 0x1a, 0x8c, 0x87, 0x64, 0xac, 0x81, 0xed,
+	0x47, 0xfb, 0x6b, 0x9d, 0xe6, 0x9b, 0x1f, 0x0e, 0xf2, 0x7b, 0x90, 0x74, 0x7a, 0x98, 0x6d, 0xf2,
+	0x98, 0x85, 0x82, 0x53, 0xa6, 0xfc, 0x6f, 0xb5, 0xe7, 0x9c, 0xc6, 0x0a, 0xbd, 0x85, 0xa6, 0x6e,
+	0xeb, 0x78, 0x6a, 0xd2, 0xb7, 0x37, 0x0c, 0xc1, 0xab, 0x12, 0x8e, 0xd5, 0x34, 0x4c, 0xf6, 0xe7,
+	0x03, 0xc9, 0x3f, 0xa3, 0x43, 0xd8, 0x34, 0x8d, 0xcf, 0xdc, 0xdf, 0x18, 0xf7, 0xf7, 0x55, 0xb7,
+	0xae, 0xce, 0xec, 0xcd, 0x69, 0xf1, 0xe5, 0xcd, 0x7f, 0x4f, 0x60, 0x3b, 0x0f, 0x17, 0x10, 0xf9,
+	0x37, 0x9d, 0x12, 0x74, 0x04, 0xad, 0x13, 0xa2, 0x46, 0x7a, 0x87, 0xe9, 0x32, 0xbb, 0x56, 0x9c,
+	0x85, 0x50, 0xb7, 0x9e, 0xb5, 0x4e, 0xd9, 0x73, 0x0e, 0xe8, 0x84, 0xa8, 0x1c, 0x7e, 0x3f, 0xe9,
+	0x85, 0xb3, 0x63, 0x05, 0x2d, 0xb0, 0x69, 0x75, 0x36, 0xef, 0xee, 0xe5, 0xd0, 0x08, 0x9e, 0x96,
+	0x69, 0x97, 0x3c, 0x7c, 0x28, 0xb1, 0x0f, 0x9b, 0x27, 0x44, 0x1d, 0xe9, 0x83, 0x6e, 0xee, 0xea,
+	0xe7, 0x76, 0xcc, 0x38, 0xce, 0x42, 0xe3, 0x39, 0x37, 0x7d, 0x37, 0x57, 0xd2, 0x34, 0x7b, 0x77,
+	0x96, 0x67, 0x87, 0xc8, 0x41, 0x4b, 0xbd, 0x97, 0xd0, 0x0a, 0x56, 0x69, 0xaf, 0xab, 0xe5, 0xab,
+	0xba, 0x4f, 0xfe, 0x5a, 0x92, 0x58, 0xb9, 0x76, 0x98, 0xa4, 0x2b, 0x9d, 0x24, 0x3b, 0x9d, 0x11,
+	0xdd, 0xe9, 0xca, 0xde, 0x63, 0x68, 0x9d, 0xc5, 0xe6, 0x82, 0xbf, 0x64, 0x8c, 0xb2, 0x5a, 0xda,
+	0x93, 0xaa, 0xdc, 0xe7, 0x7c, 0x8e, 0xfa, 0x00, 0x81, 0xc2, 0x32, 0x89, 0x55, 0x87, 0x70, 0x6c,
+	0xac, 0x07, 0x8d, 0x40, 0x71, 0xf1, 0x10, 0x44, 0x00, 0xdb, 0x3e, 0x89, 0xf4, 0x44, 0x91, 0x17,
+	0xa9, 0x8e, 0x3a, 0x56, 0xb7, 0x97, 0x93, 0x2b, 0x1c, 0xdf, 0x8c, 0xab, 0x95, 0x2e, 0xe8, 0x07,
+	0x40, 0x47, 0x44, 0x56, 0xb1, 0x3f, 0xba, 0xb0, 0x76, 0xad, 0x0b, 0x7c, 0x06, 0xcd, 0x2c, 0xc3,
+	0x60, 0x11, 0xa2, 0x57, 0x75, 0x41, 0x07, 0x8b, 0xd0, 0x85, 0xba, 0x80, 0xad, 0x62, 0x5d, 0x0d,
+	0x7b, 0x5d, 0x1f, 0xef, 0x1e, 0xdc, 0xef, 0xf0, 0x9d, 0x4f, 0x04, 0x97, 0x2a, 0x75, 0x05, 0x0a,
+	0xab, 0x65, 0x6c, 0xdf, 0x94, 0x15, 0xd9, 0x05, 0x7b, 0x07, 0xbb, 0x27, 0x44, 0xe9, 0xc1, 0xf9,
+	0x1e, 0xcf, 0x97, 0x24, 0xee, 0xdf, 0x8e, 0x24, 0x99, 0xd1, 0x4f, 0xe8, 0xa9, 0x85, 0x53, 0x92,
+	0xb2, 0xc8, 0x7b, 0x76, 0xf7, 0xf5, 0x0b, 0x2c, 0xd0, 0x10, 0xb6, 0x56, 0x58, 0xc8, 0xbb, 0xbb,
+	0x56, 0x3f, 0xc7, 0xf7, 0x71, 0x7a, 0xb0, 0x15, 0xac, 0x70, 0xdc, 0xb5, 0xae, 0x6d, 0xfd, 0x02,
+	0x8d, 0x11, 0x65, 0x91, 0x19, 0xb5, 0xae, 0x31, 0xe3, 0xb0, 0xfe, 0x0a, 0x5b, 0xda, 0x9a, 0x8f,
+	0xb4, 0x2f, 0xb4, 0xf7, 0x60, 0x67, 0xc5, 0xae, 0xc7, 0xe6, 0x17, 0x23, 0x4c, 0x78, 0x33, 0x75,
+	0xea, 0xa6, 0x9b, 0x03, 0xe1, 0x03, 0xf8, 0x4b, 0x36, 0xe0, 0x8b, 0x05, 0x66, 0x21, 0xfa, 0xa9,
+	0x5a, 0x54, 0x68, 0x7f, 0xb0, 0x3c, 0x69, 0x36, 0xda, 0x1c, 0x77, 0x1e, 0x5d, 0xc3, 0x4e, 0xd9,
+	0x97, 0xc4, 0xeb, 0xdc, 0x87, 0x36, 0x25, 0x75, 0xd8, 0x0b, 0xd8, 0xb9, 0x16, 0x73, 0x8e, 0xc3,
+	0x21, 0x9d, 0x93, 0x2b, 0x9e, 0x60, 0xad, 0x97, 0x83, 0xa2, 0xa4, 0x66, 0x02, 0x7f, 0x80, 0xdd,
+	0x23, 0xfe, 0x0f, 0xcb, 0xaa, 0x87, 0x92, 0x2f, 0x12, 0xa4, 0xf5, 0x04, 0x97, 0xcb, 0x32, 0xa8,
+	0x75, 0xd2, 0xb4, 0x38, 0xf8, 0xb8, 0x64, 0x37, 0xa8, 0x0f, 0x3b, 0xbd, 0x09, 0x97, 0xca, 0xc4,
+	0x94, 0x98, 0xc5, 0x33, 0x22, 0x9d, 0x4f, 0x89, 0x23, 0xdc, 0x29, 0xb4, 0xae, 0x30, 0x9d, 0x9f,
+	0xf3, 0x28, 0xeb, 0xdf, 0xf3, 0x6a, 0x61, 0xaa, 0xd7, 0x75, 0x6d, 0x08, 0x50, 0xb4, 0xe4, 0x01,
+	0xed, 0xba, 0x84, 0xed, 0x6b, 0x11, 0x49, 0x1c, 0x92, 0xe2, 0xc0, 0xbf, 0xb2, 0x69, 0xa6, 0xa2,
+	0xa7, 0x5f, 0x7a, 0x6b, 0x78, 0x03, 0x78, 0x9c, 0x0c, 0xa7, 0xe4, 0x37, 0x9b, 0xcd, 0xb8, 0xfd,
+	0xf4, 0x1a, 0x8e, 0x96, 0xdc, 0x13, 0xee, 0x71, 0xf6, 0x1b, 0xff, 0x9e, 0xc8, 0x98, 0x72, 0x56,
+	0xf7, 0x18, 0xb8, 0xd7, 0x40, 0xef, 0x60, 0x33, 0xcd, 0xef, 0x38, 0x07, 0x65, 0xb5, 0x66, 0x77,
+	0x3d, 0x68, 0x9c, 0x12, 0x2c, 0x55, 0x9f, 0x60, 0xe7, 0x58, 0xd9, 0x73, 0x46, 0xd1, 0x73, 0xaf,
+	0xff, 0xdb, 0x9f, 0x87, 0x5c, 0x10, 0x26, 0xa8, 0x92, 0xf4, 0xd3, 0x01, 0xe5, 0xdd, 0xe2, 0x5b,
+	0x57, 0xdc, 0x44, 0x5d, 0x31, 0xe9, 0xda, 0xff, 0xbf, 0xbc, 0x15, 0x93, 0xfc, 0xf3, 0x64, 0xc3,
+	0xbc, 0xcc, 0xff, 0xfc, 0xff, 0x00, 0xd7, 0x27, 0x94, 0xc3, 0xe8, 0x0c, 0x00, 0x00,
 }
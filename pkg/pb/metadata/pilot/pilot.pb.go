@@ -61,6 +61,11 @@ type PilotServiceClient interface {
 	PingDrone(ctx context.Context, in *types.DroneEndpoint, opts ...grpc.CallOption) (*types.Empty, error)
 	RunCommandOnFrontgateNode(ctx context.Context, in *types.RunCommandOnFrontgateRequest, opts ...grpc.CallOption) (*types.String, error)
 	RunCommandOnDrone(ctx context.Context, in *types.RunCommandOnDroneRequest, opts ...grpc.CallOption) (*types.String, error)
+	UploadFileToDrone(ctx context.Context, opts ...grpc.CallOption) (PilotService_UploadFileToDroneClient, error)
+	DownloadFileFromDrone(ctx context.Context, in *types.DownloadFileRequest, opts ...grpc.CallOption) (PilotService_DownloadFileFromDroneClient, error)
+	TailLogOnDrone(ctx context.Context, in *types.TailLogRequest, opts ...grpc.CallOption) (*types.String, error)
+	GetAgentList(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.AgentInfoList, error)
+	UpgradeAgents(ctx context.Context, opts ...grpc.CallOption) (PilotService_UpgradeAgentsClient, error)
 	FrontgateChannel(ctx context.Context, opts ...grpc.CallOption) (PilotService_FrontgateChannelClient, error)
 }
 
@@ -279,8 +284,126 @@ func (c *pilotServiceClient) RunCommandOnDrone(ctx context.Context, in *types.Ru
 	return out, nil
 }
 
+func (c *pilotServiceClient) UploadFileToDrone(ctx context.Context, opts ...grpc.CallOption) (PilotService_UploadFileToDroneClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PilotService_serviceDesc.Streams[0], "/metadata.pilot.PilotService/UploadFileToDrone", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pilotServiceUploadFileToDroneClient{stream}
+	return x, nil
+}
+
+type PilotService_UploadFileToDroneClient interface {
+	Send(*types.UploadFileRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
+}
+
+type pilotServiceUploadFileToDroneClient struct {
+	grpc.ClientStream
+}
+
+func (x *pilotServiceUploadFileToDroneClient) Send(m *types.UploadFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pilotServiceUploadFileToDroneClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pilotServiceClient) DownloadFileFromDrone(ctx context.Context, in *types.DownloadFileRequest, opts ...grpc.CallOption) (PilotService_DownloadFileFromDroneClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PilotService_serviceDesc.Streams[1], "/metadata.pilot.PilotService/DownloadFileFromDrone", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pilotServiceDownloadFileFromDroneClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PilotService_DownloadFileFromDroneClient interface {
+	Recv() (*types.FileChunk, error)
+	grpc.ClientStream
+}
+
+type pilotServiceDownloadFileFromDroneClient struct {
+	grpc.ClientStream
+}
+
+func (x *pilotServiceDownloadFileFromDroneClient) Recv() (*types.FileChunk, error) {
+	m := new(types.FileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pilotServiceClient) TailLogOnDrone(ctx context.Context, in *types.TailLogRequest, opts ...grpc.CallOption) (*types.String, error) {
+	out := new(types.String)
+	err := c.cc.Invoke(ctx, "/metadata.pilot.PilotService/TailLogOnDrone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pilotServiceClient) GetAgentList(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.AgentInfoList, error) {
+	out := new(types.AgentInfoList)
+	err := c.cc.Invoke(ctx, "/metadata.pilot.PilotService/GetAgentList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pilotServiceClient) UpgradeAgents(ctx context.Context, opts ...grpc.CallOption) (PilotService_UpgradeAgentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PilotService_serviceDesc.Streams[2], "/metadata.pilot.PilotService/UpgradeAgents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pilotServiceUpgradeAgentsClient{stream}
+	return x, nil
+}
+
+type PilotService_UpgradeAgentsClient interface {
+	Send(*types.UpgradeAgentsRequest) error
+	CloseAndRecv() (*types.AgentInfoList, error)
+	grpc.ClientStream
+}
+
+type pilotServiceUpgradeAgentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pilotServiceUpgradeAgentsClient) Send(m *types.UpgradeAgentsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pilotServiceUpgradeAgentsClient) CloseAndRecv() (*types.AgentInfoList, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.AgentInfoList)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *pilotServiceClient) FrontgateChannel(ctx context.Context, opts ...grpc.CallOption) (PilotService_FrontgateChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PilotService_serviceDesc.Streams[0], "/metadata.pilot.PilotService/FrontgateChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_PilotService_serviceDesc.Streams[3], "/metadata.pilot.PilotService/FrontgateChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -335,6 +458,11 @@ type PilotServiceServer interface {
 	PingDrone(context.Context, *types.DroneEndpoint) (*types.Empty, error)
 	RunCommandOnFrontgateNode(context.Context, *types.RunCommandOnFrontgateRequest) (*types.String, error)
 	RunCommandOnDrone(context.Context, *types.RunCommandOnDroneRequest) (*types.String, error)
+	UploadFileToDrone(PilotService_UploadFileToDroneServer) error
+	DownloadFileFromDrone(*types.DownloadFileRequest, PilotService_DownloadFileFromDroneServer) error
+	TailLogOnDrone(context.Context, *types.TailLogRequest) (*types.String, error)
+	GetAgentList(context.Context, *types.Empty) (*types.AgentInfoList, error)
+	UpgradeAgents(PilotService_UpgradeAgentsServer) error
 	FrontgateChannel(PilotService_FrontgateChannelServer) error
 }
 
@@ -756,6 +884,115 @@ func _PilotService_RunCommandOnDrone_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PilotService_UploadFileToDrone_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PilotServiceServer).UploadFileToDrone(&pilotServiceUploadFileToDroneServer{stream})
+}
+
+type PilotService_UploadFileToDroneServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*types.UploadFileRequest, error)
+	grpc.ServerStream
+}
+
+type pilotServiceUploadFileToDroneServer struct {
+	grpc.ServerStream
+}
+
+func (x *pilotServiceUploadFileToDroneServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pilotServiceUploadFileToDroneServer) Recv() (*types.UploadFileRequest, error) {
+	m := new(types.UploadFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PilotService_DownloadFileFromDrone_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.DownloadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PilotServiceServer).DownloadFileFromDrone(m, &pilotServiceDownloadFileFromDroneServer{stream})
+}
+
+type PilotService_DownloadFileFromDroneServer interface {
+	Send(*types.FileChunk) error
+	grpc.ServerStream
+}
+
+type pilotServiceDownloadFileFromDroneServer struct {
+	grpc.ServerStream
+}
+
+func (x *pilotServiceDownloadFileFromDroneServer) Send(m *types.FileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PilotService_TailLogOnDrone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.TailLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PilotServiceServer).TailLogOnDrone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.pilot.PilotService/TailLogOnDrone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PilotServiceServer).TailLogOnDrone(ctx, req.(*types.TailLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PilotService_GetAgentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PilotServiceServer).GetAgentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.pilot.PilotService/GetAgentList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PilotServiceServer).GetAgentList(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PilotService_UpgradeAgents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PilotServiceServer).UpgradeAgents(&pilotServiceUpgradeAgentsServer{stream})
+}
+
+type PilotService_UpgradeAgentsServer interface {
+	SendAndClose(*types.AgentInfoList) error
+	Recv() (*types.UpgradeAgentsRequest, error)
+	grpc.ServerStream
+}
+
+type pilotServiceUpgradeAgentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pilotServiceUpgradeAgentsServer) SendAndClose(m *types.AgentInfoList) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pilotServiceUpgradeAgentsServer) Recv() (*types.UpgradeAgentsRequest, error) {
+	m := new(types.UpgradeAgentsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _PilotService_FrontgateChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(PilotServiceServer).FrontgateChannel(&pilotServiceFrontgateChannelServer{stream})
 }
@@ -878,8 +1115,31 @@ var _PilotService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunCommandOnDrone",
 			Handler:    _PilotService_RunCommandOnDrone_Handler,
 		},
+		{
+			MethodName: "TailLogOnDrone",
+			Handler:    _PilotService_TailLogOnDrone_Handler,
+		},
+		{
+			MethodName: "GetAgentList",
+			Handler:    _PilotService_GetAgentList_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFileToDrone",
+			Handler:       _PilotService_UploadFileToDrone_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFileFromDrone",
+			Handler:       _PilotService_DownloadFileFromDrone_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpgradeAgents",
+			Handler:       _PilotService_UpgradeAgents_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "FrontgateChannel",
 			Handler:       _PilotService_FrontgateChannel_Handler,
@@ -890,47 +1150,54 @@ var _PilotService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "metadata/pilot/pilot.proto",
 }
 
-func init() { proto.RegisterFile("metadata/pilot/pilot.proto", fileDescriptor_pilot_737fcd613de0e7d0) }
-
-var fileDescriptor_pilot_737fcd613de0e7d0 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x6f, 0xd3, 0x3c,
-	0x14, 0xd6, 0x6e, 0x5e, 0x69, 0x7e, 0x59, 0xb5, 0x99, 0x0f, 0x69, 0x19, 0x8c, 0x0b, 0x34, 0x69,
-	0x42, 0x5b, 0x83, 0x40, 0x42, 0x20, 0xae, 0xd6, 0x76, 0x1b, 0x85, 0x6d, 0x4c, 0x09, 0x08, 0x89,
-	0x1b, 0xe4, 0xce, 0x67, 0xc1, 0x6a, 0x63, 0x9b, 0xf8, 0x04, 0xe8, 0x2f, 0xe3, 0xef, 0xa1, 0x38,
-	0x69, 0x9b, 0x8f, 0x3a, 0x91, 0xe0, 0x26, 0x51, 0xcf, 0xf3, 0xe1, 0xe7, 0xd8, 0xc9, 0x69, 0x88,
-	0x17, 0x03, 0x32, 0xce, 0x90, 0xf9, 0x5a, 0xcc, 0x14, 0xe6, 0xd7, 0xbe, 0x4e, 0x14, 0x2a, 0xda,
-	0x5b, 0x60, 0x7d, 0x5b, 0xf5, 0x1e, 0x46, 0x4a, 0x45, 0x33, 0xf0, 0x99, 0x16, 0x3e, 0x93, 0x52,
-	0x21, 0x43, 0xa1, 0xa4, 0xc9, 0xd9, 0xde, 0x91, 0xbd, 0xdd, 0x1c, 0x47, 0x20, 0x8f, 0xcd, 0x4f,
-	0x16, 0x45, 0x90, 0xf8, 0x4a, 0x5b, 0xc6, 0x1a, 0xf6, 0x6a, 0x5d, 0x9c, 0x6b, 0x30, 0xf9, 0xd5,
-	0x81, 0xdd, 0x28, 0x79, 0xcb, 0x1d, 0x18, 0x4f, 0x94, 0x84, 0x02, 0xdb, 0xaf, 0x61, 0xb7, 0x89,
-	0x92, 0x18, 0x31, 0x04, 0x87, 0xb6, 0xd4, 0xab, 0xb7, 0x5b, 0xcf, 0xc3, 0xcc, 0x34, 0x87, 0x9e,
-	0xff, 0xee, 0x91, 0x3b, 0xd7, 0x19, 0x35, 0x84, 0xe4, 0x87, 0xb8, 0x01, 0x3a, 0x22, 0xbd, 0x73,
-	0x40, 0x5b, 0x1a, 0x2a, 0x79, 0x2b, 0x22, 0x7a, 0xbf, 0xbf, 0xdc, 0xaa, 0xbc, 0x91, 0xd3, 0x58,
-	0xe3, 0xdc, 0xdb, 0xab, 0x97, 0xcb, 0x9a, 0x77, 0x64, 0xfb, 0x1c, 0xf0, 0x6c, 0x91, 0xf1, 0x42,
-	0x18, 0x74, 0xf9, 0x3c, 0xae, 0x97, 0x97, 0xaa, 0x31, 0xb7, 0xba, 0x80, 0xd0, 0xb2, 0x57, 0xb1,
-	0xc2, 0x5e, 0x8b, 0xac, 0xc5, 0xb3, 0x50, 0x5f, 0x10, 0x1a, 0x36, 0x3d, 0xbb, 0x64, 0xde, 0xfa,
-	0x16, 0xe8, 0x85, 0xdd, 0xb3, 0x51, 0x76, 0x5a, 0x85, 0xd3, 0xa3, 0x3a, 0xd1, 0x82, 0xa7, 0x92,
-	0x6b, 0x25, 0x24, 0x36, 0xf7, 0xae, 0xac, 0xbd, 0x22, 0xbd, 0xb0, 0xea, 0x76, 0x50, 0xa7, 0x57,
-	0xf1, 0x00, 0xbe, 0xa7, 0x60, 0xb0, 0x3d, 0x5d, 0x46, 0xe5, 0xae, 0x74, 0x16, 0x74, 0xa7, 0x2b,
-	0x6b, 0x4f, 0x49, 0x6f, 0x6c, 0x6c, 0x21, 0x48, 0xa5, 0x14, 0xb2, 0xb3, 0xd7, 0x7b, 0x75, 0x78,
-	0xa0, 0xd4, 0x8c, 0x0e, 0x08, 0x09, 0x91, 0x25, 0x79, 0xac, 0x2e, 0x0b, 0x47, 0x63, 0x27, 0x64,
-	0x33, 0x44, 0xa5, 0xff, 0xc5, 0x22, 0x24, 0xdb, 0x01, 0x44, 0xc2, 0x20, 0x24, 0x97, 0x05, 0x4e,
-	0x0f, 0x1b, 0xbb, 0x9d, 0x4e, 0x3e, 0x32, 0x33, 0xfd, 0x5a, 0x67, 0xba, 0x4c, 0x3f, 0x13, 0x3a,
-	0x82, 0xa4, 0x6e, 0xfb, 0xd4, 0x65, 0xdb, 0xe4, 0xba, 0x8c, 0xc7, 0xe4, 0xff, 0x45, 0x86, 0x61,
-	0xcc, 0xe9, 0x93, 0xae, 0xa0, 0xc3, 0x98, 0xbb, 0xac, 0x2e, 0xc9, 0xd6, 0x6a, 0xdd, 0xcc, 0xec,
-	0xa0, 0x3b, 0x5e, 0x8b, 0xdd, 0x7b, 0x72, 0x37, 0x00, 0xad, 0x12, 0x2c, 0x54, 0x21, 0x32, 0x4c,
-	0x4d, 0xf3, 0x50, 0x2a, 0xb0, 0xfb, 0x81, 0xcd, 0x86, 0x47, 0x98, 0x4e, 0x70, 0xe5, 0xb4, 0xeb,
-	0x70, 0x1a, 0x73, 0xaf, 0x7d, 0x11, 0x7a, 0x46, 0xb6, 0xde, 0x32, 0xc9, 0x67, 0x50, 0x18, 0xd2,
-	0x7d, 0x07, 0xff, 0x12, 0x8c, 0x61, 0x11, 0xb8, 0x52, 0xbd, 0x26, 0x9b, 0xd7, 0x42, 0x46, 0x76,
-	0xca, 0xb9, 0x66, 0x99, 0x43, 0x3a, 0x24, 0x5b, 0x99, 0x74, 0x39, 0x4d, 0xda, 0x87, 0x97, 0x73,
-	0x8b, 0x77, 0x2a, 0x26, 0x57, 0x8a, 0x43, 0xcb, 0xc4, 0xca, 0x60, 0xb7, 0xd9, 0x49, 0xde, 0x8c,
-	0x7d, 0x47, 0xfe, 0xf2, 0xd5, 0x61, 0x64, 0x37, 0x48, 0xe5, 0x50, 0xc5, 0x31, 0x93, 0xfc, 0x83,
-	0xac, 0xe6, 0x3a, 0xaa, 0x6b, 0xd6, 0x52, 0x17, 0x83, 0xeb, 0x41, 0xe3, 0x44, 0x30, 0xc9, 0x26,
-	0xcb, 0x27, 0xb2, 0x53, 0xd6, 0xe5, 0x69, 0x0f, 0xdb, 0xac, 0x2d, 0xa5, 0xcb, 0x76, 0x44, 0xb6,
-	0x57, 0x83, 0xfd, 0x1b, 0x93, 0x12, 0x66, 0xcd, 0x03, 0x1d, 0xcc, 0x11, 0xd6, 0x3c, 0xa1, 0xb6,
-	0x7c, 0xb8, 0xf1, 0x6c, 0x63, 0xf0, 0xea, 0xcb, 0x4b, 0xa5, 0x41, 0x6a, 0x81, 0x89, 0xf8, 0xd5,
-	0x17, 0xca, 0x5f, 0xfd, 0xf2, 0xf5, 0x34, 0xf2, 0xf5, 0xc4, 0xaf, 0x7e, 0x7e, 0xbc, 0xd1, 0x13,
-	0x7b, 0x9f, 0xfc, 0x67, 0xff, 0x7a, 0x5f, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x37, 0xb4, 0xa7,
-	0x08, 0x9f, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("metadata/pilot/pilot.proto", fileDescriptor_pilot_1250e6fd0a6bbf56) }
+
+var fileDescriptor_pilot_1250e6fd0a6bbf56 = []byte{
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x56, 0x6f, 0x90, 0x66, 0xd6, 0x6a, 0x35, 0x0c, 0x69, 0x19, 0x0c, 0x21, 0x98, 0x54, 0xa1,
+	0xad, 0x99, 0x40, 0x42, 0x20, 0xae, 0xd6, 0x76, 0xdd, 0x0a, 0xdd, 0x8f, 0x9a, 0x0d, 0x04, 0x37,
+	0xc8, 0x5d, 0x4e, 0x3d, 0xab, 0xa9, 0x6d, 0x92, 0x53, 0xc6, 0x9e, 0x91, 0x97, 0x42, 0x71, 0x92,
+	0xb5, 0x4d, 0xea, 0x54, 0x82, 0x9b, 0x46, 0x3d, 0xdf, 0x8f, 0xbf, 0xe3, 0x38, 0x27, 0x21, 0xce,
+	0x04, 0x90, 0xf9, 0x0c, 0x99, 0xab, 0x45, 0xa0, 0x30, 0xf9, 0x6d, 0xea, 0x50, 0xa1, 0xa2, 0xb5,
+	0x0c, 0x6b, 0x9a, 0xaa, 0xf3, 0x94, 0x2b, 0xc5, 0x03, 0x70, 0x99, 0x16, 0x2e, 0x93, 0x52, 0x21,
+	0x43, 0xa1, 0x64, 0x94, 0xb0, 0x9d, 0x3d, 0x73, 0xb9, 0xde, 0xe7, 0x20, 0xf7, 0xa3, 0x5b, 0xc6,
+	0x39, 0x84, 0xae, 0xd2, 0x86, 0xb1, 0x84, 0x3d, 0x5b, 0x17, 0xef, 0x34, 0x44, 0xc9, 0xaf, 0x05,
+	0x63, 0x1c, 0x24, 0x5a, 0xb0, 0x6b, 0x25, 0x47, 0xbe, 0x05, 0xf3, 0x43, 0x25, 0x21, 0xc5, 0x76,
+	0x72, 0xd8, 0x28, 0x54, 0x12, 0x39, 0x43, 0xb0, 0x68, 0xe7, 0xf6, 0xc1, 0xd9, 0xca, 0x61, 0xc8,
+	0xa2, 0x71, 0x02, 0xbd, 0xf9, 0x53, 0x27, 0xeb, 0x17, 0x31, 0xd5, 0x83, 0xf0, 0x97, 0xb8, 0x06,
+	0xda, 0x21, 0xb5, 0x63, 0x40, 0x53, 0x6a, 0x2b, 0x39, 0x12, 0x9c, 0x6e, 0x36, 0xef, 0xb7, 0x31,
+	0x69, 0xf2, 0x68, 0xa2, 0xf1, 0xce, 0xd9, 0xce, 0x97, 0xe7, 0x35, 0x9f, 0xc8, 0xc6, 0x31, 0x60,
+	0x37, 0xcb, 0xd8, 0x17, 0x11, 0xda, 0x7c, 0x9e, 0xe7, 0xcb, 0xf7, 0xaa, 0x9e, 0x6f, 0x74, 0x03,
+	0x42, 0xe7, 0xbd, 0xd2, 0x15, 0xb6, 0x4b, 0x64, 0x25, 0x9e, 0xa9, 0xba, 0x4f, 0xa8, 0x57, 0xf4,
+	0x5c, 0x25, 0x73, 0x96, 0xb7, 0x40, 0xfb, 0x66, 0xcf, 0x3a, 0xf1, 0xdd, 0x4a, 0x9d, 0x9e, 0xe5,
+	0x89, 0x06, 0x3c, 0x92, 0xbe, 0x56, 0x42, 0xa2, 0xb3, 0xbd, 0x14, 0x4e, 0xb5, 0x67, 0xa4, 0xe6,
+	0x2d, 0xba, 0xed, 0xe6, 0xe9, 0x8b, 0xf8, 0x00, 0x7e, 0x4e, 0x21, 0xc2, 0xf2, 0x74, 0x31, 0xd5,
+	0xb7, 0xa5, 0x33, 0xa0, 0x3d, 0xdd, 0xbc, 0xf6, 0x88, 0xd4, 0x7a, 0x91, 0x29, 0x0c, 0xa6, 0x52,
+	0x0a, 0xb9, 0xb2, 0xd7, 0xc7, 0x79, 0xb8, 0xa5, 0x54, 0x40, 0x5b, 0x84, 0x78, 0xc8, 0xc2, 0x24,
+	0xd6, 0x2a, 0x0b, 0x4b, 0x63, 0x87, 0x64, 0xcd, 0x43, 0xa5, 0xff, 0xc7, 0xc2, 0x23, 0x1b, 0x03,
+	0xe0, 0x22, 0x42, 0x08, 0x4f, 0x53, 0x9c, 0x36, 0x0a, 0xbb, 0x3d, 0x1d, 0x5e, 0xb2, 0x68, 0xfc,
+	0x23, 0xcf, 0xb4, 0x99, 0x7e, 0x25, 0xb4, 0x03, 0x61, 0xde, 0xf6, 0xb5, 0xcd, 0xb6, 0xc8, 0xb5,
+	0x19, 0xf7, 0xc8, 0xc3, 0x2c, 0x43, 0x7b, 0xe2, 0xd3, 0x97, 0xab, 0x82, 0xb6, 0x27, 0xbe, 0xcd,
+	0xea, 0x94, 0x54, 0x67, 0xeb, 0xc6, 0x66, 0xbb, 0xab, 0xe3, 0x95, 0xd8, 0x7d, 0x26, 0x8f, 0x06,
+	0xa0, 0x55, 0x88, 0xa9, 0xca, 0x43, 0x86, 0xd3, 0xa8, 0x78, 0x53, 0x16, 0x60, 0xfb, 0x81, 0x8d,
+	0x87, 0x87, 0x37, 0x1d, 0xe2, 0xcc, 0x69, 0xcb, 0xe2, 0xd4, 0xf3, 0x9d, 0xf2, 0x45, 0x68, 0x97,
+	0x54, 0x4f, 0x98, 0xf4, 0x03, 0x48, 0x0d, 0xe9, 0x8e, 0x85, 0x7f, 0x0a, 0x51, 0xc4, 0x38, 0xd8,
+	0x52, 0x7d, 0x20, 0x6b, 0x17, 0x42, 0x72, 0x33, 0xe5, 0x6c, 0xb3, 0xcc, 0x22, 0x6d, 0x93, 0x6a,
+	0x2c, 0xbd, 0x9f, 0x26, 0xe5, 0xc3, 0xcb, 0xba, 0xc5, 0xf5, 0x05, 0x93, 0x33, 0xe5, 0x43, 0xc9,
+	0xc4, 0x8a, 0x61, 0xbb, 0xd9, 0x61, 0xd2, 0x8c, 0x79, 0x46, 0xfe, 0xf1, 0xd1, 0x61, 0x64, 0x6b,
+	0x30, 0x95, 0x6d, 0x35, 0x99, 0x30, 0xe9, 0x9f, 0xcb, 0xc5, 0x5c, 0x7b, 0x79, 0xcd, 0x52, 0x6a,
+	0x36, 0xb8, 0x9e, 0x14, 0xee, 0x08, 0x86, 0xf1, 0x64, 0xb9, 0x22, 0xf5, 0x79, 0x5d, 0x92, 0xb6,
+	0x51, 0x66, 0x6d, 0x28, 0xab, 0x6c, 0xcf, 0x49, 0xfd, 0x4a, 0x07, 0x8a, 0xf9, 0x5d, 0x11, 0xc0,
+	0xa5, 0x4a, 0x6c, 0x5f, 0xe4, 0xc9, 0x33, 0x4a, 0xf9, 0x7c, 0x6d, 0x54, 0xe8, 0x37, 0xb2, 0xd9,
+	0x51, 0xb7, 0x32, 0xe3, 0x77, 0x43, 0x35, 0x49, 0x4c, 0x0b, 0x4f, 0xe8, 0x3c, 0x2d, 0xb3, 0x2d,
+	0x1c, 0xed, 0x18, 0x6c, 0xdf, 0x4c, 0xe5, 0xf8, 0xa0, 0x42, 0x4f, 0x48, 0xed, 0x92, 0x89, 0xa0,
+	0xaf, 0x78, 0xd6, 0x7f, 0xe1, 0xf8, 0xa6, 0xf8, 0xaa, 0xae, 0x3b, 0x64, 0xfd, 0x18, 0xf0, 0x90,
+	0x83, 0xc4, 0xb2, 0xd7, 0x71, 0xe1, 0x30, 0x18, 0x45, 0x4f, 0x8e, 0x94, 0x51, 0x7d, 0x21, 0xd5,
+	0x2b, 0xcd, 0x43, 0xe6, 0x83, 0xa9, 0x47, 0xf4, 0x55, 0x71, 0xdf, 0xe6, 0xe0, 0x2c, 0x54, 0xb9,
+	0x6b, 0xa3, 0x42, 0x3b, 0x64, 0x63, 0xf6, 0xb2, 0xbd, 0x61, 0x52, 0x42, 0x50, 0x4c, 0xd8, 0xba,
+	0x43, 0x88, 0x9c, 0xe5, 0xe5, 0x46, 0xe5, 0xa0, 0xd2, 0x7a, 0xff, 0xfd, 0x9d, 0xd2, 0x20, 0xb5,
+	0xc0, 0x50, 0xfc, 0x6e, 0x0a, 0xe5, 0xce, 0xfe, 0xb9, 0x7a, 0xcc, 0x5d, 0x3d, 0x74, 0x17, 0x3f,
+	0x17, 0x3f, 0xea, 0xa1, 0xb9, 0x0e, 0x1f, 0x98, 0xcf, 0xa1, 0xb7, 0x7f, 0x07, 0x00, 0x0d, 0xfa,
+	0xd9, 0x89, 0x4f, 0x0a, 0x00, 0x00,
 }
@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: metadata/types/agent.proto
+
+package pbtypes // import "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type AgentInfo struct {
+	AgentType            string   `protobuf:"bytes,1,opt,name=agent_type,json=agentType,proto3" json:"agent_type"`
+	FrontgateId          string   `protobuf:"bytes,2,opt,name=frontgate_id,json=frontgateId,proto3" json:"frontgate_id"`
+	FrontgateNodeId      string   `protobuf:"bytes,3,opt,name=frontgate_node_id,json=frontgateNodeId,proto3" json:"frontgate_node_id"`
+	DroneIp              string   `protobuf:"bytes,4,opt,name=drone_ip,json=droneIp,proto3" json:"drone_ip"`
+	Version              string   `protobuf:"bytes,5,opt,name=version,proto3" json:"version"`
+	StartTime            int64    `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	ReportTime           int64    `protobuf:"varint,7,opt,name=report_time,json=reportTime,proto3" json:"report_time"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentInfo) Reset()         { *m = AgentInfo{} }
+func (m *AgentInfo) String() string { return proto.CompactTextString(m) }
+func (*AgentInfo) ProtoMessage()    {}
+func (*AgentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agent_c9f63d03d105c216, []int{0}
+}
+func (m *AgentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentInfo.Unmarshal(m, b)
+}
+func (m *AgentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentInfo.Marshal(b, m, deterministic)
+}
+func (dst *AgentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentInfo.Merge(dst, src)
+}
+func (m *AgentInfo) XXX_Size() int {
+	return xxx_messageInfo_AgentInfo.Size(m)
+}
+func (m *AgentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentInfo proto.InternalMessageInfo
+
+func (m *AgentInfo) GetAgentType() string {
+	if m != nil {
+		return m.AgentType
+	}
+	return ""
+}
+
+func (m *AgentInfo) GetFrontgateId() string {
+	if m != nil {
+		return m.FrontgateId
+	}
+	return ""
+}
+
+func (m *AgentInfo) GetFrontgateNodeId() string {
+	if m != nil {
+		return m.FrontgateNodeId
+	}
+	return ""
+}
+
+func (m *AgentInfo) GetDroneIp() string {
+	if m != nil {
+		return m.DroneIp
+	}
+	return ""
+}
+
+func (m *AgentInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *AgentInfo) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *AgentInfo) GetReportTime() int64 {
+	if m != nil {
+		return m.ReportTime
+	}
+	return 0
+}
+
+func (m *AgentInfo) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type AgentInfoList struct {
+	AgentList            []*AgentInfo `protobuf:"bytes,1,rep,name=agent_list,json=agentList,proto3" json:"agent_list"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AgentInfoList) Reset()         { *m = AgentInfoList{} }
+func (m *AgentInfoList) String() string { return proto.CompactTextString(m) }
+func (*AgentInfoList) ProtoMessage()    {}
+func (*AgentInfoList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agent_c9f63d03d105c216, []int{1}
+}
+func (m *AgentInfoList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentInfoList.Unmarshal(m, b)
+}
+func (m *AgentInfoList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentInfoList.Marshal(b, m, deterministic)
+}
+func (dst *AgentInfoList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentInfoList.Merge(dst, src)
+}
+func (m *AgentInfoList) XXX_Size() int {
+	return xxx_messageInfo_AgentInfoList.Size(m)
+}
+func (m *AgentInfoList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentInfoList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentInfoList proto.InternalMessageInfo
+
+func (m *AgentInfoList) GetAgentList() []*AgentInfo {
+	if m != nil {
+		return m.AgentList
+	}
+	return nil
+}
+
+// replace the agent binary with the uploaded one and restart it,
+// the agent rolls back if it is not pinged in rollback_timeout_seconds.
+type UpgradeAgentRequest struct {
+	BinaryPath             string   `protobuf:"bytes,1,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path"`
+	Sha256                 string   `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256"`
+	RollbackTimeoutSeconds int32    `protobuf:"varint,3,opt,name=rollback_timeout_seconds,json=rollbackTimeoutSeconds,proto3" json:"rollback_timeout_seconds"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *UpgradeAgentRequest) Reset()         { *m = UpgradeAgentRequest{} }
+func (m *UpgradeAgentRequest) String() string { return proto.CompactTextString(m) }
+func (*UpgradeAgentRequest) ProtoMessage()    {}
+func (*UpgradeAgentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agent_c9f63d03d105c216, []int{2}
+}
+func (m *UpgradeAgentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeAgentRequest.Unmarshal(m, b)
+}
+func (m *UpgradeAgentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeAgentRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpgradeAgentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeAgentRequest.Merge(dst, src)
+}
+func (m *UpgradeAgentRequest) XXX_Size() int {
+	return xxx_messageInfo_UpgradeAgentRequest.Size(m)
+}
+func (m *UpgradeAgentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeAgentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeAgentRequest proto.InternalMessageInfo
+
+func (m *UpgradeAgentRequest) GetBinaryPath() string {
+	if m != nil {
+		return m.BinaryPath
+	}
+	return ""
+}
+
+func (m *UpgradeAgentRequest) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *UpgradeAgentRequest) GetRollbackTimeoutSeconds() int32 {
+	if m != nil {
+		return m.RollbackTimeoutSeconds
+	}
+	return 0
+}
+
+type UpgradeDroneRequest struct {
+	Endpoint             *DroneEndpoint       `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint"`
+	Upgrade              *UpgradeAgentRequest `protobuf:"bytes,2,opt,name=upgrade,proto3" json:"upgrade"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpgradeDroneRequest) Reset()         { *m = UpgradeDroneRequest{} }
+func (m *UpgradeDroneRequest) String() string { return proto.CompactTextString(m) }
+func (*UpgradeDroneRequest) ProtoMessage()    {}
+func (*UpgradeDroneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agent_c9f63d03d105c216, []int{3}
+}
+func (m *UpgradeDroneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeDroneRequest.Unmarshal(m, b)
+}
+func (m *UpgradeDroneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeDroneRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpgradeDroneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeDroneRequest.Merge(dst, src)
+}
+func (m *UpgradeDroneRequest) XXX_Size() int {
+	return xxx_messageInfo_UpgradeDroneRequest.Size(m)
+}
+func (m *UpgradeDroneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeDroneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeDroneRequest proto.InternalMessageInfo
+
+func (m *UpgradeDroneRequest) GetEndpoint() *DroneEndpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+func (m *UpgradeDroneRequest) GetUpgrade() *UpgradeAgentRequest {
+	if m != nil {
+		return m.Upgrade
+	}
+	return nil
+}
+
+// the new binary is sent chunk by chunk, the other fields
+// are only read from the first message.
+type UpgradeAgentsRequest struct {
+	AgentType              string               `protobuf:"bytes,1,opt,name=agent_type,json=agentType,proto3" json:"agent_type"`
+	FrontgateList          []*FrontgateEndpoint `protobuf:"bytes,2,rep,name=frontgate_list,json=frontgateList,proto3" json:"frontgate_list"`
+	DroneList              []*DroneEndpoint     `protobuf:"bytes,3,rep,name=drone_list,json=droneList,proto3" json:"drone_list"`
+	Size                   int64                `protobuf:"varint,4,opt,name=size,proto3" json:"size"`
+	Sha256                 string               `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256"`
+	RollbackTimeoutSeconds int32                `protobuf:"varint,6,opt,name=rollback_timeout_seconds,json=rollbackTimeoutSeconds,proto3" json:"rollback_timeout_seconds"`
+	Offset                 int64                `protobuf:"varint,7,opt,name=offset,proto3" json:"offset"`
+	Data                   []byte               `protobuf:"bytes,8,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
+}
+
+func (m *UpgradeAgentsRequest) Reset()         { *m = UpgradeAgentsRequest{} }
+func (m *UpgradeAgentsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpgradeAgentsRequest) ProtoMessage()    {}
+func (*UpgradeAgentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agent_c9f63d03d105c216, []int{4}
+}
+func (m *UpgradeAgentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeAgentsRequest.Unmarshal(m, b)
+}
+func (m *UpgradeAgentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeAgentsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpgradeAgentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeAgentsRequest.Merge(dst, src)
+}
+func (m *UpgradeAgentsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpgradeAgentsRequest.Size(m)
+}
+func (m *UpgradeAgentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeAgentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeAgentsRequest proto.InternalMessageInfo
+
+func (m *UpgradeAgentsRequest) GetAgentType() string {
+	if m != nil {
+		return m.AgentType
+	}
+	return ""
+}
+
+func (m *UpgradeAgentsRequest) GetFrontgateList() []*FrontgateEndpoint {
+	if m != nil {
+		return m.FrontgateList
+	}
+	return nil
+}
+
+func (m *UpgradeAgentsRequest) GetDroneList() []*DroneEndpoint {
+	if m != nil {
+		return m.DroneList
+	}
+	return nil
+}
+
+func (m *UpgradeAgentsRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *UpgradeAgentsRequest) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *UpgradeAgentsRequest) GetRollbackTimeoutSeconds() int32 {
+	if m != nil {
+		return m.RollbackTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *UpgradeAgentsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *UpgradeAgentsRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AgentInfo)(nil), "metadata.types.AgentInfo")
+	proto.RegisterType((*AgentInfoList)(nil), "metadata.types.AgentInfoList")
+	proto.RegisterType((*UpgradeAgentRequest)(nil), "metadata.types.UpgradeAgentRequest")
+	proto.RegisterType((*UpgradeDroneRequest)(nil), "metadata.types.UpgradeDroneRequest")
+	proto.RegisterType((*UpgradeAgentsRequest)(nil), "metadata.types.UpgradeAgentsRequest")
+}
+
+func init() { proto.RegisterFile("metadata/types/agent.proto", fileDescriptor_agent_c9f63d03d105c216) }
+
+var fileDescriptor_agent_c9f63d03d105c216 = []byte{
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0x13, 0x31,
+	0x10, 0x55, 0xb2, 0xcd, 0xd7, 0xa4, 0x2d, 0xc2, 0x54, 0xd5, 0x36, 0x52, 0x21, 0x5d, 0x2e, 0x11,
+	0x87, 0x5d, 0x29, 0x88, 0x2a, 0x08, 0x38, 0x80, 0x00, 0x11, 0x09, 0x21, 0xb4, 0x84, 0x0b, 0x97,
+	0x95, 0xb7, 0xeb, 0x24, 0x56, 0x13, 0xdb, 0xd8, 0x0e, 0x22, 0x9c, 0x39, 0x70, 0xe3, 0xa7, 0xf1,
+	0x97, 0x90, 0x67, 0x3f, 0xd2, 0xae, 0x10, 0xed, 0x69, 0x77, 0xe6, 0xbd, 0x19, 0x3f, 0xbf, 0x19,
+	0x19, 0x06, 0x6b, 0x66, 0x69, 0x46, 0x2d, 0x8d, 0xec, 0x56, 0x31, 0x13, 0xd1, 0x05, 0x13, 0x36,
+	0x54, 0x5a, 0x5a, 0x49, 0x0e, 0x4b, 0x2c, 0x44, 0x6c, 0x50, 0xe7, 0x66, 0x5a, 0x0a, 0x96, 0x73,
+	0x07, 0xf7, 0x6b, 0xd8, 0x5c, 0x4b, 0x61, 0x17, 0xd4, 0x16, 0x78, 0xf0, 0xb3, 0x09, 0xbd, 0x97,
+	0xae, 0xf7, 0x54, 0xcc, 0x25, 0x39, 0x05, 0xc0, 0x83, 0x12, 0x47, 0xf6, 0x1b, 0xc3, 0xc6, 0xa8,
+	0x17, 0xf7, 0x30, 0x33, 0xdb, 0x2a, 0x46, 0xce, 0x60, 0xbf, 0xaa, 0x4f, 0x78, 0xe6, 0x37, 0x91,
+	0xd0, 0xaf, 0x72, 0xd3, 0x8c, 0x3c, 0x82, 0xbb, 0x3b, 0x8a, 0x90, 0x19, 0xf2, 0x3c, 0xe4, 0xdd,
+	0xa9, 0x80, 0x0f, 0x32, 0x73, 0xdc, 0x13, 0xe8, 0xa2, 0xd4, 0x84, 0x2b, 0x7f, 0x0f, 0x29, 0x1d,
+	0x8c, 0xa7, 0x8a, 0xf8, 0xd0, 0xf9, 0xc6, 0xb4, 0xe1, 0x52, 0xf8, 0xad, 0x1c, 0x29, 0x42, 0x27,
+	0xd1, 0x58, 0xaa, 0x6d, 0x62, 0xf9, 0x9a, 0xf9, 0xed, 0x61, 0x63, 0xe4, 0xc5, 0x3d, 0xcc, 0xcc,
+	0xf8, 0x9a, 0x91, 0x07, 0xd0, 0xd7, 0x4c, 0xc9, 0x12, 0xef, 0x20, 0x0e, 0x79, 0x0a, 0x09, 0x47,
+	0xd0, 0x62, 0x5a, 0x4b, 0xed, 0x77, 0xb1, 0x6f, 0x1e, 0x04, 0x53, 0x38, 0xa8, 0x5c, 0x78, 0xcf,
+	0x8d, 0x25, 0x93, 0xd2, 0x89, 0x15, 0x37, 0xd6, 0x6f, 0x0c, 0xbd, 0x51, 0x7f, 0x7c, 0x12, 0x5e,
+	0x37, 0x3e, 0xac, 0x4a, 0x0a, 0x93, 0x5c, 0x65, 0xf0, 0xab, 0x01, 0xf7, 0x3e, 0xab, 0x85, 0xa6,
+	0x19, 0x43, 0x3c, 0x66, 0x5f, 0x37, 0xcc, 0x58, 0xa7, 0x2c, 0xe5, 0x82, 0xea, 0x6d, 0xa2, 0xa8,
+	0x5d, 0x16, 0xe6, 0x42, 0x9e, 0xfa, 0x48, 0xed, 0x92, 0x1c, 0x43, 0xdb, 0x2c, 0xe9, 0xf8, 0xc9,
+	0x79, 0xe1, 0x6b, 0x11, 0x91, 0x09, 0xf8, 0x5a, 0xae, 0x56, 0x29, 0xbd, 0xb8, 0xc4, 0x4b, 0xc9,
+	0x8d, 0x4d, 0x0c, 0xbb, 0x90, 0x22, 0x33, 0xe8, 0x6c, 0x2b, 0x3e, 0x2e, 0xf1, 0x59, 0x0e, 0x7f,
+	0xca, 0xd1, 0xe0, 0xf7, 0x4e, 0xca, 0x6b, 0x67, 0x6c, 0x29, 0xe5, 0x29, 0x74, 0x99, 0xc8, 0x94,
+	0xe4, 0xc2, 0xa2, 0x8e, 0xfe, 0xf8, 0xb4, 0x7e, 0x35, 0xe4, 0xbf, 0x29, 0x48, 0x71, 0x45, 0x27,
+	0x2f, 0xa0, 0xb3, 0xc9, 0x3b, 0xa2, 0xca, 0xfe, 0xf8, 0x61, 0xbd, 0xf2, 0x1f, 0x77, 0x8f, 0xcb,
+	0x9a, 0xe0, 0x4f, 0x13, 0x8e, 0xae, 0x12, 0x4c, 0x29, 0xe9, 0x86, 0xcd, 0x7b, 0x07, 0x87, 0xbb,
+	0xb5, 0xc2, 0x91, 0x34, 0x71, 0x24, 0x67, 0xf5, 0xd3, 0xdf, 0x96, 0xac, 0x4a, 0xfb, 0x41, 0x55,
+	0x88, 0x83, 0x7d, 0x0e, 0x90, 0x2f, 0x1d, 0x76, 0xf1, 0x86, 0xde, 0xcd, 0xb7, 0xef, 0x61, 0x01,
+	0x56, 0x13, 0xd8, 0x33, 0xfc, 0x07, 0xc3, 0x75, 0xf5, 0x62, 0xfc, 0xbf, 0x32, 0xb7, 0xd6, 0xad,
+	0xe7, 0xd6, 0xfe, 0xdf, 0xdc, 0x5c, 0x47, 0x39, 0x9f, 0x1b, 0x66, 0x8b, 0xfd, 0x2d, 0x22, 0x77,
+	0xba, 0x13, 0x89, 0xab, 0xbb, 0x1f, 0xe3, 0xff, 0xab, 0xc9, 0x97, 0x73, 0xa9, 0x98, 0x50, 0xdc,
+	0x6a, 0xfe, 0x3d, 0xe4, 0x32, 0xda, 0x45, 0x91, 0xba, 0x5c, 0x44, 0x2a, 0x8d, 0xae, 0x3f, 0x01,
+	0xcf, 0x54, 0x8a, 0xdf, 0xb4, 0x8d, 0x2f, 0xc0, 0xe3, 0xbf, 0x03, 0x00, 0x66, 0x26, 0xf1, 0xc3,
+	0x6b, 0x04, 0x00, 0x00,
+}
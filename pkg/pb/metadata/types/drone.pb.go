@@ -29,7 +29,7 @@ func (m *DroneId) Reset()         { *m = DroneId{} }
 func (m *DroneId) String() string { return proto.CompactTextString(m) }
 func (*DroneId) ProtoMessage()    {}
 func (*DroneId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_drone_e1268279a69a094c, []int{0}
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{0}
 }
 func (m *DroneId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DroneId.Unmarshal(m, b)
@@ -67,7 +67,7 @@ func (m *DroneIdList) Reset()         { *m = DroneIdList{} }
 func (m *DroneIdList) String() string { return proto.CompactTextString(m) }
 func (*DroneIdList) ProtoMessage()    {}
 func (*DroneIdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_drone_e1268279a69a094c, []int{1}
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{1}
 }
 func (m *DroneIdList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DroneIdList.Unmarshal(m, b)
@@ -95,12 +95,14 @@ func (m *DroneIdList) GetIdList() []string {
 }
 
 type DroneConfig struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host"`
-	ListenPort           int32    `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
-	CmdInfoLogPath       string   `protobuf:"bytes,4,opt,name=cmd_info_log_path,json=cmdInfoLogPath,proto3" json:"cmd_info_log_path"`
-	ConfdSelfHost        string   `protobuf:"bytes,5,opt,name=confd_self_host,json=confdSelfHost,proto3" json:"confd_self_host"`
-	LogLevel             string   `protobuf:"bytes,6,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Host           string `protobuf:"bytes,2,opt,name=host,proto3" json:"host"`
+	ListenPort     int32  `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
+	CmdInfoLogPath string `protobuf:"bytes,4,opt,name=cmd_info_log_path,json=cmdInfoLogPath,proto3" json:"cmd_info_log_path"`
+	ConfdSelfHost  string `protobuf:"bytes,5,opt,name=confd_self_host,json=confdSelfHost,proto3" json:"confd_self_host"`
+	LogLevel       string `protobuf:"bytes,6,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
+	// files can only be uploaded under this dir
+	UploadRoot           string   `protobuf:"bytes,7,opt,name=upload_root,json=uploadRoot,proto3" json:"upload_root"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -110,7 +112,7 @@ func (m *DroneConfig) Reset()         { *m = DroneConfig{} }
 func (m *DroneConfig) String() string { return proto.CompactTextString(m) }
 func (*DroneConfig) ProtoMessage()    {}
 func (*DroneConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_drone_e1268279a69a094c, []int{2}
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{2}
 }
 func (m *DroneConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DroneConfig.Unmarshal(m, b)
@@ -172,6 +174,13 @@ func (m *DroneConfig) GetLogLevel() string {
 	return ""
 }
 
+func (m *DroneConfig) GetUploadRoot() string {
+	if m != nil {
+		return m.UploadRoot
+	}
+	return ""
+}
+
 type DroneEndpoint struct {
 	FrontgateId          string   `protobuf:"bytes,1,opt,name=frontgate_id,json=frontgateId,proto3" json:"frontgate_id"`
 	DroneIp              string   `protobuf:"bytes,2,opt,name=drone_ip,json=droneIp,proto3" json:"drone_ip"`
@@ -185,7 +194,7 @@ func (m *DroneEndpoint) Reset()         { *m = DroneEndpoint{} }
 func (m *DroneEndpoint) String() string { return proto.CompactTextString(m) }
 func (*DroneEndpoint) ProtoMessage()    {}
 func (*DroneEndpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_drone_e1268279a69a094c, []int{3}
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{3}
 }
 func (m *DroneEndpoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DroneEndpoint.Unmarshal(m, b)
@@ -238,7 +247,7 @@ func (m *SetDroneConfigRequest) Reset()         { *m = SetDroneConfigRequest{} }
 func (m *SetDroneConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*SetDroneConfigRequest) ProtoMessage()    {}
 func (*SetDroneConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_drone_e1268279a69a094c, []int{4}
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{4}
 }
 func (m *SetDroneConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDroneConfigRequest.Unmarshal(m, b)
@@ -285,7 +294,7 @@ func (m *RunCommandOnDroneRequest) Reset()         { *m = RunCommandOnDroneReque
 func (m *RunCommandOnDroneRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCommandOnDroneRequest) ProtoMessage()    {}
 func (*RunCommandOnDroneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_drone_e1268279a69a094c, []int{5}
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{5}
 }
 func (m *RunCommandOnDroneRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunCommandOnDroneRequest.Unmarshal(m, b)
@@ -326,6 +335,328 @@ func (m *RunCommandOnDroneRequest) GetTimeoutSeconds() int32 {
 	return 0
 }
 
+type UploadFileRequest struct {
+	Endpoint *DroneEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint"`
+	// set by pilot, identify the upload session in frontgate
+	UploadId             string   `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path"`
+	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size"`
+	Sha256               string   `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256"`
+	Mode                 int32    `protobuf:"varint,6,opt,name=mode,proto3" json:"mode"`
+	Owner                string   `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner"`
+	Offset               int64    `protobuf:"varint,8,opt,name=offset,proto3" json:"offset"`
+	Data                 []byte   `protobuf:"bytes,9,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadFileRequest) Reset()         { *m = UploadFileRequest{} }
+func (m *UploadFileRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadFileRequest) ProtoMessage()    {}
+func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{6}
+}
+func (m *UploadFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadFileRequest.Unmarshal(m, b)
+}
+func (m *UploadFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadFileRequest.Marshal(b, m, deterministic)
+}
+func (dst *UploadFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadFileRequest.Merge(dst, src)
+}
+func (m *UploadFileRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadFileRequest.Size(m)
+}
+func (m *UploadFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadFileRequest proto.InternalMessageInfo
+
+func (m *UploadFileRequest) GetEndpoint() *DroneEndpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+func (m *UploadFileRequest) GetUploadId() string {
+	if m != nil {
+		return m.UploadId
+	}
+	return ""
+}
+
+func (m *UploadFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *UploadFileRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *UploadFileRequest) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *UploadFileRequest) GetMode() int32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *UploadFileRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *UploadFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *UploadFileRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type DownloadFileRequest struct {
+	Endpoint *DroneEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint"`
+	Path     string         `protobuf:"bytes,2,opt,name=path,proto3" json:"path"`
+	Offset   int64          `protobuf:"varint,3,opt,name=offset,proto3" json:"offset"`
+	Length   int64          `protobuf:"varint,4,opt,name=length,proto3" json:"length"`
+	// set by pilot, identify the download session in frontgate
+	DownloadId           string   `protobuf:"bytes,5,opt,name=download_id,json=downloadId,proto3" json:"download_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadFileRequest) Reset()         { *m = DownloadFileRequest{} }
+func (m *DownloadFileRequest) String() string { return proto.CompactTextString(m) }
+func (*DownloadFileRequest) ProtoMessage()    {}
+func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{7}
+}
+func (m *DownloadFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadFileRequest.Unmarshal(m, b)
+}
+func (m *DownloadFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadFileRequest.Marshal(b, m, deterministic)
+}
+func (dst *DownloadFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadFileRequest.Merge(dst, src)
+}
+func (m *DownloadFileRequest) XXX_Size() int {
+	return xxx_messageInfo_DownloadFileRequest.Size(m)
+}
+func (m *DownloadFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadFileRequest proto.InternalMessageInfo
+
+func (m *DownloadFileRequest) GetEndpoint() *DroneEndpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+func (m *DownloadFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DownloadFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DownloadFileRequest) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *DownloadFileRequest) GetDownloadId() string {
+	if m != nil {
+		return m.DownloadId
+	}
+	return ""
+}
+
+type FileChunk struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size"`
+	Sha256               string   `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256"`
+	Mode                 int32    `protobuf:"varint,4,opt,name=mode,proto3" json:"mode"`
+	Offset               int64    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset"`
+	Data                 []byte   `protobuf:"bytes,6,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileChunk) Reset()         { *m = FileChunk{} }
+func (m *FileChunk) String() string { return proto.CompactTextString(m) }
+func (*FileChunk) ProtoMessage()    {}
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{8}
+}
+func (m *FileChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileChunk.Unmarshal(m, b)
+}
+func (m *FileChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileChunk.Marshal(b, m, deterministic)
+}
+func (dst *FileChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileChunk.Merge(dst, src)
+}
+func (m *FileChunk) XXX_Size() int {
+	return xxx_messageInfo_FileChunk.Size(m)
+}
+func (m *FileChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileChunk proto.InternalMessageInfo
+
+func (m *FileChunk) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FileChunk) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *FileChunk) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *FileChunk) GetMode() int32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileChunk) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *FileChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type TailLogRequest struct {
+	Endpoint             *DroneEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint"`
+	Path                 string         `protobuf:"bytes,2,opt,name=path,proto3" json:"path"`
+	TailLines            int32          `protobuf:"varint,3,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines"`
+	SinceTime            int64          `protobuf:"varint,4,opt,name=since_time,json=sinceTime,proto3" json:"since_time"`
+	Grep                 string         `protobuf:"bytes,5,opt,name=grep,proto3" json:"grep"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TailLogRequest) Reset()         { *m = TailLogRequest{} }
+func (m *TailLogRequest) String() string { return proto.CompactTextString(m) }
+func (*TailLogRequest) ProtoMessage()    {}
+func (*TailLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_drone_fa7a0081831fdf55, []int{9}
+}
+func (m *TailLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TailLogRequest.Unmarshal(m, b)
+}
+func (m *TailLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TailLogRequest.Marshal(b, m, deterministic)
+}
+func (dst *TailLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TailLogRequest.Merge(dst, src)
+}
+func (m *TailLogRequest) XXX_Size() int {
+	return xxx_messageInfo_TailLogRequest.Size(m)
+}
+func (m *TailLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TailLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TailLogRequest proto.InternalMessageInfo
+
+func (m *TailLogRequest) GetEndpoint() *DroneEndpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+func (m *TailLogRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *TailLogRequest) GetTailLines() int32 {
+	if m != nil {
+		return m.TailLines
+	}
+	return 0
+}
+
+func (m *TailLogRequest) GetSinceTime() int64 {
+	if m != nil {
+		return m.SinceTime
+	}
+	return 0
+}
+
+func (m *TailLogRequest) GetGrep() string {
+	if m != nil {
+		return m.Grep
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DroneId)(nil), "metadata.types.DroneId")
 	proto.RegisterType((*DroneIdList)(nil), "metadata.types.DroneIdList")
@@ -333,39 +664,58 @@ func init() {
 	proto.RegisterType((*DroneEndpoint)(nil), "metadata.types.DroneEndpoint")
 	proto.RegisterType((*SetDroneConfigRequest)(nil), "metadata.types.SetDroneConfigRequest")
 	proto.RegisterType((*RunCommandOnDroneRequest)(nil), "metadata.types.RunCommandOnDroneRequest")
-}
-
-func init() { proto.RegisterFile("metadata/types/drone.proto", fileDescriptor_drone_e1268279a69a094c) }
-
-var fileDescriptor_drone_e1268279a69a094c = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x95, 0xd3, 0x36, 0x1f, 0x63, 0x9a, 0x8a, 0x95, 0x10, 0x6e, 0xab, 0x8a, 0xe0, 0x43, 0x09,
-	0x17, 0x5b, 0x6a, 0x25, 0x04, 0xe2, 0x46, 0x41, 0x22, 0x52, 0x24, 0x2a, 0xe7, 0xc6, 0x65, 0xe5,
-	0x78, 0xd7, 0xce, 0x0a, 0x7b, 0x67, 0xb1, 0x27, 0x08, 0x7e, 0x01, 0x7f, 0x81, 0xdf, 0xc3, 0x2f,
-	0x43, 0x1e, 0xbb, 0xa1, 0xa9, 0x38, 0x72, 0xb2, 0xe7, 0xbd, 0xb7, 0x6f, 0xe6, 0xcd, 0x2e, 0x9c,
-	0x55, 0x9a, 0x52, 0x95, 0x52, 0x1a, 0xd3, 0x0f, 0xa7, 0x9b, 0x58, 0xd5, 0x68, 0x75, 0xe4, 0x6a,
-	0x24, 0x14, 0xd3, 0x3b, 0x2e, 0x62, 0xee, 0xec, 0xa1, 0x36, 0x43, 0x9b, 0xab, 0x4e, 0x1b, 0x9e,
-	0xc2, 0xe8, 0x7d, 0x7b, 0x74, 0xa1, 0xc4, 0x14, 0x06, 0x46, 0x05, 0xde, 0xcc, 0x9b, 0x4f, 0x92,
-	0x81, 0x51, 0xe1, 0x25, 0xf8, 0x3d, 0xb5, 0x34, 0x0d, 0x89, 0xa7, 0x30, 0x32, 0x4a, 0x96, 0xa6,
-	0xa1, 0xc0, 0x9b, 0x1d, 0xcc, 0x27, 0xc9, 0xd0, 0x30, 0x11, 0xfe, 0xf6, 0x7a, 0xe1, 0x0d, 0xda,
-	0xdc, 0x14, 0x0f, 0x7d, 0x84, 0x80, 0xc3, 0x0d, 0x36, 0x14, 0x0c, 0x18, 0xe1, 0x7f, 0xf1, 0x0c,
-	0xfc, 0xd6, 0x49, 0x5b, 0xe9, 0xb0, 0xa6, 0xe0, 0x60, 0xe6, 0xcd, 0x8f, 0x12, 0xe8, 0xa0, 0x5b,
-	0xac, 0x49, 0xbc, 0x84, 0xc7, 0x59, 0xa5, 0xa4, 0xb1, 0x39, 0xca, 0x12, 0x0b, 0xe9, 0x52, 0xda,
-	0x04, 0x87, 0xec, 0x30, 0xcd, 0x2a, 0xb5, 0xb0, 0x39, 0x2e, 0xb1, 0xb8, 0x4d, 0x69, 0x23, 0x2e,
-	0xe1, 0x84, 0x13, 0xc9, 0x46, 0x97, 0xb9, 0xe4, 0x56, 0x47, 0x2c, 0x3c, 0x66, 0x78, 0xa5, 0xcb,
-	0xfc, 0x63, 0xdb, 0xf3, 0x1c, 0x26, 0xad, 0x53, 0xa9, 0xbf, 0xe9, 0x32, 0x18, 0xb2, 0x62, 0x5c,
-	0x62, 0xb1, 0x6c, 0xeb, 0xb0, 0x84, 0x63, 0xce, 0xf0, 0xc1, 0x2a, 0x87, 0xc6, 0x92, 0x78, 0x0e,
-	0x8f, 0xf2, 0x1a, 0x2d, 0x15, 0x29, 0x69, 0xb9, 0xcb, 0xe3, 0xef, 0xb0, 0x85, 0x12, 0xa7, 0x30,
-	0xe6, 0xb5, 0x4b, 0xe3, 0xfa, 0x70, 0x23, 0xae, 0x17, 0x4e, 0x5c, 0x00, 0x74, 0xd4, 0xbd, 0x78,
-	0x13, 0x46, 0xda, 0x74, 0xe1, 0x4f, 0x0f, 0x9e, 0xac, 0x34, 0xdd, 0xdb, 0x5a, 0xa2, 0xbf, 0x6e,
-	0x75, 0x43, 0xe2, 0x0d, 0x8c, 0x75, 0x3f, 0x02, 0xb7, 0xf4, 0xaf, 0x2e, 0xa2, 0xfd, 0xeb, 0x8c,
-	0xf6, 0xe6, 0x4c, 0x76, 0x72, 0x71, 0x0d, 0xc3, 0x8c, 0xbd, 0x78, 0x18, 0xff, 0xea, 0xfc, 0x9f,
-	0x07, 0xfb, 0x76, 0xbd, 0x34, 0xfc, 0xe5, 0x41, 0x90, 0x6c, 0xed, 0x0d, 0x56, 0x55, 0x6a, 0xd5,
-	0x27, 0xcb, 0x9a, 0xff, 0x30, 0x4c, 0x00, 0xa3, 0xac, 0xf3, 0xbc, 0x5b, 0x4d, 0x5f, 0x8a, 0x17,
-	0x70, 0x42, 0xa6, 0xd2, 0xb8, 0x25, 0xd9, 0xe8, 0x0c, 0xad, 0x6a, 0xfa, 0xfd, 0x4c, 0x7b, 0x78,
-	0xd5, 0xa1, 0xef, 0x5e, 0x7f, 0x7e, 0x85, 0x4e, 0x5b, 0x67, 0xa8, 0x36, 0xdf, 0x23, 0x83, 0xf1,
-	0xdf, 0x2a, 0x76, 0x5f, 0x8a, 0xd8, 0xad, 0xe3, 0xfd, 0x87, 0xfd, 0xd6, 0xad, 0xf9, 0xbb, 0x1e,
-	0xf2, 0xdb, 0xbe, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x47, 0xac, 0x6f, 0xba, 0x25, 0x03, 0x00,
-	0x00,
+	proto.RegisterType((*UploadFileRequest)(nil), "metadata.types.UploadFileRequest")
+	proto.RegisterType((*DownloadFileRequest)(nil), "metadata.types.DownloadFileRequest")
+	proto.RegisterType((*FileChunk)(nil), "metadata.types.FileChunk")
+	proto.RegisterType((*TailLogRequest)(nil), "metadata.types.TailLogRequest")
+}
+
+func init() { proto.RegisterFile("metadata/types/drone.proto", fileDescriptor_drone_fa7a0081831fdf55) }
+
+var fileDescriptor_drone_fa7a0081831fdf55 = []byte{
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xd3, 0x4a,
+	0x10, 0x97, 0x93, 0xe6, 0x8f, 0x27, 0x6d, 0xaa, 0xee, 0x7b, 0xaf, 0xcf, 0x6d, 0x55, 0xbd, 0x3c,
+	0x1f, 0x4a, 0xb8, 0x24, 0x52, 0x2b, 0x2a, 0x10, 0x37, 0x5a, 0x10, 0x91, 0x22, 0x51, 0xb9, 0xe5,
+	0xc2, 0xc5, 0x72, 0xb3, 0x6b, 0x67, 0x55, 0x7b, 0xc7, 0xd8, 0x1b, 0x0a, 0xdc, 0x38, 0x71, 0xe0,
+	0x0b, 0xf0, 0x35, 0x38, 0xf0, 0x89, 0xf8, 0x22, 0x68, 0xc7, 0x9b, 0x34, 0xa9, 0x72, 0x2c, 0x27,
+	0xcf, 0xfc, 0x66, 0x3c, 0xfb, 0x9b, 0xdf, 0xfe, 0x6c, 0xd8, 0xcf, 0x84, 0x8e, 0x78, 0xa4, 0xa3,
+	0xa1, 0xfe, 0x94, 0x8b, 0x72, 0xc8, 0x0b, 0x54, 0x62, 0x90, 0x17, 0xa8, 0x91, 0x75, 0xe7, 0xb5,
+	0x01, 0xd5, 0xf6, 0xef, 0xf7, 0x4e, 0x50, 0xc5, 0xbc, 0xea, 0xf5, 0xf7, 0xa0, 0x75, 0x6e, 0x5e,
+	0x1d, 0x71, 0xd6, 0x85, 0x9a, 0xe4, 0x9e, 0xd3, 0x73, 0xfa, 0x6e, 0x50, 0x93, 0xdc, 0x3f, 0x82,
+	0x8e, 0x2d, 0x8d, 0x65, 0xa9, 0xd9, 0xbf, 0xd0, 0x92, 0x3c, 0x4c, 0x65, 0xa9, 0x3d, 0xa7, 0x57,
+	0xef, 0xbb, 0x41, 0x53, 0x52, 0xc1, 0xff, 0xe5, 0xd8, 0xc6, 0x33, 0x54, 0xb1, 0x4c, 0xee, 0xcf,
+	0x61, 0x0c, 0x36, 0xa6, 0x58, 0x6a, 0xaf, 0x46, 0x08, 0xc5, 0xec, 0x3f, 0xe8, 0x98, 0x49, 0x42,
+	0x85, 0x39, 0x16, 0xda, 0xab, 0xf7, 0x9c, 0x7e, 0x23, 0x80, 0x0a, 0xba, 0xc0, 0x42, 0xb3, 0xc7,
+	0xb0, 0x33, 0xc9, 0x78, 0x28, 0x55, 0x8c, 0x61, 0x8a, 0x49, 0x98, 0x47, 0x7a, 0xea, 0x6d, 0xd0,
+	0x84, 0xee, 0x24, 0xe3, 0x23, 0x15, 0xe3, 0x18, 0x93, 0x8b, 0x48, 0x4f, 0xd9, 0x11, 0x6c, 0xd3,
+	0x46, 0x61, 0x29, 0xd2, 0x38, 0xa4, 0xa3, 0x1a, 0xd4, 0xb8, 0x45, 0xf0, 0xa5, 0x48, 0xe3, 0xd7,
+	0xe6, 0xcc, 0x03, 0x70, 0xcd, 0xa4, 0x54, 0x7c, 0x10, 0xa9, 0xd7, 0xa4, 0x8e, 0x76, 0x8a, 0xc9,
+	0xd8, 0xe4, 0x86, 0xd0, 0x2c, 0x4f, 0x31, 0xe2, 0x61, 0x81, 0xa8, 0xbd, 0x16, 0x95, 0xa1, 0x82,
+	0x02, 0x44, 0xed, 0xa7, 0xb0, 0x45, 0x4b, 0xbe, 0x54, 0x3c, 0x47, 0xa9, 0x34, 0xfb, 0x1f, 0x36,
+	0xe3, 0x02, 0x95, 0x4e, 0x22, 0x2d, 0xc2, 0xc5, 0xc2, 0x9d, 0x05, 0x36, 0xe2, 0x6c, 0x0f, 0xda,
+	0x74, 0x2f, 0xa1, 0xcc, 0xed, 0xf6, 0x2d, 0xca, 0x47, 0x39, 0x3b, 0x04, 0xa8, 0x4a, 0x4b, 0xfb,
+	0xbb, 0x84, 0x98, 0xf5, 0xfd, 0xaf, 0x0e, 0xfc, 0x73, 0x29, 0xf4, 0x92, 0xac, 0x81, 0x78, 0x3f,
+	0x13, 0xa5, 0x66, 0xcf, 0xa0, 0x2d, 0x2c, 0x05, 0x3a, 0xb2, 0x73, 0x7c, 0x38, 0x58, 0xbd, 0xef,
+	0xc1, 0x0a, 0xcf, 0x60, 0xd1, 0xce, 0x4e, 0xa0, 0x39, 0xa1, 0x59, 0x44, 0xa6, 0x73, 0x7c, 0xb0,
+	0xf6, 0x45, 0x7b, 0x9c, 0x6d, 0xf5, 0xbf, 0x3b, 0xe0, 0x05, 0x33, 0x75, 0x86, 0x59, 0x16, 0x29,
+	0xfe, 0x46, 0x51, 0xcf, 0x03, 0x90, 0xf1, 0xa0, 0x35, 0xa9, 0x66, 0xce, 0xa5, 0xb1, 0x29, 0x7b,
+	0x04, 0xdb, 0x5a, 0x66, 0x02, 0x67, 0x3a, 0x2c, 0xc5, 0x04, 0x15, 0x2f, 0xad, 0x3e, 0x5d, 0x0b,
+	0x5f, 0x56, 0xa8, 0xff, 0xa5, 0x06, 0x3b, 0x6f, 0xe9, 0x86, 0x5e, 0xc9, 0xf4, 0x21, 0x38, 0x1d,
+	0x80, 0x6b, 0x4d, 0x20, 0xe7, 0xac, 0xda, 0x15, 0x30, 0x22, 0x1b, 0x93, 0x09, 0xeb, 0x95, 0x8d,
+	0x4d, 0x6c, 0xb0, 0x52, 0x7e, 0x16, 0x64, 0xcc, 0x7a, 0x40, 0x31, 0xdb, 0x85, 0x66, 0x39, 0x8d,
+	0x8e, 0x9f, 0x9c, 0x5a, 0x17, 0xda, 0xcc, 0xf4, 0x66, 0xc8, 0x05, 0x39, 0xaf, 0x11, 0x50, 0xcc,
+	0xfe, 0x86, 0x06, 0xde, 0x2a, 0x51, 0x58, 0xbf, 0x55, 0x89, 0x99, 0x80, 0x71, 0x5c, 0x0a, 0xed,
+	0xb5, 0x69, 0xae, 0xcd, 0xcc, 0x04, 0xb3, 0x84, 0xe7, 0xf6, 0x9c, 0xfe, 0x66, 0x40, 0xb1, 0xff,
+	0xd3, 0x81, 0xbf, 0xce, 0xf1, 0x56, 0x3d, 0xa0, 0x0a, 0xf3, 0x45, 0x6b, 0x4b, 0x8b, 0xde, 0x51,
+	0xaa, 0xaf, 0x50, 0xda, 0x85, 0x66, 0x2a, 0x54, 0x62, 0xbf, 0xcd, 0x7a, 0x60, 0x33, 0xf3, 0x39,
+	0x71, 0xcb, 0xca, 0x68, 0x59, 0x29, 0x01, 0x73, 0x68, 0xc4, 0xfd, 0x6f, 0x0e, 0xb8, 0x86, 0xef,
+	0xd9, 0x74, 0xa6, 0x6e, 0x16, 0x47, 0x3a, 0x6b, 0xb4, 0xad, 0xad, 0xd5, 0xb6, 0xbe, 0x56, 0xdb,
+	0x8d, 0x25, 0x6d, 0xef, 0x28, 0x37, 0xd6, 0xaa, 0xd8, 0x5c, 0x52, 0xf1, 0x87, 0x03, 0xdd, 0xab,
+	0x48, 0xa6, 0x63, 0x4c, 0xfe, 0x90, 0x80, 0x87, 0x00, 0x3a, 0x92, 0x69, 0x98, 0x4a, 0x25, 0xe6,
+	0x7e, 0x76, 0x0d, 0x32, 0x36, 0x80, 0x29, 0x97, 0x52, 0x4d, 0x44, 0x68, 0x2c, 0x6e, 0xb5, 0x74,
+	0x09, 0xb9, 0x92, 0x99, 0x30, 0x13, 0x93, 0x42, 0xe4, 0x56, 0x47, 0x8a, 0x5f, 0x3c, 0x7d, 0x77,
+	0x8a, 0xb9, 0x50, 0xb9, 0xd4, 0x85, 0xfc, 0x38, 0x90, 0x38, 0xbc, 0xcb, 0x86, 0xf9, 0x4d, 0x32,
+	0xcc, 0xaf, 0x87, 0xab, 0xff, 0xfd, 0xe7, 0xf9, 0x35, 0x3d, 0xaf, 0x9b, 0xf4, 0xeb, 0x3f, 0xf9,
+	0x3d, 0x00, 0x4a, 0x68, 0x34, 0xe9, 0x44, 0x06, 0x00, 0x00,
 }
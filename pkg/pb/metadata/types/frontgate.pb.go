@@ -30,7 +30,7 @@ func (m *FrontgateId) Reset()         { *m = FrontgateId{} }
 func (m *FrontgateId) String() string { return proto.CompactTextString(m) }
 func (*FrontgateId) ProtoMessage()    {}
 func (*FrontgateId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_frontgate_f2b19ab9f940b0ad, []int{0}
+	return fileDescriptor_frontgate_faa840b98580a2e1, []int{0}
 }
 func (m *FrontgateId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FrontgateId.Unmarshal(m, b)
@@ -77,7 +77,7 @@ func (m *FrontgateNodeId) Reset()         { *m = FrontgateNodeId{} }
 func (m *FrontgateNodeId) String() string { return proto.CompactTextString(m) }
 func (*FrontgateNodeId) ProtoMessage()    {}
 func (*FrontgateNodeId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_frontgate_f2b19ab9f940b0ad, []int{1}
+	return fileDescriptor_frontgate_faa840b98580a2e1, []int{1}
 }
 func (m *FrontgateNodeId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FrontgateNodeId.Unmarshal(m, b)
@@ -129,7 +129,7 @@ func (m *FrontgateIdList) Reset()         { *m = FrontgateIdList{} }
 func (m *FrontgateIdList) String() string { return proto.CompactTextString(m) }
 func (*FrontgateIdList) ProtoMessage()    {}
 func (*FrontgateIdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_frontgate_f2b19ab9f940b0ad, []int{2}
+	return fileDescriptor_frontgate_faa840b98580a2e1, []int{2}
 }
 func (m *FrontgateIdList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FrontgateIdList.Unmarshal(m, b)
@@ -157,26 +157,28 @@ func (m *FrontgateIdList) GetIdList() []string {
 }
 
 type FrontgateConfig struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	NodeId               string               `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id"`
-	Host                 string               `protobuf:"bytes,3,opt,name=host,proto3" json:"host"`
-	ListenPort           int32                `protobuf:"varint,4,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
-	PilotHost            string               `protobuf:"bytes,5,opt,name=pilot_host,json=pilotHost,proto3" json:"pilot_host"`
-	PilotPort            int32                `protobuf:"varint,6,opt,name=pilot_port,json=pilotPort,proto3" json:"pilot_port"`
-	NodeList             []*FrontgateEndpoint `protobuf:"bytes,7,rep,name=node_list,json=nodeList,proto3" json:"node_list"`
-	EtcdConfig           *EtcdConfig          `protobuf:"bytes,8,opt,name=etcd_config,json=etcdConfig,proto3" json:"etcd_config"`
-	ConfdConfig          *ConfdConfig         `protobuf:"bytes,9,opt,name=confd_config,json=confdConfig,proto3" json:"confd_config"`
-	LogLevel             string               `protobuf:"bytes,10,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id          string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	NodeId      string               `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id"`
+	Host        string               `protobuf:"bytes,3,opt,name=host,proto3" json:"host"`
+	ListenPort  int32                `protobuf:"varint,4,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
+	PilotHost   string               `protobuf:"bytes,5,opt,name=pilot_host,json=pilotHost,proto3" json:"pilot_host"`
+	PilotPort   int32                `protobuf:"varint,6,opt,name=pilot_port,json=pilotPort,proto3" json:"pilot_port"`
+	NodeList    []*FrontgateEndpoint `protobuf:"bytes,7,rep,name=node_list,json=nodeList,proto3" json:"node_list"`
+	EtcdConfig  *EtcdConfig          `protobuf:"bytes,8,opt,name=etcd_config,json=etcdConfig,proto3" json:"etcd_config"`
+	ConfdConfig *ConfdConfig         `protobuf:"bytes,9,opt,name=confd_config,json=confdConfig,proto3" json:"confd_config"`
+	LogLevel    string               `protobuf:"bytes,10,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
+	// other pilot replicas, used when pilot_host:pilot_port is unavailable
+	PilotList            []*PilotEndpoint `protobuf:"bytes,11,rep,name=pilot_list,json=pilotList,proto3" json:"pilot_list"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *FrontgateConfig) Reset()         { *m = FrontgateConfig{} }
 func (m *FrontgateConfig) String() string { return proto.CompactTextString(m) }
 func (*FrontgateConfig) ProtoMessage()    {}
 func (*FrontgateConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_frontgate_f2b19ab9f940b0ad, []int{3}
+	return fileDescriptor_frontgate_faa840b98580a2e1, []int{3}
 }
 func (m *FrontgateConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FrontgateConfig.Unmarshal(m, b)
@@ -266,6 +268,13 @@ func (m *FrontgateConfig) GetLogLevel() string {
 	return ""
 }
 
+func (m *FrontgateConfig) GetPilotList() []*PilotEndpoint {
+	if m != nil {
+		return m.PilotList
+	}
+	return nil
+}
+
 type FrontgateEndpoint struct {
 	FrontgateId          string   `protobuf:"bytes,1,opt,name=frontgate_id,json=frontgateId,proto3" json:"frontgate_id"`
 	FrontgateNodeId      string   `protobuf:"bytes,2,opt,name=frontgate_node_id,json=frontgateNodeId,proto3" json:"frontgate_node_id"`
@@ -280,7 +289,7 @@ func (m *FrontgateEndpoint) Reset()         { *m = FrontgateEndpoint{} }
 func (m *FrontgateEndpoint) String() string { return proto.CompactTextString(m) }
 func (*FrontgateEndpoint) ProtoMessage()    {}
 func (*FrontgateEndpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_frontgate_f2b19ab9f940b0ad, []int{4}
+	return fileDescriptor_frontgate_faa840b98580a2e1, []int{4}
 }
 func (m *FrontgateEndpoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FrontgateEndpoint.Unmarshal(m, b)
@@ -341,7 +350,7 @@ func (m *RunCommandOnFrontgateRequest) Reset()         { *m = RunCommandOnFrontg
 func (m *RunCommandOnFrontgateRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCommandOnFrontgateRequest) ProtoMessage()    {}
 func (*RunCommandOnFrontgateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_frontgate_f2b19ab9f940b0ad, []int{5}
+	return fileDescriptor_frontgate_faa840b98580a2e1, []int{5}
 }
 func (m *RunCommandOnFrontgateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunCommandOnFrontgateRequest.Unmarshal(m, b)
@@ -392,41 +401,43 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("metadata/types/frontgate.proto", fileDescriptor_frontgate_f2b19ab9f940b0ad)
-}
-
-var fileDescriptor_frontgate_f2b19ab9f940b0ad = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x95, 0x9b, 0xe6, 0x6b, 0x5c, 0x25, 0xea, 0x5e, 0x30, 0x29, 0x1f, 0x69, 0x2e, 0x44, 0x3d,
-	0xc4, 0x52, 0x91, 0x00, 0xa9, 0xa2, 0x07, 0xaa, 0x22, 0x2a, 0x55, 0x80, 0x0c, 0x27, 0x2e, 0x96,
-	0xe3, 0x5d, 0x9b, 0x15, 0xce, 0xce, 0x62, 0x6f, 0x10, 0xfd, 0x05, 0xfc, 0x05, 0x8e, 0xfc, 0x54,
-	0xb4, 0xe3, 0x8f, 0x38, 0x86, 0x43, 0x4f, 0xf6, 0xcc, 0x9b, 0xf7, 0xf6, 0xed, 0xbe, 0x5d, 0x78,
-	0xb2, 0x11, 0x26, 0xe2, 0x91, 0x89, 0x7c, 0x73, 0xa7, 0x45, 0xe1, 0x27, 0x39, 0x2a, 0x93, 0x46,
-	0x46, 0xac, 0x74, 0x8e, 0x06, 0xd9, 0xa4, 0xc6, 0x57, 0x84, 0xcf, 0x1e, 0x76, 0xe6, 0x85, 0x89,
-	0x79, 0x39, 0x3a, 0x9b, 0x75, 0xa0, 0x18, 0x55, 0x52, 0x61, 0x8b, 0x97, 0xe0, 0xbe, 0xad, 0x95,
-	0x6f, 0x38, 0x9b, 0xc0, 0x81, 0xe4, 0x9e, 0x33, 0x77, 0x96, 0xe3, 0xe0, 0x40, 0x72, 0xe6, 0xc1,
-	0x50, 0x47, 0x77, 0x19, 0x46, 0xdc, 0x3b, 0xa0, 0x66, 0x5d, 0x2e, 0x3e, 0xc3, 0xb4, 0x21, 0xbe,
-	0x47, 0xfe, 0x3f, 0xf2, 0x03, 0x18, 0x2a, 0xe4, 0x22, 0x94, 0x35, 0x79, 0xa0, 0xca, 0xc1, 0x96,
-	0x6a, 0x6f, 0x5f, 0xf5, 0xac, 0xa5, 0x7a, 0xc3, 0x6f, 0x65, 0x61, 0xac, 0x8a, 0xe4, 0x61, 0x26,
-	0x0b, 0xe3, 0x39, 0xf3, 0x9e, 0x55, 0x91, 0x04, 0x2c, 0x7e, 0xf5, 0x5a, 0xc3, 0x57, 0xa8, 0x12,
-	0x99, 0xde, 0xdf, 0x02, 0x83, 0xc3, 0xaf, 0x58, 0x98, 0x6a, 0x7d, 0xfa, 0x67, 0x4f, 0xc1, 0xb5,
-	0xcb, 0x08, 0x15, 0x6a, 0xcc, 0x8d, 0x77, 0x38, 0x77, 0x96, 0xfd, 0x00, 0xca, 0xd6, 0x47, 0xcc,
-	0x0d, 0x7b, 0x0c, 0xa0, 0x65, 0x86, 0x26, 0x24, 0x6a, 0x9f, 0xa8, 0x63, 0xea, 0xbc, 0xb3, 0xfc,
-	0x06, 0x26, 0xfa, 0x80, 0xe8, 0x25, 0x4c, 0xec, 0x4b, 0x18, 0x93, 0x17, 0xda, 0xca, 0x70, 0xde,
-	0x5b, 0xba, 0xe7, 0xa7, 0xab, 0xfd, 0x14, 0x57, 0xcd, 0x7e, 0xae, 0x15, 0xd7, 0x28, 0x95, 0x09,
-	0x46, 0x96, 0x43, 0x07, 0x71, 0x01, 0xae, 0x0d, 0x35, 0x8c, 0x69, 0xab, 0xde, 0x68, 0xee, 0x2c,
-	0xdd, 0xf3, 0x59, 0x57, 0xe1, 0xda, 0xc4, 0xbc, 0x3c, 0x8c, 0x00, 0x44, 0xf3, 0xcf, 0x2e, 0xe1,
-	0x88, 0x62, 0xaf, 0xd9, 0x63, 0x62, 0x9f, 0x74, 0xd9, 0x76, 0xba, 0xa6, 0xbb, 0xf1, 0xae, 0x60,
-	0x27, 0x30, 0xce, 0x30, 0x0d, 0x33, 0xf1, 0x43, 0x64, 0x1e, 0xd0, 0xce, 0x47, 0x19, 0xa6, 0xb7,
-	0xb6, 0x5e, 0xfc, 0x76, 0xe0, 0xf8, 0x1f, 0xe7, 0xec, 0x14, 0x8e, 0x9a, 0x4b, 0x1b, 0x36, 0xa9,
-	0xb8, 0x49, 0xeb, 0xba, 0x9d, 0xc1, 0xf1, 0x6e, 0x64, 0x3f, 0xa8, 0x69, 0xd2, 0xb9, 0x5d, 0x4d,
-	0x94, 0xba, 0x0a, 0xad, 0x8c, 0x52, 0x5b, 0x6b, 0x04, 0xb4, 0x42, 0xa3, 0x43, 0xb3, 0x87, 0xbe,
-	0xf8, 0xe3, 0xc0, 0xa3, 0x60, 0xab, 0xae, 0x70, 0xb3, 0x89, 0x14, 0xff, 0xa0, 0x1a, 0x9b, 0x81,
-	0xf8, 0xbe, 0x15, 0x85, 0x61, 0xaf, 0x61, 0x24, 0x2a, 0xc7, 0xe4, 0xf0, 0x7e, 0xa1, 0xd4, 0x14,
-	0x7b, 0x95, 0xe3, 0x52, 0xbb, 0x7e, 0x20, 0x55, 0xc9, 0x9e, 0xc1, 0xd4, 0xc8, 0x8d, 0xc0, 0xad,
-	0x09, 0x0b, 0x11, 0xa3, 0xe2, 0x05, 0xf9, 0xee, 0x07, 0x93, 0xaa, 0xfd, 0xa9, 0xec, 0xbe, 0x79,
-	0xf5, 0xe5, 0x05, 0x6a, 0xa1, 0xb4, 0x34, 0xb9, 0xfc, 0xb9, 0x92, 0xe8, 0xef, 0x2a, 0x5f, 0x7f,
-	0x4b, 0x7d, 0xbd, 0xf6, 0xf7, 0x1f, 0xf0, 0x85, 0x5e, 0xd3, 0x77, 0x3d, 0xa0, 0x37, 0xfc, 0xfc,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x61, 0x45, 0xb6, 0x2c, 0x04, 0x00, 0x00,
+	proto.RegisterFile("metadata/types/frontgate.proto", fileDescriptor_frontgate_faa840b98580a2e1)
+}
+
+var fileDescriptor_frontgate_faa840b98580a2e1 = []byte{
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x95, 0x9b, 0xe6, 0x6b, 0x5c, 0x25, 0xea, 0x5e, 0x30, 0x29, 0x85, 0x34, 0x17, 0xa2, 0x1e,
+	0x62, 0xa9, 0x48, 0x80, 0x54, 0xe8, 0x81, 0xaa, 0x88, 0x4a, 0x15, 0x54, 0x86, 0x13, 0x17, 0xcb,
+	0xf1, 0xae, 0xcd, 0x0a, 0x67, 0x67, 0xb1, 0x37, 0x88, 0xfe, 0x13, 0x8e, 0xfc, 0x19, 0xfe, 0x17,
+	0xda, 0x71, 0xec, 0x38, 0x06, 0xa4, 0x9e, 0xe2, 0x99, 0x37, 0x6f, 0x76, 0xde, 0xbc, 0xdd, 0xc0,
+	0xe3, 0x95, 0x30, 0x11, 0x8f, 0x4c, 0xe4, 0x9b, 0x3b, 0x2d, 0x0a, 0x3f, 0xc9, 0x51, 0x99, 0x34,
+	0x32, 0x62, 0xa1, 0x73, 0x34, 0xc8, 0x46, 0x15, 0xbe, 0x20, 0x7c, 0xf2, 0xb0, 0x55, 0x2f, 0x4c,
+	0xcc, 0xcb, 0xd2, 0xc9, 0xa4, 0x05, 0xc5, 0xa8, 0x92, 0xff, 0x61, 0x5a, 0x66, 0x68, 0x4a, 0x6c,
+	0xf6, 0x02, 0xdc, 0xb7, 0xd5, 0xa9, 0xd7, 0x9c, 0x8d, 0x60, 0x4f, 0x72, 0xcf, 0x99, 0x3a, 0xf3,
+	0x61, 0xb0, 0x27, 0x39, 0xf3, 0xa0, 0xaf, 0xa3, 0xbb, 0x0c, 0x23, 0xee, 0xed, 0x51, 0xb2, 0x0a,
+	0x67, 0x9f, 0x60, 0x5c, 0x13, 0xdf, 0x23, 0xff, 0x17, 0xf9, 0x01, 0xf4, 0x15, 0x72, 0x11, 0xca,
+	0x8a, 0xdc, 0x53, 0x65, 0x61, 0xa3, 0x6b, 0x67, 0xb7, 0xeb, 0x69, 0xa3, 0xeb, 0x35, 0xbf, 0x91,
+	0x85, 0xb1, 0x5d, 0x24, 0x0f, 0x33, 0x59, 0x18, 0xcf, 0x99, 0x76, 0x6c, 0x17, 0x49, 0xc0, 0xec,
+	0x77, 0xa7, 0x51, 0x7c, 0x89, 0x2a, 0x91, 0xe9, 0xfd, 0x47, 0x60, 0xb0, 0xff, 0x05, 0x0b, 0xb3,
+	0x39, 0x9f, 0xbe, 0xd9, 0x13, 0x70, 0xed, 0x31, 0x42, 0x85, 0x1a, 0x73, 0xe3, 0xed, 0x4f, 0x9d,
+	0x79, 0x37, 0x80, 0x32, 0x75, 0x8b, 0xb9, 0x61, 0xc7, 0x00, 0xb4, 0xbb, 0x90, 0xa8, 0x5d, 0xa2,
+	0x0e, 0x29, 0xf3, 0x0e, 0x8b, 0x06, 0x4c, 0xf4, 0x1e, 0xd1, 0x4b, 0x98, 0xd8, 0x17, 0x30, 0xa4,
+	0x59, 0x48, 0x4a, 0x7f, 0xda, 0x99, 0xbb, 0x67, 0x27, 0x8b, 0x5d, 0x87, 0x17, 0xb5, 0x9e, 0x2b,
+	0xc5, 0x35, 0x4a, 0x65, 0x82, 0x81, 0xe5, 0xd0, 0x22, 0xce, 0xc1, 0xb5, 0x86, 0x87, 0x31, 0x49,
+	0xf5, 0x06, 0x53, 0x67, 0xee, 0x9e, 0x4d, 0xda, 0x1d, 0xae, 0x4c, 0xcc, 0xcb, 0x65, 0x04, 0x20,
+	0xea, 0x6f, 0x76, 0x01, 0x07, 0x96, 0x57, 0xb3, 0x87, 0xc4, 0x3e, 0x6a, 0xb3, 0x6d, 0x75, 0x45,
+	0x77, 0xe3, 0x6d, 0xc0, 0x8e, 0x60, 0x98, 0x61, 0x1a, 0x66, 0xe2, 0xbb, 0xc8, 0x3c, 0x20, 0xe5,
+	0x83, 0x0c, 0xd3, 0x1b, 0x1b, 0xb3, 0x57, 0x95, 0x70, 0x92, 0xe6, 0x92, 0xb4, 0xe3, 0x76, 0xeb,
+	0x5b, 0x5b, 0x51, 0xcb, 0x2a, 0xf7, 0x42, 0x3e, 0xfe, 0x74, 0xe0, 0xf0, 0x2f, 0xdd, 0xec, 0x04,
+	0x0e, 0xea, 0xe7, 0x10, 0xd6, 0x9e, 0xba, 0x49, 0xe3, 0xb2, 0x9e, 0xc2, 0xe1, 0xb6, 0x64, 0xd7,
+	0xe6, 0x71, 0xd2, 0xba, 0x9b, 0xf5, 0x45, 0xd0, 0x5e, 0xa7, 0x71, 0x11, 0xb4, 0x15, 0x46, 0x40,
+	0xc3, 0x72, 0x5a, 0xb9, 0xb5, 0x6c, 0xf6, 0xcb, 0x81, 0x47, 0xc1, 0x5a, 0x5d, 0xe2, 0x6a, 0x15,
+	0x29, 0xfe, 0x41, 0xd5, 0x63, 0x06, 0xe2, 0xdb, 0x5a, 0x14, 0x86, 0xbd, 0x86, 0x81, 0xd8, 0x4c,
+	0x4c, 0x13, 0xde, 0xcf, 0xd2, 0x8a, 0x62, 0x1f, 0x42, 0x5c, 0xf6, 0xae, 0x9e, 0xd7, 0x26, 0x64,
+	0x4f, 0x61, 0x6c, 0xe4, 0x4a, 0xe0, 0xda, 0x84, 0x85, 0x88, 0x51, 0xf1, 0x82, 0xe6, 0xee, 0x06,
+	0xa3, 0x4d, 0xfa, 0x63, 0x99, 0x7d, 0xf3, 0xf2, 0xf3, 0x73, 0xd4, 0x42, 0x69, 0x69, 0x72, 0xf9,
+	0x63, 0x21, 0xd1, 0xdf, 0x46, 0xbe, 0xfe, 0x9a, 0xfa, 0x7a, 0xe9, 0xef, 0x3e, 0xff, 0x73, 0xbd,
+	0xa4, 0xdf, 0x65, 0x8f, 0xfe, 0x01, 0x9e, 0xfd, 0x19, 0x00, 0xc0, 0x18, 0xe4, 0x36, 0x86, 0x04,
+	0x00, 0x00,
 }
@@ -19,20 +19,21 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type PilotConfig struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host"`
-	ListenPort           int32    `protobuf:"varint,4,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
-	LogLevel             string   `protobuf:"bytes,5,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Host                 string      `protobuf:"bytes,2,opt,name=host,proto3" json:"host"`
+	ListenPort           int32       `protobuf:"varint,4,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
+	LogLevel             string      `protobuf:"bytes,5,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
+	EtcdConfig           *EtcdConfig `protobuf:"bytes,6,opt,name=etcd_config,json=etcdConfig,proto3" json:"etcd_config"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *PilotConfig) Reset()         { *m = PilotConfig{} }
 func (m *PilotConfig) String() string { return proto.CompactTextString(m) }
 func (*PilotConfig) ProtoMessage()    {}
 func (*PilotConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pilot_d962ee71cde2a4ba, []int{0}
+	return fileDescriptor_pilot_3ff03afd4732facd, []int{0}
 }
 func (m *PilotConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PilotConfig.Unmarshal(m, b)
@@ -80,6 +81,13 @@ func (m *PilotConfig) GetLogLevel() string {
 	return ""
 }
 
+func (m *PilotConfig) GetEtcdConfig() *EtcdConfig {
+	if m != nil {
+		return m.EtcdConfig
+	}
+	return nil
+}
+
 type PilotEndpoint struct {
 	PilotId              string   `protobuf:"bytes,1,opt,name=pilot_id,json=pilotId,proto3" json:"pilot_id"`
 	PilotHost            string   `protobuf:"bytes,2,opt,name=pilot_host,json=pilotHost,proto3" json:"pilot_host"`
@@ -93,7 +101,7 @@ func (m *PilotEndpoint) Reset()         { *m = PilotEndpoint{} }
 func (m *PilotEndpoint) String() string { return proto.CompactTextString(m) }
 func (*PilotEndpoint) ProtoMessage()    {}
 func (*PilotEndpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pilot_d962ee71cde2a4ba, []int{1}
+	return fileDescriptor_pilot_3ff03afd4732facd, []int{1}
 }
 func (m *PilotEndpoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PilotEndpoint.Unmarshal(m, b)
@@ -139,23 +147,26 @@ func init() {
 	proto.RegisterType((*PilotEndpoint)(nil), "metadata.types.PilotEndpoint")
 }
 
-func init() { proto.RegisterFile("metadata/types/pilot.proto", fileDescriptor_pilot_d962ee71cde2a4ba) }
-
-var fileDescriptor_pilot_d962ee71cde2a4ba = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x41, 0x4b, 0xc3, 0x40,
-	0x10, 0x85, 0x49, 0x6c, 0xb5, 0x99, 0x62, 0x0f, 0x7b, 0x8a, 0x8a, 0x58, 0x7a, 0xea, 0x29, 0x7b,
-	0x10, 0x44, 0xf0, 0xa6, 0x08, 0x0a, 0x1e, 0x4a, 0x8f, 0x5e, 0x42, 0xc2, 0xae, 0xe9, 0xe0, 0xba,
-	0x33, 0x24, 0x83, 0xe8, 0xbf, 0x97, 0x9d, 0x60, 0xb5, 0xa7, 0xdd, 0xf7, 0x3e, 0x86, 0xf9, 0x18,
-	0x38, 0xff, 0xf0, 0xd2, 0xb8, 0x46, 0x1a, 0x2b, 0xdf, 0xec, 0x07, 0xcb, 0x18, 0x48, 0x2a, 0xee,
-	0x49, 0xc8, 0x2c, 0x7e, 0x59, 0xa5, 0x6c, 0x45, 0x30, 0xdf, 0x24, 0xfc, 0x40, 0xf1, 0x0d, 0x3b,
-	0xb3, 0x80, 0x1c, 0x5d, 0x99, 0x2d, 0xb3, 0x75, 0xb1, 0xcd, 0xd1, 0x19, 0x03, 0x93, 0x1d, 0x0d,
-	0x52, 0xe6, 0xda, 0xe8, 0xdf, 0x5c, 0xc1, 0x3c, 0xe0, 0x20, 0x3e, 0xd6, 0x4c, 0xbd, 0x94, 0x93,
-	0x65, 0xb6, 0x9e, 0x6e, 0x61, 0xac, 0x36, 0xd4, 0x8b, 0xb9, 0x80, 0x22, 0x50, 0x57, 0x07, 0xff,
-	0xe9, 0x43, 0x39, 0xd5, 0xc9, 0x59, 0xa0, 0xee, 0x25, 0xe5, 0xd5, 0x0e, 0x4e, 0x75, 0xe1, 0x63,
-	0x74, 0x4c, 0x18, 0xc5, 0x9c, 0xc1, 0x4c, 0x05, 0xeb, 0xfd, 0xe2, 0x13, 0xcd, 0xcf, 0xce, 0x5c,
-	0x02, 0x8c, 0xe8, 0x9f, 0x43, 0xa1, 0xcd, 0x53, 0x12, 0xd9, 0x63, 0xf5, 0x38, 0x52, 0x8f, 0x11,
-	0x27, 0x8d, 0xfb, 0xdb, 0xd7, 0x1b, 0x62, 0x1f, 0x19, 0xa5, 0xc7, 0xaf, 0x0a, 0xc9, 0xfe, 0x25,
-	0xcb, 0xef, 0x9d, 0xe5, 0xd6, 0x1e, 0x1e, 0xea, 0x8e, 0x5b, 0x7d, 0xdb, 0x63, 0xbd, 0xd5, 0xf5,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x02, 0x35, 0xfc, 0x49, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("metadata/types/pilot.proto", fileDescriptor_pilot_3ff03afd4732facd) }
+
+var fileDescriptor_pilot_3ff03afd4732facd = []byte{
+	// 275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x4b, 0xc3, 0x40,
+	0x10, 0x85, 0x49, 0x6c, 0x6b, 0x33, 0xc1, 0x1e, 0xf6, 0x94, 0x46, 0xc4, 0xd0, 0x53, 0x4e, 0x59,
+	0x50, 0x10, 0xa1, 0x37, 0xa5, 0xa0, 0xe0, 0xa1, 0xe4, 0xe8, 0x25, 0x24, 0xd9, 0x35, 0x5d, 0x5c,
+	0x33, 0x4b, 0x32, 0x88, 0xfe, 0x27, 0x7f, 0xa4, 0x64, 0x82, 0xd1, 0x7a, 0xda, 0x9d, 0xf7, 0x66,
+	0x98, 0xf7, 0x0d, 0xc4, 0x6f, 0x9a, 0x4a, 0x55, 0x52, 0x29, 0xe9, 0xd3, 0xe9, 0x5e, 0x3a, 0x63,
+	0x91, 0x32, 0xd7, 0x21, 0xa1, 0x58, 0xfd, 0x78, 0x19, 0x7b, 0xf1, 0xfa, 0x5f, 0xaf, 0xa6, 0x5a,
+	0x8d, 0xad, 0x9b, 0x2f, 0x0f, 0xc2, 0xfd, 0x30, 0x7a, 0x8f, 0xed, 0x8b, 0x69, 0xc4, 0x0a, 0x7c,
+	0xa3, 0x22, 0x2f, 0xf1, 0xd2, 0x20, 0xf7, 0x8d, 0x12, 0x02, 0x66, 0x07, 0xec, 0x29, 0xf2, 0x59,
+	0xe1, 0xbf, 0xb8, 0x84, 0xd0, 0x9a, 0x9e, 0x74, 0x5b, 0x38, 0xec, 0x28, 0x9a, 0x25, 0x5e, 0x3a,
+	0xcf, 0x61, 0x94, 0xf6, 0xd8, 0x91, 0x38, 0x87, 0xc0, 0x62, 0x53, 0x58, 0xfd, 0xae, 0x6d, 0x34,
+	0xe7, 0xc9, 0xa5, 0xc5, 0xe6, 0x69, 0xa8, 0xc5, 0x16, 0xc2, 0x61, 0x7f, 0x51, 0xf3, 0xc2, 0x68,
+	0x91, 0x78, 0x69, 0x78, 0x15, 0x67, 0xc7, 0x91, 0xb3, 0x1d, 0xd5, 0x6a, 0x8c, 0x94, 0x83, 0x9e,
+	0xfe, 0x9b, 0x03, 0x9c, 0x71, 0xda, 0x5d, 0xab, 0x1c, 0x9a, 0x96, 0xc4, 0x1a, 0x96, 0x4c, 0x5e,
+	0x4c, 0xa9, 0x4f, 0xb9, 0x7e, 0x54, 0xe2, 0x02, 0x60, 0xb4, 0xfe, 0x00, 0x04, 0xac, 0x3c, 0x0c,
+	0x14, 0x93, 0xcd, 0x10, 0x27, 0x0c, 0x31, 0xda, 0x03, 0xc3, 0xdd, 0xed, 0xf3, 0x0d, 0x3a, 0xdd,
+	0x3a, 0x43, 0x9d, 0xf9, 0xc8, 0x0c, 0xca, 0xdf, 0x4a, 0xba, 0xd7, 0x46, 0xba, 0x4a, 0x1e, 0x5f,
+	0x75, 0xeb, 0x2a, 0x7e, 0xab, 0x05, 0x5f, 0xf6, 0xfa, 0x7b, 0x00, 0x2d, 0xb8, 0x19, 0xbc, 0xa2,
+	0x01, 0x00, 0x00,
 }
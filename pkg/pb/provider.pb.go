@@ -0,0 +1,1345 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: provider.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DescribeProviderRequest struct {
+	Provider             *wrappers.StringValue `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeProviderRequest) Reset()         { *m = DescribeProviderRequest{} }
+func (m *DescribeProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeProviderRequest) ProtoMessage()    {}
+func (*DescribeProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{0}
+}
+func (m *DescribeProviderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeProviderRequest.Unmarshal(m, b)
+}
+func (m *DescribeProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeProviderRequest.Marshal(b, m, deterministic)
+}
+func (dst *DescribeProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeProviderRequest.Merge(dst, src)
+}
+func (m *DescribeProviderRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeProviderRequest.Size(m)
+}
+func (m *DescribeProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeProviderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeProviderRequest proto.InternalMessageInfo
+
+func (m *DescribeProviderRequest) GetProvider() *wrappers.StringValue {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+type DescribeProviderResponse struct {
+	Provider             *wrappers.StringValue `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	VmBased              *wrappers.BoolValue   `protobuf:"bytes,2,opt,name=vm_based,json=vmBased,proto3" json:"vm_based,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeProviderResponse) Reset()         { *m = DescribeProviderResponse{} }
+func (m *DescribeProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeProviderResponse) ProtoMessage()    {}
+func (*DescribeProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{1}
+}
+func (m *DescribeProviderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeProviderResponse.Unmarshal(m, b)
+}
+func (m *DescribeProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeProviderResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeProviderResponse.Merge(dst, src)
+}
+func (m *DescribeProviderResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeProviderResponse.Size(m)
+}
+func (m *DescribeProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeProviderResponse proto.InternalMessageInfo
+
+func (m *DescribeProviderResponse) GetProvider() *wrappers.StringValue {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *DescribeProviderResponse) GetVmBased() *wrappers.BoolValue {
+	if m != nil {
+		return m.VmBased
+	}
+	return nil
+}
+
+type ParseClusterConfRequest struct {
+	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	RuntimeId            *wrappers.StringValue `protobuf:"bytes,2,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Conf                 *wrappers.StringValue `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ParseClusterConfRequest) Reset()         { *m = ParseClusterConfRequest{} }
+func (m *ParseClusterConfRequest) String() string { return proto.CompactTextString(m) }
+func (*ParseClusterConfRequest) ProtoMessage()    {}
+func (*ParseClusterConfRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{2}
+}
+func (m *ParseClusterConfRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParseClusterConfRequest.Unmarshal(m, b)
+}
+func (m *ParseClusterConfRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParseClusterConfRequest.Marshal(b, m, deterministic)
+}
+func (dst *ParseClusterConfRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParseClusterConfRequest.Merge(dst, src)
+}
+func (m *ParseClusterConfRequest) XXX_Size() int {
+	return xxx_messageInfo_ParseClusterConfRequest.Size(m)
+}
+func (m *ParseClusterConfRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParseClusterConfRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParseClusterConfRequest proto.InternalMessageInfo
+
+func (m *ParseClusterConfRequest) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *ParseClusterConfRequest) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *ParseClusterConfRequest) GetConf() *wrappers.StringValue {
+	if m != nil {
+		return m.Conf
+	}
+	return nil
+}
+
+type ParseClusterConfResponse struct {
+	ClusterWrapper       *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_wrapper,json=clusterWrapper,proto3" json:"cluster_wrapper,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ParseClusterConfResponse) Reset()         { *m = ParseClusterConfResponse{} }
+func (m *ParseClusterConfResponse) String() string { return proto.CompactTextString(m) }
+func (*ParseClusterConfResponse) ProtoMessage()    {}
+func (*ParseClusterConfResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{3}
+}
+func (m *ParseClusterConfResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParseClusterConfResponse.Unmarshal(m, b)
+}
+func (m *ParseClusterConfResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParseClusterConfResponse.Marshal(b, m, deterministic)
+}
+func (dst *ParseClusterConfResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParseClusterConfResponse.Merge(dst, src)
+}
+func (m *ParseClusterConfResponse) XXX_Size() int {
+	return xxx_messageInfo_ParseClusterConfResponse.Size(m)
+}
+func (m *ParseClusterConfResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParseClusterConfResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParseClusterConfResponse proto.InternalMessageInfo
+
+func (m *ParseClusterConfResponse) GetClusterWrapper() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterWrapper
+	}
+	return nil
+}
+
+type SplitJobIntoTasksRequest struct {
+	Job                  *wrappers.StringValue `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SplitJobIntoTasksRequest) Reset()         { *m = SplitJobIntoTasksRequest{} }
+func (m *SplitJobIntoTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*SplitJobIntoTasksRequest) ProtoMessage()    {}
+func (*SplitJobIntoTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{4}
+}
+func (m *SplitJobIntoTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplitJobIntoTasksRequest.Unmarshal(m, b)
+}
+func (m *SplitJobIntoTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplitJobIntoTasksRequest.Marshal(b, m, deterministic)
+}
+func (dst *SplitJobIntoTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitJobIntoTasksRequest.Merge(dst, src)
+}
+func (m *SplitJobIntoTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_SplitJobIntoTasksRequest.Size(m)
+}
+func (m *SplitJobIntoTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitJobIntoTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitJobIntoTasksRequest proto.InternalMessageInfo
+
+func (m *SplitJobIntoTasksRequest) GetJob() *wrappers.StringValue {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type SplitJobIntoTasksResponse struct {
+	TaskLayer            *wrappers.StringValue `protobuf:"bytes,1,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SplitJobIntoTasksResponse) Reset()         { *m = SplitJobIntoTasksResponse{} }
+func (m *SplitJobIntoTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*SplitJobIntoTasksResponse) ProtoMessage()    {}
+func (*SplitJobIntoTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{5}
+}
+func (m *SplitJobIntoTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplitJobIntoTasksResponse.Unmarshal(m, b)
+}
+func (m *SplitJobIntoTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplitJobIntoTasksResponse.Marshal(b, m, deterministic)
+}
+func (dst *SplitJobIntoTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitJobIntoTasksResponse.Merge(dst, src)
+}
+func (m *SplitJobIntoTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_SplitJobIntoTasksResponse.Size(m)
+}
+func (m *SplitJobIntoTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitJobIntoTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitJobIntoTasksResponse proto.InternalMessageInfo
+
+func (m *SplitJobIntoTasksResponse) GetTaskLayer() *wrappers.StringValue {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
+type HandleSubtaskRequest struct {
+	Task                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HandleSubtaskRequest) Reset()         { *m = HandleSubtaskRequest{} }
+func (m *HandleSubtaskRequest) String() string { return proto.CompactTextString(m) }
+func (*HandleSubtaskRequest) ProtoMessage()    {}
+func (*HandleSubtaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{6}
+}
+func (m *HandleSubtaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandleSubtaskRequest.Unmarshal(m, b)
+}
+func (m *HandleSubtaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandleSubtaskRequest.Marshal(b, m, deterministic)
+}
+func (dst *HandleSubtaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandleSubtaskRequest.Merge(dst, src)
+}
+func (m *HandleSubtaskRequest) XXX_Size() int {
+	return xxx_messageInfo_HandleSubtaskRequest.Size(m)
+}
+func (m *HandleSubtaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandleSubtaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandleSubtaskRequest proto.InternalMessageInfo
+
+func (m *HandleSubtaskRequest) GetTask() *wrappers.StringValue {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type HandleSubtaskResponse struct {
+	// the task with the directive written back
+	Task                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HandleSubtaskResponse) Reset()         { *m = HandleSubtaskResponse{} }
+func (m *HandleSubtaskResponse) String() string { return proto.CompactTextString(m) }
+func (*HandleSubtaskResponse) ProtoMessage()    {}
+func (*HandleSubtaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{7}
+}
+func (m *HandleSubtaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandleSubtaskResponse.Unmarshal(m, b)
+}
+func (m *HandleSubtaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandleSubtaskResponse.Marshal(b, m, deterministic)
+}
+func (dst *HandleSubtaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandleSubtaskResponse.Merge(dst, src)
+}
+func (m *HandleSubtaskResponse) XXX_Size() int {
+	return xxx_messageInfo_HandleSubtaskResponse.Size(m)
+}
+func (m *HandleSubtaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandleSubtaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandleSubtaskResponse proto.InternalMessageInfo
+
+func (m *HandleSubtaskResponse) GetTask() *wrappers.StringValue {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type WaitSubtaskRequest struct {
+	Task *wrappers.StringValue `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// in milliseconds
+	Timeout *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// in milliseconds
+	WaitInterval         *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=wait_interval,json=waitInterval,proto3" json:"wait_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WaitSubtaskRequest) Reset()         { *m = WaitSubtaskRequest{} }
+func (m *WaitSubtaskRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitSubtaskRequest) ProtoMessage()    {}
+func (*WaitSubtaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{8}
+}
+func (m *WaitSubtaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitSubtaskRequest.Unmarshal(m, b)
+}
+func (m *WaitSubtaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitSubtaskRequest.Marshal(b, m, deterministic)
+}
+func (dst *WaitSubtaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitSubtaskRequest.Merge(dst, src)
+}
+func (m *WaitSubtaskRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitSubtaskRequest.Size(m)
+}
+func (m *WaitSubtaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitSubtaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitSubtaskRequest proto.InternalMessageInfo
+
+func (m *WaitSubtaskRequest) GetTask() *wrappers.StringValue {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *WaitSubtaskRequest) GetTimeout() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *WaitSubtaskRequest) GetWaitInterval() *wrappers.UInt32Value {
+	if m != nil {
+		return m.WaitInterval
+	}
+	return nil
+}
+
+type WaitSubtaskResponse struct {
+	// the task with the directive written back
+	Task                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WaitSubtaskResponse) Reset()         { *m = WaitSubtaskResponse{} }
+func (m *WaitSubtaskResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitSubtaskResponse) ProtoMessage()    {}
+func (*WaitSubtaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{9}
+}
+func (m *WaitSubtaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitSubtaskResponse.Unmarshal(m, b)
+}
+func (m *WaitSubtaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitSubtaskResponse.Marshal(b, m, deterministic)
+}
+func (dst *WaitSubtaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitSubtaskResponse.Merge(dst, src)
+}
+func (m *WaitSubtaskResponse) XXX_Size() int {
+	return xxx_messageInfo_WaitSubtaskResponse.Size(m)
+}
+func (m *WaitSubtaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitSubtaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitSubtaskResponse proto.InternalMessageInfo
+
+func (m *WaitSubtaskResponse) GetTask() *wrappers.StringValue {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type CheckResourceRequest struct {
+	ClusterWrapper       *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_wrapper,json=clusterWrapper,proto3" json:"cluster_wrapper,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CheckResourceRequest) Reset()         { *m = CheckResourceRequest{} }
+func (m *CheckResourceRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckResourceRequest) ProtoMessage()    {}
+func (*CheckResourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{10}
+}
+func (m *CheckResourceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckResourceRequest.Unmarshal(m, b)
+}
+func (m *CheckResourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckResourceRequest.Marshal(b, m, deterministic)
+}
+func (dst *CheckResourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckResourceRequest.Merge(dst, src)
+}
+func (m *CheckResourceRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckResourceRequest.Size(m)
+}
+func (m *CheckResourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckResourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckResourceRequest proto.InternalMessageInfo
+
+func (m *CheckResourceRequest) GetClusterWrapper() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterWrapper
+	}
+	return nil
+}
+
+type CheckResourceResponse struct {
+	Ok                   *wrappers.BoolValue `protobuf:"bytes,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CheckResourceResponse) Reset()         { *m = CheckResourceResponse{} }
+func (m *CheckResourceResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckResourceResponse) ProtoMessage()    {}
+func (*CheckResourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{11}
+}
+func (m *CheckResourceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckResourceResponse.Unmarshal(m, b)
+}
+func (m *CheckResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckResourceResponse.Marshal(b, m, deterministic)
+}
+func (dst *CheckResourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckResourceResponse.Merge(dst, src)
+}
+func (m *CheckResourceResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckResourceResponse.Size(m)
+}
+func (m *CheckResourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckResourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckResourceResponse proto.InternalMessageInfo
+
+func (m *CheckResourceResponse) GetOk() *wrappers.BoolValue {
+	if m != nil {
+		return m.Ok
+	}
+	return nil
+}
+
+type DescribeVpcRequest struct {
+	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	VpcId                *wrappers.StringValue `protobuf:"bytes,2,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeVpcRequest) Reset()         { *m = DescribeVpcRequest{} }
+func (m *DescribeVpcRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeVpcRequest) ProtoMessage()    {}
+func (*DescribeVpcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{12}
+}
+func (m *DescribeVpcRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeVpcRequest.Unmarshal(m, b)
+}
+func (m *DescribeVpcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeVpcRequest.Marshal(b, m, deterministic)
+}
+func (dst *DescribeVpcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeVpcRequest.Merge(dst, src)
+}
+func (m *DescribeVpcRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeVpcRequest.Size(m)
+}
+func (m *DescribeVpcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeVpcRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeVpcRequest proto.InternalMessageInfo
+
+func (m *DescribeVpcRequest) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *DescribeVpcRequest) GetVpcId() *wrappers.StringValue {
+	if m != nil {
+		return m.VpcId
+	}
+	return nil
+}
+
+type DescribeVpcResponse struct {
+	Vpc                  *wrappers.StringValue `protobuf:"bytes,1,opt,name=vpc,proto3" json:"vpc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeVpcResponse) Reset()         { *m = DescribeVpcResponse{} }
+func (m *DescribeVpcResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeVpcResponse) ProtoMessage()    {}
+func (*DescribeVpcResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{13}
+}
+func (m *DescribeVpcResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeVpcResponse.Unmarshal(m, b)
+}
+func (m *DescribeVpcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeVpcResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeVpcResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeVpcResponse.Merge(dst, src)
+}
+func (m *DescribeVpcResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeVpcResponse.Size(m)
+}
+func (m *DescribeVpcResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeVpcResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeVpcResponse proto.InternalMessageInfo
+
+func (m *DescribeVpcResponse) GetVpc() *wrappers.StringValue {
+	if m != nil {
+		return m.Vpc
+	}
+	return nil
+}
+
+type ValidateCredentialRequest struct {
+	RuntimeUrl           *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_url,json=runtimeUrl,proto3" json:"runtime_url,omitempty"`
+	RuntimeCredential    *wrappers.StringValue `protobuf:"bytes,2,opt,name=runtime_credential,json=runtimeCredential,proto3" json:"runtime_credential,omitempty"`
+	Zone                 *wrappers.StringValue `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ValidateCredentialRequest) Reset()         { *m = ValidateCredentialRequest{} }
+func (m *ValidateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateCredentialRequest) ProtoMessage()    {}
+func (*ValidateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{14}
+}
+func (m *ValidateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateCredentialRequest.Unmarshal(m, b)
+}
+func (m *ValidateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateCredentialRequest.Marshal(b, m, deterministic)
+}
+func (dst *ValidateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateCredentialRequest.Merge(dst, src)
+}
+func (m *ValidateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateCredentialRequest.Size(m)
+}
+func (m *ValidateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateCredentialRequest proto.InternalMessageInfo
+
+func (m *ValidateCredentialRequest) GetRuntimeUrl() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeUrl
+	}
+	return nil
+}
+
+func (m *ValidateCredentialRequest) GetRuntimeCredential() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeCredential
+	}
+	return nil
+}
+
+func (m *ValidateCredentialRequest) GetZone() *wrappers.StringValue {
+	if m != nil {
+		return m.Zone
+	}
+	return nil
+}
+
+type ValidateCredentialResponse struct {
+	Ok                   *wrappers.BoolValue `protobuf:"bytes,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ValidateCredentialResponse) Reset()         { *m = ValidateCredentialResponse{} }
+func (m *ValidateCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateCredentialResponse) ProtoMessage()    {}
+func (*ValidateCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{15}
+}
+func (m *ValidateCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateCredentialResponse.Unmarshal(m, b)
+}
+func (m *ValidateCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateCredentialResponse.Marshal(b, m, deterministic)
+}
+func (dst *ValidateCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateCredentialResponse.Merge(dst, src)
+}
+func (m *ValidateCredentialResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateCredentialResponse.Size(m)
+}
+func (m *ValidateCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateCredentialResponse proto.InternalMessageInfo
+
+func (m *ValidateCredentialResponse) GetOk() *wrappers.BoolValue {
+	if m != nil {
+		return m.Ok
+	}
+	return nil
+}
+
+type DescribeZonesRequest struct {
+	RuntimeUrl           *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_url,json=runtimeUrl,proto3" json:"runtime_url,omitempty"`
+	RuntimeCredential    *wrappers.StringValue `protobuf:"bytes,2,opt,name=runtime_credential,json=runtimeCredential,proto3" json:"runtime_credential,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeZonesRequest) Reset()         { *m = DescribeZonesRequest{} }
+func (m *DescribeZonesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeZonesRequest) ProtoMessage()    {}
+func (*DescribeZonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{16}
+}
+func (m *DescribeZonesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeZonesRequest.Unmarshal(m, b)
+}
+func (m *DescribeZonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeZonesRequest.Marshal(b, m, deterministic)
+}
+func (dst *DescribeZonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeZonesRequest.Merge(dst, src)
+}
+func (m *DescribeZonesRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeZonesRequest.Size(m)
+}
+func (m *DescribeZonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeZonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeZonesRequest proto.InternalMessageInfo
+
+func (m *DescribeZonesRequest) GetRuntimeUrl() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeUrl
+	}
+	return nil
+}
+
+func (m *DescribeZonesRequest) GetRuntimeCredential() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeCredential
+	}
+	return nil
+}
+
+type DescribeZonesResponse struct {
+	Zone                 []string `protobuf:"bytes,1,rep,name=zone,proto3" json:"zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeZonesResponse) Reset()         { *m = DescribeZonesResponse{} }
+func (m *DescribeZonesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeZonesResponse) ProtoMessage()    {}
+func (*DescribeZonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{17}
+}
+func (m *DescribeZonesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeZonesResponse.Unmarshal(m, b)
+}
+func (m *DescribeZonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeZonesResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeZonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeZonesResponse.Merge(dst, src)
+}
+func (m *DescribeZonesResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeZonesResponse.Size(m)
+}
+func (m *DescribeZonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeZonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeZonesResponse proto.InternalMessageInfo
+
+func (m *DescribeZonesResponse) GetZone() []string {
+	if m != nil {
+		return m.Zone
+	}
+	return nil
+}
+
+type UpdateClusterStatusRequest struct {
+	Job                  *wrappers.StringValue `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateClusterStatusRequest) Reset()         { *m = UpdateClusterStatusRequest{} }
+func (m *UpdateClusterStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateClusterStatusRequest) ProtoMessage()    {}
+func (*UpdateClusterStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{18}
+}
+func (m *UpdateClusterStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateClusterStatusRequest.Unmarshal(m, b)
+}
+func (m *UpdateClusterStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateClusterStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateClusterStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateClusterStatusRequest.Merge(dst, src)
+}
+func (m *UpdateClusterStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateClusterStatusRequest.Size(m)
+}
+func (m *UpdateClusterStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateClusterStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateClusterStatusRequest proto.InternalMessageInfo
+
+func (m *UpdateClusterStatusRequest) GetJob() *wrappers.StringValue {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type UpdateClusterStatusResponse struct {
+	Ok                   *wrappers.BoolValue `protobuf:"bytes,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpdateClusterStatusResponse) Reset()         { *m = UpdateClusterStatusResponse{} }
+func (m *UpdateClusterStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateClusterStatusResponse) ProtoMessage()    {}
+func (*UpdateClusterStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_provider_b0cc01cfc88074cb, []int{19}
+}
+func (m *UpdateClusterStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateClusterStatusResponse.Unmarshal(m, b)
+}
+func (m *UpdateClusterStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateClusterStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateClusterStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateClusterStatusResponse.Merge(dst, src)
+}
+func (m *UpdateClusterStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateClusterStatusResponse.Size(m)
+}
+func (m *UpdateClusterStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateClusterStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateClusterStatusResponse proto.InternalMessageInfo
+
+func (m *UpdateClusterStatusResponse) GetOk() *wrappers.BoolValue {
+	if m != nil {
+		return m.Ok
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DescribeProviderRequest)(nil), "openpitrix.DescribeProviderRequest")
+	proto.RegisterType((*DescribeProviderResponse)(nil), "openpitrix.DescribeProviderResponse")
+	proto.RegisterType((*ParseClusterConfRequest)(nil), "openpitrix.ParseClusterConfRequest")
+	proto.RegisterType((*ParseClusterConfResponse)(nil), "openpitrix.ParseClusterConfResponse")
+	proto.RegisterType((*SplitJobIntoTasksRequest)(nil), "openpitrix.SplitJobIntoTasksRequest")
+	proto.RegisterType((*SplitJobIntoTasksResponse)(nil), "openpitrix.SplitJobIntoTasksResponse")
+	proto.RegisterType((*HandleSubtaskRequest)(nil), "openpitrix.HandleSubtaskRequest")
+	proto.RegisterType((*HandleSubtaskResponse)(nil), "openpitrix.HandleSubtaskResponse")
+	proto.RegisterType((*WaitSubtaskRequest)(nil), "openpitrix.WaitSubtaskRequest")
+	proto.RegisterType((*WaitSubtaskResponse)(nil), "openpitrix.WaitSubtaskResponse")
+	proto.RegisterType((*CheckResourceRequest)(nil), "openpitrix.CheckResourceRequest")
+	proto.RegisterType((*CheckResourceResponse)(nil), "openpitrix.CheckResourceResponse")
+	proto.RegisterType((*DescribeVpcRequest)(nil), "openpitrix.DescribeVpcRequest")
+	proto.RegisterType((*DescribeVpcResponse)(nil), "openpitrix.DescribeVpcResponse")
+	proto.RegisterType((*ValidateCredentialRequest)(nil), "openpitrix.ValidateCredentialRequest")
+	proto.RegisterType((*ValidateCredentialResponse)(nil), "openpitrix.ValidateCredentialResponse")
+	proto.RegisterType((*DescribeZonesRequest)(nil), "openpitrix.DescribeZonesRequest")
+	proto.RegisterType((*DescribeZonesResponse)(nil), "openpitrix.DescribeZonesResponse")
+	proto.RegisterType((*UpdateClusterStatusRequest)(nil), "openpitrix.UpdateClusterStatusRequest")
+	proto.RegisterType((*UpdateClusterStatusResponse)(nil), "openpitrix.UpdateClusterStatusResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProviderPluginClient is the client API for ProviderPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProviderPluginClient interface {
+	DescribeProvider(ctx context.Context, in *DescribeProviderRequest, opts ...grpc.CallOption) (*DescribeProviderResponse, error)
+	ParseClusterConf(ctx context.Context, in *ParseClusterConfRequest, opts ...grpc.CallOption) (*ParseClusterConfResponse, error)
+	SplitJobIntoTasks(ctx context.Context, in *SplitJobIntoTasksRequest, opts ...grpc.CallOption) (*SplitJobIntoTasksResponse, error)
+	HandleSubtask(ctx context.Context, in *HandleSubtaskRequest, opts ...grpc.CallOption) (*HandleSubtaskResponse, error)
+	WaitSubtask(ctx context.Context, in *WaitSubtaskRequest, opts ...grpc.CallOption) (*WaitSubtaskResponse, error)
+	DescribeSubnets(ctx context.Context, in *DescribeSubnetsRequest, opts ...grpc.CallOption) (*DescribeSubnetsResponse, error)
+	CheckResource(ctx context.Context, in *CheckResourceRequest, opts ...grpc.CallOption) (*CheckResourceResponse, error)
+	DescribeVpc(ctx context.Context, in *DescribeVpcRequest, opts ...grpc.CallOption) (*DescribeVpcResponse, error)
+	ValidateCredential(ctx context.Context, in *ValidateCredentialRequest, opts ...grpc.CallOption) (*ValidateCredentialResponse, error)
+	DescribeZones(ctx context.Context, in *DescribeZonesRequest, opts ...grpc.CallOption) (*DescribeZonesResponse, error)
+	UpdateClusterStatus(ctx context.Context, in *UpdateClusterStatusRequest, opts ...grpc.CallOption) (*UpdateClusterStatusResponse, error)
+}
+
+type providerPluginClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProviderPluginClient(cc *grpc.ClientConn) ProviderPluginClient {
+	return &providerPluginClient{cc}
+}
+
+func (c *providerPluginClient) DescribeProvider(ctx context.Context, in *DescribeProviderRequest, opts ...grpc.CallOption) (*DescribeProviderResponse, error) {
+	out := new(DescribeProviderResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/DescribeProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) ParseClusterConf(ctx context.Context, in *ParseClusterConfRequest, opts ...grpc.CallOption) (*ParseClusterConfResponse, error) {
+	out := new(ParseClusterConfResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/ParseClusterConf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) SplitJobIntoTasks(ctx context.Context, in *SplitJobIntoTasksRequest, opts ...grpc.CallOption) (*SplitJobIntoTasksResponse, error) {
+	out := new(SplitJobIntoTasksResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/SplitJobIntoTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) HandleSubtask(ctx context.Context, in *HandleSubtaskRequest, opts ...grpc.CallOption) (*HandleSubtaskResponse, error) {
+	out := new(HandleSubtaskResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/HandleSubtask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) WaitSubtask(ctx context.Context, in *WaitSubtaskRequest, opts ...grpc.CallOption) (*WaitSubtaskResponse, error) {
+	out := new(WaitSubtaskResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/WaitSubtask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) DescribeSubnets(ctx context.Context, in *DescribeSubnetsRequest, opts ...grpc.CallOption) (*DescribeSubnetsResponse, error) {
+	out := new(DescribeSubnetsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/DescribeSubnets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) CheckResource(ctx context.Context, in *CheckResourceRequest, opts ...grpc.CallOption) (*CheckResourceResponse, error) {
+	out := new(CheckResourceResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/CheckResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) DescribeVpc(ctx context.Context, in *DescribeVpcRequest, opts ...grpc.CallOption) (*DescribeVpcResponse, error) {
+	out := new(DescribeVpcResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/DescribeVpc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) ValidateCredential(ctx context.Context, in *ValidateCredentialRequest, opts ...grpc.CallOption) (*ValidateCredentialResponse, error) {
+	out := new(ValidateCredentialResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/ValidateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) DescribeZones(ctx context.Context, in *DescribeZonesRequest, opts ...grpc.CallOption) (*DescribeZonesResponse, error) {
+	out := new(DescribeZonesResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/DescribeZones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) UpdateClusterStatus(ctx context.Context, in *UpdateClusterStatusRequest, opts ...grpc.CallOption) (*UpdateClusterStatusResponse, error) {
+	out := new(UpdateClusterStatusResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ProviderPlugin/UpdateClusterStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProviderPluginServer is the server API for ProviderPlugin service.
+type ProviderPluginServer interface {
+	DescribeProvider(context.Context, *DescribeProviderRequest) (*DescribeProviderResponse, error)
+	ParseClusterConf(context.Context, *ParseClusterConfRequest) (*ParseClusterConfResponse, error)
+	SplitJobIntoTasks(context.Context, *SplitJobIntoTasksRequest) (*SplitJobIntoTasksResponse, error)
+	HandleSubtask(context.Context, *HandleSubtaskRequest) (*HandleSubtaskResponse, error)
+	WaitSubtask(context.Context, *WaitSubtaskRequest) (*WaitSubtaskResponse, error)
+	DescribeSubnets(context.Context, *DescribeSubnetsRequest) (*DescribeSubnetsResponse, error)
+	CheckResource(context.Context, *CheckResourceRequest) (*CheckResourceResponse, error)
+	DescribeVpc(context.Context, *DescribeVpcRequest) (*DescribeVpcResponse, error)
+	ValidateCredential(context.Context, *ValidateCredentialRequest) (*ValidateCredentialResponse, error)
+	DescribeZones(context.Context, *DescribeZonesRequest) (*DescribeZonesResponse, error)
+	UpdateClusterStatus(context.Context, *UpdateClusterStatusRequest) (*UpdateClusterStatusResponse, error)
+}
+
+func RegisterProviderPluginServer(s *grpc.Server, srv ProviderPluginServer) {
+	s.RegisterService(&_ProviderPlugin_serviceDesc, srv)
+}
+
+func _ProviderPlugin_DescribeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).DescribeProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/DescribeProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).DescribeProvider(ctx, req.(*DescribeProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_ParseClusterConf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseClusterConfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).ParseClusterConf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/ParseClusterConf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).ParseClusterConf(ctx, req.(*ParseClusterConfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_SplitJobIntoTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitJobIntoTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).SplitJobIntoTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/SplitJobIntoTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).SplitJobIntoTasks(ctx, req.(*SplitJobIntoTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_HandleSubtask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleSubtaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).HandleSubtask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/HandleSubtask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).HandleSubtask(ctx, req.(*HandleSubtaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_WaitSubtask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitSubtaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).WaitSubtask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/WaitSubtask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).WaitSubtask(ctx, req.(*WaitSubtaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_DescribeSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSubnetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).DescribeSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/DescribeSubnets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).DescribeSubnets(ctx, req.(*DescribeSubnetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_CheckResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).CheckResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/CheckResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).CheckResource(ctx, req.(*CheckResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_DescribeVpc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVpcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).DescribeVpc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/DescribeVpc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).DescribeVpc(ctx, req.(*DescribeVpcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_ValidateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).ValidateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/ValidateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).ValidateCredential(ctx, req.(*ValidateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_DescribeZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).DescribeZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/DescribeZones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).DescribeZones(ctx, req.(*DescribeZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_UpdateClusterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).UpdateClusterStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ProviderPlugin/UpdateClusterStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).UpdateClusterStatus(ctx, req.(*UpdateClusterStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProviderPlugin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "openpitrix.ProviderPlugin",
+	HandlerType: (*ProviderPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DescribeProvider",
+			Handler:    _ProviderPlugin_DescribeProvider_Handler,
+		},
+		{
+			MethodName: "ParseClusterConf",
+			Handler:    _ProviderPlugin_ParseClusterConf_Handler,
+		},
+		{
+			MethodName: "SplitJobIntoTasks",
+			Handler:    _ProviderPlugin_SplitJobIntoTasks_Handler,
+		},
+		{
+			MethodName: "HandleSubtask",
+			Handler:    _ProviderPlugin_HandleSubtask_Handler,
+		},
+		{
+			MethodName: "WaitSubtask",
+			Handler:    _ProviderPlugin_WaitSubtask_Handler,
+		},
+		{
+			MethodName: "DescribeSubnets",
+			Handler:    _ProviderPlugin_DescribeSubnets_Handler,
+		},
+		{
+			MethodName: "CheckResource",
+			Handler:    _ProviderPlugin_CheckResource_Handler,
+		},
+		{
+			MethodName: "DescribeVpc",
+			Handler:    _ProviderPlugin_DescribeVpc_Handler,
+		},
+		{
+			MethodName: "ValidateCredential",
+			Handler:    _ProviderPlugin_ValidateCredential_Handler,
+		},
+		{
+			MethodName: "DescribeZones",
+			Handler:    _ProviderPlugin_DescribeZones_Handler,
+		},
+		{
+			MethodName: "UpdateClusterStatus",
+			Handler:    _ProviderPlugin_UpdateClusterStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provider.proto",
+}
+
+func init() { proto.RegisterFile("provider.proto", fileDescriptor_provider_b0cc01cfc88074cb) }
+
+var fileDescriptor_provider_b0cc01cfc88074cb = []byte{
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x4e, 0x33, 0x45,
+	0x18, 0xce, 0xb6, 0xf8, 0xf1, 0xf1, 0x22, 0x7c, 0x30, 0x40, 0x28, 0xab, 0xe1, 0x67, 0x41, 0x25,
+	0x9a, 0x2c, 0x04, 0xa2, 0x31, 0x21, 0x1e, 0x48, 0x25, 0xb2, 0x48, 0x0c, 0xa1, 0xfc, 0x18, 0x12,
+	0x52, 0x67, 0x77, 0xa7, 0x75, 0x64, 0x99, 0x59, 0x67, 0x67, 0x17, 0xf5, 0x02, 0x3c, 0xf1, 0x2e,
+	0xbc, 0x13, 0x0f, 0xbc, 0x08, 0xaf, 0xc5, 0x13, 0xb3, 0xed, 0x0c, 0xed, 0xb6, 0xbb, 0xed, 0x52,
+	0x3d, 0xf8, 0x0e, 0xdb, 0x79, 0x9e, 0x67, 0xdf, 0xf7, 0x99, 0x99, 0xe7, 0x1d, 0x98, 0x0f, 0x05,
+	0x4f, 0xa8, 0x4f, 0x84, 0x1d, 0x0a, 0x2e, 0x39, 0x02, 0x1e, 0x12, 0x16, 0x52, 0x29, 0xe8, 0xcf,
+	0xe6, 0x7a, 0x9b, 0xf3, 0x76, 0x40, 0xf6, 0x3a, 0x2b, 0x6e, 0xdc, 0xda, 0x7b, 0x12, 0x38, 0x0c,
+	0x89, 0x88, 0xba, 0x58, 0x73, 0xce, 0x0b, 0xe2, 0x48, 0x6a, 0xaa, 0xd5, 0x80, 0xd5, 0xaf, 0x48,
+	0xe4, 0x09, 0xea, 0x92, 0x0b, 0x25, 0x7a, 0x49, 0x7e, 0x8a, 0x49, 0x24, 0xd1, 0xe7, 0xf0, 0x5a,
+	0x7f, 0xa7, 0x66, 0x6c, 0x1a, 0xbb, 0xb3, 0x07, 0xef, 0xdb, 0x5d, 0x71, 0x5b, 0x8b, 0xdb, 0x0d,
+	0x29, 0x28, 0x6b, 0xdf, 0xe0, 0x20, 0x26, 0x97, 0xcf, 0x68, 0xeb, 0x77, 0x03, 0x6a, 0xc3, 0xaa,
+	0x51, 0xc8, 0x59, 0x44, 0x26, 0x97, 0x45, 0x9f, 0xc2, 0xeb, 0xe4, 0xb1, 0xe9, 0xe2, 0x88, 0xf8,
+	0xb5, 0x4a, 0x87, 0x69, 0x0e, 0x31, 0x8f, 0x39, 0x0f, 0xba, 0xbc, 0xe9, 0xe4, 0xf1, 0x38, 0x85,
+	0x5a, 0x7f, 0x19, 0xb0, 0x7a, 0x81, 0x45, 0x44, 0xea, 0xdd, 0xce, 0xeb, 0x9c, 0xb5, 0x74, 0x8f,
+	0x47, 0x00, 0x09, 0x11, 0x11, 0xe5, 0xac, 0x49, 0xfd, 0x52, 0xe5, 0xcc, 0x28, 0xbc, 0xe3, 0xa7,
+	0x64, 0x11, 0x33, 0x49, 0x1f, 0x49, 0x93, 0xea, 0x8a, 0xc6, 0x90, 0x15, 0xde, 0xf1, 0xd1, 0x3e,
+	0x4c, 0x79, 0x9c, 0xb5, 0x6a, 0xd5, 0x12, 0xb4, 0x0e, 0xd2, 0xc2, 0x50, 0x1b, 0x6e, 0x43, 0x99,
+	0x7a, 0x02, 0x6f, 0xd4, 0xbe, 0x36, 0xd5, 0x7e, 0x97, 0x6a, 0x66, 0x5e, 0x91, 0x6e, 0xbb, 0x1c,
+	0xeb, 0x0c, 0x6a, 0x8d, 0x30, 0xa0, 0xf2, 0x8c, 0xbb, 0x0e, 0x93, 0xfc, 0x0a, 0x47, 0x0f, 0x91,
+	0xb6, 0xca, 0x86, 0xea, 0x8f, 0xdc, 0x2d, 0x25, 0x9b, 0x02, 0xad, 0xef, 0x60, 0x2d, 0x47, 0x4b,
+	0xd5, 0x7b, 0x04, 0x20, 0x71, 0xf4, 0xd0, 0x0c, 0xf0, 0x2f, 0x25, 0x4b, 0x9d, 0x49, 0xf1, 0xe7,
+	0x29, 0xdc, 0x3a, 0x85, 0xe5, 0x53, 0xcc, 0xfc, 0x80, 0x34, 0x62, 0x37, 0xfd, 0x57, 0x57, 0xb8,
+	0x0f, 0x53, 0xe9, 0xcf, 0x52, 0x72, 0x1d, 0xa4, 0xe5, 0xc0, 0xca, 0x80, 0x92, 0xaa, 0xef, 0xe5,
+	0x52, 0x7f, 0x1a, 0x80, 0x6e, 0x31, 0x95, 0xff, 0xb5, 0x26, 0xf4, 0x19, 0x4c, 0xa7, 0x47, 0x84,
+	0xc7, 0xb2, 0xf0, 0x48, 0x5d, 0x3b, 0x4c, 0x1e, 0x1e, 0xa8, 0x63, 0xae, 0xc0, 0xe8, 0x4b, 0x98,
+	0x7b, 0xc2, 0x54, 0x36, 0x29, 0x93, 0x44, 0x24, 0x38, 0xa8, 0x55, 0x4b, 0xb0, 0xdf, 0x4d, 0x29,
+	0x8e, 0x62, 0x58, 0x5f, 0xc3, 0x52, 0xa6, 0x85, 0x89, 0xcd, 0xb8, 0x87, 0xe5, 0xfa, 0x0f, 0xc4,
+	0x4b, 0x25, 0x78, 0x2c, 0x3c, 0xa2, 0xdd, 0xf8, 0x9f, 0x8e, 0x69, 0x1d, 0x56, 0x06, 0xe4, 0x55,
+	0xa5, 0x1f, 0x43, 0x85, 0xeb, 0x3a, 0x47, 0x65, 0x43, 0x85, 0x3f, 0x58, 0xbf, 0x19, 0x80, 0x74,
+	0x48, 0xdd, 0x84, 0x5e, 0x5f, 0x22, 0xf4, 0x5d, 0x6a, 0xe3, 0x65, 0x97, 0xfa, 0x10, 0x5e, 0x25,
+	0xa1, 0x57, 0x36, 0x0d, 0xde, 0x49, 0x42, 0xcf, 0xf1, 0xad, 0x13, 0x58, 0xca, 0xd4, 0xa1, 0x7a,
+	0xb1, 0xa1, 0x9a, 0x84, 0x5e, 0xb9, 0xfb, 0x96, 0x84, 0x9e, 0xf5, 0xb7, 0x01, 0x6b, 0x37, 0x38,
+	0xa0, 0x3e, 0x96, 0xa4, 0x2e, 0x88, 0x4f, 0x98, 0xa4, 0x38, 0xd0, 0x6d, 0x7d, 0x01, 0xb3, 0xba,
+	0xad, 0x58, 0x04, 0xa5, 0x54, 0xb5, 0x0f, 0xd7, 0x22, 0x40, 0xdf, 0x00, 0xd2, 0x74, 0xef, 0x59,
+	0xbb, 0x54, 0x93, 0x8b, 0x8a, 0xd7, 0x2b, 0x29, 0x3d, 0x4f, 0xbf, 0x72, 0x46, 0xca, 0x45, 0x5f,
+	0x8a, 0xb4, 0x4e, 0xc1, 0xcc, 0x6b, 0x6d, 0x82, 0x5d, 0xff, 0xc3, 0x80, 0x65, 0xed, 0xf6, 0x1d,
+	0x67, 0x24, 0x7a, 0x0b, 0x0d, 0xb2, 0x3e, 0x81, 0x95, 0x81, 0x1a, 0x55, 0xa7, 0x48, 0x39, 0x67,
+	0x6c, 0x56, 0x77, 0x67, 0x94, 0x37, 0xe7, 0x60, 0x5e, 0x87, 0x1d, 0x67, 0xba, 0x97, 0xa4, 0x21,
+	0xb1, 0x8c, 0x27, 0x4e, 0x6d, 0x07, 0xde, 0xcb, 0x55, 0x7b, 0xb9, 0xd5, 0x07, 0xff, 0x4c, 0xc3,
+	0xbc, 0x9e, 0xfe, 0x17, 0x41, 0xdc, 0xa6, 0x0c, 0xdd, 0xc3, 0xc2, 0xe0, 0xbb, 0x00, 0x6d, 0xdb,
+	0xbd, 0xd7, 0x8b, 0x5d, 0xf0, 0x16, 0x31, 0x77, 0x46, 0x83, 0x54, 0x75, 0xf7, 0xb0, 0x30, 0x38,
+	0x21, 0xb3, 0xf2, 0x05, 0xcf, 0x00, 0x73, 0x67, 0x34, 0x48, 0xc9, 0x7f, 0x0f, 0x8b, 0x43, 0x13,
+	0x0d, 0x65, 0xa8, 0x45, 0xc3, 0xd3, 0xfc, 0x60, 0x0c, 0x4a, 0x7d, 0xe1, 0x0a, 0xe6, 0x32, 0xf3,
+	0x08, 0x6d, 0xf6, 0xf3, 0xf2, 0x86, 0x9e, 0xb9, 0x35, 0x02, 0xa1, 0x54, 0xbf, 0x85, 0xd9, 0xbe,
+	0x58, 0x47, 0xeb, 0xfd, 0x8c, 0xe1, 0x91, 0x65, 0x6e, 0x14, 0xae, 0x2b, 0xbd, 0x3b, 0x78, 0xa3,
+	0xb7, 0xa0, 0x11, 0xbb, 0x8c, 0xc8, 0x08, 0x59, 0x79, 0xfb, 0xa3, 0x16, 0xb5, 0xee, 0xf6, 0x48,
+	0x4c, 0xcf, 0x81, 0x4c, 0xb4, 0x67, 0x1d, 0xc8, 0x1b, 0x2a, 0xe6, 0xd6, 0x08, 0x44, 0xcf, 0x81,
+	0xbe, 0x88, 0xcd, 0x3a, 0x30, 0x3c, 0x03, 0xcc, 0x8d, 0xc2, 0x75, 0xa5, 0xe7, 0x01, 0x1a, 0xce,
+	0x23, 0x94, 0xd9, 0xe4, 0xc2, 0x28, 0x36, 0x3f, 0x1c, 0x07, 0xeb, 0x59, 0x91, 0x49, 0x81, 0xac,
+	0x15, 0x79, 0x21, 0x66, 0x6e, 0x8d, 0x40, 0x28, 0xd5, 0x16, 0x2c, 0xe5, 0x5c, 0x70, 0x94, 0x29,
+	0xaa, 0x38, 0x4f, 0xcc, 0x8f, 0xc6, 0xe2, 0xba, 0xdf, 0x39, 0x9e, 0xba, 0xab, 0x84, 0xae, 0xfb,
+	0xaa, 0x93, 0x0d, 0x87, 0xff, 0x0e, 0x00, 0xc1, 0xbd, 0x13, 0xb1, 0xb2, 0x0c, 0x00, 0x00,
+}
@@ -28,16 +28,18 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type CreateRepoRequest struct {
-	Name                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          *wrappers.StringValue `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Type                 *wrappers.StringValue `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Url                  *wrappers.StringValue `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	Credential           *wrappers.StringValue `protobuf:"bytes,5,opt,name=credential,proto3" json:"credential,omitempty"`
-	Visibility           *wrappers.StringValue `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
-	Providers            []string              `protobuf:"bytes,7,rep,name=providers,proto3" json:"providers,omitempty"`
-	Labels               []*RepoLabel          `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
-	Selectors            []*RepoSelector       `protobuf:"bytes,9,rep,name=selectors,proto3" json:"selectors,omitempty"`
-	CategoryId           *wrappers.StringValue `protobuf:"bytes,10,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name        *wrappers.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description *wrappers.StringValue `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type        *wrappers.StringValue `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Url         *wrappers.StringValue `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Credential  *wrappers.StringValue `protobuf:"bytes,5,opt,name=credential,proto3" json:"credential,omitempty"`
+	Visibility  *wrappers.StringValue `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Providers   []string              `protobuf:"bytes,7,rep,name=providers,proto3" json:"providers,omitempty"`
+	Labels      []*RepoLabel          `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
+	Selectors   []*RepoSelector       `protobuf:"bytes,9,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	CategoryId  *wrappers.StringValue `protobuf:"bytes,10,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// public keys to verify the provenance of the packages, one per line
+	TrustedKeys          *wrappers.StringValue `protobuf:"bytes,11,opt,name=trusted_keys,json=trustedKeys,proto3" json:"trusted_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -47,7 +49,7 @@ func (m *CreateRepoRequest) Reset()         { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()    {}
 func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{0}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{0}
 }
 func (m *CreateRepoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRepoRequest.Unmarshal(m, b)
@@ -137,6 +139,13 @@ func (m *CreateRepoRequest) GetCategoryId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *CreateRepoRequest) GetTrustedKeys() *wrappers.StringValue {
+	if m != nil {
+		return m.TrustedKeys
+	}
+	return nil
+}
+
 type CreateRepoResponse struct {
 	RepoId               *wrappers.StringValue `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -148,7 +157,7 @@ func (m *CreateRepoResponse) Reset()         { *m = CreateRepoResponse{} }
 func (m *CreateRepoResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateRepoResponse) ProtoMessage()    {}
 func (*CreateRepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{1}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{1}
 }
 func (m *CreateRepoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRepoResponse.Unmarshal(m, b)
@@ -187,6 +196,7 @@ type ModifyRepoRequest struct {
 	Labels               []*RepoLabel          `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty"`
 	Selectors            []*RepoSelector       `protobuf:"bytes,10,rep,name=selectors,proto3" json:"selectors,omitempty"`
 	CategoryId           *wrappers.StringValue `protobuf:"bytes,11,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TrustedKeys          *wrappers.StringValue `protobuf:"bytes,12,opt,name=trusted_keys,json=trustedKeys,proto3" json:"trusted_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -196,7 +206,7 @@ func (m *ModifyRepoRequest) Reset()         { *m = ModifyRepoRequest{} }
 func (m *ModifyRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyRepoRequest) ProtoMessage()    {}
 func (*ModifyRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{2}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{2}
 }
 func (m *ModifyRepoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyRepoRequest.Unmarshal(m, b)
@@ -293,6 +303,13 @@ func (m *ModifyRepoRequest) GetCategoryId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *ModifyRepoRequest) GetTrustedKeys() *wrappers.StringValue {
+	if m != nil {
+		return m.TrustedKeys
+	}
+	return nil
+}
+
 type ModifyRepoResponse struct {
 	RepoId               *wrappers.StringValue `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -304,7 +321,7 @@ func (m *ModifyRepoResponse) Reset()         { *m = ModifyRepoResponse{} }
 func (m *ModifyRepoResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyRepoResponse) ProtoMessage()    {}
 func (*ModifyRepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{3}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{3}
 }
 func (m *ModifyRepoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyRepoResponse.Unmarshal(m, b)
@@ -342,7 +359,7 @@ func (m *DeleteReposRequest) Reset()         { *m = DeleteReposRequest{} }
 func (m *DeleteReposRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteReposRequest) ProtoMessage()    {}
 func (*DeleteReposRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{4}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{4}
 }
 func (m *DeleteReposRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteReposRequest.Unmarshal(m, b)
@@ -380,7 +397,7 @@ func (m *DeleteReposResponse) Reset()         { *m = DeleteReposResponse{} }
 func (m *DeleteReposResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteReposResponse) ProtoMessage()    {}
 func (*DeleteReposResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{5}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{5}
 }
 func (m *DeleteReposResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteReposResponse.Unmarshal(m, b)
@@ -419,7 +436,7 @@ func (m *RepoLabel) Reset()         { *m = RepoLabel{} }
 func (m *RepoLabel) String() string { return proto.CompactTextString(m) }
 func (*RepoLabel) ProtoMessage()    {}
 func (*RepoLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{6}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{6}
 }
 func (m *RepoLabel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RepoLabel.Unmarshal(m, b)
@@ -465,7 +482,7 @@ func (m *RepoSelector) Reset()         { *m = RepoSelector{} }
 func (m *RepoSelector) String() string { return proto.CompactTextString(m) }
 func (*RepoSelector) ProtoMessage()    {}
 func (*RepoSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{7}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{7}
 }
 func (m *RepoSelector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RepoSelector.Unmarshal(m, b)
@@ -515,6 +532,7 @@ type Repo struct {
 	CreateTime           *timestamp.Timestamp  `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	StatusTime           *timestamp.Timestamp  `protobuf:"bytes,14,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
 	CategorySet          []*ResourceCategory   `protobuf:"bytes,15,rep,name=category_set,json=categorySet,proto3" json:"category_set,omitempty"`
+	TrustedKeys          *wrappers.StringValue `protobuf:"bytes,16,opt,name=trusted_keys,json=trustedKeys,proto3" json:"trusted_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -524,7 +542,7 @@ func (m *Repo) Reset()         { *m = Repo{} }
 func (m *Repo) String() string { return proto.CompactTextString(m) }
 func (*Repo) ProtoMessage()    {}
 func (*Repo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{8}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{8}
 }
 func (m *Repo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Repo.Unmarshal(m, b)
@@ -649,6 +667,13 @@ func (m *Repo) GetCategorySet() []*ResourceCategory {
 	return nil
 }
 
+func (m *Repo) GetTrustedKeys() *wrappers.StringValue {
+	if m != nil {
+		return m.TrustedKeys
+	}
+	return nil
+}
+
 type DescribeReposRequest struct {
 	RepoId               []string              `protobuf:"bytes,1,rep,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
 	Name                 []string              `protobuf:"bytes,2,rep,name=name,proto3" json:"name,omitempty"`
@@ -673,7 +698,7 @@ func (m *DescribeReposRequest) Reset()         { *m = DescribeReposRequest{} }
 func (m *DescribeReposRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeReposRequest) ProtoMessage()    {}
 func (*DescribeReposRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{9}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{9}
 }
 func (m *DescribeReposRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeReposRequest.Unmarshal(m, b)
@@ -803,7 +828,7 @@ func (m *DescribeReposResponse) Reset()         { *m = DescribeReposResponse{} }
 func (m *DescribeReposResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeReposResponse) ProtoMessage()    {}
 func (*DescribeReposResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{10}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{10}
 }
 func (m *DescribeReposResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeReposResponse.Unmarshal(m, b)
@@ -850,7 +875,7 @@ func (m *ValidateRepoRequest) Reset()         { *m = ValidateRepoRequest{} }
 func (m *ValidateRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateRepoRequest) ProtoMessage()    {}
 func (*ValidateRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{11}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{11}
 }
 func (m *ValidateRepoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateRepoRequest.Unmarshal(m, b)
@@ -903,7 +928,7 @@ func (m *ValidateRepoResponse) Reset()         { *m = ValidateRepoResponse{} }
 func (m *ValidateRepoResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidateRepoResponse) ProtoMessage()    {}
 func (*ValidateRepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repo_a97136dae15d6a96, []int{12}
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{12}
 }
 func (m *ValidateRepoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateRepoResponse.Unmarshal(m, b)
@@ -937,6 +962,90 @@ func (m *ValidateRepoResponse) GetErrorCode() uint32 {
 	return 0
 }
 
+type RotateRepoEncryptionKeyRequest struct {
+	KeyId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RotateRepoEncryptionKeyRequest) Reset()         { *m = RotateRepoEncryptionKeyRequest{} }
+func (m *RotateRepoEncryptionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateRepoEncryptionKeyRequest) ProtoMessage()    {}
+func (*RotateRepoEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{13}
+}
+func (m *RotateRepoEncryptionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateRepoEncryptionKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateRepoEncryptionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateRepoEncryptionKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *RotateRepoEncryptionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateRepoEncryptionKeyRequest.Merge(dst, src)
+}
+func (m *RotateRepoEncryptionKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateRepoEncryptionKeyRequest.Size(m)
+}
+func (m *RotateRepoEncryptionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateRepoEncryptionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateRepoEncryptionKeyRequest proto.InternalMessageInfo
+
+func (m *RotateRepoEncryptionKeyRequest) GetKeyId() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyId
+	}
+	return nil
+}
+
+type RotateRepoEncryptionKeyResponse struct {
+	KeyId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	RepoCount            uint32                `protobuf:"varint,2,opt,name=repo_count,json=repoCount,proto3" json:"repo_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RotateRepoEncryptionKeyResponse) Reset()         { *m = RotateRepoEncryptionKeyResponse{} }
+func (m *RotateRepoEncryptionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateRepoEncryptionKeyResponse) ProtoMessage()    {}
+func (*RotateRepoEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repo_d5bbe9158cbf502d, []int{14}
+}
+func (m *RotateRepoEncryptionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateRepoEncryptionKeyResponse.Unmarshal(m, b)
+}
+func (m *RotateRepoEncryptionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateRepoEncryptionKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *RotateRepoEncryptionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateRepoEncryptionKeyResponse.Merge(dst, src)
+}
+func (m *RotateRepoEncryptionKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RotateRepoEncryptionKeyResponse.Size(m)
+}
+func (m *RotateRepoEncryptionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateRepoEncryptionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateRepoEncryptionKeyResponse proto.InternalMessageInfo
+
+func (m *RotateRepoEncryptionKeyResponse) GetKeyId() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyId
+	}
+	return nil
+}
+
+func (m *RotateRepoEncryptionKeyResponse) GetRepoCount() uint32 {
+	if m != nil {
+		return m.RepoCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CreateRepoRequest)(nil), "openpitrix.CreateRepoRequest")
 	proto.RegisterType((*CreateRepoResponse)(nil), "openpitrix.CreateRepoResponse")
@@ -951,6 +1060,8 @@ func init() {
 	proto.RegisterType((*DescribeReposResponse)(nil), "openpitrix.DescribeReposResponse")
 	proto.RegisterType((*ValidateRepoRequest)(nil), "openpitrix.ValidateRepoRequest")
 	proto.RegisterType((*ValidateRepoResponse)(nil), "openpitrix.ValidateRepoResponse")
+	proto.RegisterType((*RotateRepoEncryptionKeyRequest)(nil), "openpitrix.RotateRepoEncryptionKeyRequest")
+	proto.RegisterType((*RotateRepoEncryptionKeyResponse)(nil), "openpitrix.RotateRepoEncryptionKeyResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -970,6 +1081,7 @@ type RepoManagerClient interface {
 	ModifyRepo(ctx context.Context, in *ModifyRepoRequest, opts ...grpc.CallOption) (*ModifyRepoResponse, error)
 	DeleteRepos(ctx context.Context, in *DeleteReposRequest, opts ...grpc.CallOption) (*DeleteReposResponse, error)
 	ValidateRepo(ctx context.Context, in *ValidateRepoRequest, opts ...grpc.CallOption) (*ValidateRepoResponse, error)
+	RotateRepoEncryptionKey(ctx context.Context, in *RotateRepoEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateRepoEncryptionKeyResponse, error)
 }
 
 type repoManagerClient struct {
@@ -1025,6 +1137,15 @@ func (c *repoManagerClient) ValidateRepo(ctx context.Context, in *ValidateRepoRe
 	return out, nil
 }
 
+func (c *repoManagerClient) RotateRepoEncryptionKey(ctx context.Context, in *RotateRepoEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateRepoEncryptionKeyResponse, error) {
+	out := new(RotateRepoEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.RepoManager/RotateRepoEncryptionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepoManagerServer is the server API for RepoManager service.
 type RepoManagerServer interface {
 	CreateRepo(context.Context, *CreateRepoRequest) (*CreateRepoResponse, error)
@@ -1032,6 +1153,7 @@ type RepoManagerServer interface {
 	ModifyRepo(context.Context, *ModifyRepoRequest) (*ModifyRepoResponse, error)
 	DeleteRepos(context.Context, *DeleteReposRequest) (*DeleteReposResponse, error)
 	ValidateRepo(context.Context, *ValidateRepoRequest) (*ValidateRepoResponse, error)
+	RotateRepoEncryptionKey(context.Context, *RotateRepoEncryptionKeyRequest) (*RotateRepoEncryptionKeyResponse, error)
 }
 
 func RegisterRepoManagerServer(s *grpc.Server, srv RepoManagerServer) {
@@ -1128,6 +1250,24 @@ func _RepoManager_ValidateRepo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoManager_RotateRepoEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRepoEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoManagerServer).RotateRepoEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.RepoManager/RotateRepoEncryptionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoManagerServer).RotateRepoEncryptionKey(ctx, req.(*RotateRepoEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RepoManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.RepoManager",
 	HandlerType: (*RepoManagerServer)(nil),
@@ -1152,84 +1292,94 @@ var _RepoManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateRepo",
 			Handler:    _RepoManager_ValidateRepo_Handler,
 		},
+		{
+			MethodName: "RotateRepoEncryptionKey",
+			Handler:    _RepoManager_RotateRepoEncryptionKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "repo.proto",
 }
 
-func init() { proto.RegisterFile("repo.proto", fileDescriptor_repo_a97136dae15d6a96) }
-
-var fileDescriptor_repo_a97136dae15d6a96 = []byte{
-	// 1122 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xdb, 0x6e, 0x1b, 0x45,
-	0x18, 0x96, 0xed, 0x8d, 0xe3, 0xfd, 0xd7, 0x4e, 0xe9, 0x34, 0x85, 0x95, 0x15, 0x12, 0x63, 0x81,
-	0x14, 0x95, 0xc6, 0x2e, 0xa1, 0x9c, 0x0f, 0x55, 0xea, 0xde, 0x54, 0xa5, 0x37, 0x1b, 0x54, 0x24,
-	0x6e, 0xcc, 0xda, 0x3b, 0x76, 0x47, 0x59, 0xef, 0x6c, 0x67, 0xc6, 0x36, 0xbe, 0x42, 0x42, 0x82,
-	0x07, 0x08, 0x88, 0x57, 0xe0, 0x09, 0x10, 0x0f, 0xc2, 0x3d, 0x57, 0x3c, 0x02, 0x0f, 0x80, 0x66,
-	0x76, 0xf6, 0x64, 0xc7, 0x65, 0xed, 0xdc, 0x70, 0xc1, 0x55, 0xb2, 0xf3, 0x7f, 0xdf, 0xfc, 0x87,
-	0xf9, 0xf6, 0xdb, 0x31, 0x00, 0xc3, 0x21, 0xed, 0x84, 0x8c, 0x0a, 0x8a, 0x80, 0x86, 0x38, 0x08,
-	0x89, 0x60, 0xe4, 0xdb, 0xe6, 0xe1, 0x98, 0xd2, 0xb1, 0x8f, 0xbb, 0x2a, 0x32, 0x98, 0x8e, 0xba,
-	0x73, 0xe6, 0x86, 0x21, 0x66, 0x3c, 0xc2, 0x36, 0x8f, 0x96, 0xe3, 0x82, 0x4c, 0x30, 0x17, 0xee,
-	0x24, 0xd4, 0x80, 0x03, 0x0d, 0x70, 0x43, 0xd2, 0x75, 0x83, 0x80, 0x0a, 0x57, 0x10, 0x1a, 0xc4,
-	0xf4, 0xbb, 0xea, 0xcf, 0xf0, 0x64, 0x8c, 0x83, 0x13, 0x3e, 0x77, 0xc7, 0x63, 0xcc, 0xba, 0x34,
-	0x54, 0x88, 0x2b, 0xd0, 0x96, 0x58, 0x84, 0x58, 0x3f, 0xb4, 0x7f, 0x35, 0xe0, 0x66, 0x8f, 0x61,
-	0x57, 0x60, 0x07, 0x87, 0xd4, 0xc1, 0x2f, 0xa6, 0x98, 0x0b, 0x74, 0x0f, 0x8c, 0xc0, 0x9d, 0x60,
-	0xbb, 0xd4, 0x2a, 0x1d, 0x5b, 0xa7, 0x07, 0x9d, 0x28, 0x7b, 0x27, 0x2e, 0xaf, 0x73, 0x2e, 0x18,
-	0x09, 0xc6, 0xcf, 0x5c, 0x7f, 0x8a, 0x1d, 0x85, 0x44, 0x9f, 0x83, 0xe5, 0x61, 0x3e, 0x64, 0x44,
-	0xa5, 0xb5, 0xcb, 0x05, 0x88, 0x59, 0x82, 0xcc, 0x28, 0xcb, 0xb2, 0x2b, 0x45, 0x32, 0x4a, 0x24,
-	0xea, 0x40, 0x65, 0xca, 0x7c, 0xdb, 0x28, 0x40, 0x90, 0x40, 0xf4, 0x29, 0xc0, 0x90, 0x61, 0x0f,
-	0x07, 0x82, 0xb8, 0xbe, 0xbd, 0x53, 0x80, 0x96, 0xc1, 0x4b, 0xf6, 0x8c, 0x70, 0x32, 0x20, 0x3e,
-	0x11, 0x0b, 0xbb, 0x5a, 0x84, 0x9d, 0xe2, 0xd1, 0x01, 0x98, 0x21, 0xa3, 0x33, 0xe2, 0x61, 0xc6,
-	0xed, 0xdd, 0x56, 0xe5, 0xd8, 0x74, 0xd2, 0x05, 0x74, 0x02, 0x55, 0xdf, 0x1d, 0x60, 0x9f, 0xdb,
-	0xb5, 0x56, 0xe5, 0xd8, 0x3a, 0xbd, 0xdd, 0x49, 0xa5, 0xd3, 0x91, 0xc7, 0xf2, 0x85, 0x8c, 0x3a,
-	0x1a, 0x84, 0xde, 0x07, 0x93, 0x63, 0x1f, 0x0f, 0x05, 0x65, 0xdc, 0x36, 0x15, 0xc3, 0x5e, 0x66,
-	0x9c, 0x6b, 0x80, 0x93, 0x42, 0xd1, 0x67, 0x60, 0x0d, 0x5d, 0x81, 0xc7, 0x94, 0x2d, 0xfa, 0xc4,
-	0xb3, 0xa1, 0xd0, 0x04, 0x34, 0xe1, 0xb1, 0xd7, 0x7e, 0x02, 0x28, 0x2b, 0x14, 0x1e, 0xd2, 0x80,
-	0x63, 0xf4, 0x1e, 0xec, 0x4a, 0xcd, 0xcb, 0x0d, 0x8b, 0x88, 0xa5, 0x2a, 0xc1, 0x8f, 0xbd, 0xf6,
-	0x9f, 0x06, 0xdc, 0x7c, 0x4a, 0x3d, 0x32, 0x5a, 0x64, 0x65, 0xb7, 0xdd, 0x66, 0x89, 0x5a, 0xcb,
-	0xdb, 0xaa, 0xb5, 0xb2, 0xad, 0x5a, 0x8d, 0x4d, 0xd5, 0xba, 0xb3, 0x9d, 0x5a, 0xab, 0xd7, 0x52,
-	0xeb, 0xee, 0x75, 0xd4, 0x5a, 0x5b, 0xaf, 0x56, 0x73, 0x63, 0xb5, 0xc2, 0xd6, 0x6a, 0xb5, 0x36,
-	0x57, 0x6b, 0x56, 0x5f, 0xd7, 0x53, 0xeb, 0x09, 0xa0, 0x47, 0xd8, 0xc7, 0x91, 0xf4, 0x79, 0xac,
-	0xd6, 0xd7, 0xb2, 0x9b, 0xc9, 0x21, 0xc5, 0xf0, 0x0e, 0xdc, 0xca, 0xc1, 0x75, 0xf2, 0xb5, 0xf8,
-	0x1f, 0x4a, 0x60, 0x26, 0x83, 0x43, 0x1f, 0x81, 0xa9, 0x46, 0xd7, 0xbf, 0xc0, 0x8b, 0x42, 0xca,
-	0xac, 0x29, 0xf8, 0x13, 0xbc, 0x90, 0x33, 0x8b, 0xa8, 0x33, 0x19, 0x28, 0xa4, 0x4e, 0x50, 0x04,
-	0xf5, 0x7f, 0xfb, 0xe7, 0x12, 0xd4, 0xb3, 0xc7, 0x81, 0x1e, 0x40, 0x3d, 0x3e, 0x90, 0xc2, 0xd5,
-	0x58, 0x31, 0x43, 0x16, 0xd4, 0x83, 0xbd, 0x64, 0x83, 0xe2, 0x35, 0x35, 0x62, 0x4e, 0x54, 0xd6,
-	0xef, 0x55, 0x30, 0x64, 0x59, 0xff, 0xdb, 0xc3, 0x7f, 0xc3, 0x1e, 0x4e, 0x61, 0x87, 0xce, 0x03,
-	0xcc, 0xec, 0x5a, 0x01, 0x62, 0x04, 0xcd, 0x5b, 0x8a, 0xb9, 0xde, 0x52, 0x60, 0x63, 0x4b, 0xb1,
-	0x8a, 0x5b, 0xca, 0x7d, 0xa8, 0x72, 0xe1, 0x8a, 0x29, 0xb7, 0xeb, 0x45, 0xe4, 0x13, 0x61, 0xd1,
-	0x27, 0x60, 0x0d, 0xd5, 0x77, 0xaf, 0x2f, 0x2f, 0x65, 0x76, 0x43, 0x51, 0x9b, 0x2b, 0xd4, 0x2f,
-	0xe3, 0x1b, 0x9b, 0x9a, 0xb4, 0x2b, 0xb0, 0x5c, 0x90, 0xe4, 0x68, 0x9b, 0x88, 0xbc, 0xf7, 0xef,
-	0xe4, 0x08, 0xae, 0xc8, 0x0f, 0xa0, 0x9e, 0x58, 0x20, 0xc7, 0xc2, 0xbe, 0xa1, 0x5a, 0x3d, 0xc8,
-	0xb7, 0xca, 0xe9, 0x94, 0x0d, 0x71, 0x4f, 0xe3, 0x9c, 0xc4, 0x34, 0xcf, 0xb1, 0x68, 0xff, 0x62,
-	0xc0, 0xfe, 0x23, 0xa5, 0xcb, 0x41, 0x31, 0xeb, 0x42, 0x28, 0x79, 0x57, 0xe4, 0x6a, 0xf4, 0x36,
-	0xa0, 0xe4, 0x6a, 0xa6, 0xd6, 0x94, 0x5e, 0x0f, 0x73, 0x0a, 0x32, 0x54, 0x24, 0xab, 0x91, 0x57,
-	0x93, 0x51, 0xef, 0x44, 0xfb, 0xeb, 0x61, 0x36, 0xa1, 0x16, 0x1f, 0xbb, 0x5d, 0x55, 0x91, 0xe4,
-	0x59, 0xea, 0x4a, 0x1d, 0x70, 0x21, 0x41, 0x46, 0x50, 0xf4, 0x21, 0xd4, 0xe2, 0xf3, 0x2d, 0x24,
-	0xc7, 0x04, 0x8d, 0xf6, 0x61, 0xc7, 0x27, 0x13, 0x22, 0x6c, 0xb3, 0x55, 0x3a, 0x6e, 0x38, 0xd1,
-	0x83, 0xac, 0x9b, 0x8e, 0x46, 0x72, 0xd8, 0xa0, 0x96, 0xf5, 0x13, 0x3a, 0x5a, 0xfe, 0x1a, 0xa9,
-	0x86, 0xd3, 0xef, 0x8d, 0xb4, 0x5e, 0x8e, 0x5d, 0x36, 0x7c, 0xde, 0x9f, 0x53, 0xe6, 0x15, 0x12,
-	0x18, 0x44, 0x84, 0xaf, 0x28, 0xf3, 0xd0, 0x07, 0x50, 0xe3, 0x94, 0x09, 0xe5, 0xb2, 0x8d, 0x02,
-	0xdc, 0x5d, 0x89, 0x96, 0x0e, 0x7b, 0x5f, 0x9e, 0xe4, 0x0c, 0x33, 0xbe, 0x5e, 0x5c, 0x0f, 0x29,
-	0xf5, 0x35, 0x4b, 0x43, 0xdb, 0x18, 0x6e, 0x2f, 0xe9, 0x42, 0x7f, 0xa3, 0x8e, 0xc0, 0x12, 0x54,
-	0xb8, 0x7e, 0x7f, 0x48, 0xa7, 0x81, 0x50, 0x36, 0xdb, 0x70, 0x40, 0x2d, 0xf5, 0xe4, 0x0a, 0x7a,
-	0x1b, 0x6a, 0x4a, 0x39, 0x72, 0x44, 0x65, 0xa5, 0xc7, 0x57, 0x96, 0x5f, 0x3d, 0x47, 0x69, 0x4b,
-	0xea, 0xef, 0xb7, 0x12, 0xdc, 0x7a, 0xe6, 0xfa, 0xc4, 0x5b, 0xfd, 0x79, 0xa1, 0x14, 0x55, 0xda,
-	0xd4, 0x1f, 0xcb, 0xdb, 0xf9, 0x63, 0x65, 0x33, 0x7f, 0x6c, 0x7f, 0x03, 0xfb, 0xf9, 0xb2, 0xf5,
-	0x74, 0xee, 0x40, 0x99, 0x5e, 0xe8, 0xaa, 0x5f, 0x36, 0xe7, 0x32, 0xbd, 0x90, 0x8e, 0x87, 0x19,
-	0xa3, 0xac, 0x47, 0xbd, 0xe8, 0xd3, 0xd3, 0x70, 0xd2, 0x85, 0xd3, 0xbf, 0x0d, 0xb0, 0xe4, 0xd6,
-	0x4f, 0xdd, 0xc0, 0x1d, 0x63, 0x86, 0x5e, 0x00, 0xa4, 0x97, 0x6b, 0xf4, 0x7a, 0x76, 0xa4, 0x2b,
-	0xbf, 0xce, 0x9a, 0x87, 0xeb, 0xc2, 0x51, 0x99, 0xed, 0x37, 0x2f, 0xcf, 0x1a, 0x48, 0x9b, 0x56,
-	0x4b, 0x9e, 0xc5, 0xf7, 0x7f, 0xfc, 0xf5, 0x53, 0x79, 0xaf, 0x6d, 0x76, 0x67, 0xef, 0x74, 0xe5,
-	0x33, 0xff, 0xb8, 0x74, 0x07, 0xfd, 0x58, 0x82, 0x46, 0x4e, 0x04, 0xa8, 0x95, 0xdd, 0xf7, 0x2a,
-	0xdf, 0x68, 0xbe, 0xf1, 0x12, 0x84, 0x4e, 0x7e, 0xef, 0xf2, 0xec, 0x00, 0x35, 0x3d, 0x1d, 0x53,
-	0xe9, 0x79, 0x6b, 0x4e, 0xc4, 0xf3, 0xd6, 0x88, 0xf8, 0x02, 0x33, 0x55, 0x8b, 0x85, 0xd2, 0x5a,
-	0x64, 0xef, 0xe9, 0x55, 0x2d, 0xdf, 0xfb, 0xca, 0x4f, 0x84, 0x7c, 0xef, 0xab, 0x37, 0x3c, 0xdd,
-	0xfb, 0x44, 0x05, 0x32, 0xbd, 0x9f, 0xe6, 0x7b, 0x9f, 0x82, 0x95, 0xb9, 0xa1, 0xa1, 0xc3, 0x7c,
-	0x5b, 0xcb, 0x37, 0xbd, 0xe6, 0xd1, 0xda, 0xb8, 0xce, 0xfa, 0xd6, 0xe5, 0xd9, 0x1e, 0xaa, 0x7b,
-	0x2a, 0x12, 0xb5, 0x1c, 0xa5, 0xbd, 0x93, 0x4f, 0xfb, 0x1d, 0xd4, 0xb3, 0xba, 0x42, 0xb9, 0x7d,
-	0xaf, 0x78, 0x51, 0x9a, 0xad, 0xf5, 0x00, 0x9d, 0xf9, 0xee, 0xe5, 0xd9, 0x0d, 0xd4, 0x98, 0xe9,
-	0x50, 0xda, 0xf1, 0x3e, 0x42, 0x49, 0xea, 0x6e, 0x1c, 0x7e, 0x68, 0x7c, 0x5d, 0x0e, 0x07, 0x83,
-	0xaa, 0x92, 0xec, 0xbb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x87, 0x8b, 0xba, 0xf9, 0xae, 0x10,
+func init() { proto.RegisterFile("repo.proto", fileDescriptor_repo_d5bbe9158cbf502d) }
+
+var fileDescriptor_repo_d5bbe9158cbf502d = []byte{
+	// 1218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x86, 0x7e, 0x2d, 0x0d, 0x25, 0x27, 0xd9, 0x38, 0x0d, 0x21, 0x38, 0xb2, 0x2a, 0xb4, 0x80,
+	0xe1, 0xc4, 0x52, 0xea, 0xa4, 0xff, 0x4d, 0x03, 0xc7, 0xe9, 0xc1, 0x70, 0x73, 0xa1, 0xdb, 0x14,
+	0xe8, 0xc5, 0xa5, 0xc4, 0xb1, 0x42, 0x98, 0xe6, 0x32, 0xbb, 0x2b, 0xb9, 0x3a, 0x15, 0x28, 0xd0,
+	0x3e, 0x80, 0x5b, 0xf4, 0xda, 0xa7, 0xe8, 0x93, 0xf4, 0x15, 0x7a, 0xc8, 0x23, 0xf4, 0x58, 0xec,
+	0x72, 0x29, 0x91, 0xb2, 0x95, 0xac, 0xe4, 0x4b, 0x0f, 0x39, 0x49, 0xdc, 0xf9, 0xbe, 0x9d, 0x99,
+	0xdd, 0x6f, 0xbf, 0x25, 0x01, 0x18, 0x46, 0xb4, 0x13, 0x31, 0x2a, 0x28, 0x01, 0x1a, 0x61, 0x18,
+	0xf9, 0x82, 0xf9, 0x3f, 0x36, 0x9a, 0x03, 0x4a, 0x07, 0x01, 0x76, 0x55, 0xa4, 0x37, 0x3c, 0xee,
+	0x9e, 0x31, 0x37, 0x8a, 0x90, 0xf1, 0x18, 0xdb, 0xd8, 0x98, 0x8d, 0x0b, 0xff, 0x14, 0xb9, 0x70,
+	0x4f, 0x23, 0x0d, 0x58, 0xd7, 0x00, 0x37, 0xf2, 0xbb, 0x6e, 0x18, 0x52, 0xe1, 0x0a, 0x9f, 0x86,
+	0x09, 0xfd, 0x9e, 0xfa, 0xe9, 0x6f, 0x0f, 0x30, 0xdc, 0xe6, 0x67, 0xee, 0x60, 0x80, 0xac, 0x4b,
+	0x23, 0x85, 0xb8, 0x04, 0x6d, 0x89, 0x71, 0x84, 0xfa, 0xa1, 0xfd, 0xaa, 0x08, 0x37, 0xf6, 0x18,
+	0xba, 0x02, 0x1d, 0x8c, 0xa8, 0x83, 0x2f, 0x87, 0xc8, 0x05, 0xb9, 0x0f, 0xc5, 0xd0, 0x3d, 0x45,
+	0x3b, 0xd7, 0xca, 0x6d, 0x5a, 0x3b, 0xeb, 0x9d, 0x38, 0x7b, 0x27, 0x29, 0xaf, 0x73, 0x28, 0x98,
+	0x1f, 0x0e, 0x9e, 0xbb, 0xc1, 0x10, 0x1d, 0x85, 0x24, 0x5f, 0x82, 0xe5, 0x21, 0xef, 0x33, 0x5f,
+	0xa5, 0xb5, 0xf3, 0x06, 0xc4, 0x34, 0x41, 0x66, 0x94, 0x65, 0xd9, 0x05, 0x93, 0x8c, 0x12, 0x49,
+	0x3a, 0x50, 0x18, 0xb2, 0xc0, 0x2e, 0x1a, 0x10, 0x24, 0x90, 0x7c, 0x01, 0xd0, 0x67, 0xe8, 0x61,
+	0x28, 0x7c, 0x37, 0xb0, 0x4b, 0x06, 0xb4, 0x14, 0x5e, 0xb2, 0x47, 0x3e, 0xf7, 0x7b, 0x7e, 0xe0,
+	0x8b, 0xb1, 0x5d, 0x36, 0x61, 0x4f, 0xf1, 0x64, 0x1d, 0xaa, 0x11, 0xa3, 0x23, 0xdf, 0x43, 0xc6,
+	0xed, 0x95, 0x56, 0x61, 0xb3, 0xea, 0x4c, 0x07, 0xc8, 0x36, 0x94, 0x03, 0xb7, 0x87, 0x01, 0xb7,
+	0x2b, 0xad, 0xc2, 0xa6, 0xb5, 0x73, 0xab, 0x33, 0x95, 0x4e, 0x47, 0x6e, 0xcb, 0xd7, 0x32, 0xea,
+	0x68, 0x10, 0xf9, 0x08, 0xaa, 0x1c, 0x03, 0xec, 0x0b, 0xca, 0xb8, 0x5d, 0x55, 0x0c, 0x7b, 0x96,
+	0x71, 0xa8, 0x01, 0xce, 0x14, 0x4a, 0x1e, 0x81, 0xd5, 0x77, 0x05, 0x0e, 0x28, 0x1b, 0x1f, 0xf9,
+	0x9e, 0x0d, 0x46, 0x2b, 0xa0, 0x09, 0xfb, 0x1e, 0x79, 0x0c, 0x35, 0xc1, 0x86, 0x5c, 0xa0, 0x77,
+	0x74, 0x82, 0x63, 0x6e, 0x5b, 0x26, 0x5b, 0xac, 0x19, 0x07, 0x38, 0xe6, 0xed, 0x03, 0x20, 0x69,
+	0xa5, 0xf1, 0x88, 0x86, 0x1c, 0xc9, 0x87, 0xb0, 0x22, 0x0f, 0x8d, 0xac, 0xc8, 0x44, 0x6d, 0x65,
+	0x09, 0xde, 0xf7, 0xda, 0x7f, 0x96, 0xe0, 0xc6, 0x33, 0xea, 0xf9, 0xc7, 0xe3, 0xb4, 0x6e, 0x97,
+	0x9b, 0x6c, 0x22, 0xf7, 0xfc, 0xb2, 0x72, 0x2f, 0x2c, 0x2b, 0xf7, 0xe2, 0xa2, 0x72, 0x2f, 0x2d,
+	0x27, 0xf7, 0xf2, 0x95, 0xe4, 0xbe, 0x72, 0x15, 0xb9, 0x57, 0xe6, 0xcb, 0xbd, 0xba, 0xb0, 0xdc,
+	0x61, 0x69, 0xb9, 0x5b, 0x57, 0x94, 0x7b, 0x6d, 0x09, 0xb9, 0xa7, 0x05, 0x7a, 0x35, 0xb9, 0x6f,
+	0x03, 0x79, 0x8a, 0x01, 0xc6, 0x67, 0x87, 0x27, 0x72, 0xbf, 0x9d, 0x9e, 0x4c, 0xae, 0x72, 0x02,
+	0xef, 0xc0, 0xcd, 0x0c, 0x5c, 0x27, 0x9f, 0x8b, 0xff, 0x25, 0x07, 0xd5, 0xc9, 0xca, 0x93, 0x4f,
+	0xa1, 0xaa, 0xd6, 0x5e, 0x36, 0x6e, 0x24, 0xed, 0x8a, 0x82, 0x1f, 0xe0, 0x58, 0x2e, 0x7a, 0x4c,
+	0x1d, 0xc9, 0x80, 0x91, 0xbc, 0x41, 0x11, 0xd4, 0xff, 0xf6, 0xef, 0x39, 0xa8, 0xa5, 0xf7, 0x53,
+	0xee, 0x42, 0xb2, 0xa3, 0xc6, 0xd5, 0x58, 0x09, 0x43, 0x16, 0xb4, 0x07, 0xab, 0x93, 0x09, 0xcc,
+	0x6b, 0xaa, 0x27, 0x9c, 0xb8, 0xac, 0x7f, 0xcb, 0x50, 0x94, 0x65, 0xbd, 0xf5, 0x97, 0xff, 0x87,
+	0xbf, 0xec, 0x40, 0x89, 0x9e, 0x85, 0xc8, 0xec, 0x8a, 0x01, 0x31, 0x86, 0x66, 0x3d, 0xa9, 0x3a,
+	0xdf, 0x93, 0x60, 0x61, 0x4f, 0xb2, 0xcc, 0x3d, 0xe9, 0x21, 0x94, 0xb9, 0x70, 0xc5, 0xd0, 0xcc,
+	0x4e, 0x34, 0x96, 0x7c, 0x0e, 0x56, 0x5f, 0x5d, 0x9c, 0x47, 0xf2, 0xb5, 0xd0, 0xae, 0x2b, 0x6a,
+	0xe3, 0x02, 0xf5, 0x9b, 0xe4, 0x9d, 0x51, 0xad, 0xb4, 0x2b, 0x50, 0x0e, 0x48, 0x72, 0x3c, 0x4d,
+	0x4c, 0x5e, 0x7d, 0x33, 0x39, 0x86, 0x2b, 0xf2, 0x63, 0xa8, 0x4d, 0x3c, 0x94, 0xa3, 0xb0, 0xaf,
+	0xa9, 0x56, 0xd7, 0xb3, 0xad, 0x72, 0x3a, 0x64, 0x7d, 0xdc, 0xd3, 0x38, 0x67, 0xe2, 0xba, 0x87,
+	0x28, 0x2e, 0xb8, 0xe8, 0xf5, 0x45, 0x5d, 0xf4, 0x8f, 0x22, 0xac, 0x3d, 0x55, 0xc2, 0xee, 0x99,
+	0x79, 0x1f, 0x21, 0x93, 0xc3, 0x26, 0x47, 0xd5, 0x7f, 0x39, 0xa6, 0xdf, 0x2e, 0xd5, 0x98, 0xfc,
+	0x4f, 0x9a, 0x19, 0x09, 0x16, 0x55, 0x24, 0x2d, 0xb2, 0x77, 0x26, 0x7b, 0x55, 0x8a, 0xe7, 0xd7,
+	0xbb, 0xd1, 0x80, 0x4a, 0xa2, 0x1b, 0xbb, 0xac, 0x22, 0x93, 0x67, 0x29, 0x4c, 0xa5, 0x10, 0x23,
+	0x45, 0xc7, 0x50, 0xf2, 0x09, 0x54, 0x12, 0x81, 0x18, 0xe9, 0x79, 0x82, 0x26, 0x6b, 0x50, 0x0a,
+	0xfc, 0x53, 0x5f, 0xd8, 0xd5, 0x56, 0x6e, 0xb3, 0xee, 0xc4, 0x0f, 0xb2, 0x6e, 0x7a, 0x7c, 0x2c,
+	0x77, 0x0b, 0xd4, 0xb0, 0x7e, 0x22, 0x1b, 0xb3, 0xf7, 0xa1, 0x6a, 0x38, 0x75, 0xe3, 0x3d, 0x02,
+	0x8b, 0xa3, 0xcb, 0xfa, 0x2f, 0x8e, 0xce, 0x28, 0xf3, 0x8c, 0x14, 0x0a, 0x31, 0xe1, 0x3b, 0xca,
+	0x3c, 0xf2, 0x31, 0x54, 0x38, 0x65, 0x42, 0xd9, 0x74, 0xdd, 0x80, 0xbb, 0x22, 0xd1, 0xd2, 0xa2,
+	0x1f, 0xca, 0x9d, 0x1c, 0x21, 0xe3, 0xf3, 0xd5, 0xf9, 0x84, 0xd2, 0x40, 0xb3, 0x34, 0xb4, 0x8d,
+	0x70, 0x6b, 0x46, 0x17, 0xfa, 0x92, 0xdb, 0x00, 0x4b, 0x50, 0xe1, 0x06, 0x47, 0x7d, 0x3a, 0x0c,
+	0x85, 0xf2, 0xe9, 0xba, 0x03, 0x6a, 0x68, 0x4f, 0x8e, 0x90, 0xbb, 0x50, 0x51, 0xca, 0x91, 0x4b,
+	0x94, 0x57, 0x82, 0xbe, 0x3e, 0x7b, 0x76, 0x1d, 0xa5, 0xad, 0x43, 0x14, 0xed, 0xbf, 0x72, 0x70,
+	0xf3, 0xb9, 0x1b, 0xf8, 0xde, 0xc5, 0x2f, 0x24, 0xa5, 0xa8, 0xdc, 0xa2, 0x06, 0x9b, 0x5f, 0xce,
+	0x60, 0x0b, 0x8b, 0x19, 0x6c, 0xfb, 0x07, 0x58, 0xcb, 0x96, 0xad, 0x57, 0x67, 0x0b, 0xf2, 0xf4,
+	0xc4, 0xce, 0xbd, 0x71, 0x9d, 0xf3, 0xf4, 0x44, 0x5a, 0x26, 0x32, 0x46, 0xd9, 0x1e, 0xf5, 0xe2,
+	0xbb, 0xab, 0xee, 0x4c, 0x07, 0xda, 0xdf, 0x42, 0xd3, 0xa1, 0x42, 0xcf, 0xff, 0x55, 0xd8, 0x67,
+	0x63, 0x75, 0xf5, 0x1c, 0xe0, 0x38, 0x59, 0xa3, 0x07, 0x50, 0x3e, 0xc1, 0xb1, 0xe9, 0x65, 0x59,
+	0x3a, 0xc1, 0xf1, 0xbe, 0xd7, 0x1e, 0xc2, 0xc6, 0xdc, 0x69, 0x75, 0x0f, 0xcb, 0xcc, 0x4b, 0xee,
+	0xc4, 0x1f, 0xe7, 0x5a, 0x15, 0xba, 0x1b, 0x39, 0xa2, 0x44, 0xb1, 0xf3, 0xaa, 0x04, 0x96, 0xcc,
+	0xf8, 0xcc, 0x0d, 0xdd, 0x01, 0x32, 0xf2, 0x12, 0x60, 0xfa, 0xb1, 0x42, 0xee, 0xa4, 0x05, 0x72,
+	0xe1, 0x73, 0xb9, 0xd1, 0x9c, 0x17, 0x8e, 0x0b, 0x6e, 0xbf, 0x77, 0xbe, 0x5b, 0x27, 0xda, 0xc3,
+	0x5b, 0x32, 0xe7, 0xcf, 0x7f, 0xff, 0xf3, 0x5b, 0x7e, 0xb5, 0x5d, 0xed, 0x8e, 0x3e, 0xe8, 0xca,
+	0x67, 0xfe, 0x59, 0x6e, 0x8b, 0xfc, 0x9a, 0x83, 0x7a, 0x46, 0xd2, 0xa4, 0x95, 0x9e, 0xf7, 0x32,
+	0x17, 0x6c, 0xbc, 0xfb, 0x1a, 0x84, 0x4e, 0x7e, 0xff, 0x7c, 0x77, 0x9d, 0x34, 0x3c, 0x1d, 0x53,
+	0xe9, 0x79, 0xeb, 0xcc, 0x17, 0x2f, 0x5a, 0xc7, 0x7e, 0x20, 0x90, 0xa9, 0x5a, 0x2c, 0x32, 0xad,
+	0x45, 0xf6, 0x3e, 0x7d, 0x73, 0xcd, 0xf6, 0x7e, 0xe1, 0x93, 0xab, 0xd1, 0x9c, 0x17, 0xce, 0xf4,
+	0x7e, 0xaa, 0x02, 0xa9, 0xde, 0x77, 0xb2, 0xbd, 0x0f, 0xc1, 0x4a, 0xbd, 0xb0, 0x92, 0x66, 0xb6,
+	0xad, 0xd9, 0x17, 0xdf, 0xc6, 0xc6, 0xdc, 0xb8, 0xce, 0xfa, 0xfe, 0xf9, 0xee, 0x2a, 0xa9, 0x79,
+	0x2a, 0x12, 0xb7, 0x1c, 0xa7, 0xdd, 0xca, 0xa6, 0xfd, 0x09, 0x6a, 0xe9, 0x53, 0x42, 0x32, 0xf3,
+	0x5e, 0x72, 0xec, 0x1b, 0xad, 0xf9, 0x00, 0x9d, 0xf9, 0xde, 0xf9, 0xee, 0x35, 0x52, 0x1f, 0xe9,
+	0xd0, 0xb4, 0xe3, 0x35, 0x42, 0x26, 0xa9, 0xbb, 0x49, 0x98, 0x30, 0xb8, 0x3d, 0x47, 0xed, 0x64,
+	0x2b, 0x63, 0x4a, 0xaf, 0x3d, 0x69, 0x8d, 0xbb, 0x46, 0xd8, 0xb8, 0xc2, 0x27, 0xc5, 0xef, 0xf3,
+	0x51, 0xaf, 0x57, 0x56, 0x87, 0xe5, 0xc1, 0x7f, 0x03, 0x00, 0xb0, 0xee, 0x37, 0x49, 0xb3, 0x12,
 	0x00, 0x00,
 }
@@ -42,7 +42,7 @@ func (m *RuntimeLabel) Reset()         { *m = RuntimeLabel{} }
 func (m *RuntimeLabel) String() string { return proto.CompactTextString(m) }
 func (*RuntimeLabel) ProtoMessage()    {}
 func (*RuntimeLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{0}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{0}
 }
 func (m *RuntimeLabel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuntimeLabel.Unmarshal(m, b)
@@ -98,17 +98,23 @@ func (m *RuntimeLabel) GetCreateTime() *timestamp.Timestamp {
 }
 
 type Runtime struct {
-	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	Name                 *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description          *wrappers.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Provider             *wrappers.StringValue `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
-	RuntimeUrl           *wrappers.StringValue `protobuf:"bytes,5,opt,name=runtime_url,json=runtimeUrl,proto3" json:"runtime_url,omitempty"`
-	Zone                 *wrappers.StringValue `protobuf:"bytes,6,opt,name=zone,proto3" json:"zone,omitempty"`
-	Labels               []*RuntimeLabel       `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
-	Owner                *wrappers.StringValue `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
-	Status               *wrappers.StringValue `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	CreateTime           *timestamp.Timestamp  `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	StatusTime           *timestamp.Timestamp  `protobuf:"bytes,11,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	RuntimeId   *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Name        *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description *wrappers.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Provider    *wrappers.StringValue `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	RuntimeUrl  *wrappers.StringValue `protobuf:"bytes,5,opt,name=runtime_url,json=runtimeUrl,proto3" json:"runtime_url,omitempty"`
+	Zone        *wrappers.StringValue `protobuf:"bytes,6,opt,name=zone,proto3" json:"zone,omitempty"`
+	Labels      []*RuntimeLabel       `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
+	Owner       *wrappers.StringValue `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	Status      *wrappers.StringValue `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CreateTime  *timestamp.Timestamp  `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	StatusTime  *timestamp.Timestamp  `protobuf:"bytes,11,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	// healthy, unhealthy or unknown before the first check
+	HealthStatus *wrappers.StringValue `protobuf:"bytes,12,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	LastError    *wrappers.StringValue `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// latency of the provider api in milliseconds
+	ApiLatency           *wrappers.UInt32Value `protobuf:"bytes,14,opt,name=api_latency,json=apiLatency,proto3" json:"api_latency,omitempty"`
+	HealthCheckTime      *timestamp.Timestamp  `protobuf:"bytes,15,opt,name=health_check_time,json=healthCheckTime,proto3" json:"health_check_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -118,7 +124,7 @@ func (m *Runtime) Reset()         { *m = Runtime{} }
 func (m *Runtime) String() string { return proto.CompactTextString(m) }
 func (*Runtime) ProtoMessage()    {}
 func (*Runtime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{1}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{1}
 }
 func (m *Runtime) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Runtime.Unmarshal(m, b)
@@ -215,6 +221,34 @@ func (m *Runtime) GetStatusTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Runtime) GetHealthStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.HealthStatus
+	}
+	return nil
+}
+
+func (m *Runtime) GetLastError() *wrappers.StringValue {
+	if m != nil {
+		return m.LastError
+	}
+	return nil
+}
+
+func (m *Runtime) GetApiLatency() *wrappers.UInt32Value {
+	if m != nil {
+		return m.ApiLatency
+	}
+	return nil
+}
+
+func (m *Runtime) GetHealthCheckTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.HealthCheckTime
+	}
+	return nil
+}
+
 type RuntimeDetail struct {
 	Runtime              *Runtime              `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
 	RuntimeCredential    *wrappers.StringValue `protobuf:"bytes,2,opt,name=runtime_credential,json=runtimeCredential,proto3" json:"runtime_credential,omitempty"`
@@ -227,7 +261,7 @@ func (m *RuntimeDetail) Reset()         { *m = RuntimeDetail{} }
 func (m *RuntimeDetail) String() string { return proto.CompactTextString(m) }
 func (*RuntimeDetail) ProtoMessage()    {}
 func (*RuntimeDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{2}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{2}
 }
 func (m *RuntimeDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuntimeDetail.Unmarshal(m, b)
@@ -278,7 +312,7 @@ func (m *CreateRuntimeRequest) Reset()         { *m = CreateRuntimeRequest{} }
 func (m *CreateRuntimeRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRuntimeRequest) ProtoMessage()    {}
 func (*CreateRuntimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{3}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{3}
 }
 func (m *CreateRuntimeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRuntimeRequest.Unmarshal(m, b)
@@ -358,7 +392,7 @@ func (m *CreateRuntimeResponse) Reset()         { *m = CreateRuntimeResponse{} }
 func (m *CreateRuntimeResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateRuntimeResponse) ProtoMessage()    {}
 func (*CreateRuntimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{4}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{4}
 }
 func (m *CreateRuntimeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRuntimeResponse.Unmarshal(m, b)
@@ -386,14 +420,17 @@ func (m *CreateRuntimeResponse) GetRuntimeId() *wrappers.StringValue {
 }
 
 type DescribeRuntimesRequest struct {
-	RuntimeId            []string              `protobuf:"bytes,1,rep,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	Status               []string              `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty"`
-	Provider             []string              `protobuf:"bytes,3,rep,name=provider,proto3" json:"provider,omitempty"`
-	SearchWord           *wrappers.StringValue `protobuf:"bytes,4,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
-	Label                *wrappers.StringValue `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
-	Owner                []string              `protobuf:"bytes,6,rep,name=owner,proto3" json:"owner,omitempty"`
-	Limit                uint32                `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               uint32                `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	RuntimeId    []string              `protobuf:"bytes,1,rep,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Status       []string              `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty"`
+	Provider     []string              `protobuf:"bytes,3,rep,name=provider,proto3" json:"provider,omitempty"`
+	SearchWord   *wrappers.StringValue `protobuf:"bytes,4,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
+	Label        *wrappers.StringValue `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	Owner        []string              `protobuf:"bytes,6,rep,name=owner,proto3" json:"owner,omitempty"`
+	Limit        uint32                `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset       uint32                `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	HealthStatus []string              `protobuf:"bytes,9,rep,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	// runtimes whose labels match the selectors of the repo
+	RepoId               *wrappers.StringValue `protobuf:"bytes,10,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -403,7 +440,7 @@ func (m *DescribeRuntimesRequest) Reset()         { *m = DescribeRuntimesRequest
 func (m *DescribeRuntimesRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeRuntimesRequest) ProtoMessage()    {}
 func (*DescribeRuntimesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{5}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{5}
 }
 func (m *DescribeRuntimesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeRuntimesRequest.Unmarshal(m, b)
@@ -479,6 +516,20 @@ func (m *DescribeRuntimesRequest) GetOffset() uint32 {
 	return 0
 }
 
+func (m *DescribeRuntimesRequest) GetHealthStatus() []string {
+	if m != nil {
+		return m.HealthStatus
+	}
+	return nil
+}
+
+func (m *DescribeRuntimesRequest) GetRepoId() *wrappers.StringValue {
+	if m != nil {
+		return m.RepoId
+	}
+	return nil
+}
+
 type DescribeRuntimesResponse struct {
 	TotalCount           uint32     `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	RuntimeSet           []*Runtime `protobuf:"bytes,2,rep,name=runtime_set,json=runtimeSet,proto3" json:"runtime_set,omitempty"`
@@ -491,7 +542,7 @@ func (m *DescribeRuntimesResponse) Reset()         { *m = DescribeRuntimesRespon
 func (m *DescribeRuntimesResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeRuntimesResponse) ProtoMessage()    {}
 func (*DescribeRuntimesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{6}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{6}
 }
 func (m *DescribeRuntimesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeRuntimesResponse.Unmarshal(m, b)
@@ -537,7 +588,7 @@ func (m *DescribeRuntimeDetailsResponse) Reset()         { *m = DescribeRuntimeD
 func (m *DescribeRuntimeDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeRuntimeDetailsResponse) ProtoMessage()    {}
 func (*DescribeRuntimeDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{7}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{7}
 }
 func (m *DescribeRuntimeDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeRuntimeDetailsResponse.Unmarshal(m, b)
@@ -585,7 +636,7 @@ func (m *ModifyRuntimeRequest) Reset()         { *m = ModifyRuntimeRequest{} }
 func (m *ModifyRuntimeRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyRuntimeRequest) ProtoMessage()    {}
 func (*ModifyRuntimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{8}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{8}
 }
 func (m *ModifyRuntimeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyRuntimeRequest.Unmarshal(m, b)
@@ -644,7 +695,7 @@ func (m *ModifyRuntimeResponse) Reset()         { *m = ModifyRuntimeResponse{} }
 func (m *ModifyRuntimeResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyRuntimeResponse) ProtoMessage()    {}
 func (*ModifyRuntimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{9}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{9}
 }
 func (m *ModifyRuntimeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyRuntimeResponse.Unmarshal(m, b)
@@ -682,7 +733,7 @@ func (m *DeleteRuntimesRequest) Reset()         { *m = DeleteRuntimesRequest{} }
 func (m *DeleteRuntimesRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRuntimesRequest) ProtoMessage()    {}
 func (*DeleteRuntimesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{10}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{10}
 }
 func (m *DeleteRuntimesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRuntimesRequest.Unmarshal(m, b)
@@ -720,7 +771,7 @@ func (m *DeleteRuntimesResponse) Reset()         { *m = DeleteRuntimesResponse{}
 func (m *DeleteRuntimesResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteRuntimesResponse) ProtoMessage()    {}
 func (*DeleteRuntimesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{11}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{11}
 }
 func (m *DeleteRuntimesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRuntimesResponse.Unmarshal(m, b)
@@ -760,7 +811,7 @@ func (m *DescribeRuntimeProviderZonesRequest) Reset()         { *m = DescribeRun
 func (m *DescribeRuntimeProviderZonesRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeRuntimeProviderZonesRequest) ProtoMessage()    {}
 func (*DescribeRuntimeProviderZonesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{12}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{12}
 }
 func (m *DescribeRuntimeProviderZonesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeRuntimeProviderZonesRequest.Unmarshal(m, b)
@@ -813,7 +864,7 @@ func (m *DescribeRuntimeProviderZonesResponse) Reset()         { *m = DescribeRu
 func (m *DescribeRuntimeProviderZonesResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeRuntimeProviderZonesResponse) ProtoMessage()    {}
 func (*DescribeRuntimeProviderZonesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{13}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{13}
 }
 func (m *DescribeRuntimeProviderZonesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DescribeRuntimeProviderZonesResponse.Unmarshal(m, b)
@@ -857,7 +908,7 @@ func (m *GetRuntimeStatisticsRequest) Reset()         { *m = GetRuntimeStatistic
 func (m *GetRuntimeStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRuntimeStatisticsRequest) ProtoMessage()    {}
 func (*GetRuntimeStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{14}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{14}
 }
 func (m *GetRuntimeStatisticsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRuntimeStatisticsRequest.Unmarshal(m, b)
@@ -891,7 +942,7 @@ func (m *GetRuntimeStatisticsResponse) Reset()         { *m = GetRuntimeStatisti
 func (m *GetRuntimeStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRuntimeStatisticsResponse) ProtoMessage()    {}
 func (*GetRuntimeStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_runtime_062bb0b898dbafe2, []int{15}
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{15}
 }
 func (m *GetRuntimeStatisticsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRuntimeStatisticsResponse.Unmarshal(m, b)
@@ -939,6 +990,411 @@ func (m *GetRuntimeStatisticsResponse) GetProviderCount() uint32 {
 	return 0
 }
 
+type RotateEncryptionKeyRequest struct {
+	// the expected current master key, the rotation is refused if the master
+	// keys of runtime manager or repo manager are not updated yet
+	KeyId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RotateEncryptionKeyRequest) Reset()         { *m = RotateEncryptionKeyRequest{} }
+func (m *RotateEncryptionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateEncryptionKeyRequest) ProtoMessage()    {}
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{16}
+}
+func (m *RotateEncryptionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateEncryptionKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateEncryptionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateEncryptionKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *RotateEncryptionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateEncryptionKeyRequest.Merge(dst, src)
+}
+func (m *RotateEncryptionKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateEncryptionKeyRequest.Size(m)
+}
+func (m *RotateEncryptionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateEncryptionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateEncryptionKeyRequest proto.InternalMessageInfo
+
+func (m *RotateEncryptionKeyRequest) GetKeyId() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyId
+	}
+	return nil
+}
+
+type RotateEncryptionKeyResponse struct {
+	// the master key that wraps the data keys of the credentials now
+	KeyId                  *wrappers.StringValue `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	RuntimeCredentialCount uint32                `protobuf:"varint,2,opt,name=runtime_credential_count,json=runtimeCredentialCount,proto3" json:"runtime_credential_count,omitempty"`
+	RepoCount              uint32                `protobuf:"varint,3,opt,name=repo_count,json=repoCount,proto3" json:"repo_count,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
+	XXX_unrecognized       []byte                `json:"-"`
+	XXX_sizecache          int32                 `json:"-"`
+}
+
+func (m *RotateEncryptionKeyResponse) Reset()         { *m = RotateEncryptionKeyResponse{} }
+func (m *RotateEncryptionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateEncryptionKeyResponse) ProtoMessage()    {}
+func (*RotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{17}
+}
+func (m *RotateEncryptionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateEncryptionKeyResponse.Unmarshal(m, b)
+}
+func (m *RotateEncryptionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateEncryptionKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *RotateEncryptionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateEncryptionKeyResponse.Merge(dst, src)
+}
+func (m *RotateEncryptionKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RotateEncryptionKeyResponse.Size(m)
+}
+func (m *RotateEncryptionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateEncryptionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateEncryptionKeyResponse proto.InternalMessageInfo
+
+func (m *RotateEncryptionKeyResponse) GetKeyId() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyId
+	}
+	return nil
+}
+
+func (m *RotateEncryptionKeyResponse) GetRuntimeCredentialCount() uint32 {
+	if m != nil {
+		return m.RuntimeCredentialCount
+	}
+	return 0
+}
+
+func (m *RotateEncryptionKeyResponse) GetRepoCount() uint32 {
+	if m != nil {
+		return m.RepoCount
+	}
+	return 0
+}
+
+// RuntimePrice is the price sheet of the resources in the runtime.
+type RuntimePrice struct {
+	RuntimeId *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Currency  *wrappers.StringValue `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// price of a cpu core per hour
+	CpuHour *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=cpu_hour,json=cpuHour,proto3" json:"cpu_hour,omitempty"`
+	// price of 1 GB memory per hour
+	MemoryGbHour *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=memory_gb_hour,json=memoryGbHour,proto3" json:"memory_gb_hour,omitempty"`
+	// price of 1 GB volume per month of 30 days
+	VolumeGbMonth *wrappers.DoubleValue `protobuf:"bytes,5,opt,name=volume_gb_month,json=volumeGbMonth,proto3" json:"volume_gb_month,omitempty"`
+	// price of a gpu per hour
+	GpuHour *wrappers.DoubleValue `protobuf:"bytes,6,opt,name=gpu_hour,json=gpuHour,proto3" json:"gpu_hour,omitempty"`
+	// price per hour of the instance classes named by cpu and memory in MB,
+	// e.g. "2c4096m", which replaces the price of the cpu and memory
+	InstanceClassHour    map[string]float64   `protobuf:"bytes,7,rep,name=instance_class_hour,json=instanceClassHour,proto3" json:"instance_class_hour,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	StatusTime           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RuntimePrice) Reset()         { *m = RuntimePrice{} }
+func (m *RuntimePrice) String() string { return proto.CompactTextString(m) }
+func (*RuntimePrice) ProtoMessage()    {}
+func (*RuntimePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{18}
+}
+func (m *RuntimePrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimePrice.Unmarshal(m, b)
+}
+func (m *RuntimePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimePrice.Marshal(b, m, deterministic)
+}
+func (dst *RuntimePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimePrice.Merge(dst, src)
+}
+func (m *RuntimePrice) XXX_Size() int {
+	return xxx_messageInfo_RuntimePrice.Size(m)
+}
+func (m *RuntimePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimePrice proto.InternalMessageInfo
+
+func (m *RuntimePrice) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *RuntimePrice) GetCurrency() *wrappers.StringValue {
+	if m != nil {
+		return m.Currency
+	}
+	return nil
+}
+
+func (m *RuntimePrice) GetCpuHour() *wrappers.DoubleValue {
+	if m != nil {
+		return m.CpuHour
+	}
+	return nil
+}
+
+func (m *RuntimePrice) GetMemoryGbHour() *wrappers.DoubleValue {
+	if m != nil {
+		return m.MemoryGbHour
+	}
+	return nil
+}
+
+func (m *RuntimePrice) GetVolumeGbMonth() *wrappers.DoubleValue {
+	if m != nil {
+		return m.VolumeGbMonth
+	}
+	return nil
+}
+
+func (m *RuntimePrice) GetGpuHour() *wrappers.DoubleValue {
+	if m != nil {
+		return m.GpuHour
+	}
+	return nil
+}
+
+func (m *RuntimePrice) GetInstanceClassHour() map[string]float64 {
+	if m != nil {
+		return m.InstanceClassHour
+	}
+	return nil
+}
+
+func (m *RuntimePrice) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *RuntimePrice) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+type ModifyRuntimePriceRequest struct {
+	RuntimeId     *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Currency      *wrappers.StringValue `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	CpuHour       *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=cpu_hour,json=cpuHour,proto3" json:"cpu_hour,omitempty"`
+	MemoryGbHour  *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=memory_gb_hour,json=memoryGbHour,proto3" json:"memory_gb_hour,omitempty"`
+	VolumeGbMonth *wrappers.DoubleValue `protobuf:"bytes,5,opt,name=volume_gb_month,json=volumeGbMonth,proto3" json:"volume_gb_month,omitempty"`
+	GpuHour       *wrappers.DoubleValue `protobuf:"bytes,6,opt,name=gpu_hour,json=gpuHour,proto3" json:"gpu_hour,omitempty"`
+	// replaces all the instance classes if not empty
+	InstanceClassHour    map[string]float64 `protobuf:"bytes,7,rep,name=instance_class_hour,json=instanceClassHour,proto3" json:"instance_class_hour,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ModifyRuntimePriceRequest) Reset()         { *m = ModifyRuntimePriceRequest{} }
+func (m *ModifyRuntimePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyRuntimePriceRequest) ProtoMessage()    {}
+func (*ModifyRuntimePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{19}
+}
+func (m *ModifyRuntimePriceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyRuntimePriceRequest.Unmarshal(m, b)
+}
+func (m *ModifyRuntimePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyRuntimePriceRequest.Marshal(b, m, deterministic)
+}
+func (dst *ModifyRuntimePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyRuntimePriceRequest.Merge(dst, src)
+}
+func (m *ModifyRuntimePriceRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyRuntimePriceRequest.Size(m)
+}
+func (m *ModifyRuntimePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyRuntimePriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyRuntimePriceRequest proto.InternalMessageInfo
+
+func (m *ModifyRuntimePriceRequest) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *ModifyRuntimePriceRequest) GetCurrency() *wrappers.StringValue {
+	if m != nil {
+		return m.Currency
+	}
+	return nil
+}
+
+func (m *ModifyRuntimePriceRequest) GetCpuHour() *wrappers.DoubleValue {
+	if m != nil {
+		return m.CpuHour
+	}
+	return nil
+}
+
+func (m *ModifyRuntimePriceRequest) GetMemoryGbHour() *wrappers.DoubleValue {
+	if m != nil {
+		return m.MemoryGbHour
+	}
+	return nil
+}
+
+func (m *ModifyRuntimePriceRequest) GetVolumeGbMonth() *wrappers.DoubleValue {
+	if m != nil {
+		return m.VolumeGbMonth
+	}
+	return nil
+}
+
+func (m *ModifyRuntimePriceRequest) GetGpuHour() *wrappers.DoubleValue {
+	if m != nil {
+		return m.GpuHour
+	}
+	return nil
+}
+
+func (m *ModifyRuntimePriceRequest) GetInstanceClassHour() map[string]float64 {
+	if m != nil {
+		return m.InstanceClassHour
+	}
+	return nil
+}
+
+type ModifyRuntimePriceResponse struct {
+	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyRuntimePriceResponse) Reset()         { *m = ModifyRuntimePriceResponse{} }
+func (m *ModifyRuntimePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyRuntimePriceResponse) ProtoMessage()    {}
+func (*ModifyRuntimePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{20}
+}
+func (m *ModifyRuntimePriceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyRuntimePriceResponse.Unmarshal(m, b)
+}
+func (m *ModifyRuntimePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyRuntimePriceResponse.Marshal(b, m, deterministic)
+}
+func (dst *ModifyRuntimePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyRuntimePriceResponse.Merge(dst, src)
+}
+func (m *ModifyRuntimePriceResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyRuntimePriceResponse.Size(m)
+}
+func (m *ModifyRuntimePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyRuntimePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyRuntimePriceResponse proto.InternalMessageInfo
+
+func (m *ModifyRuntimePriceResponse) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+type DescribeRuntimePricesRequest struct {
+	RuntimeId            []string `protobuf:"bytes,1,rep,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeRuntimePricesRequest) Reset()         { *m = DescribeRuntimePricesRequest{} }
+func (m *DescribeRuntimePricesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeRuntimePricesRequest) ProtoMessage()    {}
+func (*DescribeRuntimePricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{21}
+}
+func (m *DescribeRuntimePricesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeRuntimePricesRequest.Unmarshal(m, b)
+}
+func (m *DescribeRuntimePricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeRuntimePricesRequest.Marshal(b, m, deterministic)
+}
+func (dst *DescribeRuntimePricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeRuntimePricesRequest.Merge(dst, src)
+}
+func (m *DescribeRuntimePricesRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeRuntimePricesRequest.Size(m)
+}
+func (m *DescribeRuntimePricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeRuntimePricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeRuntimePricesRequest proto.InternalMessageInfo
+
+func (m *DescribeRuntimePricesRequest) GetRuntimeId() []string {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+type DescribeRuntimePricesResponse struct {
+	RuntimePriceSet      []*RuntimePrice `protobuf:"bytes,1,rep,name=runtime_price_set,json=runtimePriceSet,proto3" json:"runtime_price_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DescribeRuntimePricesResponse) Reset()         { *m = DescribeRuntimePricesResponse{} }
+func (m *DescribeRuntimePricesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeRuntimePricesResponse) ProtoMessage()    {}
+func (*DescribeRuntimePricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_runtime_9720fcdf33f17ff4, []int{22}
+}
+func (m *DescribeRuntimePricesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeRuntimePricesResponse.Unmarshal(m, b)
+}
+func (m *DescribeRuntimePricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeRuntimePricesResponse.Marshal(b, m, deterministic)
+}
+func (dst *DescribeRuntimePricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeRuntimePricesResponse.Merge(dst, src)
+}
+func (m *DescribeRuntimePricesResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeRuntimePricesResponse.Size(m)
+}
+func (m *DescribeRuntimePricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeRuntimePricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeRuntimePricesResponse proto.InternalMessageInfo
+
+func (m *DescribeRuntimePricesResponse) GetRuntimePriceSet() []*RuntimePrice {
+	if m != nil {
+		return m.RuntimePriceSet
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RuntimeLabel)(nil), "openpitrix.RuntimeLabel")
 	proto.RegisterType((*Runtime)(nil), "openpitrix.Runtime")
@@ -958,6 +1414,15 @@ func init() {
 	proto.RegisterType((*GetRuntimeStatisticsResponse)(nil), "openpitrix.GetRuntimeStatisticsResponse")
 	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetRuntimeStatisticsResponse.LastTwoWeekCreatedEntry")
 	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetRuntimeStatisticsResponse.TopTenProvidersEntry")
+	proto.RegisterType((*RotateEncryptionKeyRequest)(nil), "openpitrix.RotateEncryptionKeyRequest")
+	proto.RegisterType((*RotateEncryptionKeyResponse)(nil), "openpitrix.RotateEncryptionKeyResponse")
+	proto.RegisterType((*RuntimePrice)(nil), "openpitrix.RuntimePrice")
+	proto.RegisterMapType((map[string]float64)(nil), "openpitrix.RuntimePrice.InstanceClassHourEntry")
+	proto.RegisterType((*ModifyRuntimePriceRequest)(nil), "openpitrix.ModifyRuntimePriceRequest")
+	proto.RegisterMapType((map[string]float64)(nil), "openpitrix.ModifyRuntimePriceRequest.InstanceClassHourEntry")
+	proto.RegisterType((*ModifyRuntimePriceResponse)(nil), "openpitrix.ModifyRuntimePriceResponse")
+	proto.RegisterType((*DescribeRuntimePricesRequest)(nil), "openpitrix.DescribeRuntimePricesRequest")
+	proto.RegisterType((*DescribeRuntimePricesResponse)(nil), "openpitrix.DescribeRuntimePricesResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -979,6 +1444,9 @@ type RuntimeManagerClient interface {
 	DeleteRuntimes(ctx context.Context, in *DeleteRuntimesRequest, opts ...grpc.CallOption) (*DeleteRuntimesResponse, error)
 	DescribeRuntimeProviderZones(ctx context.Context, in *DescribeRuntimeProviderZonesRequest, opts ...grpc.CallOption) (*DescribeRuntimeProviderZonesResponse, error)
 	GetRuntimeStatistics(ctx context.Context, in *GetRuntimeStatisticsRequest, opts ...grpc.CallOption) (*GetRuntimeStatisticsResponse, error)
+	RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error)
+	ModifyRuntimePrice(ctx context.Context, in *ModifyRuntimePriceRequest, opts ...grpc.CallOption) (*ModifyRuntimePriceResponse, error)
+	DescribeRuntimePrices(ctx context.Context, in *DescribeRuntimePricesRequest, opts ...grpc.CallOption) (*DescribeRuntimePricesResponse, error)
 }
 
 type runtimeManagerClient struct {
@@ -1052,6 +1520,33 @@ func (c *runtimeManagerClient) GetRuntimeStatistics(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *runtimeManagerClient) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error) {
+	out := new(RotateEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.RuntimeManager/RotateEncryptionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeManagerClient) ModifyRuntimePrice(ctx context.Context, in *ModifyRuntimePriceRequest, opts ...grpc.CallOption) (*ModifyRuntimePriceResponse, error) {
+	out := new(ModifyRuntimePriceResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.RuntimeManager/ModifyRuntimePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeManagerClient) DescribeRuntimePrices(ctx context.Context, in *DescribeRuntimePricesRequest, opts ...grpc.CallOption) (*DescribeRuntimePricesResponse, error) {
+	out := new(DescribeRuntimePricesResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.RuntimeManager/DescribeRuntimePrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RuntimeManagerServer is the server API for RuntimeManager service.
 type RuntimeManagerServer interface {
 	CreateRuntime(context.Context, *CreateRuntimeRequest) (*CreateRuntimeResponse, error)
@@ -1061,6 +1556,9 @@ type RuntimeManagerServer interface {
 	DeleteRuntimes(context.Context, *DeleteRuntimesRequest) (*DeleteRuntimesResponse, error)
 	DescribeRuntimeProviderZones(context.Context, *DescribeRuntimeProviderZonesRequest) (*DescribeRuntimeProviderZonesResponse, error)
 	GetRuntimeStatistics(context.Context, *GetRuntimeStatisticsRequest) (*GetRuntimeStatisticsResponse, error)
+	RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error)
+	ModifyRuntimePrice(context.Context, *ModifyRuntimePriceRequest) (*ModifyRuntimePriceResponse, error)
+	DescribeRuntimePrices(context.Context, *DescribeRuntimePricesRequest) (*DescribeRuntimePricesResponse, error)
 }
 
 func RegisterRuntimeManagerServer(s *grpc.Server, srv RuntimeManagerServer) {
@@ -1193,6 +1691,60 @@ func _RuntimeManager_GetRuntimeStatistics_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeManager_RotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeManagerServer).RotateEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.RuntimeManager/RotateEncryptionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeManagerServer).RotateEncryptionKey(ctx, req.(*RotateEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeManager_ModifyRuntimePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyRuntimePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeManagerServer).ModifyRuntimePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.RuntimeManager/ModifyRuntimePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeManagerServer).ModifyRuntimePrice(ctx, req.(*ModifyRuntimePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeManager_DescribeRuntimePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRuntimePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeManagerServer).DescribeRuntimePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.RuntimeManager/DescribeRuntimePrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeManagerServer).DescribeRuntimePrices(ctx, req.(*DescribeRuntimePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RuntimeManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.RuntimeManager",
 	HandlerType: (*RuntimeManagerServer)(nil),
@@ -1225,90 +1777,138 @@ var _RuntimeManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRuntimeStatistics",
 			Handler:    _RuntimeManager_GetRuntimeStatistics_Handler,
 		},
+		{
+			MethodName: "RotateEncryptionKey",
+			Handler:    _RuntimeManager_RotateEncryptionKey_Handler,
+		},
+		{
+			MethodName: "ModifyRuntimePrice",
+			Handler:    _RuntimeManager_ModifyRuntimePrice_Handler,
+		},
+		{
+			MethodName: "DescribeRuntimePrices",
+			Handler:    _RuntimeManager_DescribeRuntimePrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "runtime.proto",
 }
 
-func init() { proto.RegisterFile("runtime.proto", fileDescriptor_runtime_062bb0b898dbafe2) }
-
-var fileDescriptor_runtime_062bb0b898dbafe2 = []byte{
-	// 1232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xd7, 0xda, 0xce, 0xbf, 0xe7, 0xd8, 0x4d, 0x06, 0x27, 0xd9, 0x9a, 0xb4, 0x71, 0x36, 0x45,
-	0x84, 0x40, 0xec, 0x60, 0x2a, 0x28, 0xa9, 0x5a, 0x91, 0x26, 0xa5, 0xaa, 0xda, 0x4a, 0xc8, 0x09,
-	0x54, 0xea, 0xc5, 0xda, 0x78, 0x27, 0x66, 0x95, 0xcd, 0xce, 0x76, 0x67, 0x1c, 0x13, 0x4e, 0x08,
-	0x71, 0x40, 0x70, 0x0b, 0x1f, 0x01, 0xf1, 0x15, 0xb8, 0x73, 0xe5, 0x8a, 0xf8, 0x06, 0xdc, 0xf8,
-	0x04, 0x88, 0x03, 0x68, 0xfe, 0xd9, 0xde, 0xf5, 0xd6, 0x59, 0xa7, 0x5c, 0xe8, 0xc9, 0xde, 0x99,
-	0xdf, 0x9b, 0xf7, 0xde, 0xef, 0xbd, 0xf9, 0xbd, 0x5d, 0x28, 0x84, 0x1d, 0x9f, 0xb9, 0x27, 0xb8,
-	0x1a, 0x84, 0x84, 0x11, 0x04, 0x24, 0xc0, 0x7e, 0xe0, 0xb2, 0xd0, 0xfd, 0xa2, 0x7c, 0xbd, 0x4d,
-	0x48, 0xdb, 0xc3, 0x35, 0xb1, 0x73, 0xd8, 0x39, 0xaa, 0x75, 0x43, 0x3b, 0x08, 0x70, 0x48, 0x25,
-	0xb6, 0xbc, 0x12, 0xdf, 0xe7, 0xe7, 0x50, 0x66, 0x9f, 0x04, 0x0a, 0xb0, 0xac, 0x00, 0x76, 0xe0,
-	0xd6, 0x6c, 0xdf, 0x27, 0xcc, 0x66, 0x2e, 0xf1, 0xb5, 0xf9, 0x3b, 0xe2, 0xa7, 0xb5, 0xd9, 0xc6,
-	0xfe, 0x26, 0xed, 0xda, 0xed, 0x36, 0x0e, 0x6b, 0x24, 0x10, 0x88, 0x61, 0xb4, 0xf5, 0x6b, 0x06,
-	0x66, 0x1b, 0x32, 0xd4, 0xc7, 0xf6, 0x21, 0xf6, 0xd0, 0xc7, 0x30, 0xa7, 0x42, 0x6f, 0x7a, 0x7c,
-	0xa1, 0xe9, 0x3a, 0xa6, 0x51, 0x31, 0xd6, 0xf3, 0xf5, 0xe5, 0xaa, 0xf4, 0x5b, 0xd5, 0x81, 0x55,
-	0xf7, 0x59, 0xe8, 0xfa, 0xed, 0xcf, 0x6c, 0xaf, 0x83, 0x1b, 0xc5, 0x70, 0xe0, 0x94, 0x87, 0x0e,
-	0xba, 0x0d, 0xa0, 0xcf, 0x71, 0x1d, 0x33, 0x93, 0xe2, 0x84, 0x19, 0x85, 0x7f, 0xe8, 0xa0, 0x0f,
-	0x61, 0x46, 0x3a, 0x3f, 0xc6, 0x67, 0x66, 0x36, 0x85, 0xed, 0xb4, 0x80, 0x3f, 0xc2, 0x67, 0xe8,
-	0x0e, 0xe4, 0xa5, 0xe9, 0x29, 0xdf, 0x30, 0x73, 0x29, 0x8c, 0x41, 0x18, 0x88, 0xff, 0xe8, 0x36,
-	0xe4, 0x5b, 0x21, 0xb6, 0x19, 0x6e, 0xf2, 0x50, 0xcc, 0x09, 0x61, 0x5e, 0x1e, 0x32, 0x3f, 0xd0,
-	0x25, 0x69, 0x80, 0x84, 0xf3, 0x05, 0xeb, 0xaf, 0x1c, 0x4c, 0x29, 0x32, 0x63, 0xf9, 0x1b, 0xe3,
-	0xe5, 0xbf, 0x05, 0x39, 0xdf, 0x3e, 0xc1, 0xa9, 0x68, 0x13, 0x48, 0x74, 0x17, 0xf2, 0x0e, 0xa6,
-	0xad, 0xd0, 0x15, 0x95, 0x4e, 0xc5, 0xd9, 0xa0, 0x01, 0xba, 0x05, 0xd3, 0x41, 0x48, 0x4e, 0x5d,
-	0x07, 0x87, 0xa9, 0x38, 0xeb, 0xa1, 0x39, 0xe1, 0x3a, 0xd1, 0x4e, 0xe8, 0x29, 0xc6, 0x2e, 0x20,
-	0x5c, 0x19, 0x7c, 0x1a, 0x7a, 0x3c, 0xd5, 0x2f, 0x89, 0x8f, 0xcd, 0xc9, 0x34, 0xa9, 0x72, 0x24,
-	0xda, 0x82, 0x49, 0x51, 0x30, 0x6a, 0x4e, 0x55, 0xb2, 0xeb, 0xf9, 0xba, 0x59, 0xed, 0x5f, 0xae,
-	0xea, 0x60, 0x2f, 0x37, 0x14, 0x0e, 0xd5, 0x61, 0x82, 0x74, 0x7d, 0x1c, 0x9a, 0xd3, 0x29, 0x9c,
-	0x48, 0x28, 0xba, 0x09, 0x93, 0x94, 0xd9, 0xac, 0x43, 0xcd, 0x99, 0x14, 0x46, 0x0a, 0x1b, 0x6f,
-	0x1f, 0x18, 0xa7, 0x7d, 0xb8, 0xb1, 0x3c, 0x46, 0x1a, 0xe7, 0x2f, 0x36, 0x96, 0x70, 0xd1, 0x7b,
-	0xdf, 0x1b, 0x50, 0x50, 0xc9, 0xef, 0x61, 0x66, 0xbb, 0x1e, 0xda, 0x84, 0x29, 0xc5, 0xb3, 0x6a,
-	0xbf, 0xd7, 0x12, 0x88, 0x6a, 0x68, 0x0c, 0x7a, 0x04, 0x48, 0xd7, 0xb1, 0x15, 0x62, 0x07, 0xfb,
-	0xcc, 0xb5, 0xbd, 0x54, 0x1d, 0x38, 0xaf, 0xec, 0x76, 0x7b, 0x66, 0xd6, 0xcf, 0x59, 0x28, 0xed,
-	0x8a, 0xcc, 0xb4, 0x1f, 0xfc, 0xbc, 0x83, 0x29, 0xeb, 0x75, 0xb6, 0x71, 0xd9, 0xce, 0xce, 0xbc,
-	0xc2, 0x9d, 0x9d, 0x5c, 0x82, 0xa9, 0x4b, 0x95, 0x80, 0x37, 0xb0, 0xba, 0x26, 0x69, 0xba, 0x5e,
-	0x61, 0xad, 0x03, 0x58, 0x88, 0xd5, 0x8d, 0x06, 0xc4, 0xa7, 0x2f, 0xa7, 0x67, 0xd6, 0x8f, 0x19,
-	0x58, 0xda, 0x13, 0x35, 0x39, 0xd4, 0x07, 0x53, 0xdd, 0x11, 0xd7, 0x62, 0x07, 0x67, 0xd7, 0x67,
-	0x06, 0xa5, 0x70, 0xb1, 0x77, 0x0f, 0x33, 0x62, 0x4b, 0xdf, 0xb4, 0xf2, 0x40, 0x59, 0xb3, 0x62,
-	0x27, 0x52, 0x38, 0x8a, 0xed, 0xb0, 0xf5, 0x79, 0xb3, 0x4b, 0x42, 0x27, 0xdd, 0x0c, 0x90, 0x06,
-	0x4f, 0x49, 0xe8, 0x70, 0xb9, 0x10, 0x6c, 0xa4, 0xaa, 0xb8, 0x84, 0xa2, 0x92, 0x96, 0x98, 0x49,
-	0x11, 0x8b, 0x12, 0x91, 0x12, 0x4c, 0x78, 0xee, 0x89, 0xcb, 0x44, 0x0d, 0x0b, 0x0d, 0xf9, 0xc0,
-	0x53, 0x22, 0x47, 0x47, 0x14, 0x33, 0x51, 0x99, 0x42, 0x43, 0x3d, 0x59, 0xcf, 0xc1, 0x1c, 0x26,
-	0x49, 0xd1, 0xbf, 0x02, 0x79, 0x46, 0x98, 0xed, 0x35, 0x5b, 0xa4, 0xe3, 0x33, 0xc1, 0x7f, 0xa1,
-	0x01, 0x62, 0x69, 0x97, 0xaf, 0xa0, 0x9b, 0xfd, 0x66, 0xe5, 0x27, 0x67, 0x84, 0x34, 0x26, 0xde,
-	0x78, 0x4d, 0xf7, 0x3e, 0x66, 0xd6, 0x77, 0x06, 0x5c, 0x8f, 0xf9, 0x94, 0xea, 0x31, 0x86, 0xe7,
-	0x07, 0xfd, 0xae, 0x75, 0x84, 0xed, 0x40, 0x00, 0x57, 0x13, 0x02, 0x90, 0x0e, 0x1a, 0xfa, 0x35,
-	0x43, 0x3e, 0xf2, 0x60, 0xfe, 0x36, 0xa0, 0xf4, 0x84, 0x38, 0xee, 0xd1, 0x59, 0x4c, 0x34, 0xfe,
-	0x67, 0xb3, 0xb4, 0x7f, 0xf3, 0x72, 0xe3, 0xdd, 0xbc, 0x58, 0xf2, 0xff, 0xc5, 0xcd, 0x7b, 0x1f,
-	0x16, 0xf6, 0xb0, 0x87, 0xd9, 0x98, 0xd7, 0xce, 0xfa, 0x00, 0x16, 0xe3, 0x76, 0x2a, 0x9c, 0x0b,
-	0x0c, 0xff, 0x34, 0x60, 0x2d, 0xd6, 0x51, 0x9f, 0xa8, 0x7b, 0xf9, 0x8c, 0xf8, 0x7d, 0xff, 0x83,
-	0xb2, 0x6c, 0xbc, 0x8c, 0x2c, 0x67, 0xc6, 0x94, 0xe5, 0x64, 0x91, 0xcd, 0x5e, 0x6e, 0xce, 0x31,
-	0xb8, 0x31, 0x3a, 0x59, 0x45, 0xda, 0xe5, 0xb3, 0x45, 0x6a, 0x8a, 0x48, 0xf5, 0x13, 0xff, 0xad,
-	0x6b, 0xf0, 0xfa, 0x03, 0xcc, 0x94, 0xc3, 0x7d, 0xfe, 0x42, 0x4f, 0x99, 0xdb, 0xd2, 0xd4, 0x5a,
-	0xbf, 0x67, 0x61, 0x39, 0x79, 0x5f, 0x45, 0x43, 0x61, 0xc1, 0xb3, 0x29, 0x6b, 0xb2, 0x2e, 0x69,
-	0x76, 0x31, 0x3e, 0x6e, 0xca, 0x97, 0x10, 0x59, 0xcd, 0x7c, 0xfd, 0xa3, 0xc1, 0x4b, 0x3b, 0xea,
-	0xa0, 0xea, 0x63, 0x9b, 0xb2, 0x83, 0x2e, 0x79, 0x8a, 0xf1, 0xb1, 0x9c, 0x1a, 0xce, 0x7d, 0x9f,
-	0x85, 0x67, 0x0d, 0xe4, 0x0d, 0x6d, 0x20, 0x17, 0xe6, 0x19, 0x09, 0x9a, 0x0c, 0xfb, 0x4d, 0x9d,
-	0x1c, 0x55, 0x2a, 0x71, 0x27, 0xb5, 0xc3, 0x03, 0x12, 0x1c, 0x60, 0x5f, 0x73, 0x4c, 0xa5, 0xb7,
-	0x2b, 0x2c, 0xba, 0x8a, 0xd6, 0x7a, 0x9f, 0x5f, 0x4a, 0xb4, 0xb2, 0x42, 0xb4, 0x66, 0x75, 0xfd,
-	0x84, 0x6c, 0xbd, 0x01, 0x45, 0x1d, 0x87, 0x42, 0xe5, 0x04, 0xaa, 0xa0, 0x57, 0x05, 0xac, 0x7c,
-	0x1f, 0x96, 0x5e, 0x90, 0x25, 0x9a, 0x83, 0x2c, 0xff, 0x3e, 0xe1, 0xf5, 0x9c, 0x69, 0xf0, 0xbf,
-	0x5c, 0xef, 0xe5, 0x67, 0x47, 0x46, 0xea, 0xbd, 0x78, 0xd8, 0xce, 0xdc, 0x32, 0xca, 0xf7, 0xa0,
-	0x94, 0x14, 0xfb, 0x38, 0x67, 0xd4, 0xff, 0x99, 0x82, 0xa2, 0xa2, 0xe6, 0x89, 0xed, 0xdb, 0x6d,
-	0x1c, 0xa2, 0xaf, 0x0c, 0x28, 0x44, 0xe6, 0x35, 0xaa, 0x0c, 0x72, 0x99, 0xf4, 0x0a, 0x56, 0x5e,
-	0x1d, 0x81, 0x90, 0x34, 0x5b, 0x1b, 0xe7, 0x3b, 0x73, 0xa8, 0x28, 0xbb, 0xa2, 0xa2, 0x58, 0xfb,
-	0xfa, 0xb7, 0x3f, 0x7e, 0xc8, 0xcc, 0x5b, 0xb3, 0xb5, 0xd3, 0x77, 0x6b, 0x6a, 0x89, 0x6e, 0x1b,
-	0x1b, 0xc8, 0xe5, 0x4a, 0x91, 0x34, 0x41, 0xd0, 0xda, 0xa0, 0xa3, 0x17, 0x8c, 0xff, 0xf2, 0xc6,
-	0x08, 0x50, 0x7c, 0x14, 0x7d, 0x6b, 0xc0, 0x5c, 0xfc, 0x9c, 0x74, 0x5e, 0x6e, 0x8c, 0x06, 0xa9,
-	0xb4, 0xdf, 0x3a, 0xdf, 0x41, 0x68, 0xce, 0x51, 0xdb, 0x91, 0xc4, 0x8b, 0x28, 0x92, 0xb8, 0x20,
-	0x3e, 0x22, 0xd7, 0x51, 0xe2, 0x93, 0xc6, 0x58, 0x94, 0xf8, 0x44, 0xad, 0x57, 0xc4, 0x9f, 0x88,
-	0xbd, 0x28, 0xf1, 0xf5, 0x21, 0xe2, 0xbf, 0x31, 0xa0, 0x18, 0xd5, 0x68, 0xb4, 0x1a, 0x4d, 0x33,
-	0x41, 0xf7, 0xcb, 0xd6, 0x28, 0x88, 0x8a, 0xe2, 0xed, 0xf3, 0x9d, 0x79, 0x74, 0xc5, 0x11, 0x9b,
-	0x3a, 0x0a, 0x2a, 0xc3, 0xd8, 0x18, 0x0a, 0xe3, 0x17, 0x03, 0x96, 0x47, 0x69, 0x20, 0xaa, 0x8d,
-	0xe0, 0x3e, 0x69, 0x34, 0x94, 0xb7, 0xd2, 0x1b, 0xa8, 0x80, 0xef, 0x9e, 0xef, 0xac, 0xa2, 0x95,
-	0x78, 0xe1, 0x2a, 0xfa, 0x26, 0x57, 0xb8, 0x6a, 0xca, 0x04, 0x4a, 0x08, 0x0d, 0x26, 0x50, 0x13,
-	0x3b, 0xe8, 0x27, 0x03, 0x4a, 0x49, 0xba, 0x83, 0xde, 0xbc, 0x58, 0x99, 0x64, 0xcc, 0xeb, 0x69,
-	0x25, 0xcc, 0xda, 0x3e, 0xdf, 0x31, 0xd1, 0x62, 0x1b, 0xb3, 0x5e, 0x98, 0xb4, 0x07, 0x12, 0x21,
-	0x5e, 0x45, 0x4b, 0x91, 0x10, 0xfb, 0xdb, 0xf7, 0x72, 0xcf, 0x32, 0xc1, 0xe1, 0xe1, 0xa4, 0x18,
-	0x19, 0xef, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x60, 0x29, 0xf5, 0x64, 0x12, 0x00, 0x00,
+func init() { proto.RegisterFile("runtime.proto", fileDescriptor_runtime_9720fcdf33f17ff4) }
+
+var fileDescriptor_runtime_9720fcdf33f17ff4 = []byte{
+	// 1805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x73, 0x1b, 0x49,
+	0x19, 0xaf, 0x91, 0xfc, 0xfc, 0x6c, 0xf9, 0xd1, 0xb1, 0x9d, 0xc9, 0x6c, 0x1e, 0xf2, 0x24, 0x9b,
+	0x38, 0x81, 0x48, 0x41, 0x59, 0xd8, 0x90, 0x25, 0x5b, 0x28, 0x76, 0x36, 0x98, 0x24, 0x55, 0x8b,
+	0xe2, 0x65, 0x8b, 0xbd, 0x4c, 0x8d, 0x66, 0xda, 0xf2, 0x94, 0x47, 0xd3, 0xb3, 0x3d, 0xad, 0x18,
+	0x71, 0xda, 0xa2, 0x38, 0x50, 0x70, 0x13, 0x47, 0x6e, 0x54, 0x71, 0xe2, 0xc0, 0x09, 0xee, 0x70,
+	0xe4, 0x4a, 0xf1, 0x1f, 0x70, 0xe3, 0x4f, 0xe0, 0x00, 0xd5, 0x8f, 0x91, 0x66, 0x46, 0x23, 0x79,
+	0xe4, 0xe4, 0x02, 0xb5, 0xa7, 0x78, 0xba, 0x7f, 0xdf, 0xd7, 0xbf, 0xef, 0xd9, 0xfd, 0x29, 0x50,
+	0xa1, 0xbd, 0x80, 0x79, 0x5d, 0x5c, 0x0b, 0x29, 0x61, 0x04, 0x01, 0x09, 0x71, 0x10, 0x7a, 0x8c,
+	0x7a, 0x3f, 0x35, 0xae, 0x77, 0x08, 0xe9, 0xf8, 0xb8, 0x2e, 0x76, 0xda, 0xbd, 0xe3, 0xfa, 0x19,
+	0xb5, 0xc3, 0x10, 0xd3, 0x48, 0x62, 0x8d, 0x1b, 0xd9, 0x7d, 0xae, 0x27, 0x62, 0x76, 0x37, 0x54,
+	0x80, 0xab, 0x0a, 0x60, 0x87, 0x5e, 0xdd, 0x0e, 0x02, 0xc2, 0x6c, 0xe6, 0x91, 0x20, 0x16, 0xff,
+	0xa6, 0xf8, 0xc7, 0xb9, 0xdf, 0xc1, 0xc1, 0xfd, 0xe8, 0xcc, 0xee, 0x74, 0x30, 0xad, 0x93, 0x50,
+	0x20, 0xc6, 0xd1, 0xe6, 0xdf, 0x4a, 0xb0, 0xda, 0x92, 0x54, 0x5f, 0xda, 0x6d, 0xec, 0xa3, 0x4f,
+	0x60, 0x43, 0x51, 0xb7, 0x7c, 0xbe, 0x60, 0x79, 0xae, 0xae, 0x55, 0xb5, 0xbd, 0x95, 0xc6, 0xd5,
+	0x9a, 0x3c, 0xb7, 0x16, 0x13, 0xab, 0xbd, 0x66, 0xd4, 0x0b, 0x3a, 0x3f, 0xb6, 0xfd, 0x1e, 0x6e,
+	0xad, 0xd1, 0x84, 0x96, 0x43, 0x17, 0x7d, 0x04, 0x10, 0xeb, 0xf1, 0x5c, 0xbd, 0x54, 0x40, 0xc3,
+	0xb2, 0xc2, 0x1f, 0xba, 0xe8, 0xbb, 0xb0, 0x2c, 0x0f, 0x3f, 0xc5, 0x7d, 0xbd, 0x5c, 0x40, 0x76,
+	0x49, 0xc0, 0x5f, 0xe0, 0x3e, 0x7a, 0x02, 0x2b, 0x52, 0xf4, 0x0d, 0xdf, 0xd0, 0xe7, 0x0a, 0x08,
+	0x83, 0x10, 0x10, 0x7f, 0xa3, 0x8f, 0x60, 0xc5, 0xa1, 0xd8, 0x66, 0xd8, 0xe2, 0x54, 0xf4, 0x79,
+	0x21, 0x6e, 0x8c, 0x89, 0x1f, 0xc5, 0x21, 0x69, 0x81, 0x84, 0xf3, 0x05, 0xf3, 0xab, 0x45, 0x58,
+	0x54, 0xce, 0xcc, 0xd8, 0xaf, 0xcd, 0x66, 0xff, 0x03, 0x98, 0x0b, 0xec, 0x2e, 0x2e, 0xe4, 0x36,
+	0x81, 0x44, 0x1f, 0xc3, 0x8a, 0x8b, 0x23, 0x87, 0x7a, 0x22, 0xd2, 0x85, 0x7c, 0x96, 0x14, 0x40,
+	0x8f, 0x60, 0x29, 0xa4, 0xe4, 0x8d, 0xe7, 0x62, 0x5a, 0xc8, 0x67, 0x43, 0x34, 0x77, 0x78, 0x6c,
+	0x68, 0x8f, 0xfa, 0xfa, 0x7c, 0x01, 0xe1, 0xd8, 0x33, 0x9f, 0x51, 0x9f, 0x9b, 0xfa, 0x33, 0x12,
+	0x60, 0x7d, 0xa1, 0x88, 0xa9, 0x1c, 0x89, 0x1e, 0xc0, 0x82, 0x08, 0x58, 0xa4, 0x2f, 0x56, 0xcb,
+	0x7b, 0x2b, 0x0d, 0xbd, 0x36, 0x2a, 0xae, 0x5a, 0x32, 0x97, 0x5b, 0x0a, 0x87, 0x1a, 0x30, 0x4f,
+	0xce, 0x02, 0x4c, 0xf5, 0xa5, 0x02, 0x87, 0x48, 0x28, 0xfa, 0x00, 0x16, 0x22, 0x66, 0xb3, 0x5e,
+	0xa4, 0x2f, 0x17, 0x10, 0x52, 0xd8, 0x6c, 0xfa, 0xc0, 0x2c, 0xe9, 0xc3, 0x85, 0xa5, 0x1a, 0x29,
+	0xbc, 0x72, 0xbe, 0xb0, 0x84, 0x0b, 0xe1, 0x26, 0x54, 0x4e, 0xb0, 0xed, 0xb3, 0x13, 0x4b, 0xd1,
+	0x5e, 0x2d, 0x40, 0x7b, 0x55, 0x8a, 0xbc, 0x8e, 0xc9, 0x83, 0x6f, 0x47, 0xcc, 0xc2, 0x94, 0x12,
+	0xaa, 0x57, 0x8a, 0xa4, 0x2c, 0xc7, 0x3f, 0xe3, 0x70, 0x9e, 0x06, 0x76, 0xe8, 0x59, 0xbe, 0xcd,
+	0x70, 0xe0, 0xf4, 0xf5, 0xb5, 0x09, 0xd2, 0x9f, 0x1d, 0x06, 0xec, 0x61, 0x43, 0xa5, 0x81, 0x1d,
+	0x7a, 0x2f, 0x25, 0x1e, 0x7d, 0x02, 0x9b, 0x8a, 0xbe, 0x73, 0x82, 0x9d, 0x53, 0xe9, 0x81, 0xf5,
+	0x73, 0x3d, 0xb0, 0x2e, 0x85, 0xf6, 0xb9, 0x8c, 0x28, 0xc1, 0x5f, 0x6b, 0x50, 0x51, 0x39, 0x70,
+	0x80, 0x99, 0xed, 0xf9, 0xe8, 0x3e, 0x2c, 0xaa, 0x74, 0x53, 0x55, 0x78, 0x29, 0x27, 0x5f, 0x5a,
+	0x31, 0x06, 0xbd, 0x00, 0x14, 0xa7, 0xb3, 0x43, 0xb1, 0x8b, 0x03, 0xe6, 0xd9, 0x7e, 0xa1, 0x42,
+	0xdc, 0x54, 0x72, 0xfb, 0x43, 0x31, 0xf3, 0xcf, 0x65, 0xd8, 0xda, 0x17, 0x01, 0x8e, 0xcf, 0xc1,
+	0x5f, 0xf6, 0x70, 0xc4, 0x86, 0x05, 0xae, 0x5d, 0xb4, 0xc0, 0x4b, 0xff, 0xc7, 0x05, 0x9e, 0x1f,
+	0x82, 0xc5, 0x0b, 0x85, 0x80, 0xd7, 0xb1, 0xea, 0x16, 0x45, 0x8a, 0x5f, 0x61, 0xcd, 0x23, 0xd8,
+	0xce, 0xc4, 0x2d, 0x0a, 0x49, 0x10, 0xbd, 0x5d, 0x5b, 0x37, 0xff, 0x53, 0x82, 0xcb, 0x07, 0x22,
+	0x26, 0xed, 0x58, 0x71, 0x14, 0x67, 0xc4, 0xb5, 0x8c, 0xe2, 0xf2, 0xde, 0x72, 0xf2, 0x46, 0xd8,
+	0x19, 0xb6, 0xa3, 0x92, 0xd8, 0x52, 0x5f, 0xc8, 0x48, 0x84, 0xb5, 0x2c, 0x76, 0x52, 0x81, 0x8b,
+	0xb0, 0x4d, 0x9d, 0x13, 0xeb, 0x8c, 0x50, 0xb7, 0xd8, 0x55, 0x28, 0x05, 0x3e, 0x27, 0xd4, 0xe5,
+	0x5d, 0x53, 0x78, 0xa3, 0x50, 0xc4, 0x25, 0x14, 0x6d, 0xc5, 0x9d, 0x76, 0x41, 0x70, 0x91, 0x1f,
+	0x7c, 0xd5, 0xf7, 0xba, 0x1e, 0x13, 0x31, 0xac, 0xb4, 0xe4, 0x07, 0x37, 0x89, 0x1c, 0x1f, 0x47,
+	0x98, 0x89, 0xc8, 0x54, 0x5a, 0xea, 0x0b, 0xdd, 0xcc, 0x76, 0xb2, 0x65, 0xa1, 0x2b, 0xdd, 0xab,
+	0xbe, 0x0d, 0x8b, 0x14, 0x87, 0x84, 0xfb, 0x0a, 0x8a, 0xc4, 0x95, 0x83, 0x0f, 0x5d, 0xf3, 0x4b,
+	0xd0, 0xc7, 0x03, 0xa0, 0x42, 0x7b, 0x03, 0x56, 0x18, 0x61, 0xb6, 0x6f, 0x39, 0xa4, 0x17, 0x30,
+	0x11, 0xdb, 0x4a, 0x0b, 0xc4, 0xd2, 0x3e, 0x5f, 0x41, 0x1f, 0x8c, 0x0a, 0x81, 0xb3, 0x2e, 0x55,
+	0xcb, 0x93, 0xba, 0x49, 0x1c, 0xca, 0xd7, 0x98, 0x99, 0xbf, 0xd2, 0xe0, 0x7a, 0xe6, 0x4c, 0xd9,
+	0x99, 0x66, 0x38, 0xf9, 0xf9, 0xa8, 0x22, 0x5c, 0x21, 0x9b, 0x20, 0x70, 0x25, 0x87, 0x80, 0x3c,
+	0xa0, 0xb5, 0x41, 0x93, 0x9f, 0x9c, 0xcc, 0xbf, 0x35, 0xd8, 0x7a, 0x45, 0x5c, 0xef, 0xb8, 0x9f,
+	0x69, 0x48, 0xff, 0x63, 0xcf, 0x95, 0x51, 0x55, 0xcf, 0xcd, 0x56, 0xd5, 0x19, 0xe3, 0xdf, 0x45,
+	0x55, 0x7f, 0x07, 0xb6, 0x0f, 0xb0, 0x8f, 0xd9, 0x8c, 0x25, 0x6d, 0x7e, 0x08, 0x3b, 0x59, 0x39,
+	0x45, 0xe7, 0x1c, 0xc1, 0x7f, 0x69, 0x70, 0x33, 0x93, 0x51, 0x9f, 0xaa, 0x9a, 0xff, 0x82, 0x04,
+	0xa3, 0xf3, 0x93, 0x2d, 0x5f, 0x7b, 0x9b, 0x96, 0x5f, 0x9a, 0xb1, 0xe5, 0xe7, 0x37, 0xf0, 0xf2,
+	0xc5, 0xee, 0x50, 0x06, 0xb7, 0xa6, 0x1b, 0xab, 0x9c, 0x76, 0x71, 0x6b, 0x91, 0xba, 0xa1, 0x64,
+	0x67, 0x15, 0x7f, 0x9b, 0xd7, 0xe0, 0xbd, 0xe7, 0x98, 0xa9, 0x03, 0x79, 0xcf, 0xf1, 0x22, 0xe6,
+	0x39, 0xb1, 0x6b, 0xcd, 0x7f, 0x94, 0xe1, 0x6a, 0xfe, 0xbe, 0x62, 0x13, 0xc1, 0xb6, 0x78, 0x4b,
+	0xb1, 0x33, 0x62, 0x9d, 0x61, 0x7c, 0x6a, 0xc9, 0x77, 0x9e, 0x8c, 0xe6, 0x4a, 0xe3, 0xfb, 0xc9,
+	0xa2, 0x9d, 0xa6, 0xa8, 0xf6, 0xd2, 0x8e, 0xd8, 0xd1, 0x19, 0xf9, 0x1c, 0xe3, 0x53, 0x79, 0x23,
+	0xb9, 0xcf, 0x02, 0x46, 0xfb, 0x2d, 0xe4, 0x8f, 0x6d, 0x20, 0x0f, 0x36, 0x19, 0x09, 0x2d, 0x86,
+	0x03, 0x2b, 0x36, 0x2e, 0x52, 0x5d, 0xe2, 0x49, 0xe1, 0x03, 0x8f, 0x48, 0x78, 0x84, 0x83, 0xd8,
+	0xc7, 0x91, 0x3c, 0x6d, 0x9d, 0xa5, 0x57, 0x79, 0x93, 0x1e, 0x86, 0x58, 0x34, 0xad, 0xb2, 0x68,
+	0x5a, 0xab, 0x71, 0xfc, 0xf8, 0x1a, 0x7a, 0x1f, 0xd6, 0x62, 0x1e, 0x0a, 0x35, 0x27, 0x50, 0x95,
+	0x78, 0x55, 0xc0, 0x8c, 0x67, 0x70, 0x79, 0x82, 0x95, 0x68, 0x03, 0xca, 0x7c, 0x04, 0xe4, 0xf1,
+	0x5c, 0x6e, 0xf1, 0x3f, 0xf9, 0x5d, 0x22, 0x27, 0xbb, 0x92, 0xbc, 0x4b, 0xc4, 0xc7, 0xe3, 0xd2,
+	0x23, 0xcd, 0x78, 0x0a, 0x5b, 0x79, 0xdc, 0x67, 0xd1, 0x61, 0xfe, 0x08, 0x8c, 0x16, 0x61, 0x36,
+	0xc3, 0xcf, 0x02, 0x87, 0xf6, 0x45, 0xaf, 0x79, 0x81, 0xfb, 0x71, 0x41, 0x3d, 0x84, 0x85, 0x53,
+	0xdc, 0x2f, 0xda, 0x22, 0xe6, 0x4f, 0x71, 0xff, 0xd0, 0x35, 0xff, 0xa0, 0xc1, 0x7b, 0xb9, 0x3a,
+	0x55, 0xa6, 0x5c, 0x44, 0x29, 0x7a, 0x04, 0xfa, 0x78, 0x85, 0x29, 0x1f, 0x4b, 0xa3, 0x76, 0xc6,
+	0x2a, 0x49, 0xc6, 0x84, 0xf7, 0x16, 0x7e, 0x71, 0x26, 0xa3, 0xb6, 0xcc, 0x57, 0xc4, 0xb6, 0xf9,
+	0xbb, 0xf9, 0xe1, 0xef, 0x01, 0x9f, 0x52, 0xcf, 0x79, 0xcb, 0x39, 0xf6, 0x11, 0x2c, 0x39, 0x3d,
+	0x4a, 0xc5, 0x44, 0x50, 0xa4, 0x89, 0x0c, 0xd1, 0xe8, 0x43, 0x58, 0x72, 0xc2, 0x9e, 0x75, 0x42,
+	0x7a, 0x74, 0x62, 0xe3, 0x38, 0x20, 0xbd, 0xb6, 0x8f, 0xa5, 0xe4, 0xa2, 0x13, 0xf6, 0x7e, 0x40,
+	0x7a, 0x14, 0x3d, 0x85, 0xb5, 0x2e, 0xee, 0x12, 0xda, 0xb7, 0x3a, 0x6d, 0x29, 0x3e, 0x57, 0x40,
+	0x7c, 0x55, 0xca, 0x3c, 0x6f, 0x0b, 0x1d, 0x07, 0xb0, 0xfe, 0x86, 0xf8, 0xbd, 0x2e, 0xe6, 0x3a,
+	0xba, 0x24, 0x60, 0x27, 0xfa, 0x7c, 0x01, 0x25, 0x15, 0x29, 0xf4, 0xbc, 0xfd, 0x8a, 0x8b, 0x70,
+	0x13, 0x3a, 0xb1, 0x09, 0x0b, 0x45, 0x4c, 0xe8, 0x28, 0x13, 0x2c, 0xb8, 0xe4, 0x05, 0x11, 0xb3,
+	0x03, 0x07, 0x5b, 0x8e, 0x6f, 0x47, 0x91, 0xd4, 0x21, 0xa7, 0xdd, 0x7a, 0xce, 0x75, 0x2f, 0x22,
+	0x55, 0x3b, 0x54, 0x32, 0xfb, 0x5c, 0x84, 0x2b, 0x92, 0xa5, 0xbb, 0xe9, 0x65, 0xd7, 0xb3, 0x53,
+	0xea, 0xd2, 0xdb, 0x4c, 0xa9, 0xcb, 0xb3, 0x4c, 0xa9, 0xc6, 0x01, 0xec, 0xe4, 0xd3, 0x3c, 0xaf,
+	0x4a, 0xb5, 0x64, 0x95, 0xfe, 0x75, 0x0e, 0xae, 0xa4, 0x2e, 0x72, 0xe1, 0x80, 0x77, 0xf2, 0x94,
+	0xf9, 0x3a, 0x63, 0x2f, 0x92, 0xb1, 0xfe, 0xb4, 0x8c, 0xfd, 0x5e, 0x32, 0x63, 0x27, 0x86, 0xad,
+	0x78, 0xfa, 0xbe, 0xa3, 0x24, 0xfa, 0x09, 0x18, 0x79, 0x64, 0xde, 0xc5, 0x8b, 0xf0, 0x09, 0x5c,
+	0x1d, 0x7b, 0xb2, 0x78, 0x4e, 0xe1, 0x87, 0x21, 0x86, 0x6b, 0x13, 0xc4, 0x15, 0xb9, 0x03, 0x88,
+	0xdf, 0x49, 0x56, 0xc8, 0x77, 0xc4, 0x34, 0xa0, 0x4d, 0xfc, 0x31, 0x4c, 0x5a, 0xb6, 0x4e, 0x13,
+	0x5f, 0xaf, 0x31, 0x6b, 0xfc, 0x76, 0x15, 0xd6, 0x14, 0xe2, 0x95, 0x1d, 0xd8, 0x1d, 0x4c, 0xd1,
+	0x57, 0x1a, 0x54, 0x52, 0x73, 0x2f, 0xaa, 0x26, 0xf5, 0xe5, 0xfd, 0x94, 0x61, 0xec, 0x4e, 0x41,
+	0x48, 0xbe, 0xe6, 0xbd, 0x41, 0x73, 0x03, 0xad, 0xc9, 0x1e, 0x52, 0x55, 0x34, 0x7e, 0xfe, 0xf7,
+	0x7f, 0xfe, 0xa6, 0xb4, 0x69, 0xae, 0xd6, 0xdf, 0x7c, 0xab, 0xae, 0x96, 0xa2, 0xc7, 0xda, 0x3d,
+	0xe4, 0xc1, 0x4e, 0xc6, 0x78, 0x35, 0x2d, 0xa1, 0x9b, 0xc9, 0x83, 0x26, 0x8c, 0xd1, 0xc6, 0xbd,
+	0x29, 0xa0, 0xec, 0xd8, 0xf5, 0x4b, 0x0d, 0x36, 0xb2, 0x7a, 0x8a, 0x9d, 0x72, 0x6b, 0x3a, 0x48,
+	0x99, 0x7d, 0x77, 0xd0, 0x44, 0x68, 0xc3, 0x55, 0xdb, 0x29, 0xc3, 0xd7, 0x50, 0xca, 0x70, 0xe1,
+	0xf8, 0x54, 0x36, 0xa6, 0x1d, 0x9f, 0x37, 0xb2, 0x19, 0xbb, 0x53, 0x10, 0x29, 0xc7, 0x77, 0xc5,
+	0x5e, 0xda, 0xf1, 0x8d, 0x31, 0xc7, 0xff, 0x42, 0x83, 0xb5, 0xf4, 0x3c, 0x82, 0x76, 0xd3, 0x66,
+	0xe6, 0xcc, 0x38, 0x86, 0x39, 0x0d, 0xa2, 0x58, 0x7c, 0x63, 0xd0, 0xdc, 0x44, 0xeb, 0xae, 0xd8,
+	0x8c, 0x59, 0x44, 0x92, 0xc6, 0xbd, 0x31, 0x1a, 0x7f, 0xd1, 0x72, 0x8a, 0x27, 0xf1, 0xde, 0x47,
+	0xf5, 0x29, 0xbe, 0xcf, 0x1b, 0x83, 0x8c, 0x07, 0xc5, 0x05, 0x14, 0xe1, 0x8f, 0x07, 0xcd, 0x5d,
+	0x74, 0x23, 0x1b, 0xb8, 0x6a, 0xfc, 0x6a, 0xad, 0xf2, 0x09, 0x41, 0x1a, 0xb0, 0x85, 0x50, 0xd2,
+	0x80, 0xba, 0xd8, 0x41, 0xbf, 0xd7, 0x60, 0x2b, 0xef, 0x8d, 0x8d, 0xee, 0x9c, 0xff, 0x0a, 0x97,
+	0x9c, 0xf7, 0x8a, 0x3e, 0xd7, 0xcd, 0xc7, 0x83, 0xa6, 0x8e, 0x76, 0x3a, 0x98, 0x0d, 0x69, 0x46,
+	0x43, 0x90, 0xa0, 0x78, 0x05, 0x5d, 0x4e, 0x51, 0x1c, 0x6d, 0xa3, 0x3f, 0x69, 0x70, 0x29, 0xe7,
+	0x69, 0x8a, 0x6e, 0xa7, 0x9a, 0xc8, 0xc4, 0xf7, 0xb0, 0x71, 0xe7, 0x5c, 0x9c, 0x22, 0xf9, 0x6a,
+	0xd0, 0xbc, 0x8d, 0x6e, 0x51, 0x81, 0xa8, 0xe2, 0x21, 0xa4, 0x7a, 0x8a, 0xfb, 0x55, 0x72, 0x5c,
+	0x1d, 0x3d, 0x61, 0x25, 0xe5, 0xeb, 0xe6, 0x15, 0x4e, 0x79, 0x04, 0xe4, 0xff, 0x0f, 0x54, 0x97,
+	0xe2, 0x3c, 0x47, 0xfe, 0xa8, 0x01, 0x1a, 0xef, 0xdd, 0xe8, 0xfd, 0x42, 0x17, 0x8d, 0x71, 0xfb,
+	0x3c, 0x98, 0x22, 0xfd, 0xc3, 0x41, 0xf3, 0x2e, 0xba, 0xa3, 0xba, 0x16, 0xa1, 0x55, 0x55, 0x46,
+	0xa2, 0xe3, 0x56, 0xa3, 0x13, 0x8c, 0x19, 0xe7, 0x9e, 0xac, 0x2a, 0xbd, 0x71, 0x29, 0xe5, 0x6a,
+	0x01, 0x8d, 0x14, 0xe3, 0xed, 0xdc, 0x9e, 0x8e, 0xf6, 0xa6, 0x66, 0x67, 0xe2, 0xd6, 0x30, 0xee,
+	0x16, 0x40, 0x2a, 0xea, 0xcd, 0x41, 0xf3, 0x26, 0xda, 0x1d, 0x26, 0x70, 0x82, 0x72, 0x94, 0xe0,
+	0x2c, 0x9d, 0xbd, 0x8d, 0xf2, 0x48, 0x3f, 0x9d, 0xfb, 0xa2, 0x14, 0xb6, 0xdb, 0x0b, 0xe2, 0xaa,
+	0x7b, 0xf8, 0xdf, 0x01, 0x00, 0xa7, 0x12, 0x37, 0x98, 0xcf, 0x1c, 0x00, 0x00,
 }
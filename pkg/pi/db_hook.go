@@ -5,33 +5,12 @@
 package pi
 
 import (
-	"github.com/gocraft/dbr"
-
 	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/topic"
 	"openpitrix.io/openpitrix/pkg/util/stringutil"
 )
 
-func getResourceIds(key string, whereCond []dbr.Builder) []string {
-	var rids []string
-	for _, where := range whereCond {
-		w, ok := where.(*db.EqCondition)
-		if !ok {
-			continue
-		}
-		if w.Column == key {
-			switch rid := w.Value.(type) {
-			case string:
-				rids = append(rids, rid)
-			case []string:
-				rids = rid
-			}
-		}
-	}
-	return rids
-}
-
 func GetUpdateHook(p *Pi) db.UpdateHook {
 	return func(query *db.UpdateQuery) {
 		table := query.Table
@@ -41,7 +20,7 @@ func GetUpdateHook(p *Pi) db.UpdateHook {
 			return
 		}
 		key, columns := columns[0], columns[1:]
-		rids := getResourceIds(key, whereCond)
+		rids := db.GetEqValues(key, whereCond)
 		if len(rids) == 0 {
 			return
 		}
@@ -74,7 +53,7 @@ func GetDeleteHook(p *Pi) db.DeleteHook {
 			return
 		}
 		key, columns := columns[0], columns[1:]
-		rids := getResourceIds(key, whereCond)
+		rids := db.GetEqValues(key, whereCond)
 		if len(rids) == 0 {
 			return
 		}
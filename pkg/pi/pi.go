@@ -12,6 +12,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/etcd"
 	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/secret"
 )
 
 type globalCfgWatcher func(*config.GlobalConfig)
@@ -30,6 +31,7 @@ var globalMutex sync.RWMutex
 
 func NewPi(cfg *config.Config) *Pi {
 	p := &Pi{cfg: cfg}
+	p.initSecret()
 	p.openDatabase()
 	p.openEtcd()
 	p.watchGlobalCfg()
@@ -55,6 +57,10 @@ func Global() *Pi {
 	return global
 }
 
+func (p *Pi) Config() *config.Config {
+	return p.cfg
+}
+
 func (p *Pi) GlobalConfig() (globalCfg *config.GlobalConfig) {
 	mutex.RLock()
 	globalCfg = p.globalCfg
@@ -100,6 +106,15 @@ func (p *Pi) watchGlobalCfg() *Pi {
 	return p
 }
 
+func (p *Pi) initSecret() *Pi {
+	err := secret.Init(p.cfg.Encryption)
+	if err != nil {
+		logger.Critical("failed to load master keys")
+		panic(err)
+	}
+	return p
+}
+
 func (p *Pi) openDatabase() *Pi {
 	if p.cfg.Mysql.Disable {
 		return p
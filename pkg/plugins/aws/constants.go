@@ -8,4 +8,14 @@ const (
 	DefaultVolumeClass = 1
 	DefaultDevice      = "/dev/sdf"
 	DefaultZone        = "us-east-2"
+
+	DefaultVpcCidrBlock    = "10.0.0.0/16"
+	DefaultSubnetCidrBlock = "10.0.1.0/24"
+	DefaultAddressDomain   = "vpc"
+
+	AttributeVpcMaxElasticIps = "vpc-max-elastic-ips"
+
+	// TargetGroupArnPrefix is the prefix of the arn of the elbv2 target groups,
+	// other loadbalancer listeners are taken as the names of the classic elbs
+	TargetGroupArnPrefix = "arn:aws:elasticloadbalancing:"
 )
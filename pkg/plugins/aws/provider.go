@@ -17,6 +17,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/plugins"
 	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
 	"openpitrix.io/openpitrix/pkg/util/stringutil"
 )
@@ -25,6 +26,12 @@ type Provider struct {
 	Logger *logger.Logger
 }
 
+func init() {
+	plugins.RegisterVmBased(constants.ProviderAWS, func(l *logger.Logger) plugins.ProviderInterface {
+		return NewProvider(l)
+	})
+}
+
 func NewProvider(l *logger.Logger) *Provider {
 	return &Provider{
 		Logger: l,
@@ -106,8 +113,6 @@ func (p *Provider) SplitJobIntoTasks(job *models.Job) (*models.TaskLayer, error)
 
 	switch job.JobAction {
 	case constants.ActionCreateCluster:
-		// TODO: vpc, eip, subnet
-
 		return frameInterface.CreateClusterLayer(), nil
 	case constants.ActionUpgradeCluster:
 		// not supported yet
@@ -173,6 +178,18 @@ func (p *Provider) HandleSubtask(task *models.Task) error {
 		return handler.AttachVolumes(task)
 	case vmbased.ActionDeleteVolumes:
 		return handler.DeleteVolumes(task)
+	case vmbased.ActionCreateNetwork:
+		return handler.CreateNetwork(task)
+	case vmbased.ActionDeleteNetwork:
+		return handler.DeleteNetwork(task)
+	case vmbased.ActionAllocateEips:
+		return handler.AllocateEips(task)
+	case vmbased.ActionReleaseEips:
+		return handler.ReleaseEips(task)
+	case vmbased.ActionAddLoadbalancerBackends:
+		return handler.AddLoadbalancerBackends(task)
+	case vmbased.ActionDeleteLoadbalancerBackends:
+		return handler.DeleteLoadbalancerBackends(task)
 
 	case vmbased.ActionWaitFrontgateAvailable:
 		// do nothing
@@ -203,6 +220,18 @@ func (p *Provider) WaitSubtask(task *models.Task, timeout time.Duration, waitInt
 		return handler.WaitAttachVolumes(task)
 	case vmbased.ActionDeleteVolumes:
 		return handler.WaitDeleteVolumes(task)
+	case vmbased.ActionCreateNetwork:
+		return handler.WaitCreateNetwork(task)
+	case vmbased.ActionDeleteNetwork:
+		return handler.WaitDeleteNetwork(task)
+	case vmbased.ActionAllocateEips:
+		return handler.WaitAllocateEips(task)
+	case vmbased.ActionReleaseEips:
+		return handler.WaitReleaseEips(task)
+	case vmbased.ActionAddLoadbalancerBackends:
+		return handler.WaitAddLoadbalancerBackends(task)
+	case vmbased.ActionDeleteLoadbalancerBackends:
+		return handler.WaitDeleteLoadbalancerBackends(task)
 	case vmbased.ActionWaitFrontgateAvailable:
 		return handler.WaitFrontgateAvailable(task)
 	default:
@@ -221,6 +250,16 @@ func (p *Provider) CheckResource(ctx context.Context, clusterWrapper *models.Clu
 	return handler.CheckResourceQuotas(ctx, clusterWrapper)
 }
 
+func (p *Provider) CheckNetworkQuota(runtimeId string, vpcCount, eipCount int) error {
+	handler := GetProviderHandler(p.Logger)
+	return handler.CheckNetworkQuota(runtimeId, vpcCount, eipCount)
+}
+
+func (p *Provider) CheckLoadbalancerListeners(runtimeId string, listenerIds []string) error {
+	handler := GetProviderHandler(p.Logger)
+	return handler.CheckLoadbalancerListeners(runtimeId, listenerIds)
+}
+
 func (p *Provider) DescribeVpc(runtimeId, vpcId string) (*models.Vpc, error) {
 	handler := GetProviderHandler(p.Logger)
 	return handler.DescribeVpc(runtimeId, vpcId)
@@ -7,12 +7,16 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
 
 	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
 	"openpitrix.io/openpitrix/pkg/constants"
@@ -78,6 +82,16 @@ func (p *ProviderHandler) initInstanceService(runtimeId string) (*ec2.EC2, error
 	return ec2.New(awsSession), nil
 }
 
+func (p *ProviderHandler) initLoadbalancerServices(runtimeId string) (*elb.ELB, *elbv2.ELBV2, error) {
+	awsSession, err := p.initSession(runtimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api session failed: %+v", MyProvider, err)
+		return nil, nil, err
+	}
+
+	return elb.New(awsSession), elbv2.New(awsSession), nil
+}
+
 func (p *ProviderHandler) RunInstances(task *models.Task) error {
 	if task.Directive == "" {
 		p.Logger.Warn("Skip task without directive")
@@ -976,3 +990,572 @@ func (p *ProviderHandler) DescribeAvailabilityZoneBySubnetId(runtimeId, subnetId
 
 	return zone, nil
 }
+
+func (p *ProviderHandler) createNameTag(instanceService *ec2.EC2, resourceId, name string) error {
+	_, err := instanceService.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{aws.String(resourceId)},
+		Tags: []*ec2.Tag{{
+			Key:   aws.String("Name"),
+			Value: aws.String(name),
+		}},
+	})
+	if err != nil {
+		p.Logger.Error("Send CreateTags to %s failed: %+v", MyProvider, err)
+	}
+	return err
+}
+
+// CreateNetwork creates a vpc with a subnet, the vpc is routed to the internet
+// by an internet gateway. The created resources are deleted on failure, since
+// neither the directive of the failed task is saved nor the post processor
+// writing them to the cluster is run.
+func (p *ProviderHandler) CreateNetwork(task *models.Task) (err error) {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	network, err := models.NewNetwork(task.Directive)
+	if err != nil {
+		return err
+	}
+	instanceService, err := p.initInstanceService(network.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	var gatewayId *string
+	attached := false
+	defer func() {
+		if err == nil || network.VpcId == "" {
+			return
+		}
+		p.rollbackNetwork(task, instanceService, *network, gatewayId, attached)
+	}()
+
+	vpcOutput, err := instanceService.CreateVpc(&ec2.CreateVpcInput{
+		CidrBlock: aws.String(DefaultVpcCidrBlock),
+	})
+	if err != nil {
+		p.Logger.Error("Send CreateVpc to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	network.VpcId = aws.StringValue(vpcOutput.Vpc.VpcId)
+
+	err = p.createNameTag(instanceService, network.VpcId, network.Name)
+	if err != nil {
+		return err
+	}
+	err = instanceService.WaitUntilVpcAvailable(&ec2.DescribeVpcsInput{
+		VpcIds: []*string{aws.String(network.VpcId)},
+	})
+	if err != nil {
+		p.Logger.Error("Wait %s vpc [%s] available failed: %+v", MyProvider, network.VpcId, err)
+		return err
+	}
+
+	gatewayOutput, err := instanceService.CreateInternetGateway(&ec2.CreateInternetGatewayInput{})
+	if err != nil {
+		p.Logger.Error("Send CreateInternetGateway to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	gatewayId = gatewayOutput.InternetGateway.InternetGatewayId
+	_, err = instanceService.AttachInternetGateway(&ec2.AttachInternetGatewayInput{
+		InternetGatewayId: gatewayId,
+		VpcId:             aws.String(network.VpcId),
+	})
+	if err != nil {
+		p.Logger.Error("Send AttachInternetGateway to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	attached = true
+
+	routeTableOutput, err := instanceService.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("vpc-id"),
+			Values: []*string{aws.String(network.VpcId)},
+		}},
+	})
+	if err != nil {
+		p.Logger.Error("Send DescribeRouteTables to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	if len(routeTableOutput.RouteTables) == 0 {
+		return fmt.Errorf("route table of vpc [%s] not found", network.VpcId)
+	}
+	_, err = instanceService.CreateRoute(&ec2.CreateRouteInput{
+		RouteTableId:         routeTableOutput.RouteTables[0].RouteTableId,
+		DestinationCidrBlock: aws.String("0.0.0.0/0"),
+		GatewayId:            gatewayId,
+	})
+	if err != nil {
+		p.Logger.Error("Send CreateRoute to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	subnetInput := &ec2.CreateSubnetInput{
+		CidrBlock: aws.String(DefaultSubnetCidrBlock),
+		VpcId:     aws.String(network.VpcId),
+	}
+	if network.Zone != "" {
+		subnetInput.AvailabilityZone = aws.String(network.Zone)
+	}
+	subnetOutput, err := instanceService.CreateSubnet(subnetInput)
+	if err != nil {
+		p.Logger.Error("Send CreateSubnet to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	network.SubnetId = aws.StringValue(subnetOutput.Subnet.SubnetId)
+
+	err = p.createNameTag(instanceService, network.SubnetId, network.Name)
+	if err != nil {
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(network)
+
+	return nil
+}
+
+// rollbackNetwork deletes the resources created by the failed CreateNetwork,
+// the internet gateway not attached to the vpc is deleted alone.
+func (p *ProviderHandler) rollbackNetwork(task *models.Task, instanceService *ec2.EC2, network models.Network, gatewayId *string, attached bool) {
+	p.Logger.Warn("Roll back network [%s] created by task [%s]", network.VpcId, task.TaskId)
+	if gatewayId != nil && !attached {
+		_, err := instanceService.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+			InternetGatewayId: gatewayId,
+		})
+		if err != nil {
+			p.Logger.Error("Roll back internet gateway [%s] failed: %+v", aws.StringValue(gatewayId), err)
+		}
+	}
+	rollbackTask := *task
+	rollbackTask.Directive = jsonutil.ToString(network)
+	err := p.DeleteNetwork(&rollbackTask)
+	if err != nil {
+		p.Logger.Error("Roll back network [%s] failed: %+v", network.VpcId, err)
+	}
+}
+
+func (p *ProviderHandler) WaitCreateNetwork(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	network, err := models.NewNetwork(task.Directive)
+	if err != nil {
+		return err
+	}
+	instanceService, err := p.initInstanceService(network.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	return instanceService.WaitUntilSubnetAvailable(&ec2.DescribeSubnetsInput{
+		SubnetIds: []*string{aws.String(network.SubnetId)},
+	})
+}
+
+// DeleteNetwork deletes the subnet, the internet gateway and the vpc created
+// by CreateNetwork.
+func (p *ProviderHandler) DeleteNetwork(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	network, err := models.NewNetwork(task.Directive)
+	if err != nil {
+		return err
+	}
+	instanceService, err := p.initInstanceService(network.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	if network.SubnetId != "" {
+		_, err = instanceService.DeleteSubnet(&ec2.DeleteSubnetInput{
+			SubnetId: aws.String(network.SubnetId),
+		})
+		if err != nil {
+			p.Logger.Error("Send DeleteSubnet to %s failed: %+v", MyProvider, err)
+			return err
+		}
+	}
+
+	gatewayOutput, err := instanceService.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("attachment.vpc-id"),
+			Values: []*string{aws.String(network.VpcId)},
+		}},
+	})
+	if err != nil {
+		p.Logger.Error("Send DescribeInternetGateways to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	for _, gateway := range gatewayOutput.InternetGateways {
+		_, err = instanceService.DetachInternetGateway(&ec2.DetachInternetGatewayInput{
+			InternetGatewayId: gateway.InternetGatewayId,
+			VpcId:             aws.String(network.VpcId),
+		})
+		if err != nil {
+			p.Logger.Error("Send DetachInternetGateway to %s failed: %+v", MyProvider, err)
+			return err
+		}
+		_, err = instanceService.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+			InternetGatewayId: gateway.InternetGatewayId,
+		})
+		if err != nil {
+			p.Logger.Error("Send DeleteInternetGateway to %s failed: %+v", MyProvider, err)
+			return err
+		}
+	}
+
+	_, err = instanceService.DeleteVpc(&ec2.DeleteVpcInput{
+		VpcId: aws.String(network.VpcId),
+	})
+	if err != nil {
+		p.Logger.Error("Send DeleteVpc to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	return nil
+}
+
+func (p *ProviderHandler) WaitDeleteNetwork(task *models.Task) error {
+	// vpc is deleted synchronously
+	return nil
+}
+
+func (p *ProviderHandler) AllocateEips(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	nodeEip, err := models.NewNodeEip(task.Directive)
+	if err != nil {
+		return err
+	}
+	instanceService, err := p.initInstanceService(nodeEip.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	output, err := instanceService.AllocateAddress(&ec2.AllocateAddressInput{
+		Domain: aws.String(DefaultAddressDomain),
+	})
+	if err != nil {
+		p.Logger.Error("Send AllocateAddress to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	nodeEip.EipId = aws.StringValue(output.AllocationId)
+	nodeEip.Eip = aws.StringValue(output.PublicIp)
+	task.Directive = jsonutil.ToString(nodeEip)
+
+	_, err = instanceService.AssociateAddress(&ec2.AssociateAddressInput{
+		AllocationId: aws.String(nodeEip.EipId),
+		InstanceId:   aws.String(nodeEip.InstanceId),
+	})
+	if err != nil {
+		p.Logger.Error("Send AssociateAddress to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	return nil
+}
+
+func (p *ProviderHandler) WaitAllocateEips(task *models.Task) error {
+	// address is associated synchronously
+	return nil
+}
+
+func (p *ProviderHandler) ReleaseEips(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	nodeEip, err := models.NewNodeEip(task.Directive)
+	if err != nil {
+		return err
+	}
+	instanceService, err := p.initInstanceService(nodeEip.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	output, err := instanceService.DescribeAddresses(&ec2.DescribeAddressesInput{
+		AllocationIds: []*string{aws.String(nodeEip.EipId)},
+	})
+	if err != nil {
+		p.Logger.Error("Send DescribeAddresses to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	for _, address := range output.Addresses {
+		if aws.StringValue(address.AssociationId) == "" {
+			continue
+		}
+		_, err = instanceService.DisassociateAddress(&ec2.DisassociateAddressInput{
+			AssociationId: address.AssociationId,
+		})
+		if err != nil {
+			p.Logger.Error("Send DisassociateAddress to %s failed: %+v", MyProvider, err)
+			return err
+		}
+	}
+
+	_, err = instanceService.ReleaseAddress(&ec2.ReleaseAddressInput{
+		AllocationId: aws.String(nodeEip.EipId),
+	})
+	if err != nil {
+		p.Logger.Error("Send ReleaseAddress to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	return nil
+}
+
+func (p *ProviderHandler) WaitReleaseEips(task *models.Task) error {
+	// address is released synchronously
+	return nil
+}
+
+// CheckNetworkQuota checks the elastic ips left for eipCount nodes, aws has no
+// api for the vpcs left so vpcCount is not checked.
+func (p *ProviderHandler) CheckNetworkQuota(runtimeId string, vpcCount, eipCount int) error {
+	if eipCount == 0 {
+		return nil
+	}
+	instanceService, err := p.initInstanceService(runtimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	attributeOutput, err := instanceService.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{
+		AttributeNames: []*string{aws.String(AttributeVpcMaxElasticIps)},
+	})
+	if err != nil {
+		p.Logger.Error("Send DescribeAccountAttributes to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	if len(attributeOutput.AccountAttributes) == 0 || len(attributeOutput.AccountAttributes[0].AttributeValues) == 0 {
+		return nil
+	}
+	maxEips, err := strconv.Atoi(aws.StringValue(attributeOutput.AccountAttributes[0].AttributeValues[0].AttributeValue))
+	if err != nil {
+		return err
+	}
+
+	addressOutput, err := instanceService.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("domain"),
+			Values: []*string{aws.String(DefaultAddressDomain)},
+		}},
+	})
+	if err != nil {
+		p.Logger.Error("Send DescribeAddresses to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	left := maxEips - len(addressOutput.Addresses)
+	if eipCount > left {
+		p.Logger.Error("[%s] quota not enough: need %d more %s quota", MyProvider, eipCount-left, AttributeVpcMaxElasticIps)
+		return fmt.Errorf("need %d more %s quota", eipCount-left, AttributeVpcMaxElasticIps)
+	}
+	return nil
+}
+
+func isTargetGroup(listenerId string) bool {
+	return strings.HasPrefix(listenerId, TargetGroupArnPrefix)
+}
+
+func (p *ProviderHandler) getTargets(loadbalancerBackend *models.LoadbalancerBackend) []*elbv2.TargetDescription {
+	var targets []*elbv2.TargetDescription
+	for _, instanceId := range loadbalancerBackend.InstanceIds {
+		target := &elbv2.TargetDescription{
+			Id: aws.String(instanceId),
+		}
+		if loadbalancerBackend.Port > 0 {
+			target.Port = aws.Int64(int64(loadbalancerBackend.Port))
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+func (p *ProviderHandler) getElbInstances(loadbalancerBackend *models.LoadbalancerBackend) []*elb.Instance {
+	var instances []*elb.Instance
+	for _, instanceId := range loadbalancerBackend.InstanceIds {
+		instances = append(instances, &elb.Instance{
+			InstanceId: aws.String(instanceId),
+		})
+	}
+	return instances
+}
+
+// AddLoadbalancerBackends registers the instances to the target group of an
+// application or network loadbalancer, or to a classic loadbalancer.
+func (p *ProviderHandler) AddLoadbalancerBackends(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	loadbalancerBackend, err := models.NewLoadbalancerBackend(task.Directive)
+	if err != nil {
+		return err
+	}
+	if len(loadbalancerBackend.InstanceIds) == 0 {
+		p.Logger.Warn("Skip task without instances")
+		return nil
+	}
+	elbService, elbv2Service, err := p.initLoadbalancerServices(loadbalancerBackend.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	if isTargetGroup(loadbalancerBackend.ListenerId) {
+		_, err = elbv2Service.RegisterTargets(&elbv2.RegisterTargetsInput{
+			TargetGroupArn: aws.String(loadbalancerBackend.ListenerId),
+			Targets:        p.getTargets(loadbalancerBackend),
+		})
+		if err != nil {
+			p.Logger.Error("Send RegisterTargets to %s failed: %+v", MyProvider, err)
+			return err
+		}
+	} else {
+		_, err = elbService.RegisterInstancesWithLoadBalancer(&elb.RegisterInstancesWithLoadBalancerInput{
+			LoadBalancerName: aws.String(loadbalancerBackend.ListenerId),
+			Instances:        p.getElbInstances(loadbalancerBackend),
+		})
+		if err != nil {
+			p.Logger.Error("Send RegisterInstancesWithLoadBalancer to %s failed: %+v", MyProvider, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ProviderHandler) WaitAddLoadbalancerBackends(task *models.Task) error {
+	// targets are registered synchronously, the health of the service is
+	// not waited for
+	return nil
+}
+
+func (p *ProviderHandler) DeleteLoadbalancerBackends(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	loadbalancerBackend, err := models.NewLoadbalancerBackend(task.Directive)
+	if err != nil {
+		return err
+	}
+	if len(loadbalancerBackend.InstanceIds) == 0 {
+		p.Logger.Warn("Skip task without instances")
+		return nil
+	}
+	elbService, elbv2Service, err := p.initLoadbalancerServices(loadbalancerBackend.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	if isTargetGroup(loadbalancerBackend.ListenerId) {
+		_, err = elbv2Service.DeregisterTargets(&elbv2.DeregisterTargetsInput{
+			TargetGroupArn: aws.String(loadbalancerBackend.ListenerId),
+			Targets:        p.getTargets(loadbalancerBackend),
+		})
+		if err != nil {
+			p.Logger.Error("Send DeregisterTargets to %s failed: %+v", MyProvider, err)
+			return err
+		}
+	} else {
+		_, err = elbService.DeregisterInstancesFromLoadBalancer(&elb.DeregisterInstancesFromLoadBalancerInput{
+			LoadBalancerName: aws.String(loadbalancerBackend.ListenerId),
+			Instances:        p.getElbInstances(loadbalancerBackend),
+		})
+		if err != nil {
+			p.Logger.Error("Send DeregisterInstancesFromLoadBalancer to %s failed: %+v", MyProvider, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitDeleteLoadbalancerBackends waits for the connections to the instances to
+// be drained before the instances are deleted.
+func (p *ProviderHandler) WaitDeleteLoadbalancerBackends(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	loadbalancerBackend, err := models.NewLoadbalancerBackend(task.Directive)
+	if err != nil {
+		return err
+	}
+	if len(loadbalancerBackend.InstanceIds) == 0 {
+		return nil
+	}
+	elbService, elbv2Service, err := p.initLoadbalancerServices(loadbalancerBackend.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	if isTargetGroup(loadbalancerBackend.ListenerId) {
+		err = elbv2Service.WaitUntilTargetDeregistered(&elbv2.DescribeTargetHealthInput{
+			TargetGroupArn: aws.String(loadbalancerBackend.ListenerId),
+			Targets:        p.getTargets(loadbalancerBackend),
+		})
+	} else {
+		err = elbService.WaitUntilInstanceDeregistered(&elb.DescribeInstanceHealthInput{
+			LoadBalancerName: aws.String(loadbalancerBackend.ListenerId),
+			Instances:        p.getElbInstances(loadbalancerBackend),
+		})
+	}
+	if err != nil {
+		p.Logger.Error("Wait %s instances [%s] deregistered from [%s] failed: %+v", MyProvider,
+			strings.Join(loadbalancerBackend.InstanceIds, ","), loadbalancerBackend.ListenerId, err)
+		return err
+	}
+	return nil
+}
+
+// CheckLoadbalancerListeners checks the target groups and the classic
+// loadbalancers exist.
+func (p *ProviderHandler) CheckLoadbalancerListeners(runtimeId string, listenerIds []string) error {
+	elbService, elbv2Service, err := p.initLoadbalancerServices(runtimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	for _, listenerId := range listenerIds {
+		if isTargetGroup(listenerId) {
+			output, err := elbv2Service.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+				TargetGroupArns: []*string{aws.String(listenerId)},
+			})
+			if err != nil {
+				p.Logger.Error("Send DescribeTargetGroups to %s failed: %+v", MyProvider, err)
+				return err
+			}
+			if len(output.TargetGroups) == 0 {
+				return fmt.Errorf("target group [%s] not found", listenerId)
+			}
+		} else {
+			output, err := elbService.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
+				LoadBalancerNames: []*string{aws.String(listenerId)},
+			})
+			if err != nil {
+				p.Logger.Error("Send DescribeLoadBalancers to %s failed: %+v", MyProvider, err)
+				return err
+			}
+			if len(output.LoadBalancerDescriptions) == 0 {
+				return fmt.Errorf("loadbalancer [%s] not found", listenerId)
+			}
+		}
+	}
+	return nil
+}
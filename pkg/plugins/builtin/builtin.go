@@ -0,0 +1,17 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package builtin registers the providers built in openpitrix, the services
+// that run the providers import it for the side effect:
+//
+//	import _ "openpitrix.io/openpitrix/pkg/plugins/builtin"
+package builtin
+
+import (
+	_ "openpitrix.io/openpitrix/pkg/plugins/aws"
+	_ "openpitrix.io/openpitrix/pkg/plugins/helm"
+	_ "openpitrix.io/openpitrix/pkg/plugins/openstack"
+	_ "openpitrix.io/openpitrix/pkg/plugins/qingcloud"
+	_ "openpitrix.io/openpitrix/pkg/plugins/simulator"
+)
@@ -37,6 +37,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/plugins"
 	"openpitrix.io/openpitrix/pkg/util/funcutil"
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
@@ -51,6 +52,12 @@ type Provider struct {
 	Logger *logger.Logger
 }
 
+func init() {
+	plugins.Register(constants.ProviderKubernetes, func(l *logger.Logger) plugins.ProviderInterface {
+		return NewProvider(l)
+	})
+}
+
 func NewProvider(l *logger.Logger) *Provider {
 	return &Provider{
 		Logger: l,
@@ -0,0 +1,320 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Address struct {
+	Addr    string `json:"addr"`
+	Type    string `json:"OS-EXT-IPS:type"`
+	Version int    `json:"version"`
+}
+
+type Server struct {
+	Id               string               `json:"id"`
+	Name             string               `json:"name"`
+	Status           string               `json:"status"`
+	AvailabilityZone string               `json:"OS-EXT-AZ:availability_zone"`
+	Addresses        map[string][]Address `json:"addresses"`
+	VolumesAttached  []struct {
+		Id string `json:"id"`
+	} `json:"os-extended-volumes:volumes_attached"`
+}
+
+// GetIp returns the first ipv4 address of the type, fixed or floating.
+func (s *Server) GetIp(addressType string) string {
+	for _, addresses := range s.Addresses {
+		for _, address := range addresses {
+			if address.Type == addressType && address.Version != 6 {
+				return address.Addr
+			}
+		}
+	}
+	return ""
+}
+
+func (s *Server) HasVolume(volumeId string) bool {
+	for _, volume := range s.VolumesAttached {
+		if volume.Id == volumeId {
+			return true
+		}
+	}
+	return false
+}
+
+type Flavor struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Vcpus int    `json:"vcpus"`
+	Ram   int    `json:"ram"`
+	Disk  int    `json:"disk"`
+}
+
+type Volume struct {
+	Id               string `json:"id"`
+	Name             string `json:"name"`
+	Size             int    `json:"size"`
+	Status           string `json:"status"`
+	AvailabilityZone string `json:"availability_zone"`
+	Attachments      []struct {
+		ServerId string `json:"server_id"`
+		Device   string `json:"device"`
+	} `json:"attachments"`
+}
+
+type Network struct {
+	Id                string   `json:"id"`
+	Name              string   `json:"name"`
+	Status            string   `json:"status"`
+	Subnets           []string `json:"subnets"`
+	AvailabilityZones []string `json:"availability_zones"`
+}
+
+type Subnet struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	NetworkId string `json:"network_id"`
+	Cidr      string `json:"cidr"`
+}
+
+type Image struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// Limits is the absolute limits of nova and cinder, -1 means unlimited.
+type Limits struct {
+	MaxTotalCores           int `json:"maxTotalCores"`
+	TotalCoresUsed          int `json:"totalCoresUsed"`
+	MaxTotalInstances       int `json:"maxTotalInstances"`
+	TotalInstancesUsed      int `json:"totalInstancesUsed"`
+	MaxTotalRAMSize         int `json:"maxTotalRAMSize"`
+	TotalRAMUsed            int `json:"totalRAMUsed"`
+	MaxTotalVolumes         int `json:"maxTotalVolumes"`
+	TotalVolumesUsed        int `json:"totalVolumesUsed"`
+	MaxTotalVolumeGigabytes int `json:"maxTotalVolumeGigabytes"`
+	TotalGigabytesUsed      int `json:"totalGigabytesUsed"`
+}
+
+type CreateServerInput struct {
+	Name             string              `json:"name"`
+	ImageRef         string              `json:"imageRef"`
+	FlavorRef        string              `json:"flavorRef"`
+	AvailabilityZone string              `json:"availability_zone,omitempty"`
+	Networks         []map[string]string `json:"networks"`
+	UserData         string              `json:"user_data,omitempty"`
+	KeyName          string              `json:"key_name,omitempty"`
+}
+
+func (c *Client) CreateServer(input *CreateServerInput) (*Server, error) {
+	var output struct {
+		Server *Server `json:"server"`
+	}
+	err := c.Do(ServiceCompute, http.MethodPost, "/servers", map[string]interface{}{"server": input}, &output)
+	if err != nil {
+		return nil, err
+	}
+	if output.Server == nil {
+		return nil, fmt.Errorf("no server in the response of creating server [%s]", input.Name)
+	}
+	return output.Server, nil
+}
+
+func (c *Client) GetServer(serverId string) (*Server, error) {
+	var output struct {
+		Server *Server `json:"server"`
+	}
+	err := c.Do(ServiceCompute, http.MethodGet, "/servers/"+serverId, nil, &output)
+	if err != nil {
+		return nil, err
+	}
+	if output.Server == nil {
+		return nil, fmt.Errorf("server with id [%s] not exist", serverId)
+	}
+	return output.Server, nil
+}
+
+// ServerAction runs the action of the server, e.g. os-start, os-stop.
+func (c *Client) ServerAction(serverId, action string) error {
+	return c.Do(ServiceCompute, http.MethodPost, "/servers/"+serverId+"/action",
+		map[string]interface{}{action: nil}, nil)
+}
+
+func (c *Client) DeleteServer(serverId string) error {
+	return c.Do(ServiceCompute, http.MethodDelete, "/servers/"+serverId, nil, nil)
+}
+
+func (c *Client) ListFlavors() ([]Flavor, error) {
+	var output struct {
+		Flavors []Flavor `json:"flavors"`
+	}
+	err := c.Do(ServiceCompute, http.MethodGet, "/flavors/detail", nil, &output)
+	return output.Flavors, err
+}
+
+// AttachVolume attaches the volume to the server and returns the device.
+func (c *Client) AttachVolume(serverId, volumeId string) (string, error) {
+	var output struct {
+		VolumeAttachment struct {
+			Device string `json:"device"`
+		} `json:"volumeAttachment"`
+	}
+	err := c.Do(ServiceCompute, http.MethodPost, "/servers/"+serverId+"/os-volume_attachments",
+		map[string]interface{}{"volumeAttachment": map[string]string{"volumeId": volumeId}}, &output)
+	return output.VolumeAttachment.Device, err
+}
+
+func (c *Client) DetachVolume(serverId, volumeId string) error {
+	return c.Do(ServiceCompute, http.MethodDelete, "/servers/"+serverId+"/os-volume_attachments/"+volumeId, nil, nil)
+}
+
+func (c *Client) ListAvailabilityZones() ([]string, error) {
+	var output struct {
+		AvailabilityZoneInfo []struct {
+			ZoneName  string `json:"zoneName"`
+			ZoneState struct {
+				Available bool `json:"available"`
+			} `json:"zoneState"`
+		} `json:"availabilityZoneInfo"`
+	}
+	err := c.Do(ServiceCompute, http.MethodGet, "/os-availability-zone", nil, &output)
+	if err != nil {
+		return nil, err
+	}
+	var zones []string
+	for _, zone := range output.AvailabilityZoneInfo {
+		if zone.ZoneState.Available {
+			zones = append(zones, zone.ZoneName)
+		}
+	}
+	return zones, nil
+}
+
+func (c *Client) ListKeyPairs() ([]string, error) {
+	var output struct {
+		KeyPairs []struct {
+			KeyPair struct {
+				Name string `json:"name"`
+			} `json:"keypair"`
+		} `json:"keypairs"`
+	}
+	err := c.Do(ServiceCompute, http.MethodGet, "/os-keypairs", nil, &output)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, key := range output.KeyPairs {
+		keys = append(keys, key.KeyPair.Name)
+	}
+	return keys, nil
+}
+
+// GetLimits returns the limits of both nova and cinder.
+func (c *Client) GetLimits() (*Limits, error) {
+	var output struct {
+		Limits struct {
+			Absolute Limits `json:"absolute"`
+		} `json:"limits"`
+	}
+	err := c.Do(ServiceCompute, http.MethodGet, "/limits", nil, &output)
+	if err != nil {
+		return nil, err
+	}
+	limits := output.Limits.Absolute
+	output.Limits.Absolute = Limits{}
+	err = c.Do(ServiceVolume, http.MethodGet, "/limits", nil, &output)
+	if err != nil {
+		return nil, err
+	}
+	limits.MaxTotalVolumes = output.Limits.Absolute.MaxTotalVolumes
+	limits.TotalVolumesUsed = output.Limits.Absolute.TotalVolumesUsed
+	limits.MaxTotalVolumeGigabytes = output.Limits.Absolute.MaxTotalVolumeGigabytes
+	limits.TotalGigabytesUsed = output.Limits.Absolute.TotalGigabytesUsed
+	return &limits, nil
+}
+
+func (c *Client) CreateVolume(name string, size int, availabilityZone string) (*Volume, error) {
+	var output struct {
+		Volume *Volume `json:"volume"`
+	}
+	input := map[string]interface{}{
+		"volume": map[string]interface{}{
+			"name":              name,
+			"size":              size,
+			"availability_zone": availabilityZone,
+		},
+	}
+	err := c.Do(ServiceVolume, http.MethodPost, "/volumes", input, &output)
+	if err != nil {
+		return nil, err
+	}
+	if output.Volume == nil {
+		return nil, fmt.Errorf("no volume in the response of creating volume [%s]", name)
+	}
+	return output.Volume, nil
+}
+
+func (c *Client) GetVolume(volumeId string) (*Volume, error) {
+	var output struct {
+		Volume *Volume `json:"volume"`
+	}
+	err := c.Do(ServiceVolume, http.MethodGet, "/volumes/"+volumeId, nil, &output)
+	if err != nil {
+		return nil, err
+	}
+	if output.Volume == nil {
+		return nil, fmt.Errorf("volume with id [%s] not exist", volumeId)
+	}
+	return output.Volume, nil
+}
+
+func (c *Client) DeleteVolume(volumeId string) error {
+	return c.Do(ServiceVolume, http.MethodDelete, "/volumes/"+volumeId, nil, nil)
+}
+
+func (c *Client) GetNetwork(networkId string) (*Network, error) {
+	var output struct {
+		Network *Network `json:"network"`
+	}
+	err := c.Do(ServiceNetwork, http.MethodGet, "/v2.0/networks/"+networkId, nil, &output)
+	if err != nil {
+		return nil, err
+	}
+	if output.Network == nil {
+		return nil, fmt.Errorf("network with id [%s] not exist", networkId)
+	}
+	return output.Network, nil
+}
+
+// ListSubnets returns the subnets of the ids, all the subnets are returned
+// if no id is given.
+func (c *Client) ListSubnets(subnetIds ...string) ([]Subnet, error) {
+	query := url.Values{}
+	for _, subnetId := range subnetIds {
+		query.Add("id", subnetId)
+	}
+	path := "/v2.0/subnets"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	var output struct {
+		Subnets []Subnet `json:"subnets"`
+	}
+	err := c.Do(ServiceNetwork, http.MethodGet, path, nil, &output)
+	return output.Subnets, err
+}
+
+func (c *Client) ListImages(name string) ([]Image, error) {
+	var output struct {
+		Images []Image `json:"images"`
+	}
+	err := c.Do(ServiceImage, http.MethodGet, "/v2/images?"+url.Values{"name": {name}}.Encode(), nil, &output)
+	return output.Images, err
+}
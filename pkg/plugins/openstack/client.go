@@ -0,0 +1,216 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package openstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+var httpClient = &http.Client{Timeout: 60 * time.Second}
+
+type Error struct {
+	Method     string
+	Url        string
+	StatusCode int
+	Body       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %s failed with status code [%d]: %s", e.Method, e.Url, e.StatusCode, e.Body)
+}
+
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+type endpoint struct {
+	Interface string `json:"interface"`
+	Region    string `json:"region"`
+	RegionId  string `json:"region_id"`
+	Url       string `json:"url"`
+}
+
+type catalogEntry struct {
+	Type      string     `json:"type"`
+	Endpoints []endpoint `json:"endpoints"`
+}
+
+// Client calls the apis of openstack services by the token of keystone, the
+// endpoints of the services are found in the catalog of the token.
+type Client struct {
+	token   string
+	region  string
+	catalog []catalogEntry
+}
+
+func getIdentityUrl(url string) string {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		url = "https://" + url
+	}
+	url = strings.TrimSuffix(url, "/")
+	if !strings.HasSuffix(url, "/v3") {
+		url += "/v3"
+	}
+	return url
+}
+
+func NewClient(url, runtimeCredential, region string) (*Client, error) {
+	credential := new(Credential)
+	err := jsonutil.Decode([]byte(runtimeCredential), credential)
+	if err != nil {
+		return nil, fmt.Errorf("parse [%s] credential failed: %+v", MyProvider, err)
+	}
+	if credential.UserDomainName == "" {
+		credential.UserDomainName = DefaultDomain
+	}
+	if credential.ProjectDomainName == "" {
+		credential.ProjectDomainName = DefaultDomain
+	}
+
+	request := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{
+						"name":     credential.UserName,
+						"password": credential.Password,
+						"domain":   map[string]string{"name": credential.UserDomainName},
+					},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]interface{}{
+					"name":   credential.ProjectName,
+					"domain": map[string]string{"name": credential.ProjectDomainName},
+				},
+			},
+		},
+	}
+	var response struct {
+		Token struct {
+			Catalog []catalogEntry `json:"catalog"`
+		} `json:"token"`
+	}
+	header, err := doRequest(http.MethodPost, getIdentityUrl(url)+"/auth/tokens", "", request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		token:   header.Get("X-Subject-Token"),
+		region:  region,
+		catalog: response.Token.Catalog,
+	}, nil
+}
+
+// Regions returns the regions of the compute service.
+func (c *Client) Regions() []string {
+	var regions []string
+	for _, entry := range c.catalog {
+		if !stringutil.StringIn(entry.Type, ServiceCompute) {
+			continue
+		}
+		for _, e := range entry.Endpoints {
+			region := e.RegionId
+			if region == "" {
+				region = e.Region
+			}
+			if e.Interface == EndpointInterface && !stringutil.StringIn(region, regions) {
+				regions = append(regions, region)
+			}
+		}
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+func (c *Client) getEndpoint(serviceTypes []string) (string, error) {
+	for _, serviceType := range serviceTypes {
+		for _, entry := range c.catalog {
+			if entry.Type != serviceType {
+				continue
+			}
+			for _, e := range entry.Endpoints {
+				if e.Interface != EndpointInterface {
+					continue
+				}
+				if c.region != "" && e.RegionId != c.region && e.Region != c.region {
+					continue
+				}
+				return strings.TrimSuffix(e.Url, "/"), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no endpoint of service %v in region [%s]", serviceTypes, c.region)
+}
+
+// Do calls the api of the service, the request and response are encoded in
+// json, the response is ignored if it is nil.
+func (c *Client) Do(serviceTypes []string, method, path string, request, response interface{}) error {
+	url, err := c.getEndpoint(serviceTypes)
+	if err != nil {
+		return err
+	}
+	_, err = doRequest(method, url+path, c.token, request, response)
+	return err
+}
+
+func doRequest(method, url, token string, request, response interface{}) (http.Header, error) {
+	var body []byte
+	if request != nil {
+		var err error
+		body, err = json.Marshal(request)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("X-Auth-Token", token)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &Error{
+			Method:     method,
+			Url:        url,
+			StatusCode: resp.StatusCode,
+			Body:       string(data),
+		}
+	}
+	if response != nil && len(data) > 0 {
+		err = json.Unmarshal(data, response)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.Header, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package openstack
+
+const (
+	DefaultAvailabilityZone = "nova"
+	DefaultDomain           = "Default"
+	EndpointInterface       = "public"
+)
+
+// service types in the catalog of keystone
+var (
+	ServiceCompute = []string{"compute"}
+	ServiceVolume  = []string{"volumev3", "volumev2", "block-storage", "volume"}
+	ServiceNetwork = []string{"network"}
+	ServiceImage   = []string{"image"}
+)
+
+// status of nova servers, cinder volumes and neutron networks
+const (
+	ServerStatusActive  = "ACTIVE"
+	ServerStatusShutoff = "SHUTOFF"
+	ServerStatusError   = "ERROR"
+	ServerStatusDeleted = "DELETED"
+
+	VolumeStatusError = "error"
+
+	NetworkStatusActive = "ACTIVE"
+)
+
+const (
+	ActionStartServer  = "os-start"
+	ActionStopServer   = "os-stop"
+	ActionDeleteServer = "delete"
+
+	AddressTypeFixed    = "fixed"
+	AddressTypeFloating = "floating"
+)
+
+const (
+	ResourceTypeInstance   = "instances"
+	ResourceTypeCpu        = "cores"
+	ResourceTypeGpu        = "gpu"
+	ResourceTypeMemory     = "ram"
+	ResourceTypeVolume     = "volumes"
+	ResourceTypeVolumeSize = "gigabytes"
+)
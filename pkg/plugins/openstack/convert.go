@@ -0,0 +1,40 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package openstack
+
+import (
+	"fmt"
+
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+// ConvertToFlavor returns the flavor of the cpu and memory, the smallest
+// flavor that is large enough is used if no flavor matches exactly.
+func ConvertToFlavor(flavors []Flavor, cpu, memory int) (*Flavor, error) {
+	var matched *Flavor
+	for i, flavor := range flavors {
+		if flavor.Vcpus == cpu && flavor.Ram == memory {
+			return &flavors[i], nil
+		}
+		if flavor.Vcpus < cpu || flavor.Ram < memory {
+			continue
+		}
+		if matched == nil || flavor.Vcpus < matched.Vcpus ||
+			(flavor.Vcpus == matched.Vcpus && flavor.Ram < matched.Ram) {
+			matched = &flavors[i]
+		}
+	}
+	if matched == nil {
+		return nil, fmt.Errorf("no openstack flavor matched with cpu [%d] memory [%d]", cpu, memory)
+	}
+	return matched, nil
+}
+
+func getQuotaLeft(max, used int) int {
+	if max < 0 {
+		return models.UnlimitedQuota
+	}
+	return max - used
+}
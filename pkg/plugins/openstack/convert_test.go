@@ -0,0 +1,42 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package openstack
+
+import "testing"
+
+var testFlavors = []Flavor{
+	{Id: "1", Name: "m1.tiny", Vcpus: 1, Ram: 512},
+	{Id: "2", Name: "m1.small", Vcpus: 1, Ram: 2048},
+	{Id: "3", Name: "m1.medium", Vcpus: 2, Ram: 4096},
+	{Id: "4", Name: "m1.large", Vcpus: 4, Ram: 8192},
+	{Id: "5", Name: "c1.medium", Vcpus: 4, Ram: 4096},
+}
+
+func TestConvertToFlavor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cpu     int
+		memory  int
+		want    string
+		wantErr bool
+	}{
+		{name: "exact", cpu: 2, memory: 4096, want: "m1.medium"},
+		{name: "larger memory", cpu: 1, memory: 1024, want: "m1.small"},
+		{name: "smaller memory", cpu: 3, memory: 2048, want: "c1.medium"},
+		{name: "too large", cpu: 8, memory: 1024, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertToFlavor(testFlavors, tt.cpu, tt.memory)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ConvertToFlavor() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.Name != tt.want {
+				t.Errorf("ConvertToFlavor() = %v, want %v", got.Name, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package openstack
+
+// Credential of the keystone v3 password auth, the runtime url is the
+// identity endpoint, e.g. https://keystone.example.com:5000/v3, and the
+// runtime zone is the region.
+type Credential struct {
+	UserName          string `json:"user_name"`
+	Password          string `json:"password"`
+	ProjectName       string `json:"project_name"`
+	UserDomainName    string `json:"user_domain_name"`
+	ProjectDomainName string `json:"project_domain_name"`
+}
@@ -0,0 +1,749 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+var MyProvider = constants.ProviderOpenStack
+
+type ProviderHandler struct {
+	vmbased.FrameHandler
+}
+
+func GetProviderHandler(Logger *logger.Logger) *ProviderHandler {
+	providerHandler := new(ProviderHandler)
+	if Logger == nil {
+		providerHandler.Logger = logger.NewLogger()
+	} else {
+		providerHandler.Logger = Logger
+	}
+	return providerHandler
+}
+
+// getRuntime is replaced in tests to run without the runtime manager.
+var getRuntime = func(runtimeId string) (*runtimeclient.Runtime, error) {
+	return runtimeclient.NewRuntime(runtimeId)
+}
+
+func (p *ProviderHandler) initClient(runtimeId string) (*Client, error) {
+	runtime, err := getRuntime(runtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(runtime.RuntimeUrl, runtime.Credential, runtime.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s api client failed: %+v", MyProvider, err)
+		return nil, err
+	}
+	return client, nil
+}
+
+func (p *ProviderHandler) RunInstances(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	instance, err := models.NewInstance(task.Directive)
+	if err != nil {
+		return err
+	}
+	client, err := p.initClient(instance.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	flavors, err := client.ListFlavors()
+	if err != nil {
+		p.Logger.Error("Send ListFlavors to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	flavor, err := ConvertToFlavor(flavors, instance.Cpu, instance.Memory)
+	if err != nil {
+		p.Logger.Error("Could not find an openstack flavor: %+v", err)
+		return err
+	}
+
+	subnets, err := client.ListSubnets(instance.Subnet)
+	if err != nil {
+		p.Logger.Error("Send ListSubnets to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	if len(subnets) == 0 {
+		return fmt.Errorf("subnet with id [%s] not exist", instance.Subnet)
+	}
+
+	input := &CreateServerInput{
+		Name:             instance.Name,
+		ImageRef:         instance.ImageId,
+		FlavorRef:        flavor.Id,
+		AvailabilityZone: instance.Zone,
+		Networks:         []map[string]string{{"uuid": subnets[0].NetworkId}},
+	}
+	if instance.NeedUserData == 1 {
+		input.UserData = instance.UserDataValue
+	}
+
+	p.Logger.Debug("RunInstances with input: %s", jsonutil.ToString(input))
+	server, err := client.CreateServer(input)
+	if err != nil {
+		p.Logger.Error("Send RunInstances to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	p.Logger.Debug("RunInstances get output: %s", jsonutil.ToString(server))
+
+	instance.InstanceId = server.Id
+
+	// write back
+	task.Directive = jsonutil.ToString(instance)
+
+	return nil
+}
+
+func (p *ProviderHandler) instanceAction(task *models.Task, action, skipStatus string) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	instance, err := models.NewInstance(task.Directive)
+	if err != nil {
+		return err
+	}
+	if instance.InstanceId == "" {
+		p.Logger.Warn("Skip task without instance id")
+		return nil
+	}
+	client, err := p.initClient(instance.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	server, err := client.GetServer(instance.InstanceId)
+	if err != nil {
+		if IsNotFound(err) && action == ActionDeleteServer {
+			p.Logger.Warn("Instance [%s] has already been deleted, do nothing", instance.InstanceId)
+			return nil
+		}
+		p.Logger.Error("Send DescribeInstances to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	if server.Status == skipStatus {
+		p.Logger.Warn("Instance [%s] has already been [%s], do nothing", instance.InstanceId, server.Status)
+		return nil
+	}
+
+	if action == ActionDeleteServer {
+		err = client.DeleteServer(instance.InstanceId)
+	} else {
+		err = client.ServerAction(instance.InstanceId, action)
+	}
+	if err != nil {
+		p.Logger.Error("Send [%s] to %s failed: %+v", action, MyProvider, err)
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(instance)
+
+	return nil
+}
+
+func (p *ProviderHandler) StopInstances(task *models.Task) error {
+	return p.instanceAction(task, ActionStopServer, ServerStatusShutoff)
+}
+
+func (p *ProviderHandler) StartInstances(task *models.Task) error {
+	return p.instanceAction(task, ActionStartServer, ServerStatusActive)
+}
+
+func (p *ProviderHandler) DeleteInstances(task *models.Task) error {
+	return p.instanceAction(task, ActionDeleteServer, ServerStatusDeleted)
+}
+
+func (p *ProviderHandler) CreateVolumes(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	volume, err := models.NewVolume(task.Directive)
+	if err != nil {
+		return err
+	}
+	client, err := p.initClient(volume.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	output, err := client.CreateVolume(volume.Name, volume.Size, volume.Zone)
+	if err != nil {
+		p.Logger.Error("Send CreateVolumes to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	volume.VolumeId = output.Id
+
+	// write back
+	task.Directive = jsonutil.ToString(volume)
+
+	return nil
+}
+
+func (p *ProviderHandler) DetachVolumes(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	volume, err := models.NewVolume(task.Directive)
+	if err != nil {
+		return err
+	}
+	client, err := p.initClient(volume.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	output, err := client.GetVolume(volume.VolumeId)
+	if err != nil {
+		p.Logger.Error("Send DescribeVolumes to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	if output.Status == constants.StatusAvailable {
+		p.Logger.Warn("Volume [%s] has already been [%s], do nothing", volume.VolumeId, output.Status)
+		return nil
+	}
+
+	err = client.DetachVolume(volume.InstanceId, volume.VolumeId)
+	if err != nil {
+		p.Logger.Error("Send DetachVolumes to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(volume)
+
+	return nil
+}
+
+func (p *ProviderHandler) AttachVolumes(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	volume, err := models.NewVolume(task.Directive)
+	if err != nil {
+		return err
+	}
+	client, err := p.initClient(volume.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	device, err := client.AttachVolume(volume.InstanceId, volume.VolumeId)
+	if err != nil {
+		p.Logger.Error("Send AttachVolumes to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	volume.Device = device
+
+	// write back
+	task.Directive = jsonutil.ToString(volume)
+
+	return nil
+}
+
+func (p *ProviderHandler) DeleteVolumes(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	volume, err := models.NewVolume(task.Directive)
+	if err != nil {
+		return err
+	}
+	if volume.VolumeId == "" {
+		p.Logger.Warn("Skip task without volume")
+		return nil
+	}
+	client, err := p.initClient(volume.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteVolume(volume.VolumeId)
+	if err != nil {
+		if IsNotFound(err) {
+			p.Logger.Warn("Volume [%s] has already been deleted, do nothing", volume.VolumeId)
+			return nil
+		}
+		p.Logger.Error("Send DeleteVolumes to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(volume)
+
+	return nil
+}
+
+func (p *ProviderHandler) waitInstanceVolumeAndNetwork(client *Client, task *models.Task, instanceId, volumeId string, timeout time.Duration, waitInterval time.Duration) (server *Server, err error) {
+	p.Logger.Debug("Waiting for volume [%s] attached to Instance [%s]", volumeId, instanceId)
+	if volumeId != "" {
+		volume, err := models.NewVolume(task.Directive)
+		if err != nil {
+			return nil, err
+		}
+		volume.InstanceId = instanceId
+		volumeTask := &models.Task{
+			TaskId:    task.TaskId,
+			Directive: jsonutil.ToString(volume),
+		}
+		err = p.AttachVolumes(volumeTask)
+		if err != nil {
+			p.Logger.Debug("Attach volume [%s] to Instance [%s] failed: %+v", volumeId, instanceId, err)
+			return nil, err
+		}
+
+		err = p.WaitAttachVolumes(volumeTask)
+		if err != nil {
+			p.Logger.Debug("Waiting for volume [%s] attached to Instance [%s] failed: %+v", volumeId, instanceId, err)
+			return nil, err
+		}
+	}
+
+	err = funcutil.WaitForSpecificOrError(func() (bool, error) {
+		output, err := client.GetServer(instanceId)
+		if err != nil {
+			return false, err
+		}
+
+		if output.GetIp(AddressTypeFixed) == "" {
+			return false, nil
+		}
+		if volumeId != "" && !output.HasVolume(volumeId) {
+			return false, nil
+		}
+
+		server = output
+		p.Logger.Debug("Instance [%s] get IP address [%s]", instanceId, server.GetIp(AddressTypeFixed))
+		return true, nil
+	}, timeout, waitInterval)
+	return
+}
+
+func (p *ProviderHandler) WaitRunInstances(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	instance, err := models.NewInstance(task.Directive)
+	if err != nil {
+		return err
+	}
+	client, err := p.initClient(instance.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	err = p.WaitInstanceState(task, ServerStatusActive)
+	if err != nil {
+		p.Logger.Error("Wait %s job [%s] failed: %+v", MyProvider, instance.TargetJobId, err)
+		return err
+	}
+
+	output, err := p.waitInstanceVolumeAndNetwork(client, task, instance.InstanceId, instance.VolumeId, task.GetTimeout(constants.WaitTaskTimeout), constants.WaitTaskInterval)
+	if err != nil {
+		p.Logger.Error("Wait %s instance [%s] network failed: %+v", MyProvider, instance.InstanceId, err)
+		return err
+	}
+
+	instance.PrivateIp = output.GetIp(AddressTypeFixed)
+	instance.Eip = output.GetIp(AddressTypeFloating)
+	if instance.VolumeId != "" {
+		volume, err := client.GetVolume(instance.VolumeId)
+		if err != nil {
+			p.Logger.Error("Send DescribeVolumes to %s failed: %+v", MyProvider, err)
+			return err
+		}
+		for _, attachment := range volume.Attachments {
+			if attachment.ServerId == instance.InstanceId {
+				instance.Device = attachment.Device
+			}
+		}
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(instance)
+
+	p.Logger.Debug("WaitRunInstances task [%s] directive: %s", task.TaskId, task.Directive)
+
+	return nil
+}
+
+// WaitInstanceState waits the status of nova server, the server is
+// considered as DELETED if it is not found.
+func (p *ProviderHandler) WaitInstanceState(task *models.Task, state string) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	instance, err := models.NewInstance(task.Directive)
+	if err != nil {
+		return err
+	}
+	client, err := p.initClient(instance.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	err = funcutil.WaitForSpecificOrError(func() (bool, error) {
+		output, err := client.GetServer(instance.InstanceId)
+		if err != nil {
+			if IsNotFound(err) && state == ServerStatusDeleted {
+				return true, nil
+			}
+			return true, err
+		}
+
+		if output.Status == state {
+			return true, nil
+		}
+		if output.Status == ServerStatusError {
+			return true, fmt.Errorf("instance [%s] status is [%s]", instance.InstanceId, output.Status)
+		}
+
+		return false, nil
+	}, task.GetTimeout(constants.WaitTaskTimeout), constants.WaitTaskInterval)
+	if err != nil {
+		p.Logger.Error("Wait %s instance [%s] status become to [%s] failed: %+v", MyProvider, instance.InstanceId, state, err)
+		return err
+	}
+
+	return nil
+}
+
+// WaitVolumeState waits the status of cinder volume, the volume is
+// considered as deleted if it is not found.
+func (p *ProviderHandler) WaitVolumeState(task *models.Task, state string) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	volume, err := models.NewVolume(task.Directive)
+	if err != nil {
+		return err
+	}
+	client, err := p.initClient(volume.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	err = funcutil.WaitForSpecificOrError(func() (bool, error) {
+		output, err := client.GetVolume(volume.VolumeId)
+		if err != nil {
+			if IsNotFound(err) && state == constants.StatusDeleted {
+				return true, nil
+			}
+			return true, err
+		}
+
+		if output.Status == state {
+			return true, nil
+		}
+		if output.Status == VolumeStatusError {
+			return true, fmt.Errorf("volume [%s] status is [%s]", volume.VolumeId, output.Status)
+		}
+
+		return false, nil
+	}, task.GetTimeout(constants.WaitTaskTimeout), constants.WaitTaskInterval)
+	if err != nil {
+		p.Logger.Error("Wait %s volume [%s] status become to [%s] failed: %+v", MyProvider, volume.VolumeId, state, err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *ProviderHandler) WaitStopInstances(task *models.Task) error {
+	return p.WaitInstanceState(task, ServerStatusShutoff)
+}
+
+func (p *ProviderHandler) WaitStartInstances(task *models.Task) error {
+	return p.WaitInstanceState(task, ServerStatusActive)
+}
+
+func (p *ProviderHandler) WaitDeleteInstances(task *models.Task) error {
+	return p.WaitInstanceState(task, ServerStatusDeleted)
+}
+
+func (p *ProviderHandler) WaitCreateVolumes(task *models.Task) error {
+	return p.WaitVolumeState(task, constants.StatusAvailable)
+}
+
+func (p *ProviderHandler) WaitAttachVolumes(task *models.Task) error {
+	return p.WaitVolumeState(task, constants.StatusInUse)
+}
+
+func (p *ProviderHandler) WaitDetachVolumes(task *models.Task) error {
+	return p.WaitVolumeState(task, constants.StatusAvailable)
+}
+
+func (p *ProviderHandler) WaitDeleteVolumes(task *models.Task) error {
+	return p.WaitVolumeState(task, constants.StatusDeleted)
+}
+
+func (p *ProviderHandler) getAvailabilityZone(network *Network) string {
+	if len(network.AvailabilityZones) > 0 {
+		return network.AvailabilityZones[0]
+	}
+	return DefaultAvailabilityZone
+}
+
+func (p *ProviderHandler) DescribeSubnets(ctx context.Context, req *pb.DescribeSubnetsRequest) (*pb.DescribeSubnetsResponse, error) {
+	client, err := p.initClient(req.GetRuntimeId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	subnets, err := client.ListSubnets(req.GetSubnetId()...)
+	if err != nil {
+		p.Logger.Error("DescribeSubnets to %s failed: %+v", MyProvider, err)
+		return nil, err
+	}
+
+	if len(subnets) == 0 {
+		p.Logger.Error("Send DescribeSubnets to %s failed with 0 output subnets", MyProvider)
+		return nil, fmt.Errorf("send DescribeSubnets to %s failed with 0 output subnets", MyProvider)
+	}
+
+	response := new(pb.DescribeSubnetsResponse)
+
+	networks := make(map[string]*Network)
+	for _, sn := range subnets {
+		network, ok := networks[sn.NetworkId]
+		if !ok {
+			network, err = client.GetNetwork(sn.NetworkId)
+			if err != nil {
+				p.Logger.Error("DescribeNetworks to %s failed: %+v", MyProvider, err)
+				return nil, err
+			}
+			networks[sn.NetworkId] = network
+		}
+
+		zone := p.getAvailabilityZone(network)
+		if len(req.GetZone()) > 0 && !stringutil.StringIn(zone, req.GetZone()) {
+			continue
+		}
+
+		subnet := &pb.Subnet{
+			SubnetId: pbutil.ToProtoString(sn.Id),
+			Name:     pbutil.ToProtoString(sn.Name),
+			VpcId:    pbutil.ToProtoString(sn.NetworkId),
+			Zone:     pbutil.ToProtoString(zone),
+		}
+		response.SubnetSet = append(response.SubnetSet, subnet)
+	}
+
+	response.TotalCount = uint32(len(response.SubnetSet))
+
+	return response, nil
+}
+
+func (p *ProviderHandler) CheckResourceQuotas(ctx context.Context, clusterWrapper *models.ClusterWrapper) error {
+	roleCount := make(map[string]int)
+	for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		roleCount[clusterNode.Role]++
+	}
+
+	needQuotas := models.NewQuotas()
+	needQuotas.Instance.Name = ResourceTypeInstance
+	needQuotas.Cpu.Name = ResourceTypeCpu
+	needQuotas.Gpu.Name = ResourceTypeGpu
+	needQuotas.Memory.Name = ResourceTypeMemory
+	needQuotas.Volume.Name = ResourceTypeVolume
+	needQuotas.VolumeSize.Name = ResourceTypeVolumeSize
+	for role, count := range roleCount {
+		clusterRole, ok := clusterWrapper.ClusterRoles[role]
+		if !ok {
+			continue
+		}
+		needQuotas.Instance.Count += count
+		needQuotas.Cpu.Count += int(clusterRole.Cpu) * count
+		needQuotas.Gpu.Count += int(clusterRole.Gpu) * count
+		needQuotas.Memory.Count += int(clusterRole.Memory) * count
+		if clusterRole.StorageSize > 0 {
+			needQuotas.Volume.Count += count
+			needQuotas.VolumeSize.Count += int(clusterRole.StorageSize) * count
+		}
+	}
+
+	leftQuotas, err := p.DescribeQuotaLeft(clusterWrapper.Cluster.RuntimeId)
+	if err != nil {
+		return err
+	}
+
+	err = needQuotas.LessThan(leftQuotas)
+	if err != nil {
+		p.Logger.Error("[%s] quota not enough: %+v", MyProvider, err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *ProviderHandler) DescribeQuotaLeft(runtimeId string) (*models.Quotas, error) {
+	client, err := p.initClient(runtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := client.GetLimits()
+	if err != nil {
+		p.Logger.Error("GetLimits to %s failed: %+v", MyProvider, err)
+		return nil, err
+	}
+
+	leftQuotas := models.NewQuotas()
+	leftQuotas.Instance.Name = ResourceTypeInstance
+	leftQuotas.Instance.Count = getQuotaLeft(limits.MaxTotalInstances, limits.TotalInstancesUsed)
+	leftQuotas.Cpu.Name = ResourceTypeCpu
+	leftQuotas.Cpu.Count = getQuotaLeft(limits.MaxTotalCores, limits.TotalCoresUsed)
+	// nova has no quota of gpu, it is decided by the flavors
+	leftQuotas.Gpu.Name = ResourceTypeGpu
+	leftQuotas.Gpu.Count = models.UnlimitedQuota
+	leftQuotas.Memory.Name = ResourceTypeMemory
+	leftQuotas.Memory.Count = getQuotaLeft(limits.MaxTotalRAMSize, limits.TotalRAMUsed)
+	leftQuotas.Volume.Name = ResourceTypeVolume
+	leftQuotas.Volume.Count = getQuotaLeft(limits.MaxTotalVolumes, limits.TotalVolumesUsed)
+	leftQuotas.VolumeSize.Name = ResourceTypeVolumeSize
+	leftQuotas.VolumeSize.Count = getQuotaLeft(limits.MaxTotalVolumeGigabytes, limits.TotalGigabytesUsed)
+	return leftQuotas, nil
+}
+
+func (p *ProviderHandler) DescribeVpc(runtimeId, vpcId string) (*models.Vpc, error) {
+	client, err := p.initClient(runtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := client.GetNetwork(vpcId)
+	if err != nil {
+		p.Logger.Error("DescribeNetworks to %s failed: %+v", MyProvider, err)
+		return nil, err
+	}
+
+	status := network.Status
+	if status == NetworkStatusActive {
+		status = constants.StatusActive
+	}
+
+	return &models.Vpc{
+		VpcId:   network.Id,
+		Name:    network.Name,
+		Status:  status,
+		Subnets: network.Subnets,
+	}, nil
+}
+
+func (p *ProviderHandler) DescribeAvailabilityZones(url, credential, zone string) ([]string, error) {
+	client, err := NewClient(url, credential, zone)
+	if err != nil {
+		p.Logger.Error("Init %s api client failed: %+v", MyProvider, err)
+		return nil, err
+	}
+
+	zones, err := client.ListAvailabilityZones()
+	if err != nil {
+		p.Logger.Error("DescribeAvailabilityZones to %s failed: %+v", MyProvider, err)
+		return nil, err
+	}
+	return zones, nil
+}
+
+// DescribeZones returns the regions of the cloud.
+func (p *ProviderHandler) DescribeZones(url, credential string) ([]string, error) {
+	client, err := NewClient(url, credential, "")
+	if err != nil {
+		p.Logger.Error("Init %s api client failed: %+v", MyProvider, err)
+		return nil, err
+	}
+	return client.Regions(), nil
+}
+
+func (p *ProviderHandler) DescribeKeyPairs(url, credential, zone string) ([]string, error) {
+	client, err := NewClient(url, credential, zone)
+	if err != nil {
+		p.Logger.Error("Init %s api client failed: %+v", MyProvider, err)
+		return nil, err
+	}
+
+	keys, err := client.ListKeyPairs()
+	if err != nil {
+		p.Logger.Error("DescribeKeyPairs to %s failed: %+v", MyProvider, err)
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (p *ProviderHandler) DescribeImage(runtimeId, imageName string) (string, error) {
+	client, err := p.initClient(runtimeId)
+	if err != nil {
+		return "", err
+	}
+
+	images, err := client.ListImages(imageName)
+	if err != nil {
+		p.Logger.Error("DescribeImages to %s failed: %+v", MyProvider, err)
+		return "", err
+	}
+
+	if len(images) == 0 {
+		return "", fmt.Errorf("image with name [%s] not exist", imageName)
+	}
+
+	return images[0].Id, nil
+}
+
+func (p *ProviderHandler) DescribeAvailabilityZoneBySubnetId(runtimeId, subnetId string) (string, error) {
+	client, err := p.initClient(runtimeId)
+	if err != nil {
+		return "", err
+	}
+
+	subnets, err := client.ListSubnets(subnetId)
+	if err != nil {
+		p.Logger.Error("DescribeSubnets to %s failed: %+v", MyProvider, err)
+		return "", err
+	}
+
+	if len(subnets) == 0 {
+		return "", fmt.Errorf("subnet with id [%s] not exist", subnetId)
+	}
+
+	network, err := client.GetNetwork(subnets[0].NetworkId)
+	if err != nil {
+		p.Logger.Error("DescribeNetworks to %s failed: %+v", MyProvider, err)
+		return "", err
+	}
+
+	return p.getAvailabilityZone(network), nil
+}
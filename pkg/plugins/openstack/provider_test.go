@@ -0,0 +1,388 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+const (
+	testRuntimeId  = "runtime-openstack"
+	testRegion     = "RegionOne"
+	testToken      = "token-openstack"
+	testCredential = `{"user_name":"admin","password":"password","project_name":"admin"}`
+	testNetworkId  = "net-1"
+	testSubnetId   = "subnet-1"
+)
+
+// cloud is a stand-in of the apis of keystone, nova, cinder, neutron and
+// glance. The servers and volumes reach the final status at the next GET.
+type cloud struct {
+	*httptest.Server
+	sync.Mutex
+	nextId  int
+	servers map[string]*Server
+	volumes map[string]*Volume
+	pending map[string]string // key=resource id, value=status at the next GET
+	limits  Limits
+}
+
+func newCloud() *cloud {
+	c := &cloud{
+		servers: make(map[string]*Server),
+		volumes: make(map[string]*Volume),
+		pending: make(map[string]string),
+		limits: Limits{
+			MaxTotalCores:           20,
+			MaxTotalInstances:       10,
+			MaxTotalRAMSize:         51200,
+			MaxTotalVolumes:         -1,
+			MaxTotalVolumeGigabytes: -1,
+		},
+	}
+	c.Server = httptest.NewServer(http.HandlerFunc(c.serveHTTP))
+	return c
+}
+
+func (c *cloud) newId(prefix string) string {
+	c.nextId++
+	return fmt.Sprintf("%s-%d", prefix, c.nextId)
+}
+
+func (c *cloud) write(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+func (c *cloud) catalog() []catalogEntry {
+	var entries []catalogEntry
+	for serviceType, path := range map[string]string{
+		"compute": "/compute", "volumev3": "/volume", "network": "/network", "image": "/image",
+	} {
+		entries = append(entries, catalogEntry{
+			Type: serviceType,
+			Endpoints: []endpoint{
+				{Interface: "internal", RegionId: testRegion, Url: "http://internal.invalid"},
+				{Interface: EndpointInterface, RegionId: testRegion, Url: c.URL + path},
+			},
+		})
+	}
+	return entries
+}
+
+func (c *cloud) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+
+	var body map[string]map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&body)
+
+	if r.URL.Path == "/identity/v3/auth/tokens" {
+		password := body["auth"]["identity"].(map[string]interface{})["password"].(map[string]interface{})
+		if password["user"].(map[string]interface{})["password"] != "password" {
+			c.write(w, http.StatusUnauthorized, nil)
+			return
+		}
+		w.Header().Set("X-Subject-Token", testToken)
+		c.write(w, http.StatusCreated, map[string]interface{}{
+			"token": map[string]interface{}{"catalog": c.catalog()},
+		})
+		return
+	}
+	if r.Header.Get("X-Auth-Token") != testToken {
+		c.write(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	route := r.Method + " " + parts[0] + "/" + parts[1]
+	if len(parts) > 2 {
+		route += "/*"
+	}
+	if len(parts) > 3 {
+		route += "/" + parts[3]
+	}
+	switch route {
+	case "GET compute/flavors/*":
+		c.write(w, http.StatusOK, map[string]interface{}{"flavors": testFlavors})
+	case "GET compute/os-availability-zone":
+		c.write(w, http.StatusOK, map[string]interface{}{"availabilityZoneInfo": []map[string]interface{}{
+			{"zoneName": DefaultAvailabilityZone, "zoneState": map[string]bool{"available": true}},
+			{"zoneName": "az-maintenance", "zoneState": map[string]bool{"available": false}},
+		}})
+	case "GET compute/limits", "GET volume/limits":
+		c.write(w, http.StatusOK, map[string]interface{}{"limits": map[string]interface{}{"absolute": c.limits}})
+	case "POST compute/servers":
+		server := body["server"]
+		if server["flavorRef"] != "3" || server["user_data"] != "dXNlcmRhdGE=" {
+			c.write(w, http.StatusBadRequest, map[string]string{"message": "unexpected server"})
+			return
+		}
+		id := c.newId("server")
+		c.servers[id] = &Server{Id: id, Name: server["name"].(string), Status: "BUILD"}
+		c.pending[id] = ServerStatusActive
+		c.write(w, http.StatusAccepted, map[string]interface{}{"server": map[string]string{"id": id}})
+	case "GET compute/servers/*":
+		server, ok := c.servers[parts[2]]
+		if !ok {
+			c.write(w, http.StatusNotFound, nil)
+			return
+		}
+		if status, ok := c.pending[server.Id]; ok {
+			server.Status = status
+			server.Addresses = map[string][]Address{
+				"private": {{Addr: "192.168.0.10", Type: AddressTypeFixed, Version: 4}},
+			}
+			delete(c.pending, server.Id)
+		}
+		c.write(w, http.StatusOK, map[string]interface{}{"server": server})
+	case "DELETE compute/servers/*":
+		delete(c.servers, parts[2])
+		c.write(w, http.StatusNoContent, nil)
+	case "POST compute/servers/*/action":
+		server := c.servers[parts[2]]
+		if _, ok := body["os-stop"]; ok {
+			c.pending[server.Id] = ServerStatusShutoff
+		} else {
+			c.pending[server.Id] = ServerStatusActive
+		}
+		c.write(w, http.StatusAccepted, nil)
+	case "POST compute/servers/*/os-volume_attachments":
+		server := c.servers[parts[2]]
+		volume := c.volumes[body["volumeAttachment"]["volumeId"].(string)]
+		volume.Status = constants.StatusInUse
+		volume.Attachments = append(volume.Attachments[:0], struct {
+			ServerId string `json:"server_id"`
+			Device   string `json:"device"`
+		}{server.Id, "/dev/vdb"})
+		server.VolumesAttached = append(server.VolumesAttached, struct {
+			Id string `json:"id"`
+		}{volume.Id})
+		c.write(w, http.StatusOK, map[string]interface{}{"volumeAttachment": map[string]string{"device": "/dev/vdb"}})
+	case "DELETE compute/servers/*/os-volume_attachments":
+		server := c.servers[parts[2]]
+		server.VolumesAttached = nil
+		volume := c.volumes[parts[4]]
+		volume.Status = constants.StatusAvailable
+		volume.Attachments = nil
+		c.write(w, http.StatusAccepted, nil)
+	case "POST volume/volumes":
+		id := c.newId("volume")
+		c.volumes[id] = &Volume{Id: id, Size: int(body["volume"]["size"].(float64)), Status: constants.StatusCreating}
+		c.pending[id] = constants.StatusAvailable
+		c.write(w, http.StatusAccepted, map[string]interface{}{"volume": c.volumes[id]})
+	case "GET volume/volumes/*":
+		volume, ok := c.volumes[parts[2]]
+		if !ok {
+			c.write(w, http.StatusNotFound, nil)
+			return
+		}
+		if status, ok := c.pending[volume.Id]; ok {
+			volume.Status = status
+			delete(c.pending, volume.Id)
+		}
+		c.write(w, http.StatusOK, map[string]interface{}{"volume": volume})
+	case "DELETE volume/volumes/*":
+		if _, ok := c.volumes[parts[2]]; !ok {
+			c.write(w, http.StatusNotFound, nil)
+			return
+		}
+		delete(c.volumes, parts[2])
+		c.write(w, http.StatusAccepted, nil)
+	case "GET network/v2.0/*", "GET network/v2.0/*/" + testNetworkId:
+		switch parts[2] {
+		case "subnets":
+			subnets := []Subnet{}
+			for _, id := range r.URL.Query()["id"] {
+				if id == testSubnetId {
+					subnets = append(subnets, Subnet{Id: testSubnetId, Name: "default", NetworkId: testNetworkId})
+				}
+			}
+			c.write(w, http.StatusOK, map[string]interface{}{"subnets": subnets})
+		case "networks":
+			c.write(w, http.StatusOK, map[string]interface{}{"network": Network{
+				Id: testNetworkId, Name: "private", Status: NetworkStatusActive, Subnets: []string{testSubnetId},
+			}})
+		}
+	case "GET image/v2/*":
+		c.write(w, http.StatusOK, map[string]interface{}{"images": []Image{
+			{Id: "img-" + r.URL.Query().Get("name"), Name: r.URL.Query().Get("name")},
+		}})
+	default:
+		c.write(w, http.StatusNotFound, nil)
+	}
+}
+
+func setCloud(c *cloud) {
+	getRuntime = func(runtimeId string) (*runtimeclient.Runtime, error) {
+		runtime := &runtimeclient.Runtime{Credential: testCredential}
+		runtime.RuntimeId = runtimeId
+		runtime.RuntimeUrl = c.URL + "/identity"
+		runtime.Zone = testRegion
+		return runtime, nil
+	}
+}
+
+func newTask(action string, directive interface{}) *models.Task {
+	return &models.Task{
+		TaskId:     "t-" + action,
+		JobId:      "j-openstack",
+		TaskAction: action,
+		Target:     constants.ProviderOpenStack,
+		Directive:  jsonutil.ToString(directive),
+	}
+}
+
+func runTask(t *testing.T, p *Provider, task *models.Task) {
+	err := p.HandleSubtask(task)
+	if err != nil {
+		t.Fatalf("handle task [%s] failed: %+v", task.TaskAction, err)
+	}
+	err = p.WaitSubtask(task, time.Minute, time.Second)
+	if err != nil {
+		t.Fatalf("wait task [%s] failed: %+v", task.TaskAction, err)
+	}
+}
+
+func TestClusterLifecycle(t *testing.T) {
+	c := newCloud()
+	defer c.Close()
+	setCloud(c)
+	p := NewProvider(logger.NewLogger())
+
+	subnets, err := p.DescribeSubnets(context.Background(), &pb.DescribeSubnetsRequest{
+		RuntimeId: pbutil.ToProtoString(testRuntimeId),
+		SubnetId:  []string{testSubnetId},
+		Zone:      []string{DefaultAvailabilityZone},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subnets.TotalCount != 1 || subnets.SubnetSet[0].GetVpcId().GetValue() != testNetworkId {
+		t.Fatalf("expect subnet of network [%s], got %+v", testNetworkId, subnets.SubnetSet)
+	}
+	vpc, err := p.DescribeVpc(testRuntimeId, testNetworkId)
+	if err != nil || vpc.Status != constants.StatusActive {
+		t.Fatalf("vpc should be active, got %+v %+v", vpc, err)
+	}
+	imageId, err := GetProviderHandler(nil).DescribeImage(testRuntimeId, "ubuntu")
+	if err != nil || imageId != "img-ubuntu" {
+		t.Fatalf("expect image [img-ubuntu], got [%s] %+v", imageId, err)
+	}
+
+	task := newTask(vmbased.ActionCreateVolumes, &models.Volume{RuntimeId: testRuntimeId, Size: 10})
+	runTask(t, p, task)
+	volume, _ := models.NewVolume(task.Directive)
+
+	task = newTask(vmbased.ActionRunInstances, &models.Instance{
+		RuntimeId:     testRuntimeId,
+		Name:          "node-1",
+		ImageId:       imageId,
+		Cpu:           2,
+		Memory:        4096,
+		Subnet:        testSubnetId,
+		Zone:          DefaultAvailabilityZone,
+		VolumeId:      volume.VolumeId,
+		NeedUserData:  1,
+		UserDataValue: "dXNlcmRhdGE=",
+	})
+	runTask(t, p, task)
+	instance, _ := models.NewInstance(task.Directive)
+	if instance.InstanceId == "" || instance.PrivateIp != "192.168.0.10" || instance.Device != "/dev/vdb" {
+		t.Fatalf("instance not ready: %s", task.Directive)
+	}
+
+	volume.InstanceId = instance.InstanceId
+	runTask(t, p, newTask(vmbased.ActionDetachVolumes, volume))
+	runTask(t, p, newTask(vmbased.ActionAttachVolumes, volume))
+	runTask(t, p, newTask(vmbased.ActionStopInstances, instance))
+	if c.servers[instance.InstanceId].Status != ServerStatusShutoff {
+		t.Fatalf("instance should be stopped")
+	}
+	// the instance that is stopped already is skipped
+	runTask(t, p, newTask(vmbased.ActionStopInstances, instance))
+	runTask(t, p, newTask(vmbased.ActionStartInstances, instance))
+	runTask(t, p, newTask(vmbased.ActionDetachVolumes, volume))
+	runTask(t, p, newTask(vmbased.ActionTerminateInstances, instance))
+	runTask(t, p, newTask(vmbased.ActionDeleteVolumes, volume))
+	if len(c.servers) != 0 || len(c.volumes) != 0 {
+		t.Fatalf("resources should be deleted, got %d servers %d volumes", len(c.servers), len(c.volumes))
+	}
+	// the deleted resources are skipped
+	runTask(t, p, newTask(vmbased.ActionTerminateInstances, instance))
+	runTask(t, p, newTask(vmbased.ActionDeleteVolumes, volume))
+}
+
+func TestCheckResource(t *testing.T) {
+	c := newCloud()
+	defer c.Close()
+	setCloud(c)
+	p := NewProvider(logger.NewLogger())
+
+	clusterWrapper := &models.ClusterWrapper{
+		Cluster: &models.Cluster{RuntimeId: testRuntimeId},
+		ClusterNodesWithKeyPairs: map[string]*models.ClusterNodeWithKeyPairs{
+			"cln-1": {ClusterNode: &models.ClusterNode{Role: "master"}},
+			"cln-2": {ClusterNode: &models.ClusterNode{Role: "worker"}},
+			"cln-3": {ClusterNode: &models.ClusterNode{Role: "worker"}},
+		},
+		ClusterRoles: map[string]*models.ClusterRole{
+			"master": {Role: "master", Cpu: 2, Memory: 4096, StorageSize: 10},
+			"worker": {Role: "worker", Cpu: 4, Memory: 8192, StorageSize: 100},
+		},
+	}
+	err := p.CheckResource(context.Background(), clusterWrapper)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.limits.TotalCoresUsed = 15
+	err = p.CheckResource(context.Background(), clusterWrapper)
+	if err == nil || !strings.Contains(err.Error(), ResourceTypeCpu) {
+		t.Fatalf("cores quota should not be enough, got %+v", err)
+	}
+}
+
+func TestValidateCredential(t *testing.T) {
+	c := newCloud()
+	defer c.Close()
+	p := NewProvider(logger.NewLogger())
+	url := c.URL + "/identity/v3/"
+
+	if err := p.ValidateCredential(url, testCredential, testRegion); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.ValidateCredential(url, testCredential, "RegionTwo"); err == nil {
+		t.Fatal("region should not be accessible")
+	}
+	if err := p.ValidateCredential(url, strings.Replace(testCredential, `"password":"password"`, `"password":"wrong"`, 1), ""); err == nil {
+		t.Fatal("password should be wrong")
+	}
+
+	zones, err := p.DescribeRuntimeProviderAvailabilityZones(url, testCredential, testRegion)
+	if err != nil || len(zones) != 1 || zones[0] != DefaultAvailabilityZone {
+		t.Fatalf("expect zone [%s], got %v %+v", DefaultAvailabilityZone, zones, err)
+	}
+}
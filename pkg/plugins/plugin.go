@@ -9,13 +9,9 @@ import (
 	"fmt"
 	"time"
 
-	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
-	"openpitrix.io/openpitrix/pkg/plugins/aws"
-	"openpitrix.io/openpitrix/pkg/plugins/helm"
-	"openpitrix.io/openpitrix/pkg/plugins/qingcloud"
 )
 
 type ProviderInterface interface {
@@ -32,20 +28,34 @@ type ProviderInterface interface {
 	UpdateClusterStatus(job *models.Job) error
 }
 
+// QuotaInterface is implemented by the providers that report the quotas left
+// in the runtime, e.g. qingcloud and openstack.
+type QuotaInterface interface {
+	DescribeQuotaLeft(runtimeId string) (*models.Quotas, error)
+}
+
+// NetworkInterface is implemented by the vm based providers that provision the
+// vpc, subnet and eips of the clusters, e.g. qingcloud and aws. vpcCount is the
+// count of vpcs to create and eipCount is the count of eips of the nodes.
+type NetworkInterface interface {
+	CheckNetworkQuota(runtimeId string, vpcCount, eipCount int) error
+}
+
+// LoadbalancerInterface is implemented by the vm based providers that keep the
+// nodes of the roles in sync with their loadbalancer listeners.
+type LoadbalancerInterface interface {
+	CheckLoadbalancerListeners(runtimeId string, listenerIds []string) error
+}
+
 func GetProviderPlugin(provider string, l *logger.Logger) (ProviderInterface, error) {
-	var providerInterface ProviderInterface
 	if l == nil {
 		l = logger.NewLogger()
 	}
-	switch provider {
-	case constants.ProviderQingCloud:
-		providerInterface = qingcloud.NewProvider(l)
-	case constants.ProviderKubernetes:
-		providerInterface = helm.NewProvider(l)
-	case constants.ProviderAWS:
-		providerInterface = aws.NewProvider(l)
-	default:
-		return nil, fmt.Errorf("No such provider [%s]. ", provider)
+	if p, ok := getRegistered(provider); ok {
+		return p.factory(l), nil
+	}
+	if endpoint, ok := getPluginEndpoint(provider); ok {
+		return NewRemoteProvider(provider, endpoint, l)
 	}
-	return providerInterface, nil
+	return nil, fmt.Errorf("No such provider [%s]. ", provider)
 }
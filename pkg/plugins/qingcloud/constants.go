@@ -13,10 +13,21 @@ const (
 
 	DefaultUserDataType = "exec"
 
+	DefaultRouterType     = 1
+	DefaultVxNetType      = 1
+	DefaultIpNetwork      = "192.168.100.0/24"
+	DefaultEipBandwidth   = 10
+	DefaultEipBillingMode = "traffic"
+
+	DefaultLoadbalancerBackendLimit = 100
+
 	ResourceTypeInstance   = "hp_instance"
 	ResourceTypeCpu        = "hp_cpu"
 	ResourceTypeGpu        = "gpu_passthrough"
 	ResourceTypeMemory     = "hp_memory"
 	ResourceTypeVolume     = "hpp_volume"
 	ResourceTypeVolumeSize = "hpp_volume_size"
+	ResourceTypeRouter     = "router"
+	ResourceTypeVxNet      = "vxnet"
+	ResourceTypeEip        = "eip"
 )
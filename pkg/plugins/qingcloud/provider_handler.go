@@ -915,12 +915,27 @@ func (p *ProviderHandler) CheckResourceQuotas(ctx context.Context, clusterWrappe
 		needQuotas.VolumeSize.Count += int(clusterRole.StorageSize) * count
 	}
 
-	qingcloudService, err := p.initService(clusterWrapper.Cluster.RuntimeId)
+	leftQuotas, err := p.DescribeQuotaLeft(clusterWrapper.Cluster.RuntimeId)
 	if err != nil {
-		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
 		return err
 	}
 
+	err = needQuotas.LessThan(leftQuotas)
+	if err != nil {
+		p.Logger.Error("[%s] quota not enough: %+v", MyProvider, err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *ProviderHandler) DescribeQuotaLeft(runtimeId string) (*models.Quotas, error) {
+	qingcloudService, err := p.initService(runtimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return nil, err
+	}
+
 	resourceTypes := []string{ResourceTypeInstance, ResourceTypeCpu, ResourceTypeGpu, ResourceTypeMemory,
 		ResourceTypeVolume, ResourceTypeVolumeSize}
 	var qcResourceTypes []*string
@@ -932,7 +947,7 @@ func (p *ProviderHandler) CheckResourceQuotas(ctx context.Context, clusterWrappe
 	miscService, err := qingcloudService.Misc()
 	if err != nil {
 		p.Logger.Error("Init %s misc api service failed: %+v", MyProvider, err)
-		return err
+		return nil, err
 	}
 	output, err := miscService.GetQuotaLeft(&qcservice.GetQuotaLeftInput{
 		ResourceTypes: qcResourceTypes,
@@ -940,7 +955,7 @@ func (p *ProviderHandler) CheckResourceQuotas(ctx context.Context, clusterWrappe
 	})
 	if err != nil {
 		p.Logger.Error("GetQuotaLeft to %s failed: %+v", MyProvider, err)
-		return err
+		return nil, err
 	}
 
 	retCode := qcservice.IntValue(output.RetCode)
@@ -948,7 +963,7 @@ func (p *ProviderHandler) CheckResourceQuotas(ctx context.Context, clusterWrappe
 		message := qcservice.StringValue(output.Message)
 		p.Logger.Error("Send GetQuotaLeft to %s failed with return code [%d], message [%s]",
 			MyProvider, retCode, message)
-		return fmt.Errorf("send GetQuotaLeft to %s failed: %s", MyProvider, message)
+		return nil, fmt.Errorf("send GetQuotaLeft to %s failed: %s", MyProvider, message)
 	}
 
 	leftQuotas := models.NewQuotas()
@@ -977,13 +992,7 @@ func (p *ProviderHandler) CheckResourceQuotas(ctx context.Context, clusterWrappe
 		}
 	}
 
-	err = needQuotas.LessThan(leftQuotas)
-	if err != nil {
-		p.Logger.Error("[%s] quota not enough: %+v", MyProvider, err)
-		return err
-	}
-
-	return nil
+	return leftQuotas, nil
 }
 
 func (p *ProviderHandler) DescribeVpc(runtimeId, vpcId string) (*models.Vpc, error) {
@@ -1076,3 +1085,911 @@ func (p *ProviderHandler) DescribeZones(url, credential string) ([]string, error
 	}
 	return zones, nil
 }
+
+func (p *ProviderHandler) waitJob(qingcloudService *qcservice.QingCloudService, jobId string, timeout time.Duration) error {
+	jobService, err := qingcloudService.Job(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s job api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	err = qcclient.WaitJob(jobService, jobId, timeout, constants.WaitTaskInterval)
+	if err != nil {
+		p.Logger.Error("Wait %s job [%s] failed: %+v", MyProvider, jobId, err)
+		return err
+	}
+	return nil
+}
+
+func (p *ProviderHandler) describeEipAddr(eipService *qcservice.EIPService, eipId string) (string, error) {
+	output, err := eipService.DescribeEIPs(
+		&qcservice.DescribeEIPsInput{
+			EIPs: qcservice.StringSlice([]string{eipId}),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("DescribeEIPs to %s failed: %+v", MyProvider, err)
+		return "", err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send DescribeEIPs to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return "", fmt.Errorf("send DescribeEIPs to %s failed: %s", MyProvider, message)
+	}
+
+	if len(output.EIPSet) == 0 {
+		return "", fmt.Errorf("eip [%s] not found", eipId)
+	}
+	return qcservice.StringValue(output.EIPSet[0].EIPAddr), nil
+}
+
+func (p *ProviderHandler) allocateEip(eipService *qcservice.EIPService, name string) (string, error) {
+	output, err := eipService.AllocateEIPs(
+		&qcservice.AllocateEIPsInput{
+			Bandwidth:   qcservice.Int(DefaultEipBandwidth),
+			BillingMode: qcservice.String(DefaultEipBillingMode),
+			EIPName:     qcservice.String(name),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send AllocateEIPs to %s failed: %+v", MyProvider, err)
+		return "", err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send AllocateEIPs to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return "", fmt.Errorf("send AllocateEIPs to %s failed: %s", MyProvider, message)
+	}
+	return qcservice.StringValue(output.EIPs[0]), nil
+}
+
+func (p *ProviderHandler) releaseEip(eipService *qcservice.EIPService, eipId string) (string, error) {
+	output, err := eipService.ReleaseEIPs(
+		&qcservice.ReleaseEIPsInput{
+			EIPs: qcservice.StringSlice([]string{eipId}),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send ReleaseEIPs to %s failed: %+v", MyProvider, err)
+		return "", err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send ReleaseEIPs to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return "", fmt.Errorf("send ReleaseEIPs to %s failed: %s", MyProvider, message)
+	}
+	return qcservice.StringValue(output.JobID), nil
+}
+
+// CreateNetwork creates a router with a vxnet joined and an eip bound, which
+// are the vpc and subnet of qingcloud. The created resources are deleted on
+// failure, since neither the directive of the failed task is saved nor the
+// post processor writing them to the cluster is run.
+func (p *ProviderHandler) CreateNetwork(task *models.Task) (err error) {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+
+	network, err := models.NewNetwork(task.Directive)
+	if err != nil {
+		return err
+	}
+	timeout := task.GetTimeout(constants.WaitTaskTimeout)
+
+	qingcloudService, err := p.initService(network.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	routerService, err := qingcloudService.Router(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s router api service failed: %+v", MyProvider, err)
+		return err
+	}
+	vxnetService, err := qingcloudService.VxNet(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s vxnet api service failed: %+v", MyProvider, err)
+		return err
+	}
+	eipService, err := qingcloudService.EIP(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s eip api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	joined := false
+	defer func() {
+		if err == nil || network.VpcId == "" {
+			return
+		}
+		p.rollbackNetwork(task, vxnetService, *network, joined)
+	}()
+
+	routerOutput, err := routerService.CreateRouters(
+		&qcservice.CreateRoutersInput{
+			RouterName: qcservice.String(network.Name),
+			RouterType: qcservice.Int(DefaultRouterType),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send CreateRouters to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	retCode := qcservice.IntValue(routerOutput.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(routerOutput.Message)
+		p.Logger.Error("Send CreateRouters to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send CreateRouters to %s failed: %s", MyProvider, message)
+	}
+	network.VpcId = qcservice.StringValue(routerOutput.Routers[0])
+
+	err = p.waitJob(qingcloudService, qcservice.StringValue(routerOutput.JobID), timeout)
+	if err != nil {
+		return err
+	}
+
+	vxnetOutput, err := vxnetService.CreateVxNets(
+		&qcservice.CreateVxNetsInput{
+			VxNetName: qcservice.String(network.Name),
+			VxNetType: qcservice.Int(DefaultVxNetType),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send CreateVxNets to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	retCode = qcservice.IntValue(vxnetOutput.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(vxnetOutput.Message)
+		p.Logger.Error("Send CreateVxNets to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send CreateVxNets to %s failed: %s", MyProvider, message)
+	}
+	network.SubnetId = qcservice.StringValue(vxnetOutput.VxNets[0])
+
+	joinOutput, err := routerService.JoinRouter(
+		&qcservice.JoinRouterInput{
+			Router:    qcservice.String(network.VpcId),
+			VxNet:     qcservice.String(network.SubnetId),
+			IPNetwork: qcservice.String(DefaultIpNetwork),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send JoinRouter to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	retCode = qcservice.IntValue(joinOutput.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(joinOutput.Message)
+		p.Logger.Error("Send JoinRouter to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send JoinRouter to %s failed: %s", MyProvider, message)
+	}
+	err = p.waitJob(qingcloudService, qcservice.StringValue(joinOutput.JobID), timeout)
+	if err != nil {
+		return err
+	}
+	joined = true
+
+	network.EipId, err = p.allocateEip(eipService, network.Name)
+	if err != nil {
+		return err
+	}
+
+	network.Eip, err = p.describeEipAddr(eipService, network.EipId)
+	if err != nil {
+		return err
+	}
+
+	modifyOutput, err := routerService.ModifyRouterAttributes(
+		&qcservice.ModifyRouterAttributesInput{
+			Router: qcservice.String(network.VpcId),
+			EIP:    qcservice.String(network.EipId),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send ModifyRouterAttributes to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	retCode = qcservice.IntValue(modifyOutput.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(modifyOutput.Message)
+		p.Logger.Error("Send ModifyRouterAttributes to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send ModifyRouterAttributes to %s failed: %s", MyProvider, message)
+	}
+
+	updateOutput, err := routerService.UpdateRouters(
+		&qcservice.UpdateRoutersInput{
+			Routers: qcservice.StringSlice([]string{network.VpcId}),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send UpdateRouters to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	retCode = qcservice.IntValue(updateOutput.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(updateOutput.Message)
+		p.Logger.Error("Send UpdateRouters to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send UpdateRouters to %s failed: %s", MyProvider, message)
+	}
+	network.TargetJobId = qcservice.StringValue(updateOutput.JobID)
+
+	// write back
+	task.Directive = jsonutil.ToString(network)
+
+	return nil
+}
+
+func (p *ProviderHandler) deleteVxNet(vxnetService *qcservice.VxNetService, vxnetId string) error {
+	output, err := vxnetService.DeleteVxNets(
+		&qcservice.DeleteVxNetsInput{
+			VxNets: qcservice.StringSlice([]string{vxnetId}),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send DeleteVxNets to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send DeleteVxNets to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send DeleteVxNets to %s failed: %s", MyProvider, message)
+	}
+	return nil
+}
+
+// rollbackNetwork deletes the resources created by the failed CreateNetwork,
+// the vxnet not joined to the router is deleted alone.
+func (p *ProviderHandler) rollbackNetwork(task *models.Task, vxnetService *qcservice.VxNetService, network models.Network, joined bool) {
+	p.Logger.Warn("Roll back network [%s] created by task [%s]", network.VpcId, task.TaskId)
+	if !joined && network.SubnetId != "" {
+		err := p.deleteVxNet(vxnetService, network.SubnetId)
+		if err != nil {
+			p.Logger.Error("Roll back vxnet [%s] failed: %+v", network.SubnetId, err)
+		}
+		network.SubnetId = ""
+	}
+	rollbackTask := *task
+	rollbackTask.Directive = jsonutil.ToString(network)
+	err := p.DeleteNetwork(&rollbackTask)
+	if err != nil {
+		p.Logger.Error("Roll back network [%s] failed: %+v", network.VpcId, err)
+	}
+}
+
+// DeleteNetwork deletes the router, the vxnet and the eip created by
+// CreateNetwork.
+func (p *ProviderHandler) DeleteNetwork(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+
+	network, err := models.NewNetwork(task.Directive)
+	if err != nil {
+		return err
+	}
+	timeout := task.GetTimeout(constants.WaitTaskTimeout)
+
+	qingcloudService, err := p.initService(network.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	routerService, err := qingcloudService.Router(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s router api service failed: %+v", MyProvider, err)
+		return err
+	}
+	vxnetService, err := qingcloudService.VxNet(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s vxnet api service failed: %+v", MyProvider, err)
+		return err
+	}
+	eipService, err := qingcloudService.EIP(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s eip api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	if network.SubnetId != "" {
+		leaveOutput, err := routerService.LeaveRouter(
+			&qcservice.LeaveRouterInput{
+				Router: qcservice.String(network.VpcId),
+				VxNets: qcservice.StringSlice([]string{network.SubnetId}),
+			},
+		)
+		if err != nil {
+			p.Logger.Error("Send LeaveRouter to %s failed: %+v", MyProvider, err)
+			return err
+		}
+		retCode := qcservice.IntValue(leaveOutput.RetCode)
+		if retCode != 0 {
+			message := qcservice.StringValue(leaveOutput.Message)
+			p.Logger.Error("Send LeaveRouter to %s failed with return code [%d], message [%s]",
+				MyProvider, retCode, message)
+			return fmt.Errorf("send LeaveRouter to %s failed: %s", MyProvider, message)
+		}
+		err = p.waitJob(qingcloudService, qcservice.StringValue(leaveOutput.JobID), timeout)
+		if err != nil {
+			return err
+		}
+
+		err = p.deleteVxNet(vxnetService, network.SubnetId)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the eip is dissociated from the router when the router is deleted
+	routerOutput, err := routerService.DeleteRouters(
+		&qcservice.DeleteRoutersInput{
+			Routers: qcservice.StringSlice([]string{network.VpcId}),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send DeleteRouters to %s failed: %+v", MyProvider, err)
+		return err
+	}
+	retCode := qcservice.IntValue(routerOutput.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(routerOutput.Message)
+		p.Logger.Error("Send DeleteRouters to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send DeleteRouters to %s failed: %s", MyProvider, message)
+	}
+	network.TargetJobId = qcservice.StringValue(routerOutput.JobID)
+
+	if network.EipId != "" {
+		err = p.waitJob(qingcloudService, network.TargetJobId, timeout)
+		if err != nil {
+			return err
+		}
+		network.TargetJobId, err = p.releaseEip(eipService, network.EipId)
+		if err != nil {
+			return err
+		}
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(network)
+
+	return nil
+}
+
+func (p *ProviderHandler) WaitNetworkTask(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	network, err := models.NewNetwork(task.Directive)
+	if err != nil {
+		return err
+	}
+	if network.TargetJobId == "" {
+		p.Logger.Warn("Skip task without target job id")
+		return nil
+	}
+	qingcloudService, err := p.initService(network.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	return p.waitJob(qingcloudService, network.TargetJobId, task.GetTimeout(constants.WaitTaskTimeout))
+}
+
+func (p *ProviderHandler) WaitCreateNetwork(task *models.Task) error {
+	return p.WaitNetworkTask(task)
+}
+
+func (p *ProviderHandler) WaitDeleteNetwork(task *models.Task) error {
+	return p.WaitNetworkTask(task)
+}
+
+func (p *ProviderHandler) AllocateEips(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+
+	nodeEip, err := models.NewNodeEip(task.Directive)
+	if err != nil {
+		return err
+	}
+
+	qingcloudService, err := p.initService(nodeEip.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	eipService, err := qingcloudService.EIP(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s eip api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	nodeEip.EipId, err = p.allocateEip(eipService, nodeEip.Name)
+	if err != nil {
+		return err
+	}
+	task.Directive = jsonutil.ToString(nodeEip)
+
+	nodeEip.Eip, err = p.describeEipAddr(eipService, nodeEip.EipId)
+	if err != nil {
+		return err
+	}
+
+	output, err := eipService.AssociateEIP(
+		&qcservice.AssociateEIPInput{
+			EIP:      qcservice.String(nodeEip.EipId),
+			Instance: qcservice.String(nodeEip.InstanceId),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send AssociateEIP to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send AssociateEIP to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send AssociateEIP to %s failed: %s", MyProvider, message)
+	}
+	nodeEip.TargetJobId = qcservice.StringValue(output.JobID)
+
+	// write back
+	task.Directive = jsonutil.ToString(nodeEip)
+
+	return nil
+}
+
+func (p *ProviderHandler) ReleaseEips(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+
+	nodeEip, err := models.NewNodeEip(task.Directive)
+	if err != nil {
+		return err
+	}
+
+	qingcloudService, err := p.initService(nodeEip.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	eipService, err := qingcloudService.EIP(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s eip api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	output, err := eipService.DissociateEIPs(
+		&qcservice.DissociateEIPsInput{
+			EIPs: qcservice.StringSlice([]string{nodeEip.EipId}),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send DissociateEIPs to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send DissociateEIPs to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send DissociateEIPs to %s failed: %s", MyProvider, message)
+	}
+	err = p.waitJob(qingcloudService, qcservice.StringValue(output.JobID), task.GetTimeout(constants.WaitTaskTimeout))
+	if err != nil {
+		return err
+	}
+
+	nodeEip.TargetJobId, err = p.releaseEip(eipService, nodeEip.EipId)
+	if err != nil {
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(nodeEip)
+
+	return nil
+}
+
+func (p *ProviderHandler) WaitEipTask(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	nodeEip, err := models.NewNodeEip(task.Directive)
+	if err != nil {
+		return err
+	}
+	if nodeEip.TargetJobId == "" {
+		p.Logger.Warn("Skip task without target job id")
+		return nil
+	}
+	qingcloudService, err := p.initService(nodeEip.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	return p.waitJob(qingcloudService, nodeEip.TargetJobId, task.GetTimeout(constants.WaitTaskTimeout))
+}
+
+func (p *ProviderHandler) WaitAllocateEips(task *models.Task) error {
+	return p.WaitEipTask(task)
+}
+
+func (p *ProviderHandler) WaitReleaseEips(task *models.Task) error {
+	return p.WaitEipTask(task)
+}
+
+// CheckNetworkQuota checks the quotas left for vpcCount routers with vxnets
+// and eips, and eipCount more eips of the nodes.
+func (p *ProviderHandler) CheckNetworkQuota(runtimeId string, vpcCount, eipCount int) error {
+	qingcloudService, err := p.initService(runtimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	needQuotas := map[string]int{
+		ResourceTypeRouter: vpcCount,
+		ResourceTypeVxNet:  vpcCount,
+		ResourceTypeEip:    vpcCount + eipCount,
+	}
+	var qcResourceTypes []*string
+	for resourceType := range needQuotas {
+		qcResourceTypes = append(qcResourceTypes, qcservice.String(resourceType))
+	}
+
+	miscService, err := qingcloudService.Misc()
+	if err != nil {
+		p.Logger.Error("Init %s misc api service failed: %+v", MyProvider, err)
+		return err
+	}
+	output, err := miscService.GetQuotaLeft(&qcservice.GetQuotaLeftInput{
+		ResourceTypes: qcResourceTypes,
+		Zone:          qcservice.String(qingcloudService.Config.Zone),
+	})
+	if err != nil {
+		p.Logger.Error("GetQuotaLeft to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send GetQuotaLeft to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send GetQuotaLeft to %s failed: %s", MyProvider, message)
+	}
+
+	for _, quotaLeftSet := range output.QuotaLeftSet {
+		resourceType := qcservice.StringValue(quotaLeftSet.ResourceType)
+		left := qcservice.IntValue(quotaLeftSet.Left)
+		if left != models.UnlimitedQuota && needQuotas[resourceType] > left {
+			p.Logger.Error("[%s] quota not enough: need %d more %s quota", MyProvider, needQuotas[resourceType]-left, resourceType)
+			return fmt.Errorf("need %d more %s quota", needQuotas[resourceType]-left, resourceType)
+		}
+	}
+	return nil
+}
+
+func (p *ProviderHandler) describeLoadbalancerId(loadbalancerService *qcservice.LoadBalancerService, listenerId string) (string, error) {
+	output, err := loadbalancerService.DescribeLoadBalancerListeners(
+		&qcservice.DescribeLoadBalancerListenersInput{
+			LoadBalancerListeners: qcservice.StringSlice([]string{listenerId}),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send DescribeLoadBalancerListeners to %s failed: %+v", MyProvider, err)
+		return "", err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send DescribeLoadBalancerListeners to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return "", fmt.Errorf("send DescribeLoadBalancerListeners to %s failed: %s", MyProvider, message)
+	}
+	if len(output.LoadBalancerListenerSet) == 0 {
+		p.Logger.Error("Loadbalancer listener [%s] not found", listenerId)
+		return "", fmt.Errorf("loadbalancer listener [%s] not found", listenerId)
+	}
+	return qcservice.StringValue(output.LoadBalancerListenerSet[0].LoadBalancerID), nil
+}
+
+func (p *ProviderHandler) describeLoadbalancerBackends(loadbalancerService *qcservice.LoadBalancerService, listenerId string) ([]*qcservice.LoadBalancerBackend, error) {
+	output, err := loadbalancerService.DescribeLoadBalancerBackends(
+		&qcservice.DescribeLoadBalancerBackendsInput{
+			LoadBalancerListener: qcservice.String(listenerId),
+			Limit:                qcservice.Int(DefaultLoadbalancerBackendLimit),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send DescribeLoadBalancerBackends to %s failed: %+v", MyProvider, err)
+		return nil, err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send DescribeLoadBalancerBackends to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return nil, fmt.Errorf("send DescribeLoadBalancerBackends to %s failed: %s", MyProvider, message)
+	}
+	return output.LoadBalancerBackendSet, nil
+}
+
+// updateLoadbalancer applies the modified backends of the loadbalancer and
+// returns the job id.
+func (p *ProviderHandler) updateLoadbalancer(loadbalancerService *qcservice.LoadBalancerService, loadbalancerId string) (string, error) {
+	output, err := loadbalancerService.UpdateLoadBalancers(
+		&qcservice.UpdateLoadBalancersInput{
+			LoadBalancers: qcservice.StringSlice([]string{loadbalancerId}),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send UpdateLoadBalancers to %s failed: %+v", MyProvider, err)
+		return "", err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send UpdateLoadBalancers to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return "", fmt.Errorf("send UpdateLoadBalancers to %s failed: %s", MyProvider, message)
+	}
+	return qcservice.StringValue(output.JobID), nil
+}
+
+func (p *ProviderHandler) AddLoadbalancerBackends(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+
+	loadbalancerBackend, err := models.NewLoadbalancerBackend(task.Directive)
+	if err != nil {
+		return err
+	}
+
+	qingcloudService, err := p.initService(loadbalancerBackend.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	loadbalancerService, err := qingcloudService.LoadBalancer(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s loadbalancer api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	loadbalancerId, err := p.describeLoadbalancerId(loadbalancerService, loadbalancerBackend.ListenerId)
+	if err != nil {
+		return err
+	}
+
+	// skip the instances already added when the task is retried
+	existBackends, err := p.describeLoadbalancerBackends(loadbalancerService, loadbalancerBackend.ListenerId)
+	if err != nil {
+		return err
+	}
+	existInstanceIds := make(map[string]bool)
+	for _, backend := range existBackends {
+		if qcservice.IntValue(backend.Port) == int(loadbalancerBackend.Port) {
+			existInstanceIds[qcservice.StringValue(backend.ResourceID)] = true
+		}
+	}
+
+	var backends []*qcservice.LoadBalancerBackend
+	for _, instanceId := range loadbalancerBackend.InstanceIds {
+		if existInstanceIds[instanceId] {
+			continue
+		}
+		backend := &qcservice.LoadBalancerBackend{
+			LoadBalancerBackendName: qcservice.String(loadbalancerBackend.ClusterId + "_" + loadbalancerBackend.Role),
+			ResourceID:              qcservice.String(instanceId),
+			Port:                    qcservice.Int(int(loadbalancerBackend.Port)),
+		}
+		if loadbalancerBackend.PolicyId != "" {
+			backend.LoadBalancerPolicyID = qcservice.String(loadbalancerBackend.PolicyId)
+		}
+		backends = append(backends, backend)
+	}
+	if len(backends) == 0 {
+		p.Logger.Info("Instances [%s] are already added to loadbalancer listener [%s]",
+			strings.Join(loadbalancerBackend.InstanceIds, ","), loadbalancerBackend.ListenerId)
+		return nil
+	}
+
+	output, err := loadbalancerService.AddLoadBalancerBackends(
+		&qcservice.AddLoadBalancerBackendsInput{
+			LoadBalancerListener: qcservice.String(loadbalancerBackend.ListenerId),
+			Backends:             backends,
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send AddLoadBalancerBackends to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send AddLoadBalancerBackends to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send AddLoadBalancerBackends to %s failed: %s", MyProvider, message)
+	}
+
+	loadbalancerBackend.TargetJobId, err = p.updateLoadbalancer(loadbalancerService, loadbalancerId)
+	if err != nil {
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(loadbalancerBackend)
+
+	return nil
+}
+
+func (p *ProviderHandler) DeleteLoadbalancerBackends(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+
+	loadbalancerBackend, err := models.NewLoadbalancerBackend(task.Directive)
+	if err != nil {
+		return err
+	}
+
+	qingcloudService, err := p.initService(loadbalancerBackend.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	loadbalancerService, err := qingcloudService.LoadBalancer(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s loadbalancer api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	loadbalancerId, err := p.describeLoadbalancerId(loadbalancerService, loadbalancerBackend.ListenerId)
+	if err != nil {
+		return err
+	}
+
+	existBackends, err := p.describeLoadbalancerBackends(loadbalancerService, loadbalancerBackend.ListenerId)
+	if err != nil {
+		return err
+	}
+	instanceIds := make(map[string]bool)
+	for _, instanceId := range loadbalancerBackend.InstanceIds {
+		instanceIds[instanceId] = true
+	}
+	var backendIds []string
+	for _, backend := range existBackends {
+		if instanceIds[qcservice.StringValue(backend.ResourceID)] &&
+			qcservice.IntValue(backend.Port) == int(loadbalancerBackend.Port) {
+			backendIds = append(backendIds, qcservice.StringValue(backend.LoadBalancerBackendID))
+		}
+	}
+	if len(backendIds) == 0 {
+		p.Logger.Info("Instances [%s] are not in loadbalancer listener [%s]",
+			strings.Join(loadbalancerBackend.InstanceIds, ","), loadbalancerBackend.ListenerId)
+		return nil
+	}
+
+	output, err := loadbalancerService.DeleteLoadBalancerBackends(
+		&qcservice.DeleteLoadBalancerBackendsInput{
+			LoadBalancerBackends: qcservice.StringSlice(backendIds),
+		},
+	)
+	if err != nil {
+		p.Logger.Error("Send DeleteLoadBalancerBackends to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	retCode := qcservice.IntValue(output.RetCode)
+	if retCode != 0 {
+		message := qcservice.StringValue(output.Message)
+		p.Logger.Error("Send DeleteLoadBalancerBackends to %s failed with return code [%d], message [%s]",
+			MyProvider, retCode, message)
+		return fmt.Errorf("send DeleteLoadBalancerBackends to %s failed: %s", MyProvider, message)
+	}
+
+	loadbalancerBackend.TargetJobId, err = p.updateLoadbalancer(loadbalancerService, loadbalancerId)
+	if err != nil {
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(loadbalancerBackend)
+
+	return nil
+}
+
+func (p *ProviderHandler) WaitLoadbalancerBackendTask(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	loadbalancerBackend, err := models.NewLoadbalancerBackend(task.Directive)
+	if err != nil {
+		return err
+	}
+	if loadbalancerBackend.TargetJobId == "" {
+		p.Logger.Warn("Skip task without target job id")
+		return nil
+	}
+	qingcloudService, err := p.initService(loadbalancerBackend.RuntimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	return p.waitJob(qingcloudService, loadbalancerBackend.TargetJobId, task.GetTimeout(constants.WaitTaskTimeout))
+}
+
+func (p *ProviderHandler) WaitAddLoadbalancerBackends(task *models.Task) error {
+	return p.WaitLoadbalancerBackendTask(task)
+}
+
+func (p *ProviderHandler) WaitDeleteLoadbalancerBackends(task *models.Task) error {
+	return p.WaitLoadbalancerBackendTask(task)
+}
+
+func (p *ProviderHandler) CheckLoadbalancerListeners(runtimeId string, listenerIds []string) error {
+	qingcloudService, err := p.initService(runtimeId)
+	if err != nil {
+		p.Logger.Error("Init %s api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	loadbalancerService, err := qingcloudService.LoadBalancer(qingcloudService.Config.Zone)
+	if err != nil {
+		p.Logger.Error("Init %s loadbalancer api service failed: %+v", MyProvider, err)
+		return err
+	}
+
+	for _, listenerId := range listenerIds {
+		_, err = p.describeLoadbalancerId(loadbalancerService, listenerId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
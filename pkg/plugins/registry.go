@@ -0,0 +1,117 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package plugins
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pi"
+)
+
+// Factory creates the provider running in process.
+type Factory func(l *logger.Logger) ProviderInterface
+
+type registered struct {
+	factory Factory
+	vmBased bool
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = make(map[string]*registered)
+	// vmBasedCache keeps the answers of the providers running out of process
+	vmBasedCache sync.Map
+)
+
+func register(provider string, factory Factory, vmBased bool) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	if factory == nil {
+		panic(fmt.Sprintf("factory of provider [%s] is nil", provider))
+	}
+	if _, ok := registry[provider]; ok {
+		panic(fmt.Sprintf("provider [%s] is registered twice", provider))
+	}
+	registry[provider] = &registered{factory: factory, vmBased: vmBased}
+}
+
+// Register adds the provider running in process, it is called in the init of
+// the provider package.
+func Register(provider string, factory Factory) {
+	register(provider, factory, false)
+}
+
+// RegisterVmBased adds the provider running in process whose clusters are
+// made of instances and volumes and run by vmbased.Frame.
+func RegisterVmBased(provider string, factory Factory) {
+	register(provider, factory, true)
+}
+
+func getRegistered(provider string) (*registered, bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	p, ok := registry[provider]
+	return p, ok
+}
+
+// GetRegisteredProviders returns the providers running in process.
+func GetRegisteredProviders() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	var providers []string
+	for provider := range registry {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	return providers
+}
+
+func getPluginEndpoint(provider string) (string, bool) {
+	if pi.Global() == nil || pi.Global().GlobalConfig() == nil {
+		return "", false
+	}
+	return pi.Global().GlobalConfig().Cluster.GetPluginEndpoint(provider)
+}
+
+// IsSupported returns whether the provider runs in process or out of process.
+func IsSupported(provider string) bool {
+	if _, ok := getRegistered(provider); ok {
+		return true
+	}
+	_, ok := getPluginEndpoint(provider)
+	return ok
+}
+
+// IsVmBased returns whether the clusters of provider are run by vmbased.Frame,
+// the provider running out of process is asked for it, so that the error of
+// connecting to the provider is returned instead of taken as not vm based.
+func IsVmBased(provider string) (bool, error) {
+	if p, ok := getRegistered(provider); ok {
+		return p.vmBased, nil
+	}
+	endpoint, ok := getPluginEndpoint(provider)
+	if !ok {
+		return false, fmt.Errorf("No such provider [%s]. ", provider)
+	}
+	key := provider + "=" + endpoint
+	if vmBased, ok := vmBasedCache.Load(key); ok {
+		return vmBased.(bool), nil
+	}
+	p, err := NewRemoteProvider(provider, endpoint, nil)
+	if err != nil {
+		logger.Error("Connect to provider [%s] failed: %+v", key, err)
+		return false, err
+	}
+	vmBased, err := p.IsVmBased()
+	if err != nil {
+		logger.Error("Describe provider [%s] failed: %+v", key, err)
+		return false, err
+	}
+	vmBasedCache.Store(key, vmBased)
+	return vmBased, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+)
+
+type fakeProvider struct{}
+
+func (f *fakeProvider) ParseClusterConf(versionId, runtimeId, conf string) (*models.ClusterWrapper, error) {
+	return &models.ClusterWrapper{Cluster: &models.Cluster{VersionId: versionId, RuntimeId: runtimeId}}, nil
+}
+
+func (f *fakeProvider) SplitJobIntoTasks(job *models.Job) (*models.TaskLayer, error) {
+	return &models.TaskLayer{
+		Tasks: []*models.Task{{JobId: job.JobId, TaskAction: "RunInstances"}},
+		Child: &models.TaskLayer{Tasks: []*models.Task{{JobId: job.JobId, TaskAction: "StartInstances"}}},
+	}, nil
+}
+
+func (f *fakeProvider) HandleSubtask(task *models.Task) error {
+	task.Directive = `{"InstanceId":"i-fake"}`
+	return nil
+}
+
+func (f *fakeProvider) WaitSubtask(task *models.Task, timeout time.Duration, waitInterval time.Duration) error {
+	task.Directive = fmt.Sprintf(`{"timeout":%d}`, int(timeout/time.Second))
+	return nil
+}
+
+func (f *fakeProvider) DescribeSubnets(ctx context.Context, req *pb.DescribeSubnetsRequest) (*pb.DescribeSubnetsResponse, error) {
+	return &pb.DescribeSubnetsResponse{TotalCount: 1}, nil
+}
+
+func (f *fakeProvider) CheckResource(ctx context.Context, clusterWrapper *models.ClusterWrapper) error {
+	return nil
+}
+
+func (f *fakeProvider) DescribeVpc(runtimeId, vpcId string) (*models.Vpc, error) {
+	return &models.Vpc{VpcId: vpcId, Status: "active"}, nil
+}
+
+func (f *fakeProvider) ValidateCredential(url, credential, zone string) error {
+	return fmt.Errorf("cannot access zone [%s]", zone)
+}
+
+func (f *fakeProvider) DescribeRuntimeProviderZones(url, credential string) ([]string, error) {
+	return []string{"zone-a", "zone-b"}, nil
+}
+
+func (f *fakeProvider) UpdateClusterStatus(job *models.Job) error {
+	return nil
+}
+
+func TestRegister(t *testing.T) {
+	RegisterVmBased("fake-vm", func(l *logger.Logger) ProviderInterface {
+		return new(fakeProvider)
+	})
+	Register("fake", func(l *logger.Logger) ProviderInterface {
+		return new(fakeProvider)
+	})
+
+	if vmBased, err := IsVmBased("fake-vm"); err != nil || !vmBased {
+		t.Fatalf("fake-vm provider should be vm based, %+v", err)
+	}
+	if vmBased, err := IsVmBased("fake"); err != nil || vmBased {
+		t.Fatalf("fake provider should not be vm based, %+v", err)
+	}
+	if !IsSupported("fake") {
+		t.Fatalf("fake providers are not registered")
+	}
+	if _, err := IsVmBased("unknown"); err == nil {
+		t.Fatalf("unknown provider should not be vm based")
+	}
+	if IsSupported("unknown") {
+		t.Fatalf("unknown provider should not be supported")
+	}
+	_, err := GetProviderPlugin("fake", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = GetProviderPlugin("unknown", nil)
+	if err == nil {
+		t.Fatalf("unknown provider should not be found")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("provider registered twice should panic")
+		}
+	}()
+	Register("fake", func(l *logger.Logger) ProviderInterface {
+		return new(fakeProvider)
+	})
+}
+
+func TestRemoteProvider(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterProviderPluginServer(server, NewProviderPluginServer("fake-remote", true, new(fakeProvider)))
+	go server.Serve(lis)
+	defer server.Stop()
+
+	p, err := NewRemoteProvider("fake-remote", lis.Addr().String(), logger.NewLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vmBased, err := p.IsVmBased()
+	if err != nil || !vmBased {
+		t.Fatalf("remote provider should be vm based, %+v", err)
+	}
+
+	clusterWrapper, err := p.ParseClusterConf("appv-1", "runtime-1", "{}")
+	if err != nil || clusterWrapper.Cluster.RuntimeId != "runtime-1" {
+		t.Fatalf("failed to parse cluster conf: %+v", err)
+	}
+
+	taskLayer, err := p.SplitJobIntoTasks(&models.Job{JobId: "j-1"})
+	if err != nil || taskLayer.Child == nil || taskLayer.Child.Tasks[0].JobId != "j-1" {
+		t.Fatalf("failed to split job into tasks: %+v", err)
+	}
+
+	task := &models.Task{TaskId: "t-1"}
+	err = p.HandleSubtask(task)
+	if err != nil || task.Directive != `{"InstanceId":"i-fake"}` || task.TaskId != "t-1" {
+		t.Fatalf("directive should be written back, got [%s] %+v", task.Directive, err)
+	}
+	err = p.WaitSubtask(task, time.Minute, time.Second)
+	if err != nil || task.Directive != `{"timeout":60}` {
+		t.Fatalf("directive should be written back, got [%s] %+v", task.Directive, err)
+	}
+
+	vpc, err := p.DescribeVpc("runtime-1", "vpc-1")
+	if err != nil || vpc.VpcId != "vpc-1" {
+		t.Fatalf("failed to describe vpc: %+v", err)
+	}
+
+	zones, err := p.DescribeRuntimeProviderZones("", "")
+	if err != nil || len(zones) != 2 {
+		t.Fatalf("failed to describe zones: %+v", err)
+	}
+
+	err = p.ValidateCredential("", "", "zone-c")
+	if err == nil {
+		t.Fatalf("error of remote provider should be returned")
+	}
+}
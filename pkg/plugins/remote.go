@@ -0,0 +1,331 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// RemoteProvider is the client of the provider running out of process, the
+// provider is a grpc service of pb.ProviderPluginServer.
+type RemoteProvider struct {
+	Provider string
+	Logger   *logger.Logger
+	client   pb.ProviderPluginClient
+}
+
+func NewRemoteProvider(provider, endpoint string, l *logger.Logger) (*RemoteProvider, error) {
+	if l == nil {
+		l = logger.NewLogger()
+	}
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint [%s] of provider [%s]: %+v", endpoint, provider, err)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint [%s] of provider [%s]: %+v", endpoint, provider, err)
+	}
+	conn, err := manager.NewClient(host, portNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteProvider{
+		Provider: provider,
+		Logger:   l,
+		client:   pb.NewProviderPluginClient(conn),
+	}, nil
+}
+
+func (p *RemoteProvider) IsVmBased() (bool, error) {
+	response, err := p.client.DescribeProvider(clientutil.GetSystemUserContext(), &pb.DescribeProviderRequest{
+		Provider: pbutil.ToProtoString(p.Provider),
+	})
+	if err != nil {
+		return false, err
+	}
+	return response.GetVmBased().GetValue(), nil
+}
+
+func (p *RemoteProvider) ParseClusterConf(versionId, runtimeId, conf string) (*models.ClusterWrapper, error) {
+	response, err := p.client.ParseClusterConf(clientutil.GetSystemUserContext(), &pb.ParseClusterConfRequest{
+		VersionId: pbutil.ToProtoString(versionId),
+		RuntimeId: pbutil.ToProtoString(runtimeId),
+		Conf:      pbutil.ToProtoString(conf),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return models.NewClusterWrapper(response.GetClusterWrapper().GetValue())
+}
+
+func (p *RemoteProvider) SplitJobIntoTasks(job *models.Job) (*models.TaskLayer, error) {
+	response, err := p.client.SplitJobIntoTasks(clientutil.GetSystemUserContext(), &pb.SplitJobIntoTasksRequest{
+		Job: pbutil.ToProtoString(jsonutil.ToString(job)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.GetTaskLayer().GetValue() == "" {
+		return nil, nil
+	}
+	taskLayer := new(models.TaskLayer)
+	err = jsonutil.Decode([]byte(response.GetTaskLayer().GetValue()), taskLayer)
+	if err != nil {
+		return nil, err
+	}
+	return taskLayer, nil
+}
+
+// writeBack copies the task returned by the provider, e.g. the directive.
+func writeBack(task *models.Task, data string) error {
+	if data == "" {
+		return nil
+	}
+	return jsonutil.Decode([]byte(data), task)
+}
+
+func (p *RemoteProvider) HandleSubtask(task *models.Task) error {
+	response, err := p.client.HandleSubtask(clientutil.GetSystemUserContext(), &pb.HandleSubtaskRequest{
+		Task: pbutil.ToProtoString(jsonutil.ToString(task)),
+	})
+	if err != nil {
+		return err
+	}
+	return writeBack(task, response.GetTask().GetValue())
+}
+
+func (p *RemoteProvider) WaitSubtask(task *models.Task, timeout time.Duration, waitInterval time.Duration) error {
+	response, err := p.client.WaitSubtask(clientutil.GetSystemUserContext(), &pb.WaitSubtaskRequest{
+		Task:         pbutil.ToProtoString(jsonutil.ToString(task)),
+		Timeout:      pbutil.ToProtoUInt32(uint32(timeout / time.Millisecond)),
+		WaitInterval: pbutil.ToProtoUInt32(uint32(waitInterval / time.Millisecond)),
+	})
+	if err != nil {
+		return err
+	}
+	return writeBack(task, response.GetTask().GetValue())
+}
+
+func (p *RemoteProvider) DescribeSubnets(ctx context.Context, req *pb.DescribeSubnetsRequest) (*pb.DescribeSubnetsResponse, error) {
+	return p.client.DescribeSubnets(clientutil.GetSystemUserContext(), req)
+}
+
+func (p *RemoteProvider) CheckResource(ctx context.Context, clusterWrapper *models.ClusterWrapper) error {
+	_, err := p.client.CheckResource(clientutil.GetSystemUserContext(), &pb.CheckResourceRequest{
+		ClusterWrapper: pbutil.ToProtoString(jsonutil.ToString(clusterWrapper)),
+	})
+	return err
+}
+
+func (p *RemoteProvider) DescribeVpc(runtimeId, vpcId string) (*models.Vpc, error) {
+	response, err := p.client.DescribeVpc(clientutil.GetSystemUserContext(), &pb.DescribeVpcRequest{
+		RuntimeId: pbutil.ToProtoString(runtimeId),
+		VpcId:     pbutil.ToProtoString(vpcId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	vpc := new(models.Vpc)
+	err = jsonutil.Decode([]byte(response.GetVpc().GetValue()), vpc)
+	if err != nil {
+		return nil, err
+	}
+	return vpc, nil
+}
+
+func (p *RemoteProvider) ValidateCredential(url, credential, zone string) error {
+	_, err := p.client.ValidateCredential(clientutil.GetSystemUserContext(), &pb.ValidateCredentialRequest{
+		RuntimeUrl:        pbutil.ToProtoString(url),
+		RuntimeCredential: pbutil.ToProtoString(credential),
+		Zone:              pbutil.ToProtoString(zone),
+	})
+	return err
+}
+
+func (p *RemoteProvider) DescribeRuntimeProviderZones(url, credential string) ([]string, error) {
+	response, err := p.client.DescribeZones(clientutil.GetSystemUserContext(), &pb.DescribeZonesRequest{
+		RuntimeUrl:        pbutil.ToProtoString(url),
+		RuntimeCredential: pbutil.ToProtoString(credential),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Zone, nil
+}
+
+func (p *RemoteProvider) UpdateClusterStatus(job *models.Job) error {
+	_, err := p.client.UpdateClusterStatus(clientutil.GetSystemUserContext(), &pb.UpdateClusterStatusRequest{
+		Job: pbutil.ToProtoString(jsonutil.ToString(job)),
+	})
+	return err
+}
+
+// ProviderPluginServer serves the provider out of process, e.g.
+//
+//	plugins.ServeProviderPlugin("openstack", true, openstack.NewProvider(nil), 9131)
+//
+// and "openstack=host:9131" is added to the plugins of the cluster service in
+// global config.
+type ProviderPluginServer struct {
+	provider string
+	vmBased  bool
+	plugin   ProviderInterface
+}
+
+func NewProviderPluginServer(provider string, vmBased bool, plugin ProviderInterface) *ProviderPluginServer {
+	return &ProviderPluginServer{
+		provider: provider,
+		vmBased:  vmBased,
+		plugin:   plugin,
+	}
+}
+
+func ServeProviderPlugin(provider string, vmBased bool, plugin ProviderInterface, port int) {
+	s := NewProviderPluginServer(provider, vmBased, plugin)
+	manager.NewGrpcServer(provider, port).
+		ShowErrorCause(true).
+		Serve(func(server *grpc.Server) {
+			pb.RegisterProviderPluginServer(server, s)
+		})
+}
+
+func (s *ProviderPluginServer) DescribeProvider(ctx context.Context, req *pb.DescribeProviderRequest) (*pb.DescribeProviderResponse, error) {
+	return &pb.DescribeProviderResponse{
+		Provider: pbutil.ToProtoString(s.provider),
+		VmBased:  pbutil.ToProtoBool(s.vmBased),
+	}, nil
+}
+
+func (s *ProviderPluginServer) ParseClusterConf(ctx context.Context, req *pb.ParseClusterConfRequest) (*pb.ParseClusterConfResponse, error) {
+	clusterWrapper, err := s.plugin.ParseClusterConf(
+		req.GetVersionId().GetValue(), req.GetRuntimeId().GetValue(), req.GetConf().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ParseClusterConfResponse{
+		ClusterWrapper: pbutil.ToProtoString(jsonutil.ToString(clusterWrapper)),
+	}, nil
+}
+
+func (s *ProviderPluginServer) SplitJobIntoTasks(ctx context.Context, req *pb.SplitJobIntoTasksRequest) (*pb.SplitJobIntoTasksResponse, error) {
+	job := new(models.Job)
+	err := jsonutil.Decode([]byte(req.GetJob().GetValue()), job)
+	if err != nil {
+		return nil, err
+	}
+	taskLayer, err := s.plugin.SplitJobIntoTasks(job)
+	if err != nil {
+		return nil, err
+	}
+	response := new(pb.SplitJobIntoTasksResponse)
+	if taskLayer != nil {
+		response.TaskLayer = pbutil.ToProtoString(jsonutil.ToString(taskLayer))
+	}
+	return response, nil
+}
+
+func (s *ProviderPluginServer) HandleSubtask(ctx context.Context, req *pb.HandleSubtaskRequest) (*pb.HandleSubtaskResponse, error) {
+	task := new(models.Task)
+	err := jsonutil.Decode([]byte(req.GetTask().GetValue()), task)
+	if err != nil {
+		return nil, err
+	}
+	err = s.plugin.HandleSubtask(task)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.HandleSubtaskResponse{
+		Task: pbutil.ToProtoString(jsonutil.ToString(task)),
+	}, nil
+}
+
+func (s *ProviderPluginServer) WaitSubtask(ctx context.Context, req *pb.WaitSubtaskRequest) (*pb.WaitSubtaskResponse, error) {
+	task := new(models.Task)
+	err := jsonutil.Decode([]byte(req.GetTask().GetValue()), task)
+	if err != nil {
+		return nil, err
+	}
+	err = s.plugin.WaitSubtask(task,
+		time.Duration(req.GetTimeout().GetValue())*time.Millisecond,
+		time.Duration(req.GetWaitInterval().GetValue())*time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WaitSubtaskResponse{
+		Task: pbutil.ToProtoString(jsonutil.ToString(task)),
+	}, nil
+}
+
+func (s *ProviderPluginServer) DescribeSubnets(ctx context.Context, req *pb.DescribeSubnetsRequest) (*pb.DescribeSubnetsResponse, error) {
+	return s.plugin.DescribeSubnets(ctx, req)
+}
+
+func (s *ProviderPluginServer) CheckResource(ctx context.Context, req *pb.CheckResourceRequest) (*pb.CheckResourceResponse, error) {
+	clusterWrapper, err := models.NewClusterWrapper(req.GetClusterWrapper().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	err = s.plugin.CheckResource(ctx, clusterWrapper)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CheckResourceResponse{Ok: pbutil.ToProtoBool(true)}, nil
+}
+
+func (s *ProviderPluginServer) DescribeVpc(ctx context.Context, req *pb.DescribeVpcRequest) (*pb.DescribeVpcResponse, error) {
+	vpc, err := s.plugin.DescribeVpc(req.GetRuntimeId().GetValue(), req.GetVpcId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DescribeVpcResponse{
+		Vpc: pbutil.ToProtoString(jsonutil.ToString(vpc)),
+	}, nil
+}
+
+func (s *ProviderPluginServer) ValidateCredential(ctx context.Context, req *pb.ValidateCredentialRequest) (*pb.ValidateCredentialResponse, error) {
+	err := s.plugin.ValidateCredential(
+		req.GetRuntimeUrl().GetValue(), req.GetRuntimeCredential().GetValue(), req.GetZone().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ValidateCredentialResponse{Ok: pbutil.ToProtoBool(true)}, nil
+}
+
+func (s *ProviderPluginServer) DescribeZones(ctx context.Context, req *pb.DescribeZonesRequest) (*pb.DescribeZonesResponse, error) {
+	zones, err := s.plugin.DescribeRuntimeProviderZones(
+		req.GetRuntimeUrl().GetValue(), req.GetRuntimeCredential().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DescribeZonesResponse{Zone: zones}, nil
+}
+
+func (s *ProviderPluginServer) UpdateClusterStatus(ctx context.Context, req *pb.UpdateClusterStatusRequest) (*pb.UpdateClusterStatusResponse, error) {
+	job := new(models.Job)
+	err := jsonutil.Decode([]byte(req.GetJob().GetValue()), job)
+	if err != nil {
+		return nil, err
+	}
+	err = s.plugin.UpdateClusterStatus(job)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateClusterStatusResponse{Ok: pbutil.ToProtoBool(true)}, nil
+}
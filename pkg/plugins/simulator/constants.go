@@ -0,0 +1,17 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package simulator
+
+import (
+	"time"
+)
+
+const (
+	DefaultVpcId  = "vpc-simulator"
+	DefaultDevice = "/dev/vdb"
+	WaitInterval  = 200 * time.Millisecond
+)
+
+var DefaultZones = []string{"simulator-1", "simulator-2"}
@@ -0,0 +1,89 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package simulator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// Credential of the simulator runtime describes the simulated cloud, e.g.
+//
+//	{
+//	  "zones": ["simulator-1"],
+//	  "state_file": "simulator.json",
+//	  "latency": {"RunInstances": "5s"},
+//	  "failures": {"AttachVolumes": 1}
+//	}
+//
+// The state file is relative to the state dir in the server config, and the
+// dir must be shared by the runtime, job, task and cluster services. If no
+// state file is given, the resources are kept in memory of every service, so
+// the services do not see the resources of each other, which only works when
+// they run in one process, e.g. in unit tests.
+// The latency delays the action to reach the final status, and the failures
+// fail the first N calls of the action, a negative number fails all the calls.
+type Credential struct {
+	Zones     []string          `json:"zones"`
+	StateFile string            `json:"state_file"`
+	Latency   map[string]string `json:"latency"`
+	Failures  map[string]int    `json:"failures"`
+}
+
+func NewCredential(data string) (*Credential, error) {
+	credential := new(Credential)
+	err := jsonutil.Decode([]byte(data), credential)
+	if err != nil {
+		return nil, err
+	}
+	if len(credential.Zones) == 0 {
+		credential.Zones = DefaultZones
+	}
+	if credential.StateFile != "" {
+		stateFile := filepath.Clean(credential.StateFile)
+		if filepath.IsAbs(stateFile) || stateFile == "." || stringutil.StringIn("..", strings.Split(stateFile, string(filepath.Separator))) {
+			return nil, fmt.Errorf("invalid state file [%s], it should be a relative path in the state dir", credential.StateFile)
+		}
+		credential.StateFile = stateFile
+	}
+	for action, latency := range credential.Latency {
+		_, err := time.ParseDuration(latency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latency [%s] of action [%s]", latency, action)
+		}
+	}
+	return credential, nil
+}
+
+func (c *Credential) GetLatency(action string) time.Duration {
+	latency, _ := time.ParseDuration(c.Latency[action])
+	return latency
+}
+
+// GetStateFile returns the path of the state file in the state dir.
+func (c *Credential) GetStateFile() string {
+	return filepath.Join(getStateDir(), c.StateFile)
+}
+
+// getStateDir is replaced in tests to run without the server config.
+var getStateDir = func() string {
+	return pi.Global().Config().Simulator.StateDir
+}
+
+// getCredential is replaced in tests to run without the runtime manager.
+var getCredential = func(runtimeId string) (*Credential, error) {
+	runtime, err := runtimeclient.NewRuntime(runtimeId)
+	if err != nil {
+		return nil, err
+	}
+	return NewCredential(runtime.Credential)
+}
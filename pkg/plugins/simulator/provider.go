@@ -0,0 +1,270 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package simulator is a fake vm based provider for the end to end tests.
+// It keeps the instances, volumes, vpcs and subnets of the runtime in memory
+// or in a local file, and takes over the pilot tasks of the frame, so the
+// whole cluster lifecycle runs without any cloud, frontgate or drone.
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	clusterclient "openpitrix.io/openpitrix/pkg/client/cluster"
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/plugins"
+	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+var pilotActions = []string{
+	vmbased.ActionFormatAndMountVolume,
+	vmbased.ActionRegisterMetadata,
+	vmbased.ActionDeregisterMetadata,
+	vmbased.ActionRegisterCmd,
+	vmbased.ActionDeregisterCmd,
+	vmbased.ActionStartConfd,
+	vmbased.ActionStopConfd,
+	vmbased.ActionSetFrontgateConfig,
+	vmbased.ActionSetDroneConfig,
+	vmbased.ActionPingDrone,
+	vmbased.ActionPingFrontgate,
+	vmbased.ActionRunCommandOnDrone,
+	vmbased.ActionRemoveContainerOnDrone,
+	vmbased.ActionRemoveContainerOnFrontgate,
+	vmbased.ActionRunCommandOnFrontgateNode,
+}
+
+type Provider struct {
+	Logger *logger.Logger
+}
+
+func init() {
+	plugins.RegisterVmBased(MyProvider, func(l *logger.Logger) plugins.ProviderInterface {
+		return NewProvider(l)
+	})
+}
+
+func NewProvider(l *logger.Logger) *Provider {
+	return &Provider{
+		Logger: l,
+	}
+}
+
+func (p *Provider) SetLogger(logger *logger.Logger) {
+	if logger != nil {
+		p.Logger = logger
+	}
+}
+
+func (p *Provider) ParseClusterConf(versionId, runtimeId, conf string) (*models.ClusterWrapper, error) {
+	frameInterface, err := vmbased.NewFrameInterface(nil, p.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return frameInterface.ParseClusterConf(versionId, runtimeId, conf)
+}
+
+func (p *Provider) SplitJobIntoTasks(job *models.Job) (*models.TaskLayer, error) {
+	taskLayer, err := p.splitJobIntoTasks(job)
+	if err != nil || taskLayer == nil {
+		return taskLayer, err
+	}
+	// there is no frontgate or drone, the simulator handles the pilot tasks
+	taskLayer.WalkTree(func(parent *models.TaskLayer, current *models.TaskLayer) {
+		for _, task := range current.Tasks {
+			if task.Target == constants.TargetPilot {
+				task.Target = MyProvider
+			}
+		}
+	})
+	return taskLayer, nil
+}
+
+func (p *Provider) splitJobIntoTasks(job *models.Job) (*models.TaskLayer, error) {
+	var clusterWrapper *models.ClusterWrapper
+	var err error
+
+	switch job.JobAction {
+	case constants.ActionAttachKeyPairs, constants.ActionDetachKeyPairs:
+		nodeKeyPairDetails, err := models.NewNodeKeyPairDetails(job.Directive)
+		if err != nil {
+			return nil, err
+		}
+		clusterId := nodeKeyPairDetails[0].ClusterNode.ClusterId
+		clusterClient, err := clusterclient.NewClient()
+		if err != nil {
+			return nil, err
+		}
+		ctx := clientutil.GetSystemUserContext()
+		pbClusterWrappers, err := clusterClient.GetClusterWrappers(ctx, []string{clusterId})
+		if err != nil {
+			return nil, err
+		}
+		clusterWrapper = pbClusterWrappers[0]
+	default:
+		clusterWrapper, err = models.NewClusterWrapper(job.Directive)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	runtime, err := runtimeclient.NewRuntime(clusterWrapper.Cluster.RuntimeId)
+	if err != nil {
+		return nil, err
+	}
+	imageConfig, err := pi.Global().GlobalConfig().GetRuntimeImageIdAndUrl(runtime.RuntimeUrl, runtime.Zone)
+	if err != nil {
+		return nil, err
+	}
+	frameInterface, err := vmbased.NewFrameInterface(job, p.Logger, imageConfig.ImageId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch job.JobAction {
+	case constants.ActionCreateCluster:
+		return frameInterface.CreateClusterLayer(), nil
+	case constants.ActionAddClusterNodes:
+		return frameInterface.AddClusterNodesLayer(), nil
+	case constants.ActionDeleteClusterNodes:
+		return frameInterface.DeleteClusterNodesLayer(), nil
+	case constants.ActionStopClusters:
+		return frameInterface.StopClusterLayer(), nil
+	case constants.ActionStartClusters:
+		return frameInterface.StartClusterLayer(), nil
+	case constants.ActionDeleteClusters:
+		return frameInterface.DeleteClusterLayer(), nil
+	case constants.ActionAttachKeyPairs:
+		nodeKeyPairDetails, err := models.NewNodeKeyPairDetails(job.Directive)
+		if err != nil {
+			return nil, err
+		}
+		return frameInterface.AttachKeyPairsLayer(nodeKeyPairDetails), nil
+	case constants.ActionDetachKeyPairs:
+		nodeKeyPairDetails, err := models.NewNodeKeyPairDetails(job.Directive)
+		if err != nil {
+			return nil, err
+		}
+		return frameInterface.DetachKeyPairsLayer(nodeKeyPairDetails), nil
+	case constants.ActionUpgradeCluster, constants.ActionRollbackCluster, constants.ActionResizeCluster,
+		constants.ActionRecoverClusters, constants.ActionCeaseClusters, constants.ActionUpdateClusterEnv:
+		// not supported yet
+		return nil, nil
+	default:
+		p.Logger.Error("Unknown job action [%s]", job.JobAction)
+		return nil, fmt.Errorf("unknown job action [%s]", job.JobAction)
+	}
+}
+
+func (p *Provider) HandleSubtask(task *models.Task) error {
+	handler := GetProviderHandler(p.Logger)
+
+	switch task.TaskAction {
+	case vmbased.ActionRunInstances:
+		return handler.RunInstances(task)
+	case vmbased.ActionStopInstances:
+		return handler.StopInstances(task)
+	case vmbased.ActionStartInstances:
+		return handler.StartInstances(task)
+	case vmbased.ActionTerminateInstances:
+		return handler.DeleteInstances(task)
+	case vmbased.ActionCreateVolumes:
+		return handler.CreateVolumes(task)
+	case vmbased.ActionDetachVolumes:
+		return handler.DetachVolumes(task)
+	case vmbased.ActionAttachVolumes:
+		return handler.AttachVolumes(task)
+	case vmbased.ActionDeleteVolumes:
+		return handler.DeleteVolumes(task)
+
+	case vmbased.ActionWaitFrontgateAvailable:
+		// do nothing
+		return nil
+	default:
+		if stringutil.StringIn(task.TaskAction, pilotActions) {
+			return handler.HandlePilotTask(task)
+		}
+		p.Logger.Error("Unknown task action [%s]", task.TaskAction)
+		return fmt.Errorf("unknown task action [%s]", task.TaskAction)
+	}
+}
+
+func (p *Provider) WaitSubtask(task *models.Task, timeout time.Duration, waitInterval time.Duration) error {
+	p.Logger.Debug("Wait sub task timeout [%s] interval [%s]", timeout, waitInterval)
+	handler := GetProviderHandler(p.Logger)
+
+	switch task.TaskAction {
+	case vmbased.ActionRunInstances:
+		return handler.WaitRunInstances(task)
+	case vmbased.ActionStopInstances:
+		return handler.WaitStopInstances(task)
+	case vmbased.ActionStartInstances:
+		return handler.WaitStartInstances(task)
+	case vmbased.ActionTerminateInstances:
+		return handler.WaitDeleteInstances(task)
+	case vmbased.ActionCreateVolumes:
+		return handler.WaitCreateVolumes(task)
+	case vmbased.ActionDetachVolumes:
+		return handler.WaitDetachVolumes(task)
+	case vmbased.ActionAttachVolumes:
+		return handler.WaitAttachVolumes(task)
+	case vmbased.ActionDeleteVolumes:
+		return handler.WaitDeleteVolumes(task)
+	case vmbased.ActionWaitFrontgateAvailable:
+		return handler.WaitFrontgateAvailable(task)
+	default:
+		if stringutil.StringIn(task.TaskAction, pilotActions) {
+			return handler.WaitPilotTask(task)
+		}
+		p.Logger.Error("Unknown task action [%s]", task.TaskAction)
+		return fmt.Errorf("unknown task action [%s]", task.TaskAction)
+	}
+}
+
+func (p *Provider) DescribeSubnets(ctx context.Context, req *pb.DescribeSubnetsRequest) (*pb.DescribeSubnetsResponse, error) {
+	handler := GetProviderHandler(p.Logger)
+	return handler.DescribeSubnets(ctx, req)
+}
+
+func (p *Provider) CheckResource(ctx context.Context, clusterWrapper *models.ClusterWrapper) error {
+	return nil
+}
+
+func (p *Provider) DescribeVpc(runtimeId, vpcId string) (*models.Vpc, error) {
+	handler := GetProviderHandler(p.Logger)
+	return handler.DescribeVpc(runtimeId, vpcId)
+}
+
+func (p *Provider) ValidateCredential(url, credential, zone string) error {
+	handler := GetProviderHandler(p.Logger)
+	zones, err := handler.DescribeZones(credential)
+	if err != nil {
+		return err
+	}
+	if zone == "" {
+		return nil
+	}
+	if !stringutil.StringIn(zone, zones) {
+		return fmt.Errorf("cannot access zone [%s]", zone)
+	}
+	return nil
+}
+
+func (p *Provider) UpdateClusterStatus(job *models.Job) error {
+	return nil
+}
+
+func (p *Provider) DescribeRuntimeProviderZones(url, credential string) ([]string, error) {
+	handler := GetProviderHandler(p.Logger)
+	return handler.DescribeZones(credential)
+}
@@ -0,0 +1,504 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	clusterclient "openpitrix.io/openpitrix/pkg/client/cluster"
+	jobclient "openpitrix.io/openpitrix/pkg/client/job"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+var MyProvider = constants.ProviderSimulator
+
+type ProviderHandler struct {
+	vmbased.FrameHandler
+}
+
+func GetProviderHandler(Logger *logger.Logger) *ProviderHandler {
+	providerHandler := new(ProviderHandler)
+	if Logger == nil {
+		providerHandler.Logger = logger.NewLogger()
+	} else {
+		providerHandler.Logger = Logger
+	}
+	return providerHandler
+}
+
+// getJobRuntimeId finds the runtime of the pilot tasks by the cluster of the
+// job, the directives of the pilot tasks know nothing about the runtime.
+var getJobRuntimeId = func(jobId string) (string, error) {
+	ctx := clientutil.GetSystemUserContext()
+	jobClient, err := jobclient.NewClient()
+	if err != nil {
+		return "", err
+	}
+	response, err := jobClient.DescribeJobs(ctx, &pb.DescribeJobsRequest{
+		JobId: []string{jobId},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(response.JobSet) == 0 {
+		return "", fmt.Errorf("job [%s] not exist", jobId)
+	}
+	clusterClient, err := clusterclient.NewClient()
+	if err != nil {
+		return "", err
+	}
+	clusters, err := clusterClient.GetClusters(ctx, []string{response.JobSet[0].GetClusterId().GetValue()})
+	if err != nil {
+		return "", err
+	}
+	return clusters[0].GetRuntimeId().GetValue(), nil
+}
+
+func (p *ProviderHandler) update(runtimeId string, f func(credential *Credential, state *State) error) error {
+	credential, err := getCredential(runtimeId)
+	if err != nil {
+		p.Logger.Error("Get %s runtime [%s] credential failed: %+v", MyProvider, runtimeId, err)
+		return err
+	}
+	return defaultStore.update(runtimeId, credential, func(state *State) error {
+		return f(credential, state)
+	})
+}
+
+func readyTime(credential *Credential, action string) time.Time {
+	return time.Now().Add(credential.GetLatency(action))
+}
+
+func (p *ProviderHandler) RunInstances(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	instance, err := models.NewInstance(task.Directive)
+	if err != nil {
+		return err
+	}
+	err = p.update(instance.RuntimeId, func(credential *Credential, state *State) error {
+		err := state.call(credential, vmbased.ActionRunInstances)
+		if err != nil {
+			return err
+		}
+		if instance.Subnet != "" {
+			if _, ok := state.Subnets[instance.Subnet]; !ok {
+				return fmt.Errorf("subnet [%s] not exist", instance.Subnet)
+			}
+		}
+		instanceId, sequence := state.nextId("i")
+		state.Instances[instanceId] = &Instance{
+			InstanceId:  instanceId,
+			Name:        instance.Name,
+			ImageId:     instance.ImageId,
+			Cpu:         instance.Cpu,
+			Memory:      instance.Memory,
+			Zone:        instance.Zone,
+			Subnet:      instance.Subnet,
+			PrivateIp:   fmt.Sprintf("192.168.%d.%d", sequence/250, sequence%250+2),
+			Eip:         fmt.Sprintf("172.31.%d.%d", sequence/250, sequence%250+2),
+			Status:      constants.StatusPending,
+			ReadyStatus: constants.StatusRunning,
+			ReadyTime:   readyTime(credential, vmbased.ActionRunInstances),
+		}
+		// the volume is created before the instance and attached at boot
+		if volume, ok := state.Volumes[instance.VolumeId]; ok {
+			volume.InstanceId = instanceId
+			volume.Device = DefaultDevice
+			volume.Status = constants.StatusInUse
+			volume.ReadyStatus = ""
+		}
+		instance.InstanceId = instanceId
+		return nil
+	})
+	if err != nil {
+		p.Logger.Error("Send RunInstances to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(instance)
+
+	return nil
+}
+
+func (p *ProviderHandler) changeInstance(task *models.Task, action, transitionStatus, status string) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	instance, err := models.NewInstance(task.Directive)
+	if err != nil {
+		return err
+	}
+	if instance.InstanceId == "" {
+		p.Logger.Warn("Skip task without instance id")
+		return nil
+	}
+	err = p.update(instance.RuntimeId, func(credential *Credential, state *State) error {
+		err := state.call(credential, action)
+		if err != nil {
+			return err
+		}
+		ins, ok := state.Instances[instance.InstanceId]
+		if !ok {
+			return fmt.Errorf("instance with id [%s] not exist", instance.InstanceId)
+		}
+		if ins.Status == status {
+			p.Logger.Warn("Instance [%s] has already been [%s], do nothing", instance.InstanceId, status)
+			return nil
+		}
+		ins.Status = transitionStatus
+		ins.ReadyStatus = status
+		ins.ReadyTime = readyTime(credential, action)
+		return nil
+	})
+	if err != nil {
+		p.Logger.Error("Send %s to %s failed: %+v", action, MyProvider, err)
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(instance)
+
+	return nil
+}
+
+func (p *ProviderHandler) StopInstances(task *models.Task) error {
+	return p.changeInstance(task, vmbased.ActionStopInstances, constants.StatusStopping, constants.StatusStopped)
+}
+
+func (p *ProviderHandler) StartInstances(task *models.Task) error {
+	return p.changeInstance(task, vmbased.ActionStartInstances, constants.StatusStarting, constants.StatusRunning)
+}
+
+func (p *ProviderHandler) DeleteInstances(task *models.Task) error {
+	return p.changeInstance(task, vmbased.ActionTerminateInstances, constants.StatusDeleting, constants.StatusTerminated)
+}
+
+func (p *ProviderHandler) CreateVolumes(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	volume, err := models.NewVolume(task.Directive)
+	if err != nil {
+		return err
+	}
+	err = p.update(volume.RuntimeId, func(credential *Credential, state *State) error {
+		err := state.call(credential, vmbased.ActionCreateVolumes)
+		if err != nil {
+			return err
+		}
+		volumeId, _ := state.nextId("vol")
+		state.Volumes[volumeId] = &Volume{
+			VolumeId:    volumeId,
+			Name:        volume.Name,
+			Size:        volume.Size,
+			Zone:        volume.Zone,
+			Status:      constants.StatusCreating,
+			ReadyStatus: constants.StatusAvailable,
+			ReadyTime:   readyTime(credential, vmbased.ActionCreateVolumes),
+		}
+		volume.VolumeId = volumeId
+		return nil
+	})
+	if err != nil {
+		p.Logger.Error("Send CreateVolumes to %s failed: %+v", MyProvider, err)
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(volume)
+
+	return nil
+}
+
+func (p *ProviderHandler) changeVolume(task *models.Task, action string, f func(vol *Volume, volume *models.Volume) error) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	volume, err := models.NewVolume(task.Directive)
+	if err != nil {
+		return err
+	}
+	if volume.VolumeId == "" {
+		p.Logger.Warn("Skip task without volume")
+		return nil
+	}
+	err = p.update(volume.RuntimeId, func(credential *Credential, state *State) error {
+		err := state.call(credential, action)
+		if err != nil {
+			return err
+		}
+		vol, ok := state.Volumes[volume.VolumeId]
+		if !ok {
+			return fmt.Errorf("volume with id [%s] not exist", volume.VolumeId)
+		}
+		vol.ReadyTime = readyTime(credential, action)
+		return f(vol, volume)
+	})
+	if err != nil {
+		p.Logger.Error("Send %s to %s failed: %+v", action, MyProvider, err)
+		return err
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(volume)
+
+	return nil
+}
+
+func (p *ProviderHandler) DetachVolumes(task *models.Task) error {
+	return p.changeVolume(task, vmbased.ActionDetachVolumes, func(vol *Volume, volume *models.Volume) error {
+		vol.InstanceId = ""
+		vol.Device = ""
+		vol.ReadyStatus = constants.StatusAvailable
+		return nil
+	})
+}
+
+func (p *ProviderHandler) AttachVolumes(task *models.Task) error {
+	return p.changeVolume(task, vmbased.ActionAttachVolumes, func(vol *Volume, volume *models.Volume) error {
+		if vol.InstanceId != "" && vol.InstanceId != volume.InstanceId {
+			return fmt.Errorf("volume [%s] is in use by instance [%s]", vol.VolumeId, vol.InstanceId)
+		}
+		vol.InstanceId = volume.InstanceId
+		vol.Device = DefaultDevice
+		vol.ReadyStatus = constants.StatusInUse
+		return nil
+	})
+}
+
+func (p *ProviderHandler) DeleteVolumes(task *models.Task) error {
+	return p.changeVolume(task, vmbased.ActionDeleteVolumes, func(vol *Volume, volume *models.Volume) error {
+		if vol.InstanceId != "" {
+			return fmt.Errorf("volume [%s] is in use by instance [%s]", vol.VolumeId, vol.InstanceId)
+		}
+		vol.Status = constants.StatusDeleting
+		vol.ReadyStatus = constants.StatusDeleted
+		return nil
+	})
+}
+
+// HandlePilotTask stands in for the pilot, the frontgate and the drones.
+func (p *ProviderHandler) HandlePilotTask(task *models.Task) error {
+	runtimeId, err := getJobRuntimeId(task.JobId)
+	if err != nil {
+		p.Logger.Error("Get runtime of job [%s] failed: %+v", task.JobId, err)
+		return err
+	}
+	return p.update(runtimeId, func(credential *Credential, state *State) error {
+		return state.call(credential, task.TaskAction)
+	})
+}
+
+func (p *ProviderHandler) WaitPilotTask(task *models.Task) error {
+	runtimeId, err := getJobRuntimeId(task.JobId)
+	if err != nil {
+		p.Logger.Error("Get runtime of job [%s] failed: %+v", task.JobId, err)
+		return err
+	}
+	credential, err := getCredential(runtimeId)
+	if err != nil {
+		return err
+	}
+	time.Sleep(credential.GetLatency(task.TaskAction))
+	return nil
+}
+
+func (p *ProviderHandler) waitInstance(task *models.Task, status string) (*Instance, error) {
+	instance, err := models.NewInstance(task.Directive)
+	if err != nil {
+		return nil, err
+	}
+	var ins Instance
+	err = funcutil.WaitForSpecificOrError(func() (bool, error) {
+		var ok bool
+		err := p.update(instance.RuntimeId, func(credential *Credential, state *State) error {
+			i, exist := state.Instances[instance.InstanceId]
+			if !exist {
+				return fmt.Errorf("instance with id [%s] not exist", instance.InstanceId)
+			}
+			ins = *i
+			ok = i.Status == status
+			return nil
+		})
+		return ok, err
+	}, task.GetTimeout(constants.WaitTaskTimeout), WaitInterval)
+	if err != nil {
+		p.Logger.Error("Wait %s instance [%s] status become to [%s] failed: %+v", MyProvider, instance.InstanceId, status, err)
+		return nil, err
+	}
+	return &ins, nil
+}
+
+func (p *ProviderHandler) WaitInstanceState(task *models.Task, status string) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	_, err := p.waitInstance(task, status)
+	return err
+}
+
+func (p *ProviderHandler) WaitVolumeState(task *models.Task, status string) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	volume, err := models.NewVolume(task.Directive)
+	if err != nil {
+		return err
+	}
+	err = funcutil.WaitForSpecificOrError(func() (bool, error) {
+		var ok bool
+		err := p.update(volume.RuntimeId, func(credential *Credential, state *State) error {
+			vol, exist := state.Volumes[volume.VolumeId]
+			if !exist {
+				return fmt.Errorf("volume with id [%s] not exist", volume.VolumeId)
+			}
+			ok = vol.Status == status
+			return nil
+		})
+		return ok, err
+	}, task.GetTimeout(constants.WaitTaskTimeout), WaitInterval)
+	if err != nil {
+		p.Logger.Error("Wait %s volume [%s] status become to [%s] failed: %+v", MyProvider, volume.VolumeId, status, err)
+		return err
+	}
+	return nil
+}
+
+func (p *ProviderHandler) WaitRunInstances(task *models.Task) error {
+	if task.Directive == "" {
+		p.Logger.Warn("Skip task without directive")
+		return nil
+	}
+	instance, err := models.NewInstance(task.Directive)
+	if err != nil {
+		return err
+	}
+	ins, err := p.waitInstance(task, constants.StatusRunning)
+	if err != nil {
+		return err
+	}
+	instance.PrivateIp = ins.PrivateIp
+	instance.Eip = ins.Eip
+	if instance.VolumeId != "" {
+		instance.Device = DefaultDevice
+	}
+
+	// write back
+	task.Directive = jsonutil.ToString(instance)
+
+	p.Logger.Debug("WaitRunInstances task [%s] directive: %s", task.TaskId, task.Directive)
+
+	return nil
+}
+
+func (p *ProviderHandler) WaitStopInstances(task *models.Task) error {
+	return p.WaitInstanceState(task, constants.StatusStopped)
+}
+
+func (p *ProviderHandler) WaitStartInstances(task *models.Task) error {
+	return p.WaitInstanceState(task, constants.StatusRunning)
+}
+
+func (p *ProviderHandler) WaitDeleteInstances(task *models.Task) error {
+	return p.WaitInstanceState(task, constants.StatusTerminated)
+}
+
+func (p *ProviderHandler) WaitCreateVolumes(task *models.Task) error {
+	return p.WaitVolumeState(task, constants.StatusAvailable)
+}
+
+func (p *ProviderHandler) WaitAttachVolumes(task *models.Task) error {
+	return p.WaitVolumeState(task, constants.StatusInUse)
+}
+
+func (p *ProviderHandler) WaitDetachVolumes(task *models.Task) error {
+	return p.WaitVolumeState(task, constants.StatusAvailable)
+}
+
+func (p *ProviderHandler) WaitDeleteVolumes(task *models.Task) error {
+	return p.WaitVolumeState(task, constants.StatusDeleted)
+}
+
+func (p *ProviderHandler) DescribeSubnets(ctx context.Context, req *pb.DescribeSubnetsRequest) (*pb.DescribeSubnetsResponse, error) {
+	response := new(pb.DescribeSubnetsResponse)
+	err := p.update(req.GetRuntimeId().GetValue(), func(credential *Credential, state *State) error {
+		var subnetIds []string
+		for subnetId := range state.Subnets {
+			subnetIds = append(subnetIds, subnetId)
+		}
+		sort.Strings(subnetIds)
+		for _, subnetId := range subnetIds {
+			subnet := state.Subnets[subnetId]
+			if len(req.GetSubnetId()) > 0 && !stringutil.StringIn(subnet.SubnetId, req.GetSubnetId()) {
+				continue
+			}
+			if len(req.GetZone()) > 0 && !stringutil.StringIn(subnet.Zone, req.GetZone()) {
+				continue
+			}
+			response.SubnetSet = append(response.SubnetSet, &pb.Subnet{
+				SubnetId:   pbutil.ToProtoString(subnet.SubnetId),
+				Name:       pbutil.ToProtoString(subnet.Name),
+				VpcId:      pbutil.ToProtoString(subnet.VpcId),
+				Zone:       pbutil.ToProtoString(subnet.Zone),
+				CreateTime: pbutil.ToProtoTimestamp(subnet.CreateTime),
+				InstanceId: state.getSubnetInstanceIds(subnetId),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		p.Logger.Error("DescribeSubnets to %s failed: %+v", MyProvider, err)
+		return nil, err
+	}
+	response.TotalCount = uint32(len(response.SubnetSet))
+	return response, nil
+}
+
+func (p *ProviderHandler) DescribeVpc(runtimeId, vpcId string) (*models.Vpc, error) {
+	var vpc models.Vpc
+	err := p.update(runtimeId, func(credential *Credential, state *State) error {
+		v, ok := state.Vpcs[vpcId]
+		if !ok {
+			return fmt.Errorf("vpc with id [%s] not exist", vpcId)
+		}
+		vpc = *v
+		return nil
+	})
+	if err != nil {
+		p.Logger.Error("DescribeVpcs to %s failed: %+v", MyProvider, err)
+		return nil, err
+	}
+	return &vpc, nil
+}
+
+func (p *ProviderHandler) DescribeZones(credential string) ([]string, error) {
+	c, err := NewCredential(credential)
+	if err != nil {
+		p.Logger.Error("Parse [%s] credential failed: %+v", MyProvider, err)
+		return nil, err
+	}
+	return c.Zones, nil
+}
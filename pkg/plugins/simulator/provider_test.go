@@ -0,0 +1,167 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package simulator
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+const testRuntimeId = "runtime-simulator"
+
+func setCredential(t *testing.T, credential string) {
+	c, err := NewCredential(credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+	getCredential = func(runtimeId string) (*Credential, error) {
+		return c, nil
+	}
+	getJobRuntimeId = func(jobId string) (string, error) {
+		return testRuntimeId, nil
+	}
+	defaultStore.states = make(map[string]*State)
+}
+
+func newTask(action string, directive interface{}) *models.Task {
+	return &models.Task{
+		TaskId:     "t-" + action,
+		JobId:      "j-simulator",
+		TaskAction: action,
+		Target:     constants.ProviderSimulator,
+		Directive:  jsonutil.ToString(directive),
+	}
+}
+
+func runTask(t *testing.T, p *Provider, task *models.Task) {
+	err := p.HandleSubtask(task)
+	if err != nil {
+		t.Fatalf("handle task [%s] failed: %+v", task.TaskAction, err)
+	}
+	err = p.WaitSubtask(task, time.Minute, WaitInterval)
+	if err != nil {
+		t.Fatalf("wait task [%s] failed: %+v", task.TaskAction, err)
+	}
+}
+
+func TestClusterLifecycle(t *testing.T) {
+	setCredential(t, `{"latency":{"RunInstances":"300ms"},"failures":{"AttachVolumes":1}}`)
+	p := NewProvider(logger.NewLogger())
+
+	subnets, err := p.DescribeSubnets(context.Background(), &pb.DescribeSubnetsRequest{
+		RuntimeId: pbutil.ToProtoString(testRuntimeId),
+		Zone:      []string{DefaultZones[0]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subnets.TotalCount != 1 {
+		t.Fatalf("expect 1 subnet, got [%d]", subnets.TotalCount)
+	}
+	subnetId := subnets.SubnetSet[0].GetSubnetId().GetValue()
+	vpc, err := p.DescribeVpc(testRuntimeId, subnets.SubnetSet[0].GetVpcId().GetValue())
+	if err != nil || vpc.Status != constants.StatusActive {
+		t.Fatalf("vpc should be active, got %+v %+v", vpc, err)
+	}
+
+	task := newTask(vmbased.ActionCreateVolumes, &models.Volume{RuntimeId: testRuntimeId, Size: 10})
+	runTask(t, p, task)
+	volume, _ := models.NewVolume(task.Directive)
+
+	start := time.Now()
+	task = newTask(vmbased.ActionRunInstances, &models.Instance{
+		RuntimeId: testRuntimeId,
+		Subnet:    subnetId,
+		VolumeId:  volume.VolumeId,
+	})
+	runTask(t, p, task)
+	if time.Since(start) < 300*time.Millisecond {
+		t.Fatalf("RunInstances should wait for the latency")
+	}
+	instance, _ := models.NewInstance(task.Directive)
+	if instance.InstanceId == "" || instance.PrivateIp == "" || instance.Device != DefaultDevice {
+		t.Fatalf("instance not ready: %s", task.Directive)
+	}
+
+	volume.InstanceId = instance.InstanceId
+	runTask(t, p, newTask(vmbased.ActionDetachVolumes, volume))
+	task = newTask(vmbased.ActionAttachVolumes, volume)
+	if p.HandleSubtask(task) == nil {
+		t.Fatalf("the first AttachVolumes should fail")
+	}
+	runTask(t, p, task)
+
+	runTask(t, p, newTask(vmbased.ActionRegisterMetadata, &models.Meta{}))
+	runTask(t, p, newTask(vmbased.ActionStopInstances, instance))
+	runTask(t, p, newTask(vmbased.ActionStartInstances, instance))
+	runTask(t, p, newTask(vmbased.ActionTerminateInstances, instance))
+	runTask(t, p, newTask(vmbased.ActionDetachVolumes, volume))
+	runTask(t, p, newTask(vmbased.ActionDeleteVolumes, volume))
+}
+
+func TestStateFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "simulator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	getStateDir = func() string {
+		return dir
+	}
+	setCredential(t, `{"zones":["zone-a"],"state_file":"runtime/state.json"}`)
+	p := NewProvider(logger.NewLogger())
+
+	task := newTask(vmbased.ActionCreateVolumes, &models.Volume{RuntimeId: testRuntimeId, Size: 10})
+	runTask(t, p, task)
+	volume, _ := models.NewVolume(task.Directive)
+
+	if len(defaultStore.states) != 0 {
+		t.Fatalf("state should be kept in the file only")
+	}
+	credential, _ := getCredential(testRuntimeId)
+	states, err := defaultStore.load(credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "runtime", "state.json")); err != nil {
+		t.Fatalf("state file should be in the state dir: %+v", err)
+	}
+	if states[testRuntimeId].Volumes[volume.VolumeId].Status != constants.StatusAvailable {
+		t.Fatalf("volume [%s] not found in state file", volume.VolumeId)
+	}
+	if _, ok := states[testRuntimeId].Subnets["vxnet-zone-a"]; !ok {
+		t.Fatalf("subnet of zone-a not found in state file")
+	}
+}
+
+func TestValidateCredential(t *testing.T) {
+	p := NewProvider(logger.NewLogger())
+	if err := p.ValidateCredential("", `{}`, DefaultZones[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.ValidateCredential("", `{"zones":["zone-a"]}`, DefaultZones[0]); err == nil {
+		t.Fatal("zone should not be accessible")
+	}
+	if err := p.ValidateCredential("", `{"latency":{"RunInstances":"1 minute"}}`, ""); err == nil {
+		t.Fatal("latency should be invalid")
+	}
+	for _, stateFile := range []string{"/tmp/state.json", "../state.json", "a/../../state.json", "."} {
+		if err := p.ValidateCredential("", `{"state_file":"`+stateFile+`"}`, ""); err == nil {
+			t.Fatalf("state file [%s] should be invalid", stateFile)
+		}
+	}
+}
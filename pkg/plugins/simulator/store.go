@@ -0,0 +1,221 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+type Instance struct {
+	InstanceId string
+	Name       string
+	ImageId    string
+	Cpu        int
+	Memory     int
+	Zone       string
+	Subnet     string
+	PrivateIp  string
+	Eip        string
+	Status     string
+	// Status becomes ReadyStatus after ReadyTime, it simulates the latency
+	ReadyStatus string
+	ReadyTime   time.Time
+}
+
+type Volume struct {
+	VolumeId    string
+	Name        string
+	Size        int
+	Zone        string
+	InstanceId  string
+	Device      string
+	Status      string
+	ReadyStatus string
+	ReadyTime   time.Time
+}
+
+// State is the simulated cloud of a runtime.
+type State struct {
+	Sequence  int
+	Instances map[string]*Instance
+	Volumes   map[string]*Volume
+	Vpcs      map[string]*models.Vpc
+	Subnets   map[string]*models.Subnet
+	// Calls counts the calls of every action for the failure injection
+	Calls map[string]int
+}
+
+// newState seeds the vpc and a subnet in every zone, the ids are fixed so
+// that the services with their own state in memory agree with each other.
+func newState(zones []string) *State {
+	s := &State{
+		Instances: make(map[string]*Instance),
+		Volumes:   make(map[string]*Volume),
+		Vpcs:      make(map[string]*models.Vpc),
+		Subnets:   make(map[string]*models.Subnet),
+		Calls:     make(map[string]int),
+	}
+	vpc := &models.Vpc{
+		VpcId:      DefaultVpcId,
+		Name:       "simulator",
+		CreateTime: time.Unix(0, 0),
+		Status:     constants.StatusActive,
+		Eip: &models.Eip{
+			EipId: "eip-simulator",
+			Name:  "simulator",
+			Addr:  "172.31.0.1",
+		},
+	}
+	for _, zone := range zones {
+		subnet := &models.Subnet{
+			Zone:       zone,
+			SubnetId:   "vxnet-" + zone,
+			Name:       zone,
+			CreateTime: time.Unix(0, 0),
+			VpcId:      vpc.VpcId,
+		}
+		s.Subnets[subnet.SubnetId] = subnet
+		vpc.Subnets = append(vpc.Subnets, subnet.SubnetId)
+	}
+	s.Vpcs[vpc.VpcId] = vpc
+	return s
+}
+
+func (s *State) nextId(prefix string) (string, int) {
+	s.Sequence++
+	return fmt.Sprintf("%s-%08d", prefix, s.Sequence), s.Sequence
+}
+
+func (s *State) getSubnetInstanceIds(subnetId string) []string {
+	var instanceIds []string
+	for _, instance := range s.Instances {
+		if instance.Subnet == subnetId && instance.Status != constants.StatusTerminated {
+			instanceIds = append(instanceIds, instance.InstanceId)
+		}
+	}
+	sort.Strings(instanceIds)
+	return instanceIds
+}
+
+// call fails the first calls of the action as the failures of credential.
+func (s *State) call(credential *Credential, action string) error {
+	s.Calls[action]++
+	failures, ok := credential.Failures[action]
+	if !ok {
+		return nil
+	}
+	if failures < 0 || s.Calls[action] <= failures {
+		return fmt.Errorf("simulated failure of action [%s], call [%d]", action, s.Calls[action])
+	}
+	return nil
+}
+
+// settle moves the resources to the ready status when the latency is over.
+func (s *State) settle(now time.Time) {
+	for _, instance := range s.Instances {
+		if instance.ReadyStatus != "" && !now.Before(instance.ReadyTime) {
+			instance.Status = instance.ReadyStatus
+			instance.ReadyStatus = ""
+		}
+	}
+	for _, volume := range s.Volumes {
+		if volume.ReadyStatus != "" && !now.Before(volume.ReadyTime) {
+			volume.Status = volume.ReadyStatus
+			volume.ReadyStatus = ""
+		}
+	}
+}
+
+type store struct {
+	mutex  sync.Mutex
+	states map[string]*State
+}
+
+var defaultStore = &store{states: make(map[string]*State)}
+
+func (s *store) load(credential *Credential) (map[string]*State, error) {
+	if credential.StateFile == "" {
+		return s.states, nil
+	}
+	stateFile := credential.GetStateFile()
+	states := make(map[string]*State)
+	content, err := ioutil.ReadFile(stateFile)
+	if os.IsNotExist(err) {
+		return states, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(content, &states)
+	if err != nil {
+		return nil, fmt.Errorf("decode state file [%s] failed: %+v", stateFile, err)
+	}
+	return states, nil
+}
+
+func (s *store) save(credential *Credential, states map[string]*State) error {
+	if credential.StateFile == "" {
+		return nil
+	}
+	stateFile := credential.GetStateFile()
+	content, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(stateFile), 0755)
+	if err != nil {
+		return err
+	}
+	// write to a temp file then rename, so readers never see a partial file
+	tmp, err := ioutil.TempFile(filepath.Dir(stateFile), filepath.Base(stateFile))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(content)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), stateFile)
+}
+
+// update runs f with the state of runtime and saves the changes, the calls
+// counted by the failed f are kept as well.
+func (s *store) update(runtimeId string, credential *Credential, f func(state *State) error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	states, err := s.load(credential)
+	if err != nil {
+		return err
+	}
+	state, ok := states[runtimeId]
+	if !ok {
+		state = newState(credential.Zones)
+		states[runtimeId] = state
+	}
+	state.settle(time.Now())
+	err = f(state)
+	saveErr := s.save(credential, states)
+	if err != nil {
+		return err
+	}
+	return saveErr
+}
@@ -16,6 +16,14 @@ const (
 	ActionDeleteVolumes = "DeleteVolumes"
 	ActionResizeVolumes = "ResizeVolumes"
 
+	ActionCreateNetwork = "CreateNetwork"
+	ActionDeleteNetwork = "DeleteNetwork"
+	ActionAllocateEips  = "AllocateEips"
+	ActionReleaseEips   = "ReleaseEips"
+
+	ActionAddLoadbalancerBackends    = "AddLoadbalancerBackends"
+	ActionDeleteLoadbalancerBackends = "DeleteLoadbalancerBackends"
+
 	ActionFormatAndMountVolume       = "FormatAndMountVolume"
 	ActionWaitFrontgateAvailable     = "WaitFrontgateAvailable"
 	ActionRegisterMetadata           = "RegisterMetadata"
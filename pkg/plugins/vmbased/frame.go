@@ -37,6 +37,8 @@ type Frame struct {
 	Runtime                 *runtimeclient.Runtime
 	Logger                  *logger.Logger
 	ImageConfig             *config.ImageConfig
+	// DryRun builds the task layers without calling any service
+	DryRun bool
 }
 
 func (f *Frame) startConfdServiceLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
@@ -593,6 +595,209 @@ func (f *Frame) deleteVolumesLayer(nodeIds []string, failureAllowed bool) *model
 	return taskLayer
 }
 
+// createNetworkLayer creates the vpc and subnet of the cluster when no subnet
+// is given, the frontgate created for the cluster runs in them as well.
+func (f *Frame) createNetworkLayer(failureAllowed bool) *models.TaskLayer {
+	cluster := f.ClusterWrapper.Cluster
+	if cluster.SubnetId != "" {
+		return nil
+	}
+	network := &models.Network{
+		ClusterId: cluster.ClusterId,
+		Name:      cluster.ClusterId,
+		Zone:      cluster.Zone,
+		RuntimeId: f.Runtime.RuntimeId,
+	}
+	directive := jsonutil.ToString(network)
+	createNetworkTask := &models.Task{
+		JobId:          f.Job.JobId,
+		Owner:          f.Job.Owner,
+		TaskAction:     ActionCreateNetwork,
+		Target:         f.Runtime.Provider,
+		NodeId:         cluster.ClusterId,
+		Directive:      directive,
+		FailureAllowed: failureAllowed,
+	}
+	return &models.TaskLayer{
+		Tasks: []*models.Task{createNetworkTask},
+	}
+}
+
+// deleteNetworkLayer deletes the vpc and subnet created for the cluster, the
+// frontgate in them is deleted before.
+func (f *Frame) deleteNetworkLayer(failureAllowed bool) *models.TaskLayer {
+	cluster := f.ClusterWrapper.Cluster
+	if cluster.ManagedVpcId == "" {
+		return nil
+	}
+	network := &models.Network{
+		ClusterId: cluster.ClusterId,
+		Name:      cluster.ClusterId,
+		VpcId:     cluster.ManagedVpcId,
+		SubnetId:  cluster.ManagedSubnetId,
+		EipId:     cluster.ManagedEipId,
+		Zone:      cluster.Zone,
+		RuntimeId: f.Runtime.RuntimeId,
+	}
+	directive := jsonutil.ToString(network)
+	deleteNetworkTask := &models.Task{
+		JobId:          f.Job.JobId,
+		Owner:          f.Job.Owner,
+		TaskAction:     ActionDeleteNetwork,
+		Target:         f.Runtime.Provider,
+		NodeId:         cluster.ClusterId,
+		Directive:      directive,
+		FailureAllowed: failureAllowed,
+	}
+	return &models.TaskLayer{
+		Tasks: []*models.Task{deleteNetworkTask},
+	}
+}
+
+// allocateEipsLayer allocates and binds eips to the instances of the nodes
+// whose role declares a public endpoint.
+func (f *Frame) allocateEipsLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
+	taskLayer := new(models.TaskLayer)
+	for _, nodeId := range nodeIds {
+		clusterNode := f.ClusterWrapper.ClusterNodesWithKeyPairs[nodeId]
+		if clusterNode.EipId != "" {
+			continue
+		}
+		role := clusterNode.Role
+		if strings.HasSuffix(role, constants.ReplicaRoleSuffix) {
+			role = string([]byte(role)[:len(role)-len(constants.ReplicaRoleSuffix)])
+		}
+		clusterRole, exist := f.ClusterWrapper.ClusterRoles[role]
+		if !exist || !clusterRole.PublicEndpoint {
+			continue
+		}
+		nodeEip := &models.NodeEip{
+			NodeId:    nodeId,
+			Name:      clusterNode.ClusterId + "_" + nodeId,
+			Zone:      f.ClusterWrapper.Cluster.Zone,
+			RuntimeId: f.Runtime.RuntimeId,
+		}
+		directive := jsonutil.ToString(nodeEip)
+		allocateEipsTask := &models.Task{
+			JobId:          f.Job.JobId,
+			Owner:          f.Job.Owner,
+			TaskAction:     ActionAllocateEips,
+			Target:         f.Runtime.Provider,
+			NodeId:         nodeId,
+			Directive:      directive,
+			FailureAllowed: failureAllowed,
+		}
+		taskLayer.Tasks = append(taskLayer.Tasks, allocateEipsTask)
+	}
+
+	if len(taskLayer.Tasks) > 0 {
+		return taskLayer
+	} else {
+		return nil
+	}
+}
+
+func (f *Frame) releaseEipsLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
+	taskLayer := new(models.TaskLayer)
+	for _, nodeId := range nodeIds {
+		clusterNode := f.ClusterWrapper.ClusterNodesWithKeyPairs[nodeId]
+		if clusterNode.EipId == "" {
+			continue
+		}
+		nodeEip := &models.NodeEip{
+			NodeId:     nodeId,
+			InstanceId: clusterNode.InstanceId,
+			Name:       clusterNode.ClusterId + "_" + nodeId,
+			EipId:      clusterNode.EipId,
+			Eip:        clusterNode.Eip,
+			Zone:       f.ClusterWrapper.Cluster.Zone,
+			RuntimeId:  f.Runtime.RuntimeId,
+		}
+		directive := jsonutil.ToString(nodeEip)
+		releaseEipsTask := &models.Task{
+			JobId:          f.Job.JobId,
+			Owner:          f.Job.Owner,
+			TaskAction:     ActionReleaseEips,
+			Target:         f.Runtime.Provider,
+			NodeId:         nodeId,
+			Directive:      directive,
+			FailureAllowed: failureAllowed,
+		}
+		taskLayer.Tasks = append(taskLayer.Tasks, releaseEipsTask)
+	}
+
+	if len(taskLayer.Tasks) > 0 {
+		return taskLayer
+	} else {
+		return nil
+	}
+}
+
+func (f *Frame) constructLoadbalancerBackendTasks(action string, nodeIds []string, failureAllowed bool) *models.TaskLayer {
+	// the replicas are backends of the loadbalancers of their role as well
+	roleNodeIds := make(map[string][]string)
+	for _, nodeId := range nodeIds {
+		clusterNode := f.ClusterWrapper.ClusterNodesWithKeyPairs[nodeId]
+		role := strings.TrimSuffix(clusterNode.Role, constants.ReplicaRoleSuffix)
+		roleNodeIds[role] = append(roleNodeIds[role], nodeId)
+	}
+
+	var roles []string
+	for role := range f.ClusterWrapper.ClusterLoadbalancers {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	taskLayer := new(models.TaskLayer)
+	for _, role := range roles {
+		if len(roleNodeIds[role]) == 0 {
+			continue
+		}
+		sort.Strings(roleNodeIds[role])
+		for _, clusterLoadbalancer := range f.ClusterWrapper.ClusterLoadbalancers[role] {
+			loadbalancerBackend := &models.LoadbalancerBackend{
+				ClusterId:  f.ClusterWrapper.Cluster.ClusterId,
+				Role:       role,
+				ListenerId: clusterLoadbalancer.LoadbalancerListenerId,
+				PolicyId:   clusterLoadbalancer.LoadbalancerPolicyId,
+				Port:       clusterLoadbalancer.LoadbalancerPort,
+				NodeIds:    roleNodeIds[role],
+				Zone:       f.ClusterWrapper.Cluster.Zone,
+				RuntimeId:  f.Runtime.RuntimeId,
+			}
+			directive := jsonutil.ToString(loadbalancerBackend)
+			task := &models.Task{
+				JobId:          f.Job.JobId,
+				Owner:          f.Job.Owner,
+				TaskAction:     action,
+				Target:         f.Runtime.Provider,
+				NodeId:         f.ClusterWrapper.Cluster.ClusterId,
+				Directive:      directive,
+				FailureAllowed: failureAllowed,
+			}
+			taskLayer.Tasks = append(taskLayer.Tasks, task)
+		}
+	}
+
+	if len(taskLayer.Tasks) > 0 {
+		return taskLayer
+	} else {
+		return nil
+	}
+}
+
+// addLoadbalancerBackendsLayer adds the instances of the nodes to the
+// loadbalancer listeners declared by their roles.
+func (f *Frame) addLoadbalancerBackendsLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
+	return f.constructLoadbalancerBackendTasks(ActionAddLoadbalancerBackends, nodeIds, failureAllowed)
+}
+
+// deleteLoadbalancerBackendsLayer removes the instances of the nodes from the
+// loadbalancer listeners declared by their roles.
+func (f *Frame) deleteLoadbalancerBackendsLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
+	return f.constructLoadbalancerBackendTasks(ActionDeleteLoadbalancerBackends, nodeIds, failureAllowed)
+}
+
 func (f *Frame) formatAndMountVolumeLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
 	taskLayer := new(models.TaskLayer)
 
@@ -683,10 +888,14 @@ func (f *Frame) removeContainerLayer(nodeIds []string, failureAllowed bool) *mod
 func (f *Frame) sshKeygenLayer(failureAllowed bool) *models.TaskLayer {
 	taskLayer := new(models.TaskLayer)
 	ctx := client.GetSystemUserContext()
-	clusterClient, err := clusterclient.NewClient()
-	if err != nil {
-		f.Logger.Error("New ssh key gen task layer failed: %+v", err)
-		return nil
+	var clusterClient *clusterclient.Client
+	if !f.DryRun {
+		var err error
+		clusterClient, err = clusterclient.NewClient()
+		if err != nil {
+			f.Logger.Error("New ssh key gen task layer failed: %+v", err)
+			return nil
+		}
 	}
 
 	for nodeId, clusterNode := range f.ClusterWrapper.ClusterNodesWithKeyPairs {
@@ -700,12 +909,14 @@ func (f *Frame) sshKeygenLayer(failureAllowed bool) *models.TaskLayer {
 					clusterCommon.Passphraseless, nodeId)
 				return nil
 			}
-			_, err = clusterClient.ModifyClusterNode(ctx, &pb.ModifyClusterNodeRequest{
-				ClusterNode: &pb.ClusterNode{
-					NodeId: pbutil.ToProtoString(nodeId),
-					PubKey: pbutil.ToProtoString(public),
-				},
-			})
+			if clusterClient != nil {
+				_, err = clusterClient.ModifyClusterNode(ctx, &pb.ModifyClusterNodeRequest{
+					ClusterNode: &pb.ClusterNode{
+						NodeId: pbutil.ToProtoString(nodeId),
+						PubKey: pbutil.ToProtoString(public),
+					},
+				})
+			}
 			cmd := fmt.Sprintf("mkdir -p /root/.ssh/ && chmod 700 /root/.ssh/ && "+
 				"echo \"%s\" > /root/.ssh/id_%s && echo \"%s\" > /root/.ssh/id_%s.pub && "+
 				"chown 600 /root/.ssh/id_%s && chown 644 /root/.ssh/id_%s.pub",
@@ -797,7 +1008,7 @@ func (f *Frame) umountVolumeLayer(nodeIds []string, failureAllowed bool) *models
 }
 
 func (f *Frame) getUserDataExec(filename, contents, imageUrl, frontgateIp string) string {
-	if pi.Global() == nil {
+	if pi.Global() == nil && !f.DryRun {
 		f.Logger.Error("Pi global should be init.")
 		return ""
 	}
@@ -1272,21 +1483,24 @@ func (f *Frame) CreateClusterLayer() *models.TaskLayer {
 	headTaskLayer := new(models.TaskLayer)
 
 	headTaskLayer.
-		Append(f.createVolumesLayer(nodeIds, false)).        // create volume
-		Append(f.waitFrontgateLayer(false)).                 // wait frontgate cluster to be active
-		Append(f.runInstancesLayer(nodeIds, false)).         // run instance and attach volume to instance
-		Append(f.pingDroneLayer(nodeIds, false)).            // ping drone
-		Append(f.setDroneConfigLayer(nodeIds, false)).       // set drone config
-		Append(f.formatAndMountVolumeLayer(nodeIds, false)). // format and mount volume to instance
-		Append(f.removeContainerLayer(nodeIds, false)).      // remove default container
-		Append(f.pingDroneLayer(nodeIds, false)).            // ping drone
-		Append(f.setDroneConfigLayer(nodeIds, false)).       // set drone config
-		Append(f.sshKeygenLayer(false)).                     // generate ssh key
-		Append(f.deregisterMetadataLayer(true)).             // deregister cluster
-		Append(f.registerMetadataLayer(false)).              // register cluster metadata
-		Append(f.startConfdServiceLayer(nodeIds, false)).    // start confd service
-		Append(f.initAndStartServiceLayer(nodeIds, false)).  // register init and start cmd to exec
-		Append(f.deregisterCmdLayer(nodeIds, true))          // deregister cmd
+		Append(f.createNetworkLayer(false)).                    // create vpc and subnet if no subnet given
+		Append(f.createVolumesLayer(nodeIds, false)).           // create volume
+		Append(f.waitFrontgateLayer(false)).                    // wait frontgate cluster to be active
+		Append(f.runInstancesLayer(nodeIds, false)).            // run instance and attach volume to instance
+		Append(f.allocateEipsLayer(nodeIds, false)).            // allocate and bind eip to instance of public endpoint
+		Append(f.pingDroneLayer(nodeIds, false)).               // ping drone
+		Append(f.setDroneConfigLayer(nodeIds, false)).          // set drone config
+		Append(f.formatAndMountVolumeLayer(nodeIds, false)).    // format and mount volume to instance
+		Append(f.removeContainerLayer(nodeIds, false)).         // remove default container
+		Append(f.pingDroneLayer(nodeIds, false)).               // ping drone
+		Append(f.setDroneConfigLayer(nodeIds, false)).          // set drone config
+		Append(f.sshKeygenLayer(false)).                        // generate ssh key
+		Append(f.deregisterMetadataLayer(true)).                // deregister cluster
+		Append(f.registerMetadataLayer(false)).                 // register cluster metadata
+		Append(f.startConfdServiceLayer(nodeIds, false)).       // start confd service
+		Append(f.initAndStartServiceLayer(nodeIds, false)).     // register init and start cmd to exec
+		Append(f.addLoadbalancerBackendsLayer(nodeIds, false)). // add instance to loadbalancer
+		Append(f.deregisterCmdLayer(nodeIds, true))             // deregister cmd
 
 	return headTaskLayer.Child
 }
@@ -1338,6 +1552,9 @@ func (f *Frame) DeleteClusterLayer() *models.TaskLayer {
 	}
 	headTaskLayer := new(models.TaskLayer)
 
+	headTaskLayer.
+		Append(f.deleteLoadbalancerBackendsLayer(nodeIds, true)) // remove instance from loadbalancer
+
 	if f.ClusterWrapper.Cluster.Status == constants.StatusActive {
 		headTaskLayer.
 			Append(f.destroyAndStopServiceLayer(nodeIds, nil, true)). // register destroy and stop cmd to exec
@@ -1348,8 +1565,10 @@ func (f *Frame) DeleteClusterLayer() *models.TaskLayer {
 	}
 
 	headTaskLayer.
+		Append(f.releaseEipsLayer(nodeIds, false)).     // unbind and release eip of instance
 		Append(f.deleteInstancesLayer(nodeIds, false)). // delete instance
 		Append(f.deleteVolumesLayer(nodeIds, false)).   // delete volume
+		Append(f.deleteNetworkLayer(false)).            // delete vpc and subnet created for the cluster
 		Append(f.deregisterMetadataLayer(true))         // deregister cluster
 
 	return headTaskLayer.Child
@@ -1370,6 +1589,7 @@ func (f *Frame) AddClusterNodesLayer() *models.TaskLayer {
 		Append(f.scaleOutPreCheckServiceLayer(nonAddNodeIds, false)).                       // register scale out pre check to exec
 		Append(f.createVolumesLayer(addNodeIds, false)).                                    // create volume
 		Append(f.runInstancesLayer(addNodeIds, false)).                                     // run instance and attach volume to instance
+		Append(f.allocateEipsLayer(addNodeIds, false)).                                     // allocate and bind eip to instance of public endpoint
 		Append(f.pingDroneLayer(addNodeIds, false)).                                        // ping drone
 		Append(f.setDroneConfigLayer(addNodeIds, false)).                                   // set drone config
 		Append(f.formatAndMountVolumeLayer(addNodeIds, false)).                             // format and mount volume to instance
@@ -1377,6 +1597,7 @@ func (f *Frame) AddClusterNodesLayer() *models.TaskLayer {
 		Append(f.registerScalingNodesMetadataLayer(addNodeIds, RegisterNodeAdding, false)). // register adding hosts metadata
 		Append(f.startConfdServiceLayer(addNodeIds, false)).                                // start confd service
 		Append(f.initAndStartServiceLayer(addNodeIds, false)).                              // register init and start cmd to exec
+		Append(f.addLoadbalancerBackendsLayer(addNodeIds, false)).                          // add instance to loadbalancer
 		Append(f.scaleOutServiceLayer(nonAddNodeIds, false)).                               // register scale out cmd to exec
 		Append(f.deregisterScalingNodesMetadataLayer(RegisterNodeAdding, true))             // deregister adding host metadata
 	return headTaskLayer.Child
@@ -1396,10 +1617,12 @@ func (f *Frame) DeleteClusterNodesLayer() *models.TaskLayer {
 	headTaskLayer.
 		Append(f.registerScalingNodesMetadataLayer(deleteNodeIds, RegisterNodeDeleting, false)).                    // register scale in node metadata
 		Append(f.scaleInPreCheckServiceLayer(nonDeleteNodeIds, false)).                                             // register scale in pre check to exec
+		Append(f.deleteLoadbalancerBackendsLayer(deleteNodeIds, true)).                                             // remove instance from loadbalancer
 		Append(f.destroyAndStopServiceLayer(deleteNodeIds, f.scaleInServiceLayer(nonDeleteNodeIds, false), false)). // register destroy, scale in and stop cmd to exec
 		Append(f.stopConfdServiceLayer(deleteNodeIds, false)).                                                      // stop confd service
 		Append(f.umountVolumeLayer(deleteNodeIds, false)).                                                          // umount volume from instance
 		Append(f.detachVolumesLayer(deleteNodeIds, false)).                                                         // detach volume from instance
+		Append(f.releaseEipsLayer(deleteNodeIds, false)).                                                           // unbind and release eip of instance
 		Append(f.deleteInstancesLayer(deleteNodeIds, false)).                                                       // delete instance
 		Append(f.deleteVolumesLayer(deleteNodeIds, false)).                                                         // delete volume
 		Append(f.deregisterNodesMetadataLayer(deleteNodeIds, false)).                                               // deregister deleting cluster nodes metadata
@@ -38,3 +38,29 @@ type ProviderHandlerInterface interface {
 	DescribeSubnet(runtimeId, subnetId string) (*models.Subnet, error)
 	DescribeVpc(runtimeId, vpcId string) (*models.Vpc, error)
 }
+
+// NetworkHandlerInterface is implemented by the provider handlers that
+// provision the vpc, subnet and eips of the clusters, e.g. qingcloud and aws.
+type NetworkHandlerInterface interface {
+	CreateNetwork(task *models.Task) error
+	WaitCreateNetwork(task *models.Task) error
+
+	DeleteNetwork(task *models.Task) error
+	WaitDeleteNetwork(task *models.Task) error
+
+	AllocateEips(task *models.Task) error
+	WaitAllocateEips(task *models.Task) error
+
+	ReleaseEips(task *models.Task) error
+	WaitReleaseEips(task *models.Task) error
+}
+
+// LoadbalancerHandlerInterface is implemented by the provider handlers that keep
+// the nodes of a role in sync with the loadbalancer listeners of the role.
+type LoadbalancerHandlerInterface interface {
+	AddLoadbalancerBackends(task *models.Task) error
+	WaitAddLoadbalancerBackends(task *models.Task) error
+
+	DeleteLoadbalancerBackends(task *models.Task) error
+	WaitDeleteLoadbalancerBackends(task *models.Task) error
+}
@@ -49,14 +49,15 @@ func (p *Parser) generateServerId(upperBound int, excludeServerIds []int) (int,
 
 func (p *Parser) ParseClusterRole(clusterConf app.ClusterConf, node app.Node) (*models.ClusterRole, error) {
 	clusterRole := &models.ClusterRole{
-		Role:         node.Role,
-		Cpu:          node.CPU,
-		Gpu:          node.GPU,
-		Memory:       node.Memory,
-		InstanceSize: node.Volume.InstanceSize,
-		StorageSize:  node.Volume.Size,
-		MountOptions: node.Volume.MountOptions,
-		FileSystem:   node.Volume.Filesystem,
+		Role:           node.Role,
+		Cpu:            node.CPU,
+		Gpu:            node.GPU,
+		Memory:         node.Memory,
+		InstanceSize:   node.Volume.InstanceSize,
+		StorageSize:    node.Volume.Size,
+		MountOptions:   node.Volume.MountOptions,
+		FileSystem:     node.Volume.Filesystem,
+		PublicEndpoint: node.PublicEndpoint,
 	}
 
 	mountPoint := node.Volume.MountPoint
@@ -162,7 +163,7 @@ func (p *Parser) ParseClusterLoadbalancer(node app.Node) []*models.ClusterLoadba
 	var clusterLoadbalancers []*models.ClusterLoadbalancer
 	for _, loadbalancer := range node.Loadbalancer {
 		clusterLoadbalancer := &models.ClusterLoadbalancer{
-			Role: node.Role,
+			Role:                   node.Role,
 			LoadbalancerListenerId: loadbalancer.Listener,
 			LoadbalancerPolicyId:   loadbalancer.Policy,
 			LoadbalancerPort:       loadbalancer.Port,
@@ -259,6 +260,10 @@ func (p *Parser) ParseClusterCommon(clusterConf app.ClusterConf, node app.Node)
 		clusterCommon.Monitor = ""
 	}
 
+	if len(node.Logs) > 0 {
+		clusterCommon.Logs = jsonutil.ToString(node.Logs)
+	}
+
 	for serviceName, service := range node.Services {
 		var serviceValue map[string]interface{}
 		switch reflect.TypeOf(service).Kind() {
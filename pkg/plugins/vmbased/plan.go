@@ -0,0 +1,191 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package vmbased
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/config"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+// Fake resources of the plan frame.
+const (
+	PlanClusterId   = "cl-plan"
+	PlanFrontgateId = "cl-plan-frontgate"
+	PlanRuntimeId   = "rt-plan"
+	PlanJobId       = "j-plan"
+	PlanOwner       = "usr-plan"
+	PlanZone        = "plan"
+	PlanImageId     = "img-plan"
+)
+
+// NewPlanFrame parses the cluster conf and returns the Frame against a fake
+// runtime, the task layers can be built without deploying anything.
+// The nodes are pending as the cluster is being created.
+func NewPlanFrame(clusterConf app.ClusterConf, provider string, logger *logger.Logger) (*Frame, error) {
+	parser := Parser{Logger: logger}
+	clusterWrapper, err := parser.Parse(clusterConf)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := clusterWrapper.Cluster
+	cluster.ClusterId = PlanClusterId
+	cluster.FrontgateId = PlanFrontgateId
+	cluster.RuntimeId = PlanRuntimeId
+	cluster.Zone = PlanZone
+	cluster.Owner = PlanOwner
+	cluster.ClusterType = constants.NormalClusterType
+
+	// the node id is generated when the cluster is registered,
+	// use the key of the parser instead to keep the plan stable.
+	var keys []string
+	for key := range clusterWrapper.ClusterNodesWithKeyPairs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	clusterNodes := make(map[string]*models.ClusterNodeWithKeyPairs)
+	for i, key := range keys {
+		clusterNode := clusterWrapper.ClusterNodesWithKeyPairs[key]
+		clusterNode.NodeId = "cln-" + key
+		clusterNode.ClusterId = PlanClusterId
+		clusterNode.Owner = PlanOwner
+		clusterNode.PrivateIp = planNodeIp(i)
+		clusterNodes[clusterNode.NodeId] = clusterNode
+	}
+	clusterWrapper.ClusterNodesWithKeyPairs = clusterNodes
+
+	for _, clusterCommon := range clusterWrapper.ClusterCommons {
+		clusterCommon.ClusterId = PlanClusterId
+	}
+	for _, clusterRole := range clusterWrapper.ClusterRoles {
+		clusterRole.ClusterId = PlanClusterId
+	}
+
+	runtime := new(runtimeclient.Runtime)
+	runtime.RuntimeId = PlanRuntimeId
+	runtime.Provider = provider
+	runtime.Zone = PlanZone
+
+	return &Frame{
+		Job: &models.Job{
+			JobId:     PlanJobId,
+			Owner:     PlanOwner,
+			ClusterId: PlanClusterId,
+			Provider:  provider,
+		},
+		ClusterWrapper: clusterWrapper,
+		Runtime:        runtime,
+		Logger:         logger,
+		ImageConfig: &config.ImageConfig{
+			ImageId: PlanImageId,
+		},
+		DryRun: true,
+	}, nil
+}
+
+// PlanActivate marks the cluster created, the instances, volumes, eips and
+// the network created for the cluster are filled with fake ids.
+func (f *Frame) PlanActivate() {
+	cluster := f.ClusterWrapper.Cluster
+	cluster.Status = constants.StatusActive
+	if cluster.SubnetId == "" {
+		cluster.ManagedVpcId = "vpc-plan"
+		cluster.ManagedSubnetId = "vxnet-plan"
+		cluster.ManagedEipId = "eip-plan"
+		cluster.VpcId = cluster.ManagedVpcId
+		cluster.SubnetId = cluster.ManagedSubnetId
+	}
+	for nodeId, clusterNode := range f.ClusterWrapper.ClusterNodesWithKeyPairs {
+		f.activateNode(nodeId, clusterNode)
+	}
+}
+
+// PlanAddNodes adds count pending nodes to the role of the active cluster.
+func (f *Frame) PlanAddNodes(role string, count int) error {
+	if count <= 0 {
+		return fmt.Errorf("invalid node count [%d], must be positive", count)
+	}
+	nodes := f.roleNodes(role)
+	if len(nodes) == 0 {
+		return fmt.Errorf("no node of role [%s]", role)
+	}
+
+	serverId := nodes[len(nodes)-1].ServerId
+	for i := 0; i < count; i++ {
+		serverId++
+		clusterNode := *nodes[0].ClusterNode
+		clusterNode.NodeId = fmt.Sprintf("cln-%s%d", role, serverId)
+		clusterNode.ServerId = serverId
+		clusterNode.Status = constants.StatusPending
+		clusterNode.InstanceId = ""
+		clusterNode.VolumeId = ""
+		clusterNode.EipId = ""
+		clusterNode.Device = ""
+		clusterNode.PrivateIp = planNodeIp(len(f.ClusterWrapper.ClusterNodesWithKeyPairs))
+
+		f.ClusterWrapper.ClusterNodesWithKeyPairs[clusterNode.NodeId] = &models.ClusterNodeWithKeyPairs{
+			ClusterNode: &clusterNode,
+		}
+	}
+	return nil
+}
+
+// PlanDeleteNodes marks the last count nodes of the role deleting.
+func (f *Frame) PlanDeleteNodes(role string, count int) error {
+	if count <= 0 {
+		return fmt.Errorf("invalid node count [%d], must be positive", count)
+	}
+	nodes := f.roleNodes(role)
+	if count > len(nodes) {
+		return fmt.Errorf("role [%s] has only [%d] nodes", role, len(nodes))
+	}
+
+	for _, clusterNode := range nodes[len(nodes)-count:] {
+		clusterNode.Status = constants.StatusDeleting
+	}
+	return nil
+}
+
+func (f *Frame) activateNode(nodeId string, clusterNode *models.ClusterNodeWithKeyPairs) {
+	clusterNode.Status = constants.StatusActive
+	clusterNode.InstanceId = "i-" + nodeId
+
+	role := strings.TrimSuffix(clusterNode.Role, constants.ReplicaRoleSuffix)
+	clusterRole, ok := f.ClusterWrapper.ClusterRoles[role]
+	if ok && clusterRole.StorageSize > 0 {
+		clusterNode.VolumeId = "vol-" + nodeId
+		clusterNode.Device = "/dev/vdc"
+	}
+	if ok && clusterRole.PublicEndpoint {
+		clusterNode.EipId = "eip-" + nodeId
+	}
+}
+
+// roleNodes returns the nodes of the role ordered by server id.
+func (f *Frame) roleNodes(role string) []*models.ClusterNodeWithKeyPairs {
+	var nodes []*models.ClusterNodeWithKeyPairs
+	for _, clusterNode := range f.ClusterWrapper.ClusterNodesWithKeyPairs {
+		if clusterNode.Role == role {
+			nodes = append(nodes, clusterNode)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ServerId < nodes[j].ServerId
+	})
+	return nodes
+}
+
+func planNodeIp(index int) string {
+	return fmt.Sprintf("192.168.%d.%d", index/250, index%250+2)
+}
@@ -0,0 +1,282 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package vmbased
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit/app"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+func TestPlanFrame(t *testing.T) {
+	clusterConf := app.ClusterConf{}
+	err := jsonutil.Decode([]byte(hbaseMustache), &clusterConf)
+	if err != nil {
+		t.Fatalf("Parse mustache failed: %+v", err)
+	}
+
+	frame, err := NewPlanFrame(clusterConf, constants.ProviderQingCloud, logger.NewLogger())
+	if err != nil {
+		t.Fatalf("New plan frame failed: %+v", err)
+	}
+
+	for nodeId, clusterNode := range frame.ClusterWrapper.ClusterNodesWithKeyPairs {
+		if clusterNode.NodeId != nodeId || clusterNode.PrivateIp == "" {
+			t.Errorf("Node [%s] not filled: %+v", nodeId, clusterNode.ClusterNode)
+		}
+	}
+	if frame.CreateClusterLayer() == nil {
+		t.Errorf("Create cluster layer is empty")
+	}
+
+	frame.PlanActivate()
+	if err := frame.PlanAddNodes("hbase-slave", 2); err != nil {
+		t.Fatalf("Add nodes failed: %+v", err)
+	}
+
+	var pending int
+	for _, clusterNode := range frame.ClusterWrapper.ClusterNodesWithKeyPairs {
+		if clusterNode.Status == constants.StatusPending {
+			pending++
+		}
+	}
+	if pending != 2 {
+		t.Errorf("Expect [2] pending nodes, while get [%d]", pending)
+	}
+
+	if err := frame.PlanDeleteNodes("hbase-slave", 10); err == nil {
+		t.Errorf("Expect error when deleting more nodes than the role has")
+	}
+	for _, count := range []int{0, -1} {
+		if err := frame.PlanAddNodes("hbase-slave", count); err == nil {
+			t.Errorf("Expect error when adding [%d] nodes", count)
+		}
+		if err := frame.PlanDeleteNodes("hbase-slave", count); err == nil {
+			t.Errorf("Expect error when deleting [%d] nodes", count)
+		}
+	}
+}
+
+func tNewPlanFrame(t *testing.T) *Frame {
+	clusterConf := app.ClusterConf{}
+	err := jsonutil.Decode([]byte(hbaseMustache), &clusterConf)
+	if err != nil {
+		t.Fatalf("Parse mustache failed: %+v", err)
+	}
+	frame, err := NewPlanFrame(clusterConf, constants.ProviderQingCloud, logger.NewLogger())
+	if err != nil {
+		t.Fatalf("New plan frame failed: %+v", err)
+	}
+	return frame
+}
+
+// tLayerActions returns the action of each task layer in order.
+func tLayerActions(taskLayer *models.TaskLayer) []string {
+	var actions []string
+	for ; taskLayer != nil; taskLayer = taskLayer.Child {
+		if len(taskLayer.Tasks) > 0 {
+			actions = append(actions, taskLayer.Tasks[0].TaskAction)
+		}
+	}
+	return actions
+}
+
+func tLayerTasks(taskLayer *models.TaskLayer, action string) []*models.Task {
+	var tasks []*models.Task
+	for ; taskLayer != nil; taskLayer = taskLayer.Child {
+		for _, task := range taskLayer.Tasks {
+			if task.TaskAction == action {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+	return tasks
+}
+
+func tTaskRole(frame *Frame, task *models.Task) string {
+	clusterNode, ok := frame.ClusterWrapper.ClusterNodesWithKeyPairs[task.NodeId]
+	if !ok {
+		return ""
+	}
+	return clusterNode.Role
+}
+
+func tActionIndex(actions []string, action string) int {
+	for i, a := range actions {
+		if a == action {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestPlanFrameNetwork(t *testing.T) {
+	frame := tNewPlanFrame(t)
+	frame.ClusterWrapper.Cluster.SubnetId = ""
+	frame.ClusterWrapper.ClusterRoles["hbase-master"].PublicEndpoint = true
+
+	createLayer := frame.CreateClusterLayer()
+	actions := tLayerActions(createLayer)
+	if actions[0] != ActionCreateNetwork {
+		t.Fatalf("Expect the cluster to start with [%s], while get %v", ActionCreateNetwork, actions)
+	}
+	if len(tLayerTasks(createLayer, ActionCreateNetwork)) != 1 {
+		t.Errorf("Expect [1] create network task")
+	}
+	allocateTasks := tLayerTasks(createLayer, ActionAllocateEips)
+	if len(allocateTasks) != 1 || tTaskRole(frame, allocateTasks[0]) != "hbase-master" {
+		t.Errorf("Expect one eip allocated for the master node, while get [%d]", len(allocateTasks))
+	}
+	if tActionIndex(actions, ActionAllocateEips) < tActionIndex(actions, ActionRunInstances) {
+		t.Errorf("Expect eips allocated after the instances run: %v", actions)
+	}
+
+	frame.PlanActivate()
+	deleteLayer := frame.DeleteClusterLayer()
+	actions = tLayerActions(deleteLayer)
+	releaseTasks := tLayerTasks(deleteLayer, ActionReleaseEips)
+	if len(releaseTasks) != 1 || tTaskRole(frame, releaseTasks[0]) != "hbase-master" {
+		t.Errorf("Expect the eip of the master node released, while get [%d]", len(releaseTasks))
+	}
+	if tActionIndex(actions, ActionReleaseEips) > tActionIndex(actions, ActionTerminateInstances) {
+		t.Errorf("Expect eips released before the instances deleted: %v", actions)
+	}
+	deleteNetworkTasks := tLayerTasks(deleteLayer, ActionDeleteNetwork)
+	if len(deleteNetworkTasks) != 1 {
+		t.Fatalf("Expect [1] delete network task, while get [%d]", len(deleteNetworkTasks))
+	}
+	if tActionIndex(actions, ActionDeleteNetwork) < tActionIndex(actions, ActionDeleteVolumes) {
+		t.Errorf("Expect the network deleted after the volumes: %v", actions)
+	}
+	network, err := models.NewNetwork(deleteNetworkTasks[0].Directive)
+	if err != nil {
+		t.Fatalf("Decode network failed: %+v", err)
+	}
+	if network.VpcId != "vpc-plan" || network.SubnetId != "vxnet-plan" || network.EipId != "eip-plan" {
+		t.Errorf("Unexpected network to delete: %+v", network)
+	}
+}
+
+func TestPlanFrameGivenSubnet(t *testing.T) {
+	frame := tNewPlanFrame(t)
+
+	if tLayerTasks(frame.CreateClusterLayer(), ActionCreateNetwork) != nil {
+		t.Errorf("Expect no network created for the cluster with subnet")
+	}
+	if tLayerTasks(frame.CreateClusterLayer(), ActionAllocateEips) != nil {
+		t.Errorf("Expect no eip allocated without public endpoint")
+	}
+	frame.PlanActivate()
+	if tLayerTasks(frame.DeleteClusterLayer(), ActionDeleteNetwork) != nil {
+		t.Errorf("Expect the given network kept")
+	}
+}
+
+func tLoadbalancerBackends(t *testing.T, tasks []*models.Task) []*models.LoadbalancerBackend {
+	var loadbalancerBackends []*models.LoadbalancerBackend
+	for _, task := range tasks {
+		loadbalancerBackend, err := models.NewLoadbalancerBackend(task.Directive)
+		if err != nil {
+			t.Fatalf("Decode loadbalancer backend failed: %+v", err)
+		}
+		loadbalancerBackends = append(loadbalancerBackends, loadbalancerBackend)
+	}
+	return loadbalancerBackends
+}
+
+func TestPlanFrameLoadbalancer(t *testing.T) {
+	frame := tNewPlanFrame(t)
+	frame.ClusterWrapper.ClusterLoadbalancers = map[string][]*models.ClusterLoadbalancer{
+		"hbase-slave": {{
+			ClusterId:              PlanClusterId,
+			Role:                   "hbase-slave",
+			LoadbalancerListenerId: "lbl-slave",
+			LoadbalancerPort:       8080,
+		}},
+		"hbase-master": {{
+			ClusterId:              PlanClusterId,
+			Role:                   "hbase-master",
+			LoadbalancerListenerId: "lbl-master",
+			LoadbalancerPort:       80,
+		}},
+	}
+	masters := frame.roleNodes("hbase-master")
+	replica := *masters[0].ClusterNode
+	replica.NodeId = "cln-hbase-master" + constants.ReplicaRoleSuffix
+	replica.Role = "hbase-master" + constants.ReplicaRoleSuffix
+	frame.ClusterWrapper.ClusterNodesWithKeyPairs[replica.NodeId] = &models.ClusterNodeWithKeyPairs{
+		ClusterNode: &replica,
+	}
+	replicaCommon := *frame.ClusterWrapper.ClusterCommons["hbase-master"]
+	replicaCommon.Role = replica.Role
+	frame.ClusterWrapper.ClusterCommons[replica.Role] = &replicaCommon
+	replicaRole := *frame.ClusterWrapper.ClusterRoles["hbase-master"]
+	replicaRole.Role = replica.Role
+	frame.ClusterWrapper.ClusterRoles[replica.Role] = &replicaRole
+
+	addTasks := tLayerTasks(frame.CreateClusterLayer(), ActionAddLoadbalancerBackends)
+	backends := tLoadbalancerBackends(t, addTasks)
+	if len(backends) != 2 {
+		t.Fatalf("Expect [2] add loadbalancer backends tasks, while get [%d]", len(backends))
+	}
+	if backends[0].Role != "hbase-master" || backends[0].ListenerId != "lbl-master" || backends[1].Role != "hbase-slave" {
+		t.Errorf("Expect the backends ordered by role, while get [%s] [%s]", backends[0].Role, backends[1].Role)
+	}
+	expectMasterIds := []string{replica.NodeId, masters[0].NodeId}
+	if len(backends[0].NodeIds) != 2 || backends[0].NodeIds[0] != expectMasterIds[0] || backends[0].NodeIds[1] != expectMasterIds[1] {
+		t.Errorf("Expect master backends %v, while get %v", expectMasterIds, backends[0].NodeIds)
+	}
+	if len(backends[1].NodeIds) != 3 {
+		t.Errorf("Expect [3] slave backends, while get %v", backends[1].NodeIds)
+	}
+	for _, task := range addTasks {
+		if task.FailureAllowed {
+			t.Errorf("Expect adding backends not failure allowed")
+		}
+	}
+	// the layer is stable across builds
+	rebuildTasks := tLayerTasks(frame.CreateClusterLayer(), ActionAddLoadbalancerBackends)
+	for i := range addTasks {
+		if addTasks[i].Directive != rebuildTasks[i].Directive {
+			t.Errorf("Expect the same directive, while get [%s] and [%s]", addTasks[i].Directive, rebuildTasks[i].Directive)
+		}
+	}
+
+	frame.PlanActivate()
+	if err := frame.PlanAddNodes("hbase-slave", 2); err != nil {
+		t.Fatalf("Add nodes failed: %+v", err)
+	}
+	backends = tLoadbalancerBackends(t, tLayerTasks(frame.AddClusterNodesLayer(), ActionAddLoadbalancerBackends))
+	if len(backends) != 1 || backends[0].Role != "hbase-slave" || len(backends[0].NodeIds) != 2 {
+		t.Errorf("Expect the [2] added slave nodes as backends, while get %+v", backends)
+	}
+
+	frame.PlanActivate()
+	if err := frame.PlanDeleteNodes("hbase-slave", 1); err != nil {
+		t.Fatalf("Delete nodes failed: %+v", err)
+	}
+	deleteTasks := tLayerTasks(frame.DeleteClusterNodesLayer(), ActionDeleteLoadbalancerBackends)
+	backends = tLoadbalancerBackends(t, deleteTasks)
+	if len(backends) != 1 || backends[0].Role != "hbase-slave" || len(backends[0].NodeIds) != 1 {
+		t.Fatalf("Expect [1] deleted slave node as backend, while get %+v", backends)
+	}
+	if !deleteTasks[0].FailureAllowed {
+		t.Errorf("Expect deleting backends failure allowed")
+	}
+
+	deleteTasks = tLayerTasks(frame.DeleteClusterLayer(), ActionDeleteLoadbalancerBackends)
+	if len(deleteTasks) != 2 {
+		t.Fatalf("Expect [2] delete loadbalancer backends tasks, while get [%d]", len(deleteTasks))
+	}
+	for _, task := range deleteTasks {
+		if !task.FailureAllowed {
+			t.Errorf("Expect deleting backends failure allowed")
+		}
+	}
+}
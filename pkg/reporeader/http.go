@@ -5,10 +5,13 @@
 package reporeader
 
 import (
+	"bytes"
 	"io/ioutil"
 	"net/http"
 	neturl "net/url"
 	"strings"
+
+	"openpitrix.io/openpitrix/pkg/logger"
 )
 
 type HttpReader struct {
@@ -29,6 +32,9 @@ func (h *HttpReader) GetIndexYaml() ([]byte, error) {
 		return nil, ErrGetIndexYamlFailed
 	}
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrIndexYamlNotFound
+	}
 	if resp.StatusCode != 200 {
 		return nil, ErrGetIndexYamlFailed
 	}
@@ -40,3 +46,30 @@ func (h *HttpReader) GetIndexYaml() ([]byte, error) {
 
 	return body, nil
 }
+
+func (h *HttpReader) GetFileUrl(name string) string {
+	return strings.TrimSuffix(h.url.String(), "/") + "/" + name
+}
+
+// PutFile uploads the file with http PUT, the server should accept it
+// like devkit.RepositoryServer.
+func (h *HttpReader) PutFile(name string, content []byte) error {
+	u := h.GetFileUrl(name)
+	req, err := http.NewRequest(http.MethodPut, u, bytes.NewReader(content))
+	if err != nil {
+		return ErrPutFileFailed
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Error("Failed to put [%s], error: %+v", u, err)
+		return ErrPutFileFailed
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		logger.Error("Failed to put [%s], status code [%d]", u, resp.StatusCode)
+		return ErrPutFileFailed
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"openpitrix.io/openpitrix/pkg/client"
+	appclient "openpitrix.io/openpitrix/pkg/client/app"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/httputil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+const readmeFile = "README.md"
+
+// packageFiles are the files of the package by the path in the package.
+type packageFiles map[string][]byte
+
+func newAttachment(name string, content []byte) (*pb.AppAttachment, error) {
+	_, err := models.GetAppAttachmentId(name, content)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AppAttachment{
+		Name:    pbutil.ToProtoString(name),
+		Content: content,
+	}, nil
+}
+
+func (files packageFiles) getReadme() (*pb.AppAttachment, error) {
+	for name, content := range files {
+		if strings.EqualFold(name, readmeFile) {
+			return newAttachment(readmeFile, content)
+		}
+	}
+	return nil, nil
+}
+
+// getAttachment returns the file of the package, the icon and screenshots
+// of the metadata are either the paths in the package or the urls, the
+// urls are downloaded so that they are not hotlinked any more. The urls on
+// other hosts than the repo are downloaded only from the public ips, and
+// must be images.
+func (files packageFiles) getAttachment(repoUrl, ref string) (*pb.AppAttachment, error) {
+	if ref == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return nil, err
+		}
+		r, err := url.Parse(repoUrl)
+		if err != nil {
+			return nil, err
+		}
+		var content []byte
+		if u.Host == r.Host {
+			content, err = download(ref, models.MaxAppAttachmentSize)
+		} else {
+			content, err = downloadExternalImage(ref, models.MaxAppAttachmentSize)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return newAttachment(path.Base(u.Path), content)
+	}
+	name := path.Clean(strings.TrimPrefix(ref, "/"))
+	content, ok := files[name]
+	if !ok {
+		return nil, fmt.Errorf("file [%s] not found in package", name)
+	}
+	return newAttachment(name, content)
+}
+
+// replaced in tests, the test server is on the loopback
+var httpGetExternal = httputil.HttpGetPublic
+
+// downloadExternalImage downloads the image on a host out of the repo,
+// the url comes from the package, so it is restricted to the public ips.
+func downloadExternalImage(url string, maxSize int64) ([]byte, error) {
+	resp, err := httpGetExternal(url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get [%s] failed", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get [%s] failed, status code [%d]", url, resp.StatusCode)
+	}
+	contentType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(contentType, "image/") {
+		return nil, fmt.Errorf("content type [%s] of [%s] is not an image", resp.Header.Get("Content-Type"), url)
+	}
+	if resp.ContentLength > maxSize {
+		return nil, fmt.Errorf("size of [%s] exceeds [%d]", url, maxSize)
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read [%s] failed", url)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("size of [%s] exceeds [%d]", url, maxSize)
+	}
+	return content, nil
+}
+
+// syncAppAttachments uploads the readme, icon and screenshots of the
+// package to the app manager, the attachments that fail are skipped.
+func (i *indexer) syncAppAttachments(appId string, files packageFiles, icon string, screenshots []string) error {
+	req := pb.UploadAppAttachmentsRequest{}
+	req.AppId = pbutil.ToProtoString(appId)
+
+	var err error
+	req.Readme, err = files.getReadme()
+	if err != nil {
+		i.log.Warn("Skip readme of app [%s]: %+v", appId, err)
+	}
+	repoUrl := i.repo.GetUrl().GetValue()
+	req.Icon, err = files.getAttachment(repoUrl, icon)
+	if err != nil {
+		i.log.Warn("Skip icon [%s] of app [%s]: %+v", icon, appId, err)
+	}
+	if len(screenshots) > models.MaxAppScreenshots {
+		i.log.Warn("Skip screenshots of app [%s] after the first [%d]", appId, models.MaxAppScreenshots)
+		screenshots = screenshots[:models.MaxAppScreenshots]
+	}
+	for _, screenshot := range screenshots {
+		attachment, err := files.getAttachment(repoUrl, screenshot)
+		if err != nil {
+			i.log.Warn("Skip screenshot [%s] of app [%s]: %+v", screenshot, appId, err)
+			continue
+		}
+		if attachment != nil {
+			req.Screenshots = append(req.Screenshots, attachment)
+		}
+	}
+	if req.Readme == nil && req.Icon == nil && len(req.Screenshots) == 0 {
+		return nil
+	}
+
+	ctx := client.GetSystemUserContext()
+	appManagerClient, err := appclient.NewAppManagerClient()
+	if err != nil {
+		return err
+	}
+	_, err = appManagerClient.UploadAppAttachments(ctx, &req)
+	return err
+}
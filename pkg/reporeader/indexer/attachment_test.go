@@ -0,0 +1,107 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/util/httputil"
+)
+
+func TestGetAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/icon.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte("icon"))
+		case "/large.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(bytes.Repeat([]byte("x"), models.MaxAppAttachmentSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	files := packageFiles{"images/screenshot.png": []byte("screenshot")}
+	for _, tc := range []struct {
+		ref     string
+		name    string
+		content string
+		ok      bool
+	}{
+		{ref: "", ok: true},
+		{ref: "images/screenshot.png", name: "images/screenshot.png", content: "screenshot", ok: true},
+		{ref: "/images/../images/screenshot.png", name: "images/screenshot.png", content: "screenshot", ok: true},
+		{ref: "images/missing.png", ok: false},
+		{ref: server.URL + "/icon.png", name: "icon.png", content: "icon", ok: true},
+		{ref: server.URL + "/large.png", ok: false},
+		{ref: server.URL + "/missing.png", ok: false},
+		// the other hosts are downloaded from the public ips only
+		{ref: "http://169.254.169.254/latest/meta-data/icon.png", ok: false},
+		{ref: strings.Replace(server.URL, "127.0.0.1", "localhost", 1) + "/icon.png", ok: false},
+	} {
+		attachment, err := files.getAttachment(server.URL+"/repo/", tc.ref)
+		if (err == nil) != tc.ok {
+			t.Fatalf("getAttachment [%s]: expect ok %t, got %+v", tc.ref, tc.ok, err)
+		}
+		if err != nil || tc.name == "" {
+			if attachment != nil {
+				t.Fatalf("getAttachment [%s]: expect no attachment, got %+v", tc.ref, attachment)
+			}
+			continue
+		}
+		if attachment.GetName().GetValue() != tc.name || string(attachment.GetContent()) != tc.content {
+			t.Fatalf("getAttachment [%s]: unexpected attachment %+v", tc.ref, attachment)
+		}
+	}
+}
+
+func TestGetAttachmentExternal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/icon.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte("icon"))
+		case "/large.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(bytes.Repeat([]byte("x"), models.MaxAppAttachmentSize+1))
+		case "/page.png":
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte("<html></html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// pretend the loopback test server is a public host
+	httpGetExternal = httputil.HttpGet
+	defer func() { httpGetExternal = httputil.HttpGetPublic }()
+
+	external := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	for _, tc := range []struct {
+		ref string
+		ok  bool
+	}{
+		{ref: external + "/icon.png", ok: true},
+		{ref: external + "/large.png", ok: false},
+		{ref: external + "/page.png", ok: false},
+		{ref: external + "/missing.png", ok: false},
+	} {
+		attachment, err := packageFiles{}.getAttachment(server.URL+"/repo/", tc.ref)
+		if (err == nil) != tc.ok {
+			t.Fatalf("getAttachment [%s]: expect ok %t, got %+v", tc.ref, tc.ok, err)
+		}
+		if err == nil && (attachment.GetName().GetValue() != "icon.png" || string(attachment.GetContent()) != "icon") {
+			t.Fatalf("getAttachment [%s]: unexpected attachment %+v", tc.ref, attachment)
+		}
+	}
+}
@@ -5,16 +5,27 @@
 package indexer
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
 	"sort"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
 
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit"
 	"openpitrix.io/openpitrix/pkg/devkit/app"
+	"openpitrix.io/openpitrix/pkg/util/httputil"
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 	"openpitrix.io/openpitrix/pkg/util/yamlutil"
 )
 
+// the provenance is the signature of the package and its digest
+const maxProvenanceSize = 64 * 1024
+
 type devkitIndexer struct {
 	indexer
 }
@@ -48,6 +59,7 @@ func (h appVersionWrapper) GetVersion() string     { return h.Version.GetVersion
 func (h appVersionWrapper) GetAppVersion() string  { return h.Version.GetAppVersion() }
 func (h appVersionWrapper) GetDescription() string { return h.Version.GetDescription() }
 func (h appVersionWrapper) GetUrls() []string      { return h.Version.GetUrls() }
+func (h appVersionWrapper) GetUpgradeFrom() string { return h.Version.GetUpgradeFrom() }
 func (h appVersionWrapper) GetKeywords() []string  { return h.Version.GetKeywords() }
 func (h appVersionWrapper) GetMaintainers() string {
 	return jsonutil.ToString(h.Version.GetMaintainers())
@@ -61,6 +73,10 @@ func (i *devkitIndexer) IndexRepo() error {
 	if err != nil {
 		return err
 	}
+	trustedKeys, err := devkit.ParsePublicKeys(i.repo.GetTrustedKeys().GetValue())
+	if err != nil {
+		return errors.Wrap(err, "parse trusted keys failed")
+	}
 	for appName, appVersions := range indexFile.Entries {
 		var appId string
 		i.log.Debug("Start index app [%s]", appName)
@@ -75,9 +91,14 @@ func (i *devkitIndexer) IndexRepo() error {
 		}
 		i.log.Info("Sync chart [%s] to app [%s] success", appName, appId)
 		sort.Sort(appVersions)
+		err = i.syncAttachments(appId, appVersions[len(appVersions)-1])
+		if err != nil {
+			i.log.Warn("Failed to sync attachments of app [%s]: %+v", appName, err)
+		}
 		for index, appVersion := range appVersions {
 			var versionId string
-			versionId, err = i.syncAppVersionInfo(appId, appVersion, index)
+			signatureStatus := i.verifyAppVersion(appVersion, trustedKeys)
+			versionId, err = i.syncAppVersionInfo(appId, appVersion, index, signatureStatus)
 			if err != nil {
 				i.log.Error("Failed to sync app version [%s] to app version", appVersion.GetAppVersion())
 				return err
@@ -87,3 +108,77 @@ func (i *devkitIndexer) IndexRepo() error {
 	}
 	return err
 }
+
+// syncAttachments syncs the attachments of the latest version of the app.
+func (i *devkitIndexer) syncAttachments(appId string, appVersion *app.Version) error {
+	pkg, err := download(appVersion.GetUrls()[0], constants.MaxAppPackageSize)
+	if err != nil {
+		return err
+	}
+	a, err := devkit.LoadArchive(bytes.NewReader(pkg))
+	if err != nil {
+		return err
+	}
+	files := make(packageFiles)
+	for _, f := range a.Files {
+		files[f.Name] = f.Data
+	}
+	return i.syncAppAttachments(appId, files, a.Metadata.GetIcon(), a.Metadata.GetScreenshots())
+}
+
+// verifyAppVersion returns the signature status of the app version,
+// the package is checked only when the repo has trusted keys.
+func (i *devkitIndexer) verifyAppVersion(appVersion *app.Version, trustedKeys []ed25519.PublicKey) string {
+	if len(trustedKeys) == 0 {
+		return ""
+	}
+	err := i.verifyPackage(appVersion, trustedKeys)
+	if err != nil {
+		i.log.Warn("App version [%s] is unverified: %+v", appVersion.GetVersion(), err)
+		return constants.SignatureUnverified
+	}
+	return constants.SignatureVerified
+}
+
+func (i *devkitIndexer) verifyPackage(appVersion *app.Version, trustedKeys []ed25519.PublicKey) error {
+	packageUrl := appVersion.GetUrls()[0]
+	pkg, err := download(packageUrl, constants.MaxAppPackageSize)
+	if err != nil {
+		return err
+	}
+	prov, err := download(packageUrl+devkit.ProvenanceSuffix, maxProvenanceSize)
+	if err != nil {
+		return err
+	}
+	s, err := devkit.Verify(pkg, prov, trustedKeys)
+	if err != nil {
+		return err
+	}
+	if appVersion.Digest != "" && appVersion.Digest != s.Digest {
+		return fmt.Errorf("digest [%s] of index.yaml does not match the signed digest [%s]", appVersion.Digest, s.Digest)
+	}
+	if s.Name != appVersion.GetName() || s.Version != appVersion.GetVersion() {
+		return fmt.Errorf("signed app [%s-%s] does not match [%s-%s]", s.Name, s.Version, appVersion.GetName(), appVersion.GetVersion())
+	}
+	return nil
+}
+
+// download reads the content of the url, which is no larger than maxSize.
+func download(url string, maxSize int64) ([]byte, error) {
+	resp, err := httputil.HttpGet(url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get [%s] failed", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get [%s] failed, status code [%d]", url, resp.StatusCode)
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read [%s] failed", url)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("size of [%s] exceeds [%d]", url, maxSize)
+	}
+	return content, nil
+}
@@ -5,16 +5,21 @@
 package indexer
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 
 	"github.com/pkg/errors"
+	"k8s.io/helm/pkg/chartutil"
 	"k8s.io/helm/pkg/repo"
 
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 	"openpitrix.io/openpitrix/pkg/util/yamlutil"
 )
 
+const upgradeFromAnnotation = "upgrade_from"
+
 type helmIndexer struct {
 	indexer
 }
@@ -33,7 +38,16 @@ func (h helmVersionWrapper) GetVersion() string     { return h.ChartVersion.GetV
 func (h helmVersionWrapper) GetAppVersion() string  { return h.ChartVersion.GetAppVersion() }
 func (h helmVersionWrapper) GetDescription() string { return h.ChartVersion.GetDescription() }
 func (h helmVersionWrapper) GetUrls() []string      { return h.ChartVersion.URLs }
-func (h helmVersionWrapper) GetKeywords() []string  { return h.ChartVersion.GetKeywords() }
+
+// GetUpgradeFrom reads the annotation of Chart.yaml, helm drops the unknown
+// fields of Chart.yaml, e.g.
+//
+//	annotations:
+//	  upgrade_from: ">=1.0.0, <2.0.0"
+func (h helmVersionWrapper) GetUpgradeFrom() string {
+	return h.ChartVersion.GetAnnotations()[upgradeFromAnnotation]
+}
+func (h helmVersionWrapper) GetKeywords() []string { return h.ChartVersion.GetKeywords() }
 func (h helmVersionWrapper) GetMaintainers() string {
 	return jsonutil.ToString(h.ChartVersion.GetMaintainers())
 }
@@ -60,10 +74,14 @@ func (i *helmIndexer) IndexRepo() error {
 		}
 		i.log.Info("Sync chart [%s] to app [%s] success", chartName, appId)
 		sort.Sort(chartVersions)
+		err = i.syncAttachments(appId, chartVersions[len(chartVersions)-1])
+		if err != nil {
+			i.log.Warn("Failed to sync attachments of chart [%s]: %+v", chartName, err)
+		}
 		for index, chartVersion := range chartVersions {
 			var versionId string
 			v := helmVersionWrapper{ChartVersion: chartVersion}
-			versionId, err = i.syncAppVersionInfo(appId, v, index)
+			versionId, err = i.syncAppVersionInfo(appId, v, index, "")
 			if err != nil {
 				i.log.Error("Failed to sync chart version [%s] to app version", chartVersion.GetAppVersion())
 				return err
@@ -74,6 +92,24 @@ func (i *helmIndexer) IndexRepo() error {
 	return err
 }
 
+// syncAttachments syncs the attachments of the latest version of the chart,
+// the icon of Chart.yaml is usually an url, charts have no screenshots.
+func (i *helmIndexer) syncAttachments(appId string, chartVersion *repo.ChartVersion) error {
+	pkg, err := download(chartVersion.URLs[0], constants.MaxAppPackageSize)
+	if err != nil {
+		return err
+	}
+	c, err := chartutil.LoadArchive(bytes.NewReader(pkg))
+	if err != nil {
+		return err
+	}
+	files := make(packageFiles)
+	for _, f := range c.Files {
+		files[f.TypeUrl] = f.Value
+	}
+	return i.syncAppAttachments(appId, files, c.Metadata.GetIcon(), nil)
+}
+
 // Reference: https://sourcegraph.com/github.com/kubernetes/helm@fe9d365/-/blob/pkg/repo/chartrepo.go#L111:27
 func (i *helmIndexer) getIndexFile() (*repo.IndexFile, error) {
 	var indexFile = new(repo.IndexFile)
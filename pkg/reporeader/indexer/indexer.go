@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"openpitrix.io/openpitrix/pkg/blob"
 	"openpitrix.io/openpitrix/pkg/client"
 	appclient "openpitrix.io/openpitrix/pkg/client/app"
 	"openpitrix.io/openpitrix/pkg/constants"
@@ -67,6 +68,7 @@ type versionInterface interface {
 	GetAppVersion() string
 	GetDescription() string
 	GetUrls() []string
+	GetUpgradeFrom() string
 }
 
 func (i *indexer) syncAppInfo(app appInterface) (string, error) {
@@ -178,10 +180,11 @@ func (i *indexer) syncAppInfo(app appInterface) (string, error) {
 	}
 }
 
-func (i *indexer) syncAppVersionInfo(appId string, version versionInterface, index int) (string, error) {
+func (i *indexer) syncAppVersionInfo(appId string, version versionInterface, index int, signatureStatus string) (string, error) {
 	owner := i.repo.GetOwner().GetValue()
 
 	var versionId string
+	var uploaded bool
 	ctx := client.GetSystemUserContext()
 	appManagerClient, err := appclient.NewAppManagerClient()
 	if err != nil {
@@ -209,27 +212,47 @@ func (i *indexer) syncAppVersionInfo(appId string, version versionInterface, ind
 		createReq.PackageName = pbutil.ToProtoString(packageName)
 		createReq.Description = pbutil.ToProtoString(description)
 		createReq.Sequence = pbutil.ToProtoUInt32(uint32(index))
+		createReq.UpgradeFrom = pbutil.ToProtoString(version.GetUpgradeFrom())
 
 		createRes, err := appManagerClient.CreateAppVersion(ctx, &createReq)
 		if err != nil {
 			return versionId, err
 		}
 		versionId = createRes.GetVersionId().GetValue()
-		return versionId, err
 	} else {
+		appVersion := res.AppVersionSet[0]
+		// a package uploaded by UploadAppVersionPackage owns the package name
+		// and its signature status, the indexer keeps off both of them
+		uploaded = blob.IsBlobUrl(appVersion.GetPackageName().GetValue())
+
 		modifyReq := pb.ModifyAppVersionRequest{}
-		modifyReq.VersionId = res.AppVersionSet[0].VersionId
-		modifyReq.PackageName = pbutil.ToProtoString(packageName)
+		modifyReq.VersionId = appVersion.VersionId
+		// the name refreshes the semver of the versions indexed before
+		modifyReq.Name = pbutil.ToProtoString(appVersionName)
+		if !uploaded {
+			modifyReq.PackageName = pbutil.ToProtoString(packageName)
+		}
 		modifyReq.Description = pbutil.ToProtoString(description)
 		modifyReq.Sequence = pbutil.ToProtoUInt32(uint32(index))
+		modifyReq.UpgradeFrom = pbutil.ToProtoString(version.GetUpgradeFrom())
 
 		modifyRes, err := appManagerClient.ModifyAppVersion(ctx, &modifyReq)
 		if err != nil {
 			return versionId, err
 		}
 		versionId = modifyRes.GetVersionId().GetValue()
-		return versionId, err
 	}
+
+	if uploaded {
+		return versionId, nil
+	}
+
+	// only the indexer sets the signature status, after verifying the provenance
+	_, err = appManagerClient.ModifyAppVersionSignature(ctx, &pb.ModifyAppVersionSignatureRequest{
+		VersionId:       pbutil.ToProtoString(versionId),
+		SignatureStatus: pbutil.ToProtoString(signatureStatus),
+	})
+	return versionId, err
 }
 
 func (i *indexer) DeleteRepo() error {
@@ -11,6 +11,7 @@ import (
 
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/secret"
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 )
 
@@ -18,8 +19,11 @@ type Err error
 
 var (
 	ErrGetIndexYamlFailed   Err = fmt.Errorf("get index.yaml failed")
+	ErrIndexYamlNotFound    Err = fmt.Errorf("index.yaml not found")
+	ErrPutFileFailed        Err = fmt.Errorf("put file failed")
 	ErrParseUrlFailed       Err = fmt.Errorf("parse url failed")
 	ErrDecodeJsonFailed     Err = fmt.Errorf("decode json failed")
+	ErrDecryptFailed        Err = fmt.Errorf("decrypt credential failed")
 	ErrEmptyAccessKeyId     Err = fmt.Errorf("access key id is empty")
 	ErrEmptySecretAccessKey Err = fmt.Errorf("secret access key is empty")
 	ErrSchemeNotMatched     Err = fmt.Errorf("scheme not matched")
@@ -30,6 +34,14 @@ type Reader interface {
 	GetIndexYaml() ([]byte, error)
 }
 
+// Writer uploads the files of apps to the repo.
+type Writer interface {
+	Reader
+	PutFile(name string, content []byte) error
+	// GetFileUrl returns the url to download the uploaded file
+	GetFileUrl(name string) string
+}
+
 type S3Credential struct {
 	AccessKeyId     string `json:"access_key_id"`
 	SecretAccessKey string `json:"secret_access_key"`
@@ -58,6 +70,13 @@ func New(repoType, url, credential string) (Reader, error) {
 			host := m[2]
 			bucket := m[3]
 
+			// the credential of repos is encrypted in database
+			credential, err = secret.Decrypt(credential)
+			if err != nil {
+				logger.Error("Decrypt credential of repo [%s] failed, error: %+v", url, err)
+				return nil, ErrDecryptFailed
+			}
+
 			var qc S3Credential
 			err = jsonutil.Decode([]byte(credential), &qc)
 			if err != nil {
@@ -92,3 +111,15 @@ func New(repoType, url, credential string) (Reader, error) {
 	}
 
 }
+
+func NewWriter(repoType, url, credential string) (Writer, error) {
+	reader, err := New(repoType, url, credential)
+	if err != nil {
+		return nil, err
+	}
+	writer, ok := reader.(Writer)
+	if !ok {
+		return nil, ErrInvalidType
+	}
+	return writer, nil
+}
@@ -5,11 +5,13 @@
 package reporeader
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	neturl "net/url"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -21,6 +23,8 @@ type S3Reader struct {
 	url             *neturl.URL
 	accessKeyId     string
 	secretAccessKey string
+	zone            string
+	host            string
 	bucket          string
 	config          *aws.Config
 }
@@ -37,6 +41,8 @@ func NewS3Reader(url *neturl.URL, accessKeyId, secretAccessKey, zone, host, buck
 		accessKeyId:     accessKeyId,
 		secretAccessKey: secretAccessKey,
 		config:          config,
+		zone:            zone,
+		host:            host,
 		bucket:          bucket,
 	}
 }
@@ -55,6 +61,9 @@ func (s *S3Reader) GetIndexYaml() ([]byte, error) {
 		Key:    aws.String(IndexYaml),
 	})
 	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrIndexYamlNotFound
+		}
 		logger.Error("Failed to get s3 repo [%+v] index.yaml, error: %+v", s, err)
 		return nil, ErrGetIndexYamlFailed
 	}
@@ -65,3 +74,28 @@ func (s *S3Reader) GetIndexYaml() ([]byte, error) {
 	}
 	return body, nil
 }
+
+func (s *S3Reader) GetFileUrl(name string) string {
+	return fmt.Sprintf("https://%s.s3.%s.%s/%s", s.bucket, s.zone, s.host, name)
+}
+
+func (s *S3Reader) PutFile(name string, content []byte) error {
+	sess, err := session.NewSession(s.config)
+	if err != nil {
+		logger.Error("Connect to s3 failed: %+v", err)
+		return ErrPutFileFailed
+	}
+
+	svc := s3.New(sess)
+
+	_, err = svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(name),
+		Body:   bytes.NewReader(content),
+	})
+	if err != nil {
+		logger.Error("Failed to put [%s] to s3 repo [%s], error: %+v", name, s.bucket, err)
+		return ErrPutFileFailed
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package search
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gocraft/dbr"
+
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+// the tables that the document of app is built from, and the column that
+// refers to the app
+var indexedTables = map[string]string{
+	models.AppTableName:              models.ColumnAppId,
+	models.AppVersionTableName:       models.ColumnAppId,
+	models.CategoryResourceTableName: models.ColumnResouceId,
+}
+
+// Hook chains the index to the insert, update and delete hooks of the
+// database, the apps are indexed again when their tables change. The hooks
+// only queue the changed apps, they are indexed in the background.
+func (i *Index) Hook() {
+	insertHook := i.Db.InsertHook
+	updateHook := i.Db.UpdateHook
+	deleteHook := i.Db.DeleteHook
+
+	i.Db.InsertHook = func(query *db.InsertQuery) {
+		if insertHook != nil {
+			insertHook(query)
+		}
+		i.queue(i.getInsertedAppIds(query.Table, query.Column, query.Value))
+	}
+	i.Db.UpdateHook = func(query *db.UpdateQuery) {
+		if updateHook != nil {
+			updateHook(query)
+		}
+		i.queue(i.getAppIds(query.Table, query.WhereCond))
+	}
+	i.Db.DeleteHook = func(query *db.DeleteQuery) {
+		if deleteHook != nil {
+			deleteHook(query)
+		}
+		i.queue(i.getAppIds(query.Table, query.WhereCond))
+	}
+
+	go i.reindexLoop()
+	go i.refreshProvidersLoop()
+}
+
+// queue marks the apps to be indexed again.
+func (i *Index) queue(appIds []string) {
+	if len(appIds) == 0 {
+		return
+	}
+	i.dirtyMutex.Lock()
+	for _, appId := range appIds {
+		i.dirty[appId] = true
+	}
+	i.dirtyMutex.Unlock()
+
+	select {
+	case i.notify <- struct{}{}:
+	default:
+	}
+}
+
+// takeDirty returns the queued apps and clears the queue.
+func (i *Index) takeDirty() []string {
+	i.dirtyMutex.Lock()
+	defer i.dirtyMutex.Unlock()
+	var appIds []string
+	for appId := range i.dirty {
+		appIds = append(appIds, appId)
+	}
+	i.dirty = make(map[string]bool)
+	sort.Strings(appIds)
+	return appIds
+}
+
+// reindexLoop indexes the queued apps in batches, the changes of an app in
+// the interval are merged, e.g. the versions of an app synced by the repo
+// indexer one by one.
+func (i *Index) reindexLoop() {
+	for range i.notify {
+		time.Sleep(reindexInterval)
+		appIds := i.takeDirty()
+		for start := 0; start < len(appIds); start += reindexBatchSize {
+			end := start + reindexBatchSize
+			if end > len(appIds) {
+				end = len(appIds)
+			}
+			i.reindex(appIds[start:end])
+		}
+	}
+}
+
+// refreshProvidersLoop refreshes the providers of the repos periodically,
+// the repos are in the database of repo manager which has no hooks of the
+// index.
+func (i *Index) refreshProvidersLoop() {
+	for range time.Tick(providersCacheTtl) {
+		i.mutex.Lock()
+		var repoIds []string
+		for repoId := range i.providers {
+			repoIds = append(repoIds, repoId)
+		}
+		i.mutex.Unlock()
+
+		for _, repoId := range repoIds {
+			providers, err := i.GetProviders(repoId)
+			if err != nil {
+				logger.Warn("Failed to get providers of repo [%s]: %+v", repoId, err)
+				continue
+			}
+			i.setProviders(repoId, providers)
+		}
+	}
+}
+
+func (i *Index) reindex(appIds []string) {
+	if len(appIds) == 0 {
+		return
+	}
+	err := i.IndexApps(appIds)
+	if err != nil {
+		logger.Error("Failed to index apps [%v]: %+v", appIds, err)
+	}
+}
+
+func (i *Index) getInsertedAppIds(table string, columns []string, values [][]interface{}) []string {
+	column, ok := indexedTables[table]
+	if !ok {
+		return nil
+	}
+	var appIds []string
+	for idx, c := range columns {
+		if c != column {
+			continue
+		}
+		for _, v := range values {
+			if appId, ok := v[idx].(string); ok {
+				appIds = append(appIds, appId)
+			}
+		}
+	}
+	return appIds
+}
+
+func (i *Index) getAppIds(table string, whereCond []dbr.Builder) []string {
+	column, ok := indexedTables[table]
+	if !ok {
+		return nil
+	}
+	appIds := db.GetEqValues(column, whereCond)
+	if len(appIds) > 0 || table != models.AppVersionTableName {
+		return appIds
+	}
+
+	// the app versions are changed by version_id
+	versionIds := db.GetEqValues(models.ColumnVersionId, whereCond)
+	if len(versionIds) == 0 {
+		return nil
+	}
+	_, err := i.Db.
+		Select(models.ColumnAppId).
+		From(models.AppVersionTableName).
+		Where(db.Eq(models.ColumnVersionId, versionIds)).
+		Distinct().
+		Load(&appIds)
+	if err != nil {
+		logger.Error("Failed to get app id of app versions [%v]: %+v", versionIds, err)
+	}
+	return appIds
+}
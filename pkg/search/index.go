@@ -0,0 +1,327 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package search is the full-text search of apps, the index is kept in the
+// tables app_search and app_search_facet of the app database.
+package search
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/client"
+	repoclient "openpitrix.io/openpitrix/pkg/client/repo"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+const (
+	FacetCategory   = "category_id"
+	FacetRepo       = "repo_id"
+	FacetProvider   = "provider"
+	FacetStatus     = "status"
+	FacetKeyword    = "keyword"
+	FacetMaintainer = "maintainer"
+)
+
+// Facets are counted in the result of Search, the others are only filters.
+var Facets = []string{FacetCategory, FacetRepo, FacetProvider, FacetStatus}
+
+const (
+	providersCacheTtl = 5 * time.Minute
+	// the length of app_search_facet.value
+	maxFacetValueLength = 255
+
+	// the changes of the apps in the interval are indexed together
+	reindexInterval  = time.Second
+	reindexBatchSize = 50
+)
+
+type providersCache struct {
+	providers  []string
+	expireTime time.Time
+}
+
+type Index struct {
+	Db *db.Database
+	// GetProviders returns the providers of the repo, the repo is in the
+	// database of repo manager
+	GetProviders func(repoId string) ([]string, error)
+
+	mutex     sync.Mutex
+	providers map[string]providersCache
+
+	// the apps to be indexed again, see Hook
+	dirtyMutex sync.Mutex
+	dirty      map[string]bool
+	notify     chan struct{}
+}
+
+func NewIndex(d *db.Database) *Index {
+	i := &Index{
+		Db:        d,
+		providers: make(map[string]providersCache),
+		dirty:     make(map[string]bool),
+		notify:    make(chan struct{}, 1),
+	}
+	i.GetProviders = getRepoProviders
+	return i
+}
+
+func getRepoProviders(repoId string) ([]string, error) {
+	ctx := client.GetSystemUserContext()
+	repoManagerClient, err := repoclient.NewRepoManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := repoManagerClient.DescribeRepos(ctx, &pb.DescribeReposRequest{
+		RepoId: []string{repoId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.RepoSet) == 0 {
+		return nil, nil
+	}
+	return res.RepoSet[0].GetProviders(), nil
+}
+
+// getProviders caches the providers of the repo, the apps of a repo are
+// indexed together when the repo is indexed.
+func (i *Index) getProviders(repoId string) []string {
+	i.mutex.Lock()
+	c, ok := i.providers[repoId]
+	i.mutex.Unlock()
+	if ok && time.Now().Before(c.expireTime) {
+		return c.providers
+	}
+	providers, err := i.GetProviders(repoId)
+	if err != nil {
+		logger.Warn("Failed to get providers of repo [%s]: %+v", repoId, err)
+		return nil
+	}
+	i.setProviders(repoId, providers)
+	return providers
+}
+
+// setProviders caches the providers of the repo, the apps of the repo are
+// queued to be indexed again if the providers are changed.
+func (i *Index) setProviders(repoId string, providers []string) {
+	i.mutex.Lock()
+	c, ok := i.providers[repoId]
+	i.providers[repoId] = providersCache{providers, time.Now().Add(providersCacheTtl)}
+	i.mutex.Unlock()
+	changed := len(stringutil.Diff(c.providers, providers)) > 0 || len(stringutil.Diff(providers, c.providers)) > 0
+	if !ok || !changed {
+		return
+	}
+
+	var appIds []string
+	_, err := i.Db.
+		Select(models.ColumnAppId).
+		From(models.AppTableName).
+		Where(db.Eq(models.ColumnRepoId, repoId)).
+		Load(&appIds)
+	if err != nil {
+		logger.Error("Failed to get apps of repo [%s]: %+v", repoId, err)
+		return
+	}
+	i.queue(appIds)
+}
+
+func splitKeywords(keywords string) []string {
+	var values []string
+	for _, k := range strings.Split(keywords, ",") {
+		k = strings.ToLower(strings.TrimSpace(k))
+		if k != "" {
+			values = append(values, k)
+		}
+	}
+	return values
+}
+
+// getMaintainerNames parses the maintainers of app, which is the json of
+// the maintainers in the package.
+func getMaintainerNames(maintainers string) []string {
+	var ms []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(maintainers), &ms); err != nil {
+		return nil
+	}
+	var names []string
+	for _, m := range ms {
+		if m.Name != "" {
+			names = append(names, m.Name)
+		}
+	}
+	return names
+}
+
+func (i *Index) getLatestAppVersion(appId string) (*models.AppVersion, error) {
+	var versions []*models.AppVersion
+	_, err := i.Db.
+		Select(models.AppVersionColumns...).
+		From(models.AppVersionTableName).
+		Where(db.Eq(models.ColumnAppId, appId)).
+		Where(db.Neq(models.ColumnStatus, constants.StatusDeleted)).
+		OrderDir(models.ColumnSemver, false).
+		OrderDir(models.ColumnSequence, false).
+		Limit(1).
+		Load(&versions)
+	if err != nil || len(versions) == 0 {
+		return nil, err
+	}
+	return versions[0], nil
+}
+
+func (i *Index) getCategoryIds(appId string) ([]string, error) {
+	var categoryIds []string
+	_, err := i.Db.
+		Select(models.ColumnCategoryId).
+		From(models.CategoryResourceTableName).
+		Where(db.Eq(models.ColumnResouceId, appId)).
+		Where(db.Eq(models.ColumnStatus, constants.StatusEnabled)).
+		Load(&categoryIds)
+	return categoryIds, err
+}
+
+func (i *Index) buildDocument(app *models.App) (*models.AppSearch, []*models.AppSearchFacet, error) {
+	version, err := i.getLatestAppVersion(app.AppId)
+	if err != nil {
+		return nil, nil, err
+	}
+	categoryIds, err := i.getCategoryIds(app.AppId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := []string{app.Description, app.Readme}
+	if version != nil {
+		content = append(content, version.Name, version.Description)
+	}
+	maintainers := getMaintainerNames(app.Maintainers)
+	doc := &models.AppSearch{
+		AppId:       app.AppId,
+		Name:        app.Name,
+		Keywords:    app.Keywords,
+		Maintainers: strings.Join(maintainers, " "),
+		Content:     strings.Join(content, "\n"),
+		UpdateTime:  time.Now(),
+	}
+
+	var facets []*models.AppSearchFacet
+	add := func(facet string, values ...string) {
+		seen := make(map[string]bool)
+		for _, v := range values {
+			if r := []rune(v); len(r) > maxFacetValueLength {
+				v = string(r[:maxFacetValueLength])
+			}
+			if v == "" || seen[v] {
+				continue
+			}
+			seen[v] = true
+			facets = append(facets, &models.AppSearchFacet{AppId: app.AppId, Facet: facet, Value: v})
+		}
+	}
+	add(FacetCategory, categoryIds...)
+	add(FacetRepo, app.RepoId)
+	add(FacetProvider, i.getProviders(app.RepoId)...)
+	add(FacetStatus, app.Status)
+	add(FacetKeyword, splitKeywords(app.Keywords)...)
+	add(FacetMaintainer, maintainers...)
+	return doc, facets, nil
+}
+
+// IndexApps updates the documents of the apps, the document is removed if
+// the app does not exist.
+func (i *Index) IndexApps(appIds []string) error {
+	if len(appIds) == 0 {
+		return nil
+	}
+	var apps []*models.App
+	_, err := i.Db.
+		Select(models.AppColumns...).
+		From(models.AppTableName).
+		Where(db.Eq(models.ColumnAppId, appIds)).
+		Load(&apps)
+	if err != nil {
+		return err
+	}
+
+	tx, err := i.Db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	// the transaction bypasses the hooks of the database
+	_, err = tx.DeleteFrom(models.AppSearchTableName).Where(db.Eq(models.ColumnAppId, appIds)).Exec()
+	if err != nil {
+		return err
+	}
+	_, err = tx.DeleteFrom(models.AppSearchFacetTableName).Where(db.Eq(models.ColumnAppId, appIds)).Exec()
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		doc, facets, err := i.buildDocument(app)
+		if err != nil {
+			return err
+		}
+		_, err = tx.InsertInto(models.AppSearchTableName).
+			Columns(models.AppSearchColumns...).
+			Record(doc).
+			Exec()
+		if err != nil {
+			return err
+		}
+		if len(facets) == 0 {
+			continue
+		}
+		insert := tx.InsertInto(models.AppSearchFacetTableName).Columns(models.AppSearchFacetColumns...)
+		for _, facet := range facets {
+			insert = insert.Record(facet)
+		}
+		_, err = insert.Exec()
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// IndexAll indexes all the apps, it builds the index of the apps created
+// before the index exists.
+func (i *Index) IndexAll() error {
+	const limit = 50
+	var lastAppId string
+	for {
+		var appIds []string
+		_, err := i.Db.
+			Select(models.ColumnAppId).
+			From(models.AppTableName).
+			Where(db.Gt(models.ColumnAppId, lastAppId)).
+			OrderDir(models.ColumnAppId, true).
+			Limit(limit).
+			Load(&appIds)
+		if err != nil {
+			return err
+		}
+		if len(appIds) == 0 {
+			return nil
+		}
+		err = i.IndexApps(appIds)
+		if err != nil {
+			return err
+		}
+		lastAppId = appIds[len(appIds)-1]
+	}
+}
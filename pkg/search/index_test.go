@@ -0,0 +1,84 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package search
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gocraft/dbr"
+
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+func TestSplitKeywords(t *testing.T) {
+	got := splitKeywords(" Database, MySQL ,,cache")
+	want := []string{"database", "mysql", "cache"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("splitKeywords: got %v, want %v", got, want)
+	}
+}
+
+func TestGetMaintainerNames(t *testing.T) {
+	got := getMaintainerNames(`[{"name":"alice","email":"a@example.com"},{"email":"b@example.com"}]`)
+	want := []string{"alice"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("getMaintainerNames: got %v, want %v", got, want)
+	}
+	if names := getMaintainerNames("not json"); names != nil {
+		t.Fatalf("getMaintainerNames: got %v, want nil", names)
+	}
+}
+
+func TestGetInsertedAppIds(t *testing.T) {
+	i := &Index{}
+	columns := []string{models.ColumnCategoryId, models.ColumnResouceId}
+	values := [][]interface{}{{"ctg-1", "app-1"}, {"ctg-2", "app-2"}}
+	got := i.getInsertedAppIds(models.CategoryResourceTableName, columns, values)
+	want := []string{"app-1", "app-2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("getInsertedAppIds: got %v, want %v", got, want)
+	}
+	if got := i.getInsertedAppIds(models.RepoTableName, columns, values); got != nil {
+		t.Fatalf("getInsertedAppIds: got %v, want nil", got)
+	}
+}
+
+func TestGetAppIds(t *testing.T) {
+	i := &Index{}
+	conds := []dbr.Builder{db.Eq(models.ColumnAppId, []string{"app-1", "app-2"})}
+	got := i.getAppIds(models.AppTableName, conds)
+	want := []string{"app-1", "app-2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("getAppIds: got %v, want %v", got, want)
+	}
+}
+
+func TestQueue(t *testing.T) {
+	i := NewIndex(nil)
+	i.queue(nil)
+	select {
+	case <-i.notify:
+		t.Fatalf("queue: notified without apps")
+	default:
+	}
+
+	i.queue([]string{"app-2", "app-1"})
+	i.queue([]string{"app-1", "app-3"})
+	select {
+	case <-i.notify:
+	default:
+		t.Fatalf("queue: not notified")
+	}
+	got := i.takeDirty()
+	want := []string{"app-1", "app-2", "app-3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("takeDirty: got %v, want %v", got, want)
+	}
+	if got := i.takeDirty(); got != nil {
+		t.Fatalf("takeDirty: got %v, want nil", got)
+	}
+}
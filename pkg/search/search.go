@@ -0,0 +1,156 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package search
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gocraft/dbr"
+
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+// the columns of app_search that have a FULLTEXT index together
+var matchColumns = []string{
+	models.ColumnName,
+	models.ColumnKeywords,
+	models.ColumnMaintainers,
+	models.ColumnContent,
+}
+
+type Query struct {
+	SearchWord string
+	// Filters are the values of the facets, the values of a facet are OR,
+	// the facets are AND
+	Filters map[string][]string
+	Limit   uint64
+	Offset  uint64
+}
+
+type FacetValue struct {
+	Value string
+	Count uint32
+}
+
+type Result struct {
+	TotalCount uint32
+	// AppIds are ordered by the relevance to the search word
+	AppIds []string
+	Facets map[string][]FacetValue
+}
+
+// score weights the match of name and keywords over the match of the
+// whole document.
+func score(word string) dbr.Builder {
+	name := db.Match([]string{models.ColumnName}, word)
+	keywords := db.Match([]string{models.ColumnKeywords}, word)
+	all := db.Match(matchColumns, word)
+	return dbr.BuildFunc(func(d dbr.Dialect, buf dbr.Buffer) error {
+		buf.WriteString("4 * ")
+		if err := name.Build(d, buf); err != nil {
+			return err
+		}
+		buf.WriteString(" + 2 * ")
+		if err := keywords.Build(d, buf); err != nil {
+			return err
+		}
+		buf.WriteString(" + ")
+		return all.Build(d, buf)
+	})
+}
+
+func (i *Index) facetFilter(facet string, values []string) dbr.Builder {
+	subqueryStmt := i.Db.
+		Select(models.ColumnAppId).
+		From(models.AppSearchFacetTableName).
+		Where(db.Eq(models.ColumnFacet, facet)).
+		Where(db.Eq(models.ColumnValue, values))
+	return db.Eq(models.ColumnAppId, []*db.SelectQuery{subqueryStmt})
+}
+
+// filters returns the conditions of the query, except the filter of the
+// facet which is being counted.
+func (i *Index) filters(q Query, except string) []dbr.Builder {
+	var conds []dbr.Builder
+	if q.SearchWord != "" {
+		conds = append(conds, db.Match(matchColumns, q.SearchWord))
+	}
+	// keep the order of the conditions stable
+	var facets []string
+	for facet := range q.Filters {
+		facets = append(facets, facet)
+	}
+	sort.Strings(facets)
+	for _, facet := range facets {
+		values := q.Filters[facet]
+		if facet == except || len(values) == 0 {
+			continue
+		}
+		if facet == FacetKeyword {
+			values = splitKeywords(strings.Join(values, ","))
+		}
+		conds = append(conds, i.facetFilter(facet, values))
+	}
+	return conds
+}
+
+func (i *Index) countFacet(q Query, facet string) ([]FacetValue, error) {
+	var values []FacetValue
+	query := i.Db.
+		Select(models.ColumnValue, "COUNT(*) AS count").
+		From(models.AppSearchFacetTableName).
+		Where(db.Eq(models.ColumnFacet, facet)).
+		GroupBy(models.ColumnValue).
+		OrderBy(dbr.Expr("count"), false).
+		OrderDir(models.ColumnValue, true)
+	conds := i.filters(q, facet)
+	if len(conds) > 0 {
+		subqueryStmt := i.Db.
+			Select(models.ColumnAppId).
+			From(models.AppSearchTableName).
+			Where(db.And(conds...))
+		query = query.Where(db.Eq(models.ColumnAppId, []*db.SelectQuery{subqueryStmt}))
+	}
+	_, err := query.Load(&values)
+	return values, err
+}
+
+// Search returns the apps matching the query, ranked by relevance, and the
+// counts of the values of Facets. The count of a facet ignores the filter
+// of the facet itself, so that the other values stay selectable.
+func (i *Index) Search(q Query) (*Result, error) {
+	query := i.Db.
+		Select(models.ColumnAppId).
+		From(models.AppSearchTableName).
+		Offset(q.Offset).
+		Limit(q.Limit)
+	conds := i.filters(q, "")
+	if len(conds) > 0 {
+		query = query.Where(db.And(conds...))
+	}
+	if q.SearchWord != "" {
+		query = query.OrderBy(score(q.SearchWord), false)
+	}
+	query = query.OrderDir(models.ColumnName, true)
+
+	result := &Result{Facets: make(map[string][]FacetValue)}
+	_, err := query.Load(&result.AppIds)
+	if err != nil {
+		return nil, err
+	}
+	result.TotalCount, err = query.Count()
+	if err != nil {
+		return nil, err
+	}
+	for _, facet := range Facets {
+		result.Facets[facet], err = i.countFacet(q, facet)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// +build db
+
+package search
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/config/test_config"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+var tc = test_config.NewDbTestConfig("app")
+
+func tDeleteApps(d *db.Database, appIds []string) {
+	for _, table := range []string{models.AppTableName, models.AppSearchTableName, models.AppSearchFacetTableName} {
+		d.DeleteFrom(table).Where(db.Eq(models.ColumnAppId, appIds)).Exec()
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tc.CheckDbUnitTest(t)
+	d := tc.GetDatabaseConn()
+
+	repoId := "repo-search-test"
+	apps := []*models.App{
+		{AppId: "app-search-test-1", Name: "nginx", RepoId: repoId, Keywords: "web, proxy", Description: "web server"},
+		{AppId: "app-search-test-2", Name: "redis", RepoId: repoId, Keywords: "cache", Description: "in-memory store used as web cache"},
+		{AppId: "app-search-test-3", Name: "mysql", RepoId: repoId, Keywords: "database", Description: "relational database"},
+	}
+	var appIds []string
+	for _, app := range apps {
+		app.Status = constants.StatusActive
+		app.CreateTime = time.Now()
+		app.StatusTime = time.Now()
+		appIds = append(appIds, app.AppId)
+	}
+	tDeleteApps(d, appIds)
+	defer tDeleteApps(d, appIds)
+
+	for _, app := range apps {
+		_, err := d.InsertInto(models.AppTableName).Columns(models.AppColumns...).Record(app).Exec()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := NewIndex(d)
+	i.GetProviders = func(string) ([]string, error) {
+		return []string{constants.ProviderKubernetes}, nil
+	}
+	if err := i.IndexApps(appIds); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Query{
+		SearchWord: "web",
+		Filters:    map[string][]string{FacetRepo: {repoId}},
+		Limit:      10,
+	}
+	result, err := i.Search(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the match of name and keywords ranks first
+	want := []string{"app-search-test-1", "app-search-test-2"}
+	if result.TotalCount != 2 || !reflect.DeepEqual(result.AppIds, want) {
+		t.Fatalf("Search: got %d %v, want %v", result.TotalCount, result.AppIds, want)
+	}
+	if got := result.Facets[FacetProvider]; len(got) != 1 || got[0] != (FacetValue{constants.ProviderKubernetes, 2}) {
+		t.Fatalf("Search: unexpected provider facet %v", got)
+	}
+
+	q.SearchWord = ""
+	q.Filters[FacetKeyword] = []string{"Database"}
+	result, err = i.Search(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TotalCount != 1 || !reflect.DeepEqual(result.AppIds, []string{"app-search-test-3"}) {
+		t.Fatalf("Search: got %d %v, want [app-search-test-3]", result.TotalCount, result.AppIds)
+	}
+	// the other facets are counted with the filter of keywords
+	if got := result.Facets[FacetRepo]; len(got) != 1 || got[0] != (FacetValue{repoId, 1}) {
+		t.Fatalf("Search: unexpected repo facet %v", got)
+	}
+
+	// the documents are removed with the apps
+	tDeleteApps(d, appIds[:1])
+	if err := i.IndexApps(appIds[:1]); err != nil {
+		t.Fatal(err)
+	}
+	result, err = i.Search(Query{SearchWord: "nginx", Filters: map[string][]string{FacetRepo: {repoId}}, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TotalCount != 0 {
+		t.Fatalf("Search: got %v after the app is deleted", result.AppIds)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package secret
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// LocalKms stands in for a key management service, the master keys are
+// given by the config of every service that reads the credentials.
+type LocalKms struct {
+	currentKeyId string
+	keys         map[string][]byte
+}
+
+// NewLocalKms parses the master keys in the form of
+//
+//	key_id:base64 of 32 bytes,key_id:base64 of 32 bytes
+//
+// The first key wraps the new data keys, the others unwrap the data keys
+// wrapped before the rotation.
+func NewLocalKms(masterKeys string) (*LocalKms, error) {
+	k := &LocalKms{keys: make(map[string][]byte)}
+	for _, s := range strings.Split(masterKeys, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		parts := strings.SplitN(s, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid master key [%s], expect key_id:base64_key", parts[0])
+		}
+		keyId := parts[0]
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid master key [%s]: %+v", keyId, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("invalid master key [%s]: expect %d bytes, got %d", keyId, dataKeySize, len(key))
+		}
+		if _, ok := k.keys[keyId]; ok {
+			return nil, fmt.Errorf("duplicate master key [%s]", keyId)
+		}
+		k.keys[keyId] = key
+		if k.currentKeyId == "" {
+			k.currentKeyId = keyId
+		}
+	}
+	if k.currentKeyId == "" {
+		return nil, ErrNoMasterKey
+	}
+	return k, nil
+}
+
+func (k *LocalKms) CurrentKeyId() string {
+	return k.currentKeyId
+}
+
+func (k *LocalKms) getKey(keyId string) ([]byte, error) {
+	key, ok := k.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("master key [%s] not found", keyId)
+	}
+	return key, nil
+}
+
+func (k *LocalKms) Wrap(keyId string, dataKey []byte) ([]byte, error) {
+	key, err := k.getKey(keyId)
+	if err != nil {
+		return nil, err
+	}
+	return seal(key, dataKey)
+}
+
+func (k *LocalKms) Unwrap(keyId string, wrapped []byte) ([]byte, error) {
+	key, err := k.getKey(keyId)
+	if err != nil {
+		return nil, err
+	}
+	return open(key, wrapped)
+}
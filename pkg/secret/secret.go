@@ -0,0 +1,239 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package secret is the envelope encryption of the credentials kept in the
+// database, e.g. the access keys of runtimes and repos. The credential is
+// encrypted by a random data key with AES-GCM, and the data key is wrapped
+// by the master key of the Kms and stored along with the credential:
+//
+//	enc:v1:<master key id>:<wrapped data key>:<nonce and ciphertext>
+//
+// The values without the prefix are the plaintext written before the
+// encryption, they are returned as is by Decrypt.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"openpitrix.io/openpitrix/pkg/config"
+	"openpitrix.io/openpitrix/pkg/logger"
+)
+
+const Prefix = "enc:v1:"
+
+const dataKeySize = 32
+
+var ErrNoMasterKey = fmt.Errorf("no master key is configured")
+
+// Kms keeps the master keys, the master keys never leave the Kms.
+type Kms interface {
+	// CurrentKeyId returns the master key that wraps the new data keys.
+	CurrentKeyId() string
+	Wrap(keyId string, dataKey []byte) ([]byte, error)
+	Unwrap(keyId string, wrapped []byte) ([]byte, error)
+}
+
+var (
+	mutex sync.RWMutex
+	kms   Kms
+)
+
+// Init sets the Kms of the master keys in config, the credentials are kept
+// in plaintext if no master key is configured.
+func Init(cfg config.EncryptionConfig) error {
+	if cfg.MasterKeys == "" {
+		logger.Warn("No master key is configured, the credentials are not encrypted")
+		SetKms(nil)
+		return nil
+	}
+	k, err := NewLocalKms(cfg.MasterKeys)
+	if err != nil {
+		return err
+	}
+	SetKms(k)
+	return nil
+}
+
+// CheckEnabled returns ErrNoMasterKey if no master key is configured, the
+// services that keep the credentials refuse to start without master keys.
+func CheckEnabled() error {
+	if getKms() == nil {
+		return ErrNoMasterKey
+	}
+	return nil
+}
+
+func SetKms(k Kms) {
+	mutex.Lock()
+	kms = k
+	mutex.Unlock()
+}
+
+func getKms() Kms {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return kms
+}
+
+func IsEncrypted(s string) bool {
+	return strings.HasPrefix(s, Prefix)
+}
+
+// GetKeyId returns the master key of the encrypted value.
+func GetKeyId(s string) string {
+	if !IsEncrypted(s) {
+		return ""
+	}
+	return strings.SplitN(strings.TrimPrefix(s, Prefix), ":", 2)[0]
+}
+
+func seal(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, sealed []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func format(keyId string, wrappedKey, sealed []byte) string {
+	return Prefix + keyId + ":" +
+		base64.StdEncoding.EncodeToString(wrappedKey) + ":" +
+		base64.StdEncoding.EncodeToString(sealed)
+}
+
+func parse(s string) (keyId string, wrappedKey, sealed []byte, err error) {
+	parts := strings.Split(strings.TrimPrefix(s, Prefix), ":")
+	if len(parts) != 3 {
+		return "", nil, nil, fmt.Errorf("invalid encrypted value")
+	}
+	wrappedKey, err = base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, err
+	}
+	sealed, err = base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return parts[0], wrappedKey, sealed, nil
+}
+
+// Encrypt encrypts the plaintext by a new data key.
+func Encrypt(plaintext string) (string, error) {
+	k := getKms()
+	if k == nil || plaintext == "" {
+		return plaintext, nil
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	sealed, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	keyId := k.CurrentKeyId()
+	wrappedKey, err := k.Wrap(keyId, dataKey)
+	if err != nil {
+		return "", err
+	}
+	return format(keyId, wrappedKey, sealed), nil
+}
+
+// Decrypt returns the plaintext of the value, the value that is not
+// encrypted is returned as is.
+func Decrypt(s string) (string, error) {
+	if !IsEncrypted(s) {
+		return s, nil
+	}
+	k := getKms()
+	if k == nil {
+		return "", ErrNoMasterKey
+	}
+	keyId, wrappedKey, sealed, err := parse(s)
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := k.Unwrap(keyId, wrappedKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dataKey, sealed)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Rewrap wraps the data key of the value by the current master key, the
+// ciphertext of the credential is unchanged. The plaintext value is
+// encrypted. It returns false if the value needs no change.
+func Rewrap(s string) (string, bool, error) {
+	k := getKms()
+	if k == nil {
+		return "", false, ErrNoMasterKey
+	}
+	if s == "" {
+		return s, false, nil
+	}
+	if !IsEncrypted(s) {
+		encrypted, err := Encrypt(s)
+		return encrypted, err == nil, err
+	}
+	keyId, wrappedKey, sealed, err := parse(s)
+	if err != nil {
+		return "", false, err
+	}
+	currentKeyId := k.CurrentKeyId()
+	if keyId == currentKeyId {
+		return s, false, nil
+	}
+	dataKey, err := k.Unwrap(keyId, wrappedKey)
+	if err != nil {
+		return "", false, err
+	}
+	wrappedKey, err = k.Wrap(currentKeyId, dataKey)
+	if err != nil {
+		return "", false, err
+	}
+	return format(currentKeyId, wrappedKey, sealed), true, nil
+}
+
+// CurrentKeyId returns the master key that wraps the new data keys.
+func CurrentKeyId() string {
+	k := getKms()
+	if k == nil {
+		return ""
+	}
+	return k.CurrentKeyId()
+}
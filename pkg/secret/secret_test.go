@@ -0,0 +1,119 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package secret
+
+import (
+	"strings"
+	"testing"
+)
+
+const (
+	key1 = "key1:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	key2 = "key2:ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+)
+
+const credential = `{"access_key_id":"id","secret_access_key":"key"}`
+
+func setKms(t *testing.T, masterKeys string) {
+	k, err := NewLocalKms(masterKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetKms(k)
+}
+
+func TestNewLocalKms(t *testing.T) {
+	for _, masterKeys := range []string{
+		"",
+		"key1",
+		"key1:not-base64",
+		"key1:MDEyMzQ1Njc4OWFiY2RlZg==",
+		key1 + "," + key1,
+	} {
+		_, err := NewLocalKms(masterKeys)
+		if err == nil {
+			t.Fatalf("master keys [%s] should be invalid", masterKeys)
+		}
+	}
+}
+
+func TestEncrypt(t *testing.T) {
+	SetKms(nil)
+	defer SetKms(nil)
+
+	// plaintext is kept if no master key is configured
+	s, err := Encrypt(credential)
+	if err != nil || s != credential {
+		t.Fatalf("expect plaintext, got [%s] %+v", s, err)
+	}
+
+	setKms(t, key1)
+	encrypted, err := Encrypt(credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEncrypted(encrypted) || strings.Contains(encrypted, "secret_access_key") {
+		t.Fatalf("credential not encrypted: [%s]", encrypted)
+	}
+	if GetKeyId(encrypted) != "key1" {
+		t.Fatalf("expect key1, got [%s]", GetKeyId(encrypted))
+	}
+
+	s, err = Decrypt(encrypted)
+	if err != nil || s != credential {
+		t.Fatalf("expect [%s], got [%s] %+v", credential, s, err)
+	}
+	s, err = Decrypt(credential)
+	if err != nil || s != credential {
+		t.Fatalf("plaintext should be returned as is, got [%s] %+v", s, err)
+	}
+
+	// the value is tampered
+	_, err = Decrypt(encrypted[:len(encrypted)-4] + "AAA=")
+	if err == nil {
+		t.Fatal("tampered value should fail to decrypt")
+	}
+
+	setKms(t, key2)
+	_, err = Decrypt(encrypted)
+	if err == nil {
+		t.Fatal("value should fail to decrypt without key1")
+	}
+}
+
+func TestRewrap(t *testing.T) {
+	defer SetKms(nil)
+
+	setKms(t, key1)
+	encrypted, err := Encrypt(credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, changed, err := Rewrap(encrypted)
+	if err != nil || changed {
+		t.Fatalf("value of current key should not change, %+v", err)
+	}
+
+	// key2 is the current key, key1 is kept for the rotation
+	setKms(t, key2+","+key1)
+	rewrapped, changed, err := Rewrap(encrypted)
+	if err != nil || !changed {
+		t.Fatalf("value should be rewrapped, %+v", err)
+	}
+	if GetKeyId(rewrapped) != "key2" {
+		t.Fatalf("expect key2, got [%s]", GetKeyId(rewrapped))
+	}
+
+	setKms(t, key2)
+	s, err := Decrypt(rewrapped)
+	if err != nil || s != credential {
+		t.Fatalf("expect [%s], got [%s] %+v", credential, s, err)
+	}
+
+	rewrapped, changed, err = Rewrap(credential)
+	if err != nil || !changed || GetKeyId(rewrapped) != "key2" {
+		t.Fatalf("plaintext should be encrypted, got [%s] %+v", rewrapped, err)
+	}
+}
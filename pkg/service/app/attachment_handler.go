@@ -0,0 +1,124 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"context"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/blob"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+func getAttachmentKey(attachmentId string) string {
+	return "attachments/" + attachmentId
+}
+
+// writeAttachment stores the attachment and returns the url served by the
+// api gateway.
+func (p *Server) writeAttachment(attachment *pb.AppAttachment) (string, error) {
+	attachmentId, err := models.GetAppAttachmentId(attachment.GetName().GetValue(), attachment.GetContent())
+	if err != nil {
+		return "", gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorInvalidAppAttachment, attachment.GetName().GetValue())
+	}
+	key := getAttachmentKey(attachmentId)
+	err = p.store.Write(key, attachment.GetContent())
+	if err != nil {
+		logger.Error("Failed to write [%s] to storage, error: %+v", key, err)
+		return "", gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	return constants.AppAttachmentPath + attachmentId, nil
+}
+
+func (p *Server) UploadAppAttachments(ctx context.Context, req *pb.UploadAppAttachmentsRequest) (*pb.UploadAppAttachmentsResponse, error) {
+	appId := req.GetAppId().GetValue()
+	app, err := p.getApp(appId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, appId)
+	}
+	if app.Status == constants.StatusDeleted {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorResourceAlreadyDeleted, appId)
+	}
+	if len(req.GetScreenshots()) > models.MaxAppScreenshots {
+		return nil, gerr.New(gerr.InvalidArgument, gerr.ErrorIllegalParameterLength, "screenshots")
+	}
+
+	attributes := make(map[string]interface{})
+	if req.GetReadme() != nil {
+		_, err = p.writeAttachment(req.GetReadme())
+		if err != nil {
+			return nil, err
+		}
+		// the readme is kept in the app as well for the search of apps
+		attributes[models.ColumnReadme] = string(req.GetReadme().GetContent())
+	}
+	if req.GetIcon() != nil {
+		url, err := p.writeAttachment(req.GetIcon())
+		if err != nil {
+			return nil, err
+		}
+		attributes[models.ColumnIcon] = url
+	}
+	if len(req.GetScreenshots()) > 0 {
+		var urls []string
+		for _, screenshot := range req.GetScreenshots() {
+			url, err := p.writeAttachment(screenshot)
+			if err != nil {
+				return nil, err
+			}
+			urls = append(urls, url)
+		}
+		attributes[models.ColumnScreenshots] = jsonutil.ToString(urls)
+	}
+	if len(attributes) == 0 {
+		return &pb.UploadAppAttachmentsResponse{
+			AppId: req.GetAppId(),
+		}, nil
+	}
+
+	attributes[models.ColumnUpdateTime] = time.Now()
+	_, err = p.Db.
+		Update(models.AppTableName).
+		SetMap(attributes).
+		Where(db.Eq(models.ColumnAppId, appId)).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, appId)
+	}
+
+	return &pb.UploadAppAttachmentsResponse{
+		AppId: req.GetAppId(),
+	}, nil
+}
+
+func (p *Server) GetAppAttachment(ctx context.Context, req *pb.GetAppAttachmentRequest) (*pb.GetAppAttachmentResponse, error) {
+	attachmentId := req.GetAttachmentId().GetValue()
+	contentType, ok := models.GetAppAttachmentContentType(attachmentId)
+	if !ok {
+		return nil, gerr.New(gerr.InvalidArgument, gerr.ErrorInvalidAppAttachment, attachmentId)
+	}
+
+	content, err := p.store.Read(getAttachmentKey(attachmentId))
+	if err == blob.ErrNotFound {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, attachmentId)
+	}
+	if err != nil {
+		logger.Error("Failed to read attachment [%s] from storage, error: %+v", attachmentId, err)
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, attachmentId)
+	}
+
+	return &pb.GetAppAttachmentResponse{
+		AttachmentId: req.GetAttachmentId(),
+		ContentType:  pbutil.ToProtoString(contentType),
+		Content:      content,
+	}, nil
+}
@@ -7,10 +7,17 @@ package app
 import (
 	"context"
 
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 )
 
+var SupportedSignatureStatus = []string{
+	"",
+	constants.SignatureVerified,
+	constants.SignatureUnverified,
+}
+
 func (p *Server) Checker(ctx context.Context, req interface{}) error {
 	switch r := req.(type) {
 	case *pb.CreateAppRequest:
@@ -25,6 +32,14 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 		return manager.NewChecker(ctx, r).
 			Required("app_id").
 			Exec()
+	case *pb.UploadAppAttachmentsRequest:
+		return manager.NewChecker(ctx, r).
+			Required("app_id").
+			Exec()
+	case *pb.GetAppAttachmentRequest:
+		return manager.NewChecker(ctx, r).
+			Required("attachment_id").
+			Exec()
 	case *pb.CreateAppVersionRequest:
 		return manager.NewChecker(ctx, r).
 			Required("app_id", "name", "repo_id").
@@ -33,6 +48,11 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 		return manager.NewChecker(ctx, r).
 			Required("version_id").
 			Exec()
+	case *pb.ModifyAppVersionSignatureRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id").
+			StringChosen("signature_status", SupportedSignatureStatus).
+			Exec()
 	case *pb.DeleteAppVersionsRequest:
 		return manager.NewChecker(ctx, r).
 			Required("version_id").
@@ -41,10 +61,42 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 		return manager.NewChecker(ctx, r).
 			Required("version_id").
 			Exec()
+	case *pb.UploadAppVersionPackageRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id", "package").
+			Exec()
 	case *pb.GetAppVersionPackageFilesRequest:
 		return manager.NewChecker(ctx, r).
 			Required("version_id").
 			Exec()
+	case *pb.GetAppVersionUpgradePathsRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id").
+			Exec()
+	case *pb.SubmitAppVersionRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id").
+			Exec()
+	case *pb.ReviewAppVersionRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id").
+			Exec()
+	case *pb.PassAppVersionRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id").
+			Exec()
+	case *pb.RejectAppVersionRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id", "message").
+			Exec()
+	case *pb.ReleaseAppVersionRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id").
+			Exec()
+	case *pb.SuspendAppVersionRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id").
+			Exec()
 	}
 	return nil
 }
@@ -5,13 +5,19 @@
 package app
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"strings"
 	"time"
 
+	"k8s.io/helm/pkg/chartutil"
+
+	"openpitrix.io/openpitrix/pkg/blob"
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/devkit"
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
@@ -21,7 +27,9 @@ import (
 	"openpitrix.io/openpitrix/pkg/util/gziputil"
 	"openpitrix.io/openpitrix/pkg/util/httputil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/semverutil"
 	"openpitrix.io/openpitrix/pkg/util/senderutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
 )
 
 func (p *Server) getAppVersion(versionId string) (*models.AppVersion, error) {
@@ -70,6 +78,13 @@ func (p *Server) DescribeApps(ctx context.Context, req *pb.DescribeAppsRequest)
 			Where(db.Eq(models.ColumnCategoryId, categoryIds))
 		query = query.Where(db.Eq(models.ColumnAppId, []*db.SelectQuery{subqueryStmt}))
 	}
+	if req.GetRuntimeId() != nil {
+		repoIds, err := getRepoIdsByRuntime(req.GetRuntimeId().GetValue())
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(db.Eq(models.ColumnRepoId, repoIds))
+	}
 	// TODO: validate sort_key
 	query = manager.AddQueryOrderDir(query, req, models.ColumnCreateTime)
 	_, err := query.Load(&apps)
@@ -198,6 +213,11 @@ func (p *Server) DeleteApps(ctx context.Context, req *pb.DeleteAppsRequest) (*pb
 
 func (p *Server) CreateAppVersion(ctx context.Context, req *pb.CreateAppVersionRequest) (*pb.CreateAppVersionResponse, error) {
 	// TODO: validate CreateAppVersionRequest
+	upgradeFrom := req.GetUpgradeFrom().GetValue()
+	err := semverutil.ValidateConstraint(upgradeFrom)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorParameterParseFailed, "upgrade_from")
+	}
 	s := senderutil.GetSenderFromContext(ctx)
 	newAppVersion := models.NewAppVersion(
 		req.GetAppId().GetValue(),
@@ -209,8 +229,15 @@ func (p *Server) CreateAppVersion(ctx context.Context, req *pb.CreateAppVersionR
 	if req.Sequence != nil {
 		newAppVersion.Sequence = req.Sequence.GetValue()
 	}
+	newAppVersion.UpgradeFrom = upgradeFrom
 
-	_, err := p.Db.
+	tx, err := p.Db.NewTx()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	_, err = tx.
 		InsertInto(models.AppVersionTableName).
 		Columns(models.AppVersionColumns...).
 		Record(newAppVersion).
@@ -219,6 +246,15 @@ func (p *Server) CreateAppVersion(ctx context.Context, req *pb.CreateAppVersionR
 		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
 	}
 
+	err = addAppVersionAudit(tx, newAppVersion, s.UserId, "")
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+
 	res := &pb.CreateAppVersionResponse{
 		VersionId: pbutil.ToProtoString(newAppVersion.VersionId),
 	}
@@ -266,8 +302,22 @@ func (p *Server) ModifyAppVersion(ctx context.Context, req *pb.ModifyAppVersionR
 		return nil, gerr.NewWithDetail(gerr.FailedPrecondition, err, gerr.ErrorResourceAlreadyDeleted, versionId)
 	}
 
-	attributes := manager.BuildUpdateAttributes(req, "name", "description", "package_name", "sequence")
-	_, err = p.Db.
+	err = semverutil.ValidateConstraint(req.GetUpgradeFrom().GetValue())
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorParameterParseFailed, "upgrade_from")
+	}
+
+	attributes := manager.BuildUpdateAttributes(req, "name", "description", "package_name", "sequence", "upgrade_from")
+	if req.Name != nil {
+		attributes[models.ColumnSemver] = semverutil.SortKey(req.GetName().GetValue())
+	}
+	tx, err := p.Db.NewTx()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	_, err = tx.
 		Update(models.AppVersionTableName).
 		SetMap(attributes).
 		Where(db.Eq(models.ColumnVersionId, versionId)).
@@ -277,6 +327,20 @@ func (p *Server) ModifyAppVersion(ctx context.Context, req *pb.ModifyAppVersionR
 		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
 	}
 
+	// the package reviewed can not be changed without another review
+	revised := req.Name != nil && req.GetName().GetValue() != version.Name ||
+		req.PackageName != nil && req.GetPackageName().GetValue() != version.PackageName
+	if revised {
+		_, err = updateAppVersionStatus(tx, version, actionRevise, senderutil.GetSenderFromContext(ctx).UserId, "package changed")
+		if err != nil {
+			return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+	}
+
 	res := &pb.ModifyAppVersionResponse{
 		VersionId: req.GetVersionId(),
 	}
@@ -284,6 +348,31 @@ func (p *Server) ModifyAppVersion(ctx context.Context, req *pb.ModifyAppVersionR
 
 }
 
+func (p *Server) ModifyAppVersionSignature(ctx context.Context, req *pb.ModifyAppVersionSignatureRequest) (*pb.ModifyAppVersionSignatureResponse, error) {
+	versionId := req.GetVersionId().GetValue()
+	version, err := p.getAppVersion(versionId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	if version.Status == constants.StatusDeleted {
+		return nil, gerr.NewWithDetail(gerr.FailedPrecondition, err, gerr.ErrorResourceAlreadyDeleted, versionId)
+	}
+
+	_, err = p.Db.
+		Update(models.AppVersionTableName).
+		Set(models.ColumnSignatureStatus, req.GetSignatureStatus().GetValue()).
+		Set(models.ColumnUpdateTime, time.Now()).
+		Where(db.Eq(models.ColumnVersionId, versionId)).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+	}
+
+	return &pb.ModifyAppVersionSignatureResponse{
+		VersionId: req.GetVersionId(),
+	}, nil
+}
+
 func (p *Server) DeleteAppVersions(ctx context.Context, req *pb.DeleteAppVersionsRequest) (*pb.DeleteAppVersionsResponse, error) {
 	// TODO: check resource permission
 	versionIds := req.GetVersionId()
@@ -302,6 +391,44 @@ func (p *Server) DeleteAppVersions(ctx context.Context, req *pb.DeleteAppVersion
 	}, nil
 }
 
+// getPackage reads the package from the storage if it was uploaded,
+// otherwise from the repo it was indexed from.
+func (p *Server) getPackage(version *models.AppVersion) ([]byte, error) {
+	packageName := version.PackageName
+	if blob.IsBlobUrl(packageName) {
+		content, err := p.store.Read(blob.GetKey(packageName))
+		if err != nil {
+			logger.Error("Failed to read [%s] from storage, error: %+v", packageName, err)
+			return nil, err
+		}
+		return content, nil
+	}
+	resp, err := httputil.HttpGet(packageName)
+	if err != nil {
+		logger.Error("Failed to http get [%s], error: %+v", packageName, err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		logger.Error("Failed to read http response [%s], error: %+v", packageName, err)
+		return nil, err
+	}
+	return content, nil
+}
+
+// loadPackage checks the package in the format of the providers like the
+// repo indexer does, helm chart for kubernetes and app package of devkit for
+// the others.
+func loadPackage(providers []string, content []byte) error {
+	if stringutil.StringIn(constants.ProviderKubernetes, providers) {
+		_, err := chartutil.LoadArchive(bytes.NewReader(content))
+		return err
+	}
+	_, err := devkit.LoadArchive(bytes.NewReader(content))
+	return err
+}
+
 func (p *Server) GetAppVersionPackage(ctx context.Context, req *pb.GetAppVersionPackageRequest) (*pb.GetAppVersionPackageResponse, error) {
 	// TODO: check resource permission
 	versionId := req.GetVersionId().GetValue()
@@ -310,15 +437,8 @@ func (p *Server) GetAppVersionPackage(ctx context.Context, req *pb.GetAppVersion
 		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, versionId)
 	}
 	logger.Debug("Got app version: [%+v]", version)
-	packageUrl := version.PackageName
-	resp, err := httputil.HttpGet(packageUrl)
+	content, err := p.getPackage(version)
 	if err != nil {
-		logger.Error("Failed to http get [%s], error: %+v", packageUrl, err)
-		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, versionId)
-	}
-	content, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logger.Error("Failed to read http response [%s], error: %+v", packageUrl, err)
 		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, versionId)
 	}
 	return &pb.GetAppVersionPackageResponse{
@@ -327,6 +447,76 @@ func (p *Server) GetAppVersionPackage(ctx context.Context, req *pb.GetAppVersion
 	}, nil
 }
 
+func (p *Server) UploadAppVersionPackage(ctx context.Context, req *pb.UploadAppVersionPackageRequest) (*pb.UploadAppVersionPackageResponse, error) {
+	// TODO: check resource permission
+	versionId := req.GetVersionId().GetValue()
+	version, err := p.getAppVersion(versionId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, versionId)
+	}
+	if version.Status == constants.StatusDeleted {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorResourceAlreadyDeleted, versionId)
+	}
+
+	app, err := p.getApp(version.AppId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, version.AppId)
+	}
+	providers, err := getRepoProviders(app.RepoId)
+	if err != nil {
+		return nil, err
+	}
+
+	content := req.GetPackage()
+	err = loadPackage(providers, content)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorInvalidAppPackage, err.Error())
+	}
+
+	key := fmt.Sprintf("apps/%s/%s.tgz", version.AppId, versionId)
+	err = p.store.Write(key, content)
+	if err != nil {
+		logger.Error("Failed to write [%s] to storage, error: %+v", key, err)
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, versionId)
+	}
+
+	tx, err := p.Db.NewTx()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, versionId)
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	query := tx.
+		Update(models.AppVersionTableName).
+		Set(models.ColumnPackageName, blob.Url(key)).
+		Set(models.ColumnUpdateTime, time.Now())
+	// the uploaded package has no provenance, it can not keep the
+	// verification of the repo
+	if version.SignatureStatus != "" {
+		query = query.Set(models.ColumnSignatureStatus, constants.SignatureUnverified)
+	}
+	_, err = query.
+		Where(db.Eq(models.ColumnVersionId, versionId)).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, versionId)
+	}
+
+	// the package reviewed can not be changed without another review
+	_, err = updateAppVersionStatus(tx, version, actionRevise, senderutil.GetSenderFromContext(ctx).UserId, "package uploaded")
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, versionId)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, versionId)
+	}
+
+	return &pb.UploadAppVersionPackageResponse{
+		VersionId: req.GetVersionId(),
+	}, nil
+}
+
 func (p *Server) GetAppVersionPackageFiles(ctx context.Context, req *pb.GetAppVersionPackageFilesRequest) (*pb.GetAppVersionPackageFilesResponse, error) {
 	// TODO: check resource permission
 	versionId := req.GetVersionId().GetValue()
@@ -335,15 +525,13 @@ func (p *Server) GetAppVersionPackageFiles(ctx context.Context, req *pb.GetAppVe
 	if err != nil {
 		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, versionId)
 	}
-	packageUrl := version.PackageName
-	resp, err := httputil.HttpGet(packageUrl)
+	content, err := p.getPackage(version)
 	if err != nil {
-		logger.Error("Failed to http get [%s], error: %+v", packageUrl, err)
 		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, versionId)
 	}
-	archiveFiles, err := gziputil.LoadArchive(resp.Body, includeFiles...)
+	archiveFiles, err := gziputil.LoadArchive(bytes.NewReader(content), includeFiles...)
 	if err != nil {
-		logger.Error("Failed to load package [%s] archive, error: %+v", packageUrl, err)
+		logger.Error("Failed to load package [%s] archive, error: %+v", version.PackageName, err)
 		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, versionId)
 	}
 	return &pb.GetAppVersionPackageFilesResponse{
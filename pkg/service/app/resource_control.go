@@ -43,6 +43,7 @@ func (p *Server) getLatestAppVersion(appId string) (*models.AppVersion, error) {
 		Select(models.AppVersionColumns...).
 		From(models.AppVersionTableName).
 		Where(db.Eq("app_id", appId)).
+		OrderDir(models.ColumnSemver, false).
 		OrderDir(models.ColumnSequence, false).
 		LoadOne(&appVersion)
 	if err != nil {
@@ -0,0 +1,228 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/senderutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+const (
+	ActionSubmit  = "submit"
+	ActionReview  = "review"
+	ActionPass    = "pass"
+	ActionReject  = "reject"
+	ActionRelease = "release"
+	ActionSuspend = "suspend"
+)
+
+// actionRevise is taken by the changes of the package, the app version
+// has to be reviewed again after its package is changed.
+const actionRevise = "revise"
+
+type transition struct {
+	from []string
+	to   string
+}
+
+// appVersionTransitions is the release workflow of app version:
+//
+//	draft -> submitted -> in-review -> passed -> active <-> suspended
+//	                  \-------------> rejected -> submitted
+//
+// a version in review or reviewed goes back to draft when it is revised.
+var appVersionTransitions = map[string]transition{
+	ActionSubmit:  {[]string{constants.StatusDraft, constants.StatusRejected}, constants.StatusSubmitted},
+	ActionReview:  {[]string{constants.StatusSubmitted}, constants.StatusInReview},
+	ActionPass:    {[]string{constants.StatusInReview}, constants.StatusPassed},
+	ActionReject:  {[]string{constants.StatusSubmitted, constants.StatusInReview}, constants.StatusRejected},
+	ActionRelease: {[]string{constants.StatusPassed, constants.StatusSuspended}, constants.StatusActive},
+	ActionSuspend: {[]string{constants.StatusActive}, constants.StatusSuspended},
+	actionRevise: {[]string{constants.StatusSubmitted, constants.StatusInReview, constants.StatusPassed,
+		constants.StatusActive, constants.StatusSuspended}, constants.StatusDraft},
+}
+
+// nextAppVersionStatus returns the status that the action moves the app
+// version in the status to, and false if the action is not allowed.
+func nextAppVersionStatus(action, status string) (string, bool) {
+	t, ok := appVersionTransitions[action]
+	if !ok || !stringutil.StringIn(status, t.from) {
+		return "", false
+	}
+	return t.to, true
+}
+
+func addAppVersionAudit(tx *db.Tx, version *models.AppVersion, operator, message string) error {
+	audit := models.NewAppVersionAudit(version.VersionId, version.AppId, version.Status, operator, message)
+	_, err := tx.
+		InsertInto(models.AppVersionAuditTableName).
+		Columns(models.AppVersionAuditColumns...).
+		Record(audit).
+		Exec()
+	if err != nil {
+		logger.Error("Failed to add audit of app version [%s], error: %+v", version.VersionId, err)
+	}
+	return err
+}
+
+// updateAppVersionStatus moves the app version to the next status of the
+// action and records the transition in the transaction, it returns false
+// when the version is not in the status that the action moves from.
+func updateAppVersionStatus(tx *db.Tx, version *models.AppVersion, action, operator, message string) (bool, error) {
+	t := appVersionTransitions[action]
+	// the status is checked by the update itself, concurrent actions on
+	// the same version can not both succeed
+	result, err := tx.
+		Update(models.AppVersionTableName).
+		Set(models.ColumnStatus, t.to).
+		Set(models.ColumnStatusTime, time.Now()).
+		Where(db.Eq(models.ColumnVersionId, version.VersionId)).
+		Where(db.Eq(models.ColumnStatus, t.from)).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+	if count, err := result.RowsAffected(); err == nil && count == 0 {
+		return false, nil
+	}
+
+	version.Status = t.to
+	return true, addAppVersionAudit(tx, version, operator, message)
+}
+
+// transitAppVersion moves the app version to the next status of the action
+// and records the transition.
+func (p *Server) transitAppVersion(ctx context.Context, versionId, action, message string) error {
+	t := appVersionTransitions[action]
+	version, err := p.getAppVersion(versionId)
+	if err != nil {
+		return gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, versionId)
+	}
+	if version.Status == constants.StatusDeleted {
+		return gerr.New(gerr.FailedPrecondition, gerr.ErrorResourceAlreadyDeleted, versionId)
+	}
+	if _, ok := nextAppVersionStatus(action, version.Status); !ok {
+		return gerr.New(gerr.FailedPrecondition, gerr.ErrorResourceNotInStatus, versionId, strings.Join(t.from, "/"))
+	}
+
+	tx, err := p.Db.NewTx()
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, versionId)
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	ok, err := updateAppVersionStatus(tx, version, action, senderutil.GetSenderFromContext(ctx).UserId, message)
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, versionId)
+	}
+	if !ok {
+		return gerr.New(gerr.FailedPrecondition, gerr.ErrorResourceNotInStatus, versionId, strings.Join(t.from, "/"))
+	}
+	err = tx.Commit()
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourceFailed, versionId)
+	}
+	return nil
+}
+
+func (p *Server) SubmitAppVersion(ctx context.Context, req *pb.SubmitAppVersionRequest) (*pb.SubmitAppVersionResponse, error) {
+	err := p.transitAppVersion(ctx, req.GetVersionId().GetValue(), ActionSubmit, req.GetMessage().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmitAppVersionResponse{
+		VersionId: req.GetVersionId(),
+	}, nil
+}
+
+func (p *Server) ReviewAppVersion(ctx context.Context, req *pb.ReviewAppVersionRequest) (*pb.ReviewAppVersionResponse, error) {
+	err := p.transitAppVersion(ctx, req.GetVersionId().GetValue(), ActionReview, req.GetMessage().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReviewAppVersionResponse{
+		VersionId: req.GetVersionId(),
+	}, nil
+}
+
+func (p *Server) PassAppVersion(ctx context.Context, req *pb.PassAppVersionRequest) (*pb.PassAppVersionResponse, error) {
+	err := p.transitAppVersion(ctx, req.GetVersionId().GetValue(), ActionPass, req.GetMessage().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PassAppVersionResponse{
+		VersionId: req.GetVersionId(),
+	}, nil
+}
+
+func (p *Server) RejectAppVersion(ctx context.Context, req *pb.RejectAppVersionRequest) (*pb.RejectAppVersionResponse, error) {
+	err := p.transitAppVersion(ctx, req.GetVersionId().GetValue(), ActionReject, req.GetMessage().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RejectAppVersionResponse{
+		VersionId: req.GetVersionId(),
+	}, nil
+}
+
+func (p *Server) ReleaseAppVersion(ctx context.Context, req *pb.ReleaseAppVersionRequest) (*pb.ReleaseAppVersionResponse, error) {
+	err := p.transitAppVersion(ctx, req.GetVersionId().GetValue(), ActionRelease, req.GetMessage().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseAppVersionResponse{
+		VersionId: req.GetVersionId(),
+	}, nil
+}
+
+func (p *Server) SuspendAppVersion(ctx context.Context, req *pb.SuspendAppVersionRequest) (*pb.SuspendAppVersionResponse, error) {
+	err := p.transitAppVersion(ctx, req.GetVersionId().GetValue(), ActionSuspend, req.GetMessage().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SuspendAppVersionResponse{
+		VersionId: req.GetVersionId(),
+	}, nil
+}
+
+func (p *Server) DescribeAppVersionAudits(ctx context.Context, req *pb.DescribeAppVersionAuditsRequest) (*pb.DescribeAppVersionAuditsResponse, error) {
+	var audits []*models.AppVersionAudit
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+
+	query := p.Db.
+		Select(models.AppVersionAuditColumns...).
+		From(models.AppVersionAuditTableName).
+		Offset(offset).
+		Limit(limit).
+		Where(manager.BuildFilterConditions(req, models.AppVersionAuditTableName))
+	query = manager.AddQueryOrderDir(query, req, models.ColumnStatusTime)
+	_, err := query.Load(&audits)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	count, err := query.Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	res := &pb.DescribeAppVersionAuditsResponse{
+		AppVersionAuditSet: models.AppVersionAuditsToPbs(audits),
+		TotalCount:         count,
+	}
+	return res, nil
+}
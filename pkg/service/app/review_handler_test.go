@@ -0,0 +1,72 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+)
+
+func TestNextAppVersionStatus(t *testing.T) {
+	statuses := []string{
+		constants.StatusDraft,
+		constants.StatusSubmitted,
+		constants.StatusInReview,
+		constants.StatusPassed,
+		constants.StatusRejected,
+		constants.StatusActive,
+		constants.StatusSuspended,
+		constants.StatusDeleted,
+	}
+	// the allowed transitions of the workflow, the others are rejected
+	allowed := map[string]map[string]string{
+		ActionSubmit: {
+			constants.StatusDraft:    constants.StatusSubmitted,
+			constants.StatusRejected: constants.StatusSubmitted,
+		},
+		ActionReview: {
+			constants.StatusSubmitted: constants.StatusInReview,
+		},
+		ActionPass: {
+			constants.StatusInReview: constants.StatusPassed,
+		},
+		ActionReject: {
+			constants.StatusSubmitted: constants.StatusRejected,
+			constants.StatusInReview:  constants.StatusRejected,
+		},
+		ActionRelease: {
+			constants.StatusPassed:    constants.StatusActive,
+			constants.StatusSuspended: constants.StatusActive,
+		},
+		ActionSuspend: {
+			constants.StatusActive: constants.StatusSuspended,
+		},
+		actionRevise: {
+			constants.StatusSubmitted: constants.StatusDraft,
+			constants.StatusInReview:  constants.StatusDraft,
+			constants.StatusPassed:    constants.StatusDraft,
+			constants.StatusActive:    constants.StatusDraft,
+			constants.StatusSuspended: constants.StatusDraft,
+		},
+	}
+	if len(allowed) != len(appVersionTransitions) {
+		t.Fatalf("expect %d actions, got %d", len(allowed), len(appVersionTransitions))
+	}
+
+	for action, next := range allowed {
+		for _, status := range statuses {
+			to, ok := nextAppVersionStatus(action, status)
+			expect, expectOk := next[status]
+			if ok != expectOk || to != expect {
+				t.Fatalf("action [%s] on [%s]: expect [%s] %t, got [%s] %t", action, status, expect, expectOk, to, ok)
+			}
+		}
+	}
+
+	if _, ok := nextAppVersionStatus("unknown", constants.StatusDraft); ok {
+		t.Fatalf("unknown action should not be allowed")
+	}
+}
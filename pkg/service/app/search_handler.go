@@ -0,0 +1,75 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"context"
+
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/search"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+func (p *Server) SearchApps(ctx context.Context, req *pb.SearchAppsRequest) (*pb.SearchAppsResponse, error) {
+	result, err := p.index.Search(search.Query{
+		SearchWord: req.GetSearchWord().GetValue(),
+		Filters: map[string][]string{
+			search.FacetCategory:   req.GetCategoryId(),
+			search.FacetRepo:       req.GetRepoId(),
+			search.FacetProvider:   req.GetProvider(),
+			search.FacetStatus:     req.GetStatus(),
+			search.FacetKeyword:    req.GetKeyword(),
+			search.FacetMaintainer: req.GetMaintainer(),
+		},
+		Offset: pbutil.GetOffsetFromRequest(req),
+		Limit:  pbutil.GetLimitFromRequest(req),
+	})
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	apps, err := p.getApps(result.AppIds)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	// keep the rank of the search
+	appMap := make(map[string]*models.App)
+	for _, app := range apps {
+		appMap[app.AppId] = app
+	}
+	apps = apps[:0]
+	for _, appId := range result.AppIds {
+		if app, ok := appMap[appId]; ok {
+			apps = append(apps, app)
+		}
+	}
+	appSet, err := p.formatAppSet(apps)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	var facetSet []*pb.SearchFacet
+	for _, facet := range search.Facets {
+		pbFacet := &pb.SearchFacet{
+			Name: pbutil.ToProtoString(facet),
+		}
+		for _, v := range result.Facets[facet] {
+			pbFacet.ValueSet = append(pbFacet.ValueSet, &pb.SearchFacetValue{
+				Value: pbutil.ToProtoString(v.Value),
+				Count: v.Count,
+			})
+		}
+		facetSet = append(facetSet, pbFacet)
+	}
+
+	res := &pb.SearchAppsResponse{
+		TotalCount: result.TotalCount,
+		AppSet:     appSet,
+		FacetSet:   facetSet,
+	}
+	return res, nil
+}
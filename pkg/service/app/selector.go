@@ -0,0 +1,94 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	repoclient "openpitrix.io/openpitrix/pkg/client/repo"
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+)
+
+func getRuntimeLabels(runtimeId string) (map[string]string, error) {
+	ctx := clientutil.GetSystemUserContext()
+	runtimeManagerClient, err := runtimeclient.NewRuntimeManagerClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	res, err := runtimeManagerClient.DescribeRuntimes(ctx, &pb.DescribeRuntimesRequest{
+		RuntimeId: []string{runtimeId},
+	})
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, runtimeId)
+	}
+	if len(res.RuntimeSet) == 0 {
+		return nil, gerr.New(gerr.NotFound, gerr.ErrorResourceNotFound, runtimeId)
+	}
+	return models.PbToRuntimeLabelMap(res.RuntimeSet[0].GetLabels()), nil
+}
+
+// getRepoIdsByRuntime returns the ids of the repos whose selectors match the
+// labels of the runtime.
+func getRepoIdsByRuntime(runtimeId string) ([]string, error) {
+	labels, err := getRuntimeLabels(runtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := clientutil.GetSystemUserContext()
+	repoManagerClient, err := repoclient.NewRepoManagerClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	limit := uint32(50)
+	offset := uint32(0)
+	repoIds := []string{}
+	for {
+		res, err := repoManagerClient.DescribeRepos(ctx, &pb.DescribeReposRequest{
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+		for _, repo := range res.RepoSet {
+			selector, err := models.PbToSelector(repo.GetSelectors())
+			if err != nil {
+				logger.Warn("Failed to parse selectors of repo [%s]: %+v", repo.GetRepoId().GetValue(), err)
+				continue
+			}
+			if selector.Matches(labels) {
+				repoIds = append(repoIds, repo.GetRepoId().GetValue())
+			}
+		}
+		if len(res.RepoSet) < int(limit) {
+			return repoIds, nil
+		}
+		offset += uint32(len(res.RepoSet))
+	}
+}
+
+// getRepoProviders returns the providers of the repo, which decide the
+// format of the packages of its apps.
+func getRepoProviders(repoId string) ([]string, error) {
+	ctx := clientutil.GetSystemUserContext()
+	repoManagerClient, err := repoclient.NewRepoManagerClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	res, err := repoManagerClient.DescribeRepos(ctx, &pb.DescribeReposRequest{
+		RepoId: []string{repoId},
+	})
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, repoId)
+	}
+	if len(res.RepoSet) == 0 {
+		return nil, gerr.New(gerr.NotFound, gerr.ErrorResourceNotFound, repoId)
+	}
+	return res.RepoSet[0].GetProviders(), nil
+}
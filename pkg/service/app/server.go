@@ -7,20 +7,42 @@ package app
 import (
 	"google.golang.org/grpc"
 
+	"openpitrix.io/openpitrix/pkg/blob"
 	"openpitrix.io/openpitrix/pkg/config"
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/search"
 )
 
 type Server struct {
 	*pi.Pi
+	store blob.Store
+	index *search.Index
 }
 
 func Serve(cfg *config.Config) {
 	pi.SetGlobalPi(cfg)
-	s := Server{pi.Global()}
+	store, err := blob.NewStore(cfg.Storage)
+	if err != nil {
+		logger.Critical("Failed to create storage: %+v", err)
+		panic(err)
+	}
+	index := search.NewIndex(pi.Global().Db)
+	index.Hook()
+	s := Server{pi.Global(), store, index}
+	go func() {
+		err := s.syncSemver()
+		if err != nil {
+			logger.Error("Failed to sync semver of app versions: %+v", err)
+		}
+		err = index.IndexAll()
+		if err != nil {
+			logger.Error("Failed to index apps: %+v", err)
+		}
+	}()
 	manager.NewGrpcServer("app-manager", constants.AppManagerPort).
 		ShowErrorCause(cfg.Grpc.ShowErrorCause).
 		WithChecker(s.Checker).
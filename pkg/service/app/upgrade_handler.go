@@ -0,0 +1,116 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"context"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/semverutil"
+)
+
+// canUpgrade reports whether the app version can upgrade from one to the
+// other, the target must be newer and allow the source by its upgrade_from.
+func canUpgrade(from, to *models.AppVersion) bool {
+	_, errFrom := semverutil.Parse(from.Name)
+	_, errTo := semverutil.Parse(to.Name)
+	if errFrom == nil && errTo == nil {
+		if semverutil.Compare(to.Name, from.Name) <= 0 {
+			return false
+		}
+	} else if to.Sequence <= from.Sequence {
+		// fall back to the order of the versions in the repo
+		return false
+	}
+	ok, err := semverutil.CheckConstraint(to.UpgradeFrom, from.Name)
+	if err != nil {
+		logger.Warn("Invalid upgrade_from [%s] of app version [%s]: %+v", to.UpgradeFrom, to.VersionId, err)
+		return false
+	}
+	return ok
+}
+
+func (p *Server) getUpgradePaths(version *models.AppVersion) ([]*models.AppVersion, error) {
+	var versions []*models.AppVersion
+	_, err := p.Db.
+		Select(models.AppVersionColumns...).
+		From(models.AppVersionTableName).
+		Where(db.Eq(models.ColumnAppId, version.AppId)).
+		Where(db.Eq(models.ColumnStatus, constants.StatusActive)).
+		Where(db.Neq(models.ColumnVersionId, version.VersionId)).
+		OrderDir(models.ColumnSemver, true).
+		OrderDir(models.ColumnSequence, true).
+		Load(&versions)
+	if err != nil {
+		return nil, err
+	}
+	var paths []*models.AppVersion
+	for _, v := range versions {
+		if canUpgrade(version, v) {
+			paths = append(paths, v)
+		}
+	}
+	return paths, nil
+}
+
+func (p *Server) GetAppVersionUpgradePaths(ctx context.Context, req *pb.GetAppVersionUpgradePathsRequest) (*pb.GetAppVersionUpgradePathsResponse, error) {
+	versionId := req.GetVersionId().GetValue()
+	version, err := p.getAppVersion(versionId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, versionId)
+	}
+	paths, err := p.getUpgradePaths(version)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	return &pb.GetAppVersionUpgradePathsResponse{
+		VersionId:     req.GetVersionId(),
+		AppVersionSet: models.AppVersionsToPbs(paths),
+	}, nil
+}
+
+// syncSemver refreshes the semver sort keys of the app versions, the keys of
+// the versions created before the column exists are empty, and the keys of
+// pre-releases change with the format of semverutil.SortKey.
+func (p *Server) syncSemver() error {
+	var versions []*models.AppVersion
+	_, err := p.Db.
+		Select(models.ColumnVersionId, models.ColumnName, models.ColumnSemver).
+		From(models.AppVersionTableName).
+		Where(db.Neq(models.ColumnStatus, constants.StatusDeleted)).
+		Load(&versions)
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.Db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	// the transaction bypasses the hooks of the database, the apps are
+	// indexed after the sync
+	for _, version := range versions {
+		key := semverutil.SortKey(version.Name)
+		if key == version.Semver {
+			continue
+		}
+		_, err = tx.
+			Update(models.AppVersionTableName).
+			Set(models.ColumnSemver, key).
+			Where(db.Eq(models.ColumnVersionId, version.VersionId)).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,33 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+func TestCanUpgrade(t *testing.T) {
+	v := func(name, upgradeFrom string, sequence uint32) *models.AppVersion {
+		return &models.AppVersion{Name: name, UpgradeFrom: upgradeFrom, Sequence: sequence}
+	}
+	testCases := []struct {
+		from, to *models.AppVersion
+		ok       bool
+	}{
+		{v("1.0.0", "", 0), v("1.1.0 [2.0]", "", 1), true},
+		{v("1.1.0", "", 1), v("1.0.0", "", 0), false},
+		{v("1.0.0", "", 0), v("2.0.0", ">=1.1.0", 2), false},
+		{v("1.1.0", "", 1), v("2.0.0", ">=1.1.0", 2), true},
+		{v("latest", "", 0), v("stable", "", 1), true},
+		{v("latest", "", 0), v("2.0.0", ">=1.1.0", 1), false},
+	}
+	for _, tc := range testCases {
+		if canUpgrade(tc.from, tc.to) != tc.ok {
+			t.Fatalf("upgrade from [%s] to [%s], expect %t", tc.from.Name, tc.to.Name, tc.ok)
+		}
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	appclient "openpitrix.io/openpitrix/pkg/client/app"
+	repoclient "openpitrix.io/openpitrix/pkg/client/repo"
 	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
@@ -92,6 +96,14 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 		return manager.NewChecker(ctx, r).
 			Required("app_id", "version_id", "runtime_id", "conf").
 			Exec()
+	case *pb.EstimateClusterCostRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id", "runtime_id", "conf").
+			Exec()
+	case *pb.DescribeClusterCostsRequest:
+		return manager.NewChecker(ctx, r).
+			Required("cluster_id").
+			Exec()
 	case *pb.DeleteClustersRequest:
 		return manager.NewChecker(ctx, r).
 			Required("cluster_id").
@@ -120,6 +132,10 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 		return manager.NewChecker(ctx, r).
 			Required("cluster_id", "env").
 			Exec()
+	case *pb.DescribeClusterNodeLogsRequest:
+		return manager.NewChecker(ctx, r).
+			Required("cluster_id").
+			Exec()
 	case *pb.StopClustersRequest:
 		return manager.NewChecker(ctx, r).
 			Required("cluster_id").
@@ -140,6 +156,107 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 	return nil
 }
 
+// checkAppVersion blocks the app version that is not released yet, and the
+// one whose package failed the signature verification against the trusted
+// keys of its repo.
+func checkAppVersion(versionId string) error {
+	ctx := clientutil.GetSystemUserContext()
+	appManagerClient, err := appclient.NewAppManagerClient()
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	res, err := appManagerClient.DescribeAppVersions(ctx, &pb.DescribeAppVersionsRequest{
+		VersionId: []string{versionId},
+	})
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, versionId)
+	}
+	if len(res.AppVersionSet) == 0 {
+		return gerr.New(gerr.NotFound, gerr.ErrorResourceNotFound, versionId)
+	}
+	if res.AppVersionSet[0].GetStatus().GetValue() != constants.StatusActive {
+		return gerr.New(gerr.FailedPrecondition, gerr.ErrorAppVersionNotActive, versionId)
+	}
+	if res.AppVersionSet[0].GetSignatureStatus().GetValue() == constants.SignatureUnverified {
+		return gerr.New(gerr.FailedPrecondition, gerr.ErrorAppVersionUnverified, versionId)
+	}
+	return nil
+}
+
+// checkRepoSelector rejects the runtime whose labels do not match the
+// selectors of the repo of the app version.
+func checkRepoSelector(versionId string, runtime *runtimeclient.Runtime) error {
+	ctx := clientutil.GetSystemUserContext()
+	appManagerClient, err := appclient.NewAppManagerClient()
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	versionRes, err := appManagerClient.DescribeAppVersions(ctx, &pb.DescribeAppVersionsRequest{
+		VersionId: []string{versionId},
+	})
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, versionId)
+	}
+	if len(versionRes.AppVersionSet) == 0 {
+		return gerr.New(gerr.NotFound, gerr.ErrorResourceNotFound, versionId)
+	}
+	appId := versionRes.AppVersionSet[0].GetAppId().GetValue()
+	appRes, err := appManagerClient.DescribeApps(ctx, &pb.DescribeAppsRequest{
+		AppId: []string{appId},
+	})
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, appId)
+	}
+	if len(appRes.AppSet) == 0 {
+		return gerr.New(gerr.NotFound, gerr.ErrorResourceNotFound, appId)
+	}
+	repoId := appRes.AppSet[0].GetRepoId().GetValue()
+
+	repoManagerClient, err := repoclient.NewRepoManagerClient()
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	repoRes, err := repoManagerClient.DescribeRepos(ctx, &pb.DescribeReposRequest{
+		RepoId: []string{repoId},
+	})
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, repoId)
+	}
+	if len(repoRes.RepoSet) == 0 {
+		return gerr.New(gerr.NotFound, gerr.ErrorResourceNotFound, repoId)
+	}
+	selector, err := models.PbToSelector(repoRes.RepoSet[0].GetSelectors())
+	if err != nil {
+		return gerr.NewWithDetail(gerr.FailedPrecondition, err, gerr.ErrorParameterParseFailed, "selectors")
+	}
+	if !selector.Matches(runtime.Labels) {
+		return gerr.New(gerr.FailedPrecondition, gerr.ErrorRuntimeNotMatchRepoSelector, runtime.RuntimeId, selector.String(), repoId)
+	}
+	return nil
+}
+
+// checkAppVersionUpgrade blocks the upgrade that the target app version does
+// not allow by its upgrade_from, or that does not go to a newer version.
+func checkAppVersionUpgrade(fromVersionId, toVersionId string) error {
+	ctx := clientutil.GetSystemUserContext()
+	appManagerClient, err := appclient.NewAppManagerClient()
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	res, err := appManagerClient.GetAppVersionUpgradePaths(ctx, &pb.GetAppVersionUpgradePathsRequest{
+		VersionId: pbutil.ToProtoString(fromVersionId),
+	})
+	if err != nil {
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, fromVersionId)
+	}
+	for _, appVersion := range res.AppVersionSet {
+		if appVersion.GetVersionId().GetValue() == toVersionId {
+			return nil
+		}
+	}
+	return gerr.New(gerr.FailedPrecondition, gerr.ErrorAppVersionUpgradeNotAllowed, fromVersionId, toVersionId)
+}
+
 func CheckVmBasedProvider(ctx context.Context, runtime *runtimeclient.Runtime, providerInterface plugins.ProviderInterface,
 	clusterWrapper *models.ClusterWrapper) error {
 	// check image
@@ -148,6 +265,57 @@ func CheckVmBasedProvider(ctx context.Context, runtime *runtimeclient.Runtime, p
 		return gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorValidateFailed)
 	}
 
+	// check network provision, the vpc and subnet are created with the cluster
+	// if no subnet is specified, the eips are allocated for the public endpoints
+	eipCount := 0
+	for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		role := clusterNode.Role
+		if strings.HasSuffix(role, constants.ReplicaRoleSuffix) {
+			role = string([]byte(role)[:len(role)-len(constants.ReplicaRoleSuffix)])
+		}
+		clusterRole, exist := clusterWrapper.ClusterRoles[role]
+		if exist && clusterRole.PublicEndpoint {
+			eipCount++
+		}
+	}
+	if clusterWrapper.Cluster.SubnetId == "" || eipCount > 0 {
+		networkInterface, ok := providerInterface.(plugins.NetworkInterface)
+		if !ok {
+			err = fmt.Errorf("provider [%s] can not provision network", runtime.Provider)
+			return gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorNetworkProvisionNotSupported, runtime.Provider)
+		}
+		vpcCount := 0
+		if clusterWrapper.Cluster.SubnetId == "" {
+			vpcCount = 1
+		}
+		err = networkInterface.CheckNetworkQuota(runtime.RuntimeId, vpcCount, eipCount)
+		if err != nil {
+			return gerr.NewWithDetail(gerr.PermissionDenied, err, gerr.ErrorResourceQuotaNotEnough, err.Error())
+		}
+	}
+	// check loadbalancer listeners declared by the roles
+	var listenerIds []string
+	for _, clusterLoadbalancers := range clusterWrapper.ClusterLoadbalancers {
+		for _, clusterLoadbalancer := range clusterLoadbalancers {
+			listenerIds = append(listenerIds, clusterLoadbalancer.LoadbalancerListenerId)
+		}
+	}
+	if len(listenerIds) > 0 {
+		loadbalancerInterface, ok := providerInterface.(plugins.LoadbalancerInterface)
+		if !ok {
+			err = fmt.Errorf("provider [%s] can not add nodes to loadbalancer", runtime.Provider)
+			return gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorLoadbalancerNotSupported, runtime.Provider)
+		}
+		err = loadbalancerInterface.CheckLoadbalancerListeners(runtime.RuntimeId, listenerIds)
+		if err != nil {
+			return gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, strings.Join(listenerIds, ","))
+		}
+	}
+
+	if clusterWrapper.Cluster.SubnetId == "" {
+		return createVmBasedNetwork(ctx, runtime, providerInterface, clusterWrapper)
+	}
+
 	// check subnet, vpc, eip
 	subnetResponse, err := providerInterface.DescribeSubnets(ctx, &pb.DescribeSubnetsRequest{
 		RuntimeId: pbutil.ToProtoString(runtime.RuntimeId),
@@ -187,3 +355,26 @@ func CheckVmBasedProvider(ctx context.Context, runtime *runtimeclient.Runtime, p
 
 	return nil
 }
+
+// createVmBasedNetwork creates a new frontgate for the cluster without subnet,
+// the frontgate runs in the vpc and subnet created by the cluster.
+func createVmBasedNetwork(ctx context.Context, runtime *runtimeclient.Runtime, providerInterface plugins.ProviderInterface,
+	clusterWrapper *models.ClusterWrapper) error {
+	err := providerInterface.CheckResource(ctx, clusterWrapper)
+	if err != nil {
+		return gerr.NewWithDetail(gerr.PermissionDenied, err, gerr.ErrorResourceQuotaNotEnough, err.Error())
+	}
+
+	fg := &Frontgate{
+		Runtime: runtime,
+	}
+	frontgateId, err := fg.CreateCluster(clusterWrapper)
+	if err != nil {
+		logger.Error("Create frontgate with network for user [%s] failed. ", clusterWrapper.Cluster.Owner)
+		return gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorCreateResourceFailed, frontgateId)
+	}
+
+	clusterWrapper.Cluster.FrontgateId = frontgateId
+
+	return nil
+}
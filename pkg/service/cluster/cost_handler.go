@@ -0,0 +1,191 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/plugins"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// getRoleCosts returns the cost per hour of the nodes of the roles sorted by
+// role and the sum of them.
+func getRoleCosts(runtimePrice *models.RuntimePrice, clusterRoles map[string]*models.ClusterRole,
+	nodeCounts map[string]uint32) ([]*pb.RoleCost, float64) {
+	var roleCosts []*pb.RoleCost
+	var hourly float64
+	for role, clusterRole := range clusterRoles {
+		roleCost := runtimePrice.GetRoleCost(role, nodeCounts[role],
+			clusterRole.Cpu, clusterRole.Gpu, clusterRole.Memory, clusterRole.StorageSize)
+		roleCosts = append(roleCosts, roleCost)
+		hourly += roleCost.Total
+	}
+	sort.Slice(roleCosts, func(i, j int) bool {
+		return roleCosts[i].GetRole().GetValue() < roleCosts[j].GetRole().GetValue()
+	})
+	return roleCosts, hourly
+}
+
+// getAccumulatedCost returns the cost of the nodes from their creation to now,
+// the released nodes are charged until their status time.
+func getAccumulatedCost(runtimePrice *models.RuntimePrice, clusterRoles map[string]*models.ClusterRole,
+	clusterNodes []*models.ClusterNode, now time.Time) float64 {
+	nodeHourly := make(map[string]float64)
+	for role, clusterRole := range clusterRoles {
+		nodeHourly[role] = runtimePrice.GetRoleCost(role, 1,
+			clusterRole.Cpu, clusterRole.Gpu, clusterRole.Memory, clusterRole.StorageSize).Total
+	}
+	var accumulated float64
+	for _, clusterNode := range clusterNodes {
+		end := now
+		if stringutil.StringIn(clusterNode.Status, releasedStatuses) {
+			end = clusterNode.StatusTime
+		}
+		if end.Before(clusterNode.CreateTime) {
+			continue
+		}
+		accumulated += nodeHourly[clusterNode.Role] * end.Sub(clusterNode.CreateTime).Hours()
+	}
+	return accumulated
+}
+
+func getRuntimePrice(runtimeId string) (*models.RuntimePrice, error) {
+	runtimePrices, err := runtimeclient.GetRuntimePrices([]string{runtimeId})
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	runtimePrice, ok := runtimePrices[runtimeId]
+	if !ok {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorRuntimePriceNotFound, runtimeId)
+	}
+	return runtimePrice, nil
+}
+
+func (p *Server) EstimateClusterCost(ctx context.Context, req *pb.EstimateClusterCostRequest) (*pb.EstimateClusterCostResponse, error) {
+	versionId := req.GetVersionId().GetValue()
+	conf := req.GetConf().GetValue()
+	runtimeId := req.GetRuntimeId().GetValue()
+	runtime, err := runtimeclient.NewRuntime(runtimeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.PermissionDenied, err, gerr.ErrorResourceNotFound, runtimeId)
+	}
+	runtimePrice, err := getRuntimePrice(runtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	providerInterface, err := plugins.GetProviderPlugin(runtime.Provider, nil)
+	if err != nil {
+		logger.Error("No such provider [%s]. ", runtime.Provider)
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorProviderNotFound, runtime.Provider)
+	}
+	clusterWrapper, err := providerInterface.ParseClusterConf(versionId, runtimeId, conf)
+	if err != nil {
+		logger.Error("Parse cluster conf with versionId [%s] runtime [%s] failed: %+v", versionId, runtimeId, err)
+		if gerr.IsGRPCError(err) {
+			return nil, err
+		}
+		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorValidateFailed)
+	}
+
+	nodeCounts := make(map[string]uint32)
+	for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		nodeCounts[clusterNode.Role]++
+	}
+	roleCosts, hourly := getRoleCosts(runtimePrice, clusterWrapper.ClusterRoles, nodeCounts)
+
+	return &pb.EstimateClusterCostResponse{
+		RuntimeId:   pbutil.ToProtoString(runtimeId),
+		Currency:    pbutil.ToProtoString(runtimePrice.Currency),
+		RoleCostSet: roleCosts,
+		Hourly:      hourly,
+		Monthly:     hourly * constants.HoursPerMonth,
+	}, nil
+}
+
+func (p *Server) DescribeClusterCosts(ctx context.Context, req *pb.DescribeClusterCostsRequest) (*pb.DescribeClusterCostsResponse, error) {
+	var clusters []*models.Cluster
+	_, err := pi.Global().Db.
+		Select(models.ClusterColumns...).
+		From(models.ClusterTableName).
+		Where(db.Eq(models.ColumnClusterId, req.GetClusterId())).
+		OrderDir(models.ColumnClusterId, true).
+		Load(&clusters)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	if len(clusters) == 0 {
+		return &pb.DescribeClusterCostsResponse{}, nil
+	}
+	var runtimeIds []string
+	for _, cluster := range clusters {
+		runtimeIds = append(runtimeIds, cluster.RuntimeId)
+	}
+	runtimePrices, err := runtimeclient.GetRuntimePrices(stringutil.Unique(runtimeIds))
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	now := time.Now()
+	res := &pb.DescribeClusterCostsResponse{}
+	for _, cluster := range clusters {
+		runtimePrice, ok := runtimePrices[cluster.RuntimeId]
+		if !ok {
+			return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorRuntimePriceNotFound, cluster.RuntimeId)
+		}
+		var roles []*models.ClusterRole
+		_, err = pi.Global().Db.
+			Select(models.ClusterRoleColumns...).
+			From(models.ClusterRoleTableName).
+			Where(db.Eq(models.ColumnClusterId, cluster.ClusterId)).
+			Load(&roles)
+		if err != nil {
+			return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+		clusterRoles := make(map[string]*models.ClusterRole)
+		for _, role := range roles {
+			clusterRoles[role.Role] = role
+		}
+
+		var clusterNodes []*models.ClusterNode
+		_, err = pi.Global().Db.
+			Select(models.ClusterNodeColumns...).
+			From(models.ClusterNodeTableName).
+			Where(db.Eq(models.ColumnClusterId, cluster.ClusterId)).
+			Load(&clusterNodes)
+		if err != nil {
+			return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+
+		nodeCounts := make(map[string]uint32)
+		for _, clusterNode := range clusterNodes {
+			if !stringutil.StringIn(clusterNode.Status, releasedStatuses) {
+				nodeCounts[clusterNode.Role]++
+			}
+		}
+		roleCosts, hourly := getRoleCosts(runtimePrice, clusterRoles, nodeCounts)
+		res.ClusterCostSet = append(res.ClusterCostSet, &pb.ClusterCost{
+			ClusterId:   pbutil.ToProtoString(cluster.ClusterId),
+			RuntimeId:   pbutil.ToProtoString(cluster.RuntimeId),
+			Currency:    pbutil.ToProtoString(runtimePrice.Currency),
+			RoleCostSet: roleCosts,
+			Hourly:      hourly,
+			Accumulated: getAccumulatedCost(runtimePrice, clusterRoles, clusterNodes, now),
+		})
+	}
+	return res, nil
+}
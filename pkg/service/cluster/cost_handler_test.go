@@ -0,0 +1,76 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+func floatEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestGetRoleCosts(t *testing.T) {
+	runtimePrice := models.NewRuntimePrice("rt-1")
+	runtimePrice.CpuHour = 0.1
+	runtimePrice.MemoryGbHour = 0.05
+	runtimePrice.VolumeGbMonth = 0.72
+	runtimePrice.GpuHour = 2
+	runtimePrice.SetInstanceClassHour(map[string]float64{"4c8192m": 0.3})
+
+	clusterRoles := map[string]*models.ClusterRole{
+		"worker": {Role: "worker", Cpu: 2, Memory: 2048, StorageSize: 10, Gpu: 1},
+		"master": {Role: "master", Cpu: 4, Memory: 8192, StorageSize: 100},
+	}
+	nodeCounts := map[string]uint32{"master": 1, "worker": 3}
+	roleCosts, hourly := getRoleCosts(runtimePrice, clusterRoles, nodeCounts)
+	if len(roleCosts) != 2 {
+		t.Fatalf("expect 2 role costs, got %d", len(roleCosts))
+	}
+
+	master := roleCosts[0]
+	if master.GetRole().GetValue() != "master" {
+		t.Fatalf("expect role costs sorted by role, got [%s]", master.GetRole().GetValue())
+	}
+	if master.Cpu != 0 || master.Memory != 0 || !floatEqual(master.InstanceClass, 0.3) {
+		t.Fatalf("expect instance class price of master, got %+v", master)
+	}
+	if !floatEqual(master.Volume, 0.1) || !floatEqual(master.Total, 0.4) {
+		t.Fatalf("unexpected cost of master %+v", master)
+	}
+
+	worker := roleCosts[1]
+	if !floatEqual(worker.Cpu, 0.6) || !floatEqual(worker.Memory, 0.3) ||
+		!floatEqual(worker.Gpu, 6) || !floatEqual(worker.Volume, 0.03) {
+		t.Fatalf("unexpected cost of worker %+v", worker)
+	}
+	if !floatEqual(hourly, 0.4+6.93) {
+		t.Fatalf("expect hourly cost %v, got %v", 0.4+6.93, hourly)
+	}
+}
+
+func TestGetAccumulatedCost(t *testing.T) {
+	runtimePrice := models.NewRuntimePrice("rt-1")
+	runtimePrice.CpuHour = 1
+
+	now := time.Now()
+	clusterRoles := map[string]*models.ClusterRole{
+		"": {Cpu: 2},
+	}
+	clusterNodes := []*models.ClusterNode{
+		{Status: constants.StatusActive, CreateTime: now.Add(-10 * time.Hour)},
+		{Status: constants.StatusDeleted, CreateTime: now.Add(-10 * time.Hour), StatusTime: now.Add(-5 * time.Hour)},
+		{Status: constants.StatusActive, CreateTime: now.Add(time.Hour)},
+	}
+	accumulated := getAccumulatedCost(runtimePrice, clusterRoles, clusterNodes, now)
+	if !floatEqual(accumulated, 30) {
+		t.Fatalf("expect accumulated cost 30, got %v", accumulated)
+	}
+}
@@ -7,20 +7,26 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	pb_empty "github.com/golang/protobuf/ptypes/empty"
 
 	jobclient "openpitrix.io/openpitrix/pkg/client/job"
+	pilotclient "openpitrix.io/openpitrix/pkg/client/pilot"
 	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/devkit/app"
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
 	"openpitrix.io/openpitrix/pkg/pi"
 	"openpitrix.io/openpitrix/pkg/plugins"
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
@@ -609,6 +615,18 @@ func (p *Server) CreateCluster(ctx context.Context, req *pb.CreateClusterRequest
 	if err != nil {
 		return nil, gerr.NewWithDetail(gerr.PermissionDenied, err, gerr.ErrorResourceNotFound, runtimeId)
 	}
+	if runtime.HealthStatus == constants.HealthStatusUnhealthy {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorRuntimeUnhealthy, runtimeId, runtime.LastError)
+	}
+
+	err = checkAppVersion(versionId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkRepoSelector(versionId, runtime)
+	if err != nil {
+		return nil, err
+	}
 
 	providerInterface, err := plugins.GetProviderPlugin(runtime.Provider, nil)
 	if err != nil {
@@ -632,7 +650,11 @@ func (p *Server) CreateCluster(ctx context.Context, req *pb.CreateClusterRequest
 	clusterWrapper.Cluster.ClusterId = clusterId
 	clusterWrapper.Cluster.ClusterType = constants.NormalClusterType
 
-	if reflectutil.In(runtime.Provider, constants.VmBaseProviders) {
+	vmBased, err := plugins.IsVmBased(runtime.Provider)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorProviderNotFound, runtime.Provider)
+	}
+	if vmBased {
 		err = CheckVmBasedProvider(ctx, runtime, providerInterface, clusterWrapper)
 		if err != nil {
 			return nil, err
@@ -948,6 +970,14 @@ func (p *Server) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterReque
 	if err != nil {
 		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
 	}
+	err = checkAppVersion(versionId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppVersionUpgrade(clusterWrapper.Cluster.VersionId, versionId)
+	if err != nil {
+		return nil, err
+	}
 
 	directive := jsonutil.ToString(clusterWrapper)
 
@@ -1320,6 +1350,151 @@ func (p *Server) DescribeClusterNodes(ctx context.Context, req *pb.DescribeClust
 	return res, nil
 }
 
+// toTailLogLines clamps the requested lines before the conversion,
+// so a large uint32 does not wrap to negative.
+func toTailLogLines(n uint32) int32 {
+	if n > constants.MaxTailLogLines {
+		return constants.MaxTailLogLines
+	}
+	return int32(n)
+}
+
+func (p *Server) DescribeClusterNodeLogs(ctx context.Context, req *pb.DescribeClusterNodeLogsRequest) (*pb.DescribeClusterNodeLogsResponse, error) {
+	s := senderutil.GetSenderFromContext(ctx)
+	clusterId := req.GetClusterId().GetValue()
+
+	if grep := req.GetGrep().GetValue(); grep != "" {
+		if _, err := regexp.Compile(grep); err != nil {
+			return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "grep", grep)
+		}
+	}
+
+	cluster, err := getCluster(clusterId, s.UserId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+	}
+
+	runtime, err := runtimeclient.NewRuntime(cluster.RuntimeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, cluster.RuntimeId)
+	}
+	vmBased, err := plugins.IsVmBased(runtime.Provider)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorProviderNotFound, runtime.Provider)
+	}
+	if !vmBased {
+		err = fmt.Errorf("node logs of provider [%s] are not supported", runtime.Provider)
+		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "cluster_id", clusterId)
+	}
+
+	clusterWrapper, err := getClusterWrapper(clusterId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+	}
+
+	pilotClient, err := pilotclient.NewClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	var sinceTime int64
+	if req.GetSinceTime() != nil {
+		sinceTime = pbutil.FromProtoTimestamp(req.GetSinceTime()).Unix()
+	}
+
+	type tailLogRequest struct {
+		log      *pb.ClusterNodeLog
+		endpoint *pbtypes.DroneEndpoint
+	}
+	var requests []tailLogRequest
+	for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		if len(req.GetNodeId()) > 0 && !reflectutil.In(clusterNode.NodeId, req.GetNodeId()) {
+			continue
+		}
+
+		clusterCommon, ok := clusterWrapper.ClusterCommons[clusterNode.Role]
+		if !ok || clusterCommon.Logs == "" {
+			continue
+		}
+
+		var logs []app.Log
+		if err := jsonutil.Decode([]byte(clusterCommon.Logs), &logs); err != nil {
+			logger.Error("Decode logs of cluster [%s] role [%s] failed: %+v", clusterId, clusterNode.Role, err)
+			return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+
+		for _, log := range logs {
+			if len(req.GetLogName()) > 0 && !reflectutil.In(log.Name, req.GetLogName()) {
+				continue
+			}
+
+			requests = append(requests, tailLogRequest{
+				log: &pb.ClusterNodeLog{
+					NodeId:  pbutil.ToProtoString(clusterNode.NodeId),
+					Role:    pbutil.ToProtoString(clusterNode.Role),
+					LogName: pbutil.ToProtoString(log.Name),
+					Path:    pbutil.ToProtoString(log.Path),
+				},
+				endpoint: &pbtypes.DroneEndpoint{
+					FrontgateId: cluster.FrontgateId,
+					DroneIp:     clusterNode.PrivateIp,
+					DronePort:   constants.DroneServicePort,
+				},
+			})
+		}
+	}
+
+	// tail the nodes concurrently, so an unreachable node only costs one timeout
+	var (
+		wg    sync.WaitGroup
+		limit = make(chan struct{}, constants.TailLogConcurrency)
+	)
+	for _, r := range requests {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(r tailLogRequest) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+
+			withTimeoutCtx, cancel := context.WithTimeout(ctx, constants.GrpcToPilotTimeout)
+			defer cancel()
+			content, err := pilotClient.TailLogOnDrone(withTimeoutCtx, &pbtypes.TailLogRequest{
+				Endpoint:  r.endpoint,
+				Path:      r.log.GetPath().GetValue(),
+				TailLines: toTailLogLines(req.GetTailLines()),
+				SinceTime: sinceTime,
+				Grep:      req.GetGrep().GetValue(),
+			})
+			if err != nil {
+				// one unreachable node should not fail the whole request
+				logger.Warn("Tail log [%s] of cluster node [%s] failed: %+v", r.log.GetPath().GetValue(), r.log.GetNodeId().GetValue(), err)
+				r.log.Error = pbutil.ToProtoString(err.Error())
+			} else {
+				r.log.Content = pbutil.ToProtoString(content.GetValue())
+			}
+		}(r)
+	}
+	wg.Wait()
+
+	var pbClusterNodeLogs []*pb.ClusterNodeLog
+	for _, r := range requests {
+		pbClusterNodeLogs = append(pbClusterNodeLogs, r.log)
+	}
+
+	sort.Slice(pbClusterNodeLogs, func(i, j int) bool {
+		if a, b := pbClusterNodeLogs[i].GetNodeId().GetValue(), pbClusterNodeLogs[j].GetNodeId().GetValue(); a != b {
+			return a < b
+		}
+		return pbClusterNodeLogs[i].GetLogName().GetValue() < pbClusterNodeLogs[j].GetLogName().GetValue()
+	})
+
+	return &pb.DescribeClusterNodeLogsResponse{
+		ClusterNodeLogSet: pbClusterNodeLogs,
+	}, nil
+}
+
 func (p *Server) StopClusters(ctx context.Context, req *pb.StopClustersRequest) (*pb.StopClustersResponse, error) {
 	s := senderutil.GetSenderFromContext(ctx)
 
@@ -0,0 +1,30 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+)
+
+func TestToTailLogLines(t *testing.T) {
+	for _, tt := range []struct {
+		n      uint32
+		expect int32
+	}{
+		{0, 0},
+		{10, 10},
+		{constants.MaxTailLogLines, constants.MaxTailLogLines},
+		{constants.MaxTailLogLines + 1, constants.MaxTailLogLines},
+		{math.MaxInt32 + 1, constants.MaxTailLogLines},
+		{math.MaxUint32, constants.MaxTailLogLines},
+	} {
+		if got := toTailLogLines(tt.n); got != tt.expect {
+			t.Fatalf("toTailLogLines(%d): expect %d, got %d", tt.n, tt.expect, got)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	_ "openpitrix.io/openpitrix/pkg/plugins/builtin"
 )
 
 type Server struct {
@@ -20,6 +21,7 @@ type Server struct {
 func Serve(cfg *config.Config) {
 	pi.SetGlobalPi(cfg)
 	s := Server{}
+	go s.snapshotRuntimeUsage()
 	manager.NewGrpcServer("cluster-manager", constants.ClusterManagerPort).
 		ShowErrorCause(cfg.Grpc.ShowErrorCause).
 		WithChecker(s.Checker).
@@ -0,0 +1,240 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/plugins"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+var releasedStatuses = []string{constants.StatusDeleted, constants.StatusCeased}
+
+// sumRuntimeUsage sums the resources of the nodes by the runtime and owner of
+// their clusters, the usages are sorted by runtime and owner.
+func sumRuntimeUsage(clusters []*models.Cluster, clusterNodes []*models.ClusterNode,
+	clusterRoles []*models.ClusterRole) []*models.RuntimeUsage {
+	type usageKey struct{ runtimeId, owner string }
+	usageMap := make(map[usageKey]*models.RuntimeUsage)
+	clusterUsages := make(map[string]*models.RuntimeUsage)
+	for _, cluster := range clusters {
+		key := usageKey{cluster.RuntimeId, cluster.Owner}
+		usage, ok := usageMap[key]
+		if !ok {
+			usage = &models.RuntimeUsage{RuntimeId: cluster.RuntimeId, Owner: cluster.Owner}
+			usageMap[key] = usage
+		}
+		usage.ClusterCount++
+		clusterUsages[cluster.ClusterId] = usage
+	}
+
+	roles := make(map[string]*models.ClusterRole)
+	for _, clusterRole := range clusterRoles {
+		roles[clusterRole.ClusterId+"/"+clusterRole.Role] = clusterRole
+	}
+	for _, clusterNode := range clusterNodes {
+		usage, ok := clusterUsages[clusterNode.ClusterId]
+		if !ok {
+			continue
+		}
+		usage.NodeCount++
+		role, ok := roles[clusterNode.ClusterId+"/"+clusterNode.Role]
+		if !ok {
+			continue
+		}
+		usage.Cpu += role.Cpu
+		usage.Gpu += role.Gpu
+		usage.Memory += role.Memory
+		usage.VolumeSize += role.StorageSize
+	}
+
+	var usages []*models.RuntimeUsage
+	for _, usage := range usageMap {
+		usages = append(usages, usage)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].RuntimeId != usages[j].RuntimeId {
+			return usages[i].RuntimeId < usages[j].RuntimeId
+		}
+		return usages[i].Owner < usages[j].Owner
+	})
+	return usages
+}
+
+func describeRuntimeUsage(runtimeIds, owners []string) ([]*models.RuntimeUsage, error) {
+	query := pi.Global().Db.
+		Select(models.ColumnClusterId, models.ColumnRuntimeId, models.ColumnOwner).
+		From(models.ClusterTableName).
+		Where(db.Neq(models.ColumnStatus, releasedStatuses))
+	if len(runtimeIds) > 0 {
+		query = query.Where(db.Eq(models.ColumnRuntimeId, runtimeIds))
+	}
+	if len(owners) > 0 {
+		query = query.Where(db.Eq(models.ColumnOwner, owners))
+	}
+	var clusters []*models.Cluster
+	_, err := query.Load(&clusters)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) == 0 {
+		return nil, nil
+	}
+	var clusterIds []string
+	for _, cluster := range clusters {
+		clusterIds = append(clusterIds, cluster.ClusterId)
+	}
+
+	var clusterNodes []*models.ClusterNode
+	_, err = pi.Global().Db.
+		Select(models.ColumnClusterId, models.ColumnRole).
+		From(models.ClusterNodeTableName).
+		Where(db.Eq(models.ColumnClusterId, clusterIds)).
+		Where(db.Neq(models.ColumnStatus, releasedStatuses)).
+		Load(&clusterNodes)
+	if err != nil {
+		return nil, err
+	}
+	var clusterRoles []*models.ClusterRole
+	_, err = pi.Global().Db.
+		Select(models.ClusterRoleColumns...).
+		From(models.ClusterRoleTableName).
+		Where(db.Eq(models.ColumnClusterId, clusterIds)).
+		Load(&clusterRoles)
+	if err != nil {
+		return nil, err
+	}
+	return sumRuntimeUsage(clusters, clusterNodes, clusterRoles), nil
+}
+
+func describeRuntimeQuota(runtimeId string) *pb.RuntimeQuota {
+	runtimeQuota := &pb.RuntimeQuota{RuntimeId: pbutil.ToProtoString(runtimeId)}
+	runtime, err := runtimeclient.NewRuntime(runtimeId)
+	if err != nil {
+		runtimeQuota.Error = pbutil.ToProtoString(err.Error())
+		return runtimeQuota
+	}
+	providerInterface, err := plugins.GetProviderPlugin(runtime.Provider, nil)
+	if err != nil {
+		runtimeQuota.Error = pbutil.ToProtoString(err.Error())
+		return runtimeQuota
+	}
+	quotaInterface, ok := providerInterface.(plugins.QuotaInterface)
+	if !ok {
+		runtimeQuota.Error = pbutil.ToProtoString(fmt.Sprintf("provider [%s] does not report quotas", runtime.Provider))
+		return runtimeQuota
+	}
+	quotas, err := quotaInterface.DescribeQuotaLeft(runtimeId)
+	if err != nil {
+		runtimeQuota.Error = pbutil.ToProtoString(err.Error())
+		return runtimeQuota
+	}
+	return models.QuotasToRuntimeQuotaPb(runtimeId, quotas)
+}
+
+func (p *Server) DescribeRuntimeUsage(ctx context.Context, req *pb.DescribeRuntimeUsageRequest) (*pb.DescribeRuntimeUsageResponse, error) {
+	usages, err := describeRuntimeUsage(req.GetRuntimeId(), req.GetOwner())
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	res := &pb.DescribeRuntimeUsageResponse{
+		UsageSet: models.RuntimeUsagesToPbs(usages),
+	}
+
+	if req.GetWithQuota().GetValue() {
+		runtimeIds := req.GetRuntimeId()
+		for _, usage := range usages {
+			runtimeIds = append(runtimeIds, usage.RuntimeId)
+		}
+		runtimeIds = stringutil.Unique(runtimeIds)
+		sort.Strings(runtimeIds)
+		for _, runtimeId := range runtimeIds {
+			res.QuotaSet = append(res.QuotaSet, describeRuntimeQuota(runtimeId))
+		}
+	}
+
+	if req.GetStartTime() != nil || req.GetEndTime() != nil {
+		query := pi.Global().Db.
+			Select(models.RuntimeUsageColumns...).
+			From(models.RuntimeUsageTableName).
+			OrderDir(models.ColumnCreateTime, true)
+		if len(req.GetRuntimeId()) > 0 {
+			query = query.Where(db.Eq(models.ColumnRuntimeId, req.GetRuntimeId()))
+		}
+		if len(req.GetOwner()) > 0 {
+			query = query.Where(db.Eq(models.ColumnOwner, req.GetOwner()))
+		}
+		if req.GetStartTime() != nil {
+			query = query.Where(db.Gte(models.ColumnCreateTime, pbutil.FromProtoTimestamp(req.GetStartTime())))
+		}
+		if req.GetEndTime() != nil {
+			query = query.Where(db.Lte(models.ColumnCreateTime, pbutil.FromProtoTimestamp(req.GetEndTime())))
+		}
+		var snapshots []*models.RuntimeUsage
+		_, err = query.Load(&snapshots)
+		if err != nil {
+			return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+		res.SnapshotSet = models.RuntimeUsagesToPbs(snapshots)
+	}
+	return res, nil
+}
+
+// snapshotRuntimeUsage saves the usage of the runtimes periodically, the
+// snapshot is skipped if another cluster manager has just taken it.
+func (p *Server) snapshotRuntimeUsage() {
+	for {
+		err := takeRuntimeUsageSnapshot()
+		if err != nil {
+			logger.Error("Failed to take snapshot of runtime usage: %+v", err)
+		}
+		time.Sleep(constants.RuntimeUsageSnapshotInterval)
+	}
+}
+
+func takeRuntimeUsageSnapshot() error {
+	now := time.Now()
+	count, err := pi.Global().Db.
+		Select(models.ColumnRuntimeId).
+		From(models.RuntimeUsageTableName).
+		Where(db.Gt(models.ColumnCreateTime, now.Add(-constants.RuntimeUsageSnapshotInterval/2))).
+		Count()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	usages, err := describeRuntimeUsage(nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(usages) == 0 {
+		return nil
+	}
+	insert := pi.Global().Db.
+		InsertInto(models.RuntimeUsageTableName).
+		Columns(models.RuntimeUsageColumns...)
+	for _, usage := range usages {
+		usage.CreateTime = now
+		insert = insert.Record(usage)
+	}
+	_, err = insert.Exec()
+	return err
+}
@@ -0,0 +1,48 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/models"
+)
+
+func TestSumRuntimeUsage(t *testing.T) {
+	clusters := []*models.Cluster{
+		{ClusterId: "cl-1", RuntimeId: "rt-2", Owner: "usr-1"},
+		{ClusterId: "cl-2", RuntimeId: "rt-1", Owner: "usr-1"},
+		{ClusterId: "cl-3", RuntimeId: "rt-1", Owner: "usr-1"},
+		{ClusterId: "cl-4", RuntimeId: "rt-1", Owner: "usr-2"},
+	}
+	clusterNodes := []*models.ClusterNode{
+		{ClusterId: "cl-1", Role: "master"},
+		{ClusterId: "cl-2", Role: "master"},
+		{ClusterId: "cl-2", Role: "worker"},
+		{ClusterId: "cl-2", Role: "worker"},
+		{ClusterId: "cl-3", Role: ""},
+		{ClusterId: "cl-5", Role: ""},
+	}
+	clusterRoles := []*models.ClusterRole{
+		{ClusterId: "cl-1", Role: "master", Cpu: 1, Memory: 1024, StorageSize: 10},
+		{ClusterId: "cl-2", Role: "master", Cpu: 2, Memory: 2048, StorageSize: 20},
+		{ClusterId: "cl-2", Role: "worker", Cpu: 4, Gpu: 1, Memory: 8192},
+		{ClusterId: "cl-3", Role: "", Cpu: 1, Memory: 1024, StorageSize: 5},
+	}
+
+	expected := []*models.RuntimeUsage{
+		{RuntimeId: "rt-1", Owner: "usr-1", ClusterCount: 2, NodeCount: 4, Cpu: 11, Gpu: 2, Memory: 19456, VolumeSize: 25},
+		{RuntimeId: "rt-1", Owner: "usr-2", ClusterCount: 1},
+		{RuntimeId: "rt-2", Owner: "usr-1", ClusterCount: 1, NodeCount: 1, Cpu: 1, Memory: 1024, VolumeSize: 10},
+	}
+	usages := sumRuntimeUsage(clusters, clusterNodes, clusterRoles)
+	if !reflect.DeepEqual(usages, expected) {
+		for _, usage := range usages {
+			t.Logf("%+v", usage)
+		}
+		t.Fatal("runtime usages not matched")
+	}
+}
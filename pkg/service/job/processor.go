@@ -18,7 +18,6 @@ import (
 	"openpitrix.io/openpitrix/pkg/plugins"
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
-	"openpitrix.io/openpitrix/pkg/util/reflectutil"
 )
 
 type Processor struct {
@@ -161,7 +160,11 @@ func (p *Processor) Post() error {
 		if err != nil {
 			return err
 		}
-		if !reflectutil.In(p.Job.Provider, constants.VmBaseProviders) {
+		vmBased, err := plugins.IsVmBased(p.Job.Provider)
+		if err != nil {
+			return err
+		}
+		if !vmBased {
 			return nil
 		}
 		clusterWrapper := clusterWrappers[0]
@@ -205,7 +208,11 @@ func (p *Processor) Post() error {
 		if err != nil {
 			return err
 		}
-		if !reflectutil.In(p.Job.Provider, constants.VmBaseProviders) {
+		vmBased, err := plugins.IsVmBased(p.Job.Provider)
+		if err != nil {
+			return err
+		}
+		if !vmBased {
 			return nil
 		}
 		clusterWrappers, err := clusterClient.GetClusterWrappers(ctx, []string{p.Job.ClusterId})
@@ -15,6 +15,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	_ "openpitrix.io/openpitrix/pkg/plugins/builtin"
 )
 
 type Server struct {
@@ -0,0 +1,63 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package drone
+
+import (
+	"context"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/service/metadata/upgrader"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+	"openpitrix.io/openpitrix/pkg/version"
+)
+
+func (p *Server) GetDroneVersion(ctx context.Context, arg *pbtypes.Empty) (*pbtypes.AgentInfo, error) {
+	logger.Info(funcutil.CallerName(1))
+
+	// pinged by pilot, the upgraded binary works
+	upgrader.Confirm()
+
+	return p.agentInfo(), nil
+}
+
+func (p *Server) UpgradeDrone(ctx context.Context, arg *pbtypes.UpgradeAgentRequest) (*pbtypes.Empty, error) {
+	logger.Info(funcutil.CallerName(1))
+
+	timeout := constants.DefaultAgentRollbackTimeout
+	if x := arg.GetRollbackTimeoutSeconds(); x > 0 {
+		timeout = time.Duration(x) * time.Second
+	}
+
+	if err := upgrader.Upgrade(arg.GetBinaryPath(), arg.GetSha256(), timeout); err != nil {
+		logger.Warn("%+v", err)
+		return nil, err
+	}
+
+	return &pbtypes.Empty{}, nil
+}
+
+func (p *Server) agentInfo() *pbtypes.AgentInfo {
+	return &pbtypes.AgentInfo{
+		AgentType:  constants.AgentTypeDrone,
+		DroneIp:    p.cfg.Get().GetHost(),
+		Version:    version.GetVersionString(),
+		StartTime:  upgrader.StartTime().Unix(),
+		ReportTime: time.Now().Unix(),
+	}
+}
+
+// reportAgentInfo reports the drone version to frontgate periodically,
+// frontgate passes it to pilot in heartbeat.
+func (p *Server) reportAgentInfo() {
+	for {
+		if err := p.fg.ReportDroneInfo(p.agentInfo()); err != nil {
+			logger.Warn("Report drone info failed: %+v", err)
+		}
+		time.Sleep(constants.AgentReportInterval)
+	}
+}
@@ -70,8 +70,15 @@ func (p *ConfigManager) Set(cfg *pbtypes.DroneConfig) error {
 		return err
 	}
 
+	if cfg.UploadRoot != "" && cfg.UploadRoot != p.cfg.UploadRoot {
+		err := fmt.Errorf("drone: config.UploadRoot is read only")
+		logger.Warn("%+v", err)
+		return err
+	}
+
 	cfg.Id = p.cfg.Id
 	cfg.ListenPort = p.cfg.ListenPort
+	cfg.UploadRoot = p.cfg.UploadRoot
 
 	p.cfg = proto.Clone(cfg).(*pbtypes.DroneConfig)
 	return nil
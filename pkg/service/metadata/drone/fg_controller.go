@@ -76,6 +76,30 @@ func (p *FrontgateController) ReportSubTaskStatus(in *pbtypes.SubTaskStatus) err
 	return nil
 }
 
+// ReportDroneInfo does nothing before the frontgate config is set by pilot.
+func (p *FrontgateController) ReportDroneInfo(in *pbtypes.AgentInfo) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cfg == nil {
+		return nil
+	}
+
+	client, err := p.getClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ReportDroneInfo(in)
+	if err != nil {
+		// reconnect next time
+		p.closeAllClient()
+		return err
+	}
+
+	return nil
+}
+
 func (p *FrontgateController) getClient() (
 	*pbfrontgate.FrontgateServiceClient,
 	error,
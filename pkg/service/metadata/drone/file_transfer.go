@@ -0,0 +1,324 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package drone
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/drone"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+)
+
+const defaultFileMode = 0644
+
+func (p *Server) UploadFile(stream pbdrone.DroneService_UploadFileServer) error {
+	logger.Info(funcutil.CallerName(1))
+
+	first, err := stream.Recv()
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	if err := checkUploadFileRequest(first); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	path := filepath.Clean(first.GetPath())
+	root := p.getUploadRoot()
+	if isSubPath(constants.AgentUpgradeStagingDir, path) {
+		// the new binary of agent upgrade is staged by pilot
+		root = constants.AgentUpgradeStagingDir
+	}
+	if !isSubPath(root, path) {
+		err = fmt.Errorf("drone: path %q is outside of upload root %q", first.GetPath(), root)
+		logger.Warn("%+v", err)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	if err := checkRealPath(root, filepath.Dir(path)); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	// write to a temp file in the same dir, so the rename is atomic
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".upload-")
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	tmpname := f.Name()
+	defer os.Remove(tmpname)
+
+	var (
+		h       = sha256.New()
+		w       = io.MultiWriter(f, h)
+		written int64
+	)
+	for msg := first; ; {
+		if msg.GetOffset() != written {
+			f.Close()
+			err = fmt.Errorf("drone: invalid chunk offset %d, expect %d", msg.GetOffset(), written)
+			logger.Warn("%+v", err)
+			return err
+		}
+		if written+int64(len(msg.GetData())) > first.GetSize() {
+			f.Close()
+			err = fmt.Errorf("drone: file %q larger than %d bytes", path, first.GetSize())
+			logger.Warn("%+v", err)
+			return err
+		}
+		if _, err = w.Write(msg.GetData()); err != nil {
+			f.Close()
+			logger.Warn("%+v", err)
+			return err
+		}
+		written += int64(len(msg.GetData()))
+
+		msg, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			f.Close()
+			logger.Warn("%+v", err)
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	if written != first.GetSize() {
+		err = fmt.Errorf("drone: file %q size mismatch, got %d, expect %d", path, written, first.GetSize())
+		logger.Warn("%+v", err)
+		return err
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(sum, first.GetSha256()) {
+		err = fmt.Errorf("drone: file %q checksum mismatch, got %s, expect %s", path, sum, first.GetSha256())
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	mode := os.FileMode(defaultFileMode)
+	if first.GetMode() != 0 {
+		mode = os.FileMode(first.GetMode()) & os.ModePerm
+	}
+	if err := os.Chmod(tmpname, mode); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	if owner := first.GetOwner(); owner != "" {
+		uid, gid, err := lookupFileOwner(owner)
+		if err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+		if err := os.Chown(tmpname, uid, gid); err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+	}
+
+	if err := os.Rename(tmpname, path); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	logger.Info("Upload file [%s] done, size = %d", path, written)
+	return stream.SendAndClose(&pbtypes.Empty{})
+}
+
+func (p *Server) DownloadFile(arg *pbtypes.DownloadFileRequest, stream pbdrone.DroneService_DownloadFileServer) error {
+	logger.Info(funcutil.CallerName(1))
+
+	path := filepath.Clean(arg.GetPath())
+	if !filepath.IsAbs(path) {
+		err := fmt.Errorf("drone: path %q is not absolute", arg.GetPath())
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	if !fi.Mode().IsRegular() {
+		err = fmt.Errorf("drone: %q is not a regular file", path)
+		logger.Warn("%+v", err)
+		return err
+	}
+	if fi.Size() > constants.MaxFileTransferSize {
+		err = fmt.Errorf("drone: file %q larger than %d bytes", path, constants.MaxFileTransferSize)
+		logger.Warn("%+v", err)
+		return err
+	}
+	if arg.GetOffset() < 0 || arg.GetOffset() > fi.Size() {
+		err = fmt.Errorf("drone: invalid offset %d, file size is %d", arg.GetOffset(), fi.Size())
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	// the checksum of the whole file is sent with the first chunk
+	var sum string
+	if arg.GetOffset() == 0 {
+		h := sha256.New()
+		if _, err := io.Copy(h, f); err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+		sum = hex.EncodeToString(h.Sum(nil))
+	}
+	if _, err := f.Seek(arg.GetOffset(), io.SeekStart); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	var (
+		offset = arg.GetOffset()
+		end    = fi.Size()
+		buf    = make([]byte, constants.FileTransferChunkSize)
+	)
+	if n := arg.GetLength(); n > 0 && offset+n < end {
+		end = offset + n
+	}
+
+	for {
+		n := int64(len(buf))
+		if end-offset < n {
+			n = end - offset
+		}
+		if _, err := io.ReadFull(f, buf[:n]); err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+
+		err := stream.Send(&pbtypes.FileChunk{
+			Path:   path,
+			Size:   fi.Size(),
+			Sha256: sum,
+			Mode:   int32(fi.Mode().Perm()),
+			Offset: offset,
+			Data:   buf[:n],
+		})
+		if err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+
+		sum = ""
+		offset += n
+		if offset >= end {
+			return nil
+		}
+	}
+}
+
+func checkUploadFileRequest(arg *pbtypes.UploadFileRequest) error {
+	if !filepath.IsAbs(arg.GetPath()) {
+		return fmt.Errorf("drone: path %q is not absolute", arg.GetPath())
+	}
+	if arg.GetSize() < 0 || arg.GetSize() > constants.MaxFileTransferSize {
+		return fmt.Errorf("drone: invalid file size %d, limit is %d", arg.GetSize(), constants.MaxFileTransferSize)
+	}
+	if arg.GetSha256() == "" {
+		return fmt.Errorf("drone: sha256 of file %q is empty", arg.GetPath())
+	}
+	return nil
+}
+
+func (p *Server) getUploadRoot() string {
+	root := p.cfg.Get().GetUploadRoot()
+	if root == "" {
+		root = DefaultUploadRoot
+	}
+	return filepath.Clean(root)
+}
+
+// isSubPath reports whether the clean path is under the dir.
+func isSubPath(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// checkRealPath checks the dir is still under the root after the symlinks
+// are resolved, so a link can not redirect the upload to other places.
+func checkRealPath(root, dir string) error {
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return err
+	}
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	if realDir != realRoot && !isSubPath(realRoot, realDir) {
+		return fmt.Errorf("drone: dir %q is outside of upload root %q", dir, root)
+	}
+	return nil
+}
+
+// lookupFileOwner parses owner likes "user" or "user:group",
+// numeric uid/gid is also accepted.
+func lookupFileOwner(owner string) (uid, gid int, err error) {
+	var userName, groupName = owner, ""
+	if idx := strings.Index(owner, ":"); idx >= 0 {
+		userName, groupName = owner[:idx], owner[idx+1:]
+	}
+
+	u, err := user.Lookup(userName)
+	if err != nil {
+		if u, err = user.LookupId(userName); err != nil {
+			return 0, 0, err
+		}
+	}
+	if uid, err = strconv.Atoi(u.Uid); err != nil {
+		return 0, 0, err
+	}
+	if gid, err = strconv.Atoi(u.Gid); err != nil {
+		return 0, 0, err
+	}
+
+	if groupName != "" {
+		g, err := user.LookupGroup(groupName)
+		if err != nil {
+			if g, err = user.LookupGroupId(groupName); err != nil {
+				return 0, 0, err
+			}
+		}
+		if gid, err = strconv.Atoi(g.Gid); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return uid, gid, nil
+}
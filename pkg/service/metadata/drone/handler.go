@@ -21,6 +21,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/pb/metadata/drone"
 	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
 	"openpitrix.io/openpitrix/pkg/service/metadata/drone/yunify_confdfunc"
+	"openpitrix.io/openpitrix/pkg/service/metadata/upgrader"
 	"openpitrix.io/openpitrix/pkg/util/funcutil"
 )
 
@@ -311,6 +312,7 @@ func (p *Server) PingFrontgate(ctx context.Context, arg *pbtypes.FrontgateEndpoi
 
 func (p *Server) PingDrone(ctx context.Context, arg *pbtypes.Empty) (*pbtypes.Empty, error) {
 	logger.Info(funcutil.CallerName(1))
+	upgrader.Confirm()
 	return &pbtypes.Empty{}, nil
 }
 
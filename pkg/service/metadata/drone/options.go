@@ -15,6 +15,8 @@ import (
 
 type Options func(opt *pbtypes.DroneConfig)
 
+const DefaultUploadRoot = "/opt/openpitrix/upload"
+
 func NewDefaultConfigString() string {
 	p := &pbtypes.DroneConfig{
 		Id:             "drone-001",
@@ -23,6 +25,7 @@ func NewDefaultConfigString() string {
 		CmdInfoLogPath: "/opt/openpitrix/log/cmd.log",
 		ConfdSelfHost:  "127.0.0.1",
 		LogLevel:       logger.DebugLevel.String(),
+		UploadRoot:     DefaultUploadRoot,
 	}
 
 	data, err := json.MarshalIndent(p, "", "\t")
@@ -61,3 +64,9 @@ func WithCmdInfoLogPath(path string) func(opt *pbtypes.DroneConfig) {
 		opt.CmdInfoLogPath = path
 	}
 }
+
+func WithUploadRoot(dir string) func(opt *pbtypes.DroneConfig) {
+	return func(opt *pbtypes.DroneConfig) {
+		opt.UploadRoot = dir
+	}
+}
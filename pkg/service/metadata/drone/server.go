@@ -9,6 +9,7 @@ import (
 
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb/metadata/drone"
+	"openpitrix.io/openpitrix/pkg/service/metadata/upgrader"
 )
 
 type Server struct {
@@ -28,7 +29,10 @@ func NewServer(cfg *ConfigManager, confd *ConfdServer) *Server {
 }
 
 func Serve(cfg *ConfigManager, confd *ConfdServer) {
+	upgrader.Check()
+
 	s := NewServer(cfg, confd)
+	go s.reportAgentInfo()
 
 	manager.NewGrpcServer("drone-service", int(s.cfg.Get().ListenPort)).Serve(func(server *grpc.Server) {
 		pbdrone.RegisterDroneServiceServer(server, s)
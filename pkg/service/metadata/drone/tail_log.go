@@ -0,0 +1,185 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package drone
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+)
+
+const tailLogBlockSize = 64 * 1024
+
+// layouts of the leading timestamp of a log line, used by since_time
+var logTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006/01/02 15:04:05.999999999",
+}
+
+func (p *Server) TailLog(ctx context.Context, arg *pbtypes.TailLogRequest) (*pbtypes.String, error) {
+	logger.Info(funcutil.CallerName(1))
+
+	path := filepath.Clean(arg.GetPath())
+	if !filepath.IsAbs(path) {
+		err := fmt.Errorf("drone: path %q is not absolute", arg.GetPath())
+		logger.Warn("%+v", err)
+		return nil, err
+	}
+
+	var re *regexp.Regexp
+	if arg.GetGrep() != "" {
+		var err error
+		if re, err = regexp.Compile(arg.GetGrep()); err != nil {
+			logger.Warn("%+v", err)
+			return nil, err
+		}
+	}
+
+	n := int(arg.GetTailLines())
+	if n <= 0 {
+		n = constants.DefaultTailLogLines
+	}
+	if n > constants.MaxTailLogLines {
+		n = constants.MaxTailLogLines
+	}
+
+	var since time.Time
+	if arg.GetSinceTime() > 0 {
+		since = time.Unix(arg.GetSinceTime(), 0)
+	}
+
+	lines, err := tailLogFile(path, n, re, since)
+	if err != nil {
+		logger.Warn("%+v", err)
+		return nil, err
+	}
+
+	return &pbtypes.String{Value: strings.Join(lines, "\n")}, nil
+}
+
+// tailLogFile reads the file backward block by block, until n lines are
+// matched, a line older than since is met or MaxTailLogScanSize is read.
+func tailLogFile(path string, n int, re *regexp.Regexp, since time.Time) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !fi.Mode().IsRegular() {
+		return nil, fmt.Errorf("drone: %q is not a regular file", path)
+	}
+
+	var (
+		lines   []string // in reverse order
+		pos     = fi.Size()
+		rest    []byte // incomplete line at the head of the last block
+		scanned int64
+		buf     = make([]byte, tailLogBlockSize)
+	)
+
+	// the newline at the end of file does not start a new line
+	trimEOL := true
+
+	for pos > 0 && scanned < constants.MaxTailLogScanSize {
+		size := int64(len(buf))
+		if pos < size {
+			size = pos
+		}
+		pos -= size
+		scanned += size
+
+		if _, err := f.ReadAt(buf[:size], pos); err != nil {
+			return nil, err
+		}
+
+		data := append(append([]byte{}, buf[:size]...), rest...)
+		if trimEOL {
+			data = bytes.TrimSuffix(data, []byte("\n"))
+			trimEOL = false
+		}
+		if len(data) == 0 {
+			rest = nil
+			continue
+		}
+
+		parts := bytes.Split(data, []byte("\n"))
+		rest = nil
+		if pos > 0 {
+			rest, parts = parts[0], parts[1:]
+		}
+
+		for i := len(parts) - 1; i >= 0; i-- {
+			line := bytes.TrimSuffix(parts[i], []byte("\r"))
+
+			if !since.IsZero() {
+				if t, ok := parseLogTime(line); ok && t.Before(since) {
+					return reverseLines(lines), nil
+				}
+			}
+			if re != nil && !re.Match(line) {
+				continue
+			}
+
+			lines = append(lines, string(line))
+			if len(lines) >= n {
+				return reverseLines(lines), nil
+			}
+		}
+	}
+
+	return reverseLines(lines), nil
+}
+
+func parseLogTime(line []byte) (time.Time, bool) {
+	fields := strings.Fields(string(line[:minInt(len(line), 64)]))
+	if len(fields) == 0 {
+		return time.Time{}, false
+	}
+
+	candidates := []string{fields[0]}
+	if len(fields) > 1 {
+		candidates = append(candidates, fields[0]+" "+fields[1])
+	}
+	for _, s := range candidates {
+		s = strings.Trim(s, "[]")
+		for _, layout := range logTimeLayouts {
+			if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func reverseLines(lines []string) []string {
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return lines
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,188 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package drone
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func tWriteLogFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestTailLogFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "drone-tail-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	timed := strings.Join([]string{
+		"2018-06-01 10:00:00 first",
+		"2018-06-01 10:00:01 second",
+		"    at stack frame",
+		"2018-06-01 10:00:02 third",
+	}, "\n") + "\n"
+
+	// a file spanning several blocks, so lines are split across reads
+	var long []string
+	for i := 0; len(strings.Join(long, "\n")) < 3*tailLogBlockSize; i++ {
+		long = append(long, fmt.Sprintf("line %06d %s", i, strings.Repeat("x", 100)))
+	}
+
+	since := time.Date(2018, 6, 1, 10, 0, 1, 0, time.Local)
+
+	for _, tc := range []struct {
+		name    string
+		content string
+		n       int
+		grep    string
+		since   time.Time
+		expect  []string
+	}{
+		{
+			name:    "empty",
+			content: "",
+			n:       10,
+			expect:  nil,
+		},
+		{
+			name:    "trailing newline",
+			content: "a\nb\nc\n",
+			n:       2,
+			expect:  []string{"b", "c"},
+		},
+		{
+			name:    "no trailing newline",
+			content: "a\nb\nc",
+			n:       10,
+			expect:  []string{"a", "b", "c"},
+		},
+		{
+			name:    "crlf",
+			content: "a\r\nb\r\nc\r\n",
+			n:       10,
+			expect:  []string{"a", "b", "c"},
+		},
+		{
+			name:    "empty lines are kept",
+			content: "a\n\nb\n",
+			n:       10,
+			expect:  []string{"a", "", "b"},
+		},
+		{
+			name:    "grep",
+			content: "error 1\ninfo 2\nerror 3\ninfo 4\n",
+			n:       10,
+			grep:    "^error",
+			expect:  []string{"error 1", "error 3"},
+		},
+		{
+			name:    "grep counts matched lines only",
+			content: "error 1\ninfo 2\nerror 3\ninfo 4\n",
+			n:       1,
+			grep:    "^error",
+			expect:  []string{"error 3"},
+		},
+		{
+			name:    "since stops at older line",
+			content: timed,
+			n:       10,
+			since:   since,
+			expect: []string{
+				"2018-06-01 10:00:01 second",
+				"    at stack frame",
+				"2018-06-01 10:00:02 third",
+			},
+		},
+		{
+			name:    "multiple blocks",
+			content: strings.Join(long, "\n") + "\n",
+			n:       3,
+			expect:  long[len(long)-3:],
+		},
+		{
+			name:    "multiple blocks with grep",
+			content: strings.Join(long, "\n") + "\n",
+			n:       10,
+			grep:    "^line 00000[02] ",
+			expect:  []string{long[0], long[2]},
+		},
+	} {
+		path := tWriteLogFile(t, dir, strings.Replace(tc.name, " ", "_", -1), tc.content)
+
+		var re *regexp.Regexp
+		if tc.grep != "" {
+			re = regexp.MustCompile(tc.grep)
+		}
+
+		lines, err := tailLogFile(path, tc.n, re, tc.since)
+		if err != nil {
+			t.Fatalf("%s: %+v", tc.name, err)
+		}
+		if len(lines) == 0 && len(tc.expect) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(lines, tc.expect) {
+			t.Fatalf("%s: expect %q, got %q", tc.name, tc.expect, lines)
+		}
+	}
+}
+
+func TestTailLogFileNotRegular(t *testing.T) {
+	dir, err := ioutil.TempDir("", "drone-tail-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := tailLogFile(dir, 10, nil, time.Time{}); err == nil {
+		t.Fatalf("expect error when tailing directory [%s]", dir)
+	}
+	if _, err := tailLogFile(filepath.Join(dir, "missing"), 10, nil, time.Time{}); err == nil {
+		t.Fatalf("expect error when tailing missing file")
+	}
+}
+
+func TestParseLogTime(t *testing.T) {
+	expect := time.Date(2018, 6, 1, 10, 0, 1, 0, time.Local)
+
+	for _, tc := range []struct {
+		line   string
+		ok     bool
+		expect time.Time
+	}{
+		{line: "2018-06-01 10:00:01 started", ok: true, expect: expect},
+		{line: "2018-06-01 10:00:01.5 started", ok: true, expect: expect.Add(500 * time.Millisecond)},
+		{line: "2018-06-01T10:00:01 started", ok: true, expect: expect},
+		{line: "2018/06/01 10:00:01 started", ok: true, expect: expect},
+		{line: "[2018-06-01 10:00:01] started", ok: true, expect: expect},
+		{line: "2018-06-01T10:00:01Z started", ok: true, expect: time.Date(2018, 6, 1, 10, 0, 1, 0, time.UTC)},
+		{line: "2018-06-01T10:00:01+08:00 started", ok: true, expect: time.Date(2018, 6, 1, 2, 0, 1, 0, time.UTC)},
+		{line: "", ok: false},
+		{line: "    at stack frame", ok: false},
+		{line: "INFO 2018-06-01 10:00:01 started", ok: false},
+	} {
+		got, ok := parseLogTime([]byte(tc.line))
+		if ok != tc.ok {
+			t.Fatalf("%q: expect ok [%t], got [%t]", tc.line, tc.ok, ok)
+		}
+		if ok && !got.Equal(tc.expect) {
+			t.Fatalf("%q: expect [%s], got [%s]", tc.line, tc.expect, got)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package frontgate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/service/metadata/drone/droneutil"
+	"openpitrix.io/openpitrix/pkg/service/metadata/upgrader"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+	"openpitrix.io/openpitrix/pkg/version"
+)
+
+// DroneInfoManager keeps the drone info reported in the last AgentInfoTTL.
+type DroneInfoManager struct {
+	infos map[string]*pbtypes.AgentInfo // drone ip => info
+	mu    sync.Mutex
+}
+
+func NewDroneInfoManager() *DroneInfoManager {
+	return &DroneInfoManager{
+		infos: make(map[string]*pbtypes.AgentInfo),
+	}
+}
+
+func (p *DroneInfoManager) Put(info *pbtypes.AgentInfo) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.infos[info.DroneIp] = proto.Clone(info).(*pbtypes.AgentInfo)
+}
+
+func (p *DroneInfoManager) List() []*pbtypes.AgentInfo {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var (
+		infos  []*pbtypes.AgentInfo
+		expire = time.Now().Add(-constants.AgentInfoTTL).Unix()
+	)
+	for ip, info := range p.infos {
+		if info.ReportTime < expire {
+			delete(p.infos, ip)
+			continue
+		}
+		infos = append(infos, proto.Clone(info).(*pbtypes.AgentInfo))
+	}
+	return infos
+}
+
+func (p *Server) HeartBeat(in *pbtypes.Empty, out *pbtypes.AgentInfoList) error {
+	// pinged by pilot, the upgraded binary works
+	upgrader.Confirm()
+
+	cfg := p.cfg.Get()
+	out.AgentList = append([]*pbtypes.AgentInfo{{
+		AgentType:       constants.AgentTypeFrontgate,
+		FrontgateId:     cfg.GetId(),
+		FrontgateNodeId: cfg.GetNodeId(),
+		Version:         version.GetVersionString(),
+		StartTime:       upgrader.StartTime().Unix(),
+		ReportTime:      time.Now().Unix(),
+	}}, p.drones.List()...)
+	return nil
+}
+
+func (p *Server) ReportDroneInfo(in *pbtypes.AgentInfo, out *pbtypes.Empty) error {
+	logger.Debug(funcutil.CallerName(1))
+
+	info := proto.Clone(in).(*pbtypes.AgentInfo)
+	info.AgentType = constants.AgentTypeDrone
+	info.FrontgateId = p.cfg.Get().GetId()
+	info.ReportTime = time.Now().Unix()
+
+	p.drones.Put(info)
+	return nil
+}
+
+func (p *Server) GetDroneVersion(in *pbtypes.DroneEndpoint, out *pbtypes.AgentInfo) error {
+	logger.Info(funcutil.CallerName(1))
+
+	ctx := context.Background()
+
+	client, conn, err := droneutil.DialDroneService(ctx, in.GetDroneIp(), int(in.GetDronePort()))
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	defer conn.Close()
+
+	info, err := client.GetDroneVersion(ctx, &pbtypes.Empty{})
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	info.FrontgateId = p.cfg.Get().GetId()
+	p.drones.Put(info)
+
+	*out = *info
+	return nil
+}
+
+func (p *Server) UpgradeDrone(in *pbtypes.UpgradeDroneRequest, out *pbtypes.Empty) error {
+	logger.Info(funcutil.CallerName(1))
+
+	ctx := context.Background()
+
+	client, conn, err := droneutil.DialDroneService(ctx,
+		in.GetEndpoint().GetDroneIp(),
+		int(in.GetEndpoint().GetDronePort()),
+	)
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.UpgradeDrone(ctx, in.GetUpgrade())
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *Server) UpgradeFrontgate(in *pbtypes.UpgradeAgentRequest, out *pbtypes.Empty) error {
+	logger.Info(funcutil.CallerName(1))
+
+	timeout := constants.DefaultAgentRollbackTimeout
+	if x := in.GetRollbackTimeoutSeconds(); x > 0 {
+		timeout = time.Duration(x) * time.Second
+	}
+
+	if err := upgrader.Upgrade(in.GetBinaryPath(), in.GetSha256(), timeout); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	return nil
+}
+
+// UploadFile writes the chunk to <path>.upload-<upload_id>, the file is
+// verified and renamed to path when the last chunk arrives.
+func (p *Server) UploadFile(in *pbtypes.UploadFileRequest, out *pbtypes.Empty) error {
+	logger.Debug(funcutil.CallerName(1))
+
+	if !filepath.IsAbs(in.GetPath()) {
+		err := fmt.Errorf("frontgate: path %q is not absolute", in.GetPath())
+		logger.Warn("%+v", err)
+		return err
+	}
+	if in.GetUploadId() == "" {
+		err := fmt.Errorf("frontgate: upload id is empty")
+		logger.Warn("%+v", err)
+		return err
+	}
+	if in.GetSize() < 0 || in.GetSize() > constants.MaxFileTransferSize {
+		err := fmt.Errorf("frontgate: invalid file size %d, limit is %d", in.GetSize(), constants.MaxFileTransferSize)
+		logger.Warn("%+v", err)
+		return err
+	}
+	if in.GetOffset()+int64(len(in.GetData())) > in.GetSize() {
+		err := fmt.Errorf("frontgate: file %q larger than %d bytes", in.GetPath(), in.GetSize())
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	path := filepath.Clean(in.GetPath())
+	tmpname := path + ".upload-" + in.GetUploadId()
+
+	if in.GetOffset() == 0 {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+	}
+
+	if err := appendFileChunk(tmpname, in.GetOffset(), in.GetData()); err != nil {
+		os.Remove(tmpname)
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	if in.GetOffset()+int64(len(in.GetData())) < in.GetSize() {
+		return nil
+	}
+
+	defer os.Remove(tmpname)
+
+	if err := checkFileSha256(tmpname, in.GetSha256()); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	mode := os.FileMode(0644)
+	if in.GetMode() != 0 {
+		mode = os.FileMode(in.GetMode()) & os.ModePerm
+	}
+	if err := os.Chmod(tmpname, mode); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	if err := os.Rename(tmpname, path); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	return nil
+}
+
+func appendFileChunk(name string, offset int64, data []byte) error {
+	flag := os.O_WRONLY | os.O_APPEND
+	if offset == 0 {
+		flag = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+
+	f, err := os.OpenFile(name, flag, 0600)
+	if err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	if fi.Size() != offset {
+		f.Close()
+		return fmt.Errorf("frontgate: invalid chunk offset %d, expect %d", offset, fi.Size())
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func checkFileSha256(name, sum string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(got, sum) {
+		return fmt.Errorf("frontgate: file checksum mismatch, got %s, expect %s", got, sum)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package frontgate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/drone"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/service/metadata/drone/droneutil"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+)
+
+// net/rpc has no stream, every chunk of an upload or a download is a single
+// call, the drone stream is kept in the session until the last chunk.
+type fileTransferSession struct {
+	upload     pbdrone.DroneService_UploadFileClient
+	download   pbdrone.DroneService_DownloadFileClient
+	conn       *grpc.ClientConn
+	cancel     context.CancelFunc
+	size       int64 // upload: size of the file, download: end of the range
+	offset     int64
+	lastActive time.Time
+}
+
+func (p *fileTransferSession) Close() {
+	p.cancel()
+	p.conn.Close()
+}
+
+type FileTransferSessionManager struct {
+	sessions map[string]*fileTransferSession
+	mu       sync.Mutex
+}
+
+func NewFileTransferSessionManager() *FileTransferSessionManager {
+	return &FileTransferSessionManager{
+		sessions: make(map[string]*fileTransferSession),
+	}
+}
+
+func (p *FileTransferSessionManager) Get(id string) (*fileTransferSession, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s, ok := p.sessions[id]
+	if ok {
+		s.lastActive = time.Now()
+	}
+	return s, ok
+}
+
+func (p *FileTransferSessionManager) Put(id string, s *fileTransferSession) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if old, ok := p.sessions[id]; ok && old != s {
+		old.Close()
+	}
+	s.lastActive = time.Now()
+	p.sessions[id] = s
+}
+
+func (p *FileTransferSessionManager) Remove(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if s, ok := p.sessions[id]; ok {
+		s.Close()
+		delete(p.sessions, id)
+	}
+}
+
+// ClearExpired closes the sessions without any chunk in timeout.
+func (p *FileTransferSessionManager) ClearExpired(timeout time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id, s := range p.sessions {
+		if time.Since(s.lastActive) > timeout {
+			logger.Warn("File transfer session [%s] expired", id)
+			s.Close()
+			delete(p.sessions, id)
+		}
+	}
+}
+
+func (p *Server) UploadFileToDrone(in *pbtypes.UploadFileRequest, out *pbtypes.Empty) error {
+	logger.Debug(funcutil.CallerName(1))
+
+	p.transfers.ClearExpired(constants.FileTransferSessionTimeout)
+
+	if in.GetUploadId() == "" {
+		err := fmt.Errorf("frontgate: upload id is empty")
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	s, ok := p.transfers.Get(in.GetUploadId())
+	if ok && s.upload == nil {
+		err := fmt.Errorf("frontgate: session [%s] is not an upload", in.GetUploadId())
+		logger.Warn("%+v", err)
+		return err
+	}
+	if !ok {
+		if in.GetOffset() != 0 {
+			err := fmt.Errorf("frontgate: upload session [%s] not found", in.GetUploadId())
+			logger.Warn("%+v", err)
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.FileTransferSessionTimeout)
+		client, conn, err := droneutil.DialDroneService(ctx,
+			in.GetEndpoint().GetDroneIp(),
+			int(in.GetEndpoint().GetDronePort()),
+		)
+		if err != nil {
+			cancel()
+			logger.Warn("%+v", err)
+			return err
+		}
+		stream, err := client.UploadFile(ctx)
+		if err != nil {
+			cancel()
+			conn.Close()
+			logger.Warn("%+v", err)
+			return err
+		}
+
+		s = &fileTransferSession{
+			upload: stream,
+			conn:   conn,
+			cancel: cancel,
+			size:   in.GetSize(),
+		}
+		p.transfers.Put(in.GetUploadId(), s)
+	}
+
+	if err := s.upload.Send(in); err != nil {
+		if err == io.EOF {
+			// the real error is returned by CloseAndRecv
+			_, err = s.upload.CloseAndRecv()
+		}
+		p.transfers.Remove(in.GetUploadId())
+		logger.Warn("%+v", err)
+		return err
+	}
+	s.offset += int64(len(in.GetData()))
+
+	if s.offset < s.size {
+		return nil
+	}
+
+	defer p.transfers.Remove(in.GetUploadId())
+
+	if _, err := s.upload.CloseAndRecv(); err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	return nil
+}
+
+func (p *Server) DownloadFileFromDrone(in *pbtypes.DownloadFileRequest, out *pbtypes.FileChunk) error {
+	logger.Debug(funcutil.CallerName(1))
+
+	p.transfers.ClearExpired(constants.FileTransferSessionTimeout)
+
+	if in.GetDownloadId() == "" {
+		err := fmt.Errorf("frontgate: download id is empty")
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	s, ok := p.transfers.Get(in.GetDownloadId())
+	if ok && (s.download == nil || s.offset != in.GetOffset()) {
+		// not the next chunk of the stream, start a new one
+		p.transfers.Remove(in.GetDownloadId())
+		ok = false
+	}
+	if !ok {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.FileTransferSessionTimeout)
+		client, conn, err := droneutil.DialDroneService(ctx,
+			in.GetEndpoint().GetDroneIp(),
+			int(in.GetEndpoint().GetDronePort()),
+		)
+		if err != nil {
+			cancel()
+			logger.Warn("%+v", err)
+			return err
+		}
+		stream, err := client.DownloadFile(ctx, in)
+		if err != nil {
+			cancel()
+			conn.Close()
+			logger.Warn("%+v", err)
+			return err
+		}
+
+		s = &fileTransferSession{
+			download: stream,
+			conn:     conn,
+			cancel:   cancel,
+			size:     -1, // known after the first chunk
+			offset:   in.GetOffset(),
+		}
+		p.transfers.Put(in.GetDownloadId(), s)
+	}
+
+	// one call returns one chunk of the drone stream
+	chunk, err := s.download.Recv()
+	if err == io.EOF {
+		err = fmt.Errorf("frontgate: unexpected end of file %q at offset %d", in.GetPath(), s.offset)
+	}
+	if err != nil {
+		p.transfers.Remove(in.GetDownloadId())
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	if s.size < 0 {
+		s.size = chunk.GetSize()
+		if n := in.GetLength(); n > 0 && in.GetOffset()+n < s.size {
+			s.size = in.GetOffset() + n
+		}
+	}
+	s.offset += int64(len(chunk.GetData()))
+	if s.offset >= s.size || len(chunk.GetData()) == 0 {
+		p.transfers.Remove(in.GetDownloadId())
+	}
+
+	*out = *chunk
+	return nil
+}
+
+func (p *Server) AbortFileTransfer(in *pbtypes.String, out *pbtypes.Empty) error {
+	logger.Debug(funcutil.CallerName(1))
+
+	p.transfers.Remove(in.GetValue())
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package frontgate
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/drone"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/service/metadata/drone"
+)
+
+func tStartDrone(t *testing.T, uploadRoot string) (*pbtypes.DroneEndpoint, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	cfg := drone.NewConfigManager(filepath.Join(uploadRoot, "drone-config.json"), nil, drone.WithUploadRoot(uploadRoot))
+	pbdrone.RegisterDroneServiceServer(s, drone.NewServer(cfg, nil))
+	go s.Serve(lis)
+
+	return &pbtypes.DroneEndpoint{
+		FrontgateId: "fg-1",
+		DroneIp:     "127.0.0.1",
+		DronePort:   int32(lis.Addr().(*net.TCPAddr).Port),
+	}, s.Stop
+}
+
+// tUpload sends the file in chunks like pilot, one net/rpc call per chunk.
+func tUpload(p *Server, endpoint *pbtypes.DroneEndpoint, uploadId, path string, data []byte, sum string) error {
+	for offset := 0; offset == 0 || offset < len(data); offset += constants.FileTransferChunkSize {
+		end := offset + constants.FileTransferChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		err := p.UploadFileToDrone(&pbtypes.UploadFileRequest{
+			Endpoint: endpoint,
+			UploadId: uploadId,
+			Path:     path,
+			Size:     int64(len(data)),
+			Sha256:   sum,
+			Offset:   int64(offset),
+			Data:     data[offset:end],
+		}, &pbtypes.Empty{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestFileTransferToDrone(t *testing.T) {
+	dir, err := ioutil.TempDir("", "frontgate-file-transfer-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	endpoint, stop := tStartDrone(t, dir)
+	defer stop()
+	p := &Server{transfers: NewFileTransferSessionManager()}
+
+	data := make([]byte, constants.FileTransferChunkSize*2+100)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	h := sha256.Sum256(data)
+	sum := hex.EncodeToString(h[:])
+	path := filepath.Join(dir, "sub", "file")
+
+	err = tUpload(p, endpoint, "upload-1", path, data, sum)
+	Assert(t, err == nil, err)
+	got, err := ioutil.ReadFile(path)
+	Assert(t, err == nil, err)
+	Assert(t, bytes.Equal(got, data), "uploaded file mismatch")
+	_, ok := p.transfers.Get("upload-1")
+	Assert(t, !ok, "upload session not removed")
+
+	// download in chunks like pilot
+	var downloaded []byte
+	for int64(len(downloaded)) < int64(len(data)) {
+		var chunk pbtypes.FileChunk
+		err = p.DownloadFileFromDrone(&pbtypes.DownloadFileRequest{
+			Endpoint:   endpoint,
+			DownloadId: "download-1",
+			Path:       path,
+			Offset:     int64(len(downloaded)),
+		}, &chunk)
+		Assert(t, err == nil, err)
+		Assert(t, len(chunk.Data) <= constants.FileTransferChunkSize, "chunk too large:", len(chunk.Data))
+		Assert(t, chunk.Size == int64(len(data)), "file size mismatch:", chunk.Size)
+		Assert(t, (chunk.Offset == 0) == (chunk.Sha256 != ""), "sha256 must be sent with the first chunk only")
+		Assert(t, len(chunk.Data) > 0, "empty chunk")
+		downloaded = append(downloaded, chunk.Data...)
+
+		// the drone stream is reused for the next chunk
+		s, ok := p.transfers.Get("download-1")
+		if int64(len(downloaded)) < int64(len(data)) {
+			Assert(t, ok && s.offset == int64(len(downloaded)), "download session not kept")
+		} else {
+			Assert(t, !ok, "download session not removed")
+		}
+	}
+	Assert(t, bytes.Equal(downloaded, data), "downloaded file mismatch")
+}
+
+func TestFileTransferToDroneOutsideUploadRoot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "frontgate-file-transfer-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	root := filepath.Join(dir, "root")
+	if err := os.MkdirAll(root, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(dir, filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint, stop := tStartDrone(t, root)
+	defer stop()
+	p := &Server{transfers: NewFileTransferSessionManager()}
+
+	data := []byte("hello drone")
+	h := sha256.Sum256(data)
+	sum := hex.EncodeToString(h[:])
+
+	for i, path := range []string{
+		filepath.Join(dir, "file"),
+		filepath.Join(root, "..", "file"),
+		filepath.Join(root, "link", "file"),
+		root,
+	} {
+		err = tUpload(p, endpoint, fmt.Sprintf("upload-%d", i), path, data, sum)
+		Assert(t, err != nil, "expect upload to", path, "rejected")
+	}
+	_, err = os.Stat(filepath.Join(dir, "file"))
+	Assert(t, os.IsNotExist(err), "file must not be created:", err)
+}
+
+func TestFileTransferToDroneChecksumMismatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "frontgate-file-transfer-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	endpoint, stop := tStartDrone(t, dir)
+	defer stop()
+	p := &Server{transfers: NewFileTransferSessionManager()}
+
+	data := []byte("hello drone")
+	path := filepath.Join(dir, "file")
+
+	err = tUpload(p, endpoint, "upload-2", path, data, "0000")
+	Assert(t, err != nil, "expect checksum mismatch")
+	_, err = os.Stat(path)
+	Assert(t, os.IsNotExist(err), "file must not be created:", err)
+}
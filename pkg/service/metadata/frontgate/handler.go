@@ -733,6 +733,27 @@ func (p *Server) RunCommandOnDrone(in *pbtypes.RunCommandOnDroneRequest, out *pb
 	return nil
 }
 
-func (p *Server) HeartBeat(in *pbtypes.Empty, out *pbtypes.Empty) error {
-	return nil // OK
+func (p *Server) TailLogOnDrone(in *pbtypes.TailLogRequest, out *pbtypes.String) error {
+	logger.Info(funcutil.CallerName(1))
+
+	ctx := context.Background()
+
+	client, conn, err := droneutil.DialDroneService(ctx,
+		in.GetEndpoint().GetDroneIp(),
+		int(in.GetEndpoint().GetDronePort()),
+	)
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	defer conn.Close()
+
+	reply, err := client.TailLog(ctx, in)
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	*out = *reply
+	return nil
 }
@@ -114,6 +114,12 @@ func WithPilotService(host string, port int) func(opt *pbtypes.FrontgateConfig)
 		opt.PilotPort = int32(port)
 	}
 }
+func WithPilotList(pilots ...*pbtypes.PilotEndpoint) func(opt *pbtypes.FrontgateConfig) {
+	return func(opt *pbtypes.FrontgateConfig) {
+		opt.PilotList = append([]*pbtypes.PilotEndpoint{}, pilots...)
+	}
+}
+
 func WithFrontgateNodeList(node ...*pbtypes.FrontgateEndpoint) func(opt *pbtypes.FrontgateConfig) {
 	return func(opt *pbtypes.FrontgateConfig) {
 		opt.NodeList = append([]*pbtypes.FrontgateEndpoint{}, node...)
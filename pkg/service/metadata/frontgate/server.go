@@ -16,13 +16,17 @@ import (
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/pb/metadata/frontgate"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
 	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
 	"openpitrix.io/openpitrix/pkg/service/metadata/pilot/pilotutil"
+	"openpitrix.io/openpitrix/pkg/service/metadata/upgrader"
 )
 
 type Server struct {
-	cfg  *ConfigManager
-	etcd *EtcdClientManager
+	cfg       *ConfigManager
+	etcd      *EtcdClientManager
+	transfers *FileTransferSessionManager
+	drones    *DroneInfoManager
 
 	ch   *pilotutil.FrameChannel
 	conn *grpc.ClientConn
@@ -30,9 +34,13 @@ type Server struct {
 }
 
 func Serve(cfg *ConfigManager) {
+	upgrader.Check()
+
 	p := &Server{
-		cfg:  cfg,
-		etcd: NewEtcdClientManager(),
+		cfg:       cfg,
+		etcd:      NewEtcdClientManager(),
+		transfers: NewFileTransferSessionManager(),
+		drones:    NewDroneInfoManager(),
 	}
 
 	go ServeReverseRpcServerForPilot(cfg.Get(), p)
@@ -51,36 +59,95 @@ func ServeReverseRpcServerForPilot(
 	logger.Info("ReverseRpcServerForPilot beign")
 	defer logger.Info("ReverseRpcServerForPilot end")
 
-	var lastErrCode = codes.OK
+	var (
+		lastErrCode = codes.OK
+		endpoints   = getPilotEndpoints(cfg)
+		idx         = 0
+	)
 
 	for {
+		target := endpoints[idx%len(endpoints)]
+
 		ch, conn, err := pilotutil.DialFrontgateChannel(
-			context.Background(), fmt.Sprintf("%s:%d", cfg.PilotHost, cfg.PilotPort),
+			context.Background(), target,
 			grpc.WithInsecure(),
 		)
 		if err != nil {
+			// try next pilot replica
+			idx++
+			time.Sleep(time.Second)
+
 			gerr, ok := status.FromError(err)
 			if !ok {
 				logger.Error("err shoule be grpc error type")
-				time.Sleep(time.Second)
 				continue
 			}
 
 			if gerr.Code() != codes.Unavailable || gerr.Code() != lastErrCode {
-				logger.Error("did not connect: %v", gerr.Err())
+				logger.Error("did not connect %s: %v", target, gerr.Err())
 			}
 
 			lastErrCode = gerr.Code()
 			continue
 		} else {
 			if lastErrCode == codes.Unavailable {
-				logger.Info("pilot connect ok")
+				logger.Info("pilot %s connect ok", target)
 			}
 
 			lastErrCode = codes.OK
 		}
 
+		done := make(chan bool)
+		go keepPilotAlive(conn, done)
+
 		pbfrontgate.ServeFrontgateService(ch, service)
+		close(done)
 		conn.Close()
+
+		logger.Warn("pilot %s disconnected, reconnecting", target)
+	}
+}
+
+func getPilotEndpoints(cfg *pbtypes.FrontgateConfig) []string {
+	endpoints := []string{fmt.Sprintf("%s:%d", cfg.PilotHost, cfg.PilotPort)}
+	for _, p := range cfg.GetPilotList() {
+		endpoint := fmt.Sprintf("%s:%d", p.GetPilotHost(), p.GetPilotPort())
+		if endpoint != endpoints[0] {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// keepPilotAlive closes the connection if the pilot does not answer the ping,
+// so the frontgate can reconnect to another pilot replica.
+func keepPilotAlive(conn *grpc.ClientConn, done chan bool) {
+	const maxFailures = 3
+
+	client := pbpilot.NewPilotServiceClient(conn)
+	failures := 0
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(time.Second * 10):
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+		_, err := client.PingPilot(ctx, &pbtypes.Empty{})
+		cancel()
+
+		if err == nil {
+			failures = 0
+			continue
+		}
+
+		failures++
+		logger.Warn("ping pilot failed (%d/%d): %v", failures, maxFailures, err)
+		if failures >= maxFailures {
+			conn.Close()
+			return
+		}
 	}
 }
@@ -0,0 +1,377 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+)
+
+const waitAgentInterval = 3 * time.Second
+
+func (p *Server) GetAgentList(context.Context, *pbtypes.Empty) (*pbtypes.AgentInfoList, error) {
+	logger.Info(funcutil.CallerName(1))
+
+	return &pbtypes.AgentInfoList{AgentList: p.agentInventory.List()}, nil
+}
+
+// UpgradeAgents pushes the new binary to every agent, one by one, and
+// waits for the upgraded agent to ping back. The agents connected to
+// other pilot replicas are forwarded to the owner.
+func (p *Server) UpgradeAgents(stream pbpilot.PilotService_UpgradeAgentsServer) error {
+	logger.Info(funcutil.CallerName(1))
+
+	req, data, err := recvAgentBinary(stream)
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	timeout := constants.DefaultAgentRollbackTimeout
+	if x := req.GetRollbackTimeoutSeconds(); x > 0 {
+		timeout = time.Duration(x) * time.Second
+	}
+
+	// the staging path on the agent host
+	binaryPath := path.Join(constants.AgentUpgradeStagingDir, req.GetAgentType()+"-"+req.GetSha256()[:16])
+
+	reply := new(pbtypes.AgentInfoList)
+
+	switch req.GetAgentType() {
+	case constants.AgentTypeDrone:
+		for _, endpoint := range req.GetDroneList() {
+			info := &pbtypes.AgentInfo{
+				AgentType:   constants.AgentTypeDrone,
+				FrontgateId: endpoint.GetFrontgateId(),
+				DroneIp:     endpoint.GetDroneIp(),
+			}
+
+			if !p.fgClientMgr.HasClient(endpoint.GetFrontgateId()) {
+				sub := proto.Clone(req).(*pbtypes.UpgradeAgentsRequest)
+				sub.DroneList = []*pbtypes.DroneEndpoint{endpoint}
+				reply.AgentList = append(reply.AgentList, p.forwardUpgradeAgent(stream.Context(), info, sub, data)...)
+				continue
+			}
+
+			upgraded, err := p.upgradeDrone(endpoint, binaryPath, req.GetSha256(), data, timeout)
+			if err != nil {
+				logger.Warn("Upgrade drone [%s/%s] failed: %+v", endpoint.GetFrontgateId(), endpoint.GetDroneIp(), err)
+				info.Error = err.Error()
+			} else {
+				info = upgraded
+			}
+			reply.AgentList = append(reply.AgentList, info)
+		}
+
+	case constants.AgentTypeFrontgate:
+		for _, endpoint := range req.GetFrontgateList() {
+			info := &pbtypes.AgentInfo{
+				AgentType:       constants.AgentTypeFrontgate,
+				FrontgateId:     endpoint.GetFrontgateId(),
+				FrontgateNodeId: endpoint.GetFrontgateNodeId(),
+			}
+
+			if _, err := p.fgClientMgr.GetNodeClient(endpoint.GetFrontgateId(), endpoint.GetFrontgateNodeId()); err != nil {
+				sub := proto.Clone(req).(*pbtypes.UpgradeAgentsRequest)
+				sub.FrontgateList = []*pbtypes.FrontgateEndpoint{endpoint}
+				reply.AgentList = append(reply.AgentList, p.forwardUpgradeAgent(stream.Context(), info, sub, data)...)
+				continue
+			}
+
+			upgraded, err := p.upgradeFrontgate(endpoint, binaryPath, req.GetSha256(), data, timeout)
+			if err != nil {
+				logger.Warn("Upgrade frontgate [%s/%s] failed: %+v", endpoint.GetFrontgateId(), endpoint.GetFrontgateNodeId(), err)
+				info.Error = err.Error()
+			} else {
+				info = upgraded
+			}
+			reply.AgentList = append(reply.AgentList, info)
+		}
+
+	default:
+		err := fmt.Errorf("pilot: unknown agent type %q", req.GetAgentType())
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	return stream.SendAndClose(reply)
+}
+
+func recvAgentBinary(stream pbpilot.PilotService_UpgradeAgentsServer) (*pbtypes.UpgradeAgentsRequest, []byte, error) {
+	first, err := stream.Recv()
+	if err != nil {
+		return nil, nil, err
+	}
+	if first.GetSize() <= 0 || first.GetSize() > constants.MaxFileTransferSize {
+		return nil, nil, fmt.Errorf("pilot: invalid binary size %d, limit is %d", first.GetSize(), constants.MaxFileTransferSize)
+	}
+	if len(first.GetSha256()) < 16 {
+		return nil, nil, fmt.Errorf("pilot: invalid binary sha256 %q", first.GetSha256())
+	}
+
+	data := make([]byte, 0, first.GetSize())
+	for msg := first; ; {
+		if msg.GetOffset() != int64(len(data)) {
+			return nil, nil, fmt.Errorf("pilot: invalid chunk offset %d, expect %d", msg.GetOffset(), len(data))
+		}
+		if int64(len(data)+len(msg.GetData())) > first.GetSize() {
+			return nil, nil, fmt.Errorf("pilot: binary larger than %d bytes", first.GetSize())
+		}
+		data = append(data, msg.GetData()...)
+
+		msg, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if int64(len(data)) != first.GetSize() {
+		return nil, nil, fmt.Errorf("pilot: binary incomplete, got %d, expect %d", len(data), first.GetSize())
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); !strings.EqualFold(got, first.GetSha256()) {
+		return nil, nil, fmt.Errorf("pilot: binary checksum mismatch, got %s, expect %s", got, first.GetSha256())
+	}
+
+	req := proto.Clone(first).(*pbtypes.UpgradeAgentsRequest)
+	req.Offset = 0
+	req.Data = nil
+	return req, data, nil
+}
+
+func (p *Server) forwardUpgradeAgent(
+	ctx context.Context, info *pbtypes.AgentInfo,
+	req *pbtypes.UpgradeAgentsRequest, data []byte,
+) []*pbtypes.AgentInfo {
+	reply, err := func() (*pbtypes.AgentInfoList, error) {
+		ctx, pilotClient, err := p.fgOwnerMgr.GetOwnerClient(ctx, info.FrontgateId, info.FrontgateNodeId)
+		if err != nil {
+			return nil, err
+		}
+		stream, err := pilotClient.UpgradeAgents(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for offset := 0; ; {
+			n := len(data) - offset
+			if n > constants.FileTransferChunkSize {
+				n = constants.FileTransferChunkSize
+			}
+
+			msg := req
+			if offset > 0 {
+				msg = &pbtypes.UpgradeAgentsRequest{}
+			}
+			msg.Offset = int64(offset)
+			msg.Data = data[offset : offset+n]
+
+			if err := stream.Send(msg); err != nil {
+				if err == io.EOF {
+					break // the real error is returned by CloseAndRecv
+				}
+				return nil, err
+			}
+			if offset += n; offset >= len(data) {
+				break
+			}
+		}
+
+		return stream.CloseAndRecv()
+	}()
+	if err != nil {
+		logger.Warn("Forward agent upgrade of frontgate [%s] failed: %+v", info.FrontgateId, err)
+		info.Error = err.Error()
+		return []*pbtypes.AgentInfo{info}
+	}
+
+	return reply.GetAgentList()
+}
+
+func (p *Server) upgradeDrone(
+	endpoint *pbtypes.DroneEndpoint, binaryPath, sum string,
+	data []byte, timeout time.Duration,
+) (*pbtypes.AgentInfo, error) {
+	client, err := p.fgClientMgr.GetClient(endpoint.GetFrontgateId())
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := client.GetDroneVersion(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadId := idutil.GetUuid("upload-")
+	for offset := 0; ; {
+		n := len(data) - offset
+		if n > constants.FileTransferChunkSize {
+			n = constants.FileTransferChunkSize
+		}
+
+		_, err := client.UploadFileToDrone(&pbtypes.UploadFileRequest{
+			Endpoint: endpoint,
+			UploadId: uploadId,
+			Path:     binaryPath,
+			Size:     int64(len(data)),
+			Sha256:   sum,
+			Mode:     0755,
+			Offset:   int64(offset),
+			Data:     data[offset : offset+n],
+		})
+		if err != nil {
+			return nil, err
+		}
+		if offset += n; offset >= len(data) {
+			break
+		}
+	}
+
+	_, err = client.UpgradeDrone(&pbtypes.UpgradeDroneRequest{
+		Endpoint: endpoint,
+		Upgrade: &pbtypes.UpgradeAgentRequest{
+			BinaryPath:             binaryPath,
+			Sha256:                 sum,
+			RollbackTimeoutSeconds: int32(timeout / time.Second),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the drone confirms the upgrade when it is pinged
+	info, err := waitAgentUpgraded(constants.AgentTypeDrone, before, timeout, func() (*pbtypes.AgentInfo, bool) {
+		info, err := client.GetDroneVersion(endpoint)
+		return info, err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.agentInventory.Put(info)
+	return info, nil
+}
+
+func (p *Server) upgradeFrontgate(
+	endpoint *pbtypes.FrontgateEndpoint, binaryPath, sum string,
+	data []byte, timeout time.Duration,
+) (*pbtypes.AgentInfo, error) {
+	id, nodeId := endpoint.GetFrontgateId(), endpoint.GetFrontgateNodeId()
+
+	client, err := p.fgClientMgr.GetNodeClient(id, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := client.HeartBeat(&pbtypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	if len(before.GetAgentList()) == 0 {
+		return nil, fmt.Errorf("pilot: frontgate [%s/%s] does not support upgrade", id, nodeId)
+	}
+
+	uploadId := idutil.GetUuid("upload-")
+	for offset := 0; ; {
+		n := len(data) - offset
+		if n > constants.FileTransferChunkSize {
+			n = constants.FileTransferChunkSize
+		}
+
+		_, err := client.UploadFile(&pbtypes.UploadFileRequest{
+			UploadId: uploadId,
+			Path:     binaryPath,
+			Size:     int64(len(data)),
+			Sha256:   sum,
+			Mode:     0755,
+			Offset:   int64(offset),
+			Data:     data[offset : offset+n],
+		})
+		if err != nil {
+			return nil, err
+		}
+		if offset += n; offset >= len(data) {
+			break
+		}
+	}
+
+	_, err = client.UpgradeFrontgate(&pbtypes.UpgradeAgentRequest{
+		BinaryPath:             binaryPath,
+		Sha256:                 sum,
+		RollbackTimeoutSeconds: int32(timeout / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the frontgate confirms the upgrade when it receives the heartbeat,
+	// it may reconnect to another pilot replica, so check the inventory too.
+	return waitAgentUpgraded(constants.AgentTypeFrontgate, before.GetAgentList()[0], timeout, func() (*pbtypes.AgentInfo, bool) {
+		if c, err := p.fgClientMgr.GetNodeClient(id, nodeId); err == nil {
+			if reply, err := c.HeartBeat(&pbtypes.Empty{}); err == nil && len(reply.GetAgentList()) > 0 {
+				p.agentInventory.Put(reply.GetAgentList()...)
+			}
+		}
+		return p.agentInventory.Get(constants.AgentTypeFrontgate, id, nodeId)
+	})
+}
+
+// waitAgentUpgraded waits for the agent to restart and ping back, which
+// confirms the upgrade. Otherwise it waits for the agent to roll back too,
+// and reports what the agent runs at last instead of assuming a rollback.
+func waitAgentUpgraded(
+	agentType string, before *pbtypes.AgentInfo, timeout time.Duration,
+	probe func() (*pbtypes.AgentInfo, bool),
+) (*pbtypes.AgentInfo, error) {
+	var last *pbtypes.AgentInfo
+	restarted := func() (bool, error) {
+		info, ok := probe()
+		if !ok {
+			return false, nil
+		}
+		last = info
+		return info.StartTime != before.StartTime, nil
+	}
+
+	if err := funcutil.WaitForSpecificOrError(restarted, timeout, waitAgentInterval); err == nil {
+		return last, nil
+	}
+
+	last = nil
+	err := funcutil.WaitForSpecificOrError(restarted, constants.AgentRollbackWaitTimeout, waitAgentInterval)
+	switch {
+	case last == nil:
+		return nil, fmt.Errorf("pilot: %s did not ping back in %v, rollback unknown: %v",
+			agentType, timeout+constants.AgentRollbackWaitTimeout, err)
+	case err != nil:
+		return nil, fmt.Errorf("pilot: %s was not restarted in %v, still running version [%s]",
+			agentType, timeout+constants.AgentRollbackWaitTimeout, last.Version)
+	case last.Version == before.Version:
+		return nil, fmt.Errorf("pilot: %s did not ping back in %v, rolled back to version [%s]",
+			agentType, timeout, last.Version)
+	}
+
+	// the new agent came back late and was confirmed by the probe
+	logger.Warn("The %s pinged back after %v, upgraded to version [%s]", agentType, timeout, last.Version)
+	return last, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/golang/protobuf/proto"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+const agentInfoKeyPrefix = "pilot/agent/"
+
+// AgentInventory keeps the version of the frontgate/drone agents reported
+// in heartbeat, shared by all pilot replicas if etcd is configured.
+type AgentInventory struct {
+	etcd *etcd.Etcd
+
+	db map[string]*pbtypes.AgentInfo
+	sync.Mutex
+}
+
+func NewAgentInventory(e *etcd.Etcd) *AgentInventory {
+	return &AgentInventory{
+		etcd: e,
+		db:   make(map[string]*pbtypes.AgentInfo),
+	}
+}
+
+func agentInfoKey(info *pbtypes.AgentInfo) string {
+	if info.AgentType == constants.AgentTypeDrone {
+		return agentInfoKeyPrefix + info.AgentType + "/" + info.FrontgateId + "/" + info.DroneIp
+	}
+	return agentInfoKeyPrefix + info.AgentType + "/" + info.FrontgateId + "/" + info.FrontgateNodeId
+}
+
+func (p *AgentInventory) Put(infos ...*pbtypes.AgentInfo) {
+	if p.etcd != nil {
+		err := p.putEtcdInfos(infos)
+		if err == nil {
+			return
+		}
+		logger.Warn("Put agent info to etcd failed: %+v", err)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	for _, info := range infos {
+		p.db[agentInfoKey(info)] = proto.Clone(info).(*pbtypes.AgentInfo)
+	}
+}
+
+func (p *AgentInventory) List() []*pbtypes.AgentInfo {
+	var infos []*pbtypes.AgentInfo
+
+	if p.etcd != nil {
+		var err error
+		if infos, err = p.listEtcdInfos(); err != nil {
+			logger.Warn("List agent info from etcd failed: %+v", err)
+			infos = nil
+		}
+	}
+
+	if infos == nil {
+		p.Lock()
+		expire := time.Now().Add(-constants.AgentInfoTTL).Unix()
+		for key, info := range p.db {
+			if info.ReportTime < expire {
+				delete(p.db, key)
+				continue
+			}
+			infos = append(infos, proto.Clone(info).(*pbtypes.AgentInfo))
+		}
+		p.Unlock()
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return agentInfoKey(infos[i]) < agentInfoKey(infos[j])
+	})
+	return infos
+}
+
+func (p *AgentInventory) Get(agentType, frontgateId, nodeIdOrDroneIp string) (*pbtypes.AgentInfo, bool) {
+	key := agentInfoKey(&pbtypes.AgentInfo{
+		AgentType:       agentType,
+		FrontgateId:     frontgateId,
+		FrontgateNodeId: nodeIdOrDroneIp,
+		DroneIp:         nodeIdOrDroneIp,
+	})
+	for _, info := range p.List() {
+		if agentInfoKey(info) == key {
+			return info, true
+		}
+	}
+	return nil, false
+}
+
+func (p *AgentInventory) putEtcdInfos(infos []*pbtypes.AgentInfo) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	lease, err := p.etcd.Grant(ctx, int64(constants.AgentInfoTTL/time.Second))
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		data, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		_, err = p.etcd.Put(ctx, agentInfoKey(info), string(data), clientv3.WithLease(lease.ID))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *AgentInventory) listEtcdInfos() ([]*pbtypes.AgentInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	resp, err := p.etcd.Get(ctx, agentInfoKeyPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	infos := []*pbtypes.AgentInfo{}
+	for _, kv := range resp.Kvs {
+		var info pbtypes.AgentInfo
+		if err := json.Unmarshal(kv.Value, &info); err != nil {
+			logger.Warn("Invalid agent info [%s]: %+v", string(kv.Key), err)
+			continue
+		}
+		infos = append(infos, &info)
+	}
+	return infos, nil
+}
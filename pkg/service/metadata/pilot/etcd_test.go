@@ -0,0 +1,106 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// +build etcd
+
+package pilot
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/config/test_config"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+var tc = test_config.NewEtcdTestConfig()
+
+func TestTaskStatusManagerEtcd(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mgr := NewTaskStatusManager(e, time.Minute)
+	taskId := fmt.Sprintf("test-task-%d", rand.Intn(10000))
+
+	mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: taskId, Status: "running"})
+	leaseId := mgr.leases[taskId].id
+	mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: taskId, Status: "successful"})
+	if mgr.leases[taskId].id != leaseId {
+		t.Fatalf("expect lease [%d] reused, got [%d]", leaseId, mgr.leases[taskId].id)
+	}
+
+	v, ok := mgr.GetStatus(taskId)
+	if !ok || v.Status != "successful" {
+		t.Fatalf("expect status successful of [%s], got %v %v", taskId, v, ok)
+	}
+
+	// status kept in memory when etcd failed is still found
+	memTaskId := taskId + "-mem"
+	mgr.db[memTaskId] = taskStatusEntry{
+		status:   pbtypes.SubTaskStatus{TaskId: memTaskId, Status: "failed"},
+		expireAt: time.Now().Add(time.Minute),
+	}
+	v, ok = mgr.GetStatus(memTaskId)
+	if !ok || v.Status != "failed" {
+		t.Fatalf("expect status failed of [%s], got %v %v", memTaskId, v, ok)
+	}
+}
+
+func TestFrontgateOwnerFailover(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pilotA := NewFrontgateOwnerManager(e, &pbtypes.PilotEndpoint{PilotId: "pilot-a", PilotHost: "pilot-a", PilotPort: 9110})
+	pilotB := NewFrontgateOwnerManager(e, &pbtypes.PilotEndpoint{PilotId: "pilot-b", PilotHost: "pilot-b", PilotPort: 9110})
+	for _, mgr := range []*FrontgateOwnerManager{pilotA, pilotB} {
+		if _, err := mgr.grantLease(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fgId := fmt.Sprintf("test-fg-%d", rand.Intn(10000))
+	fg := &pbtypes.FrontgateConfig{Id: fgId, NodeId: "cln-1"}
+
+	// the frontgate connects to pilot a, pilot b routes to a
+	pilotA.Register(fg)
+	owner, err := pilotB.GetOwner(fgId, "")
+	if err != nil || owner.PilotId != "pilot-a" {
+		t.Fatalf("expect owner pilot-a, got %v %v", owner, err)
+	}
+	if _, err = pilotA.GetOwner(fgId, "cln-1"); err == nil {
+		t.Fatalf("expect pilot-a not routed to itself")
+	}
+
+	// the frontgate fails over to pilot b, the late deregister of pilot a
+	// must not remove the new owner
+	pilotB.Register(fg)
+	pilotA.Deregister(fgId, "cln-1")
+	owner, err = pilotA.GetOwner(fgId, "cln-1")
+	if err != nil || owner.PilotId != "pilot-b" {
+		t.Fatalf("expect owner pilot-b, got %v %v", owner, err)
+	}
+
+	ids, err := pilotA.ListFrontgateIds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, id := range ids {
+		found = found || id == fgId
+	}
+	if !found {
+		t.Fatalf("expect [%s] in frontgate ids %v", fgId, ids)
+	}
+
+	pilotB.Deregister(fgId, "cln-1")
+	if _, err = pilotA.GetOwner(fgId, ""); err == nil {
+		t.Fatalf("expect no owner after deregister")
+	}
+}
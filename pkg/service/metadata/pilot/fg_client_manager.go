@@ -21,6 +21,8 @@ import (
 
 type FrontgateClientManager struct {
 	clientMap map[string][]*FrontgateClient
+	ownerMgr  *FrontgateOwnerManager
+	inventory *AgentInventory
 	sync.Mutex
 }
 
@@ -30,9 +32,11 @@ type FrontgateClient struct {
 	closed chan bool
 }
 
-func NewFrontgateClientManager() *FrontgateClientManager {
+func NewFrontgateClientManager(ownerMgr *FrontgateOwnerManager, inventory *AgentInventory) *FrontgateClientManager {
 	return &FrontgateClientManager{
 		clientMap: make(map[string][]*FrontgateClient),
+		ownerMgr:  ownerMgr,
+		inventory: inventory,
 	}
 }
 
@@ -54,12 +58,13 @@ func (p *FrontgateClientManager) CheckAllClient() {
 	// ping frontgate
 	for id, cs := range clientMap {
 		for _, c := range cs {
-			if _, err := c.HeartBeat(&pbtypes.Empty{}); err != nil {
+			if reply, err := c.HeartBeat(&pbtypes.Empty{}); err != nil {
 				invalidClientKeys = append(invalidClientKeys, c.info.Id+c.info.NodeId)
 				invalidClientMap[c.info.Id+c.info.NodeId] = c
 				p.CloseClient(id, c.info.NodeId)
 			} else {
 				validClinetMap[c.info.Id+c.info.NodeId] = c
+				p.inventory.Put(reply.GetAgentList()...)
 			}
 		}
 	}
@@ -86,6 +91,27 @@ func (p *FrontgateClientManager) GetClient(id string) (*FrontgateClient, error)
 	return nil, fmt.Errorf("not found")
 }
 
+func (p *FrontgateClientManager) HasClient(id string) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	return len(p.clientMap[id]) > 0
+}
+
+func (p *FrontgateClientManager) GetClientIds() []string {
+	p.Lock()
+	defer p.Unlock()
+
+	var ids []string
+	for id, cs := range p.clientMap {
+		if len(cs) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
 func (p *FrontgateClientManager) GetNodeClient(id, nodeId string) (*FrontgateClient, error) {
 	p.Lock()
 	defer p.Unlock()
@@ -107,11 +133,12 @@ func (p *FrontgateClientManager) PutClient(c *pbfrontgate.FrontgateServiceClient
 
 	client := &FrontgateClient{
 		FrontgateServiceClient: c,
-		info:   info,
-		closed: make(chan bool),
+		info:                   info,
+		closed:                 make(chan bool),
 	}
 
 	p.clientMap[info.Id] = append(p.clientMap[info.Id], client)
+	p.ownerMgr.Register(info)
 	return client.closed
 }
 
@@ -128,6 +155,7 @@ func (p *FrontgateClientManager) CloseClient(id, nodeId string) {
 		for _, t := range cs {
 			if t.info.Id == id {
 				close(t.closed)
+				p.ownerMgr.Deregister(t.info.Id, t.info.NodeId)
 			}
 		}
 		delete(p.clientMap, id)
@@ -137,6 +165,7 @@ func (p *FrontgateClientManager) CloseClient(id, nodeId string) {
 	for i, t := range cs {
 		if t.info.Id == id && t.info.NodeId == nodeId {
 			close(t.closed)
+			p.ownerMgr.Deregister(t.info.Id, t.info.NodeId)
 			cs[i] = cs[len(cs)-1]
 			p.clientMap[id] = cs[:len(cs)-1]
 			return
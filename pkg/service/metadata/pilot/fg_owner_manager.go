@@ -0,0 +1,275 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"google.golang.org/grpc/metadata"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+const (
+	frontgateOwnerKeyPrefix = "pilot/frontgate-owner/"
+	frontgateOwnerTTL       = 30 // seconds
+
+	// set by the pilot which forwards a request to the owner pilot,
+	// the owner must not forward it again.
+	pilotForwardedMetadataKey = "openpitrix-pilot-forwarded"
+)
+
+// FrontgateOwnerManager records in etcd which pilot replica holds the
+// channel of each frontgate node, so any replica can route a request to
+// the owner.
+type FrontgateOwnerManager struct {
+	etcd *etcd.Etcd
+	self *pbtypes.PilotEndpoint
+
+	leaseId clientv3.LeaseID
+	owned   map[string]bool // key => registered by this pilot
+	mu      sync.Mutex
+}
+
+func NewFrontgateOwnerManager(e *etcd.Etcd, self *pbtypes.PilotEndpoint) *FrontgateOwnerManager {
+	return &FrontgateOwnerManager{
+		etcd:  e,
+		self:  self,
+		owned: make(map[string]bool),
+	}
+}
+
+func frontgateOwnerKey(id, nodeId string) string {
+	return frontgateOwnerKeyPrefix + id + "/" + nodeId
+}
+
+// KeepAlive keeps the lease of the owner keys, when the lease is lost
+// (etcd restart or network partition) all the owned keys are registered again.
+func (p *FrontgateOwnerManager) KeepAlive() {
+	if p.etcd == nil {
+		return
+	}
+
+	for {
+		ch, err := p.grantLease()
+		if err != nil {
+			logger.Error("Grant pilot lease failed: %+v", err)
+			time.Sleep(time.Second * 5)
+			continue
+		}
+
+		for range ch {
+			// drain the keep alive responses
+		}
+
+		logger.Warn("Pilot [%s] lease lost, register frontgate owner again", p.self.PilotId)
+	}
+}
+
+func (p *FrontgateOwnerManager) grantLease() (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	lease, err := p.etcd.Grant(ctx, frontgateOwnerTTL)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := p.etcd.KeepAlive(context.Background(), lease.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.leaseId = lease.ID
+	var keys []string
+	for key := range p.owned {
+		keys = append(keys, key)
+	}
+	p.mu.Unlock()
+
+	for _, key := range keys {
+		if err := p.putOwner(key); err != nil {
+			logger.Error("Register frontgate owner [%s] failed: %+v", key, err)
+		}
+	}
+	return ch, nil
+}
+
+func (p *FrontgateOwnerManager) putOwner(key string) error {
+	data, err := json.Marshal(p.self)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	leaseId := p.leaseId
+	p.mu.Unlock()
+
+	if leaseId == clientv3.NoLease {
+		return fmt.Errorf("pilot: lease not granted")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	_, err = p.etcd.Put(ctx, key, string(data), clientv3.WithLease(leaseId))
+	return err
+}
+
+func (p *FrontgateOwnerManager) Register(info *pbtypes.FrontgateConfig) {
+	if p.etcd == nil {
+		return
+	}
+
+	key := frontgateOwnerKey(info.Id, info.NodeId)
+
+	p.mu.Lock()
+	p.owned[key] = true
+	p.mu.Unlock()
+
+	if err := p.putOwner(key); err != nil {
+		logger.Error("Register frontgate owner [%s] failed: %+v", key, err)
+	}
+}
+
+func (p *FrontgateOwnerManager) Deregister(id, nodeId string) {
+	if p.etcd == nil {
+		return
+	}
+
+	key := frontgateOwnerKey(id, nodeId)
+
+	p.mu.Lock()
+	delete(p.owned, key)
+	p.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	// only delete the key if it still belongs to this pilot,
+	// the frontgate may have reconnected to another replica.
+	data, err := json.Marshal(p.self)
+	if err != nil {
+		return
+	}
+	_, err = p.etcd.Txn(ctx).
+		If(clientv3.Compare(clientv3.Value(key), "=", string(data))).
+		Then(clientv3.OpDelete(key)).
+		Commit()
+	if err != nil {
+		logger.Warn("Deregister frontgate owner [%s] failed: %+v", key, err)
+	}
+}
+
+// GetOwner returns the pilot which holds the channel of the frontgate,
+// if nodeId is empty, any node of the frontgate is accepted.
+func (p *FrontgateOwnerManager) GetOwner(id, nodeId string) (*pbtypes.PilotEndpoint, error) {
+	if p.etcd == nil {
+		return nil, fmt.Errorf("pilot: etcd not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	var (
+		resp *clientv3.GetResponse
+		err  error
+	)
+	if nodeId != "" {
+		resp, err = p.etcd.Get(ctx, frontgateOwnerKey(id, nodeId))
+	} else {
+		resp, err = p.etcd.Get(ctx, frontgateOwnerKeyPrefix+id+"/", clientv3.WithPrefix())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kv := range resp.Kvs {
+		var owner pbtypes.PilotEndpoint
+		if err := json.Unmarshal(kv.Value, &owner); err != nil {
+			logger.Warn("Invalid frontgate owner [%s]: %+v", string(kv.Key), err)
+			continue
+		}
+		if owner.PilotId == p.self.PilotId {
+			continue
+		}
+		return &owner, nil
+	}
+
+	return nil, fmt.Errorf("pilot: frontgate owner not found, id = %s, nodeId = %s", id, nodeId)
+}
+
+// GetOwnerClient returns a pilot client to the owner replica of the frontgate,
+// the returned context marks the request as forwarded.
+func (p *FrontgateOwnerManager) GetOwnerClient(ctx context.Context, id, nodeId string) (
+	context.Context, pbpilot.PilotServiceClient, error,
+) {
+	if IsForwardedRequest(ctx) {
+		return nil, nil, fmt.Errorf("pilot: frontgate not connected, id = %s, nodeId = %s", id, nodeId)
+	}
+
+	owner, err := p.GetOwner(id, nodeId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := manager.NewClient(owner.PilotHost, int(owner.PilotPort))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger.Debug("Forward frontgate [%s/%s] request to pilot [%s]", id, nodeId, owner.PilotId)
+
+	ctx = metadata.AppendToOutgoingContext(ctx, pilotForwardedMetadataKey, p.self.PilotId)
+	return ctx, pbpilot.NewPilotServiceClient(conn), nil
+}
+
+func IsForwardedRequest(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	return len(md.Get(pilotForwardedMetadataKey)) > 0
+}
+
+// ListFrontgateIds returns the frontgate ids connected to all pilot replicas.
+func (p *FrontgateOwnerManager) ListFrontgateIds() ([]string, error) {
+	if p.etcd == nil {
+		return nil, fmt.Errorf("pilot: etcd not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	resp, err := p.etcd.Get(ctx, frontgateOwnerKeyPrefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ids  []string
+		seen = map[string]bool{}
+	)
+	for _, kv := range resp.Kvs {
+		key := strings.TrimPrefix(string(kv.Key), frontgateOwnerKeyPrefix)
+		id := strings.SplitN(key, "/", 2)[0]
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
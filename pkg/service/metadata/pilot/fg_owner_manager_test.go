@@ -0,0 +1,44 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+func TestIsForwardedRequest(t *testing.T) {
+	if IsForwardedRequest(context.Background()) {
+		t.Fatalf("expect request without metadata not forwarded")
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(pilotForwardedMetadataKey, "pilot-a"))
+	if !IsForwardedRequest(ctx) {
+		t.Fatalf("expect request with [%s] forwarded", pilotForwardedMetadataKey)
+	}
+}
+
+func TestGetOwnerClientForwarded(t *testing.T) {
+	mgr := NewFrontgateOwnerManager(nil, &pbtypes.PilotEndpoint{PilotId: "pilot-b"})
+
+	// a forwarded request must not be forwarded again
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(pilotForwardedMetadataKey, "pilot-a"))
+	_, _, err := mgr.GetOwnerClient(ctx, "fg-1", "")
+	if err == nil {
+		t.Fatalf("expect forwarded request refused")
+	}
+
+	// no etcd, no owner
+	_, _, err = mgr.GetOwnerClient(context.Background(), "fg-1", "")
+	if err == nil {
+		t.Fatalf("expect owner not found without etcd")
+	}
+}
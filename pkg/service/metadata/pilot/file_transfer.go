@@ -0,0 +1,251 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"fmt"
+	"io"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+)
+
+// the upload/download id identifies the session in frontgate,
+// it is always assigned by pilot
+var newFileTransferId = func(prefix string) string {
+	return idutil.GetUuid(prefix)
+}
+
+func (p *Server) UploadFileToDrone(stream pbpilot.PilotService_UploadFileToDroneServer) (err error) {
+	logger.Info(funcutil.CallerName(1))
+
+	first, err := stream.Recv()
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	if first.GetSize() < 0 || first.GetSize() > constants.MaxFileTransferSize {
+		err = fmt.Errorf("pilot: invalid file size %d, limit is %d", first.GetSize(), constants.MaxFileTransferSize)
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	client, err := p.fgClientMgr.GetClient(first.GetEndpoint().GetFrontgateId())
+	if err != nil {
+		ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(
+			stream.Context(), first.GetEndpoint().GetFrontgateId(), "",
+		)
+		if e == nil {
+			return forwardUploadFile(stream, first, func() (pbpilot.PilotService_UploadFileToDroneClient, error) {
+				return pilotClient.UploadFileToDrone(ctx)
+			})
+		}
+
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	uploadId := newFileTransferId("upload-")
+
+	defer func() {
+		if err != nil {
+			abortFileTransfer(client, uploadId)
+		}
+		if err != nil && p.fgClientMgr.IsFrontgateShutdownError(err) {
+			p.fgClientMgr.CloseClient(client.info.Id, client.info.NodeId)
+		}
+	}()
+
+	var written int64
+	for msg := first; ; {
+		// split the data, so every net/rpc call carries at most one chunk
+		data := msg.GetData()
+		for {
+			n := len(data)
+			if n > constants.FileTransferChunkSize {
+				n = constants.FileTransferChunkSize
+			}
+
+			req := *first
+			req.UploadId = uploadId
+			req.Offset = written
+			req.Data = data[:n]
+
+			if written+int64(n) > first.GetSize() {
+				err = fmt.Errorf("pilot: file %q larger than %d bytes", first.GetPath(), first.GetSize())
+				logger.Warn("%+v", err)
+				return err
+			}
+			if _, err = client.UploadFileToDrone(&req); err != nil {
+				logger.Warn("%+v", err)
+				return err
+			}
+
+			written += int64(n)
+			if data = data[n:]; len(data) == 0 {
+				break
+			}
+		}
+
+		msg, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+	}
+
+	if written != first.GetSize() {
+		err = fmt.Errorf("pilot: file %q incomplete, got %d, expect %d", first.GetPath(), written, first.GetSize())
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	return stream.SendAndClose(&pbtypes.Empty{})
+}
+
+func (p *Server) DownloadFileFromDrone(arg *pbtypes.DownloadFileRequest, stream pbpilot.PilotService_DownloadFileFromDroneServer) (err error) {
+	logger.Info(funcutil.CallerName(1))
+
+	client, err := p.fgClientMgr.GetClient(arg.GetEndpoint().GetFrontgateId())
+	if err != nil {
+		ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(
+			stream.Context(), arg.GetEndpoint().GetFrontgateId(), "",
+		)
+		if e == nil {
+			ownerStream, err := pilotClient.DownloadFileFromDrone(ctx, arg)
+			if err != nil {
+				logger.Warn("%+v", err)
+				return err
+			}
+			return forwardDownloadFile(stream, ownerStream)
+		}
+
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	downloadId := newFileTransferId("download-")
+
+	defer func() {
+		if err != nil {
+			abortFileTransfer(client, downloadId)
+		}
+		if err != nil && p.fgClientMgr.IsFrontgateShutdownError(err) {
+			p.fgClientMgr.CloseClient(client.info.Id, client.info.NodeId)
+		}
+	}()
+
+	var (
+		offset = arg.GetOffset()
+		end    = int64(-1) // known after the first chunk
+	)
+	for {
+		// frontgate keeps the drone stream of the whole range,
+		// and returns the next chunk of it in every call
+		req := *arg
+		req.DownloadId = downloadId
+		req.Offset = offset
+		if end >= 0 {
+			req.Length = end - offset
+		}
+
+		var chunk *pbtypes.FileChunk
+		chunk, err = client.DownloadFileFromDrone(&req)
+		if err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+		if err = stream.Send(chunk); err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+
+		if end < 0 {
+			end = chunk.GetSize()
+			if n := arg.GetLength(); n > 0 && arg.GetOffset()+n < end {
+				end = arg.GetOffset() + n
+			}
+		}
+
+		offset += int64(len(chunk.GetData()))
+		if offset >= end || len(chunk.GetData()) == 0 {
+			return nil
+		}
+	}
+}
+
+// abortFileTransfer closes the session in frontgate at once,
+// instead of leaving it to the session timeout.
+func abortFileTransfer(client *FrontgateClient, id string) {
+	if _, err := client.AbortFileTransfer(&pbtypes.String{Value: id}); err != nil {
+		logger.Warn("%+v", err)
+	}
+}
+
+func forwardUploadFile(
+	stream pbpilot.PilotService_UploadFileToDroneServer,
+	first *pbtypes.UploadFileRequest,
+	dial func() (pbpilot.PilotService_UploadFileToDroneClient, error),
+) error {
+	ownerStream, err := dial()
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+
+	for msg := first; ; {
+		if err := ownerStream.Send(msg); err != nil {
+			if err == io.EOF {
+				// the real error is returned by CloseAndRecv
+				_, err = ownerStream.CloseAndRecv()
+			}
+			logger.Warn("%+v", err)
+			return err
+		}
+
+		msg, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+	}
+
+	reply, err := ownerStream.CloseAndRecv()
+	if err != nil {
+		logger.Warn("%+v", err)
+		return err
+	}
+	return stream.SendAndClose(reply)
+}
+
+func forwardDownloadFile(
+	stream pbpilot.PilotService_DownloadFileFromDroneServer,
+	ownerStream pbpilot.PilotService_DownloadFileFromDroneClient,
+) error {
+	for {
+		chunk, err := ownerStream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+		if err := stream.Send(chunk); err != nil {
+			logger.Warn("%+v", err)
+			return err
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/frontgate"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+// tFrontgate receives the chunks sent by pilot over net/rpc like frontgate,
+// every call must carry one chunk at the next offset.
+type tFrontgate struct {
+	uploadId   string
+	uploaded   bytes.Buffer
+	downloadId string
+	file       []byte
+	aborted    []string
+}
+
+func (p *tFrontgate) UploadFileToDrone(in *pbtypes.UploadFileRequest, out *pbtypes.Empty) error {
+	if len(in.Data) > constants.FileTransferChunkSize {
+		return fmt.Errorf("chunk size %d larger than %d", len(in.Data), constants.FileTransferChunkSize)
+	}
+	if in.Offset != int64(p.uploaded.Len()) {
+		return fmt.Errorf("invalid chunk offset %d, expect %d", in.Offset, p.uploaded.Len())
+	}
+	if p.uploadId == "" {
+		p.uploadId = in.UploadId
+	}
+	if in.UploadId != p.uploadId {
+		return fmt.Errorf("upload id changed from [%s] to [%s]", p.uploadId, in.UploadId)
+	}
+	p.uploaded.Write(in.Data)
+	return nil
+}
+
+func (p *tFrontgate) DownloadFileFromDrone(in *pbtypes.DownloadFileRequest, out *pbtypes.FileChunk) error {
+	if p.downloadId == "" {
+		p.downloadId = in.DownloadId
+	}
+	if in.DownloadId != p.downloadId {
+		return fmt.Errorf("download id changed from [%s] to [%s]", p.downloadId, in.DownloadId)
+	}
+	end := int64(len(p.file))
+	if in.Length > 0 && in.Offset+in.Length < end {
+		end = in.Offset + in.Length
+	}
+	if end-in.Offset > constants.FileTransferChunkSize {
+		end = in.Offset + constants.FileTransferChunkSize
+	}
+	*out = pbtypes.FileChunk{
+		Path:   in.Path,
+		Size:   int64(len(p.file)),
+		Offset: in.Offset,
+		Data:   p.file[in.Offset:end],
+	}
+	return nil
+}
+
+func (p *tFrontgate) AbortFileTransfer(in *pbtypes.String, out *pbtypes.Empty) error {
+	p.aborted = append(p.aborted, in.Value)
+	return nil
+}
+
+type tUploadStream struct {
+	grpc.ServerStream
+	msgs []*pbtypes.UploadFileRequest
+}
+
+func (p *tUploadStream) Context() context.Context { return context.Background() }
+
+func (p *tUploadStream) Recv() (*pbtypes.UploadFileRequest, error) {
+	if len(p.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := p.msgs[0]
+	p.msgs = p.msgs[1:]
+	return msg, nil
+}
+
+func (p *tUploadStream) SendAndClose(*pbtypes.Empty) error { return nil }
+
+type tDownloadStream struct {
+	grpc.ServerStream
+	data bytes.Buffer
+}
+
+func (p *tDownloadStream) Context() context.Context { return context.Background() }
+
+func (p *tDownloadStream) Send(chunk *pbtypes.FileChunk) error {
+	if chunk.Offset != int64(p.data.Len()) {
+		return fmt.Errorf("invalid chunk offset %d, expect %d", chunk.Offset, p.data.Len())
+	}
+	p.data.Write(chunk.Data)
+	return nil
+}
+
+func tNewFileTransferServer(t *testing.T, fg *tFrontgate) *Server {
+	newFileTransferId = func(prefix string) string { return prefix + "1" }
+
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("metadata.frontgate.FrontgateService", fg); err != nil {
+		t.Fatal(err)
+	}
+	c1, c2 := net.Pipe()
+	go srv.ServeConn(c1)
+
+	ownerMgr := NewFrontgateOwnerManager(nil, &pbtypes.PilotEndpoint{PilotId: "pilot-a"})
+	p := &Server{
+		fgOwnerMgr:  ownerMgr,
+		fgClientMgr: NewFrontgateClientManager(ownerMgr, nil),
+	}
+	p.fgClientMgr.PutClient(pbfrontgate.NewFrontgateServiceClient(c2), &pbtypes.FrontgateConfig{
+		Id:     "fg-1",
+		NodeId: "cln-1",
+	})
+	return p
+}
+
+func tFileData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func TestUploadFileToDroneChunked(t *testing.T) {
+	fg := &tFrontgate{}
+	p := tNewFileTransferServer(t, fg)
+
+	// the stream messages are not aligned with the chunks
+	data := tFileData(constants.FileTransferChunkSize*2 + 100)
+	endpoint := &pbtypes.DroneEndpoint{FrontgateId: "fg-1", DroneIp: "127.0.0.1"}
+	stream := &tUploadStream{msgs: []*pbtypes.UploadFileRequest{
+		{Endpoint: endpoint, UploadId: "upload-from-client", Path: "/tmp/file", Size: int64(len(data)), Data: data[:constants.FileTransferChunkSize+10]},
+		{Data: data[constants.FileTransferChunkSize+10 : constants.FileTransferChunkSize+20]},
+		{Data: data[constants.FileTransferChunkSize+20:]},
+	}}
+
+	if err := p.UploadFileToDrone(stream); err != nil {
+		t.Fatal(err)
+	}
+	// the upload id is always assigned by pilot
+	if fg.uploadId != "upload-1" {
+		t.Fatalf("expect upload id [upload-1], got [%s]", fg.uploadId)
+	}
+	if !bytes.Equal(fg.uploaded.Bytes(), data) {
+		t.Fatalf("expect %d bytes uploaded, got %d", len(data), fg.uploaded.Len())
+	}
+	if len(fg.aborted) != 0 {
+		t.Fatalf("expect no upload aborted, got %v", fg.aborted)
+	}
+}
+
+func TestUploadFileToDroneIncomplete(t *testing.T) {
+	fg := &tFrontgate{}
+	p := tNewFileTransferServer(t, fg)
+
+	data := tFileData(100)
+	stream := &tUploadStream{msgs: []*pbtypes.UploadFileRequest{{
+		Endpoint: &pbtypes.DroneEndpoint{FrontgateId: "fg-1"},
+		Path:     "/tmp/file",
+		Size:     int64(len(data)) + 1,
+		Data:     data,
+	}}}
+
+	if err := p.UploadFileToDrone(stream); err == nil {
+		t.Fatalf("expect incomplete upload failed")
+	}
+	if len(fg.aborted) != 1 || fg.aborted[0] != "upload-1" {
+		t.Fatalf("expect upload [upload-1] aborted, got %v", fg.aborted)
+	}
+}
+
+func TestDownloadFileFromDroneChunked(t *testing.T) {
+	fg := &tFrontgate{file: tFileData(constants.FileTransferChunkSize*2 + 100)}
+	p := tNewFileTransferServer(t, fg)
+
+	for _, tt := range []struct {
+		offset, length int64
+	}{
+		{0, 0},
+		{10, 0},
+		{10, constants.FileTransferChunkSize + 5},
+	} {
+		fg.downloadId = ""
+		stream := &tDownloadStream{}
+		stream.data.Write(make([]byte, tt.offset))
+		err := p.DownloadFileFromDrone(&pbtypes.DownloadFileRequest{
+			Endpoint: &pbtypes.DroneEndpoint{FrontgateId: "fg-1"},
+			Path:     "/tmp/file",
+			Offset:   tt.offset,
+			Length:   tt.length,
+		}, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		end := int64(len(fg.file))
+		if tt.length > 0 {
+			end = tt.offset + tt.length
+		}
+		if got := stream.data.Bytes()[tt.offset:]; !bytes.Equal(got, fg.file[tt.offset:end]) {
+			t.Fatalf("offset %d length %d: expect %d bytes, got %d", tt.offset, tt.length, end-tt.offset, len(got))
+		}
+		if fg.downloadId != "download-1" {
+			t.Fatalf("expect download id [download-1], got [%s]", fg.downloadId)
+		}
+	}
+}
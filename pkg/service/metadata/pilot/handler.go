@@ -33,7 +33,11 @@ func (p *Server) GetPilotConfig(context.Context, *pbtypes.Empty) (*pbtypes.Pilot
 func (p *Server) GetFrontgateList(context.Context, *pbtypes.Empty) (*pbtypes.FrontgateIdList, error) {
 	logger.Info(funcutil.CallerName(1))
 
-	return nil, fmt.Errorf("TODO")
+	if ids, err := p.fgOwnerMgr.ListFrontgateIds(); err == nil {
+		return &pbtypes.FrontgateIdList{IdList: ids}, nil
+	}
+
+	return &pbtypes.FrontgateIdList{IdList: p.fgClientMgr.GetClientIds()}, nil
 }
 
 func (p *Server) GetFrontgateConfig(ctx context.Context, arg *pbtypes.FrontgateId) (*pbtypes.FrontgateConfig, error) {
@@ -41,6 +45,10 @@ func (p *Server) GetFrontgateConfig(ctx context.Context, arg *pbtypes.FrontgateI
 
 	client, err := p.fgClientMgr.GetClient(arg.Id)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.Id, ""); e == nil {
+			return pilotClient.GetFrontgateConfig(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -65,6 +73,10 @@ func (p *Server) SetFrontgateConfig(ctx context.Context, arg *pbtypes.FrontgateC
 
 	client, err := p.fgClientMgr.GetClient(arg.Id)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.Id, ""); e == nil {
+			return pilotClient.SetFrontgateConfig(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -89,6 +101,10 @@ func (p *Server) GetDroneConfig(ctx context.Context, arg *pbtypes.DroneEndpoint)
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.GetDroneConfig(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -112,6 +128,10 @@ func (p *Server) SetDroneConfig(ctx context.Context, arg *pbtypes.SetDroneConfig
 
 	client, err := p.fgClientMgr.GetClient(arg.Endpoint.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.Endpoint.FrontgateId, ""); e == nil {
+			return pilotClient.SetDroneConfig(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -154,6 +174,10 @@ func (p *Server) GetConfdConfig(ctx context.Context, arg *pbtypes.ConfdEndpoint)
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.GetConfdConfig(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -181,6 +205,10 @@ func (p *Server) IsConfdRunning(ctx context.Context, arg *pbtypes.DroneEndpoint)
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.IsConfdRunning(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -208,6 +236,10 @@ func (p *Server) StartConfd(ctx context.Context, arg *pbtypes.DroneEndpoint) (*p
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.StartConfd(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -235,6 +267,10 @@ func (p *Server) StopConfd(ctx context.Context, arg *pbtypes.DroneEndpoint) (*pb
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.StopConfd(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -262,6 +298,10 @@ func (p *Server) RegisterMetadata(ctx context.Context, arg *pbtypes.SubTask_Regi
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.RegisterMetadata(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -286,6 +326,10 @@ func (p *Server) DeregisterMetadata(ctx context.Context, arg *pbtypes.SubTask_De
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.DeregisterMetadata(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -310,6 +354,10 @@ func (p *Server) RegisterCmd(ctx context.Context, arg *pbtypes.SubTask_RegisterC
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.RegisterCmd(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -334,6 +382,10 @@ func (p *Server) DeregisterCmd(ctx context.Context, arg *pbtypes.SubTask_Deregis
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.DeregisterCmd(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -375,6 +427,13 @@ func (p *Server) GetSubtaskStatus(ctx context.Context, arg *pbtypes.SubTaskId) (
 func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage) (*pbtypes.Empty, error) {
 	logger.Info(funcutil.CallerName(1))
 
+	// the frontgate is connected to another pilot replica
+	if fgId := getSubtaskFrontgateId(msg); fgId != "" && !p.fgClientMgr.HasClient(fgId) {
+		if ctx, pilotClient, err := p.fgOwnerMgr.GetOwnerClient(ctx, fgId, ""); err == nil {
+			return pilotClient.HandleSubtask(ctx, msg)
+		}
+	}
+
 	switch msg.Action {
 	case pbtypes.SubTaskAction_StartConfd.String():
 		var x pbtypes.SubTask_StartConfd
@@ -573,6 +632,16 @@ func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage)
 	}
 }
 
+func getSubtaskFrontgateId(msg *pbtypes.SubTaskMessage) string {
+	var x struct {
+		FrontgateId string `json:"frontgate_id"`
+	}
+	if err := json.Unmarshal([]byte(msg.Directive), &x); err != nil {
+		return ""
+	}
+	return x.FrontgateId
+}
+
 func (p *Server) PingPilot(ctx context.Context, arg *pbtypes.Empty) (*pbtypes.Empty, error) {
 	logger.Info(funcutil.CallerName(1))
 
@@ -584,6 +653,10 @@ func (p *Server) PingFrontgate(ctx context.Context, arg *pbtypes.FrontgateId) (*
 
 	client, err := p.fgClientMgr.GetClient(arg.Id)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.Id, ""); e == nil {
+			return pilotClient.PingFrontgate(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -608,6 +681,10 @@ func (p *Server) PingFrontgateNode(ctx context.Context, arg *pbtypes.FrontgateNo
 
 	client, err := p.fgClientMgr.GetNodeClient(arg.Id, arg.NodeId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.Id, arg.NodeId); e == nil {
+			return pilotClient.PingFrontgateNode(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -632,6 +709,10 @@ func (p *Server) PingDrone(ctx context.Context, arg *pbtypes.DroneEndpoint) (*pb
 
 	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.FrontgateId, ""); e == nil {
+			return pilotClient.PingDrone(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -663,6 +744,13 @@ func (p *Server) RunCommandOnFrontgateNode(ctx context.Context, arg *pbtypes.Run
 		arg.GetEndpoint().GetFrontgateNodeId(),
 	)
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx,
+			arg.GetEndpoint().GetFrontgateId(),
+			arg.GetEndpoint().GetFrontgateNodeId(),
+		); e == nil {
+			return pilotClient.RunCommandOnFrontgateNode(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -687,6 +775,10 @@ func (p *Server) RunCommandOnDrone(ctx context.Context, arg *pbtypes.RunCommandO
 
 	client, err := p.fgClientMgr.GetClient(arg.GetEndpoint().GetFrontgateId())
 	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.GetEndpoint().GetFrontgateId(), ""); e == nil {
+			return pilotClient.RunCommandOnDrone(ctx, arg)
+		}
+
 		logger.Warn("%+v", err)
 		return nil, err
 	}
@@ -705,3 +797,31 @@ func (p *Server) RunCommandOnDrone(ctx context.Context, arg *pbtypes.RunCommandO
 
 	return reply, nil
 }
+
+func (p *Server) TailLogOnDrone(ctx context.Context, arg *pbtypes.TailLogRequest) (*pbtypes.String, error) {
+	logger.Info(funcutil.CallerName(1))
+
+	client, err := p.fgClientMgr.GetClient(arg.GetEndpoint().GetFrontgateId())
+	if err != nil {
+		if ctx, pilotClient, e := p.fgOwnerMgr.GetOwnerClient(ctx, arg.GetEndpoint().GetFrontgateId(), ""); e == nil {
+			return pilotClient.TailLogOnDrone(ctx, arg)
+		}
+
+		logger.Warn("%+v", err)
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil && p.fgClientMgr.IsFrontgateShutdownError(err) {
+			p.fgClientMgr.CloseClient(client.info.Id, client.info.NodeId)
+		}
+	}()
+
+	reply, err := client.TailLogOnDrone(arg)
+	if err != nil {
+		logger.Warn("%+v", err)
+		return nil, err
+	}
+
+	return reply, nil
+}
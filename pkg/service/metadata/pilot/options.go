@@ -48,8 +48,20 @@ func WithPilotId(id string) func(opt *pbtypes.PilotConfig) {
 	}
 }
 
+func WithPilotHost(host string) func(opt *pbtypes.PilotConfig) {
+	return func(opt *pbtypes.PilotConfig) {
+		opt.Host = host
+	}
+}
+
 func WithListenPort(port int) func(opt *pbtypes.PilotConfig) {
 	return func(opt *pbtypes.PilotConfig) {
 		opt.ListenPort = int32(port)
 	}
 }
+
+func WithEtcdConfig(etcdConfig *pbtypes.EtcdConfig) func(opt *pbtypes.PilotConfig) {
+	return func(opt *pbtypes.PilotConfig) {
+		opt.EtcdConfig = etcdConfig
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilotutil
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/golang/protobuf/proto"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+// UpgradeAgents pushes the local binary to the agents in req, size, sha256
+// and data of req are filled from the binary.
+func UpgradeAgents(
+	ctx context.Context, client pbpilot.PilotServiceClient,
+	req *pbtypes.UpgradeAgentsRequest, binaryPath string,
+) (*pbtypes.AgentInfoList, error) {
+	data, err := ioutil.ReadFile(binaryPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 || len(data) > constants.MaxFileTransferSize {
+		return nil, fmt.Errorf("pilotutil: invalid binary %q size %d", binaryPath, len(data))
+	}
+
+	sum := sha256.Sum256(data)
+
+	stream, err := client.UpgradeAgents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for offset := 0; ; {
+		n := len(data) - offset
+		if n > constants.FileTransferChunkSize {
+			n = constants.FileTransferChunkSize
+		}
+
+		msg := &pbtypes.UpgradeAgentsRequest{}
+		if offset == 0 {
+			msg = proto.Clone(req).(*pbtypes.UpgradeAgentsRequest)
+			msg.Size = int64(len(data))
+			msg.Sha256 = hex.EncodeToString(sum[:])
+		}
+		msg.Offset = int64(offset)
+		msg.Data = data[offset : offset+n]
+
+		err := stream.Send(msg)
+		if err == io.EOF {
+			break // the real error is returned by CloseAndRecv
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if offset += n; offset >= len(data) {
+			break
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
@@ -0,0 +1,141 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilotutil
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+// UploadFileToDrone copies the local file to the drone, mode and owner
+// keep the drone default if they are zero.
+func UploadFileToDrone(
+	ctx context.Context, client pbpilot.PilotServiceClient,
+	endpoint *pbtypes.DroneEndpoint, localPath, remotePath string,
+	mode int32, owner string,
+) error {
+	data, err := ioutil.ReadFile(localPath)
+	if err != nil {
+		return err
+	}
+	if len(data) > constants.MaxFileTransferSize {
+		return fmt.Errorf("pilotutil: file %q larger than %d bytes", localPath, constants.MaxFileTransferSize)
+	}
+
+	sum := sha256.Sum256(data)
+
+	stream, err := client.UploadFileToDrone(ctx)
+	if err != nil {
+		return err
+	}
+
+	for offset := 0; ; {
+		n := len(data) - offset
+		if n > constants.FileTransferChunkSize {
+			n = constants.FileTransferChunkSize
+		}
+
+		err := stream.Send(&pbtypes.UploadFileRequest{
+			Endpoint: endpoint,
+			Path:     remotePath,
+			Size:     int64(len(data)),
+			Sha256:   hex.EncodeToString(sum[:]),
+			Mode:     mode,
+			Owner:    owner,
+			Offset:   int64(offset),
+			Data:     data[offset : offset+n],
+		})
+		if err == io.EOF {
+			break // the real error is returned by CloseAndRecv
+		}
+		if err != nil {
+			return err
+		}
+
+		if offset += n; offset >= len(data) {
+			break
+		}
+	}
+
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// DownloadFileFromDrone copies the drone file to local path,
+// the checksum is verified before the local file is replaced.
+func DownloadFileFromDrone(
+	ctx context.Context, client pbpilot.PilotServiceClient,
+	endpoint *pbtypes.DroneEndpoint, remotePath, localPath string,
+) error {
+	stream, err := client.DownloadFileFromDrone(ctx, &pbtypes.DownloadFileRequest{
+		Endpoint: endpoint,
+		Path:     remotePath,
+	})
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(localPath), "."+filepath.Base(localPath)+".download-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	var (
+		h       = sha256.New()
+		w       = io.MultiWriter(f, h)
+		first   *pbtypes.FileChunk
+		written int64
+	)
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first == nil {
+			first = chunk
+		}
+		if chunk.GetOffset() != written {
+			return fmt.Errorf("pilotutil: invalid chunk offset %d, expect %d", chunk.GetOffset(), written)
+		}
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return err
+		}
+		written += int64(len(chunk.GetData()))
+	}
+
+	if first == nil {
+		return fmt.Errorf("pilotutil: no data of file %q", remotePath)
+	}
+	if written != first.GetSize() {
+		return fmt.Errorf("pilotutil: file %q size mismatch, got %d, expect %d", remotePath, written, first.GetSize())
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(sum, first.GetSha256()) {
+		return fmt.Errorf("pilotutil: file %q checksum mismatch, got %s, expect %s", remotePath, sum, first.GetSha256())
+	}
+
+	if err := f.Chmod(os.FileMode(first.GetMode()) & os.ModePerm); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), localPath)
+}
@@ -5,20 +5,27 @@
 package pilot
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc"
 
+	"openpitrix.io/openpitrix/pkg/config"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
 	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
 )
 
 type Server struct {
-	cfg           *pbtypes.PilotConfig
-	fgClientMgr   *FrontgateClientManager
-	taskStatusMgr *TaskStatusManager
+	cfg            *pbtypes.PilotConfig
+	etcd           *etcd.Etcd
+	fgOwnerMgr     *FrontgateOwnerManager
+	fgClientMgr    *FrontgateClientManager
+	taskStatusMgr  *TaskStatusManager
+	agentInventory *AgentInventory
 }
 
 func Serve(cfg *pbtypes.PilotConfig, opts ...Options) {
@@ -33,14 +40,24 @@ func Serve(cfg *pbtypes.PilotConfig, opts ...Options) {
 	}
 
 	p := &Server{
-		cfg:           cfg,
-		fgClientMgr:   NewFrontgateClientManager(),
-		taskStatusMgr: NewTaskStatusManager(),
+		cfg:  cfg,
+		etcd: openEtcd(cfg.GetEtcdConfig()),
 	}
+	p.fgOwnerMgr = NewFrontgateOwnerManager(p.etcd, &pbtypes.PilotEndpoint{
+		PilotId:   cfg.Id,
+		PilotHost: cfg.Host,
+		PilotPort: cfg.ListenPort,
+	})
+	p.agentInventory = NewAgentInventory(p.etcd)
+	p.fgClientMgr = NewFrontgateClientManager(p.fgOwnerMgr, p.agentInventory)
+	p.taskStatusMgr = NewTaskStatusManager(p.etcd, 0)
+
+	go p.fgOwnerMgr.KeepAlive()
 
 	go func() {
 		for {
 			p.fgClientMgr.CheckAllClient()
+			p.taskStatusMgr.ClearExpired()
 			time.Sleep(time.Second * 10)
 		}
 	}()
@@ -49,3 +66,21 @@ func Serve(cfg *pbtypes.PilotConfig, opts ...Options) {
 		pbpilot.RegisterPilotServiceServer(server, p)
 	})
 }
+
+func openEtcd(cfg *pbtypes.EtcdConfig) *etcd.Etcd {
+	var endpoints []string
+	for _, node := range cfg.GetNodeList() {
+		endpoints = append(endpoints, fmt.Sprintf("%s:%d", node.GetHost(), node.GetPort()))
+	}
+	if len(endpoints) == 0 {
+		logger.Warn("No etcd configured, pilot subtask status is kept in memory")
+		return nil
+	}
+
+	e, err := etcd.Connect(endpoints, config.EtcdPrefix)
+	if err != nil {
+		logger.Error("Connect etcd %v failed, pilot subtask status is kept in memory: %+v", endpoints, err)
+		return nil
+	}
+	return e
+}
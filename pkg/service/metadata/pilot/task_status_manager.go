@@ -5,33 +5,209 @@
 package pilot
 
 import (
+	"context"
+	"encoding/json"
 	"sync"
+	"time"
 
+	"github.com/coreos/etcd/clientv3"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
 )
 
+const taskStatusKeyPrefix = "pilot/subtask-status/"
+
+// TaskStatusManager keeps the subtask status reported by frontgate/drone.
+//
+// If etcd is configured, the status is stored in etcd with a lease, so every
+// pilot replica can answer GetSubtaskStatus and a restart loses nothing.
+// Otherwise the status is only kept in memory.
+//
+// When a put to etcd fails, the status is kept in memory instead, and the
+// memory entry wins over the older one in etcd until a later put succeeds.
 type TaskStatusManager struct {
-	db map[string]pbtypes.SubTaskStatus
+	etcd  *etcd.Etcd
+	store taskStatusStore
+	ttl   time.Duration
+
+	db     map[string]taskStatusEntry
+	leases map[string]taskStatusLease // key=task id
 	sync.Mutex
 }
 
-func NewTaskStatusManager() *TaskStatusManager {
-	return &TaskStatusManager{
-		db: make(map[string]pbtypes.SubTaskStatus),
+// taskStatusStore is the shared storage of the subtask status.
+type taskStatusStore interface {
+	getEtcdStatus(id string) (pbtypes.SubTaskStatus, bool, error)
+	putEtcdStatus(v pbtypes.SubTaskStatus) error
+}
+
+type taskStatusEntry struct {
+	status   pbtypes.SubTaskStatus
+	expireAt time.Time
+}
+
+// taskStatusLease is the etcd lease shared by all the status writes of a
+// subtask, every write keeps it alive instead of granting a new one.
+type taskStatusLease struct {
+	id       clientv3.LeaseID
+	expireAt time.Time
+}
+
+func NewTaskStatusManager(e *etcd.Etcd, ttl time.Duration) *TaskStatusManager {
+	if ttl <= 0 {
+		ttl = constants.SubTaskStatusTTL
+	}
+	p := &TaskStatusManager{
+		etcd:   e,
+		ttl:    ttl,
+		db:     make(map[string]taskStatusEntry),
+		leases: make(map[string]taskStatusLease),
 	}
+	if e != nil {
+		p.store = p
+	}
+	return p
 }
 
 func (p *TaskStatusManager) GetStatus(id string) (v pbtypes.SubTaskStatus, ok bool) {
+	// the status is kept in memory only if etcd failed when it was put,
+	// so it is newer than the one in etcd
+	if v, ok := p.getMemoryStatus(id); ok {
+		return v, ok
+	}
+
+	if p.store != nil {
+		v, ok, err := p.store.getEtcdStatus(id)
+		if err == nil && ok {
+			return v, ok
+		}
+		if err != nil {
+			logger.Warn("Get subtask [%s] status from etcd failed: %+v", id, err)
+		}
+	}
+	return v, false
+}
+
+func (p *TaskStatusManager) getMemoryStatus(id string) (v pbtypes.SubTaskStatus, ok bool) {
 	p.Lock()
 	defer p.Unlock()
 
-	v, ok = p.db[id]
-	return
+	e, ok := p.db[id]
+	if !ok || time.Now().After(e.expireAt) {
+		delete(p.db, id)
+		return v, false
+	}
+	return e.status, true
 }
 
 func (p *TaskStatusManager) PutStatus(v pbtypes.SubTaskStatus) {
+	if p.store != nil {
+		err := p.store.putEtcdStatus(v)
+		if err == nil {
+			// etcd holds the latest status now
+			p.Lock()
+			delete(p.db, v.TaskId)
+			p.Unlock()
+			return
+		}
+		logger.Warn("Put subtask [%s] status to etcd failed: %+v", v.TaskId, err)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	p.db[v.TaskId] = taskStatusEntry{
+		status:   v,
+		expireAt: time.Now().Add(p.ttl),
+	}
+}
+
+// ClearExpired drops the expired in-memory status, the etcd keys are
+// removed by their lease.
+func (p *TaskStatusManager) ClearExpired() {
+	p.Lock()
+	defer p.Unlock()
+
+	now := time.Now()
+	for id, e := range p.db {
+		if now.After(e.expireAt) {
+			delete(p.db, id)
+		}
+	}
+	for id, l := range p.leases {
+		if now.After(l.expireAt) {
+			delete(p.leases, id)
+		}
+	}
+}
+
+func (p *TaskStatusManager) getEtcdStatus(id string) (v pbtypes.SubTaskStatus, ok bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	resp, err := p.etcd.Get(ctx, taskStatusKeyPrefix+id)
+	if err != nil {
+		return v, false, err
+	}
+	if len(resp.Kvs) == 0 {
+		return v, false, nil
+	}
+	if err = json.Unmarshal(resp.Kvs[0].Value, &v); err != nil {
+		return v, false, err
+	}
+	return v, true, nil
+}
+
+func (p *TaskStatusManager) putEtcdStatus(v pbtypes.SubTaskStatus) error {
+	data, err := json.Marshal(&v)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.GrpcToPilotTimeout)
+	defer cancel()
+
+	leaseId, err := p.getLease(ctx, v.TaskId)
+	if err != nil {
+		return err
+	}
+	_, err = p.etcd.Put(ctx, taskStatusKeyPrefix+v.TaskId, string(data), clientv3.WithLease(leaseId))
+	return err
+}
+
+// getLease returns the lease of the subtask with its ttl renewed, a new lease
+// is granted only for the first write or after the lease expired.
+func (p *TaskStatusManager) getLease(ctx context.Context, taskId string) (clientv3.LeaseID, error) {
+	p.Lock()
+	l, ok := p.leases[taskId]
+	p.Unlock()
+
+	if ok && time.Now().Before(l.expireAt) {
+		_, err := p.etcd.KeepAliveOnce(ctx, l.id)
+		if err == nil {
+			p.setLease(taskId, l.id)
+			return l.id, nil
+		}
+		logger.Warn("Keep alive subtask [%s] lease failed, grant a new one: %+v", taskId, err)
+	}
+
+	lease, err := p.etcd.Grant(ctx, int64(p.ttl/time.Second))
+	if err != nil {
+		return 0, err
+	}
+	p.setLease(taskId, lease.ID)
+	return lease.ID, nil
+}
+
+func (p *TaskStatusManager) setLease(taskId string, id clientv3.LeaseID) {
 	p.Lock()
 	defer p.Unlock()
 
-	p.db[v.TaskId] = v
+	p.leases[taskId] = taskStatusLease{
+		id:       id,
+		expireAt: time.Now().Add(p.ttl),
+	}
 }
@@ -0,0 +1,88 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+func TestTaskStatusManagerInMemory(t *testing.T) {
+	mgr := NewTaskStatusManager(nil, time.Hour)
+
+	_, ok := mgr.GetStatus("t-1")
+	if ok {
+		t.Fatalf("expect no status of t-1")
+	}
+
+	mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: "t-1", Status: "running"})
+	mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: "t-1", Status: "successful"})
+	v, ok := mgr.GetStatus("t-1")
+	if !ok || v.Status != "successful" {
+		t.Fatalf("expect status successful of t-1, got %v %v", v, ok)
+	}
+}
+
+func TestTaskStatusManagerExpired(t *testing.T) {
+	mgr := NewTaskStatusManager(nil, time.Hour)
+	mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: "t-1", Status: "running"})
+	mgr.db["t-1"] = taskStatusEntry{
+		status:   mgr.db["t-1"].status,
+		expireAt: time.Now().Add(-time.Second),
+	}
+
+	mgr.ClearExpired()
+	if _, ok := mgr.GetStatus("t-1"); ok {
+		t.Fatalf("expect status of t-1 expired")
+	}
+}
+
+// tTaskStatusStore is an etcd store whose put can be made to fail.
+type tTaskStatusStore struct {
+	status  map[string]pbtypes.SubTaskStatus
+	putFail bool
+}
+
+func (s *tTaskStatusStore) getEtcdStatus(id string) (pbtypes.SubTaskStatus, bool, error) {
+	v, ok := s.status[id]
+	return v, ok, nil
+}
+
+func (s *tTaskStatusStore) putEtcdStatus(v pbtypes.SubTaskStatus) error {
+	if s.putFail {
+		return fmt.Errorf("etcd unavailable")
+	}
+	s.status[v.TaskId] = v
+	return nil
+}
+
+func TestTaskStatusManagerEtcdPutFailed(t *testing.T) {
+	store := &tTaskStatusStore{status: make(map[string]pbtypes.SubTaskStatus)}
+	mgr := NewTaskStatusManager(nil, time.Hour)
+	mgr.store = store
+
+	mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: "t-1", Status: "running"})
+	store.putFail = true
+	mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: "t-1", Status: "successful"})
+
+	v, ok := mgr.GetStatus("t-1")
+	if !ok || v.Status != "successful" {
+		t.Fatalf("expect status successful of t-1 kept in memory, got %v %v", v, ok)
+	}
+
+	// a later successful put makes etcd the source again
+	store.putFail = false
+	mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: "t-1", Status: "failed"})
+	if _, ok := mgr.db["t-1"]; ok {
+		t.Fatalf("expect the memory status of t-1 dropped")
+	}
+	v, ok = mgr.GetStatus("t-1")
+	if !ok || v.Status != "failed" {
+		t.Fatalf("expect status failed of t-1, got %v %v", v, ok)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// +build !windows
+
+package upgrader
+
+import (
+	"os"
+	"syscall"
+)
+
+// restart replaces the current process with the binary,
+// the pid is kept so the supervisor does not notice.
+func restart(exe string) error {
+	return syscall.Exec(exe, os.Args, os.Environ())
+}
+
+// spawnWatchdog starts the binary as the watchdog of the agent exe in its
+// own session, so it outlives the restart of the agent.
+func spawnWatchdog(binary, exe string) error {
+	p, err := os.StartProcess(binary, os.Args, &os.ProcAttr{
+		Env:   append(os.Environ(), watchdogEnv+"="+exe),
+		Files: []*os.File{nil, os.Stdout, os.Stderr},
+		Sys:   &syscall.SysProcAttr{Setsid: true},
+	})
+	if err != nil {
+		return err
+	}
+	return p.Release()
+}
+
+func kill(pid int) error {
+	return syscall.Kill(pid, syscall.SIGKILL)
+}
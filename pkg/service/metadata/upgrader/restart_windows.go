@@ -0,0 +1,21 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package upgrader
+
+import (
+	"fmt"
+)
+
+func restart(exe string) error {
+	return fmt.Errorf("upgrader: restart is not supported on windows")
+}
+
+func spawnWatchdog(binary, exe string) error {
+	return fmt.Errorf("upgrader: watchdog is not supported on windows")
+}
+
+func kill(pid int) error {
+	return fmt.Errorf("upgrader: kill is not supported on windows")
+}
@@ -0,0 +1,391 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package upgrader replaces the binary of the running frontgate/drone agent.
+//
+// The new binary is run once in preflight mode before it is installed, so
+// a binary which can not start at all is never swapped in.
+//
+// The old binary is kept as <binary>.bak and a <binary>.upgrade marker
+// records the rollback deadline. The new agent must call Check when it
+// starts and Confirm when it is pinged by pilot, otherwise the old binary
+// is restored and restarted after the deadline.
+//
+// The new agent may also hang or crash before it reaches Check, so Upgrade
+// starts a watchdog from the old binary too. The watchdog restores the old
+// binary when the marker is still there a little after the deadline, kills
+// the unconfirmed agent and restarts the old one. When the agent runs under
+// a supervisor like systemd or supervisord, the watchdog leaves the restart
+// to the supervisor, otherwise two agents would compete for the port.
+package upgrader
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+)
+
+const (
+	// set when the new binary is run in preflight mode
+	preflightEnv = "OPENPITRIX_UPGRADE_PREFLIGHT"
+	// set to the agent binary path when the old binary is run as watchdog
+	watchdogEnv = "OPENPITRIX_UPGRADE_WATCHDOG"
+	// true/false, tells whether the agent is restarted by a supervisor,
+	// detected from the environment of systemd and supervisord if not set
+	supervisedEnv = "OPENPITRIX_AGENT_SUPERVISED"
+
+	preflightTimeout = 10 * time.Second
+	// the agent rolls back by itself at the deadline if it is alive,
+	// the watchdog only steps in after the grace period.
+	watchdogGrace    = 10 * time.Second
+	watchdogInterval = time.Second
+	restartDelay     = time.Second
+)
+
+var (
+	startTime = time.Now()
+
+	mu    sync.Mutex
+	timer *time.Timer
+
+	// replaced in tests
+	getExecutable = executable
+	restartAgent  = restart
+	startWatchdog = spawnWatchdog
+	killAgent     = kill
+)
+
+type marker struct {
+	Backup   string    `json:"backup"`
+	Deadline time.Time `json:"deadline"`
+	// pid of the unconfirmed agent, killed by the watchdog on rollback
+	Pid int `json:"pid"`
+}
+
+func init() {
+	if os.Getenv(preflightEnv) != "" {
+		// the binary is loadable and the runtime is up, that is all preflight checks
+		os.Exit(0)
+	}
+	if exe := os.Getenv(watchdogEnv); exe != "" {
+		os.Unsetenv(watchdogEnv)
+		if watch(exe, watchdogInterval) && !isSupervised() {
+			if err := restartAgent(exe); err != nil {
+				logger.Critical("Restart agent [%s] failed: %+v", exe, err)
+				os.Exit(1)
+			}
+		}
+		os.Exit(0)
+	}
+}
+
+// StartTime returns the time the agent process started,
+// pilot uses it to know whether the agent has been restarted.
+func StartTime() time.Time {
+	return startTime
+}
+
+// Upgrade verifies the new binary, swaps it with the running one and
+// restarts the agent in the background, so the caller can reply first.
+func Upgrade(binaryPath, sum string, rollbackTimeout time.Duration) error {
+	exe, err := getExecutable()
+	if err != nil {
+		return err
+	}
+
+	if err := checkSha256(binaryPath, sum); err != nil {
+		return err
+	}
+
+	if err := copyFile(binaryPath, exe+".new", 0755); err != nil {
+		return err
+	}
+	if err := preflight(exe + ".new"); err != nil {
+		os.Remove(exe + ".new")
+		return err
+	}
+	if err := copyFile(exe, exe+".bak", 0755); err != nil {
+		os.Remove(exe + ".new")
+		return err
+	}
+
+	err = writeMarker(exe, &marker{
+		Backup:   exe + ".bak",
+		Deadline: time.Now().Add(rollbackTimeout),
+		Pid:      os.Getpid(),
+	})
+	if err != nil {
+		os.Remove(exe + ".new")
+		return err
+	}
+
+	if err := os.Rename(exe+".new", exe); err != nil {
+		os.Remove(exe + ".upgrade")
+		return err
+	}
+
+	// the watchdog runs the old binary, which is known to work
+	if err := startWatchdog(exe+".bak", exe); err != nil {
+		logger.Warn("Start upgrade watchdog of agent [%s] failed, rely on the agent to roll back: %+v", exe, err)
+	}
+
+	logger.Info("Agent binary [%s] upgraded, restart in %v", exe, restartDelay)
+	go func() {
+		time.Sleep(restartDelay)
+		if err := restartAgent(exe); err != nil {
+			logger.Critical("Restart agent [%s] failed: %+v", exe, err)
+		}
+	}()
+
+	return nil
+}
+
+// Check must be called when the agent starts, it rolls back at once if
+// the deadline has passed, otherwise rolls back when the deadline comes.
+func Check() {
+	exe, err := getExecutable()
+	if err != nil {
+		logger.Warn("%+v", err)
+		return
+	}
+
+	m, ok := readMarker(exe)
+	if !ok {
+		return
+	}
+
+	// the agent may be restarted by the supervisor with another pid
+	if m.Pid != os.Getpid() {
+		m.Pid = os.Getpid()
+		if err := writeMarker(exe, m); err != nil {
+			logger.Warn("Update agent upgrade marker failed: %+v", err)
+		}
+	}
+
+	remaining := m.Deadline.Sub(time.Now())
+	if remaining <= 0 {
+		logger.Warn("Agent upgrade not confirmed before %v, roll back", m.Deadline)
+		if rollback(exe) {
+			restartRolledBack(exe)
+		}
+		return
+	}
+
+	logger.Info("Agent upgrade waiting for confirm, roll back in %v", remaining)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	timer = time.AfterFunc(remaining, func() {
+		logger.Warn("Agent upgrade not confirmed in time, roll back")
+		if rollback(exe) {
+			restartRolledBack(exe)
+		}
+	})
+}
+
+// Confirm keeps the new binary, it is called when the agent is pinged.
+func Confirm() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if timer == nil {
+		return
+	}
+	timer.Stop()
+	timer = nil
+
+	exe, err := getExecutable()
+	if err != nil {
+		logger.Warn("%+v", err)
+		return
+	}
+
+	if m, ok := readMarker(exe); ok {
+		os.Remove(m.Backup)
+	}
+	os.Remove(exe + ".upgrade")
+
+	logger.Info("Agent upgrade confirmed")
+}
+
+// rollback restores the old binary, it reports false when there is
+// nothing to roll back, e.g. the watchdog or the agent has done it.
+func rollback(exe string) bool {
+	m, ok := readMarker(exe)
+	if !ok {
+		return false
+	}
+
+	if err := os.Rename(m.Backup, exe); err != nil {
+		if !os.IsNotExist(err) {
+			logger.Critical("Roll back agent [%s] failed: %+v", exe, err)
+		}
+		return false
+	}
+	os.Remove(exe + ".upgrade")
+
+	logger.Warn("Agent [%s] rolled back", exe)
+	return true
+}
+
+func restartRolledBack(exe string) {
+	if err := restartAgent(exe); err != nil {
+		logger.Critical("Restart agent [%s] failed: %+v", exe, err)
+	}
+}
+
+// watch waits until the upgrade of the agent is confirmed or the deadline
+// plus watchdogGrace is passed. It rolls back and kills the unconfirmed
+// agent in the later case, and reports whether the old agent should be
+// restarted.
+func watch(exe string, interval time.Duration) bool {
+	for {
+		m, ok := readMarker(exe)
+		if !ok {
+			// confirmed, or rolled back by the agent itself
+			return false
+		}
+
+		if time.Now().After(m.Deadline.Add(watchdogGrace)) {
+			logger.Warn("Agent upgrade not confirmed before %v, watchdog roll back", m.Deadline)
+			if !rollback(exe) {
+				return false
+			}
+			if m.Pid > 0 && m.Pid != os.Getpid() {
+				if err := killAgent(m.Pid); err != nil {
+					logger.Warn("Kill unconfirmed agent [%d] failed: %+v", m.Pid, err)
+				}
+			}
+			return true
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// isSupervised reports whether the agent is restarted by a supervisor
+// after it is killed, so the watchdog must not start another one.
+func isSupervised() bool {
+	if v := os.Getenv(supervisedEnv); v != "" {
+		supervised, err := strconv.ParseBool(v)
+		if err != nil {
+			logger.Warn("Invalid %s [%s]: %+v", supervisedEnv, v, err)
+		}
+		return supervised
+	}
+	// INVOCATION_ID is set by systemd, SUPERVISOR_ENABLED by supervisord
+	return os.Getenv("INVOCATION_ID") != "" || os.Getenv("SUPERVISOR_ENABLED") != ""
+}
+
+// preflight runs the new binary once, it exits at once in preflight mode.
+func preflight(binaryPath string) error {
+	cmd := exec.Command(binaryPath)
+	cmd.Env = append(os.Environ(), preflightEnv+"=1")
+
+	out, err := runWithTimeout(cmd, preflightTimeout)
+	if err != nil {
+		return fmt.Errorf("upgrader: %q failed to start: %v: %s", binaryPath, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func runWithTimeout(cmd *exec.Cmd, timeout time.Duration) ([]byte, error) {
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err := <-done:
+		return out.Bytes(), err
+	case <-time.After(timeout):
+		cmd.Process.Kill()
+		<-done
+		return out.Bytes(), fmt.Errorf("timeout after %v", timeout)
+	}
+}
+
+func writeMarker(exe string, m *marker) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(exe+".upgrade", data, 0644)
+}
+
+func readMarker(exe string) (*marker, bool) {
+	data, err := ioutil.ReadFile(exe + ".upgrade")
+	if err != nil {
+		return nil, false
+	}
+
+	var m marker
+	if err := json.Unmarshal(data, &m); err != nil {
+		logger.Warn("Invalid agent upgrade marker: %+v", err)
+		return nil, false
+	}
+	return &m, true
+}
+
+func executable() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(exe)
+}
+
+func checkSha256(path, sum string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(got, sum) {
+		return fmt.Errorf("upgrader: %q checksum mismatch, got %s, expect %s", path, got, sum)
+	}
+	return nil
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
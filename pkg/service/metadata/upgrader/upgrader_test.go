@@ -0,0 +1,335 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// +build !windows
+
+package upgrader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type tAgent struct {
+	dir       string
+	exe       string
+	restarted chan string
+	watchdogs chan string
+	killed    chan int
+}
+
+// tNewAgent installs a fake agent binary in a temp dir and hooks the
+// restart of the agent, the returned func restores the hooks.
+func tNewAgent(t *testing.T) (*tAgent, func()) {
+	dir, err := ioutil.TempDir("", "upgrader")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &tAgent{
+		dir:       dir,
+		exe:       filepath.Join(dir, "agent"),
+		restarted: make(chan string, 10),
+		watchdogs: make(chan string, 10),
+		killed:    make(chan int, 10),
+	}
+	tWriteBinary(t, a.exe, "exit 0 # old")
+
+	getExecutable = func() (string, error) { return a.exe, nil }
+	restartAgent = func(exe string) error { a.restarted <- exe; return nil }
+	startWatchdog = func(binary, exe string) error { a.watchdogs <- binary; return nil }
+	killAgent = func(pid int) error { a.killed <- pid; return nil }
+
+	return a, func() {
+		getExecutable = executable
+		restartAgent = restart
+		startWatchdog = spawnWatchdog
+		killAgent = kill
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func tWriteBinary(t *testing.T, path, script string) string {
+	data := []byte("#!/bin/sh\n" + script + "\n")
+	if err := ioutil.WriteFile(path, data, 0755); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func tReadFile(t *testing.T, path string) string {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func tExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func tWriteMarker(t *testing.T, a *tAgent, deadline time.Time, pid int) {
+	if err := os.Rename(a.exe, a.exe+".bak"); err != nil {
+		t.Fatal(err)
+	}
+	tWriteBinary(t, a.exe, "exit 0 # new")
+	if err := writeMarker(a.exe, &marker{Backup: a.exe + ".bak", Deadline: deadline, Pid: pid}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	a, done := tNewAgent(t)
+	defer done()
+
+	binary := filepath.Join(a.dir, "agent-new")
+	sum := tWriteBinary(t, binary, "exit 0 # new")
+
+	if err := Upgrade(binary, sum, time.Minute); err != nil {
+		t.Fatalf("Upgrade failed: %+v", err)
+	}
+
+	if got := tReadFile(t, a.exe); got != tReadFile(t, binary) {
+		t.Fatalf("Agent binary not replaced, got %q", got)
+	}
+	if got := tReadFile(t, a.exe+".bak"); got != "#!/bin/sh\nexit 0 # old\n" {
+		t.Fatalf("Agent binary not backed up, got %q", got)
+	}
+	m, ok := readMarker(a.exe)
+	if !ok {
+		t.Fatalf("Upgrade marker not written")
+	}
+	if m.Pid != os.Getpid() || m.Deadline.Before(time.Now()) {
+		t.Fatalf("Unexpected upgrade marker %+v", m)
+	}
+
+	if got := <-a.watchdogs; got != a.exe+".bak" {
+		t.Fatalf("Watchdog should run the old binary, got [%s]", got)
+	}
+	select {
+	case <-a.restarted:
+	case <-time.After(restartDelay + 3*time.Second):
+		t.Fatalf("Agent not restarted")
+	}
+}
+
+func TestUpgradeRejected(t *testing.T) {
+	a, done := tNewAgent(t)
+	defer done()
+
+	broken := filepath.Join(a.dir, "agent-broken")
+	brokenSum := tWriteBinary(t, broken, "echo broken; exit 1")
+
+	for _, tc := range []struct {
+		name, binary, sum string
+	}{
+		{name: "checksum mismatch", binary: broken, sum: "0000"},
+		{name: "preflight failed", binary: broken, sum: brokenSum},
+	} {
+		if err := Upgrade(tc.binary, tc.sum, time.Minute); err == nil {
+			t.Fatalf("%s: Upgrade should fail", tc.name)
+		}
+		if got := tReadFile(t, a.exe); got != "#!/bin/sh\nexit 0 # old\n" {
+			t.Fatalf("%s: Agent binary should be kept, got %q", tc.name, got)
+		}
+		for _, suffix := range []string{".new", ".bak", ".upgrade"} {
+			if tExists(a.exe + suffix) {
+				t.Fatalf("%s: [%s] should not be left", tc.name, a.exe+suffix)
+			}
+		}
+	}
+}
+
+func TestCheckExpired(t *testing.T) {
+	a, done := tNewAgent(t)
+	defer done()
+
+	tWriteMarker(t, a, time.Now().Add(-time.Second), os.Getpid())
+	Check()
+
+	if got := tReadFile(t, a.exe); got != "#!/bin/sh\nexit 0 # old\n" {
+		t.Fatalf("Agent binary not rolled back, got %q", got)
+	}
+	if tExists(a.exe + ".upgrade") {
+		t.Fatalf("Upgrade marker not removed")
+	}
+	if got := <-a.restarted; got != a.exe {
+		t.Fatalf("Restarted [%s], expect [%s]", got, a.exe)
+	}
+}
+
+func TestCheckTimeout(t *testing.T) {
+	a, done := tNewAgent(t)
+	defer done()
+
+	tWriteMarker(t, a, time.Now().Add(100*time.Millisecond), 1)
+	Check()
+
+	// Check takes over the marker from the pid before restart
+	if m, _ := readMarker(a.exe); m == nil || m.Pid != os.Getpid() {
+		t.Fatalf("Upgrade marker pid not updated: %+v", m)
+	}
+
+	select {
+	case <-a.restarted:
+	case <-time.After(3 * time.Second):
+		t.Fatalf("Agent not rolled back in time")
+	}
+	if got := tReadFile(t, a.exe); got != "#!/bin/sh\nexit 0 # old\n" {
+		t.Fatalf("Agent binary not rolled back, got %q", got)
+	}
+}
+
+func TestCheckConfirm(t *testing.T) {
+	a, done := tNewAgent(t)
+	defer done()
+
+	tWriteMarker(t, a, time.Now().Add(time.Minute), os.Getpid())
+	Check()
+	Confirm()
+
+	if got := tReadFile(t, a.exe); got != "#!/bin/sh\nexit 0 # new\n" {
+		t.Fatalf("Agent binary should be kept, got %q", got)
+	}
+	if tExists(a.exe+".bak") || tExists(a.exe+".upgrade") {
+		t.Fatalf("Backup and marker should be removed after confirm")
+	}
+	select {
+	case exe := <-a.restarted:
+		t.Fatalf("Agent [%s] should not be restarted", exe)
+	default:
+	}
+}
+
+func TestWatch(t *testing.T) {
+	a, done := tNewAgent(t)
+	defer done()
+
+	// confirmed by the agent
+	tWriteMarker(t, a, time.Now().Add(time.Minute), 1)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		os.Remove(a.exe + ".upgrade")
+	}()
+	if watch(a.exe, 10*time.Millisecond) {
+		t.Fatalf("Confirmed agent should not be restarted")
+	}
+
+	// not confirmed after the grace period
+	tWriteBinary(t, a.exe, "exit 0 # old")
+	tWriteMarker(t, a, time.Now().Add(-watchdogGrace), 12345)
+	if !watch(a.exe, 10*time.Millisecond) {
+		t.Fatalf("Unconfirmed agent should be restarted")
+	}
+	if got := tReadFile(t, a.exe); got != "#!/bin/sh\nexit 0 # old\n" {
+		t.Fatalf("Agent binary not rolled back, got %q", got)
+	}
+	if pid := <-a.killed; pid != 12345 {
+		t.Fatalf("Killed [%d], expect the unconfirmed agent", pid)
+	}
+}
+
+func TestWatchdogProcess(t *testing.T) {
+	a, done := tNewAgent(t)
+	defer done()
+
+	// the unconfirmed agent which hangs
+	hung := exec.Command("sleep", "60")
+	if err := hung.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer hung.Process.Kill()
+
+	restarted := filepath.Join(a.dir, "restarted")
+	tWriteBinary(t, a.exe, "echo ok > "+restarted)
+	if err := os.Rename(a.exe, a.exe+".bak"); err != nil {
+		t.Fatal(err)
+	}
+	tWriteBinary(t, a.exe, "exit 0 # new")
+	err := writeMarker(a.exe, &marker{
+		Backup:   a.exe + ".bak",
+		Deadline: time.Now().Add(-watchdogGrace),
+		Pid:      hung.Process.Pid,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the test binary runs as the watchdog, like the old agent binary does
+	tRunWatchdog(t, a, false)
+
+	if err := hung.Wait(); err == nil {
+		t.Fatalf("Unconfirmed agent should be killed")
+	}
+	if got := tReadFile(t, restarted); got != "ok\n" {
+		t.Fatalf("Old agent binary not restarted, got %q", got)
+	}
+	if tExists(a.exe + ".upgrade") {
+		t.Fatalf("Upgrade marker not removed")
+	}
+}
+
+func TestWatchdogProcessSupervised(t *testing.T) {
+	a, done := tNewAgent(t)
+	defer done()
+
+	hung := exec.Command("sleep", "60")
+	if err := hung.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer hung.Process.Kill()
+
+	restarted := filepath.Join(a.dir, "restarted")
+	tWriteBinary(t, a.exe, "echo ok > "+restarted)
+	if err := os.Rename(a.exe, a.exe+".bak"); err != nil {
+		t.Fatal(err)
+	}
+	tWriteBinary(t, a.exe, "exit 0 # new")
+	err := writeMarker(a.exe, &marker{
+		Backup:   a.exe + ".bak",
+		Deadline: time.Now().Add(-watchdogGrace),
+		Pid:      hung.Process.Pid,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the supervisor restarts the rolled back agent, not the watchdog
+	tRunWatchdog(t, a, true)
+
+	if err := hung.Wait(); err == nil {
+		t.Fatalf("Unconfirmed agent should be killed")
+	}
+	if tExists(restarted) {
+		t.Fatalf("Supervised agent should not be restarted by the watchdog")
+	}
+	if got := tReadFile(t, a.exe); got != "#!/bin/sh\necho ok > "+restarted+"\n" {
+		t.Fatalf("Agent binary not rolled back, got %q", got)
+	}
+}
+
+func tRunWatchdog(t *testing.T, a *tAgent, supervised bool) {
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	cmd.Env = append(os.Environ(),
+		watchdogEnv+"="+a.exe,
+		supervisedEnv+"="+strconv.FormatBool(supervised),
+	)
+	if out, err := runWithTimeout(cmd, 10*time.Second); err != nil {
+		t.Fatalf("Watchdog failed: %+v: %s", err, out)
+	}
+}
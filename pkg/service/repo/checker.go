@@ -22,13 +22,13 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 	case *pb.CreateRepoRequest:
 		return manager.NewChecker(ctx, r).
 			Required("type", "name", "url", "credential", "visibility", "providers").
-			StringChosen("providers", p.GlobalConfig().Cluster.Plugins).
+			StringChosen("providers", p.GlobalConfig().Cluster.GetPluginNames()).
 			StringChosen("visibility", SupportedVisibility).
 			Exec()
 	case *pb.ModifyRepoRequest:
 		return manager.NewChecker(ctx, r).
 			Required("repo_id").
-			StringChosen("providers", p.GlobalConfig().Cluster.Plugins).
+			StringChosen("providers", p.GlobalConfig().Cluster.GetPluginNames()).
 			StringChosen("visibility", SupportedVisibility).
 			Exec()
 	case *pb.DeleteReposRequest:
@@ -0,0 +1,92 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"context"
+
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/secret"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// rewrapRepoCredentials wraps the data keys of the repo credentials by the
+// current master key, the plaintext credentials are encrypted. Only the
+// plaintext credentials are changed if plaintextOnly is true.
+func (p *Server) rewrapRepoCredentials(plaintextOnly bool) (uint32, error) {
+	var repos []*models.Repo
+	_, err := p.Db.
+		Select(models.RepoColumns...).
+		From(models.RepoTableName).
+		Load(&repos)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint32
+	for _, repo := range repos {
+		if plaintextOnly && secret.IsEncrypted(repo.Credential) {
+			continue
+		}
+		credential, changed, err := secret.Rewrap(repo.Credential)
+		if err != nil {
+			return count, err
+		}
+		if !changed {
+			continue
+		}
+		_, err = p.Db.
+			Update(models.RepoTableName).
+			Set(models.ColumnCredential, credential).
+			Where(db.Eq(models.ColumnRepoId, repo.RepoId)).
+			Exec()
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// encryptRepoCredentials encrypts the credentials written before the master
+// key is configured.
+func (p *Server) encryptRepoCredentials() {
+	if secret.CurrentKeyId() == "" {
+		return
+	}
+	count, err := p.rewrapRepoCredentials(true)
+	if err != nil {
+		logger.Error("Failed to encrypt repo credentials: %+v", err)
+		return
+	}
+	if count > 0 {
+		logger.Info("Encrypted [%d] repo credentials", count)
+	}
+}
+
+func (p *Server) RotateRepoEncryptionKey(ctx context.Context, req *pb.RotateRepoEncryptionKeyRequest) (*pb.RotateRepoEncryptionKeyResponse, error) {
+	keyId := secret.CurrentKeyId()
+	if keyId == "" {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorEncryptionNotEnabled)
+	}
+	if req.GetKeyId() != nil && req.GetKeyId().GetValue() != keyId {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorMasterKeyMismatch, keyId, req.GetKeyId().GetValue())
+	}
+
+	count, err := p.rewrapRepoCredentials(false)
+	if err != nil {
+		logger.Error("Failed to rotate encryption key of repo credentials: %+v", err)
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+	}
+
+	return &pb.RotateRepoEncryptionKeyResponse{
+		KeyId:     pbutil.ToProtoString(keyId),
+		RepoCount: count,
+	}, nil
+}
@@ -12,11 +12,13 @@ import (
 	indexerclient "openpitrix.io/openpitrix/pkg/client/repo_indexer"
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/devkit"
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/secret"
 	"openpitrix.io/openpitrix/pkg/service/category/categoryutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
 	"openpitrix.io/openpitrix/pkg/util/senderutil"
@@ -96,6 +98,10 @@ func (p *Server) CreateRepo(ctx context.Context, req *pb.CreateRepoRequest) (*pb
 	visibility := req.GetVisibility().GetValue()
 	providers := req.GetProviders()
 
+	// the credentials described are encrypted, they can not be saved again
+	if secret.IsEncrypted(credential) {
+		return nil, gerr.New(gerr.InvalidArgument, gerr.ErrorEncryptedCredential)
+	}
 	err := validate(repoType, url, credential, providers)
 	if err != nil {
 		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorValidateFailed)
@@ -107,15 +113,31 @@ func (p *Server) CreateRepo(ctx context.Context, req *pb.CreateRepoRequest) (*pb
 		return nil, err
 	}
 
+	trustedKeys := req.GetTrustedKeys().GetValue()
+	_, err = devkit.ParsePublicKeys(trustedKeys)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorParameterParseFailed, "trusted_keys")
+	}
+	_, err = models.PbToSelector(req.GetSelectors())
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorParameterParseFailed, "selectors")
+	}
+
+	encryptedCredential, err := secret.Encrypt(credential)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+
 	s := senderutil.GetSenderFromContext(ctx)
 	newRepo := models.NewRepo(
 		name,
 		req.GetDescription().GetValue(),
 		repoType,
 		url,
-		credential,
+		encryptedCredential,
 		visibility,
-		s.UserId)
+		s.UserId,
+		trustedKeys)
 
 	_, err = p.Db.
 		InsertInto(models.RepoTableName).
@@ -193,6 +215,10 @@ func (p *Server) ModifyRepo(ctx context.Context, req *pb.ModifyRepoRequest) (*pb
 	}
 	if req.GetCredential() != nil {
 		credential = req.GetCredential().GetValue()
+		// the credentials described are encrypted, they can not be saved again
+		if secret.IsEncrypted(credential) {
+			return nil, gerr.New(gerr.InvalidArgument, gerr.ErrorEncryptedCredential)
+		}
 		needValidate = true
 	}
 	if req.GetVisibility() != nil {
@@ -210,10 +236,26 @@ func (p *Server) ModifyRepo(ctx context.Context, req *pb.ModifyRepoRequest) (*pb
 			return nil, err
 		}
 	}
+	if req.GetTrustedKeys() != nil {
+		_, err = devkit.ParsePublicKeys(req.GetTrustedKeys().GetValue())
+		if err != nil {
+			return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorParameterParseFailed, "trusted_keys")
+		}
+	}
+	_, err = models.PbToSelector(req.GetSelectors())
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.InvalidArgument, err, gerr.ErrorParameterParseFailed, "selectors")
+	}
 
 	attributes := manager.BuildUpdateAttributes(req,
 		models.ColumnName, models.ColumnDescription, models.ColumnType, models.ColumnUrl,
-		models.ColumnCredential, models.ColumnVisibility)
+		models.ColumnCredential, models.ColumnVisibility, models.ColumnTrustedKeys)
+	if req.GetCredential() != nil {
+		attributes[models.ColumnCredential], err = secret.Encrypt(credential)
+		if err != nil {
+			return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+		}
+	}
 	if len(attributes) > 0 {
 		_, err = p.Db.
 			Update(models.RepoTableName).
@@ -10,6 +10,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/secret"
 	"openpitrix.io/openpitrix/pkg/service/category/categoryutil"
 	"openpitrix.io/openpitrix/pkg/util/stringutil"
 )
@@ -48,6 +49,10 @@ func (p *Server) getRepo(repoId string) (*models.Repo, error) {
 	if err != nil {
 		return nil, err
 	}
+	repo.Credential, err = secret.Decrypt(repo.Credential)
+	if err != nil {
+		return nil, err
+	}
 	return repo, nil
 }
 
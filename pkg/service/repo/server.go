@@ -9,9 +9,11 @@ import (
 
 	"openpitrix.io/openpitrix/pkg/config"
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/secret"
 )
 
 type Server struct {
@@ -20,7 +22,13 @@ type Server struct {
 
 func Serve(cfg *config.Config) {
 	pi.SetGlobalPi(cfg)
+	err := secret.CheckEnabled()
+	if err != nil {
+		logger.Critical("The credentials of repos need the master keys: %+v", err)
+		panic(err)
+	}
 	s := Server{pi.Global()}
+	go s.encryptRepoCredentials()
 	manager.NewGrpcServer("repo-manager", constants.RepoManagerPort).
 		ShowErrorCause(cfg.Grpc.ShowErrorCause).
 		WithChecker(s.Checker).
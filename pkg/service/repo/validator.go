@@ -48,7 +48,7 @@ func validate(repoType, url, credential string, providers []string) error {
 	body, err := reader.GetIndexYaml()
 	if err != nil {
 		switch err {
-		case reporeader.ErrGetIndexYamlFailed:
+		case reporeader.ErrGetIndexYamlFailed, reporeader.ErrIndexYamlNotFound:
 			switch repoType {
 			case constants.TypeHttp, constants.TypeHttps:
 				errCode = ErrHttpAccessDeny
@@ -9,9 +9,11 @@ import (
 
 	"openpitrix.io/openpitrix/pkg/config"
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/secret"
 )
 
 type Server struct {
@@ -21,6 +23,11 @@ type Server struct {
 
 func Serve(cfg *config.Config) {
 	pi.SetGlobalPi(cfg)
+	err := secret.CheckEnabled()
+	if err != nil {
+		logger.Critical("The credentials of repos need the master keys: %+v", err)
+		panic(err)
+	}
 	p := pi.Global()
 	controller := NewEventController(p)
 	s := Server{Pi: p, controller: controller}
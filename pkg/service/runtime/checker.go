@@ -16,12 +16,16 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 	case *pb.CreateRuntimeRequest:
 		return manager.NewChecker(ctx, r).
 			Required("name", "provider", "runtime_url", "zone", "runtime_credential").
-			StringChosen("provider", p.GlobalConfig().Cluster.Plugins).
+			StringChosen("provider", p.GlobalConfig().Cluster.GetPluginNames()).
 			Exec()
 	case *pb.ModifyRuntimeRequest:
 		return manager.NewChecker(ctx, r).
 			Required("runtime_id").
 			Exec()
+	case *pb.ModifyRuntimePriceRequest:
+		return manager.NewChecker(ctx, r).
+			Required("runtime_id").
+			Exec()
 	case *pb.DeleteRuntimesRequest:
 		return manager.NewChecker(ctx, r).
 			Required("runtime_id").
@@ -29,7 +33,7 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 	case *pb.DescribeRuntimeProviderZonesRequest:
 		return manager.NewChecker(ctx, r).
 			Required("provider", "runtime_url", "runtime_credential").
-			StringChosen("provider", p.GlobalConfig().Cluster.Plugins).
+			StringChosen("provider", p.GlobalConfig().Cluster.GetPluginNames()).
 			Exec()
 
 	}
@@ -21,6 +21,17 @@ const (
 	LabelValueColumn  = "label_value"
 )
 
+const (
+	HealthStatusColumn    = "health_status"
+	LastErrorColumn       = "last_error"
+	ApiLatencyColumn      = "api_latency"
+	HealthCheckTimeColumn = "health_check_time"
+)
+
+// the time of the last health check and the failures of the runtimes, shared
+// by the replicas of the runtime manager
+const HealthCheckStateKey = "runtime_health_check_state"
+
 const (
 	NameMinLength       = "1"
 	NameMaxLength       = "255"
@@ -0,0 +1,109 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"context"
+
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	repoclient "openpitrix.io/openpitrix/pkg/client/repo"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/secret"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// rewrapRuntimeCredentials wraps the data keys of the runtime credentials by
+// the current master key, the plaintext credentials are encrypted. Only the
+// plaintext credentials are changed if plaintextOnly is true.
+func (p *Server) rewrapRuntimeCredentials(plaintextOnly bool) (uint32, error) {
+	var runtimeCredentials []*models.RuntimeCredential
+	_, err := p.Db.
+		Select(models.RuntimeCredentialColumns...).
+		From(models.RuntimeCredentialTableName).
+		Load(&runtimeCredentials)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint32
+	for _, runtimeCredential := range runtimeCredentials {
+		if plaintextOnly && secret.IsEncrypted(runtimeCredential.Content) {
+			continue
+		}
+		content, changed, err := secret.Rewrap(runtimeCredential.Content)
+		if err != nil {
+			return count, err
+		}
+		if !changed {
+			continue
+		}
+		_, err = p.Db.
+			Update(models.RuntimeCredentialTableName).
+			Set(RuntimeCredentialContentColumn, content).
+			Where(db.Eq(RuntimeCredentialIdColumn, runtimeCredential.RuntimeCredentialId)).
+			Exec()
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// encryptRuntimeCredentials encrypts the credentials written before the
+// master key is configured.
+func (p *Server) encryptRuntimeCredentials() {
+	if secret.CurrentKeyId() == "" {
+		return
+	}
+	count, err := p.rewrapRuntimeCredentials(true)
+	if err != nil {
+		logger.Error("Failed to encrypt runtime credentials: %+v", err)
+		return
+	}
+	if count > 0 {
+		logger.Info("Encrypted [%d] runtime credentials", count)
+	}
+}
+
+func (p *Server) RotateEncryptionKey(ctx context.Context, req *pb.RotateEncryptionKeyRequest) (*pb.RotateEncryptionKeyResponse, error) {
+	keyId := secret.CurrentKeyId()
+	if keyId == "" {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorEncryptionNotEnabled)
+	}
+	if req.GetKeyId() != nil && req.GetKeyId().GetValue() != keyId {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorMasterKeyMismatch, keyId, req.GetKeyId().GetValue())
+	}
+
+	// the repo manager refuses the rotation if its current master key is not
+	// the same, so the repo credentials are rotated first
+	ctx = clientutil.GetSystemUserContext()
+	repoManagerClient, err := repoclient.NewRepoManagerClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	repoRes, err := repoManagerClient.RotateRepoEncryptionKey(ctx, &pb.RotateRepoEncryptionKeyRequest{
+		KeyId: pbutil.ToProtoString(keyId),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeCredentialCount, err := p.rewrapRuntimeCredentials(false)
+	if err != nil {
+		logger.Error("Failed to rotate encryption key of runtime credentials: %+v", err)
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+	}
+
+	return &pb.RotateEncryptionKeyResponse{
+		KeyId:                  pbutil.ToProtoString(keyId),
+		RuntimeCredentialCount: runtimeCredentialCount,
+		RepoCount:              repoRes.GetRepoCount(),
+	}, nil
+}
@@ -92,6 +92,13 @@ func (p *Server) DescribeRuntimes(ctx context.Context, req *pb.DescribeRuntimesR
 
 	query = manager.AddQueryJoinWithMap(query, models.RuntimeTableName, models.RuntimeLabelTableName, RuntimeIdColumn,
 		models.ColumnLabelKey, models.ColumnLabelValue, selectorMap)
+	if req.GetRepoId() != nil {
+		runtimeIds, err := p.getRuntimeIdsByRepo(req.GetRepoId().GetValue())
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(db.Eq(models.RuntimeTableName+"."+RuntimeIdColumn, runtimeIds))
+	}
 	query = manager.AddQueryOrderDir(query, req, models.ColumnCreateTime)
 	_, err = query.Load(&runtimes)
 	if err != nil {
@@ -141,6 +148,13 @@ func (p *Server) DescribeRuntimeDetails(ctx context.Context, req *pb.DescribeRun
 
 	query = manager.AddQueryJoinWithMap(query, models.RuntimeTableName, models.RuntimeLabelTableName, RuntimeIdColumn,
 		models.ColumnLabelKey, models.ColumnLabelValue, selectorMap)
+	if req.GetRepoId() != nil {
+		runtimeIds, err := p.getRuntimeIdsByRepo(req.GetRepoId().GetValue())
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(db.Eq(models.RuntimeTableName+"."+RuntimeIdColumn, runtimeIds))
+	}
 	query = manager.AddQueryOrderDir(query, req, models.ColumnCreateTime)
 	_, err = query.Load(&runtimes)
 	if err != nil {
@@ -187,6 +201,14 @@ func (p *Server) ModifyRuntime(ctx context.Context, req *pb.ModifyRuntimeRequest
 	if err != nil {
 		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
 	}
+	// the credential and zone of runtime are not modifiable, the owner fixed
+	// the unhealthy runtime on the cloud can reset the health by modifying it
+	if runtime.HealthStatus == constants.HealthStatusUnhealthy {
+		err = p.resetRuntimeHealth(runtime)
+		if err != nil {
+			return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+		}
+	}
 
 	// update runtime label
 	if req.Labels != nil {
@@ -0,0 +1,238 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/plugins"
+	"openpitrix.io/openpitrix/pkg/topic"
+)
+
+var (
+	healthCheckTimeout     = constants.RuntimeHealthCheckTimeout
+	healthCheckConcurrency = constants.RuntimeHealthCheckConcurrency
+	healthFailureThreshold = constants.RuntimeHealthFailureThreshold
+)
+
+// probingRuntimes holds the runtimes whose provider is not returned from the
+// last probe, they are not probed again until it returns, so the goroutines
+// waiting for a provider never responding do not pile up.
+var probingRuntimes sync.Map
+
+// probeRuntime checks the credential and lists the zones of the runtime through
+// the provider, the latency of the provider api is returned.
+func probeRuntime(runtime *models.Runtime, credential string) (time.Duration, error) {
+	providerInterface, err := plugins.GetProviderPlugin(runtime.Provider, nil)
+	if err != nil {
+		return 0, err
+	}
+	credential = RuntimeCredentialJsonStringToString(runtime.Provider, credential)
+
+	if _, probing := probingRuntimes.LoadOrStore(runtime.RuntimeId, true); probing {
+		return healthCheckTimeout, fmt.Errorf("provider [%s] not responded to the last probe", runtime.Provider)
+	}
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer probingRuntimes.Delete(runtime.RuntimeId)
+		err := providerInterface.ValidateCredential(runtime.RuntimeUrl, credential, runtime.Zone)
+		if err == nil {
+			_, err = providerInterface.DescribeRuntimeProviderZones(runtime.RuntimeUrl, credential)
+		}
+		done <- err
+	}()
+	select {
+	case err = <-done:
+		return time.Since(start), err
+	case <-time.After(healthCheckTimeout):
+		return healthCheckTimeout, fmt.Errorf("provider [%s] not responded in [%s]", runtime.Provider, healthCheckTimeout)
+	}
+}
+
+// getHealth returns the health status and the error of the runtime after the
+// probe, the runtime is unhealthy only after the consecutive failures reach
+// the threshold, otherwise the status is kept.
+func getHealth(runtime *models.Runtime, probeErr error, failures int) (string, string) {
+	if probeErr == nil {
+		return constants.HealthStatusHealthy, ""
+	}
+	if failures < healthFailureThreshold {
+		return runtime.HealthStatus, runtime.LastError
+	}
+	return constants.HealthStatusUnhealthy, probeErr.Error()
+}
+
+type healthCheckState struct {
+	CheckTime time.Time      `json:"check_time"`
+	Failures  map[string]int `json:"failures"`
+}
+
+func (p *Server) getHealthCheckState(ctx context.Context) (*healthCheckState, error) {
+	state := &healthCheckState{Failures: make(map[string]int)}
+	resp, err := p.Etcd.Get(ctx, HealthCheckStateKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return state, nil
+	}
+	err = json.Unmarshal(resp.Kvs[0].Value, state)
+	if err != nil {
+		logger.Warn("Failed to decode health check state: %+v", err)
+		return &healthCheckState{Failures: make(map[string]int)}, nil
+	}
+	return state, nil
+}
+
+func (p *Server) putHealthCheckState(ctx context.Context, state *healthCheckState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	_, err = p.Etcd.Put(ctx, HealthCheckStateKey, string(data))
+	return err
+}
+
+// checkRuntimesHealth checks the runtimes in every interval, the replicas of
+// the runtime manager take the lock in turn, and only the first one in the
+// interval checks the runtimes.
+func (p *Server) checkRuntimesHealth() {
+	for {
+		err := p.Etcd.Dlock(context.Background(), constants.RuntimeHealthCheckKey, p.checkAllRuntimesHealth)
+		if err != nil {
+			logger.Error("Failed to check health of runtimes: %+v", err)
+		}
+		time.Sleep(constants.RuntimeHealthCheckInterval)
+	}
+}
+
+func (p *Server) checkAllRuntimesHealth() error {
+	ctx := context.Background()
+	state, err := p.getHealthCheckState(ctx)
+	if err != nil {
+		return err
+	}
+	checkTime := time.Now()
+	if checkTime.Sub(state.CheckTime) < constants.RuntimeHealthCheckInterval {
+		logger.Debug("Runtimes are checked at [%s]", state.CheckTime)
+		return nil
+	}
+
+	var runtimes []*models.Runtime
+	_, err = p.Db.
+		Select(models.RuntimeColumns...).
+		From(models.RuntimeTableName).
+		Where(db.Neq(StatusColumn, constants.StatusDeleted)).
+		Load(&runtimes)
+	if err != nil {
+		return err
+	}
+	var credentialIds []string
+	for _, runtime := range runtimes {
+		credentialIds = append(credentialIds, runtime.RuntimeCredentialId)
+	}
+	credentialMap, err := p.getCredentialMap(credentialIds...)
+	if err != nil {
+		return err
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	failures := make(map[string]int)
+	sem := make(chan struct{}, healthCheckConcurrency)
+	for _, runtime := range runtimes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(runtime *models.Runtime) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			var latency time.Duration
+			var err error
+			runtimeCredential, ok := credentialMap[runtime.RuntimeCredentialId]
+			if ok {
+				latency, err = probeRuntime(runtime, runtimeCredential.Content)
+			} else {
+				err = fmt.Errorf("runtime credential [%s] not found", runtime.RuntimeCredentialId)
+			}
+			// the failures are counted again after the health is reset
+			failure := 0
+			if err != nil {
+				if runtime.HealthCheckTime != nil {
+					failure = state.Failures[runtime.RuntimeId]
+				}
+				failure++
+				mutex.Lock()
+				failures[runtime.RuntimeId] = failure
+				mutex.Unlock()
+			}
+			err = p.updateRuntimeHealth(runtime, latency, err, failure)
+			if err != nil {
+				logger.Error("Failed to update health of runtime [%s]: %+v", runtime.RuntimeId, err)
+			}
+		}(runtime)
+	}
+	wg.Wait()
+
+	return p.putHealthCheckState(ctx, &healthCheckState{CheckTime: checkTime, Failures: failures})
+}
+
+// updateRuntimeHealth saves the result of the probe, the owner of the runtime
+// is notified when the health status or the error is changed.
+func (p *Server) updateRuntimeHealth(runtime *models.Runtime, latency time.Duration, probeErr error, failures int) error {
+	healthStatus, lastError := getHealth(runtime, probeErr, failures)
+	if probeErr != nil {
+		logger.Warn("Failed to probe runtime [%s] [%d/%d]: %+v", runtime.RuntimeId, failures, healthFailureThreshold, probeErr)
+	}
+
+	_, err := p.Db.
+		Update(models.RuntimeTableName).
+		Set(HealthStatusColumn, healthStatus).
+		Set(LastErrorColumn, lastError).
+		Set(ApiLatencyColumn, uint32(latency/time.Millisecond)).
+		Set(HealthCheckTimeColumn, time.Now()).
+		Where(db.Eq(RuntimeIdColumn, runtime.RuntimeId)).
+		Exec()
+	if err != nil {
+		return err
+	}
+	return p.pushRuntimeHealth(runtime, healthStatus, lastError)
+}
+
+// resetRuntimeHealth sets the health of the runtime to unknown, the runtime is
+// usable again until it fails the probes of the next checks.
+func (p *Server) resetRuntimeHealth(runtime *models.Runtime) error {
+	_, err := p.Db.
+		Update(models.RuntimeTableName).
+		Set(HealthStatusColumn, constants.HealthStatusUnknown).
+		Set(LastErrorColumn, "").
+		Set(HealthCheckTimeColumn, nil).
+		Where(db.Eq(RuntimeIdColumn, runtime.RuntimeId)).
+		Exec()
+	if err != nil {
+		return err
+	}
+	return p.pushRuntimeHealth(runtime, constants.HealthStatusUnknown, "")
+}
+
+func (p *Server) pushRuntimeHealth(runtime *models.Runtime, healthStatus, lastError string) error {
+	if healthStatus == runtime.HealthStatus && lastError == runtime.LastError {
+		return nil
+	}
+	return topic.PushEvent(p.Etcd, runtime.Owner, topic.Update,
+		topic.NewResource(models.RuntimeTableName, runtime.RuntimeId).
+			WithValue(HealthStatusColumn, healthStatus).
+			WithValue(LastErrorColumn, lastError))
+}
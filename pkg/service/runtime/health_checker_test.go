@@ -0,0 +1,106 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/plugins"
+)
+
+const testHealthProvider = "health-test"
+
+type healthTestProvider struct {
+	plugins.ProviderInterface
+}
+
+func (p *healthTestProvider) ValidateCredential(url, credential, zone string) error {
+	if credential != "valid" {
+		return fmt.Errorf("invalid credential")
+	}
+	return nil
+}
+
+func (p *healthTestProvider) DescribeRuntimeProviderZones(url, credential string) ([]string, error) {
+	if url == "slow" {
+		time.Sleep(time.Second)
+	}
+	return []string{"zone"}, nil
+}
+
+func init() {
+	plugins.Register(testHealthProvider, func(l *logger.Logger) plugins.ProviderInterface {
+		return new(healthTestProvider)
+	})
+}
+
+func TestProbeRuntime(t *testing.T) {
+	healthCheckTimeout = 100 * time.Millisecond
+
+	runtime := &models.Runtime{Provider: testHealthProvider, Zone: "zone"}
+	_, err := probeRuntime(runtime, "valid")
+	if err != nil {
+		t.Fatalf("probe healthy runtime failed: %+v", err)
+	}
+
+	_, err = probeRuntime(runtime, "invalid")
+	if err == nil {
+		t.Fatal("probe runtime with invalid credential should fail")
+	}
+
+	runtime.RuntimeUrl = "slow"
+	latency, err := probeRuntime(runtime, "valid")
+	if err == nil {
+		t.Fatal("probe slow runtime should time out")
+	}
+	if latency != healthCheckTimeout {
+		t.Fatalf("latency of slow runtime should be [%s], got [%s]", healthCheckTimeout, latency)
+	}
+
+	_, err = probeRuntime(runtime, "valid")
+	if err == nil {
+		t.Fatal("probe runtime not returned from the last probe should fail")
+	}
+	time.Sleep(time.Second)
+	_, err = probeRuntime(runtime, "invalid")
+	if err == nil || err.Error() != "invalid credential" {
+		t.Fatalf("runtime should be probed after the last probe returned, got %+v", err)
+	}
+
+	runtime.Provider = "not-exist"
+	_, err = probeRuntime(runtime, "valid")
+	if err == nil {
+		t.Fatal("probe runtime with unknown provider should fail")
+	}
+}
+
+func TestGetHealth(t *testing.T) {
+	probeErr := fmt.Errorf("probe failed")
+	for _, c := range []struct {
+		status       string
+		lastError    string
+		probeErr     error
+		failures     int
+		expectStatus string
+		expectError  string
+	}{
+		{constants.HealthStatusUnhealthy, "old", nil, 0, constants.HealthStatusHealthy, ""},
+		{constants.HealthStatusHealthy, "", probeErr, 1, constants.HealthStatusHealthy, ""},
+		{constants.HealthStatusUnknown, "", probeErr, healthFailureThreshold - 1, constants.HealthStatusUnknown, ""},
+		{constants.HealthStatusHealthy, "", probeErr, healthFailureThreshold, constants.HealthStatusUnhealthy, "probe failed"},
+		{constants.HealthStatusUnhealthy, "old", probeErr, healthFailureThreshold + 1, constants.HealthStatusUnhealthy, "probe failed"},
+	} {
+		runtime := &models.Runtime{HealthStatus: c.status, LastError: c.lastError}
+		status, lastError := getHealth(runtime, c.probeErr, c.failures)
+		if status != c.expectStatus || lastError != c.expectError {
+			t.Fatalf("health of %+v should be [%s] [%s], got [%s] [%s]", c, c.expectStatus, c.expectError, status, lastError)
+		}
+	}
+}
@@ -12,7 +12,6 @@ import (
 
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/models"
-	"openpitrix.io/openpitrix/pkg/util/stringutil"
 )
 
 func LabelStringToMap(labelString string) (map[string]string, error) {
@@ -73,9 +72,6 @@ func LabelStructToMap(labelStructs []*models.RuntimeLabel) map[string]string {
 }
 
 func RuntimeCredentialStringToJsonString(provider, content string) string {
-	if i := stringutil.FindString(constants.VmBaseProviders, provider); i != -1 {
-		return content
-	}
 	if constants.ProviderKubernetes == provider {
 		content, err := yaml.YAMLToJSON([]byte(content))
 		if err != nil {
@@ -83,13 +79,10 @@ func RuntimeCredentialStringToJsonString(provider, content string) string {
 		}
 		return string(content)
 	}
-	panic("unsupport provider")
+	return content
 }
 
 func RuntimeCredentialJsonStringToString(provider, content string) string {
-	if i := stringutil.FindString(constants.VmBaseProviders, provider); i != -1 {
-		return content
-	}
 	if constants.ProviderKubernetes == provider {
 		content, err := yaml.JSONToYAML([]byte(content))
 		if err != nil {
@@ -97,5 +90,5 @@ func RuntimeCredentialJsonStringToString(provider, content string) string {
 		}
 		return string(content)
 	}
-	panic("unsupport provider")
+	return content
 }
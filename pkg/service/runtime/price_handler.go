@@ -0,0 +1,153 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/senderutil"
+)
+
+func (p *Server) getRuntimePrice(runtimeId string) (*models.RuntimePrice, error) {
+	runtimePrice := &models.RuntimePrice{}
+	err := p.Db.
+		Select(models.RuntimePriceColumns...).
+		From(models.RuntimePriceTableName).
+		Where(db.Eq(RuntimeIdColumn, runtimeId)).
+		LoadOne(runtimePrice)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return runtimePrice, nil
+}
+
+func validateModifyRuntimePriceRequest(req *pb.ModifyRuntimePriceRequest) error {
+	prices := map[string]float64{
+		"cpu_hour":        req.GetCpuHour().GetValue(),
+		"memory_gb_hour":  req.GetMemoryGbHour().GetValue(),
+		"volume_gb_month": req.GetVolumeGbMonth().GetValue(),
+		"gpu_hour":        req.GetGpuHour().GetValue(),
+	}
+	for instanceClass, price := range req.GetInstanceClassHour() {
+		prices["instance_class_hour."+instanceClass] = price
+	}
+	for name, price := range prices {
+		if price < 0 {
+			return gerr.New(gerr.InvalidArgument, gerr.ErrorIllegalPrice, name)
+		}
+	}
+	return nil
+}
+
+// only the owner of the runtime and the system user can modify its price
+func checkRuntimePricePermission(s *senderutil.Info, runtime *models.Runtime) bool {
+	if s == nil {
+		return false
+	}
+	return s.UserId == runtime.Owner || s.UserId == senderutil.GetSystemUser().UserId
+}
+
+func (p *Server) ModifyRuntimePrice(ctx context.Context, req *pb.ModifyRuntimePriceRequest) (*pb.ModifyRuntimePriceResponse, error) {
+	s := senderutil.GetSenderFromContext(ctx)
+	err := validateModifyRuntimePriceRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	runtimeId := req.GetRuntimeId().GetValue()
+	runtime, err := p.getRuntime(runtimeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.FailedPrecondition, err, gerr.ErrorResourceNotFound, runtimeId)
+	}
+	if runtime.Status == constants.StatusDeleted {
+		return nil, gerr.New(gerr.FailedPrecondition, gerr.ErrorResourceAlreadyDeleted, runtimeId)
+	}
+	if !checkRuntimePricePermission(s, runtime) {
+		return nil, gerr.New(gerr.PermissionDenied, gerr.ErrorPermissionDenied, runtimeId)
+	}
+
+	runtimePrice, err := p.getRuntimePrice(runtimeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+	}
+	created := runtimePrice == nil
+	if created {
+		runtimePrice = models.NewRuntimePrice(runtimeId)
+	}
+	if req.GetCurrency() != nil {
+		runtimePrice.Currency = req.GetCurrency().GetValue()
+	}
+	if req.GetCpuHour() != nil {
+		runtimePrice.CpuHour = req.GetCpuHour().GetValue()
+	}
+	if req.GetMemoryGbHour() != nil {
+		runtimePrice.MemoryGbHour = req.GetMemoryGbHour().GetValue()
+	}
+	if req.GetVolumeGbMonth() != nil {
+		runtimePrice.VolumeGbMonth = req.GetVolumeGbMonth().GetValue()
+	}
+	if req.GetGpuHour() != nil {
+		runtimePrice.GpuHour = req.GetGpuHour().GetValue()
+	}
+	if len(req.GetInstanceClassHour()) > 0 {
+		runtimePrice.SetInstanceClassHour(req.GetInstanceClassHour())
+	}
+	runtimePrice.StatusTime = time.Now()
+
+	if created {
+		_, err = p.Db.
+			InsertInto(models.RuntimePriceTableName).
+			Columns(models.RuntimePriceColumns...).
+			Record(runtimePrice).
+			Exec()
+	} else {
+		_, err = p.Db.
+			Update(models.RuntimePriceTableName).
+			SetMap(map[string]interface{}{
+				"currency":            runtimePrice.Currency,
+				"cpu_hour":            runtimePrice.CpuHour,
+				"memory_gb_hour":      runtimePrice.MemoryGbHour,
+				"volume_gb_month":     runtimePrice.VolumeGbMonth,
+				"gpu_hour":            runtimePrice.GpuHour,
+				"instance_class_hour": runtimePrice.InstanceClassHour,
+				"status_time":         runtimePrice.StatusTime,
+			}).
+			Where(db.Eq(RuntimeIdColumn, runtimeId)).
+			Exec()
+	}
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+	}
+
+	return &pb.ModifyRuntimePriceResponse{
+		RuntimeId: req.GetRuntimeId(),
+	}, nil
+}
+
+func (p *Server) DescribeRuntimePrices(ctx context.Context, req *pb.DescribeRuntimePricesRequest) (*pb.DescribeRuntimePricesResponse, error) {
+	query := p.Db.
+		Select(models.RuntimePriceColumns...).
+		From(models.RuntimePriceTableName).
+		OrderDir(RuntimeIdColumn, true)
+	if len(req.GetRuntimeId()) > 0 {
+		query = query.Where(db.Eq(RuntimeIdColumn, req.GetRuntimeId()))
+	}
+	var runtimePrices []*models.RuntimePrice
+	_, err := query.Load(&runtimePrices)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	return &pb.DescribeRuntimePricesResponse{
+		RuntimePriceSet: models.RuntimePricesToPbs(runtimePrices),
+	}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/secret"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
 )
 
@@ -41,6 +42,10 @@ func (p *Server) getRuntimeCredential(runtimeCredentialId string) (*models.Runti
 	if err != nil {
 		return nil, err
 	}
+	runtimeCredential.Content, err = secret.Decrypt(runtimeCredential.Content)
+	if err != nil {
+		return nil, err
+	}
 	return runtimeCredential, nil
 }
 
@@ -88,6 +93,12 @@ func (p *Server) getCredentialMap(credentialIds ...string) (map[string]*models.R
 	if err != nil {
 		return nil, err
 	}
+	for _, runtimeCredential := range runtimeCredneitals {
+		runtimeCredential.Content, err = secret.Decrypt(runtimeCredential.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
 	credentialMap := models.RuntimeCredentialMap(runtimeCredneitals)
 	return credentialMap, nil
 }
@@ -304,7 +315,11 @@ func (p *Server) updateRuntimeLabels(runtimeId string, labelString string) error
 func (p *Server) createRuntimeCredential(provider, content string) (
 	runtimeCredentialId string, err error) {
 
-	newRunTimeCredential := models.NewRuntimeCredential(RuntimeCredentialStringToJsonString(provider, content))
+	content, err = secret.Encrypt(RuntimeCredentialStringToJsonString(provider, content))
+	if err != nil {
+		return "", err
+	}
+	newRunTimeCredential := models.NewRuntimeCredential(content)
 	err = p.insertRuntimeCredential(*newRunTimeCredential)
 	if err != nil {
 		return "", err
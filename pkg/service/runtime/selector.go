@@ -0,0 +1,65 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	repoclient "openpitrix.io/openpitrix/pkg/client/repo"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/selectorutil"
+)
+
+func getRepoSelector(repoId string) (selectorutil.Selector, error) {
+	ctx := clientutil.GetSystemUserContext()
+	repoManagerClient, err := repoclient.NewRepoManagerClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	res, err := repoManagerClient.DescribeRepos(ctx, &pb.DescribeReposRequest{
+		RepoId: []string{repoId},
+	})
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourceFailed, repoId)
+	}
+	if len(res.RepoSet) == 0 {
+		return nil, gerr.New(gerr.NotFound, gerr.ErrorResourceNotFound, repoId)
+	}
+	selector, err := models.PbToSelector(res.RepoSet[0].GetSelectors())
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.FailedPrecondition, err, gerr.ErrorParameterParseFailed, "selectors")
+	}
+	return selector, nil
+}
+
+// getRuntimeIdsByRepo returns the ids of the runtimes whose labels match the
+// selectors of the repo.
+func (p *Server) getRuntimeIdsByRepo(repoId string) ([]string, error) {
+	selector, err := getRepoSelector(repoId)
+	if err != nil {
+		return nil, err
+	}
+	var runtimeIds []string
+	_, err = p.Db.
+		Select(RuntimeIdColumn).
+		From(models.RuntimeTableName).
+		Load(&runtimeIds)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	labelsMap, err := p.getLabelsMap(runtimeIds)
+	if err != nil {
+		return nil, gerr.NewWithDetail(gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	matchedRuntimeIds := []string{}
+	for _, runtimeId := range runtimeIds {
+		if selector.Matches(models.RuntimeLabelsToMap(labelsMap[runtimeId])) {
+			matchedRuntimeIds = append(matchedRuntimeIds, runtimeId)
+		}
+	}
+	return matchedRuntimeIds, nil
+}
@@ -9,9 +9,12 @@ import (
 
 	"openpitrix.io/openpitrix/pkg/config"
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	_ "openpitrix.io/openpitrix/pkg/plugins/builtin"
+	"openpitrix.io/openpitrix/pkg/secret"
 )
 
 type Server struct {
@@ -20,7 +23,14 @@ type Server struct {
 
 func Serve(cfg *config.Config) {
 	pi.SetGlobalPi(cfg)
+	err := secret.CheckEnabled()
+	if err != nil {
+		logger.Critical("The credentials of runtimes need the master keys: %+v", err)
+		panic(err)
+	}
 	s := Server{pi.Global()}
+	go s.encryptRuntimeCredentials()
+	go s.checkRuntimesHealth()
 	manager.NewGrpcServer("runtime-manager", constants.RuntimeManagerPort).
 		ShowErrorCause(cfg.Grpc.ShowErrorCause).
 		WithChecker(s.Checker).
@@ -12,7 +12,6 @@ import (
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/plugins"
-	"openpitrix.io/openpitrix/pkg/util/stringutil"
 )
 
 func ValidateName(name string) error {
@@ -26,10 +25,7 @@ func ValidateProvider(provider string) error {
 	if !govalidator.StringLength(provider, ProviderMinLength, ProviderMaxLength) {
 		return gerr.New(gerr.InvalidArgument, gerr.ErrorIllegalParameterLength, "provider")
 	}
-	if i := stringutil.FindString(constants.VmBaseProviders, provider); i != -1 {
-		return nil
-	}
-	if constants.ProviderKubernetes == provider {
+	if plugins.IsSupported(provider) {
 		return nil
 	}
 	return gerr.New(gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "provider", provider)
@@ -46,7 +42,11 @@ func ValidateCredential(provider, url, credential, zone string) error {
 	if len(credential) < CredentialMinLength {
 		return gerr.New(gerr.InvalidArgument, gerr.ErrorIllegalParameterLength, "credential")
 	}
-	if i := stringutil.FindString(constants.VmBaseProviders, provider); i != -1 {
+	vmBased, err := plugins.IsVmBased(provider)
+	if err != nil {
+		return gerr.NewWithDetail(gerr.NotFound, err, gerr.ErrorProviderNotFound, provider)
+	}
+	if vmBased {
 		err := ValidateURL(url)
 		if err != nil {
 			return err
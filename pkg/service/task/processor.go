@@ -5,6 +5,9 @@
 package task
 
 import (
+	"context"
+	"fmt"
+
 	"openpitrix.io/openpitrix/pkg/client"
 	clusterclient "openpitrix.io/openpitrix/pkg/client/cluster"
 	"openpitrix.io/openpitrix/pkg/constants"
@@ -15,8 +18,10 @@ import (
 	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
 	"openpitrix.io/openpitrix/pkg/pi"
 	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+	"openpitrix.io/openpitrix/pkg/util/funcutil"
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/senderutil"
 )
 
 type Processor struct {
@@ -65,9 +70,64 @@ func (p *Processor) Pre() error {
 			return err
 		}
 		instance.VolumeId = clusterNodes[0].GetVolumeId().GetValue()
+		if instance.Subnet == "" {
+			// subnet is created by the cluster after the task is split
+			instance.Subnet, err = p.getSubnetId(ctx, clusterClient, clusterNodes[0])
+			if err != nil {
+				return err
+			}
+		}
 		// write back
 		p.Task.Directive = jsonutil.ToString(instance)
 
+	case vmbased.ActionAllocateEips:
+		// instance created after the task is split
+		nodeEip, err := models.NewNodeEip(p.Task.Directive)
+		if err != nil {
+			return err
+		}
+		clusterNodes, err := clusterClient.GetClusterNodes(ctx, []string{nodeEip.NodeId})
+		if err != nil {
+			return err
+		}
+		nodeEip.InstanceId = clusterNodes[0].GetInstanceId().GetValue()
+		// write back
+		p.Task.Directive = jsonutil.ToString(nodeEip)
+
+	case vmbased.ActionDeleteNetwork:
+		network, err := models.NewNetwork(p.Task.Directive)
+		if err != nil {
+			return err
+		}
+		inUse, err := p.deleteNetworkFrontgate(ctx, clusterClient, network)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			// the network is handed over to the other clusters, skip deleting it
+			p.Task.Directive = ""
+		}
+
+	case vmbased.ActionAddLoadbalancerBackends, vmbased.ActionDeleteLoadbalancerBackends:
+		// instances of added nodes are created after the task is split
+		loadbalancerBackend, err := models.NewLoadbalancerBackend(p.Task.Directive)
+		if err != nil {
+			return err
+		}
+		clusterNodes, err := clusterClient.GetClusterNodes(ctx, loadbalancerBackend.NodeIds)
+		if err != nil {
+			return err
+		}
+		loadbalancerBackend.InstanceIds = nil
+		for _, clusterNode := range clusterNodes {
+			instanceId := clusterNode.GetInstanceId().GetValue()
+			if instanceId != "" {
+				loadbalancerBackend.InstanceIds = append(loadbalancerBackend.InstanceIds, instanceId)
+			}
+		}
+		// write back
+		p.Task.Directive = jsonutil.ToString(loadbalancerBackend)
+
 	case vmbased.ActionStartInstances:
 		instance, err := models.NewInstance(p.Task.Directive)
 		if err != nil {
@@ -447,6 +507,111 @@ func (p *Processor) Post() error {
 			return err
 		}
 
+	case vmbased.ActionCreateNetwork:
+		network, err := models.NewNetwork(p.Task.Directive)
+		if err != nil {
+			return err
+		}
+		pbClusters, err := clusterClient.GetClusters(ctx, []string{network.ClusterId})
+		if err != nil {
+			return err
+		}
+		var pbClusterNodes []*pb.ClusterNode
+		for _, clusterNode := range pbClusters[0].ClusterNodeSet {
+			pbClusterNodes = append(pbClusterNodes, &pb.ClusterNode{
+				NodeId:   clusterNode.GetNodeId(),
+				SubnetId: pbutil.ToProtoString(network.SubnetId),
+			})
+		}
+		_, err = clusterClient.ModifyCluster(ctx, &pb.ModifyClusterRequest{
+			Cluster: &pb.Cluster{
+				ClusterId:       pbutil.ToProtoString(network.ClusterId),
+				VpcId:           pbutil.ToProtoString(network.VpcId),
+				SubnetId:        pbutil.ToProtoString(network.SubnetId),
+				ManagedVpcId:    pbutil.ToProtoString(network.VpcId),
+				ManagedSubnetId: pbutil.ToProtoString(network.SubnetId),
+				ManagedEipId:    pbutil.ToProtoString(network.EipId),
+			},
+			ClusterNodeSet: pbClusterNodes,
+		})
+		if err != nil {
+			return err
+		}
+		// the frontgate created for the cluster runs in the network as well
+		frontgateId := pbClusters[0].GetFrontgateId().GetValue()
+		if frontgateId != "" {
+			pbFrontgates, err := clusterClient.GetClusters(ctx, []string{frontgateId})
+			if err != nil {
+				return err
+			}
+			if pbFrontgates[0].GetSubnetId().GetValue() == "" {
+				_, err = clusterClient.ModifyCluster(ctx, &pb.ModifyClusterRequest{
+					Cluster: &pb.Cluster{
+						ClusterId: pbutil.ToProtoString(frontgateId),
+						VpcId:     pbutil.ToProtoString(network.VpcId),
+						SubnetId:  pbutil.ToProtoString(network.SubnetId),
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+	case vmbased.ActionDeleteNetwork:
+		if p.Task.Directive == "" {
+			// the network is handed over to the other clusters by pre processor
+			p.TLogger.Debug("Skip empty task [%s] directive", p.Task.TaskId)
+			return nil
+		}
+		network, err := models.NewNetwork(p.Task.Directive)
+		if err != nil {
+			return err
+		}
+		_, err = clusterClient.ModifyCluster(ctx, &pb.ModifyClusterRequest{
+			Cluster: &pb.Cluster{
+				ClusterId:       pbutil.ToProtoString(network.ClusterId),
+				ManagedVpcId:    pbutil.ToProtoString(""),
+				ManagedSubnetId: pbutil.ToProtoString(""),
+				ManagedEipId:    pbutil.ToProtoString(""),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+	case vmbased.ActionAllocateEips:
+		nodeEip, err := models.NewNodeEip(p.Task.Directive)
+		if err != nil {
+			return err
+		}
+		_, err = clusterClient.ModifyClusterNode(ctx, &pb.ModifyClusterNodeRequest{
+			ClusterNode: &pb.ClusterNode{
+				NodeId: pbutil.ToProtoString(nodeEip.NodeId),
+				Eip:    pbutil.ToProtoString(nodeEip.Eip),
+				EipId:  pbutil.ToProtoString(nodeEip.EipId),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+	case vmbased.ActionReleaseEips:
+		nodeEip, err := models.NewNodeEip(p.Task.Directive)
+		if err != nil {
+			return err
+		}
+		_, err = clusterClient.ModifyClusterNode(ctx, &pb.ModifyClusterNodeRequest{
+			ClusterNode: &pb.ClusterNode{
+				NodeId: pbutil.ToProtoString(nodeEip.NodeId),
+				Eip:    pbutil.ToProtoString(""),
+				EipId:  pbutil.ToProtoString(""),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
 	case vmbased.ActionPingDrone, vmbased.ActionRegisterCmd, vmbased.ActionStartConfd:
 		err = clusterClient.ModifyClusterNodeTransitionStatus(ctx, p.Task.NodeId, "")
 		if err != nil {
@@ -457,3 +622,109 @@ func (p *Processor) Post() error {
 	}
 	return err
 }
+
+// getSubnetId returns the subnet of the cluster of the node and writes it back
+// to the node, the frontgate created for a cluster without subnet waits for
+// the network created by the cluster.
+func (p *Processor) getSubnetId(ctx context.Context, clusterClient *clusterclient.Client, clusterNode *pb.ClusterNode) (string, error) {
+	if clusterNode.GetSubnetId().GetValue() != "" {
+		return clusterNode.GetSubnetId().GetValue(), nil
+	}
+	clusterId := clusterNode.GetClusterId().GetValue()
+	var subnetId string
+	err := funcutil.WaitForSpecificOrError(func() (bool, error) {
+		pbClusters, err := clusterClient.GetClusters(ctx, []string{clusterId})
+		if err != nil {
+			return false, err
+		}
+		subnetId = pbClusters[0].GetSubnetId().GetValue()
+		return subnetId != "", nil
+	}, p.Task.GetTimeout(constants.WaitTaskTimeout), constants.WaitTaskInterval)
+	if err != nil {
+		p.TLogger.Error("Wait subnet of cluster [%s] failed: %+v", clusterId, err)
+		return "", fmt.Errorf("subnet of cluster [%s] not found", clusterId)
+	}
+
+	_, err = clusterClient.ModifyCluster(ctx, &pb.ModifyClusterRequest{
+		Cluster: &pb.Cluster{
+			ClusterId: pbutil.ToProtoString(clusterId),
+		},
+		ClusterNodeSet: []*pb.ClusterNode{{
+			NodeId:   clusterNode.GetNodeId(),
+			SubnetId: pbutil.ToProtoString(subnetId),
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	return subnetId, nil
+}
+
+// deleteNetworkFrontgate deletes the frontgate running in the network created
+// for the cluster and waits for it to be deleted. When the frontgate serves
+// other clusters, the network is handed over to one of them and kept.
+func (p *Processor) deleteNetworkFrontgate(ctx context.Context, clusterClient *clusterclient.Client, network *models.Network) (bool, error) {
+	pbClusters, err := clusterClient.GetClusters(ctx, []string{network.ClusterId})
+	if err != nil {
+		return false, err
+	}
+	frontgateId := pbClusters[0].GetFrontgateId().GetValue()
+	if frontgateId == "" {
+		return false, nil
+	}
+	pbFrontgates, err := clusterClient.GetClusters(ctx, []string{frontgateId})
+	if err != nil {
+		return false, err
+	}
+	frontgate := pbFrontgates[0]
+	if frontgate.GetVpcId().GetValue() != network.VpcId || frontgate.GetStatus().GetValue() == constants.StatusDeleted {
+		return false, nil
+	}
+
+	statuses := []string{constants.StatusActive, constants.StatusPending, constants.StatusStopped}
+	pbOtherClusters, err := clusterClient.DescribeClustersWithFrontgateId(ctx, frontgateId, statuses)
+	if err != nil {
+		return false, err
+	}
+	for _, pbCluster := range pbOtherClusters {
+		if pbCluster.GetClusterId().GetValue() == network.ClusterId {
+			continue
+		}
+		_, err = clusterClient.ModifyCluster(ctx, &pb.ModifyClusterRequest{
+			Cluster: &pb.Cluster{
+				ClusterId:       pbCluster.GetClusterId(),
+				ManagedVpcId:    pbutil.ToProtoString(network.VpcId),
+				ManagedSubnetId: pbutil.ToProtoString(network.SubnetId),
+				ManagedEipId:    pbutil.ToProtoString(network.EipId),
+			},
+		})
+		if err != nil {
+			return false, err
+		}
+		p.TLogger.Info("Network [%s] of cluster [%s] is handed over to cluster [%s]",
+			network.VpcId, network.ClusterId, pbCluster.GetClusterId().GetValue())
+		return true, nil
+	}
+
+	// the frontgate is deleted on behalf of its owner
+	ownerCtx := senderutil.NewContext(context.Background(), &senderutil.Info{UserId: frontgate.GetOwner().GetValue()})
+	_, err = clusterClient.DeleteClusters(ownerCtx, &pb.DeleteClustersRequest{
+		ClusterId: []string{frontgateId},
+	})
+	if err != nil {
+		return false, err
+	}
+	err = funcutil.WaitForSpecificOrError(func() (bool, error) {
+		pbFrontgates, err := clusterClient.GetClusters(ctx, []string{frontgateId})
+		if err != nil {
+			//network or api error, not considered task fail.
+			return false, nil
+		}
+		return pbFrontgates[0].GetStatus().GetValue() == constants.StatusDeleted, nil
+	}, p.Task.GetTimeout(constants.WaitFrontgateServiceTimeout), constants.WaitFrontgateServiceInterval)
+	if err != nil {
+		p.TLogger.Error("Wait frontgate [%s] deleted failed: %+v", frontgateId, err)
+		return false, err
+	}
+	return false, nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package task
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+)
+
+// the directive of DeleteNetwork is cleared by pre processor when the network
+// is handed over to the other clusters sharing the frontgate
+func TestPostDeleteNetworkHandedOver(t *testing.T) {
+	task := &models.Task{
+		TaskId:     "t-delete-network",
+		TaskAction: vmbased.ActionDeleteNetwork,
+		Target:     constants.ProviderQingCloud,
+		Directive:  "",
+	}
+	err := NewProcessor(task, nil).Post()
+	if err != nil {
+		t.Fatalf("post process of the handed over network should be skipped: %+v", err)
+	}
+}
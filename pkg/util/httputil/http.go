@@ -5,8 +5,11 @@
 package httputil
 
 import (
+	"context"
+	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -33,3 +36,111 @@ func HttpGet(url string) (*http.Response, error) {
 	}
 	return response, err
 }
+
+// the networks that are not reachable from the internet, HttpGetPublic
+// refuses them, so a url from user input can not reach internal services
+var nonPublicNetworks = parseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err) // unreachable
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func IsPublicIP(ip net.IP) bool {
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func checkPublicUrl(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme of [%s] is not http or https", u)
+	}
+	return nil
+}
+
+// HttpGetPublic gets the url of http or https only on the public ips, the
+// host is resolved before dial, so it can not be rebound to a private ip.
+// The redirects are checked in the same way.
+func HttpGetPublic(rawurl string) (*http.Response, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPublicUrl(u); err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	var netTransport = &http.Transport{
+		// a proxy would dial the host by itself
+		Proxy: nil,
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			host, port, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, err
+			}
+			addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+			if err != nil {
+				return nil, err
+			}
+			if len(addrs) == 0 {
+				return nil, fmt.Errorf("no address of host [%s]", host)
+			}
+			for _, a := range addrs {
+				if !IsPublicIP(a.IP) {
+					return nil, fmt.Errorf("address [%s] of host [%s] is not public", a.IP, host)
+				}
+			}
+			return dialer.DialContext(ctx, network, net.JoinHostPort(addrs[0].IP.String(), port))
+		},
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	var netClient = &http.Client{
+		Timeout:   time.Second * 30,
+		Transport: netTransport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 3 {
+				return fmt.Errorf("stopped after %d redirects", len(via))
+			}
+			return checkPublicUrl(req.URL)
+		},
+	}
+
+	return netClient.Get(u.String())
+}
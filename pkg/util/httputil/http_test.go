@@ -0,0 +1,54 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package httputil
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	for _, tc := range []struct {
+		ip     string
+		public bool
+	}{
+		{"8.8.8.8", true},
+		{"2001:4860:4860::8888", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"fd00::1", false},
+		{"::ffff:127.0.0.1", false},
+	} {
+		if got := IsPublicIP(net.ParseIP(tc.ip)); got != tc.public {
+			t.Fatalf("IsPublicIP [%s]: expect %t, got %t", tc.ip, tc.public, got)
+		}
+	}
+}
+
+func TestHttpGetPublic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	for _, url := range []string{
+		server.URL,
+		"ftp://example.com/icon.png",
+		"file:///etc/passwd",
+	} {
+		if resp, err := HttpGetPublic(url); err == nil {
+			resp.Body.Close()
+			t.Fatalf("HttpGetPublic [%s]: expect error", url)
+		}
+	}
+}
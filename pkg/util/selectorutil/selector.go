@@ -0,0 +1,204 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package selectorutil matches the labels by the selectors in the syntax of
+// kubernetes, e.g. "env=prod,tier in (web,db),!deprecated".
+package selectorutil
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type Operator string
+
+const (
+	Equals       Operator = "="
+	NotEquals    Operator = "!="
+	In           Operator = "in"
+	NotIn        Operator = "notin"
+	Exists       Operator = "exists"
+	DoesNotExist Operator = "!"
+)
+
+var setRequirementRegexp = regexp.MustCompile(`^([^\s=!(),]+)\s+(in|notin)\s*\((.*)\)$`)
+
+// Requirement is the condition on the value of one label key.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Matches returns true if the labels satisfy the requirement, the labels
+// without the key satisfy the requirements of != and notin.
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+	switch r.Operator {
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	case Equals, In:
+		return ok && r.hasValue(value)
+	case NotEquals, NotIn:
+		return !ok || !r.hasValue(value)
+	}
+	return false
+}
+
+func (r Requirement) hasValue(value string) bool {
+	for _, v := range r.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Requirement) String() string {
+	switch r.Operator {
+	case Exists:
+		return r.Key
+	case DoesNotExist:
+		return "!" + r.Key
+	case In, NotIn:
+		values := append([]string{}, r.Values...)
+		sort.Strings(values)
+		return fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(values, ","))
+	}
+	return r.Key + string(r.Operator) + strings.Join(r.Values, "")
+}
+
+// Selector is the requirements that must all be satisfied, the empty
+// selector matches all the labels.
+type Selector []Requirement
+
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Selector) String() string {
+	var requirements []string
+	for _, r := range s {
+		requirements = append(requirements, r.String())
+	}
+	return strings.Join(requirements, ",")
+}
+
+// Add appends the requirement to the selector, the equality requirement of a
+// key that is already required by equality is merged into a set.
+func (s Selector) Add(requirement Requirement) Selector {
+	if requirement.Operator == Equals || requirement.Operator == In {
+		for i, r := range s {
+			if r.Key == requirement.Key && (r.Operator == Equals || r.Operator == In) {
+				s[i].Operator = In
+				s[i].Values = append(s[i].Values, requirement.Values...)
+				return s
+			}
+		}
+	}
+	return append(s, requirement)
+}
+
+// Parse parses the selector string, the requirements are separated by commas.
+func Parse(selector string) (Selector, error) {
+	var s Selector
+	for _, requirement := range split(selector) {
+		r, err := ParseRequirement(requirement)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, r)
+	}
+	return s, nil
+}
+
+// ParseKeyValue parses the requirement of the key from the value, e.g.
+// "prod", "!=prod", "in (web,db)", the key is required to exist if the value
+// is empty and not to exist if the key starts with "!".
+func ParseKeyValue(key, value string) (Requirement, error) {
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	switch {
+	case value == "" || strings.HasPrefix(key, "!"):
+		return ParseRequirement(key)
+	case strings.HasPrefix(value, "!="), strings.HasPrefix(value, "="):
+		return ParseRequirement(key + value)
+	case setRequirementRegexp.MatchString(key + " " + value):
+		return ParseRequirement(key + " " + value)
+	}
+	return ParseRequirement(key + "=" + value)
+}
+
+func ParseRequirement(requirement string) (Requirement, error) {
+	requirement = strings.TrimSpace(requirement)
+	if match := setRequirementRegexp.FindStringSubmatch(requirement); match != nil {
+		var values []string
+		for _, value := range strings.Split(match[3], ",") {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return Requirement{}, fmt.Errorf("empty value in requirement [%s]", requirement)
+			}
+			values = append(values, value)
+		}
+		return newRequirement(requirement, match[1], Operator(match[2]), values...)
+	}
+	if strings.HasPrefix(requirement, "!") && !strings.Contains(requirement, "=") {
+		return newRequirement(requirement, strings.TrimSpace(requirement[1:]), DoesNotExist)
+	}
+	for _, op := range []string{"!=", "==", "="} {
+		if i := strings.Index(requirement, op); i >= 0 {
+			operator := Operator(op)
+			if op == "==" {
+				operator = Equals
+			}
+			value := strings.TrimSpace(requirement[i+len(op):])
+			return newRequirement(requirement, strings.TrimSpace(requirement[:i]), operator, value)
+		}
+	}
+	return newRequirement(requirement, requirement, Exists)
+}
+
+func newRequirement(requirement, key string, operator Operator, values ...string) (Requirement, error) {
+	if key == "" || strings.ContainsAny(key, " \t!=(),") {
+		return Requirement{}, fmt.Errorf("invalid key in requirement [%s]", requirement)
+	}
+	for _, value := range values {
+		if strings.ContainsAny(value, " \t!=(),") {
+			return Requirement{}, fmt.Errorf("invalid value in requirement [%s]", requirement)
+		}
+	}
+	return Requirement{Key: key, Operator: operator, Values: values}, nil
+}
+
+// split splits the selector by the commas out of the parentheses.
+func split(selector string) []string {
+	var requirements []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				requirements = append(requirements, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(selector[start:]); last != "" || len(requirements) > 0 {
+		requirements = append(requirements, selector[start:])
+	}
+	return requirements
+}
@@ -0,0 +1,86 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package selectorutil
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	selector, err := Parse("env=prod, tier in (web, db),zone!=pek3a,gpu,!deprecated,team notin (a,b),os==linux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "env=prod,tier in (db,web),zone!=pek3a,gpu,!deprecated,team notin (a,b),os=linux"
+	if selector.String() != expected {
+		t.Fatalf("expected [%s], got [%s]", expected, selector.String())
+	}
+
+	for _, s := range []string{"env in (prod,)", "=prod", "env=a b", "tier in (web", "a,,b"} {
+		_, err := Parse(s)
+		if err == nil {
+			t.Fatalf("parse [%s] should fail", s)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"env": "prod", "tier": "web", "gpu": "true"}
+	matched := []string{
+		"",
+		"env=prod",
+		"env=prod,tier in (web,db)",
+		"zone!=pek3a",
+		"team notin (a,b)",
+		"gpu,!deprecated",
+	}
+	for _, s := range matched {
+		selector, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !selector.Matches(labels) {
+			t.Fatalf("[%s] should match %v", s, labels)
+		}
+	}
+	unmatched := []string{
+		"env=dev",
+		"env=prod,tier notin (web)",
+		"zone",
+		"!gpu",
+		"tier in (db)",
+	}
+	for _, s := range unmatched {
+		selector, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if selector.Matches(labels) {
+			t.Fatalf("[%s] should not match %v", s, labels)
+		}
+	}
+}
+
+func TestParseKeyValue(t *testing.T) {
+	var selector Selector
+	for _, kv := range [][2]string{
+		{"env", "prod"},
+		{"env", "dev"},
+		{"zone", "!=pek3a"},
+		{"tier", "in (web,db)"},
+		{"gpu", ""},
+		{"!deprecated", ""},
+	} {
+		r, err := ParseKeyValue(kv[0], kv[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		selector = selector.Add(r)
+	}
+	expected := "env in (dev,prod),zone!=pek3a,tier in (db,web),gpu,!deprecated"
+	if selector.String() != expected {
+		t.Fatalf("expected [%s], got [%s]", expected, selector.String())
+	}
+}
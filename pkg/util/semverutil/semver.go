@@ -0,0 +1,111 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package semverutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver"
+)
+
+// Parse parses the semantic version from the name of app version, the name
+// may carry the version of the app in brackets, e.g. "1.0.0 [2.4.1]".
+func Parse(name string) (*semver.Version, error) {
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return nil, semver.ErrInvalidSemVer
+	}
+	return semver.NewVersion(fields[0])
+}
+
+// SortKey returns the key that sorts as string in the semver order of the
+// name, it is empty if the name is not a semantic version so that those
+// versions go first.
+func SortKey(name string) string {
+	v, err := Parse(name)
+	if err != nil {
+		return ""
+	}
+	key := fmt.Sprintf("%010d.%010d.%010d", v.Major(), v.Minor(), v.Patch())
+	// '-' < '~', the release goes after its pre-releases
+	if v.Prerelease() == "" {
+		return key + "~"
+	}
+	return key + "-" + prereleaseKey(v.Prerelease())
+}
+
+// prereleaseKey returns the key that sorts as string in the precedence of
+// the pre-release, the numeric identifiers compare numerically and go before
+// the alphanumeric ones, and the shorter set of identifiers goes first when
+// the others are equal, e.g. "alpha" < "alpha.1" < "alpha.beta" < "rc.9" < "rc.10".
+func prereleaseKey(prerelease string) string {
+	var keys []string
+	for _, id := range strings.Split(prerelease, ".") {
+		if isNumeric(id) {
+			// the numeric identifier is no longer than a uint64
+			keys = append(keys, "0"+strings.Repeat("0", 20-len(id))+id)
+		} else {
+			keys = append(keys, "1"+id)
+		}
+	}
+	// '!' is less than the characters of the identifiers
+	return strings.Join(keys, "!")
+}
+
+func isNumeric(id string) bool {
+	if id == "" || len(id) > 20 {
+		return false
+	}
+	for _, c := range id {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare returns -1, 0 or 1 as the version a is less than, equal to or
+// greater than b, the name that is not a semantic version is the least.
+func Compare(a, b string) int {
+	va, errA := Parse(a)
+	vb, errB := Parse(b)
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	case errB != nil:
+		return 1
+	}
+	return va.Compare(vb)
+}
+
+// ValidateConstraint checks the constraint, e.g. ">=1.0.0, <2.0.0".
+func ValidateConstraint(constraint string) error {
+	if constraint == "" {
+		return nil
+	}
+	_, err := semver.NewConstraint(constraint)
+	return err
+}
+
+// CheckConstraint reports whether the name satisfies the constraint, any
+// name satisfies the empty constraint, and no name that is not a semantic
+// version satisfies the others.
+func CheckConstraint(constraint, name string) (bool, error) {
+	if constraint == "" {
+		return true, nil
+	}
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+	v, err := Parse(name)
+	if err != nil {
+		return false, nil
+	}
+	return c.Check(v), nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package semverutil
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSortKey(t *testing.T) {
+	names := []string{"1.10.0", "v1.2.0 [3.0]", "latest", "1.2.0-beta.1", "0.9.9", "2.0.0"}
+	sort.Slice(names, func(i, j int) bool {
+		return SortKey(names[i]) < SortKey(names[j])
+	})
+	expected := []string{"latest", "0.9.9", "1.2.0-beta.1", "v1.2.0 [3.0]", "1.10.0", "2.0.0"}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("expect %v, got %v", expected, names)
+		}
+	}
+	for i := 1; i < len(names); i++ {
+		if Compare(names[i-1], names[i]) >= 0 {
+			t.Fatalf("expect [%s] less than [%s]", names[i-1], names[i])
+		}
+	}
+}
+
+func TestSortKeyPrerelease(t *testing.T) {
+	// the precedence of the example of semver 2.0.0, section 11
+	expected := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0-rc.9",
+		"1.0.0-rc.10",
+		"1.0.0",
+	}
+	names := make([]string, len(expected))
+	copy(names, expected)
+	sort.Slice(names, func(i, j int) bool {
+		return SortKey(names[i]) < SortKey(names[j])
+	})
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("expect %v, got %v", expected, names)
+		}
+	}
+	for i := 1; i < len(names); i++ {
+		if Compare(names[i-1], names[i]) >= 0 {
+			t.Fatalf("expect [%s] less than [%s]", names[i-1], names[i])
+		}
+	}
+	// the identifier with hyphen goes after its prefix
+	if SortKey("1.0.0-a.b") >= SortKey("1.0.0-a-b") {
+		t.Fatalf("expect [1.0.0-a.b] less than [1.0.0-a-b]")
+	}
+}
+
+func TestCheckConstraint(t *testing.T) {
+	testCases := []struct {
+		constraint string
+		name       string
+		ok         bool
+	}{
+		{"", "latest", true},
+		{">=1.0.0, <2.0.0", "1.5.0 [1.13]", true},
+		{">=1.0.0, <2.0.0", "2.0.0", false},
+		{"1.x || 2.1.x", "2.1.3", true},
+		{"1.x", "latest", false},
+	}
+	for _, tc := range testCases {
+		ok, err := CheckConstraint(tc.constraint, tc.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != tc.ok {
+			t.Fatalf("check [%s] against [%s], expect %t, got %t", tc.name, tc.constraint, tc.ok, ok)
+		}
+	}
+	if ValidateConstraint(">=1.0.0 <2.0.0") == nil {
+		t.Fatal("expect invalid constraint")
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package test
+
+import (
+	"openpitrix.io/openpitrix/pkg/constants"
+	apiclient "openpitrix.io/openpitrix/test/client"
+	"openpitrix.io/openpitrix/test/client/app_manager"
+	"openpitrix.io/openpitrix/test/models"
+)
+
+// ReleaseAppVersion walks the app version through the review workflow,
+// the cluster can only be created from the active app version.
+func ReleaseAppVersion(client *apiclient.Openpitrix, appVersion *models.OpenpitrixAppVersion) error {
+	versionId := appVersion.VersionID
+	if appVersion.Status == constants.StatusActive {
+		return nil
+	}
+	if appVersion.Status == constants.StatusDraft || appVersion.Status == constants.StatusRejected {
+		params := app_manager.NewSubmitAppVersionParams()
+		params.SetBody(&models.OpenpitrixSubmitAppVersionRequest{VersionID: versionId})
+		if _, err := client.AppManager.SubmitAppVersion(params); err != nil {
+			return err
+		}
+		appVersion.Status = constants.StatusSubmitted
+	}
+	if appVersion.Status == constants.StatusSubmitted {
+		params := app_manager.NewReviewAppVersionParams()
+		params.SetBody(&models.OpenpitrixReviewAppVersionRequest{VersionID: versionId})
+		if _, err := client.AppManager.ReviewAppVersion(params); err != nil {
+			return err
+		}
+		appVersion.Status = constants.StatusInReview
+	}
+	if appVersion.Status == constants.StatusInReview {
+		params := app_manager.NewPassAppVersionParams()
+		params.SetBody(&models.OpenpitrixPassAppVersionRequest{VersionID: versionId})
+		if _, err := client.AppManager.PassAppVersion(params); err != nil {
+			return err
+		}
+		appVersion.Status = constants.StatusPassed
+	}
+	params := app_manager.NewReleaseAppVersionParams()
+	params.SetBody(&models.OpenpitrixReleaseAppVersionRequest{VersionID: versionId})
+	if _, err := client.AppManager.ReleaseAppVersion(params); err != nil {
+		return err
+	}
+	appVersion.Status = constants.StatusActive
+	return nil
+}
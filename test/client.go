@@ -23,12 +23,16 @@ func (IgnoreLogger) Debugf(format string, args ...interface{}) {
 type ClientConfig struct {
 	Host     string
 	BasePath string
+	AuthKey  string
 	Debug    bool
 }
 
 func GetClient(conf *ClientConfig) *apiclient.Openpitrix {
 	transport := httptransport.New(conf.Host, conf.BasePath, []string{"http"})
 	transport.SetDebug(conf.Debug)
+	if conf.AuthKey != "" {
+		transport.DefaultAuthentication = httptransport.APIKeyAuth("X-Auth-Key", "header", conf.AuthKey)
+	}
 	//transport.SetLogger(IgnoreLogger{})
 	Client := apiclient.New(transport, strfmt.Default)
 	return Client
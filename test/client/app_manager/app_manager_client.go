@@ -136,6 +136,34 @@ func (a *Client) DeleteApps(params *DeleteAppsParams) (*DeleteAppsOK, error) {
 
 }
 
+/*
+DescribeAppVersionAudits describes the status transitions of app versions
+*/
+func (a *Client) DescribeAppVersionAudits(params *DescribeAppVersionAuditsParams) (*DescribeAppVersionAuditsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeAppVersionAuditsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeAppVersionAudits",
+		Method:             "GET",
+		PathPattern:        "/v1/app_versions/audits",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DescribeAppVersionAuditsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeAppVersionAuditsOK), nil
+
+}
+
 /*
 DescribeAppVersions describes app versions with filter
 */
@@ -192,6 +220,34 @@ func (a *Client) DescribeApps(params *DescribeAppsParams) (*DescribeAppsOK, erro
 
 }
 
+/*
+GetAppAttachment gets the readme icon or screenshot of app
+*/
+func (a *Client) GetAppAttachment(params *GetAppAttachmentParams) (*GetAppAttachmentOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAppAttachmentParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetAppAttachment",
+		Method:             "GET",
+		PathPattern:        "/v1/app/attachment",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAppAttachmentReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetAppAttachmentOK), nil
+
+}
+
 /*
 GetAppStatistics gets app statistics
 */
@@ -276,6 +332,34 @@ func (a *Client) GetAppVersionPackageFiles(params *GetAppVersionPackageFilesPara
 
 }
 
+/*
+GetAppVersionUpgradePaths gets the app versions that the app version can upgrade to
+*/
+func (a *Client) GetAppVersionUpgradePaths(params *GetAppVersionUpgradePathsParams) (*GetAppVersionUpgradePathsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAppVersionUpgradePathsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetAppVersionUpgradePaths",
+		Method:             "GET",
+		PathPattern:        "/v1/app_versions/upgrade_paths",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAppVersionUpgradePathsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetAppVersionUpgradePathsOK), nil
+
+}
+
 /*
 ModifyApp modifies app
 */
@@ -332,6 +416,230 @@ func (a *Client) ModifyAppVersion(params *ModifyAppVersionParams) (*ModifyAppVer
 
 }
 
+/*
+PassAppVersion passes the review of app version
+*/
+func (a *Client) PassAppVersion(params *PassAppVersionParams) (*PassAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPassAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PassAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_versions/pass",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PassAppVersionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PassAppVersionOK), nil
+
+}
+
+/*
+RejectAppVersion rejects the review of app version
+*/
+func (a *Client) RejectAppVersion(params *RejectAppVersionParams) (*RejectAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRejectAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RejectAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_versions/reject",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RejectAppVersionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RejectAppVersionOK), nil
+
+}
+
+/*
+ReleaseAppVersion releases the passed or suspended app version
+*/
+func (a *Client) ReleaseAppVersion(params *ReleaseAppVersionParams) (*ReleaseAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReleaseAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ReleaseAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_versions/release",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReleaseAppVersionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ReleaseAppVersionOK), nil
+
+}
+
+/*
+ReviewAppVersion starts the review of app version
+*/
+func (a *Client) ReviewAppVersion(params *ReviewAppVersionParams) (*ReviewAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReviewAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ReviewAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_versions/review",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReviewAppVersionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ReviewAppVersionOK), nil
+
+}
+
+/*
+SearchApps searches apps with full text search and facets
+*/
+func (a *Client) SearchApps(params *SearchAppsParams) (*SearchAppsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSearchAppsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SearchApps",
+		Method:             "GET",
+		PathPattern:        "/v1/apps/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SearchAppsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SearchAppsOK), nil
+
+}
+
+/*
+SubmitAppVersion submits app version for review
+*/
+func (a *Client) SubmitAppVersion(params *SubmitAppVersionParams) (*SubmitAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSubmitAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SubmitAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_versions/submit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SubmitAppVersionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SubmitAppVersionOK), nil
+
+}
+
+/*
+SuspendAppVersion suspends the active app version
+*/
+func (a *Client) SuspendAppVersion(params *SuspendAppVersionParams) (*SuspendAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSuspendAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SuspendAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_versions/suspend",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SuspendAppVersionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SuspendAppVersionOK), nil
+
+}
+
+/*
+UploadAppVersionPackage uploads the package of app version to the storage of open pitrix
+*/
+func (a *Client) UploadAppVersionPackage(params *UploadAppVersionPackageParams) (*UploadAppVersionPackageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUploadAppVersionPackageParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UploadAppVersionPackage",
+		Method:             "POST",
+		PathPattern:        "/v1/app_version/package",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UploadAppVersionPackageReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UploadAppVersionPackageOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -81,7 +81,10 @@ type DescribeAppVersionsParams struct {
 	Reverse *bool
 	/*SearchWord*/
 	SearchWord *string
-	/*SortKey*/
+	/*SortKey
+	  sequence by default, semver to sort by the semantic version of the name.
+
+	*/
 	SortKey *string
 	/*Status*/
 	Status []string
@@ -81,6 +81,8 @@ type DescribeAppsParams struct {
 	RepoID []string
 	/*Reverse*/
 	Reverse *bool
+	/*RuntimeID*/
+	RuntimeID *string
 	/*SearchWord*/
 	SearchWord *string
 	/*SortKey*/
@@ -225,6 +227,17 @@ func (o *DescribeAppsParams) SetReverse(reverse *bool) {
 	o.Reverse = reverse
 }
 
+// WithRuntimeID adds the runtimeID to the describe apps params
+func (o *DescribeAppsParams) WithRuntimeID(runtimeID *string) *DescribeAppsParams {
+	o.SetRuntimeID(runtimeID)
+	return o
+}
+
+// SetRuntimeID adds the runtimeID to the describe apps params
+func (o *DescribeAppsParams) SetRuntimeID(runtimeID *string) {
+	o.RuntimeID = runtimeID
+}
+
 // WithSearchWord adds the searchWord to the describe apps params
 func (o *DescribeAppsParams) WithSearchWord(searchWord *string) *DescribeAppsParams {
 	o.SetSearchWord(searchWord)
@@ -362,6 +375,22 @@ func (o *DescribeAppsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 
 	}
 
+	if o.RuntimeID != nil {
+
+		// query param runtime_id
+		var qrRuntimeID string
+		if o.RuntimeID != nil {
+			qrRuntimeID = *o.RuntimeID
+		}
+		qRuntimeID := qrRuntimeID
+		if qRuntimeID != "" {
+			if err := r.SetQueryParam("runtime_id", qRuntimeID); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.SearchWord != nil {
 
 		// query param search_word
@@ -248,6 +248,62 @@ func (a *Client) DeleteKeyPairs(params *DeleteKeyPairsParams) (*DeleteKeyPairsOK
 
 }
 
+/*
+DescribeClusterCosts describes cost of clusters
+*/
+func (a *Client) DescribeClusterCosts(params *DescribeClusterCostsParams) (*DescribeClusterCostsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeClusterCostsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeClusterCosts",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/costs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DescribeClusterCostsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeClusterCostsOK), nil
+
+}
+
+/*
+DescribeClusterNodeLogs describes cluster node logs
+*/
+func (a *Client) DescribeClusterNodeLogs(params *DescribeClusterNodeLogsParams) (*DescribeClusterNodeLogsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeClusterNodeLogsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeClusterNodeLogs",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/nodes/logs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DescribeClusterNodeLogsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeClusterNodeLogsOK), nil
+
+}
+
 /*
 DescribeClusterNodes describes cluster nodes
 */
@@ -332,6 +388,34 @@ func (a *Client) DescribeKeyPairs(params *DescribeKeyPairsParams) (*DescribeKeyP
 
 }
 
+/*
+DescribeRuntimeUsage describes resource usage of clusters by runtime and owner
+*/
+func (a *Client) DescribeRuntimeUsage(params *DescribeRuntimeUsageParams) (*DescribeRuntimeUsageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeRuntimeUsageParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeRuntimeUsage",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/runtime_usage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DescribeRuntimeUsageReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeRuntimeUsageOK), nil
+
+}
+
 /*
 DescribeSubnets describes subnets
 */
@@ -388,6 +472,34 @@ func (a *Client) DetachKeyPairs(params *DetachKeyPairsParams) (*DetachKeyPairsOK
 
 }
 
+/*
+EstimateClusterCost estimates cost of cluster before creating it
+*/
+func (a *Client) EstimateClusterCost(params *EstimateClusterCostParams) (*EstimateClusterCostOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEstimateClusterCostParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "EstimateClusterCost",
+		Method:             "POST",
+		PathPattern:        "/v1/clusters/estimate_cost",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &EstimateClusterCostReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*EstimateClusterCostOK), nil
+
+}
+
 /*
 GetClusterStatistics gets cluster statistics
 */
@@ -63,6 +63,8 @@ for the describe runtimes operation typically these are written to a http.Reques
 */
 type DescribeRuntimesParams struct {
 
+	/*HealthStatus*/
+	HealthStatus []string
 	/*Label*/
 	Label *string
 	/*Limit*/
@@ -73,6 +75,8 @@ type DescribeRuntimesParams struct {
 	Owner []string
 	/*Provider*/
 	Provider []string
+	/*RepoID*/
+	RepoID *string
 	/*RuntimeID*/
 	RuntimeID []string
 	/*SearchWord*/
@@ -118,6 +122,17 @@ func (o *DescribeRuntimesParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithHealthStatus adds the healthStatus to the describe runtimes params
+func (o *DescribeRuntimesParams) WithHealthStatus(healthStatus []string) *DescribeRuntimesParams {
+	o.SetHealthStatus(healthStatus)
+	return o
+}
+
+// SetHealthStatus adds the healthStatus to the describe runtimes params
+func (o *DescribeRuntimesParams) SetHealthStatus(healthStatus []string) {
+	o.HealthStatus = healthStatus
+}
+
 // WithLabel adds the label to the describe runtimes params
 func (o *DescribeRuntimesParams) WithLabel(label *string) *DescribeRuntimesParams {
 	o.SetLabel(label)
@@ -173,6 +188,17 @@ func (o *DescribeRuntimesParams) SetProvider(provider []string) {
 	o.Provider = provider
 }
 
+// WithRepoID adds the repoID to the describe runtimes params
+func (o *DescribeRuntimesParams) WithRepoID(repoID *string) *DescribeRuntimesParams {
+	o.SetRepoID(repoID)
+	return o
+}
+
+// SetRepoID adds the repoID to the describe runtimes params
+func (o *DescribeRuntimesParams) SetRepoID(repoID *string) {
+	o.RepoID = repoID
+}
+
 // WithRuntimeID adds the runtimeID to the describe runtimes params
 func (o *DescribeRuntimesParams) WithRuntimeID(runtimeID []string) *DescribeRuntimesParams {
 	o.SetRuntimeID(runtimeID)
@@ -214,6 +240,14 @@ func (o *DescribeRuntimesParams) WriteToRequest(r runtime.ClientRequest, reg str
 	}
 	var res []error
 
+	valuesHealthStatus := o.HealthStatus
+
+	joinedHealthStatus := swag.JoinByFormat(valuesHealthStatus, "multi")
+	// query array param health_status
+	if err := r.SetQueryParam("health_status", joinedHealthStatus...); err != nil {
+		return err
+	}
+
 	if o.Label != nil {
 
 		// query param label
@@ -278,6 +312,22 @@ func (o *DescribeRuntimesParams) WriteToRequest(r runtime.ClientRequest, reg str
 		return err
 	}
 
+	if o.RepoID != nil {
+
+		// query param repo_id
+		var qrRepoID string
+		if o.RepoID != nil {
+			qrRepoID = *o.RepoID
+		}
+		qRepoID := qrRepoID
+		if qRepoID != "" {
+			if err := r.SetQueryParam("repo_id", qRepoID); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	valuesRuntimeID := o.RuntimeID
 
 	joinedRuntimeID := swag.JoinByFormat(valuesRuntimeID, "multi")
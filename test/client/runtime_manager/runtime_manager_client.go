@@ -80,6 +80,34 @@ func (a *Client) DeleteRuntimes(params *DeleteRuntimesParams) (*DeleteRuntimesOK
 
 }
 
+/*
+DescribeRuntimePrices describes price sheets of runtimes
+*/
+func (a *Client) DescribeRuntimePrices(params *DescribeRuntimePricesParams) (*DescribeRuntimePricesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeRuntimePricesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeRuntimePrices",
+		Method:             "GET",
+		PathPattern:        "/v1/runtimes/prices",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DescribeRuntimePricesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeRuntimePricesOK), nil
+
+}
+
 /*
 DescribeRuntimeProviderZones describes runtime provider zones
 */
@@ -192,6 +220,62 @@ func (a *Client) ModifyRuntime(params *ModifyRuntimeParams) (*ModifyRuntimeOK, e
 
 }
 
+/*
+ModifyRuntimePrice creates or modify price sheet of runtime
+*/
+func (a *Client) ModifyRuntimePrice(params *ModifyRuntimePriceParams) (*ModifyRuntimePriceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewModifyRuntimePriceParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ModifyRuntimePrice",
+		Method:             "PATCH",
+		PathPattern:        "/v1/runtimes/prices",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ModifyRuntimePriceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ModifyRuntimePriceOK), nil
+
+}
+
+/*
+RotateEncryptionKey rotates encryption key of credentials
+*/
+func (a *Client) RotateEncryptionKey(params *RotateEncryptionKeyParams) (*RotateEncryptionKeyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRotateEncryptionKeyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RotateEncryptionKey",
+		Method:             "POST",
+		PathPattern:        "/v1/encryption_key/rotate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RotateEncryptionKeyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RotateEncryptionKeyOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -166,6 +166,10 @@ func TestK8s(t *testing.T) {
 		}
 
 		appVersion = appVersions[0]
+		err = ReleaseAppVersion(client, appVersion)
+		if err != nil {
+			t.Fatal(err)
+		}
 	}
 	log.Printf("Got app version [%s]\n", appVersion.Name)
 
@@ -120,6 +120,12 @@ func TestK8s(t *testing.T) {
 
 		appVersion1 = appVersions[0]
 		appVersion2 = appVersions[1]
+		for _, appVersion := range appVersions {
+			err = ReleaseAppVersion(client, appVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
 	}
 	log.Printf("Got app version [%s] [%s]\n", appVersion1.Name, appVersion2.Name)
 
@@ -37,6 +37,9 @@ type OpenpitrixAppVersion struct {
 	// sequence
 	Sequence int64 `json:"sequence,omitempty"`
 
+	// verified/unverified, empty if the repo has no trusted keys
+	SignatureStatus string `json:"signature_status,omitempty"`
+
 	// status
 	Status string `json:"status,omitempty"`
 
@@ -46,6 +49,9 @@ type OpenpitrixAppVersion struct {
 	// update time
 	UpdateTime strfmt.DateTime `json:"update_time,omitempty"`
 
+	// semver constraint of the versions that can upgrade to this version
+	UpgradeFrom string `json:"upgrade_from,omitempty"`
+
 	// version id
 	VersionID string `json:"version_id,omitempty"`
 }
@@ -55,6 +55,15 @@ type OpenpitrixCluster struct {
 	// global uuid
 	GlobalUUID string `json:"global_uuid,omitempty"`
 
+	// managed eip id
+	ManagedEipID string `json:"managed_eip_id,omitempty"`
+
+	// managed subnet id
+	ManagedSubnetID string `json:"managed_subnet_id,omitempty"`
+
+	// managed vpc id
+	ManagedVpcID string `json:"managed_vpc_id,omitempty"`
+
 	// metadata root access
 	MetadataRootAccess bool `json:"metadata_root_access,omitempty"`
 
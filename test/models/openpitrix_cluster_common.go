@@ -58,6 +58,9 @@ type OpenpitrixClusterCommon struct {
 	// init service
 	InitService string `json:"init_service,omitempty"`
 
+	// logs
+	Logs string `json:"logs,omitempty"`
+
 	// monitor
 	Monitor string `json:"monitor,omitempty"`
 
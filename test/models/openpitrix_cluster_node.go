@@ -40,6 +40,9 @@ type OpenpitrixClusterNode struct {
 	// eip
 	Eip string `json:"eip,omitempty"`
 
+	// eip id
+	EipID string `json:"eip_id,omitempty"`
+
 	// global server id
 	GlobalServerID *ProtobufUint32Value `json:"global_server_id,omitempty"`
 
@@ -43,6 +43,9 @@ type OpenpitrixClusterRole struct {
 	// mount point
 	MountPoint string `json:"mount_point,omitempty"`
 
+	// public endpoint
+	PublicEndpoint bool `json:"public_endpoint,omitempty"`
+
 	// role
 	Role string `json:"role,omitempty"`
 
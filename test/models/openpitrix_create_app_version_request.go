@@ -33,6 +33,9 @@ type OpenpitrixCreateAppVersionRequest struct {
 
 	// sequence
 	Sequence *ProtobufUint32Value `json:"sequence,omitempty"`
+
+	// semver constraint of the versions that can upgrade to this version, e.g. ">=1.0.0, <2.0.0"
+	UpgradeFrom string `json:"upgrade_from,omitempty"`
 }
 
 // Validate validates this openpitrix create app version request
@@ -37,6 +37,9 @@ type OpenpitrixCreateRepoRequest struct {
 	// selectors
 	Selectors OpenpitrixCreateRepoRequestSelectors `json:"selectors"`
 
+	// public keys to verify the provenance of the packages, one per line
+	TrustedKeys string `json:"trusted_keys,omitempty"`
+
 	// type
 	Type string `json:"type,omitempty"`
 
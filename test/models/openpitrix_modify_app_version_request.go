@@ -31,6 +31,9 @@ type OpenpitrixModifyAppVersionRequest struct {
 	// sequence
 	Sequence *ProtobufUint32Value `json:"sequence,omitempty"`
 
+	// semver constraint of the versions that can upgrade to this version, e.g. ">=1.0.0, <2.0.0"
+	UpgradeFrom string `json:"upgrade_from,omitempty"`
+
 	// version id
 	VersionID string `json:"version_id,omitempty"`
 }
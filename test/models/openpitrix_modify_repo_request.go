@@ -40,6 +40,9 @@ type OpenpitrixModifyRepoRequest struct {
 	// selectors
 	Selectors OpenpitrixModifyRepoRequestSelectors `json:"selectors"`
 
+	// trusted keys
+	TrustedKeys string `json:"trusted_keys,omitempty"`
+
 	// type
 	Type string `json:"type,omitempty"`
 
@@ -52,6 +52,9 @@ type OpenpitrixRepo struct {
 	// status time
 	StatusTime strfmt.DateTime `json:"status_time,omitempty"`
 
+	// trusted keys
+	TrustedKeys string `json:"trusted_keys,omitempty"`
+
 	// type
 	Type string `json:"type,omitempty"`
 
@@ -16,15 +16,27 @@ import (
 // swagger:model openpitrixRuntime
 type OpenpitrixRuntime struct {
 
+	// latency of the provider api in milliseconds
+	APILatency *ProtobufUint32Value `json:"api_latency,omitempty"`
+
 	// create time
 	CreateTime strfmt.DateTime `json:"create_time,omitempty"`
 
 	// description
 	Description string `json:"description,omitempty"`
 
+	// health check time
+	HealthCheckTime strfmt.DateTime `json:"health_check_time,omitempty"`
+
+	// healthy, unhealthy or unknown before the first check
+	HealthStatus string `json:"health_status,omitempty"`
+
 	// labels
 	Labels OpenpitrixRuntimeLabels `json:"labels"`
 
+	// last error
+	LastError string `json:"last_error,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -54,12 +66,36 @@ type OpenpitrixRuntime struct {
 func (m *OpenpitrixRuntime) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAPILatency(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *OpenpitrixRuntime) validateAPILatency(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.APILatency) { // not required
+		return nil
+	}
+
+	if m.APILatency != nil {
+
+		if err := m.APILatency.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("api_latency")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *OpenpitrixRuntime) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,250 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+//go:build simulator
+// +build simulator
+
+package test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	apiclient "openpitrix.io/openpitrix/test/client"
+	"openpitrix.io/openpitrix/test/client/app_manager"
+	"openpitrix.io/openpitrix/test/client/cluster_manager"
+	"openpitrix.io/openpitrix/test/client/repo_manager"
+	"openpitrix.io/openpitrix/test/client/runtime_manager"
+	"openpitrix.io/openpitrix/test/models"
+)
+
+var (
+	SimulatorRepoNameForTest    = "simulator"
+	SimulatorRepoUrlForTest     = "https://openpitrix.pek3a.qingstor.com/package/"
+	SimulatorAppNameForTest     = "elk"
+	SimulatorRuntimeNameForTest = "simulator runtime"
+	SimulatorZoneForTest        = "simulator-1"
+	// RunInstances takes a while as a real cloud, the state file is shared by
+	// the services, see "make simulator-test"
+	SimulatorCredentialForTest = `{"state_file": "simulator-test.json", "latency": {"RunInstances": "5s"}}`
+)
+
+func waitClusterStatus(t *testing.T, client *apiclient.Openpitrix, clusterId, status string) {
+	for i := 0; i < 120; i++ {
+		describeParams := cluster_manager.NewDescribeClustersParams()
+		describeParams.SetClusterID([]string{clusterId})
+		describeResp, err := client.ClusterManager.DescribeClusters(describeParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clusters := describeResp.Payload.ClusterSet
+		if len(clusters) != 1 {
+			t.Fatalf("failed to describe cluster [%s]", clusterId)
+		}
+		cluster := clusters[0]
+		if cluster.TransitionStatus == "" && cluster.Status == status {
+			return
+		}
+		log.Printf("Waiting for cluster [%s] status [%s], now [%s] [%s]\n",
+			clusterId, status, cluster.Status, cluster.TransitionStatus)
+		time.Sleep(5 * time.Second)
+	}
+	t.Fatalf("cluster [%s] status should be [%s]", clusterId, status)
+}
+
+func TestSimulator(t *testing.T) {
+	log.SetPrefix("[ === SIMULATOR TEST === ] ")
+
+	client := GetClient(GetClientConfig())
+
+	// create repo
+	var repoID string
+	{
+		describeParams := repo_manager.NewDescribeReposParams()
+		describeParams.SetName([]string{SimulatorRepoNameForTest})
+		describeResp, err := client.RepoManager.DescribeRepos(describeParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		repos := describeResp.Payload.RepoSet
+
+		if len(repos) != 0 {
+			repoID = repos[0].RepoID
+		} else {
+			createParams := repo_manager.NewCreateRepoParams()
+			createParams.SetBody(
+				&models.OpenpitrixCreateRepoRequest{
+					Name:        SimulatorRepoNameForTest,
+					Description: "vm based apps",
+					Type:        "https",
+					URL:         SimulatorRepoUrlForTest,
+					Credential:  `{}`,
+					Visibility:  "public",
+					Providers:   []string{constants.ProviderSimulator},
+				})
+			createResp, err := client.RepoManager.CreateRepo(createParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+			repoID = createResp.Payload.RepoID
+		}
+	}
+	log.Printf("Got repo [%s]\n", repoID)
+
+	// waiting for apps indexed by repo indexer
+	var app *models.OpenpitrixApp
+	{
+		for {
+			describeParams := app_manager.NewDescribeAppsParams()
+			describeParams.WithRepoID([]string{repoID})
+			describeParams.WithName([]string{SimulatorAppNameForTest})
+			describeResp, err := client.AppManager.DescribeApps(describeParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+			apps := describeResp.Payload.AppSet
+			if len(apps) != 0 {
+				app = apps[0]
+				break
+			}
+			log.Printf("Waiting for app ...")
+			time.Sleep(5 * time.Second)
+		}
+	}
+	log.Printf("Got app [%s]\n", app.Name)
+
+	var appVersion *models.OpenpitrixAppVersion
+	{
+		describeParams := app_manager.NewDescribeAppVersionsParams()
+		describeParams.SetAppID([]string{app.AppID})
+		describeResp, err := client.AppManager.DescribeAppVersions(describeParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		appVersions := describeResp.Payload.AppVersionSet
+		if len(appVersions) == 0 {
+			t.Fatal("App has no version released")
+		}
+
+		appVersion = appVersions[0]
+		err = ReleaseAppVersion(client, appVersion)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	log.Printf("Got app version [%s]\n", appVersion.Name)
+
+	// create runtime
+	var runtimeID string
+	{
+		describeParams := runtime_manager.NewDescribeRuntimesParams()
+		describeParams.SetSearchWord(&SimulatorRuntimeNameForTest)
+		describeResp, err := client.RuntimeManager.DescribeRuntimes(describeParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		runtimes := describeResp.Payload.RuntimeSet
+		if len(runtimes) != 0 {
+			runtimeID = runtimes[0].RuntimeID
+		} else {
+			createParams := runtime_manager.NewCreateRuntimeParams()
+			createParams.SetBody(
+				&models.OpenpitrixCreateRuntimeRequest{
+					Name:              SimulatorRuntimeNameForTest,
+					Description:       "simulator runtime",
+					Provider:          constants.ProviderSimulator,
+					RuntimeURL:        "https://simulator.openpitrix.io",
+					RuntimeCredential: SimulatorCredentialForTest,
+					Zone:              SimulatorZoneForTest,
+				})
+			createResp, err := client.RuntimeManager.CreateRuntime(createParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+			runtimeID = createResp.Payload.RuntimeID
+		}
+	}
+	log.Printf("Got runtime [%s]\n", runtimeID)
+
+	var subnetID string
+	{
+		describeParams := cluster_manager.NewDescribeSubnetsParams()
+		describeParams.SetRuntimeID(&runtimeID)
+		describeParams.SetZone([]string{SimulatorZoneForTest})
+		describeResp, err := client.ClusterManager.DescribeSubnets(describeParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		subnets := describeResp.Payload.SubnetSet
+		if len(subnets) != 1 {
+			t.Fatalf("expect 1 subnet in zone [%s], got [%d]", SimulatorZoneForTest, len(subnets))
+		}
+		subnetID = subnets[0].SubnetID
+	}
+	log.Printf("Got subnet [%s]\n", subnetID)
+
+	var clusterId string
+	log.Printf("Creating cluster...\n")
+	{
+		createParams := cluster_manager.NewCreateClusterParams()
+		createParams.SetBody(&models.OpenpitrixCreateClusterRequest{
+			AdvancedParam: []string{},
+			AppID:         app.AppID,
+			Conf:          fmt.Sprintf(`{"cluster": {"name": "simulator", "subnet": "%s"}}`, subnetID),
+			RuntimeID:     runtimeID,
+			VersionID:     appVersion.VersionID,
+		})
+
+		createResp, err := client.ClusterManager.CreateCluster(createParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		clusterId = createResp.Payload.ClusterID
+	}
+	waitClusterStatus(t, client, clusterId, constants.StatusActive)
+	log.Printf("Cluster [%s] created \n", clusterId)
+
+	{
+		stopParams := cluster_manager.NewStopClustersParams()
+		stopParams.SetBody(&models.OpenpitrixStopClustersRequest{
+			ClusterID: []string{clusterId},
+		})
+		_, err := client.ClusterManager.StopClusters(stopParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitClusterStatus(t, client, clusterId, constants.StatusStopped)
+	log.Printf("Cluster [%s] stopped \n", clusterId)
+
+	{
+		startParams := cluster_manager.NewStartClustersParams()
+		startParams.SetBody(&models.OpenpitrixStartClustersRequest{
+			ClusterID: []string{clusterId},
+		})
+		_, err := client.ClusterManager.StartClusters(startParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitClusterStatus(t, client, clusterId, constants.StatusActive)
+	log.Printf("Cluster [%s] started \n", clusterId)
+
+	{
+		deleteParams := cluster_manager.NewDeleteClustersParams()
+		deleteParams.SetBody(&models.OpenpitrixDeleteClustersRequest{
+			ClusterID: []string{clusterId},
+		})
+		_, err := client.ClusterManager.DeleteClusters(deleteParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitClusterStatus(t, client, clusterId, constants.StatusDeleted)
+	log.Printf("Cluster [%s] deleted \n", clusterId)
+}